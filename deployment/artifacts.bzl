@@ -184,6 +184,11 @@ ARTIFACTS = {
         "components": ["fleetAdmin"],
         "image": "//go/pkg/fleet_node_reporter:fleet_node_reporter_image",
     },
+    "flowlog-collector": {
+        "chart": "//deployment/charts/flowlog-collector:chart",
+        "components": ["sdnVN"],
+        "image": "//go/pkg/network/flowlog/cmd/flowlog_collector:flowlog_collector_image",
+    },
     "git-to-grpc-synchronizer": {
         "chart": "//deployment/charts/git-to-grpc-synchronizer:chart",
         "components": ["computePopulateInstanceType", "computePopulateMachineImage", "computePopulateSubnet", "populateProductCatalog"],
@@ -1012,6 +1012,7 @@ configure_region_services() {
   basic_service_regional ${region} ${DEFAULT_NAMESPACE} ${region} network-api-server
   basic_service_regional ${region} ${DEFAULT_NAMESPACE} ${region} network-operator
   basic_service_regional ${region} ${DEFAULT_NAMESPACE} ${region} sdn-vn-controller
+  basic_service_regional ${region} ${DEFAULT_NAMESPACE} ${region} flowlog-collector ${region}-cloudmonitor-logs-api-server
   configure_baremetal_enrollment_api ${region}
 }
 
@@ -4656,6 +4656,13 @@ def go_dependencies():
         sum = "h1:YtoBGgbOq9iiNeoJ4kNUbbzUS26XprncPJGGvOqQRRI=",
         version = "v0.47.3-envoy",
     )
+    go_repository(
+        name = "com_github_openconfig_gnmi",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/openconfig/gnmi",
+        sum = "h1:H7pLIb/o3xObu3+x0Fv9DCK7TH3FUh7mNwbYe+34hFw=",
+        version = "v0.11.0",
+    )
     go_repository(
         name = "com_github_opencontainers_go_digest",
         build_file_proto_mode = "disable",
@@ -271,6 +271,9 @@ admin_allowed_actions = {
 	"/proto.QuotaManagementService/ListAllServiceQuotas": ["GET", "POST"],
 	"/proto.QuotaManagementService/ListRegisteredServices": ["GET", "POST"],
 	"/proto.QuotaManagementService/ListServiceResources": ["GET", "POST"],
+	"/proto.QuotaManagementService/ListQuotaIncreaseRequests": ["GET", "POST"],
+	"/proto.QuotaManagementService/ApproveQuotaIncreaseRequest": ["GET", "POST"],
+	"/proto.QuotaManagementService/RejectQuotaIncreaseRequest": ["GET", "POST"],
 	# Product Catalog admin endpoints
 	"/proto.ProductAccessService/ReadAccess": ["GET", "POST"],
 	"/proto.ProductAccessService/CheckProductAccess": ["GET", "POST"],
@@ -117,6 +117,7 @@ require (
 	github.com/onsi/gomega v1.33.1
 	github.com/open-policy-agent/opa v0.47.3
 	github.com/open-policy-agent/opa-envoy-plugin v0.47.3-envoy
+	github.com/openconfig/gnmi v0.11.0
 	github.com/ory/dockertest v3.3.5+incompatible
 	github.com/ovn-org/libovsdb v0.6.1-0.20240125124854-03f787b1a892
 	github.com/ovn-org/ovn-kubernetes/go-controller v0.0.0-20240520202957-f71cdcb4c186
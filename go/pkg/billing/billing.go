@@ -97,7 +97,12 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 	pb.RegisterBillingCouponServiceServer(grpcServer, billingCouponService)
 	pb.RegisterBillingAccountServiceServer(grpcServer, &BillingAccountService{})
 	pb.RegisterBillingCreditServiceServer(grpcServer, &BillingCreditService{})
-	pb.RegisterBillingDeactivateInstancesServiceServer(grpcServer, &BillingDeactivateInstancesService{cloudAccountClient: svc.CloudAccountSvcClient})
+	billingDeactivateInstancesService := NewBillingDeactivateInstancesService(svc.Sql, svc.CloudAccountSvcClient, notificationClient)
+	pb.RegisterBillingDeactivateInstancesServiceServer(grpcServer, billingDeactivateInstancesService)
+	// the test database has no instances deactivation notifications table.
+	if !cfg.TestProfile {
+		billingDeactivateInstancesService.Start(ctx)
+	}
 	pb.RegisterBillingUsageServiceServer(grpcServer, &BillingUsageService{usageServiceClient: svc.UsageServiceClient})
 
 	// this will move to a new micro service after 1.0
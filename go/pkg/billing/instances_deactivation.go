@@ -4,6 +4,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"strconv"
 	"time"
 
@@ -26,13 +27,35 @@ const (
 	sendCloudAccountsForServiceDeactivationErr = "failed to send cloud accounts for service deactivation"
 	defaultCleanupThreshold                    = 30 //days
 	defaultTimeout                             = 5  //seconds
+	InstancesDeactivationEventSubType          = "InstancesDeactivation"
 )
 
 type BillingDeactivateInstancesService struct {
 	cloudAccountClient *billingCommon.CloudAccountSvcClient
+	// sends the instances deactivation events, nil if events are not sent.
+	notifier *instancesDeactivationNotifier
 	pb.UnimplementedBillingDeactivateInstancesServiceServer
 }
 
+// NewBillingDeactivateInstancesService creates the service. Instances deactivation events are sent in the background
+// once the service is started, unless notificationClient is nil.
+func NewBillingDeactivateInstancesService(db *sql.DB, cloudAccountClient *billingCommon.CloudAccountSvcClient, notificationClient *NotificationClient) *BillingDeactivateInstancesService {
+	svc := &BillingDeactivateInstancesService{
+		cloudAccountClient: cloudAccountClient,
+	}
+	if notificationClient != nil {
+		svc.notifier = newInstancesDeactivationNotifier(db, notificationClient)
+	}
+	return svc
+}
+
+// Start sends the instances deactivation events of the cloud accounts added to the deactivation lists.
+func (svc *BillingDeactivateInstancesService) Start(ctx context.Context) {
+	if svc.notifier != nil {
+		svc.notifier.start(ctx)
+	}
+}
+
 func (svc *BillingDeactivateInstancesService) getInstanceDeactivationList(ctx context.Context, accountTypes []pb.AccountType, tradeRestricted bool) ([]*pb.DeactivateInstances, error) {
 	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("BillingDeactivateInstancesService.getInstanceDeactivationList").Start()
 	defer span.End()
@@ -123,6 +146,7 @@ func (svc *BillingDeactivateInstancesService) GetDeactivateInstances(ctx context
 		log.Error(err, getCloudAccountsForDeactivationErr)
 		return nil, status.Errorf(codes.Internal, "%v: %v", getPaidProductsForDeactivationErr, err)
 	}
+	svc.notifyDeactivations(deactivationList, tradeResDeactivationList)
 	deactivationList = append(deactivationList, tradeResDeactivationList...)
 
 	response := &pb.DeactivateInstancesResponse{DeactivationList: deactivationList}
@@ -186,11 +210,21 @@ func (svc *BillingDeactivateInstancesService) getDeactivationLists(ctx context.C
 	if err != nil {
 		return nil, err
 	}
+	svc.notifyDeactivations(deactivationList, tradeResDeactivationList)
 	deactivationList = append(deactivationList, tradeResDeactivationList...)
 
 	return deactivationList, nil
 }
 
+// notifyDeactivations records the deactivation lists, an instances deactivation event is sent in the background
+// for each cloud account which was added to them.
+func (svc *BillingDeactivateInstancesService) notifyDeactivations(deactivationList []*pb.DeactivateInstances, tradeResDeactivationList []*pb.DeactivateInstances) {
+	if svc.notifier == nil {
+		return
+	}
+	svc.notifier.update(deactivationList, tradeResDeactivationList)
+}
+
 func (svc *BillingDeactivateInstancesService) Ping(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
 	log := log.FromContext(ctx).WithName("BillingDeactivateInstancesService.Ping")
 	log.Info("Ping")
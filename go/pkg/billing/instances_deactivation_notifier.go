@@ -0,0 +1,232 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+)
+
+const (
+	// interval between attempts to send the pending instances deactivation events.
+	instancesDeactivationNotifyInterval = time.Minute
+	// a claimed event is sent again by any replica when it was not marked as sent within this time.
+	instancesDeactivationClaimDuration  = 5 * time.Minute
+	instancesDeactivationClaimBatchSize = 100
+
+	insertInstancesDeactivationNotificationQuery = `
+		INSERT INTO instances_deactivation_notifications (cloud_account_id, trade_restricted)
+		VALUES ($1, $2)
+		ON CONFLICT (cloud_account_id) DO NOTHING
+	`
+
+	selectInstancesDeactivationNotificationsQuery = `
+		SELECT cloud_account_id FROM instances_deactivation_notifications
+	`
+
+	deleteInstancesDeactivationNotificationQuery = `
+		DELETE FROM instances_deactivation_notifications WHERE cloud_account_id = $1
+	`
+
+	claimInstancesDeactivationNotificationsQuery = `
+		UPDATE instances_deactivation_notifications SET claimed_until = $2
+		WHERE cloud_account_id IN (
+			SELECT cloud_account_id FROM instances_deactivation_notifications
+			WHERE NOT notified AND (claimed_until IS NULL OR claimed_until < $1)
+			ORDER BY created_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING cloud_account_id, trade_restricted
+	`
+
+	markInstancesDeactivationNotifiedQuery = `
+		UPDATE instances_deactivation_notifications SET notified = TRUE, claimed_until = NULL
+		WHERE cloud_account_id = $1
+	`
+)
+
+type instancesDeactivationEvent struct {
+	cloudAccountId  string
+	tradeRestricted bool
+}
+
+// instancesDeactivationNotifier sends an instances deactivation event for each cloud account which was added to the
+// deactivation lists. The listed cloud accounts and whether they were notified are stored in the database, so that
+// an account is notified once across restarts and replicas, and again after it left the deactivation lists.
+// Events are sent in the background, the deactivation lists are never delayed by the notification gateway.
+type instancesDeactivationNotifier struct {
+	db                 *sql.DB
+	notificationClient *NotificationClient
+	mu                 sync.Mutex
+	// the latest deactivation lists which were not stored yet, nil if there are none.
+	listed map[string]bool
+	wake   chan struct{}
+}
+
+func newInstancesDeactivationNotifier(db *sql.DB, notificationClient *NotificationClient) *instancesDeactivationNotifier {
+	return &instancesDeactivationNotifier{
+		db:                 db,
+		notificationClient: notificationClient,
+		wake:               make(chan struct{}, 1),
+	}
+}
+
+// update records the latest deactivation lists, they are stored and notified by run.
+func (n *instancesDeactivationNotifier) update(deactivationList []*pb.DeactivateInstances, tradeResDeactivationList []*pb.DeactivateInstances) {
+	listed := make(map[string]bool, len(deactivationList)+len(tradeResDeactivationList))
+	for _, deactivation := range deactivationList {
+		listed[deactivation.GetCloudAccountId()] = false
+	}
+	// an account on both lists is notified as trade restricted.
+	for _, deactivation := range tradeResDeactivationList {
+		listed[deactivation.GetCloudAccountId()] = true
+	}
+	n.mu.Lock()
+	n.listed = listed
+	n.mu.Unlock()
+	select {
+	case n.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (n *instancesDeactivationNotifier) start(ctx context.Context) {
+	go n.run(context.WithoutCancel(ctx))
+}
+
+func (n *instancesDeactivationNotifier) run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("instancesDeactivationNotifier.run")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	ticker := time.NewTicker(instancesDeactivationNotifyInterval)
+	defer ticker.Stop()
+	for {
+		if err := n.storeListed(ctx); err != nil {
+			logger.Error(err, "failed to store the instances deactivation lists")
+		}
+		if err := n.notifyPending(ctx); err != nil {
+			logger.Error(err, "failed to send instances deactivation events")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-n.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// storeListed adds the newly listed cloud accounts and removes the accounts which left the deactivation lists.
+func (n *instancesDeactivationNotifier) storeListed(ctx context.Context) error {
+	n.mu.Lock()
+	listed := n.listed
+	n.listed = nil
+	n.mu.Unlock()
+	if listed == nil {
+		return nil
+	}
+
+	err := n.storeListedTx(ctx, listed)
+	if err != nil {
+		// keep the lists unless newer ones were recorded meanwhile.
+		n.mu.Lock()
+		if n.listed == nil {
+			n.listed = listed
+		}
+		n.mu.Unlock()
+	}
+	return err
+}
+
+func (n *instancesDeactivationNotifier) storeListedTx(ctx context.Context, listed map[string]bool) error {
+	tx, err := n.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, selectInstancesDeactivationNotificationsQuery)
+	if err != nil {
+		return err
+	}
+	var unlisted []string
+	for rows.Next() {
+		var cloudAccountId string
+		if err := rows.Scan(&cloudAccountId); err != nil {
+			rows.Close()
+			return err
+		}
+		if _, found := listed[cloudAccountId]; !found {
+			unlisted = append(unlisted, cloudAccountId)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, cloudAccountId := range unlisted {
+		if _, err := tx.ExecContext(ctx, deleteInstancesDeactivationNotificationQuery, cloudAccountId); err != nil {
+			return err
+		}
+	}
+	for cloudAccountId, tradeRestricted := range listed {
+		if _, err := tx.ExecContext(ctx, insertInstancesDeactivationNotificationQuery, cloudAccountId, tradeRestricted); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// notifyPending sends the events of the listed cloud accounts which were not notified yet. The events are claimed
+// before they are sent, so that replicas don't send the same events. Failed events are sent again once their claim
+// expired.
+func (n *instancesDeactivationNotifier) notifyPending(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("instancesDeactivationNotifier.notifyPending")
+	for {
+		events, err := n.claimPending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		for _, event := range events {
+			if err := n.notificationClient.SendInstancesDeactivationEvent(ctx, event.cloudAccountId, event.tradeRestricted); err != nil {
+				logger.Error(err, "failed to send instances deactivation event", "cloudAccountId", event.cloudAccountId)
+				continue
+			}
+			if _, err := n.db.ExecContext(ctx, markInstancesDeactivationNotifiedQuery, event.cloudAccountId); err != nil {
+				logger.Error(err, "failed to mark instances deactivation event as sent", "cloudAccountId", event.cloudAccountId)
+			}
+		}
+		if len(events) < instancesDeactivationClaimBatchSize {
+			return nil
+		}
+	}
+}
+
+func (n *instancesDeactivationNotifier) claimPending(ctx context.Context) ([]instancesDeactivationEvent, error) {
+	now := time.Now()
+	rows, err := n.db.QueryContext(ctx, claimInstancesDeactivationNotificationsQuery,
+		now, now.Add(instancesDeactivationClaimDuration), instancesDeactivationClaimBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var events []instancesDeactivationEvent
+	for rows.Next() {
+		event := instancesDeactivationEvent{}
+		if err := rows.Scan(&event.cloudAccountId, &event.tradeRestricted); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
@@ -4,6 +4,7 @@ package server
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/grpcutil"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
@@ -11,7 +12,8 @@ import (
 )
 
 type NotificationClient struct {
-	emailNotificationServiceClient pb.EmailNotificationServiceClient
+	emailNotificationServiceClient   pb.EmailNotificationServiceClient
+	notificationGatewayServiceClient pb.NotificationGatewayServiceClient
 }
 
 func NewNotificationClient(ctx context.Context, resolver grpcutil.Resolver) (*NotificationClient, error) {
@@ -26,7 +28,38 @@ func NewNotificationClient(ctx context.Context, resolver grpcutil.Resolver) (*No
 		return nil, err
 	}
 	emailNotificationServiceClient := pb.NewEmailNotificationServiceClient(conn)
-	return &NotificationClient{emailNotificationServiceClient: pb.EmailNotificationServiceClient(emailNotificationServiceClient)}, nil
+	return &NotificationClient{
+		emailNotificationServiceClient:   pb.EmailNotificationServiceClient(emailNotificationServiceClient),
+		notificationGatewayServiceClient: pb.NewNotificationGatewayServiceClient(conn),
+	}, nil
+}
+
+// SendInstancesDeactivationEvent creates a notification event for the deactivation of the instances of a cloud
+// account. The notification gateway dispatches it to the webhooks of the cloud account.
+func (notificationClient *NotificationClient) SendInstancesDeactivationEvent(ctx context.Context, cloudAccountId string, tradeRestricted bool) error {
+	logger := log.FromContext(ctx).WithName("NotificationClient.SendInstancesDeactivationEvent")
+	logger.Info("send instances deactivation event", "cloudAccountId", cloudAccountId, "tradeRestricted", tradeRestricted)
+	message := "Paid instances of the cloud account are being deactivated"
+	if tradeRestricted {
+		message = "Instances of the trade restricted cloud account are being deactivated"
+	}
+	severity := pb.EventSeverity_HIGH
+	serviceName := pb.ServiceName_BILLING
+	createEvent := &pb.CreateEvent{
+		Status:         pb.EventStatus_ACTIVE,
+		Type:           pb.EventType_NOTIFICATION,
+		Severity:       &severity,
+		ServiceName:    &serviceName,
+		Message:        &message,
+		CloudAccountId: &cloudAccountId,
+		EventSubType:   InstancesDeactivationEventSubType,
+		Properties:     map[string]string{"tradeRestricted": strconv.FormatBool(tradeRestricted)},
+	}
+	if _, err := notificationClient.notificationGatewayServiceClient.Create(ctx, createEvent); err != nil {
+		logger.Error(err, "unable to create instances deactivation event", "cloudAccountId", cloudAccountId)
+		return err
+	}
+	return nil
 }
 
 func (notificationClient *NotificationClient) SendEmailNotification(ctx context.Context, req *pb.EmailRequest) (*pb.EmailResponse, error) {
@@ -5,4 +5,7 @@ package billing
 type CommonConfig struct {
 	MaxDefaultHistory    int `koanf:"maxDefaultHistory"`
 	InstanceSearchWindow int `koanf:"instanceSearchWindow"`
+	// Invoices are generated for the previous month every InvoiceSchedulerIntervalHours, 0 disables generation.
+	InvoiceSchedulerIntervalHours int `koanf:"invoiceSchedulerIntervalHours"`
+	InvoicePaymentTermDays        int `koanf:"invoicePaymentTermDays"`
 }
@@ -0,0 +1,586 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package billing
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	InvoiceStatusDue  = "Due"
+	InvoiceStatusPaid = "Paid"
+
+	StatementMimeType = "text/csv"
+
+	billingPeriodLayout           = "January 2006"
+	defaultInvoicePaymentTermDays = 30
+)
+
+// InvoiceEngine builds monthly invoices from the product usages of the usage service and the
+// cloud credits of an account. Credits are consumed when usage is reported to the billing driver,
+// an invoice only records the consumed credits it is paid with. It is shared by the billing drivers that do not have an external
+// billing system, generated invoices are stored in the billing database.
+type InvoiceEngine struct {
+	session         *sql.DB
+	usageClient     pb.UsageServiceClient
+	accountType     pb.AccountType
+	paymentTermDays int
+}
+
+// InvoiceLineItem is the usage of one product in one region at one rate during an invoice period.
+type InvoiceLineItem struct {
+	ProductId     string
+	ProductName   string
+	Region        string
+	UsageUnitType string
+	Start         time.Time
+	End           time.Time
+	Quantity      float64
+	Rate          float64
+	Amount        float64
+}
+
+type invoiceCredit struct {
+	CreditId   int64
+	Expiration time.Time
+	// Amount consumed by reported usage which is not applied to an invoice yet
+	Available float64
+}
+
+type creditAllocation struct {
+	CreditId int64
+	Amount   float64
+}
+
+func NewInvoiceEngine(session *sql.DB, usageClient pb.UsageServiceClient, accountType pb.AccountType, cfg CommonConfig) *InvoiceEngine {
+	paymentTermDays := cfg.InvoicePaymentTermDays
+	if paymentTermDays <= 0 {
+		paymentTermDays = defaultInvoicePaymentTermDays
+	}
+	return &InvoiceEngine{
+		session:         session,
+		usageClient:     usageClient,
+		accountType:     accountType,
+		paymentTermDays: paymentTermDays,
+	}
+}
+
+// StartInvoiceScheduler generates the invoices of the previous month every interval until the context is done.
+func (engine *InvoiceEngine) StartInvoiceScheduler(ctx context.Context, interval time.Duration, cloudAccountClient *CloudAccountSvcClient) {
+	logger := log.FromContext(ctx).WithName("InvoiceEngine.StartInvoiceScheduler")
+	logger.Info("starting invoice scheduler", "interval", interval, "accountType", engine.accountType)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		cloudAccounts, err := cloudAccountClient.GetCloudAcctsOfTypes(ctx, []pb.AccountType{engine.accountType})
+		if err != nil {
+			logger.Error(err, "failed to get cloud accounts")
+		} else if err := engine.GenerateInvoices(ctx, cloudAccounts, time.Now()); err != nil {
+			logger.Error(err, "failed to generate invoices")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GenerateInvoices creates the invoices of the billing period before now for the given cloud accounts.
+func (engine *InvoiceEngine) GenerateInvoices(ctx context.Context, cloudAccounts []*pb.CloudAccount, now time.Time) error {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("InvoiceEngine.GenerateInvoices").Start()
+	defer span.End()
+	logger.Info("BEGIN", "numberOfCloudAccounts", len(cloudAccounts))
+	defer logger.Info("END")
+
+	periodStart := BillingPeriodStart(now).AddDate(0, -1, 0)
+	var errs []error
+	for _, cloudAccount := range cloudAccounts {
+		if _, err := engine.GenerateInvoice(ctx, cloudAccount.GetId(), periodStart); err != nil {
+			logger.Error(err, "failed to generate invoice", "cloudAccountId", cloudAccount.GetId())
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GenerateInvoice creates the invoice of the billing period starting at periodStart. Periods without
+// usage are not invoiced and nil is returned. Generating an invoice which already exists returns the
+// stored invoice.
+func (engine *InvoiceEngine) GenerateInvoice(ctx context.Context, cloudAccountId string, periodStart time.Time) (*pb.Invoice, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("InvoiceEngine.GenerateInvoice").
+		WithValues("cloudAccountId", cloudAccountId, "periodStart", periodStart).Start()
+	defer span.End()
+
+	periodStart = BillingPeriodStart(periodStart)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+
+	existing, err := engine.readInvoices(ctx, "cloud_account_id=$1 AND period_start=$2", cloudAccountId, periodStart)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return existing[0], nil
+	}
+
+	usages, err := engine.productUsages(ctx, cloudAccountId, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	lineItems := AggregateProductUsages(usages, periodStart, periodEnd)
+	if len(lineItems) == 0 {
+		logger.V(9).Info("no usage in billing period")
+		return nil, nil
+	}
+	total := 0.0
+	for _, lineItem := range lineItems {
+		total += lineItem.Amount
+	}
+	total = roundAmount(total)
+
+	tx, err := engine.session.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(err, "error starting db transaction")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	credits, err := consumedCredits(ctx, tx, cloudAccountId, periodStart, periodEnd)
+	if err != nil {
+		logger.Error(err, "error reading credits")
+		return nil, err
+	}
+	creditsApplied, allocations := applyCredits(total, credits)
+
+	invoiceDate := periodEnd
+	invoice := &pb.Invoice{
+		CloudAccountId: cloudAccountId,
+		Total:          total,
+		Paid:           creditsApplied,
+		Due:            roundAmount(total - creditsApplied),
+		Start:          timestamppb.New(periodStart),
+		End:            timestamppb.New(periodEnd),
+		InvoiceDate:    timestamppb.New(invoiceDate),
+		DueDate:        timestamppb.New(invoiceDate.AddDate(0, 0, engine.paymentTermDays)),
+		BillingPeriod:  periodStart.Format(billingPeriodLayout),
+		Status:         invoiceStatus(total - creditsApplied),
+	}
+
+	query := "INSERT INTO driver_invoices (cloud_account_id, period_start, period_end, invoice_date, due_date, " +
+		"total, credits_applied, due, status) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) " +
+		"ON CONFLICT (cloud_account_id, period_start) DO NOTHING RETURNING id"
+	err = tx.QueryRowContext(ctx, query, cloudAccountId, periodStart, periodEnd, invoiceDate, invoice.DueDate.AsTime(),
+		invoice.Total, invoice.Paid, invoice.Due, invoice.Status).Scan(&invoice.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Created concurrently by another replica
+		logger.Info("invoice already exists")
+		tx.Rollback()
+		return engine.readInvoice(ctx, cloudAccountId, periodStart)
+	}
+	if err != nil {
+		logger.Error(err, "error inserting invoice")
+		return nil, err
+	}
+
+	for _, lineItem := range lineItems {
+		query := "INSERT INTO driver_invoice_line_items (invoice_id, product_id, product_name, region, usage_unit_type, " +
+			"start_time, end_time, quantity, rate, amount) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)"
+		if _, err := tx.ExecContext(ctx, query, invoice.Id, lineItem.ProductId, lineItem.ProductName, lineItem.Region,
+			lineItem.UsageUnitType, lineItem.Start, lineItem.End, lineItem.Quantity, lineItem.Rate, lineItem.Amount); err != nil {
+			logger.Error(err, "error inserting invoice line item", "productId", lineItem.ProductId)
+			return nil, err
+		}
+	}
+	for _, allocation := range allocations {
+		query := "INSERT INTO driver_invoice_credits (invoice_id, credit_id, amount) VALUES ($1, $2, $3)"
+		if _, err := tx.ExecContext(ctx, query, invoice.Id, allocation.CreditId, allocation.Amount); err != nil {
+			logger.Error(err, "error inserting invoice credit", "creditId", allocation.CreditId)
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing db transaction")
+		return nil, err
+	}
+
+	logger.Info("generated invoice", "invoiceId", invoice.Id, "total", invoice.Total, "creditsApplied", invoice.Paid)
+	return invoice, nil
+}
+
+// ReadInvoices returns the invoices of the filter ordered by billing period, most recent first.
+// Without a search start only the last maxHistoryMonths are returned.
+func (engine *InvoiceEngine) ReadInvoices(ctx context.Context, filter *pb.BillingInvoiceFilter, maxHistoryMonths int) ([]*pb.Invoice, error) {
+	where := "cloud_account_id=$1"
+	args := []any{filter.GetCloudAccountId()}
+	if filter.Id != nil {
+		args = append(args, filter.GetId())
+		where += fmt.Sprintf(" AND id=$%d", len(args))
+	}
+	if filter.SearchStart != nil {
+		args = append(args, filter.GetSearchStart().AsTime())
+		where += fmt.Sprintf(" AND invoice_date>=$%d", len(args))
+	} else if maxHistoryMonths > 0 && filter.Id == nil {
+		args = append(args, time.Now().AddDate(0, -maxHistoryMonths, 0))
+		where += fmt.Sprintf(" AND invoice_date>=$%d", len(args))
+	}
+	if filter.SearchEnd != nil {
+		args = append(args, filter.GetSearchEnd().AsTime())
+		where += fmt.Sprintf(" AND invoice_date<=$%d", len(args))
+	}
+	return engine.readInvoices(ctx, where, args...)
+}
+
+// ReadInvoiceDetails returns the line items of an invoice of the cloud account.
+func (engine *InvoiceEngine) ReadInvoiceDetails(ctx context.Context, invoiceId *pb.InvoiceId) ([]*pb.InvoiceDetail, error) {
+	lineItems, err := engine.readLineItems(ctx, invoiceId)
+	if err != nil {
+		return nil, err
+	}
+	return engine.invoiceDetails(lineItems), nil
+}
+
+// ReadUnbilled returns the usage of the cloud account which is not invoiced yet.
+func (engine *InvoiceEngine) ReadUnbilled(ctx context.Context, cloudAccountId string, now time.Time) ([]*pb.InvoiceDetail, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("InvoiceEngine.ReadUnbilled").WithValues("cloudAccountId", cloudAccountId).Start()
+	defer span.End()
+
+	// The previous period is unbilled until the scheduler generated its invoice
+	start := BillingPeriodStart(now).AddDate(0, -1, 0)
+	query := "SELECT COALESCE(MAX(period_end), $2) FROM driver_invoices WHERE cloud_account_id=$1"
+	if err := engine.session.QueryRowContext(ctx, query, cloudAccountId, start).Scan(&start); err != nil {
+		logger.Error(err, "error reading last invoice")
+		return nil, err
+	}
+	start = start.UTC()
+
+	usages, err := engine.productUsages(ctx, cloudAccountId, start, now)
+	if err != nil {
+		return nil, err
+	}
+	return engine.invoiceDetails(AggregateProductUsages(usages, start, now)), nil
+}
+
+// ReadStatement returns an invoice and its line items as a csv document.
+func (engine *InvoiceEngine) ReadStatement(ctx context.Context, invoiceId *pb.InvoiceId) (*pb.Statement, error) {
+	invoices, err := engine.readInvoices(ctx, "cloud_account_id=$1 AND id=$2", invoiceId.GetCloudAccountId(), invoiceId.GetInvoiceId())
+	if err != nil {
+		return nil, err
+	}
+	if len(invoices) == 0 {
+		return nil, status.Errorf(codes.NotFound, "invoice %d not found", invoiceId.GetInvoiceId())
+	}
+	lineItems, err := engine.readLineItems(ctx, invoiceId)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := BuildStatement(invoices[0], lineItems)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Statement{
+		MimeType:  StatementMimeType,
+		Statement: statement,
+	}, nil
+}
+
+func (engine *InvoiceEngine) readInvoice(ctx context.Context, cloudAccountId string, periodStart time.Time) (*pb.Invoice, error) {
+	invoices, err := engine.readInvoices(ctx, "cloud_account_id=$1 AND period_start=$2", cloudAccountId, periodStart)
+	if err != nil {
+		return nil, err
+	}
+	if len(invoices) == 0 {
+		return nil, fmt.Errorf("invoice of %v for cloud account %v not found", periodStart, cloudAccountId)
+	}
+	return invoices[0], nil
+}
+
+func (engine *InvoiceEngine) readInvoices(ctx context.Context, where string, args ...any) ([]*pb.Invoice, error) {
+	logger := log.FromContext(ctx).WithName("InvoiceEngine.readInvoices")
+
+	query := "SELECT id, cloud_account_id, period_start, period_end, invoice_date, due_date, total, credits_applied, due, status " +
+		"FROM driver_invoices WHERE " + where + " ORDER BY period_start DESC"
+	rows, err := engine.session.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error(err, "error executing query", "query", query)
+		return nil, err
+	}
+	defer rows.Close()
+
+	invoices := []*pb.Invoice{}
+	for rows.Next() {
+		invoice := &pb.Invoice{}
+		var periodStart, periodEnd, invoiceDate, dueDate time.Time
+		if err := rows.Scan(&invoice.Id, &invoice.CloudAccountId, &periodStart, &periodEnd, &invoiceDate, &dueDate,
+			&invoice.Total, &invoice.Paid, &invoice.Due, &invoice.Status); err != nil {
+			logger.Error(err, "error reading invoice row")
+			return nil, err
+		}
+		invoice.Start = timestamppb.New(periodStart)
+		invoice.End = timestamppb.New(periodEnd)
+		invoice.InvoiceDate = timestamppb.New(invoiceDate)
+		invoice.DueDate = timestamppb.New(dueDate)
+		invoice.BillingPeriod = periodStart.Format(billingPeriodLayout)
+		if invoice.Status == InvoiceStatusPaid {
+			invoice.PaidDate = invoice.InvoiceDate
+		}
+		invoices = append(invoices, invoice)
+	}
+	return invoices, rows.Err()
+}
+
+func (engine *InvoiceEngine) readLineItems(ctx context.Context, invoiceId *pb.InvoiceId) ([]*InvoiceLineItem, error) {
+	logger := log.FromContext(ctx).WithName("InvoiceEngine.readLineItems")
+
+	// Joining the invoice restricts the line items to invoices of the cloud account
+	query := "SELECT li.product_id, li.product_name, li.region, li.usage_unit_type, li.start_time, li.end_time, " +
+		"li.quantity, li.rate, li.amount FROM driver_invoice_line_items li " +
+		"JOIN driver_invoices i ON li.invoice_id = i.id " +
+		"WHERE i.cloud_account_id=$1 AND i.id=$2 ORDER BY li.id"
+	rows, err := engine.session.QueryContext(ctx, query, invoiceId.GetCloudAccountId(), invoiceId.GetInvoiceId())
+	if err != nil {
+		logger.Error(err, "error executing query")
+		return nil, err
+	}
+	defer rows.Close()
+
+	lineItems := []*InvoiceLineItem{}
+	for rows.Next() {
+		lineItem := &InvoiceLineItem{}
+		if err := rows.Scan(&lineItem.ProductId, &lineItem.ProductName, &lineItem.Region, &lineItem.UsageUnitType,
+			&lineItem.Start, &lineItem.End, &lineItem.Quantity, &lineItem.Rate, &lineItem.Amount); err != nil {
+			logger.Error(err, "error reading line item row")
+			return nil, err
+		}
+		lineItems = append(lineItems, lineItem)
+	}
+	return lineItems, rows.Err()
+}
+
+func (engine *InvoiceEngine) productUsages(ctx context.Context, cloudAccountId string, start, end time.Time) ([]*pb.ProductUsage, error) {
+	logger := log.FromContext(ctx).WithName("InvoiceEngine.productUsages")
+
+	// Usages are attributed to the period they start in, the end of the period is applied when aggregating.
+	stream, err := engine.usageClient.StreamSearchProductUsages(ctx, &pb.ProductUsagesFilter{
+		CloudAccountId: &cloudAccountId,
+		StartTime:      timestamppb.New(start),
+	})
+	if err != nil {
+		logger.Error(err, "failed to search product usages")
+		return nil, err
+	}
+	usages := []*pb.ProductUsage{}
+	for {
+		usage, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			logger.Error(err, "failed to receive product usage")
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+func (engine *InvoiceEngine) invoiceDetails(lineItems []*InvoiceLineItem) []*pb.InvoiceDetail {
+	details := make([]*pb.InvoiceDetail, 0, len(lineItems))
+	for _, lineItem := range lineItems {
+		details = append(details, &pb.InvoiceDetail{
+			ProductId: lineItem.ProductId,
+			Start:     timestamppb.New(lineItem.Start),
+			End:       timestamppb.New(lineItem.End),
+			Rate: &pb.Rate{
+				AccountType: engine.accountType,
+				Unit:        pb.RateUnit(pb.RateUnit_value[lineItem.UsageUnitType]),
+				Rate:        strconv.FormatFloat(lineItem.Rate, 'f', -1, 64),
+			},
+			Usage:  lineItem.Quantity,
+			Amount: lineItem.Amount,
+		})
+	}
+	return details
+}
+
+// Reads the credits which were valid during the period and the part of them which was consumed by reported usage
+// but is not applied to an invoice yet. The remaining amount of a credit is reduced when usage is reported, it goes
+// below zero once usage exceeds the credit. The credits are locked until the invoice applying them is committed.
+func consumedCredits(ctx context.Context, tx *sql.Tx, cloudAccountId string, periodStart, periodEnd time.Time) ([]invoiceCredit, error) {
+	query := "SELECT id, expiry, available FROM (" +
+		"SELECT id, expiry, original_amount - GREATEST(COALESCE(remaining_amount, original_amount), 0) - " +
+		"COALESCE((SELECT SUM(amount) FROM driver_invoice_credits WHERE credit_id=cloud_credits.id), 0) AS available " +
+		"FROM cloud_credits WHERE cloud_account_id=$1 AND created_at<$3 AND expiry>$2 FOR UPDATE" +
+		") AS credits WHERE available>0"
+	rows, err := tx.QueryContext(ctx, query, cloudAccountId, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credits := []invoiceCredit{}
+	for rows.Next() {
+		credit := invoiceCredit{}
+		if err := rows.Scan(&credit.CreditId, &credit.Expiration, &credit.Available); err != nil {
+			return nil, err
+		}
+		credits = append(credits, credit)
+	}
+	return credits, rows.Err()
+}
+
+// BillingPeriodStart returns the start of the calendar month of t in UTC.
+func BillingPeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// AggregateProductUsages sums the product usages which started in [start, end) into one line item per
+// product, region and rate. Line items are ordered by product name, region and start.
+func AggregateProductUsages(usages []*pb.ProductUsage, start, end time.Time) []*InvoiceLineItem {
+	type lineItemKey struct {
+		productId string
+		region    string
+		rate      float64
+	}
+	lineItemsByKey := map[lineItemKey]*InvoiceLineItem{}
+	for _, usage := range usages {
+		usageStart := usage.GetStartTime().AsTime()
+		if usageStart.Before(start) || !usageStart.Before(end) {
+			continue
+		}
+		usageEnd := usage.GetEndTime().AsTime()
+		key := lineItemKey{productId: usage.GetProductId(), region: usage.GetRegion(), rate: usage.GetRate()}
+		lineItem, found := lineItemsByKey[key]
+		if !found {
+			lineItem = &InvoiceLineItem{
+				ProductId:     usage.GetProductId(),
+				ProductName:   usage.GetProductName(),
+				Region:        usage.GetRegion(),
+				UsageUnitType: usage.GetUsageUnitType(),
+				Start:         usageStart,
+				End:           usageEnd,
+				Rate:          usage.GetRate(),
+			}
+			lineItemsByKey[key] = lineItem
+		}
+		if usageStart.Before(lineItem.Start) {
+			lineItem.Start = usageStart
+		}
+		if usageEnd.After(lineItem.End) {
+			lineItem.End = usageEnd
+		}
+		lineItem.Quantity += usage.GetQuantity()
+		lineItem.Amount += usage.GetQuantity() * usage.GetRate()
+	}
+
+	lineItems := make([]*InvoiceLineItem, 0, len(lineItemsByKey))
+	for _, lineItem := range lineItemsByKey {
+		lineItem.Amount = roundAmount(lineItem.Amount)
+		lineItems = append(lineItems, lineItem)
+	}
+	sort.Slice(lineItems, func(i, j int) bool {
+		if lineItems[i].ProductName != lineItems[j].ProductName {
+			return lineItems[i].ProductName < lineItems[j].ProductName
+		}
+		if lineItems[i].Region != lineItems[j].Region {
+			return lineItems[i].Region < lineItems[j].Region
+		}
+		return lineItems[i].Start.Before(lineItems[j].Start)
+	})
+	return lineItems
+}
+
+// applyCredits applies credits to an invoice total, credits expiring first are used first.
+// Returns the total amount applied and the amount taken from each credit.
+func applyCredits(total float64, credits []invoiceCredit) (float64, []creditAllocation) {
+	sorted := append([]invoiceCredit{}, credits...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Expiration.Before(sorted[j].Expiration)
+	})
+
+	applied := 0.0
+	allocations := []creditAllocation{}
+	for _, credit := range sorted {
+		remaining := roundAmount(total - applied)
+		if remaining <= 0 {
+			break
+		}
+		if credit.Available <= 0 {
+			continue
+		}
+		amount := roundAmount(math.Min(credit.Available, remaining))
+		applied += amount
+		allocations = append(allocations, creditAllocation{CreditId: credit.CreditId, Amount: amount})
+	}
+	return roundAmount(applied), allocations
+}
+
+// BuildStatement writes the invoice summary followed by its line items as csv.
+func BuildStatement(invoice *pb.Invoice, lineItems []*InvoiceLineItem) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	records := [][]string{
+		{"Cloud Account", invoice.GetCloudAccountId()},
+		{"Invoice", strconv.FormatUint(invoice.GetId(), 10)},
+		{"Billing Period", invoice.GetBillingPeriod()},
+		{"Start", invoice.GetStart().AsTime().Format(time.RFC3339)},
+		{"End", invoice.GetEnd().AsTime().Format(time.RFC3339)},
+		{"Invoice Date", invoice.GetInvoiceDate().AsTime().Format(time.RFC3339)},
+		{"Due Date", invoice.GetDueDate().AsTime().Format(time.RFC3339)},
+		{"Total", formatAmount(invoice.GetTotal())},
+		{"Credits Applied", formatAmount(invoice.GetPaid())},
+		{"Amount Due", formatAmount(invoice.GetDue())},
+		{},
+		{"Product Id", "Product Name", "Region", "Start", "End", "Quantity", "Unit", "Rate", "Amount"},
+	}
+	for _, lineItem := range lineItems {
+		records = append(records, []string{
+			lineItem.ProductId,
+			lineItem.ProductName,
+			lineItem.Region,
+			lineItem.Start.Format(time.RFC3339),
+			lineItem.End.Format(time.RFC3339),
+			strconv.FormatFloat(lineItem.Quantity, 'f', -1, 64),
+			lineItem.UsageUnitType,
+			strconv.FormatFloat(lineItem.Rate, 'f', -1, 64),
+			formatAmount(lineItem.Amount),
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("error writing statement: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func invoiceStatus(due float64) string {
+	if roundAmount(due) <= 0 {
+		return InvoiceStatusPaid
+	}
+	return InvoiceStatusDue
+}
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,164 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package billing
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func getProductUsageForInvoiceTests(productId string, region string, rate float64, quantity float64, start time.Time) *pb.ProductUsage {
+	return &pb.ProductUsage{
+		ProductId:     productId,
+		ProductName:   "name-" + productId,
+		Region:        region,
+		Rate:          rate,
+		Quantity:      quantity,
+		UsageUnitType: pb.RateUnit_RATE_UNIT_DOLLARS_PER_MINUTE.String(),
+		StartTime:     timestamppb.New(start),
+		EndTime:       timestamppb.New(start.Add(time.Hour)),
+	}
+}
+
+func TestBillingPeriodStart(t *testing.T) {
+	location := time.FixedZone("test", 5*60*60)
+	periodStart := BillingPeriodStart(time.Date(2024, time.March, 1, 2, 0, 0, 0, location))
+	if !periodStart.Equal(time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected billing period start %v", periodStart)
+	}
+}
+
+func TestAggregateProductUsages(t *testing.T) {
+	periodStart := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	usages := []*pb.ProductUsage{
+		getProductUsageForInvoiceTests("p1", "us-region-1", 0.5, 10, periodStart.Add(time.Hour)),
+		getProductUsageForInvoiceTests("p1", "us-region-1", 0.5, 20, periodStart.Add(48*time.Hour)),
+		// same product at a different rate is a separate line item
+		getProductUsageForInvoiceTests("p1", "us-region-1", 0.25, 4, periodStart.Add(72*time.Hour)),
+		getProductUsageForInvoiceTests("p2", "us-region-2", 1.0, 3, periodStart.Add(time.Hour)),
+		// outside of the period
+		getProductUsageForInvoiceTests("p2", "us-region-2", 1.0, 100, periodStart.Add(-time.Hour)),
+		getProductUsageForInvoiceTests("p2", "us-region-2", 1.0, 100, periodEnd),
+	}
+
+	lineItems := AggregateProductUsages(usages, periodStart, periodEnd)
+	if len(lineItems) != 3 {
+		t.Fatalf("expected 3 line items, got %d", len(lineItems))
+	}
+
+	first := lineItems[0]
+	if first.ProductId != "p1" || first.Rate != 0.5 || first.Quantity != 30 || first.Amount != 15 {
+		t.Fatalf("unexpected first line item %+v", first)
+	}
+	if !first.Start.Equal(periodStart.Add(time.Hour)) || !first.End.Equal(periodStart.Add(49*time.Hour)) {
+		t.Fatalf("unexpected first line item interval %v - %v", first.Start, first.End)
+	}
+	if lineItems[1].ProductId != "p1" || lineItems[1].Amount != 1 {
+		t.Fatalf("unexpected second line item %+v", lineItems[1])
+	}
+	if lineItems[2].ProductId != "p2" || lineItems[2].Amount != 3 {
+		t.Fatalf("unexpected third line item %+v", lineItems[2])
+	}
+}
+
+func TestApplyCredits(t *testing.T) {
+	now := time.Now()
+	credits := []invoiceCredit{
+		{CreditId: 1, Expiration: now.AddDate(0, 2, 0), Available: 50},
+		{CreditId: 2, Expiration: now.AddDate(0, 1, 0), Available: 30},
+		{CreditId: 3, Expiration: now, Available: 0},
+	}
+
+	tests := []struct {
+		name                string
+		total               float64
+		expectedApplied     float64
+		expectedAllocations []creditAllocation
+	}{
+		{
+			name:                "credits expiring first are used first",
+			total:               20,
+			expectedApplied:     20,
+			expectedAllocations: []creditAllocation{{CreditId: 2, Amount: 20}},
+		},
+		{
+			name:                "multiple credits",
+			total:               45.5,
+			expectedApplied:     45.5,
+			expectedAllocations: []creditAllocation{{CreditId: 2, Amount: 30}, {CreditId: 1, Amount: 15.5}},
+		},
+		{
+			name:                "total exceeds credits",
+			total:               100,
+			expectedApplied:     80,
+			expectedAllocations: []creditAllocation{{CreditId: 2, Amount: 30}, {CreditId: 1, Amount: 50}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied, allocations := applyCredits(tt.total, credits)
+			if applied != tt.expectedApplied {
+				t.Fatalf("expected %v applied, got %v", tt.expectedApplied, applied)
+			}
+			if len(allocations) != len(tt.expectedAllocations) {
+				t.Fatalf("expected allocations %v, got %v", tt.expectedAllocations, allocations)
+			}
+			for i := range allocations {
+				if allocations[i] != tt.expectedAllocations[i] {
+					t.Fatalf("expected allocations %v, got %v", tt.expectedAllocations, allocations)
+				}
+			}
+		})
+	}
+
+	applied, allocations := applyCredits(10, nil)
+	if applied != 0 || len(allocations) != 0 {
+		t.Fatalf("expected no credits applied, got %v", applied)
+	}
+}
+
+func TestBuildStatement(t *testing.T) {
+	periodStart := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	invoice := &pb.Invoice{
+		CloudAccountId: "123456789012",
+		Id:             7,
+		Total:          16,
+		Paid:           10,
+		Due:            6,
+		Start:          timestamppb.New(periodStart),
+		End:            timestamppb.New(periodStart.AddDate(0, 1, 0)),
+		InvoiceDate:    timestamppb.New(periodStart.AddDate(0, 1, 0)),
+		DueDate:        timestamppb.New(periodStart.AddDate(0, 1, 30)),
+		BillingPeriod:  periodStart.Format(billingPeriodLayout),
+	}
+	lineItems := AggregateProductUsages([]*pb.ProductUsage{
+		getProductUsageForInvoiceTests("p1", "us-region-1", 0.5, 30, periodStart),
+		getProductUsageForInvoiceTests("p2", "us-region-1", 1, 1, periodStart),
+	}, periodStart, periodStart.AddDate(0, 1, 0))
+
+	statement, err := BuildStatement(invoice, lineItems)
+	if err != nil {
+		t.Fatalf("failed to build statement: %v", err)
+	}
+	reader := csv.NewReader(bytes.NewReader(statement))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		t.Fatalf("failed to parse statement: %v", err)
+	}
+	if records[2][1] != "March 2024" || records[9][1] != "6.00" {
+		t.Fatalf("unexpected statement summary %v", records[:10])
+	}
+	if len(records) != 13 {
+		t.Fatalf("expected 13 statement records, got %d", len(records))
+	}
+	if records[11][0] != "p1" || records[11][8] != "15.00" || records[12][0] != "p2" {
+		t.Fatalf("unexpected statement line items %v", records[11:])
+	}
+}
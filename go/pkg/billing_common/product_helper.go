@@ -8,6 +8,7 @@ import (
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/productcatalog/expr"
 )
 
 const (
@@ -142,7 +143,7 @@ func ValidateProductsForUsageMetricType(inProducts []*pb.Product) ([]*pb.Product
 		// This can lead to false positives and hence it is important to check for every condition that will set it to false in the if conditions.
 		validMetric := true
 		for _, rate := range product.Rates {
-			if rate.UsageExpr != SupportedMetricType {
+			if err := expr.ValidateUsageExpr(rate.UsageExpr); err != nil {
 				validMetric = false
 			}
 		}
@@ -238,7 +238,7 @@ func TestValidateProductsForUsageMetric(t *testing.T) {
 			AccountType: pb.AccountType_ACCOUNT_TYPE_PREMIUM,
 			Rate:        defaultPremiumAccountRate,
 			Unit:        defaultRateUnit,
-			UsageExpr:   "SomeUnsupportedExpression",
+			UsageExpr:   "time – – ",
 		},
 	}
 	matchingExpressionProductId := uuid.NewString()
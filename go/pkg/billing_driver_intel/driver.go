@@ -5,6 +5,7 @@ package billing_driver_intel
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	billing "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/billing_common"
 	billingCommon "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/billing_common"
@@ -24,6 +25,7 @@ type Service struct {
 	cloudAccountClient *billing.CloudAccountSvcClient
 	usageClient        *billing.MeteringClient
 	productClient      *billing.ProductClient
+	invoiceEngine      *billing.InvoiceEngine
 	Mdb                *manageddb.ManagedDb
 	Sql                *sql.DB
 }
@@ -75,15 +77,24 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 		return err
 	}
 
+	// product usages are the source of generated invoices
+	usageSvcClient, err := billingCommon.NewUsageServiceClient(ctx, resolver)
+	if err != nil {
+		logger.Error(err, "failed to initialize usage client")
+		return err
+	}
+	svc.invoiceEngine = billingCommon.NewInvoiceEngine(svc.Sql, usageSvcClient.UsageClient, pb.AccountType_ACCOUNT_TYPE_INTEL, cfg.CommonConfig)
+	if cfg.CommonConfig.InvoiceSchedulerIntervalHours > 0 {
+		go svc.invoiceEngine.StartInvoiceScheduler(ctx, time.Duration(cfg.CommonConfig.InvoiceSchedulerIntervalHours)*time.Hour, svc.cloudAccountClient)
+	}
+
 	pb.RegisterBillingAccountServiceServer(grpcServer, &IntelBillingAccountService{})
 	pb.RegisterBillingOptionServiceServer(grpcServer, &IntelBillingOptionService{})
 	pb.RegisterBillingRateServiceServer(grpcServer, &IntelBillingRateService{})
 	pb.RegisterBillingCreditServiceServer(grpcServer, &IntelBillingCreditService{session: svc.Sql})
 	pb.RegisterBillingInvoiceServiceServer(grpcServer, &IntelBillingInvoiceService{
-		meteringServiceClient: svc.usageClient,
-		productServiceClient:  svc.productClient,
-		cloudAccountClient:    svc.cloudAccountClient,
-		config:                cfg,
+		invoiceEngine: svc.invoiceEngine,
+		config:        cfg,
 	})
 	pb.RegisterBillingInstancesServiceServer(grpcServer, &IntelBillingInstancesService{
 		meteringServiceClient: svc.usageClient,
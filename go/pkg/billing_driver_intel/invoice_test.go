@@ -0,0 +1,125 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package billing_driver_intel
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	billing "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/billing_common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type invoiceTestUsageClient struct {
+	pb.UsageServiceClient
+	usages []*pb.ProductUsage
+}
+
+func (c *invoiceTestUsageClient) StreamSearchProductUsages(ctx context.Context, in *pb.ProductUsagesFilter, opts ...grpc.CallOption) (pb.UsageService_StreamSearchProductUsagesClient, error) {
+	usages := []*pb.ProductUsage{}
+	for _, usage := range c.usages {
+		if !usage.StartTime.AsTime().Before(in.StartTime.AsTime()) {
+			usages = append(usages, usage)
+		}
+	}
+	return &invoiceTestUsageStream{usages: usages}, nil
+}
+
+type invoiceTestUsageStream struct {
+	grpc.ClientStream
+	usages []*pb.ProductUsage
+}
+
+func (s *invoiceTestUsageStream) Recv() (*pb.ProductUsage, error) {
+	if len(s.usages) == 0 {
+		return nil, io.EOF
+	}
+	usage := s.usages[0]
+	s.usages = s.usages[1:]
+	return usage, nil
+}
+
+func getProductUsageForInvoiceTests(quantity float64, start time.Time) *pb.ProductUsage {
+	return &pb.ProductUsage{
+		ProductId:     "invoice-test-product",
+		ProductName:   "invoice-test-product",
+		Region:        "us-region-1",
+		Rate:          1,
+		Quantity:      quantity,
+		UsageUnitType: pb.RateUnit_RATE_UNIT_DOLLARS_PER_MINUTE.String(),
+		StartTime:     timestamppb.New(start),
+		EndTime:       timestamppb.New(start.Add(time.Hour)),
+	}
+}
+
+func TestInvoiceCreditsApplied(t *testing.T) {
+	ctx := context.Background()
+	cloudAcct := CreateIntelCloudAcct(t, ctx)
+	firstPeriod := billing.BillingPeriodStart(time.Now()).AddDate(0, -2, 0)
+	secondPeriod := firstPeriod.AddDate(0, 1, 0)
+
+	query := "INSERT INTO cloud_credits (cloud_account_id, coupon_code, original_amount, created_at, expiry, remaining_amount) " +
+		"VALUES ($1, $2, $3, $4, $5, $6)"
+	if _, err := IntelService.Sql.ExecContext(ctx, query, cloudAcct.Id, "INVOICETEST01", 50,
+		firstPeriod.Add(-time.Hour), time.Now().AddDate(1, 0, 0), 50); err != nil {
+		t.Fatalf("failed to insert cloud credit: %v", err)
+	}
+	remainingAmount := func() float64 {
+		remaining := 0.0
+		if err := IntelService.Sql.QueryRowContext(ctx, "SELECT remaining_amount FROM cloud_credits WHERE cloud_account_id=$1",
+			cloudAcct.Id).Scan(&remaining); err != nil {
+			t.Fatalf("failed to read cloud credit: %v", err)
+		}
+		return remaining
+	}
+
+	usageClient := &invoiceTestUsageClient{usages: []*pb.ProductUsage{
+		getProductUsageForInvoiceTests(30, firstPeriod.Add(time.Hour)),
+		getProductUsageForInvoiceTests(30, secondPeriod.Add(time.Hour)),
+	}}
+	engine := billing.NewInvoiceEngine(IntelService.Sql, usageClient, pb.AccountType_ACCOUNT_TYPE_INTEL, billing.CommonConfig{})
+	for _, tc := range []struct {
+		periodStart time.Time
+		remaining   float64
+	}{
+		{periodStart: firstPeriod, remaining: 20},
+		// usage exceeds the credit
+		{periodStart: secondPeriod, remaining: -10},
+	} {
+		// the usage of the period is reported before its invoice is generated, reporting consumes the credit
+		if err := billing.ProcessCloudAccountCost(ctx, IntelService.Sql, cloudAcct.Id, &billing.CloudAccountToCost{Cost: 30}); err != nil {
+			t.Fatalf("failed to process usage cost: %v", err)
+		}
+		if remaining := remainingAmount(); remaining != tc.remaining {
+			t.Fatalf("expected %v of the credit remaining after the usage report, got %v", tc.remaining, remaining)
+		}
+		if _, err := engine.GenerateInvoice(ctx, cloudAcct.Id, tc.periodStart); err != nil {
+			t.Fatalf("failed to generate invoice: %v", err)
+		}
+		// the invoice records the consumed credit without consuming it again
+		if remaining := remainingAmount(); remaining != tc.remaining {
+			t.Fatalf("expected %v of the credit remaining after the invoice, got %v", tc.remaining, remaining)
+		}
+	}
+
+	invoiceClient := pb.NewBillingInvoiceServiceClient(intelDriverConn)
+	res, err := invoiceClient.Read(ctx, &pb.BillingInvoiceFilter{CloudAccountId: cloudAcct.Id})
+	if err != nil {
+		t.Fatalf("failed to read invoices: %v", err)
+	}
+	if len(res.Invoices) != 2 {
+		t.Fatalf("expected 2 invoices, got %d", len(res.Invoices))
+	}
+	second, first := res.Invoices[0], res.Invoices[1]
+	if first.Total != 30 || first.Paid != 30 || first.Due != 0 {
+		t.Fatalf("unexpected first invoice total %v paid %v due %v", first.Total, first.Paid, first.Due)
+	}
+	// only the remainder of the credit is applied to the second invoice
+	if second.Total != 30 || second.Paid != 20 || second.Due != 10 {
+		t.Fatalf("unexpected second invoice total %v paid %v due %v", second.Total, second.Paid, second.Due)
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"fmt"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/authz"
-	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/billing/db"
+	cloudcredits "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloud_credits"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/grpcutil"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
@@ -15,6 +15,7 @@ import (
 
 	meteringTests "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/metering/tests"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/usage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -44,7 +45,7 @@ func (ts *TestService) Init(ctx context.Context, cfg *Config,
 		return err
 	}
 
-	if err := ts.Mdb.Migrate(ctx, db.MigrationsFs, db.MigrationsDir); err != nil {
+	if err := ts.Mdb.Migrate(ctx, cloudcredits.MigrationsFs, cloudcredits.MigrationsDir); err != nil {
 		log.Error(err, "error migrating database")
 		return err
 	}
@@ -64,6 +65,7 @@ func EmbedService(ctx context.Context) {
 	meteringTests.EmbedService(ctx)
 	authz.EmbedService(ctx)
 	cloudaccount.EmbedService(ctx)
+	usage.EmbedService(ctx)
 	grpcutil.AddTestService[*Config](&Test, &Config{})
 	grpcutil.AddTestService[*grpcutil.ListenConfig](&TestCatalogService{}, &grpcutil.ListenConfig{})
 }
@@ -5,6 +5,7 @@ package standard
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	billingCommon "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/billing_common"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/grpcutil"
@@ -21,6 +22,7 @@ type Service struct {
 	cloudAccountClient *billingCommon.CloudAccountSvcClient
 	usageClient        *billingCommon.MeteringClient
 	productClient      *billingCommon.ProductClient
+	invoiceEngine      *billingCommon.InvoiceEngine
 	Mdb                *manageddb.ManagedDb
 	Sql                *sql.DB
 }
@@ -72,10 +74,25 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 		return err
 	}
 
+	// product usages are the source of generated invoices
+	usageSvcClient, err := billingCommon.NewUsageServiceClient(ctx, resolver)
+	if err != nil {
+		logger.Error(err, "failed to initialize usage client")
+		return err
+	}
+	svc.invoiceEngine = billingCommon.NewInvoiceEngine(svc.Sql, usageSvcClient.UsageClient, pb.AccountType_ACCOUNT_TYPE_STANDARD, cfg.CommonConfig)
+	if cfg.CommonConfig.InvoiceSchedulerIntervalHours > 0 {
+		go svc.invoiceEngine.StartInvoiceScheduler(ctx, time.Duration(cfg.CommonConfig.InvoiceSchedulerIntervalHours)*time.Hour, svc.cloudAccountClient)
+	}
+
 	pb.RegisterBillingAccountServiceServer(grpcServer, &StandardBillingAccountService{})
 	pb.RegisterBillingOptionServiceServer(grpcServer, &StandardBillingOptionService{})
 	pb.RegisterBillingRateServiceServer(grpcServer, &StandardBillingRateService{})
 	pb.RegisterBillingCreditServiceServer(grpcServer, &StandardBillingCreditService{session: svc.Sql})
+	pb.RegisterBillingInvoiceServiceServer(grpcServer, &StandardBillingInvoiceService{
+		invoiceEngine: svc.invoiceEngine,
+		config:        cfg,
+	})
 	pb.RegisterBillingInstancesServiceServer(grpcServer, &StandardBillingInstancesService{
 		meteringServiceClient: svc.usageClient,
 		productServiceClient:  svc.productClient,
@@ -4,39 +4,92 @@ package standard
 
 import (
 	"context"
+	"time"
 
 	billing "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/billing_common"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type StandardBillingInvoiceService struct {
 	pb.UnimplementedBillingInvoiceServiceServer
-	meteringServiceClient *billing.MeteringClient
-	productServiceClient  *billing.ProductClient
-	cloudAccountClient    *billing.CloudAccountSvcClient
-	config                *Config
+	invoiceEngine *billing.InvoiceEngine
+	config        *Config
 }
 
 func (svc *StandardBillingInvoiceService) Read(ctx context.Context, in *pb.BillingInvoiceFilter) (*pb.BillingInvoiceResponse, error) {
-	// Unimplemented: not required by invoice
-	return nil, nil
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("StandardBillingInvoiceService.Read").WithValues("cloudAccountId", in.CloudAccountId).Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+
+	invoices, err := svc.invoiceEngine.ReadInvoices(ctx, in, svc.config.CommonConfig.MaxDefaultHistory)
+	if err != nil {
+		log.Error(err, "error reading invoices")
+		return nil, status.Errorf(codes.Internal, "error reading invoices")
+	}
+	return &pb.BillingInvoiceResponse{
+		LastUpdated: timestamppb.New(time.Now()),
+		Invoices:    invoices,
+	}, nil
 }
 
 func (svc *StandardBillingInvoiceService) ReadDetail(in *pb.InvoiceId, outStream pb.BillingInvoiceService_ReadDetailServer) error {
-	// Unimplemented: not required by invoice
+	ctx, log, span := obs.LogAndSpanFromContext(outStream.Context()).WithName("StandardBillingInvoiceService.ReadDetail").WithValues("cloudAccountId", in.CloudAccountId, "invoiceId", in.InvoiceId).Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+
+	details, err := svc.invoiceEngine.ReadInvoiceDetails(ctx, in)
+	if err != nil {
+		log.Error(err, "error reading invoice details")
+		return status.Errorf(codes.Internal, "error reading invoice details")
+	}
+	for _, detail := range details {
+		if err := outStream.Send(detail); err != nil {
+			log.Error(err, "error sending invoice detail")
+			return err
+		}
+	}
 	return nil
 }
 
 func (svc *StandardBillingInvoiceService) ReadUnbilled(in *pb.BillingAccount, outStream pb.BillingInvoiceService_ReadUnbilledServer) error {
-	// Unimplemented: not required by invoice
-	return nil
+	ctx, log, span := obs.LogAndSpanFromContext(outStream.Context()).WithName("StandardBillingInvoiceService.ReadUnbilled").WithValues("cloudAccountId", in.CloudAccountId).Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
 
+	details, err := svc.invoiceEngine.ReadUnbilled(ctx, in.CloudAccountId, time.Now())
+	if err != nil {
+		log.Error(err, "error reading unbilled usage")
+		return status.Errorf(codes.Internal, "error reading unbilled usage")
+	}
+	for _, detail := range details {
+		if err := outStream.Send(detail); err != nil {
+			log.Error(err, "error sending unbilled usage")
+			return err
+		}
+	}
+	return nil
 }
 
-func (svc *StandardBillingInvoiceService) ReadStatement(context.Context, *pb.InvoiceId) (*pb.Statement, error) {
-	// TODO: implement statement by querying metering data
-	// Code should be shared with standard driver
-	return nil, status.Error(codes.Unimplemented, "statements not implemented yet")
+func (svc *StandardBillingInvoiceService) ReadStatement(ctx context.Context, in *pb.InvoiceId) (*pb.Statement, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("StandardBillingInvoiceService.ReadStatement").WithValues("cloudAccountId", in.CloudAccountId, "invoiceId", in.InvoiceId).Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+
+	statement, err := svc.invoiceEngine.ReadStatement(ctx, in)
+	if status.Code(err) == codes.NotFound {
+		return nil, err
+	}
+	if err != nil {
+		log.Error(err, "error building statement")
+		return nil, status.Errorf(codes.Internal, "error building statement")
+	}
+	return statement, nil
 }
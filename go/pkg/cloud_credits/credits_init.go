@@ -138,13 +138,17 @@ func (*Service) Name() string {
 	return "cloudcredits"
 }
 
+// MigrationsFs holds the schema of the cloudcredits database which is shared with the billing drivers.
+//
 //go:embed sql/*.sql
-var fs embed.FS
+var MigrationsFs embed.FS
+
+const MigrationsDir = "sql"
 
 func openDb(ctx context.Context, mdb *manageddb.ManagedDb) error {
 	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CloudCreditsCreditService.openDb").Start()
 	defer span.End()
-	if err := mdb.Migrate(ctx, fs, "sql"); err != nil {
+	if err := mdb.Migrate(ctx, MigrationsFs, MigrationsDir); err != nil {
 		log.Error(err, "migrate:")
 		return err
 	}
@@ -0,0 +1,5 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+DROP TABLE IF EXISTS driver_invoice_credits;
+DROP TABLE IF EXISTS driver_invoice_line_items;
+DROP TABLE IF EXISTS driver_invoices;
@@ -0,0 +1,51 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+--------------------------------------------------------------------------------
+-- invoices generated by the standard and intel billing drivers
+--------------------------------------------------------------------------------
+
+CREATE TABLE IF NOT EXISTS driver_invoices (
+    id BIGSERIAL PRIMARY KEY NOT NULL,
+    cloud_account_id VARCHAR(12) NOT NULL,
+    period_start TIMESTAMP NOT NULL,
+    period_end TIMESTAMP NOT NULL,
+    invoice_date TIMESTAMP NOT NULL,
+    due_date TIMESTAMP NOT NULL,
+    total NUMERIC NOT NULL,
+    credits_applied NUMERIC NOT NULL,
+    due NUMERIC NOT NULL,
+    status VARCHAR(12) NOT NULL,
+    UNIQUE (cloud_account_id, period_start)
+);
+CREATE INDEX IF NOT EXISTS driver_invoices_account_id_idx ON driver_invoices(cloud_account_id);
+
+--------------------------------------------------------------------------------
+-- invoice line items, one per product, region and rate
+--------------------------------------------------------------------------------
+
+CREATE TABLE IF NOT EXISTS driver_invoice_line_items (
+    id BIGSERIAL PRIMARY KEY NOT NULL,
+    invoice_id BIGINT NOT NULL REFERENCES driver_invoices(id) ON DELETE CASCADE,
+    product_id VARCHAR(64) NOT NULL,
+    product_name VARCHAR NOT NULL,
+    region VARCHAR(64) NOT NULL,
+    usage_unit_type VARCHAR(64) NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    quantity NUMERIC NOT NULL,
+    rate NUMERIC NOT NULL,
+    amount NUMERIC NOT NULL
+);
+CREATE INDEX IF NOT EXISTS driver_invoice_line_items_invoice_id_idx ON driver_invoice_line_items(invoice_id);
+
+--------------------------------------------------------------------------------
+-- cloud credits applied to an invoice
+--------------------------------------------------------------------------------
+
+CREATE TABLE IF NOT EXISTS driver_invoice_credits (
+    invoice_id BIGINT NOT NULL REFERENCES driver_invoices(id) ON DELETE CASCADE,
+    credit_id BIGINT NOT NULL REFERENCES cloud_credits(id),
+    amount NUMERIC NOT NULL,
+    PRIMARY KEY (invoice_id, credit_id)
+);
+CREATE INDEX IF NOT EXISTS driver_invoice_credits_credit_id_idx ON driver_invoice_credits(credit_id);
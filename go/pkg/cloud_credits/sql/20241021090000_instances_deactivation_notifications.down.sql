@@ -0,0 +1,3 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+DROP TABLE IF EXISTS instances_deactivation_notifications;
@@ -0,0 +1,14 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+--------------------------------------------------------------------------------
+-- cloud accounts on the instances deactivation lists and whether they were notified
+--------------------------------------------------------------------------------
+
+CREATE TABLE IF NOT EXISTS instances_deactivation_notifications (
+    cloud_account_id VARCHAR(12) PRIMARY KEY NOT NULL,
+    trade_restricted BOOLEAN NOT NULL,
+    notified BOOLEAN NOT NULL DEFAULT FALSE,
+    claimed_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS instances_deactivation_notifications_pending_idx ON instances_deactivation_notifications(notified, claimed_until);
@@ -439,6 +439,8 @@ func (svc *InvitationService) SendInvitationEmail(ctx context.Context, code stri
 	}
 
 	emailRequest := GetInvitationEmailRequest(code, memberEmail, cloudAccount.GetOwner(), invitationNotes)
+	emailRequest.CloudAccountId = &adminAccountId
+	emailRequest.EventProperties = map[string]string{"memberEmail": memberEmail}
 	logger.Info("sending email")
 	if _, err := svc.notificationClient.SendEmailNotification(ctx, emailRequest); err != nil {
 		logger.Error(err, "couldn't send email")
@@ -462,6 +464,8 @@ func (svc *InvitationService) SendAcceptInvitationEmail(ctx context.Context, cod
 	}
 
 	emailRequest := GetInvitationAcceptEmailRequest(code, memberEmail, cloudAccount.GetOwner())
+	emailRequest.CloudAccountId = &adminAccountId
+	emailRequest.EventProperties = map[string]string{"memberEmail": memberEmail}
 	logger.Info("sending email")
 	if _, err := svc.notificationClient.SendEmailNotification(ctx, emailRequest); err != nil {
 		logger.Error(err, "couldn't send email")
@@ -139,7 +139,7 @@ func (s *InvitationsExpiryScheduler) RemoveAndNotifyAll(ctx context.Context) err
 
 			if s.notificationClient != nil && s.cfg.GetInviteExpiryEmail() {
 				// notify cloud account owner about the invitation expiry
-				if err := s.notificationClient.SendInvitationExpiredEmail(ctx, "Invitation Expired", invite.AdminAccountEmail, invite.MemberEmail, s.cfg.GetInviteExpiredTemplate()); err != nil {
+				if err := s.notificationClient.SendInvitationExpiredEmail(ctx, "Invitation Expired", invite.AdminAccountId, invite.AdminAccountEmail, invite.MemberEmail, s.cfg.GetInviteExpiredTemplate()); err != nil {
 					logger.Error(err, fmt.Sprintf(InvitationsExpiryCloudAccountOwnerNotificationError, invite.AdminAccountId))
 				}
 			}
@@ -45,7 +45,7 @@ func (notificationClient *NotificationClient) SendEmailNotification(ctx context.
 	return resp, nil
 }
 
-func (notificationClient *NotificationClient) SendInvitationExpiredEmail(ctx context.Context, messageType string, cloudAcctEmail string, memberEmail string, templateName string) error {
+func (notificationClient *NotificationClient) SendInvitationExpiredEmail(ctx context.Context, messageType string, cloudAcctId string, cloudAcctEmail string, memberEmail string, templateName string) error {
 	logger := log.FromContext(ctx).WithName("NotificationClient.SendInvitationExpiredEmail")
 
 	senderEmail := notificationClient.cfg.GetSenderEmail()
@@ -61,6 +61,9 @@ func (notificationClient *NotificationClient) SendInvitationExpiredEmail(ctx con
 		Sender:       senderEmail,
 		TemplateName: templateName,
 		TemplateData: templateData,
+		// also notifies the webhooks of the cloud account
+		CloudAccountId:  &cloudAcctId,
+		EventProperties: map[string]string{"memberEmail": memberEmail},
 	}
 
 	if _, err := notificationClient.SendEmailNotification(ctx, emailRequest); err != nil {
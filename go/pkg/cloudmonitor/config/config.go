@@ -3,21 +3,100 @@
 package config
 
 import (
+	"time"
+
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
 )
 
+const (
+	ALERT_RULES_EVALUATION_INTERVAL   = time.Minute
+	ALERT_RULES_LOOKBACK_PERIOD       = 5 * time.Minute
+	ALERT_RULES_QUERY_STEP            = time.Minute
+	ALERT_RULES_QUERY_TIMEOUT         = 30 * time.Second
+	ALERT_RULES_MAX_PER_CLOUD_ACCOUNT = 50
+	ALERT_RULES_EVENT_RETENTION       = 30 * 24 * time.Hour
+	ALERT_RULES_TOPIC_NAME            = "idc-cloudmonitor-alerts-topic"
+)
+
 // Application configuration
 type Config struct {
-	ListenPort          uint16           `koanf:"listenPort"`
-	Database            manageddb.Config `koanf:"database"`
-	VictoriaMetricsAddr string           `koanf:"victoriaMetricsAddr"`
-	RemoteWriteIKSAddr  string           `koanf:"remoteWriteIKSAddr"`
-	RemoteWriteBMAddr   string           `koanf:"remoteWriteBMAddr"`
-	InsecureSkipVerify  bool             `koanf:"insecureSkipVerify"`
-	VMClusterName       string           `koanf:"vMClusterName"`
-	ClusterEndpoint     string           `koanf:"clusterEndpoint"`
-	AwsVMClusterRegion  string           `koanf:"region"`
-	IamRole             string           `koanf:"iamRole"`
-	EnableMetricsBM     bool             `koanf:"enableMetricsBM"`
-	RemoteReadAddrBM    string           `koanf:"remoteReadAddrBM"`
+	ListenPort              uint16           `koanf:"listenPort"`
+	Database                manageddb.Config `koanf:"database"`
+	VictoriaMetricsAddr     string           `koanf:"victoriaMetricsAddr"`
+	RemoteWriteIKSAddr      string           `koanf:"remoteWriteIKSAddr"`
+	RemoteWriteBMAddr       string           `koanf:"remoteWriteBMAddr"`
+	InsecureSkipVerify      bool             `koanf:"insecureSkipVerify"`
+	VMClusterName           string           `koanf:"vMClusterName"`
+	ClusterEndpoint         string           `koanf:"clusterEndpoint"`
+	AwsVMClusterRegion      string           `koanf:"region"`
+	IamRole                 string           `koanf:"iamRole"`
+	EnableMetricsBM         bool             `koanf:"enableMetricsBM"`
+	RemoteReadAddrBM        string           `koanf:"remoteReadAddrBM"`
+	NotificationGatewayAddr string           `koanf:"notificationGatewayAddr"`
+	AlertRules              AlertRulesConfig `koanf:"alertRules"`
+}
+
+type AlertRulesConfig struct {
+	// Enables the background evaluation of alert rules.
+	// Rules can be managed when the evaluator is disabled.
+	Enabled            bool          `koanf:"enabled"`
+	EvaluationInterval time.Duration `koanf:"evaluationInterval"`
+	// Rules are evaluated against the most recent sample in this period.
+	LookbackPeriod          time.Duration `koanf:"lookbackPeriod"`
+	QueryStep               time.Duration `koanf:"queryStep"`
+	QueryTimeout            time.Duration `koanf:"queryTimeout"`
+	MaxRulesPerCloudAccount int           `koanf:"maxRulesPerCloudAccount"`
+	// Alert rule state changes older than this are deleted.
+	EventRetentionPeriod time.Duration `koanf:"eventRetentionPeriod"`
+	// The notification gateway topic the alert events are published to.
+	TopicName string `koanf:"topicName"`
+}
+
+func (c AlertRulesConfig) GetEvaluationInterval() time.Duration {
+	if c.EvaluationInterval <= 0 {
+		return ALERT_RULES_EVALUATION_INTERVAL
+	}
+	return c.EvaluationInterval
+}
+
+func (c AlertRulesConfig) GetLookbackPeriod() time.Duration {
+	if c.LookbackPeriod <= 0 {
+		return ALERT_RULES_LOOKBACK_PERIOD
+	}
+	return c.LookbackPeriod
+}
+
+func (c AlertRulesConfig) GetQueryStep() time.Duration {
+	if c.QueryStep <= 0 {
+		return ALERT_RULES_QUERY_STEP
+	}
+	return c.QueryStep
+}
+
+func (c AlertRulesConfig) GetQueryTimeout() time.Duration {
+	if c.QueryTimeout <= 0 {
+		return ALERT_RULES_QUERY_TIMEOUT
+	}
+	return c.QueryTimeout
+}
+
+func (c AlertRulesConfig) GetMaxRulesPerCloudAccount() int {
+	if c.MaxRulesPerCloudAccount <= 0 {
+		return ALERT_RULES_MAX_PER_CLOUD_ACCOUNT
+	}
+	return c.MaxRulesPerCloudAccount
+}
+
+func (c AlertRulesConfig) GetEventRetentionPeriod() time.Duration {
+	if c.EventRetentionPeriod <= 0 {
+		return ALERT_RULES_EVENT_RETENTION
+	}
+	return c.EventRetentionPeriod
+}
+
+func (c AlertRulesConfig) GetTopicName() string {
+	if c.TopicName == "" {
+		return ALERT_RULES_TOPIC_NAME
+	}
+	return c.TopicName
 }
@@ -0,0 +1,360 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudmonitor/config"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+)
+
+const (
+	AlertFiringEventName   = "CloudMonitorAlertFiring"
+	AlertResolvedEventName = "CloudMonitorAlertResolved"
+	AlertEventSubType      = "CloudMonitorAlert"
+
+	// a stored alert event is published by one replica at a time, it is retried when it was not published within this time.
+	alertEventPublishClaimDuration = 5 * time.Minute
+	// maximum number of unpublished alert events retried at once.
+	alertEventPublishBatchSize = 100
+)
+
+// alertEvent is an alert event stored with the state of its rule, it is published through the notification gateway.
+type alertEvent struct {
+	id             int64
+	ruleId         string
+	state          pb.AlertRuleState
+	value          float64
+	item           string
+	clientRecordId string
+}
+
+// AlertEvaluator periodically evaluates the enabled alert rules against Victoria Metrics.
+// The state of each rule is kept in the database, and an event is published through the
+// notification gateway when an alert fires or is resolved. Events are stored with the state
+// of the rule before they are published, events which could not be published are retried.
+type AlertEvaluator struct {
+	server             *Server
+	notificationClient pb.NotificationGatewayServiceClient
+	cfg                config.AlertRulesConfig
+	now                func() time.Time
+}
+
+func NewAlertEvaluator(server *Server, notificationClient pb.NotificationGatewayServiceClient) *AlertEvaluator {
+	return &AlertEvaluator{
+		server:             server,
+		notificationClient: notificationClient,
+		cfg:                server.cfg.AlertRules,
+		now:                time.Now,
+	}
+}
+
+func (e *AlertEvaluator) Start(ctx context.Context) {
+	go e.Loop(ctx)
+}
+
+func (e *AlertEvaluator) Loop(ctx context.Context) {
+	_, log, _ := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("AlertEvaluator.Loop").Start()
+	log.Info("BEGIN", "evaluationInterval", e.cfg.GetEvaluationInterval())
+	defer log.Info("END")
+
+	ticker := time.NewTicker(e.cfg.GetEvaluationInterval())
+	defer ticker.Stop()
+	for {
+		e.EvaluateAll(ctx)
+		if err := e.PublishPending(ctx); err != nil {
+			log.Error(err, "error publishing alert rule events")
+		}
+		if err := e.deleteExpiredEvents(ctx); err != nil {
+			log.Error(err, "error deleting expired alert rule events")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EvaluateAll evaluates all the enabled rules once.
+func (e *AlertEvaluator) EvaluateAll(ctx context.Context) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("AlertEvaluator.EvaluateAll").Start()
+	defer span.End()
+	log.V(9).Info("BEGIN")
+	defer log.V(9).Info("END")
+
+	rules, err := e.server.searchAlertRules(ctx, searchAlertRulesQuery+" WHERE enabled ORDER BY id")
+	if err != nil {
+		log.Error(err, "error searching alert rules")
+		return
+	}
+	for _, rule := range rules {
+		if err := e.evaluate(ctx, rule); err != nil {
+			log.Error(err, "error evaluating alert rule", "cloudAccountId", rule.CloudAccountId, "id", rule.Id)
+		}
+	}
+}
+
+func (e *AlertEvaluator) evaluate(ctx context.Context, rule *AlertRule) error {
+	queryCtx, cancel := context.WithTimeout(ctx, e.cfg.GetQueryTimeout())
+	defer cancel()
+	now := e.now().UTC()
+	params := url.Values{}
+	params.Add("start", strconv.FormatInt(now.Add(-e.cfg.GetLookbackPeriod()).Unix(), 10))
+	params.Add("end", strconv.FormatInt(now.Unix(), 10))
+	params.Add("step", fmt.Sprintf("%ds", int64(e.cfg.GetQueryStep()/time.Second)))
+	result, _, err := e.server.queryMetrics(queryCtx, rule.ResourceType, rule.Metric, rule.CloudAccountId, rule.ResourceId, params)
+	if err != nil {
+		return err
+	}
+
+	value, item, found := alertRuleValue(rule, result)
+	previous := *rule
+	state := nextAlertRuleState(rule, value, found, now)
+	event, err := e.saveEvaluation(ctx, &previous, rule, state, item)
+	if err != nil {
+		return err
+	}
+	// The rule was changed through the API or by another replica since it was read, or no event is due.
+	if event == nil {
+		return nil
+	}
+	// The event was claimed when it was stored, it is retried by PublishPending if publishing fails.
+	return e.publish(ctx, rule, event)
+}
+
+// alertRuleValue returns the latest value of the series selected by the rule that is the closest to breaching it,
+// and the item that identifies that series.
+func alertRuleValue(rule *AlertRule, result *QueryResult) (float64, string, bool) {
+	greater := rule.Comparison == pb.AlertRuleComparison_ALERT_RULE_COMPARISON_GREATER_THAN ||
+		rule.Comparison == pb.AlertRuleComparison_ALERT_RULE_COMPARISON_GREATER_THAN_OR_EQUAL
+	value := 0.0
+	item := ""
+	found := false
+	for _, series := range result.Data.Result {
+		if !rule.matches(series.Metric) || len(series.Values) == 0 {
+			continue
+		}
+		sample := series.Values[len(series.Values)-1]
+		if len(sample) < 2 {
+			continue
+		}
+		valueStr, ok := sample[1].(string)
+		if !ok {
+			continue
+		}
+		sampleValue, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil || math.IsNaN(sampleValue) {
+			continue
+		}
+		if !found || (greater && sampleValue > value) || (!greater && sampleValue < value) {
+			value = sampleValue
+			item = seriesItem(rule.ResourceType, rule.Metric, series.Metric)
+			found = true
+		}
+	}
+	return value, item, found
+}
+
+// nextAlertRuleState updates the state of the rule with the result of an evaluation.
+// It returns ALERT_RULE_STATE_FIRING when the alert fires, ALERT_RULE_STATE_OK when a firing alert is resolved
+// and ALERT_RULE_STATE_UNSPECIFIED otherwise.
+// Rules without data keep their state, so that a gap in the metrics does not resolve an alert.
+func nextAlertRuleState(rule *AlertRule, value float64, found bool, now time.Time) pb.AlertRuleState {
+	rule.LastEvaluated = &now
+	if !found {
+		return pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED
+	}
+	rule.LastValue = value
+	state := rule.State
+	if rule.breached(value) {
+		switch {
+		case state == pb.AlertRuleState_ALERT_RULE_STATE_FIRING:
+		case state == pb.AlertRuleState_ALERT_RULE_STATE_PENDING && rule.PendingSince != nil && now.Sub(*rule.PendingSince) >= rule.Duration,
+			rule.Duration == 0:
+			rule.State = pb.AlertRuleState_ALERT_RULE_STATE_FIRING
+			rule.PendingSince = nil
+		case state != pb.AlertRuleState_ALERT_RULE_STATE_PENDING:
+			rule.State = pb.AlertRuleState_ALERT_RULE_STATE_PENDING
+			rule.PendingSince = &now
+		}
+	} else {
+		rule.State = pb.AlertRuleState_ALERT_RULE_STATE_OK
+		rule.PendingSince = nil
+	}
+	if rule.State == state {
+		return pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED
+	}
+	rule.StateChanged = now
+	if rule.State == pb.AlertRuleState_ALERT_RULE_STATE_FIRING {
+		return pb.AlertRuleState_ALERT_RULE_STATE_FIRING
+	}
+	if state == pb.AlertRuleState_ALERT_RULE_STATE_FIRING {
+		return pb.AlertRuleState_ALERT_RULE_STATE_OK
+	}
+	return pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED
+}
+
+// saveEvaluation stores the new state of the rule and the alert event, if any. The event is claimed for publishing.
+// It returns the event, nil if there is none or if the rule was changed since it was read.
+func (e *AlertEvaluator) saveEvaluation(ctx context.Context, previous *AlertRule, rule *AlertRule, state pb.AlertRuleState, item string) (*alertEvent, error) {
+	tx, err := e.server.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	result, err := tx.ExecContext(ctx, `
+		UPDATE cloudmonitor_alert_rules
+		SET state = $3, last_value = $4, pending_since = $5, last_evaluated_at = $6, state_changed_at = $7
+		WHERE id = $1 AND updated_at = $2 AND state = $8 AND state_changed_at = $9`,
+		rule.Id, previous.Updated, rule.State, rule.LastValue, rule.PendingSince, rule.LastEvaluated, rule.StateChanged,
+		previous.State, previous.StateChanged)
+	if err != nil {
+		return nil, err
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return nil, err
+	}
+	var event *alertEvent
+	if state != pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED {
+		event = &alertEvent{ruleId: rule.Id, state: state, value: rule.LastValue, item: item, clientRecordId: uuid.NewString()}
+		if err := tx.QueryRowContext(ctx, `
+			INSERT INTO cloudmonitor_alert_rule_events (rule_id, state, value, created_at, item, client_record_id, published, publish_claimed_until)
+			VALUES ($1, $2, $3, $4, $5, $6, FALSE, $7)
+			RETURNING id`, rule.Id, state, rule.LastValue, rule.StateChanged, item, event.clientRecordId,
+			e.now().UTC().Add(alertEventPublishClaimDuration)).Scan(&event.id); err != nil {
+			return nil, err
+		}
+	}
+	return event, tx.Commit()
+}
+
+// PublishPending publishes the stored alert events which were not published, they are claimed first so that
+// replicas don't publish the same events.
+func (e *AlertEvaluator) PublishPending(ctx context.Context) error {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("AlertEvaluator.PublishPending").Start()
+	defer span.End()
+	log.V(9).Info("BEGIN")
+	defer log.V(9).Info("END")
+
+	events, err := e.claimPendingEvents(ctx)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		rules, err := e.server.searchAlertRules(ctx, searchAlertRulesQuery+" WHERE id = $1", event.ruleId)
+		if err != nil {
+			return err
+		}
+		// The events of deleted rules are deleted too.
+		if len(rules) == 0 {
+			continue
+		}
+		rule := rules[0]
+		rule.LastValue = event.value
+		if err := e.publish(ctx, rule, event); err != nil {
+			log.Error(err, "error publishing alert rule event", "cloudAccountId", rule.CloudAccountId, "id", rule.Id)
+		}
+	}
+	return nil
+}
+
+func (e *AlertEvaluator) claimPendingEvents(ctx context.Context) ([]*alertEvent, error) {
+	now := e.now().UTC()
+	rows, err := e.server.session.QueryContext(ctx, `
+		UPDATE cloudmonitor_alert_rule_events SET publish_claimed_until = $2
+		WHERE id IN (
+			SELECT id FROM cloudmonitor_alert_rule_events
+			WHERE NOT published AND (publish_claimed_until IS NULL OR publish_claimed_until < $1)
+			ORDER BY id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, rule_id, state, value, item, client_record_id`,
+		now, now.Add(alertEventPublishClaimDuration), alertEventPublishBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var events []*alertEvent
+	for rows.Next() {
+		event := &alertEvent{}
+		if err := rows.Scan(&event.id, &event.ruleId, &event.state, &event.value, &event.item, &event.clientRecordId); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	// Events are published in the order they were created.
+	sort.Slice(events, func(i, j int) bool { return events[i].id < events[j].id })
+	return events, rows.Err()
+}
+
+// publish publishes a claimed alert event and marks it as published.
+func (e *AlertEvaluator) publish(ctx context.Context, rule *AlertRule, event *alertEvent) error {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("AlertEvaluator.publish").
+		WithValues("cloudAccountId", rule.CloudAccountId, "id", rule.Id, "event", event.state).Start()
+	defer span.End()
+
+	if e.notificationClient == nil {
+		log.Info("notification gateway is not configured, alert event not published")
+	} else if _, err := e.notificationClient.PublishEvent(ctx, alertEventRequest(rule, event.state, event.item, event.clientRecordId, e.cfg.GetTopicName())); err != nil {
+		return err
+	}
+	_, err := e.server.session.ExecContext(ctx, `
+		UPDATE cloudmonitor_alert_rule_events SET published = TRUE, publish_claimed_until = NULL WHERE id = $1`, event.id)
+	return err
+}
+
+func alertEventRequest(rule *AlertRule, event pb.AlertRuleState, item string, clientRecordId string, topicName string) *pb.PublishEventRequest {
+	eventName := AlertFiringEventName
+	eventStatus := pb.EventStatus_ACTIVE
+	message := fmt.Sprintf("Alert %s is firing: %s of %s %s is %s",
+		rule.Name, rule.Metric, rule.ResourceType, rule.ResourceId, strconv.FormatFloat(rule.LastValue, 'f', -1, 64))
+	if event == pb.AlertRuleState_ALERT_RULE_STATE_OK {
+		eventName = AlertResolvedEventName
+		eventStatus = pb.EventStatus_INACTIVE
+		message = fmt.Sprintf("Alert %s is resolved", rule.Name)
+	}
+	severity := rule.Severity
+	cloudAccountId := rule.CloudAccountId
+	return &pb.PublishEventRequest{
+		TopicName: topicName,
+		Subject:   eventName,
+		CreateEvent: &pb.CreateEvent{
+			EventName:      eventName,
+			Status:         eventStatus,
+			Type:           pb.EventType_ALERT,
+			Severity:       &severity,
+			Message:        &message,
+			EventSubType:   AlertEventSubType,
+			ClientRecordId: clientRecordId,
+			CloudAccountId: &cloudAccountId,
+			Properties: map[string]string{
+				"ruleId":       rule.Id,
+				"ruleName":     rule.Name,
+				"resourceType": rule.ResourceType,
+				"resourceId":   rule.ResourceId,
+				"metric":       rule.Metric,
+				"item":         item,
+				"comparison":   rule.Comparison.String(),
+				"threshold":    strconv.FormatFloat(rule.Threshold, 'f', -1, 64),
+				"value":        strconv.FormatFloat(rule.LastValue, 'f', -1, 64),
+			},
+		},
+	}
+}
+
+func (e *AlertEvaluator) deleteExpiredEvents(ctx context.Context) error {
+	_, err := e.server.session.ExecContext(ctx, "DELETE FROM cloudmonitor_alert_rule_events WHERE created_at < $1",
+		e.now().UTC().Add(-e.cfg.GetEventRetentionPeriod()))
+	return err
+}
@@ -0,0 +1,127 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+)
+
+func newTestAlertRule(duration time.Duration) *AlertRule {
+	return &AlertRule{
+		Id:             "123456789012",
+		CloudAccountId: "000000000001",
+		Name:           "disk-full",
+		ResourceType:   "BM",
+		ResourceId:     "9f857a32-407e-4123-b434-119a018cc101",
+		Metric:         "disk",
+		Comparison:     pb.AlertRuleComparison_ALERT_RULE_COMPARISON_GREATER_THAN,
+		Threshold:      0.9,
+		Duration:       duration,
+		Severity:       pb.EventSeverity_HIGH,
+		Enabled:        true,
+		State:          pb.AlertRuleState_ALERT_RULE_STATE_OK,
+	}
+}
+
+func TestNextAlertRuleStateWithDuration(t *testing.T) {
+	rule := newTestAlertRule(5 * time.Minute)
+	now := time.Now()
+
+	steps := []struct {
+		offset time.Duration
+		value  float64
+		found  bool
+		state  pb.AlertRuleState
+		event  pb.AlertRuleState
+	}{
+		{0, 0.5, true, pb.AlertRuleState_ALERT_RULE_STATE_OK, pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED},
+		{time.Minute, 0.95, true, pb.AlertRuleState_ALERT_RULE_STATE_PENDING, pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED},
+		{3 * time.Minute, 0.95, true, pb.AlertRuleState_ALERT_RULE_STATE_PENDING, pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED},
+		{6 * time.Minute, 0.95, true, pb.AlertRuleState_ALERT_RULE_STATE_FIRING, pb.AlertRuleState_ALERT_RULE_STATE_FIRING},
+		{7 * time.Minute, 0.99, true, pb.AlertRuleState_ALERT_RULE_STATE_FIRING, pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED},
+		// Missing data does not resolve the alert.
+		{8 * time.Minute, 0, false, pb.AlertRuleState_ALERT_RULE_STATE_FIRING, pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED},
+		{9 * time.Minute, 0.5, true, pb.AlertRuleState_ALERT_RULE_STATE_OK, pb.AlertRuleState_ALERT_RULE_STATE_OK},
+		// A pending alert that recovers does not fire.
+		{10 * time.Minute, 0.95, true, pb.AlertRuleState_ALERT_RULE_STATE_PENDING, pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED},
+		{11 * time.Minute, 0.5, true, pb.AlertRuleState_ALERT_RULE_STATE_OK, pb.AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED},
+	}
+	for i, step := range steps {
+		event := nextAlertRuleState(rule, step.value, step.found, now.Add(step.offset))
+		if rule.State != step.state || event != step.event {
+			t.Fatalf("step %d: expected state %v and event %v, got %v and %v", i, step.state, step.event, rule.State, event)
+		}
+	}
+}
+
+func TestNextAlertRuleStateWithoutDuration(t *testing.T) {
+	rule := newTestAlertRule(0)
+	if event := nextAlertRuleState(rule, 0.95, true, time.Now()); event != pb.AlertRuleState_ALERT_RULE_STATE_FIRING {
+		t.Fatalf("expected the alert to fire on the first evaluation, got %v", event)
+	}
+}
+
+func TestAlertRuleValue(t *testing.T) {
+	result := &QueryResult{Data: Data{Result: []Result{
+		{Metric: Metric{Mountpoint: "/"}, Values: [][]interface{}{{1.0, "0.97"}, {2.0, "0.5"}}},
+		{Metric: Metric{Mountpoint: "/data"}, Values: [][]interface{}{{1.0, "0.2"}, {2.0, "0.8"}}},
+		{Metric: Metric{Mountpoint: "/tmp"}, Values: [][]interface{}{{1.0, "NaN"}}},
+	}}}
+
+	rule := newTestAlertRule(0)
+	value, item, found := alertRuleValue(rule, result)
+	if !found || value != 0.8 || item != "/data" {
+		t.Fatalf("expected the latest highest value, got %v %v %v", value, item, found)
+	}
+
+	rule.Comparison = pb.AlertRuleComparison_ALERT_RULE_COMPARISON_LESS_THAN
+	value, item, found = alertRuleValue(rule, result)
+	if !found || value != 0.5 || item != "/" {
+		t.Fatalf("expected the latest lowest value, got %v %v %v", value, item, found)
+	}
+
+	rule.LabelSelector = map[string]string{"mountpoint": "/tmp"}
+	if _, _, found := alertRuleValue(rule, result); found {
+		t.Fatalf("expected no value for a series without numeric samples")
+	}
+}
+
+func TestValidateAlertRule(t *testing.T) {
+	if err := validateAlertRule(newTestAlertRule(0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	invalid := map[string]func(*AlertRule){
+		"name":       func(r *AlertRule) { r.Name = "" },
+		"type":       func(r *AlertRule) { r.ResourceType = "GPU" },
+		"metric":     func(r *AlertRule) { r.Metric = "invalid_metric" },
+		"resource":   func(r *AlertRule) { r.ResourceId = "not-a-uuid" },
+		"label":      func(r *AlertRule) { r.LabelSelector = map[string]string{"namespace": "x"} },
+		"comparison": func(r *AlertRule) { r.Comparison = pb.AlertRuleComparison_ALERT_RULE_COMPARISON_UNSPECIFIED },
+		"severity":   func(r *AlertRule) { r.Severity = pb.EventSeverity(42) },
+	}
+	for name, modify := range invalid {
+		rule := newTestAlertRule(0)
+		modify(rule)
+		if err := validateAlertRule(rule); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestAlertEventRequest(t *testing.T) {
+	rule := newTestAlertRule(0)
+	rule.LastValue = 0.95
+	req := alertEventRequest(rule, pb.AlertRuleState_ALERT_RULE_STATE_FIRING, "/", "00000000-0000-0000-0000-000000000001", "topic")
+	if req.CreateEvent.EventName != AlertFiringEventName || req.CreateEvent.Status != pb.EventStatus_ACTIVE ||
+		req.CreateEvent.GetCloudAccountId() != rule.CloudAccountId || req.CreateEvent.Properties["value"] != "0.95" ||
+		req.CreateEvent.ClientRecordId != "00000000-0000-0000-0000-000000000001" {
+		t.Fatalf("unexpected firing event: %v", req)
+	}
+	req = alertEventRequest(rule, pb.AlertRuleState_ALERT_RULE_STATE_OK, "/", "00000000-0000-0000-0000-000000000001", "topic")
+	if req.CreateEvent.EventName != AlertResolvedEventName || req.CreateEvent.Status != pb.EventStatus_INACTIVE {
+		t.Fatalf("unexpected resolved event: %v", req)
+	}
+}
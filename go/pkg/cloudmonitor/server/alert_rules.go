@@ -0,0 +1,520 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxAlertRuleNameLength        = 63
+	maxAlertRuleDescriptionLength = 256
+	maxAlertRuleResourceIdLength  = 63
+	maxAlertRuleDuration          = 24 * time.Hour
+	defaultAlertRuleEventLimit    = 100
+	maxAlertRuleEventLimit        = 1000
+)
+
+// Labels of the series that can be used in the label selector of an alert rule.
+var alertRuleLabels = map[string]func(Metric) string{
+	"drive":      func(m Metric) string { return m.Drive },
+	"hostname":   func(m Metric) string { return m.Hostname },
+	"verb":       func(m Metric) string { return m.Verb },
+	"code":       func(m Metric) string { return m.Code },
+	"device":     func(m Metric) string { return m.Device },
+	"mode":       func(m Metric) string { return m.Mode },
+	"mountpoint": func(m Metric) string { return m.Mountpoint },
+}
+
+type AlertRule struct {
+	Id             string
+	CloudAccountId string
+	Name           string
+	Description    string
+	ResourceType   string
+	ResourceId     string
+	Metric         string
+	LabelSelector  map[string]string
+	Comparison     pb.AlertRuleComparison
+	Threshold      float64
+	Duration       time.Duration
+	Severity       pb.EventSeverity
+	Enabled        bool
+	State          pb.AlertRuleState
+	LastValue      float64
+	PendingSince   *time.Time
+	LastEvaluated  *time.Time
+	StateChanged   time.Time
+	Created        time.Time
+	Updated        time.Time
+}
+
+type AlertRuleEvent struct {
+	RuleId  string
+	State   pb.AlertRuleState
+	Value   float64
+	Created time.Time
+}
+
+// matches returns true if the series has all the labels of the selector.
+func (rule *AlertRule) matches(m Metric) bool {
+	for label, value := range rule.LabelSelector {
+		if alertRuleLabels[label](m) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// breached returns true if the value meets the condition of the rule.
+func (rule *AlertRule) breached(value float64) bool {
+	switch rule.Comparison {
+	case pb.AlertRuleComparison_ALERT_RULE_COMPARISON_GREATER_THAN:
+		return value > rule.Threshold
+	case pb.AlertRuleComparison_ALERT_RULE_COMPARISON_GREATER_THAN_OR_EQUAL:
+		return value >= rule.Threshold
+	case pb.AlertRuleComparison_ALERT_RULE_COMPARISON_LESS_THAN:
+		return value < rule.Threshold
+	case pb.AlertRuleComparison_ALERT_RULE_COMPARISON_LESS_THAN_OR_EQUAL:
+		return value <= rule.Threshold
+	}
+	return false
+}
+
+func (c *Server) CreateAlertRule(ctx context.Context, req *pb.AlertRuleCreateRequest) (*pb.AlertRule, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CloudMonitorService.CreateAlertRule").WithValues("cloudAccountId", req.GetCloudAccountId()).Start()
+	defer span.End()
+	log.V(9).Info("BEGIN")
+	defer log.V(9).Info("END")
+
+	if req.GetCloudAccountId() == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "missing cloudAccountId")
+	}
+	duration, err := parseAlertRuleDuration(req.GetDuration())
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	rule := &AlertRule{
+		CloudAccountId: req.CloudAccountId,
+		Name:           req.Name,
+		Description:    req.Description,
+		ResourceType:   req.ResourceType,
+		ResourceId:     req.ResourceId,
+		Metric:         req.Metric,
+		LabelSelector:  req.LabelSelector,
+		Comparison:     req.Comparison,
+		Threshold:      req.Threshold,
+		Duration:       duration,
+		Severity:       req.Severity,
+		Enabled:        true,
+		State:          pb.AlertRuleState_ALERT_RULE_STATE_OK,
+		StateChanged:   now,
+		Created:        now,
+		Updated:        now,
+	}
+	if rule.Severity == pb.EventSeverity_EVENT_SEVERITY_UNSPECIFIED {
+		rule.Severity = pb.EventSeverity_MEDIUM
+	}
+	if err := validateAlertRule(rule); err != nil {
+		return nil, err
+	}
+
+	count, err := c.countAlertRules(ctx, rule.CloudAccountId)
+	if err != nil {
+		log.Error(err, "error counting alert rules")
+		return nil, status.Error(grpccodes.Internal, "failed to create alert rule")
+	}
+	if count >= c.cfg.AlertRules.GetMaxRulesPerCloudAccount() {
+		return nil, status.Errorf(grpccodes.ResourceExhausted, "a cloud account can have at most %d alert rules", c.cfg.AlertRules.GetMaxRulesPerCloudAccount())
+	}
+	if rule.Id, err = NewId(); err != nil {
+		log.Error(err, "error generating id")
+		return nil, status.Error(grpccodes.Internal, "failed to create alert rule")
+	}
+	created, err := c.createAlertRule(ctx, rule)
+	if err != nil {
+		log.Error(err, "error creating alert rule")
+		return nil, status.Error(grpccodes.Internal, "failed to create alert rule")
+	}
+	if !created {
+		return nil, status.Errorf(grpccodes.AlreadyExists, "alert rule %s already exists", rule.Name)
+	}
+	return alertRuleToPb(rule), nil
+}
+
+func (c *Server) GetAlertRule(ctx context.Context, req *pb.AlertRuleReference) (*pb.AlertRule, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CloudMonitorService.GetAlertRule").WithValues("cloudAccountId", req.GetCloudAccountId(), "id", req.GetId()).Start()
+	defer span.End()
+	log.V(9).Info("BEGIN")
+	defer log.V(9).Info("END")
+
+	rule, err := c.getAlertRuleOrError(ctx, req.GetCloudAccountId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return alertRuleToPb(rule), nil
+}
+
+func (c *Server) SearchAlertRules(ctx context.Context, req *pb.AlertRuleSearchRequest) (*pb.AlertRuleSearchResponse, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CloudMonitorService.SearchAlertRules").WithValues("cloudAccountId", req.GetCloudAccountId()).Start()
+	defer span.End()
+	log.V(9).Info("BEGIN")
+	defer log.V(9).Info("END")
+
+	if req.GetCloudAccountId() == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "missing cloudAccountId")
+	}
+	rules, err := c.searchAlertRules(ctx, searchAlertRulesQuery+" WHERE cloud_account_id = $1 ORDER BY created_at", req.CloudAccountId)
+	if err != nil {
+		log.Error(err, "error searching alert rules")
+		return nil, status.Error(grpccodes.Internal, "failed to search alert rules")
+	}
+	resp := &pb.AlertRuleSearchResponse{}
+	for _, rule := range rules {
+		resp.Items = append(resp.Items, alertRuleToPb(rule))
+	}
+	return resp, nil
+}
+
+func (c *Server) UpdateAlertRule(ctx context.Context, req *pb.AlertRuleUpdateRequest) (*pb.AlertRule, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CloudMonitorService.UpdateAlertRule").WithValues("cloudAccountId", req.GetCloudAccountId(), "id", req.GetId()).Start()
+	defer span.End()
+	log.V(9).Info("BEGIN")
+	defer log.V(9).Info("END")
+
+	rule, err := c.getAlertRuleOrError(ctx, req.GetCloudAccountId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	// Changing the condition restarts the evaluation of the rule.
+	conditionChanged := false
+	if req.Name != nil && req.GetName() != rule.Name {
+		existing, err := c.searchAlertRules(ctx, searchAlertRulesQuery+" WHERE cloud_account_id = $1 AND name = $2", rule.CloudAccountId, req.GetName())
+		if err != nil {
+			log.Error(err, "error searching alert rules")
+			return nil, status.Error(grpccodes.Internal, "failed to update alert rule")
+		}
+		if len(existing) > 0 {
+			return nil, status.Errorf(grpccodes.AlreadyExists, "alert rule %s already exists", req.GetName())
+		}
+		rule.Name = req.GetName()
+	}
+	if req.Description != nil {
+		rule.Description = req.GetDescription()
+	}
+	if req.LabelSelector != nil {
+		rule.LabelSelector = req.LabelSelector.GetLabels()
+		conditionChanged = true
+	}
+	if req.Comparison != nil {
+		rule.Comparison = req.GetComparison()
+		conditionChanged = true
+	}
+	if req.Threshold != nil {
+		rule.Threshold = req.GetThreshold()
+		conditionChanged = true
+	}
+	if req.Duration != nil {
+		if rule.Duration, err = parseAlertRuleDuration(req.GetDuration()); err != nil {
+			return nil, err
+		}
+		conditionChanged = true
+	}
+	if req.Severity != nil {
+		rule.Severity = req.GetSeverity()
+	}
+	if req.Enabled != nil {
+		conditionChanged = conditionChanged || rule.Enabled != req.GetEnabled()
+		rule.Enabled = req.GetEnabled()
+	}
+	if err := validateAlertRule(rule); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	// A pending alert starts over with the new condition.
+	// A firing alert is resolved by the next evaluation if the new condition is not met.
+	// Disabled rules are not evaluated, so they are reset.
+	if (conditionChanged && rule.State == pb.AlertRuleState_ALERT_RULE_STATE_PENDING) ||
+		(!rule.Enabled && rule.State != pb.AlertRuleState_ALERT_RULE_STATE_OK) {
+		rule.State = pb.AlertRuleState_ALERT_RULE_STATE_OK
+		rule.PendingSince = nil
+		rule.StateChanged = now
+	}
+	rule.Updated = now
+	found, err := c.updateAlertRule(ctx, rule)
+	if err != nil {
+		log.Error(err, "error updating alert rule")
+		return nil, status.Error(grpccodes.Internal, "failed to update alert rule")
+	}
+	if !found {
+		return nil, status.Errorf(grpccodes.NotFound, "alert rule %s not found", req.Id)
+	}
+	return alertRuleToPb(rule), nil
+}
+
+func (c *Server) DeleteAlertRule(ctx context.Context, req *pb.AlertRuleReference) (*emptypb.Empty, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CloudMonitorService.DeleteAlertRule").WithValues("cloudAccountId", req.GetCloudAccountId(), "id", req.GetId()).Start()
+	defer span.End()
+	log.V(9).Info("BEGIN")
+	defer log.V(9).Info("END")
+
+	if req.GetCloudAccountId() == "" || req.GetId() == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "missing cloudAccountId or id")
+	}
+	result, err := c.session.ExecContext(ctx, "DELETE FROM cloudmonitor_alert_rules WHERE cloud_account_id = $1 AND id = $2", req.CloudAccountId, req.Id)
+	if err != nil {
+		log.Error(err, "error deleting alert rule")
+		return nil, status.Error(grpccodes.Internal, "failed to delete alert rule")
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(grpccodes.NotFound, "alert rule %s not found", req.Id)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *Server) SearchAlertRuleEvents(ctx context.Context, req *pb.AlertRuleEventSearchRequest) (*pb.AlertRuleEventSearchResponse, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CloudMonitorService.SearchAlertRuleEvents").WithValues("cloudAccountId", req.GetCloudAccountId(), "id", req.GetId()).Start()
+	defer span.End()
+	log.V(9).Info("BEGIN")
+	defer log.V(9).Info("END")
+
+	// Checks that the rule belongs to the cloud account.
+	if _, err := c.getAlertRuleOrError(ctx, req.GetCloudAccountId(), req.GetId()); err != nil {
+		return nil, err
+	}
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultAlertRuleEventLimit
+	}
+	if limit > maxAlertRuleEventLimit {
+		limit = maxAlertRuleEventLimit
+	}
+	rows, err := c.session.QueryContext(ctx, `
+		SELECT rule_id, state, value, created_at
+		FROM cloudmonitor_alert_rule_events
+		WHERE rule_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2`, req.Id, limit)
+	if err != nil {
+		log.Error(err, "error searching alert rule events")
+		return nil, status.Error(grpccodes.Internal, "failed to search alert rule events")
+	}
+	defer rows.Close()
+	resp := &pb.AlertRuleEventSearchResponse{}
+	for rows.Next() {
+		event := AlertRuleEvent{}
+		if err := rows.Scan(&event.RuleId, &event.State, &event.Value, &event.Created); err != nil {
+			log.Error(err, "error reading alert rule event")
+			return nil, status.Error(grpccodes.Internal, "failed to search alert rule events")
+		}
+		resp.Items = append(resp.Items, &pb.AlertRuleEvent{
+			RuleId:    event.RuleId,
+			State:     event.State,
+			Value:     event.Value,
+			Timestamp: timestamppb.New(event.Created),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		log.Error(err, "error reading alert rule events")
+		return nil, status.Error(grpccodes.Internal, "failed to search alert rule events")
+	}
+	return resp, nil
+}
+
+func (c *Server) getAlertRuleOrError(ctx context.Context, cloudAccountId string, id string) (*AlertRule, error) {
+	if cloudAccountId == "" || id == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "missing cloudAccountId or id")
+	}
+	rules, err := c.searchAlertRules(ctx, searchAlertRulesQuery+" WHERE cloud_account_id = $1 AND id = $2", cloudAccountId, id)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "failed to get alert rule")
+	}
+	if len(rules) == 0 {
+		return nil, status.Errorf(grpccodes.NotFound, "alert rule %s not found", id)
+	}
+	return rules[0], nil
+}
+
+func parseAlertRuleDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 || duration > maxAlertRuleDuration {
+		return 0, status.Errorf(grpccodes.InvalidArgument, "duration must be between 0s and %s", maxAlertRuleDuration)
+	}
+	return duration, nil
+}
+
+func validateAlertRule(rule *AlertRule) error {
+	if rule.Name == "" || len(rule.Name) > maxAlertRuleNameLength {
+		return status.Errorf(grpccodes.InvalidArgument, "name must have between 1 and %d characters", maxAlertRuleNameLength)
+	}
+	if len(rule.Description) > maxAlertRuleDescriptionLength {
+		return status.Errorf(grpccodes.InvalidArgument, "description must not be longer than %d characters", maxAlertRuleDescriptionLength)
+	}
+	if rule.ResourceId == "" || len(rule.ResourceId) > maxAlertRuleResourceIdLength || !validResourceId(rule.ResourceType, rule.ResourceId) {
+		return status.Error(grpccodes.InvalidArgument, "invalid resourceId")
+	}
+	var err error
+	switch rule.ResourceType {
+	case "VM":
+		_, _, err = QueryGenerator(rule.Metric, rule.CloudAccountId, rule.ResourceId)
+	case "BM":
+		_, _, err = QueryGeneratorBM(rule.Metric, rule.CloudAccountId, rule.ResourceId)
+	case "IKS":
+		_, _, err = QueryGeneratorIKS(rule.Metric, rule.CloudAccountId, rule.ResourceId)
+	default:
+		return status.Error(grpccodes.InvalidArgument, "resourceType must be one of VM, BM or IKS")
+	}
+	if err != nil {
+		return status.Errorf(grpccodes.InvalidArgument, "metric %q is not supported for resource type %s", rule.Metric, rule.ResourceType)
+	}
+	for label := range rule.LabelSelector {
+		if _, ok := alertRuleLabels[label]; !ok {
+			return status.Errorf(grpccodes.InvalidArgument, "label %q is not supported", label)
+		}
+	}
+	if _, ok := pb.AlertRuleComparison_name[int32(rule.Comparison)]; !ok || rule.Comparison == pb.AlertRuleComparison_ALERT_RULE_COMPARISON_UNSPECIFIED {
+		return status.Error(grpccodes.InvalidArgument, "missing or invalid comparison")
+	}
+	if math.IsNaN(rule.Threshold) || math.IsInf(rule.Threshold, 0) {
+		return status.Error(grpccodes.InvalidArgument, "invalid threshold")
+	}
+	if _, ok := pb.EventSeverity_name[int32(rule.Severity)]; !ok || rule.Severity == pb.EventSeverity_EVENT_SEVERITY_UNSPECIFIED {
+		return status.Error(grpccodes.InvalidArgument, "invalid severity")
+	}
+	return nil
+}
+
+func alertRuleToPb(rule *AlertRule) *pb.AlertRule {
+	resp := &pb.AlertRule{
+		Id:                   rule.Id,
+		CloudAccountId:       rule.CloudAccountId,
+		Name:                 rule.Name,
+		Description:          rule.Description,
+		ResourceType:         rule.ResourceType,
+		ResourceId:           rule.ResourceId,
+		Metric:               rule.Metric,
+		LabelSelector:        rule.LabelSelector,
+		Comparison:           rule.Comparison,
+		Threshold:            rule.Threshold,
+		Duration:             rule.Duration.String(),
+		Severity:             rule.Severity,
+		Enabled:              rule.Enabled,
+		State:                rule.State,
+		LastValue:            rule.LastValue,
+		StateChangeTimestamp: timestamppb.New(rule.StateChanged),
+		CreationTimestamp:    timestamppb.New(rule.Created),
+		UpdateTimestamp:      timestamppb.New(rule.Updated),
+	}
+	if rule.LastEvaluated != nil {
+		resp.LastEvaluationTimestamp = timestamppb.New(*rule.LastEvaluated)
+	}
+	return resp
+}
+
+const searchAlertRulesQuery = `
+	SELECT id, cloud_account_id, name, description, resource_type, resource_id, metric, label_selector,
+		comparison, threshold, duration_seconds, severity, enabled, state, last_value,
+		pending_since, last_evaluated_at, state_changed_at, created_at, updated_at
+	FROM cloudmonitor_alert_rules`
+
+func (c *Server) searchAlertRules(ctx context.Context, query string, args ...any) ([]*AlertRule, error) {
+	rows, err := c.session.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	rules := []*AlertRule{}
+	for rows.Next() {
+		rule := &AlertRule{}
+		var labelSelector []byte
+		var durationSeconds int64
+		var pendingSince, lastEvaluated sql.NullTime
+		if err := rows.Scan(&rule.Id, &rule.CloudAccountId, &rule.Name, &rule.Description, &rule.ResourceType, &rule.ResourceId,
+			&rule.Metric, &labelSelector, &rule.Comparison, &rule.Threshold, &durationSeconds, &rule.Severity, &rule.Enabled,
+			&rule.State, &rule.LastValue, &pendingSince, &lastEvaluated, &rule.StateChanged, &rule.Created, &rule.Updated); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(labelSelector, &rule.LabelSelector); err != nil {
+			return nil, fmt.Errorf("invalid label selector of alert rule %s: %w", rule.Id, err)
+		}
+		rule.Duration = time.Duration(durationSeconds) * time.Second
+		if pendingSince.Valid {
+			rule.PendingSince = &pendingSince.Time
+		}
+		if lastEvaluated.Valid {
+			rule.LastEvaluated = &lastEvaluated.Time
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func (c *Server) countAlertRules(ctx context.Context, cloudAccountId string) (int, error) {
+	count := 0
+	err := c.session.QueryRowContext(ctx, "SELECT COUNT(*) FROM cloudmonitor_alert_rules WHERE cloud_account_id = $1", cloudAccountId).Scan(&count)
+	return count, err
+}
+
+// createAlertRule returns false if the cloud account already has a rule with the same name.
+func (c *Server) createAlertRule(ctx context.Context, rule *AlertRule) (bool, error) {
+	labelSelector, err := json.Marshal(alertRuleLabelSelector(rule))
+	if err != nil {
+		return false, err
+	}
+	result, err := c.session.ExecContext(ctx, `
+		INSERT INTO cloudmonitor_alert_rules (id, cloud_account_id, name, description, resource_type, resource_id, metric,
+			label_selector, comparison, threshold, duration_seconds, severity, enabled, state, state_changed_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		ON CONFLICT (cloud_account_id, name) DO NOTHING`,
+		rule.Id, rule.CloudAccountId, rule.Name, rule.Description, rule.ResourceType, rule.ResourceId, rule.Metric,
+		string(labelSelector), rule.Comparison, rule.Threshold, int64(rule.Duration/time.Second), rule.Severity, rule.Enabled,
+		rule.State, rule.StateChanged, rule.Created, rule.Updated)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// updateAlertRule updates the fields of a rule that can be changed through the API.
+func (c *Server) updateAlertRule(ctx context.Context, rule *AlertRule) (bool, error) {
+	labelSelector, err := json.Marshal(alertRuleLabelSelector(rule))
+	if err != nil {
+		return false, err
+	}
+	result, err := c.session.ExecContext(ctx, `
+		UPDATE cloudmonitor_alert_rules
+		SET name = $3, description = $4, label_selector = $5, comparison = $6, threshold = $7, duration_seconds = $8,
+			severity = $9, enabled = $10, state = $11, pending_since = $12, state_changed_at = $13, updated_at = $14
+		WHERE cloud_account_id = $1 AND id = $2`,
+		rule.CloudAccountId, rule.Id, rule.Name, rule.Description, string(labelSelector), rule.Comparison, rule.Threshold,
+		int64(rule.Duration/time.Second), rule.Severity, rule.Enabled, rule.State, rule.PendingSince, rule.StateChanged, rule.Updated)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+func alertRuleLabelSelector(rule *AlertRule) map[string]string {
+	if rule.LabelSelector == nil {
+		return map[string]string{}
+	}
+	return rule.LabelSelector
+}
@@ -97,6 +97,19 @@ func (s *GrpcService) Start(ctx context.Context) error {
 	}
 	v1.RegisterCloudMonitorServiceServer(s.grpcServer, lensSrv)
 
+	if s.cfg.AlertRules.Enabled {
+		var notificationClient v1.NotificationGatewayServiceClient
+		if s.cfg.NotificationGatewayAddr != "" {
+			notificationConn, err := grpcutil.NewClient(ctx, s.cfg.NotificationGatewayAddr)
+			if err != nil {
+				log.Error(err, "error connecting to notification gateway", "addr", s.cfg.NotificationGatewayAddr)
+				return err
+			}
+			notificationClient = v1.NewNotificationGatewayServiceClient(notificationConn)
+		}
+		NewAlertEvaluator(lensSrv, notificationClient).Start(ctx)
+	}
+
 	reflection.Register(s.grpcServer)
 	listener, err := net.Listen("tcp", s.ListenAddr)
 	if err != nil {
@@ -3,6 +3,7 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/tls"
@@ -18,9 +19,12 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudmonitor/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
@@ -88,232 +92,54 @@ type Server struct {
 	pb.UnimplementedCloudMonitorServiceServer
 	session *sql.DB
 	cfg     config.Config
+
+	httpClientMu       sync.Mutex
+	httpClient         *http.Client
+	httpClientCA       []byte
+	httpClientCAReadAt time.Time
+	caCertFile         string
 }
 
 func (c *Server) QueryResourcesMetrics(ctx context.Context, req *pb.QueryResourcesMetricsRequest) (*pb.QueryResourcesMetricsResponse, error) {
-	//first(ctx, req)
-	preview := false
-	apiEndpointVM := ""
-	tokenVM := ""
-	query := ""
-	unit := ""
 	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CloudMonitorService.QueryResourcesMetrics").WithValues("cloudAccountId", req.CloudAccountId,
 		"resourceId", req.ResourceId).Start()
 
 	defer span.End()
 
 	// Validate resourceId.
-	if strings.HasPrefix(req.ResourceId, "request") || strings.HasPrefix(req.ResourceId, "reservation") {
-		preview = true
-	} else {
-		preview = false
-	}
-
-	if !preview && req.ResourceType != "IKS" {
-		if _, err := uuid.Parse(req.ResourceId); err != nil {
-			log.Error(err, "Incorrect format of resourceId in input")
-			span.SetAttributes(
-				attribute.String("resourceId", req.ResourceId),
-			)
-			span.RecordError(fmt.Errorf("Incorrect format of resourceId in input"))
-			return &pb.QueryResourcesMetricsResponse{}, status.Error(grpccodes.InvalidArgument, "Invalid argument")
-
-		}
-	} else {
-		fmt.Println("resourceId:", req.ResourceId)
+	if !validResourceId(req.ResourceType, req.ResourceId) {
+		log.Error(fmt.Errorf("Incorrect format of resourceId in input"), "Incorrect format of resourceId in input")
+		span.SetAttributes(
+			attribute.String("resourceId", req.ResourceId),
+		)
+		span.RecordError(fmt.Errorf("Incorrect format of resourceId in input"))
+		return &pb.QueryResourcesMetricsResponse{}, status.Error(grpccodes.InvalidArgument, "Invalid argument")
 	}
 
 	// log.Info("Request", "req", req)
 	returnValue := &pb.QueryResourcesMetricsResponse{
 		Response: []*pb.QueryResourcesMetricsResponseItem{},
 	}
-	itemized := &pb.QueryResourcesMetricsResponseItem{
 
-		Queryvalue: []*pb.Queryvalue{},
-		Unit:       "",
-		Item:       "",
-	}
-	if req.ResourceType == "VM" {
-		apiEndpoint := c.cfg.VictoriaMetricsAddr
-		filePath := "/vault/secrets/vmtoken"
-		token, err := os.ReadFile(filePath)
-		if err != nil {
-			// fmt.Println("Error creating request:", err)
-			log.Error(err, "Unable to read ca for  Victoria Metrics ")
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-		}
-		apiEndpointVM = apiEndpoint
-		tokenVM = string(token)
-	} else if req.ResourceType == "IKS" {
-		apiEndpoint := c.cfg.RemoteWriteIKSAddr
-		filePath := "/vault/secrets/vmtokeniks"
-		token, err := os.ReadFile(filePath)
-		if err != nil {
-			// fmt.Println("Error creating request:", err)
-			log.Error(err, "Unable to read ca for  Victoria Metrics ")
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-		}
-		apiEndpointVM = apiEndpoint
-		tokenVM = string(token)
-	} else if req.ResourceType == "BM" {
-		if !c.cfg.EnableMetricsBM {
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("Metrics is not enabled for BM resource")
-		}
-
-		// cluster := c.cfg.VMClusterName  //"ilens-dev"
-		// role := c.cfg.IamRole           //"arn:aws:iam::390677890188:role/cloudmonitor-describe-cluster-role"
-		// server := c.cfg.ClusterEndpoint //"https://E9403701F90A90B408CFF9ABCC4144C5.gr7.us-west-2.eks.amazonaws.com"
-		// region := c.cfg.AwsVMClusterRegion
-
-		//fmt.Println("Request", "req", req)
-
-		// filePathAccessId := "/vault/secrets/accessid"
-		// filePathAccessSecret := "/vault/secrets/accesssecret"
-		//caFile := "/vault/secrets/eksserverca"
-		apiEndpoint := c.cfg.RemoteReadAddrBM
-
-		filePath := "/vault/secrets/vmtokenbm"
-		token, err := os.ReadFile(filePath)
-		if err != nil {
-			// fmt.Println("Error creating request:", err)
-			log.Error(err, "Unable to read token for  Victoria Metrics ")
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-		}
-		vmRecord, err := GetVMRecord(req.CloudAccountId, c, ctx)
-		if err != nil {
-			// fmt.Println("Error creating request:", err)
-			log.Error(err, "Unable to get VM ID")
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to generate query")
-		}
-		apiEndpoint = strings.Replace(apiEndpoint, "%id%", vmRecord, -1)
-
-		// token, err := GetVMUserAWSAuth(caFile, cluster, req.CloudAccountId, server, region, role)
-		// if err != nil {
-		// 	// fmt.Println("Error creating request:", err)
-		// 	log.Error(err, "Unable to create a query for Victoria Metrics with Query Generator")
-		// 	return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-		// }
-		apiEndpointVM = apiEndpoint
-		tokenVM = string(token)
-	} else {
-		span.RecordError(fmt.Errorf("Incorrect resource type"))
-		return &pb.QueryResourcesMetricsResponse{}, status.Error(grpccodes.InvalidArgument, "Invalid argument")
-	}
-	//"https://internal-placeholder.com/select/11/prometheus/api/v1/query_range"
-	if req.ResourceType == "BM" {
-
-		queryBM, unitBM, err := QueryGeneratorBM(req.Metric, req.CloudAccountId, req.ResourceId)
-		if err != nil {
-			// fmt.Println("Error creating request:", err)
-			log.Error(err, "Unable to create a query for Victoria Metrics with Query Generator")
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-		}
-		query = queryBM
-		unit = unitBM
-	} else if req.ResourceType == "IKS" {
-
-		queryBM, unitBM, err := QueryGeneratorIKS(req.Metric, req.CloudAccountId, req.ResourceId)
-		if err != nil {
-			// fmt.Println("Error creating request:", err)
-			log.Error(err, "Unable to create a query for Victoria Metrics with Query Generator")
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-		}
-		query = queryBM
-		unit = unitBM
-	} else {
-		queryVM, unitVM, err := QueryGenerator(req.Metric, req.CloudAccountId, req.ResourceId)
-		if err != nil {
-			// fmt.Println("Error creating request:", err)
-			log.Error(err, "Unable to create a query for Victoria Metrics with Query Generator")
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-		}
-		query = queryVM
-		unit = unitVM
-	}
-
-	client := &http.Client{}
 	params := url.Values{}
 	params.Add("start", req.Start)
 	params.Add("end", req.End)
 	params.Add("step", req.Step)
-	params.Add("query", query)
-	apiEndpointVM += "?" + params.Encode() //"query=" + query + "&start=" + req.Start + "&end=" + req.End + "&step=" + req.Step
-	//apiEndpoint = `https://internal-placeholder.com/select/11/prometheus/api/v1/query_range?query=sum(avg(rate(kubevirt_vmi_vcpu_seconds%7Bnamespace%3D%22239002718779%22%2C%20name%3D%22eb70f9ed-c996-47ca-a8dd-10a5768f3da6%22%7D%5B5m%5D))%20by%20(domain%2C%20name))&start=1709015351.383&end=1709026151.383&step=30s` //"?" + params.Encode()
-	//http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	if c.cfg.InsecureSkipVerify != true {
-
-		caCert, err := os.ReadFile("/vault/secrets/rootca")
-		if err != nil {
-			log.Error(err, "Unable to read CA for Victoria Metrics")
-			return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-		}
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caCert)
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{RootCAs: caCertPool}
-	} else {
-
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	}
-
-	fmt.Println(apiEndpointVM)
-
-	request, err := http.NewRequest("GET", apiEndpointVM, nil)
-	if err != nil {
-		// fmt.Println("Error creating request:", err)
-		log.Error(err, "Error creating request to call Victoria Metrics endpoint")
-
-		return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-	}
-	request.Header.Add("Authorization", "Bearer "+(string(tokenVM)))
-	response, err := client.Do(request)
-	if err != nil {
-		log.Error(err, "Error calling Victoria Metrics endpoint")
-		fmt.Println("Error making HTTP request:", err)
-		fmt.Println("Error making HTTP request:", response)
-		span.SetAttributes(
-			attribute.String("resourceId", req.ResourceId),
-		)
-		span.SetStatus(codes.Error, "unable to fetch data from VictoriaMetrics")
-		span.RecordError(fmt.Errorf("unable to fetch data"))
-		return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-
-	}
-	//Add attributes to a span
-
-	defer response.Body.Close()
-	// Read the response body
-	body, err := io.ReadAll(response.Body)
+	apiResponse, unit, err := c.queryMetrics(ctx, req.ResourceType, req.Metric, req.CloudAccountId, req.ResourceId, params)
 	if err != nil {
-
-		log.Error(err, "Error reading response body")
 		span.SetAttributes(
 			attribute.String("resourceId", req.ResourceId),
 		)
-		span.SetStatus(codes.Error, "unable to read response data from VictoriaMetrics")
-		span.RecordError(fmt.Errorf("unable to read response data from VictoriaMetrics"))
-		return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to fetch data")
-
-	}
-
-	// Parse the JSON response
-	var apiResponse QueryResult
-	err = json.Unmarshal(body, &apiResponse)
-	if err != nil {
-		log.Error(err, "Error parsing response")
-		span.SetAttributes(
-			attribute.String("resourceId", req.ResourceId),
-		)
-		span.SetStatus(codes.Error, "unable to parse data from VictoriaMetrics")
-		span.RecordError(fmt.Errorf("unable to parse data"))
-		return &pb.QueryResourcesMetricsResponse{}, fmt.Errorf("unable to parse data")
-
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return &pb.QueryResourcesMetricsResponse{}, err
 	}
 
 	// Check if Data, Result, and Values exist and have the correct structure
 
 	if len(apiResponse.Data.Result) > 0 {
-		for i, _ := range apiResponse.Data.Result {
-			itemized = &pb.QueryResourcesMetricsResponseItem{
+		for i := range apiResponse.Data.Result {
+			itemized := &pb.QueryResourcesMetricsResponseItem{
 
 				Queryvalue: []*pb.Queryvalue{},
 				Unit:       "",
@@ -332,29 +158,7 @@ func (c *Server) QueryResourcesMetrics(ctx context.Context, req *pb.QueryResourc
 
 			}
 			itemized.Unit = unit
-			if req.ResourceType == "IKS" {
-				if req.Metric == "apiserver_requestsbycode" {
-					itemized.Item = apiResponse.Data.Result[i].Metric.Code
-				} else if req.Metric == "apiserver_requestsbyverb" || req.Metric == "apiserver_errorsbyverb" || req.Metric == "apiserver_latencybyverb" {
-					itemized.Item = apiResponse.Data.Result[i].Metric.Verb
-				} else if req.Metric == "apiserver_latencybyhostname" {
-					itemized.Item = apiResponse.Data.Result[i].Metric.Hostname + " " + apiResponse.Data.Result[i].Metric.Verb
-				} else {
-					itemized.Item = apiResponse.Data.Result[i].Metric.Hostname
-				}
-			} else if req.ResourceType == "BM" {
-				if req.Metric == "network_transmit_bytes" || req.Metric == "io_traffic_read" || req.Metric == "io_traffic_write" || req.Metric == "network_receive_bytes" {
-					itemized.Item = apiResponse.Data.Result[i].Metric.Device
-				} else if req.Metric == "cpu" {
-					itemized.Item = apiResponse.Data.Result[i].Metric.Mode
-				} else if req.Metric == "disk" {
-					itemized.Item = apiResponse.Data.Result[i].Metric.Mountpoint
-				} else {
-					itemized.Item = apiResponse.Data.Result[i].Metric.Hostname
-				}
-			} else {
-				itemized.Item = apiResponse.Data.Result[i].Metric.Drive
-			}
+			itemized.Item = seriesItem(req.ResourceType, req.Metric, apiResponse.Data.Result[i].Metric)
 
 			returnValue.Response = append(returnValue.Response, itemized)
 		}
@@ -371,6 +175,176 @@ func (c *Server) QueryResourcesMetrics(ctx context.Context, req *pb.QueryResourc
 	}
 }
 
+// validResourceId returns false if the resource id cannot refer to a resource of the given type.
+// IKS clusters are referred to by name and previews by their request or reservation id.
+func validResourceId(resourceType string, resourceId string) bool {
+	if strings.HasPrefix(resourceId, "request") || strings.HasPrefix(resourceId, "reservation") || resourceType == "IKS" {
+		return true
+	}
+	_, err := uuid.Parse(resourceId)
+	return err == nil
+}
+
+// queryMetrics runs the query of a metric against the Victoria Metrics endpoint of the resource type.
+// params holds the query_range parameters (start, end and step). It returns the result and the unit of the metric.
+func (c *Server) queryMetrics(ctx context.Context, resourceType string, metric string, cloudAccountId string, resourceId string, params url.Values) (*QueryResult, string, error) {
+	log := log.FromContext(ctx).WithName("CloudMonitorService.queryMetrics")
+
+	apiEndpoint := ""
+	tokenFile := ""
+	query := ""
+	unit := ""
+	var err error
+	if resourceType == "VM" {
+		apiEndpoint = c.cfg.VictoriaMetricsAddr
+		tokenFile = "/vault/secrets/vmtoken"
+		query, unit, err = QueryGenerator(metric, cloudAccountId, resourceId)
+	} else if resourceType == "IKS" {
+		apiEndpoint = c.cfg.RemoteWriteIKSAddr
+		tokenFile = "/vault/secrets/vmtokeniks"
+		query, unit, err = QueryGeneratorIKS(metric, cloudAccountId, resourceId)
+	} else if resourceType == "BM" {
+		if !c.cfg.EnableMetricsBM {
+			return nil, "", fmt.Errorf("Metrics is not enabled for BM resource")
+		}
+		apiEndpoint = c.cfg.RemoteReadAddrBM
+		tokenFile = "/vault/secrets/vmtokenbm"
+		query, unit, err = QueryGeneratorBM(metric, cloudAccountId, resourceId)
+	} else {
+		return nil, "", status.Error(grpccodes.InvalidArgument, "Invalid argument")
+	}
+
+	token, tokenErr := os.ReadFile(tokenFile)
+	if tokenErr != nil {
+		log.Error(tokenErr, "Unable to read token for Victoria Metrics", "tokenFile", tokenFile)
+		return nil, "", fmt.Errorf("unable to fetch data")
+	}
+	if err != nil {
+		log.Error(err, "Unable to create a query for Victoria Metrics with Query Generator")
+		return nil, "", fmt.Errorf("unable to fetch data")
+	}
+	if resourceType == "BM" {
+		vmRecord, err := GetVMRecord(cloudAccountId, c, ctx)
+		if err != nil {
+			log.Error(err, "Unable to get VM ID")
+			return nil, "", fmt.Errorf("unable to generate query")
+		}
+		apiEndpoint = strings.Replace(apiEndpoint, "%id%", vmRecord, -1)
+	}
+
+	client, err := c.metricsHttpClient()
+	if err != nil {
+		log.Error(err, "Unable to read CA for Victoria Metrics")
+		return nil, "", fmt.Errorf("unable to fetch data")
+	}
+	params.Set("query", query)
+	request, err := http.NewRequestWithContext(ctx, "GET", apiEndpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		log.Error(err, "Error creating request to call Victoria Metrics endpoint")
+		return nil, "", fmt.Errorf("unable to fetch data")
+	}
+	request.Header.Add("Authorization", "Bearer "+string(token))
+	response, err := client.Do(request)
+	if err != nil {
+		log.Error(err, "Error calling Victoria Metrics endpoint")
+		return nil, "", fmt.Errorf("unable to fetch data")
+	}
+	defer response.Body.Close()
+	// Read the response body
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		log.Error(err, "Error reading response body")
+		return nil, "", fmt.Errorf("unable to fetch data")
+	}
+
+	// Parse the JSON response
+	var apiResponse QueryResult
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Error(err, "Error parsing response")
+		return nil, "", fmt.Errorf("unable to parse data")
+	}
+	return &apiResponse, unit, nil
+}
+
+const (
+	defaultCACertFile = "/vault/secrets/rootca"
+	// caReloadInterval is how often the CA bundle is reread, so a rotated bundle is picked up without a restart.
+	caReloadInterval = time.Minute
+)
+
+// metricsHttpClient returns the client used to call Victoria Metrics.
+// The client is shared by API calls and the alert rule evaluator, so the TLS configuration is set on its own transport.
+// The CA bundle is reread every caReloadInterval and the client is rebuilt when its contents change.
+func (c *Server) metricsHttpClient() (*http.Client, error) {
+	c.httpClientMu.Lock()
+	defer c.httpClientMu.Unlock()
+	if c.httpClient != nil && (c.cfg.InsecureSkipVerify || time.Since(c.httpClientCAReadAt) < caReloadInterval) {
+		return c.httpClient, nil
+	}
+	if c.cfg.InsecureSkipVerify {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		c.httpClient = &http.Client{Transport: transport}
+		return c.httpClient, nil
+	}
+
+	caFile := c.caCertFile
+	if caFile == "" {
+		caFile = defaultCACertFile
+	}
+	caCert, err := os.ReadFile(caFile)
+	if err != nil {
+		if c.httpClient != nil {
+			// keep using the last bundle that was read
+			return c.httpClient, nil
+		}
+		return nil, err
+	}
+	c.httpClientCAReadAt = time.Now()
+	if c.httpClient != nil && bytes.Equal(caCert, c.httpClientCA) {
+		return c.httpClient, nil
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		if c.httpClient != nil {
+			return c.httpClient, nil
+		}
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: caCertPool}
+	if c.httpClient != nil {
+		c.httpClient.CloseIdleConnections()
+	}
+	c.httpClient = &http.Client{Transport: transport}
+	c.httpClientCA = caCert
+	return c.httpClient, nil
+}
+
+// seriesItem returns the label that identifies a series of the metric.
+func seriesItem(resourceType string, metric string, m Metric) string {
+	if resourceType == "IKS" {
+		if metric == "apiserver_requestsbycode" {
+			return m.Code
+		} else if metric == "apiserver_requestsbyverb" || metric == "apiserver_errorsbyverb" || metric == "apiserver_latencybyverb" {
+			return m.Verb
+		} else if metric == "apiserver_latencybyhostname" {
+			return m.Hostname + " " + m.Verb
+		}
+		return m.Hostname
+	} else if resourceType == "BM" {
+		if metric == "network_transmit_bytes" || metric == "io_traffic_read" || metric == "io_traffic_write" || metric == "network_receive_bytes" {
+			return m.Device
+		} else if metric == "cpu" {
+			return m.Mode
+		} else if metric == "disk" {
+			return m.Mountpoint
+		}
+		return m.Hostname
+	}
+	return m.Drive
+}
+
 func QueryGenerator(metric string, cloudaccountid string, resourseid string) (string, string, error) {
 
 	query := ""
@@ -0,0 +1,104 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTLSServer starts a server with its own self-signed certificate, httptest.NewTLSServer shares one certificate
+// between servers.
+func newTLSServer(t *testing.T, handler http.Handler) *httptest.Server {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "victoria-metrics"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	server.StartTLS()
+	return server
+}
+
+func writeServerCA(t *testing.T, path string, server *httptest.Server) {
+	t.Helper()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.TLS.Certificates[0].Certificate[0]})
+	if err := os.WriteFile(path, ca, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMetricsHttpClientReloadsCA(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	oldServer := newTLSServer(t, handler)
+	defer oldServer.Close()
+	newServer := newTLSServer(t, handler)
+	defer newServer.Close()
+
+	caFile := filepath.Join(t.TempDir(), "rootca")
+	writeServerCA(t, caFile, oldServer)
+	c := &Server{caCertFile: caFile}
+
+	client, err := c.metricsHttpClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(oldServer.URL); err != nil {
+		t.Fatalf("expected the old CA to be trusted: %v", err)
+	}
+	if _, err := client.Get(newServer.URL); err == nil {
+		t.Fatal("expected the new CA not to be trusted yet")
+	}
+
+	// the bundle is rotated, it is picked up once the reload interval has passed
+	writeServerCA(t, caFile, newServer)
+	if reused, _ := c.metricsHttpClient(); reused != client {
+		t.Fatal("expected the client to be reused within the reload interval")
+	}
+	c.httpClientCAReadAt = time.Now().Add(-caReloadInterval)
+	client, err = c.metricsHttpClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(newServer.URL); err != nil {
+		t.Fatalf("expected the rotated CA to be trusted: %v", err)
+	}
+
+	// the last bundle is kept if the file can't be read
+	if err := os.Remove(caFile); err != nil {
+		t.Fatal(err)
+	}
+	c.httpClientCAReadAt = time.Now().Add(-caReloadInterval)
+	if reused, err := c.metricsHttpClient(); err != nil || reused != client {
+		t.Fatalf("expected the last client to be kept, err %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func newAlertRuleCreateRequest(cloudAccountId string, name string) *pb.AlertRuleCreateRequest {
+	return &pb.AlertRuleCreateRequest{
+		CloudAccountId: cloudAccountId,
+		Name:           name,
+		ResourceType:   "VM",
+		ResourceId:     "9f857a32-407e-4123-b434-119a018cc101",
+		Metric:         "cpu",
+		Comparison:     pb.AlertRuleComparison_ALERT_RULE_COMPARISON_GREATER_THAN,
+		Threshold:      0.9,
+		Duration:       "5m",
+		Severity:       pb.EventSeverity_HIGH,
+	}
+}
+
+func TestAlertRuleLifecycle(t *testing.T) {
+	ctx := context.Background()
+	cloudAccountId := "100000000001"
+
+	rule, err := cloudmonitorClient.CreateAlertRule(ctx, newAlertRuleCreateRequest(cloudAccountId, "high-cpu"))
+	if err != nil {
+		t.Fatalf("create alert rule: %v", err)
+	}
+	if rule.Id == "" || !rule.Enabled || rule.State != pb.AlertRuleState_ALERT_RULE_STATE_OK || rule.Duration != "5m0s" {
+		t.Fatalf("unexpected alert rule: %v", rule)
+	}
+
+	_, err = cloudmonitorClient.CreateAlertRule(ctx, newAlertRuleCreateRequest(cloudAccountId, "high-cpu"))
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists, got %v", err)
+	}
+
+	ref := &pb.AlertRuleReference{CloudAccountId: cloudAccountId, Id: rule.Id}
+	got, err := cloudmonitorClient.GetAlertRule(ctx, ref)
+	if err != nil {
+		t.Fatalf("get alert rule: %v", err)
+	}
+	if got.Name != "high-cpu" || got.Threshold != 0.9 {
+		t.Fatalf("unexpected alert rule: %v", got)
+	}
+
+	// Rules of other cloud accounts are not visible.
+	_, err = cloudmonitorClient.GetAlertRule(ctx, &pb.AlertRuleReference{CloudAccountId: "100000000002", Id: rule.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+
+	updated, err := cloudmonitorClient.UpdateAlertRule(ctx, &pb.AlertRuleUpdateRequest{
+		CloudAccountId: cloudAccountId,
+		Id:             rule.Id,
+		Threshold:      proto.Float64(0.8),
+		Enabled:        proto.Bool(false),
+	})
+	if err != nil {
+		t.Fatalf("update alert rule: %v", err)
+	}
+	if updated.Threshold != 0.8 || updated.Enabled || updated.Name != "high-cpu" {
+		t.Fatalf("unexpected alert rule: %v", updated)
+	}
+
+	search, err := cloudmonitorClient.SearchAlertRules(ctx, &pb.AlertRuleSearchRequest{CloudAccountId: cloudAccountId})
+	if err != nil {
+		t.Fatalf("search alert rules: %v", err)
+	}
+	if len(search.Items) != 1 || search.Items[0].Id != rule.Id {
+		t.Fatalf("unexpected alert rules: %v", search.Items)
+	}
+
+	events, err := cloudmonitorClient.SearchAlertRuleEvents(ctx, &pb.AlertRuleEventSearchRequest{CloudAccountId: cloudAccountId, Id: rule.Id})
+	if err != nil {
+		t.Fatalf("search alert rule events: %v", err)
+	}
+	if len(events.Items) != 0 {
+		t.Fatalf("unexpected alert rule events: %v", events.Items)
+	}
+
+	if _, err := cloudmonitorClient.DeleteAlertRule(ctx, ref); err != nil {
+		t.Fatalf("delete alert rule: %v", err)
+	}
+	_, err = cloudmonitorClient.GetAlertRule(ctx, ref)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
+
+func TestCreateAlertRuleInvalidArgument(t *testing.T) {
+	ctx := context.Background()
+	cloudAccountId := "100000000003"
+
+	invalid := map[string]func(*pb.AlertRuleCreateRequest){
+		"metric":   func(req *pb.AlertRuleCreateRequest) { req.Metric = "invalid_metric" },
+		"duration": func(req *pb.AlertRuleCreateRequest) { req.Duration = "forever" },
+		"comparison": func(req *pb.AlertRuleCreateRequest) {
+			req.Comparison = pb.AlertRuleComparison_ALERT_RULE_COMPARISON_UNSPECIFIED
+		},
+		"label": func(req *pb.AlertRuleCreateRequest) { req.LabelSelector = map[string]string{"namespace": "x"} },
+	}
+	for name, modify := range invalid {
+		req := newAlertRuleCreateRequest(cloudAccountId, name)
+		modify(req)
+		_, err := cloudmonitorClient.CreateAlertRule(ctx, req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+DROP TABLE IF EXISTS cloudmonitor_alert_rule_events;
+DROP TABLE IF EXISTS cloudmonitor_alert_rules;
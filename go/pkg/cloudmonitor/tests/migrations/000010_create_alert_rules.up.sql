@@ -0,0 +1,39 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+CREATE TABLE IF NOT EXISTS cloudmonitor_alert_rules
+(
+    id VARCHAR(12) NOT NULL,
+    cloud_account_id VARCHAR(12) NOT NULL,
+    name VARCHAR(63) NOT NULL,
+    description VARCHAR(256) NOT NULL DEFAULT '',
+    resource_type VARCHAR(8) NOT NULL,
+    resource_id VARCHAR(63) NOT NULL,
+    metric VARCHAR(63) NOT NULL,
+    label_selector JSONB NOT NULL DEFAULT '{}',
+    comparison INTEGER NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    duration_seconds BIGINT NOT NULL DEFAULT 0,
+    severity INTEGER NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    state INTEGER NOT NULL,
+    last_value DOUBLE PRECISION NOT NULL DEFAULT 0,
+    pending_since TIMESTAMP,
+    last_evaluated_at TIMESTAMP,
+    state_changed_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT cloudmonitor_alert_rules_pkey PRIMARY KEY (id),
+    CONSTRAINT cloudmonitor_alert_rules_ukey UNIQUE (cloud_account_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS cloudmonitor_alert_rule_events
+(
+    id BIGSERIAL,
+    rule_id VARCHAR(12) NOT NULL REFERENCES cloudmonitor_alert_rules (id) ON DELETE CASCADE,
+    state INTEGER NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    CONSTRAINT cloudmonitor_alert_rule_events_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS cloudmonitor_alert_rule_events_rule_id_idx ON cloudmonitor_alert_rule_events (rule_id, created_at);
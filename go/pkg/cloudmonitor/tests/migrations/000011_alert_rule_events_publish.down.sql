@@ -0,0 +1,8 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+DROP INDEX IF EXISTS cloudmonitor_alert_rule_events_unpublished_idx;
+ALTER TABLE cloudmonitor_alert_rule_events
+    DROP COLUMN IF EXISTS item,
+    DROP COLUMN IF EXISTS client_record_id,
+    DROP COLUMN IF EXISTS published,
+    DROP COLUMN IF EXISTS publish_claimed_until;
@@ -0,0 +1,10 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+-- Alert events are published after they are stored, events which were not published yet are retried.
+ALTER TABLE cloudmonitor_alert_rule_events
+    ADD COLUMN IF NOT EXISTS item VARCHAR(256) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS client_record_id VARCHAR(36) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS published BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN IF NOT EXISTS publish_claimed_until TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS cloudmonitor_alert_rule_events_unpublished_idx ON cloudmonitor_alert_rule_events (id) WHERE NOT published;
@@ -380,9 +380,16 @@ func (s *CloudMonitorLogsService) SearchLogsByFilter(ctx context.Context, req *p
 	logger.Info("CloudMonitorLogsService: SearchLogsByFilter invoked")
 
 	// select valid filters based on resource type
+	// resourceIdFieldName is the field of the log records that identifies the resource.
 	allowedFilterFieldNames := []string{}
+	resourceIdFieldName := ""
 	if req.ResourceType == "IKS" {
 		allowedFilterFieldNames = s.cfg.IKSFilterFieldNames
+		resourceIdFieldName = "cluster_id"
+	} else if req.ResourceType == "FLOWLOG" {
+		// Flow logs of security groups. The resource id is the security group id.
+		allowedFilterFieldNames = s.cfg.FlowLogFilterFieldNames
+		resourceIdFieldName = "security_group_id"
 	} else {
 		fmt.Println("Invalid resource type!!")
 		return &pb.SearchLogsByFilterResponse{}, fmt.Errorf("Invalid resource type!!")
@@ -409,7 +416,7 @@ func (s *CloudMonitorLogsService) SearchLogsByFilter(ctx context.Context, req *p
 	insecureSkipVerify := s.cfg.InsecureSkipVerify
 	maxPageSize := int32(200)
 	cloudAccountId := req.CloudAccountId
-	clusterId := req.ResourceId
+	resourceId := req.ResourceId
 
 	// validate Timestamp and convert to UTC string with miliseconds
 	startTime, endTime := validateTimestamp(req.StartTime, req.EndTime)
@@ -434,7 +441,7 @@ func (s *CloudMonitorLogsService) SearchLogsByFilter(ctx context.Context, req *p
 	from := (pageNumber - 1) * size
 
 	// build dynamic query
-	query, err := BuildQuery(fieldNames, fieldValues, cloudAccountId, clusterRegion, clusterId, size, from, startTime, endTime)
+	query, err := BuildQuery(fieldNames, fieldValues, cloudAccountId, clusterRegion, resourceIdFieldName, resourceId, size, from, startTime, endTime)
 	if err != nil {
 		fmt.Printf("Error building query: %v\n", err)
 		return &pb.SearchLogsByFilterResponse{}, fmt.Errorf("Unable to fetch data!!")
@@ -131,7 +131,7 @@ func validateTimestamp(startTimeEpoch string, endTimeEpoch string) (int64, int64
 	return startTime, endTime
 }
 
-func BuildQuery(fieldNames []string, values []string, cloudAccountId string, clusterRegion string, clusterId string, size int32, from int32, startTime int64, endTime int64) (string, error) {
+func BuildQuery(fieldNames []string, values []string, cloudAccountId string, clusterRegion string, resourceIdFieldName string, resourceId string, size int32, from int32, startTime int64, endTime int64) (string, error) {
 
 	var mustClauses []interface{}
 
@@ -158,10 +158,10 @@ func BuildQuery(fieldNames []string, values []string, cloudAccountId string, clu
 		},
 	})
 
-	// add clusterId as match phrase
+	// add resourceId as match phrase
 	mustClauses = append(mustClauses, map[string]interface{}{
 		"term": map[string]interface{}{
-			"cluster_id": clusterId,
+			resourceIdFieldName: resourceId,
 		},
 	})
 
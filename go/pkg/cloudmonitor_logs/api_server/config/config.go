@@ -16,5 +16,6 @@ type Config struct {
 	IKSAggregationFieldNames []string         `koanf:"iKSAggregationFieldNames"`
 	ClusterRegion            string           `koanf:"clusterRegion"`
 	IKSFilterFieldNames      []string         `koanf:"iKSFilterFieldNames"`
+	FlowLogFilterFieldNames  []string         `koanf:"flowLogFilterFieldNames"`
 	UseProxy                 bool             `koanf:"useProxy"`
 }
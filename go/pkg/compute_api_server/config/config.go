@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/vnet"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/grpcutil"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -36,7 +37,16 @@ type Config struct {
 	StorageInterface               StorageInterface     `koanf:"storageInterface"`
 	ObjectStoragePrivateServerAddr string               `koanf:"objectStoragePrivateServerAddr"`
 	FleetAdminServerAddr           string               `koanf:"fleetAdminServerAddr"`
-	QuotaManagementServerAddr      string               `koanf:"quotaManagementServerAddr"`
+	// Namespace of the Kubernetes secrets that hold the object storage credentials used to import custom machine images.
+	// Images cannot be imported if this is empty.
+	CustomMachineImageSecretNamespace string `koanf:"customMachineImageSecretNamespace"`
+	QuotaManagementServerAddr         string `koanf:"quotaManagementServerAddr"`
+	// Interval of time between corrections of the quota usage in the Quota Management Service. Defaults to 10 minutes.
+	QuotaUsageReconcileInterval time.Duration `koanf:"quotaUsageReconcileInterval"`
+	// Per cloud account request rate and concurrency limits.
+	RateLimit grpcutil.RateLimitConfig `koanf:"rateLimit"`
+	// Idempotency-Key support for Create methods.
+	Idempotency grpcutil.IdempotencyConfig `koanf:"idempotency"`
 }
 
 type FeatureFlags struct {
@@ -0,0 +1,453 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package custom_machine_image
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/instance"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/pbconvert"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// The name of the image volume in the VM cluster is this prefix followed by the resource id.
+	storageNamePrefix = "cmi-"
+	// The name of the object user that is used to import an image is this prefix followed by the resource id.
+	// The Kubernetes secret that holds the secret key of the object user has the same name.
+	importObjectUserNamePrefix = "cmi-import-"
+	objectUserSecretKeyKey     = "secretKey"
+)
+
+var (
+	// A name is valid when it starts and ends with lowercase alphanumeric
+	// and contains lowercase alphanumeric and '-' characters and has at most 63 characters.
+	nameRegexp      = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?$`)
+	sha256SumRegexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+type CustomMachineImageService struct {
+	pb.UnimplementedCustomMachineImageServiceServer
+	pb.UnimplementedCustomMachineImagePrivateServiceServer
+	db                  *sql.DB
+	pbConverter         *pbconvert.PbConverter
+	sqlTransformer      *CustomMachineImageSqlTransformer
+	instanceService     *instance.InstanceService
+	machineImageService pb.MachineImageServiceServer
+	// objectStorageServicePrivateClient may be nil
+	objectStorageServicePrivateClient pb.ObjectStorageServicePrivateClient
+	// Stores the secret keys of object users so that they are not stored in the database. May be nil.
+	secrets corev1client.SecretInterface
+}
+
+func NewCustomMachineImageService(
+	db *sql.DB,
+	instanceService *instance.InstanceService,
+	machineImageService pb.MachineImageServiceServer,
+	objectStorageServicePrivateClient pb.ObjectStorageServicePrivateClient,
+	secrets corev1client.SecretInterface,
+) (*CustomMachineImageService, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	return &CustomMachineImageService{
+		db:                                db,
+		pbConverter:                       pbconvert.NewPbConverter(),
+		sqlTransformer:                    NewCustomMachineImageSqlTransformer(),
+		instanceService:                   instanceService,
+		machineImageService:               machineImageService,
+		objectStorageServicePrivateClient: objectStorageServicePrivateClient,
+		secrets:                           secrets,
+	}, nil
+}
+
+// Capture the root disk of a stopped virtual machine instance.
+// The image is stored in the VM cluster of the instance.
+// Public API.
+func (s *CustomMachineImageService) Capture(ctx context.Context, req *pb.CustomMachineImageCaptureRequest) (*pb.CustomMachineImage, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.Capture").WithValues(logkeys.CloudAccountId, req.GetMetadata().GetCloudAccountId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.CustomMachineImage, error) {
+		// Validate input.
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+		if req.Source == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing source")
+		}
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		image, err := s.newCustomMachineImage(ctx, req.Metadata, req.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		sourceInstance, err := s.instanceService.GetInstancePrivate(ctx, cloudAccountId, req.Source.InstanceResourceId, req.Source.InstanceName)
+		if err != nil {
+			return nil, err
+		}
+		if sourceInstance.Spec.InstanceTypeSpec.GetInstanceCategory() != pb.InstanceCategory_VirtualMachine {
+			return nil, status.Error(codes.InvalidArgument, "only virtual machine instances can be captured")
+		}
+		if sourceInstance.Metadata.DeletionTimestamp != nil {
+			return nil, status.Error(codes.FailedPrecondition, "instance is being deleted")
+		}
+		if sourceInstance.Status.GetPhase() != pb.InstancePhase_Stopped {
+			return nil, status.Error(codes.FailedPrecondition, "instance must be stopped before it can be captured")
+		}
+
+		image.Spec.Source = &pb.CustomMachineImageSpecPrivate_Capture{
+			Capture: &pb.CustomMachineImageCaptureSource{
+				InstanceName:       sourceInstance.Metadata.Name,
+				InstanceResourceId: sourceInstance.Metadata.ResourceId,
+			},
+		}
+		if image.Spec.UserName == "" {
+			image.Spec.UserName = sourceInstance.Spec.MachineImageSpec.GetUserName()
+		}
+		// The disk of the instance can only be cloned within its cluster.
+		image.Status.ClusterId = sourceInstance.Spec.ClusterId
+
+		if err := s.create(ctx, image); err != nil {
+			return nil, err
+		}
+
+		// Query database and return response.
+		return s.get(ctx, cloudAccountId, "resource_id", image.Metadata.ResourceId)
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, utils.SanitizeError(err)
+}
+
+// Import a qcow2 or raw image file from an object storage bucket.
+// A read-only object user is created so that the VM cluster that claims the image can download it.
+// Public API.
+func (s *CustomMachineImageService) Import(ctx context.Context, req *pb.CustomMachineImageImportRequest) (*pb.CustomMachineImage, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.Import").WithValues(logkeys.CloudAccountId, req.GetMetadata().GetCloudAccountId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.CustomMachineImage, error) {
+		// Validate input.
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+		if req.Source == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing source")
+		}
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+		if s.objectStorageServicePrivateClient == nil || s.secrets == nil {
+			return nil, status.Error(codes.FailedPrecondition, "importing machine images is not supported in this region")
+		}
+
+		source := &pb.CustomMachineImageObjectStorageSource{
+			BucketName: req.Source.BucketName,
+			ObjectKey:  req.Source.ObjectKey,
+			Format:     req.Source.Format,
+			Sha256Sum:  strings.ToLower(req.Source.Sha256Sum),
+		}
+		if err := validateObjectStorageSource(source); err != nil {
+			return nil, err
+		}
+
+		image, err := s.newCustomMachineImage(ctx, req.Metadata, req.Spec)
+		if err != nil {
+			return nil, err
+		}
+		image.Spec.Source = &pb.CustomMachineImageSpecPrivate_ObjectStorage{
+			ObjectStorage: source,
+		}
+
+		objectStorageAccess, err := s.createObjectUser(ctx, cloudAccountId, image.Metadata.ResourceId, source)
+		if err != nil {
+			return nil, err
+		}
+		image.Spec.ObjectStorageAccess = objectStorageAccess
+
+		if err := s.create(ctx, image); err != nil {
+			if err := s.deleteObjectUser(ctx, cloudAccountId, objectStorageAccess.ObjectUserName); err != nil {
+				log.Error(err, "unable to delete object user", logkeys.Name, objectStorageAccess.ObjectUserName)
+			}
+			return nil, err
+		}
+
+		// Query database and return response.
+		return s.get(ctx, cloudAccountId, "resource_id", image.Metadata.ResourceId)
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *CustomMachineImageService) Get(ctx context.Context, req *pb.CustomMachineImageGetRequest) (*pb.CustomMachineImage, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.Get").WithValues(logkeys.CloudAccountId, req.GetMetadata().GetCloudAccountId(),
+		logkeys.ResourceId, req.GetMetadata().GetResourceId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.CustomMachineImage, error) {
+		// Validate input.
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+		argName, arg, err := common.ResourceUniqueColumnAndValue(req.Metadata.GetResourceId(), req.Metadata.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		return s.get(ctx, cloudAccountId, argName, arg)
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *CustomMachineImageService) Search(ctx context.Context, req *pb.CustomMachineImageSearchRequest) (*pb.CustomMachineImageSearchResponse, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.Search").WithValues(logkeys.CloudAccountId, req.GetMetadata().GetCloudAccountId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.CustomMachineImageSearchResponse, error) {
+		// Validate input.
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		if err := utils.ValidateLabels(req.Metadata.Labels); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		flattenedObject := protodb.Flattened{
+			Columns: []string{"cloud_account_id", "deleted_timestamp"},
+			Values:  []any{cloudAccountId, common.TimestampInfinityStr},
+		}
+		for key, value := range req.Metadata.Labels {
+			column := fmt.Sprintf("value->'metadata'->'labels'->>'%s'", key)
+			flattenedObject.Add(column, value)
+		}
+
+		query := fmt.Sprintf(`
+			select %s
+			from   custom_machine_image
+			where  %s
+			order by name
+		`, s.sqlTransformer.ColumnsForFromRow(), flattenedObject.GetWhereString(1))
+
+		rows, err := s.db.QueryContext(ctx, query, flattenedObject.Values...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		resp := &pb.CustomMachineImageSearchResponse{}
+		for rows.Next() {
+			item, err := s.rowToCustomMachineImage(ctx, rows)
+			if err != nil {
+				return nil, err
+			}
+			resp.Items = append(resp.Items, item)
+		}
+		return resp, nil
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, utils.SanitizeError(err)
+}
+
+// Delete indicates a public API user's request to delete a custom machine image. It sets the deletionTimestamp of the record's "value" field.
+// The record is deleted by RemoveFinalizer after the VM cluster deletes the image volume.
+// Public API.
+func (s *CustomMachineImageService) Delete(ctx context.Context, req *pb.CustomMachineImageDeleteRequest) (*emptypb.Empty, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.Delete").WithValues(logkeys.CloudAccountId, req.GetMetadata().GetCloudAccountId(),
+		logkeys.ResourceId, req.GetMetadata().GetResourceId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*emptypb.Empty, error) {
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+		updateFunc := func(image *pb.CustomMachineImagePrivate) error {
+			if image.Metadata.DeletionTimestamp == nil {
+				image.Metadata.DeletionTimestamp = timestamppb.Now()
+			}
+			return nil
+		}
+		if err := s.update(ctx, cloudAccountId, req.Metadata.GetResourceId(), req.Metadata.GetName(), "", updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, utils.SanitizeError(err)
+}
+
+// GetMachineImage returns a custom machine image as a MachineImage that can be used to launch instances,
+// along with the VM cluster that stores it.
+// Implements instance.CustomMachineImageGetter.
+func (s *CustomMachineImageService) GetMachineImage(ctx context.Context, cloudAccountId string, name string) (*pb.MachineImage, string, error) {
+	log := log.FromContext(ctx).WithName("CustomMachineImageService.GetMachineImage")
+	rows, err := s.selectCustomMachineImage(ctx, cloudAccountId, "name", name)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	image, err := s.sqlTransformer.FromRow(ctx, rows)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "GetMachineImage: %v", err)
+	}
+	if image.Metadata.DeletionTimestamp != nil {
+		return nil, "", status.Errorf(codes.FailedPrecondition, "machine image %q is being deleted", name)
+	}
+	if image.Status.Phase != pb.CustomMachineImagePhase_CustomMachineImageReady {
+		return nil, "", status.Errorf(codes.FailedPrecondition, "machine image %q is not ready", name)
+	}
+	machineImage := &pb.MachineImage{
+		Metadata: &pb.MachineImage_Metadata{
+			Name: image.Metadata.Name,
+		},
+		Spec: &pb.MachineImageSpec{
+			DisplayName:        image.Spec.DisplayName,
+			Description:        image.Spec.Description,
+			UserName:           image.Spec.UserName,
+			InstanceCategories: []pb.InstanceCategory{pb.InstanceCategory_VirtualMachine},
+			InstanceTypes:      image.Spec.InstanceTypes,
+			Sha256Sum:          image.Status.Sha256Sum,
+			VirtualSizeBytes:   image.Status.VirtualSizeBytes,
+			StorageName:        image.Spec.StorageName,
+		},
+	}
+	log.Info("Custom machine image", logkeys.MachineImage, machineImage, logkeys.ClusterId, image.Status.ClusterId)
+	return machineImage, image.Status.ClusterId, nil
+}
+
+// Returns a new custom machine image with validated metadata and spec.
+// The caller must set the source.
+func (s *CustomMachineImageService) newCustomMachineImage(ctx context.Context, metadata *pb.ResourceMetadataCreate, spec *pb.CustomMachineImageSpecCreate) (*pb.CustomMachineImagePrivate, error) {
+	resourceId, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	name := metadata.Name
+	if name == "" {
+		name = resourceId.String()
+	}
+	if !nameRegexp.MatchString(name) {
+		return nil, status.Error(codes.InvalidArgument, "invalid machine image name")
+	}
+	if err := utils.ValidateLabels(metadata.Labels); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// A global machine image takes precedence over a custom machine image with the same name.
+	_, err = s.machineImageService.Get(ctx, &pb.MachineImageGetRequest{
+		Metadata: &pb.MachineImageGetRequest_Metadata{
+			Name: name,
+		},
+	})
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "machine image %s already exists", name)
+	}
+	if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	return &pb.CustomMachineImagePrivate{
+		Metadata: &pb.CustomMachineImageMetadataPrivate{
+			CloudAccountId:    metadata.CloudAccountId,
+			Name:              name,
+			ResourceId:        resourceId.String(),
+			Labels:            metadata.Labels,
+			CreationTimestamp: timestamppb.Now(),
+		},
+		Spec: &pb.CustomMachineImageSpecPrivate{
+			DisplayName:   spec.GetDisplayName(),
+			Description:   spec.GetDescription(),
+			UserName:      spec.GetUserName(),
+			InstanceTypes: spec.GetInstanceTypes(),
+			StorageName:   storageNamePrefix + resourceId.String(),
+		},
+		Status: &pb.CustomMachineImageStatusPrivate{
+			Phase: pb.CustomMachineImagePhase_CustomMachineImagePending,
+		},
+	}, nil
+}
+
+func validateObjectStorageSource(source *pb.CustomMachineImageObjectStorageSource) error {
+	if source.BucketName == "" {
+		return status.Error(codes.InvalidArgument, "missing source.bucketName")
+	}
+	if source.ObjectKey == "" {
+		return status.Error(codes.InvalidArgument, "missing source.objectKey")
+	}
+	if _, ok := pb.CustomMachineImageFormat_name[int32(source.Format)]; !ok {
+		return status.Error(codes.InvalidArgument, "invalid source.format")
+	}
+	if !sha256SumRegexp.MatchString(source.Sha256Sum) {
+		return status.Error(codes.InvalidArgument, "source.sha256sum must be a hex-encoded SHA256 checksum")
+	}
+	return nil
+}
+
+func (s *CustomMachineImageService) PingPrivate(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("CustomMachineImageService.PingPrivate")
+	log.Info("Ping")
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,463 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package custom_machine_image
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// SearchPrivate returns custom machine images that are assigned to the requested cluster or that are not assigned to any cluster.
+// Images that are being deleted are included so that the cluster can delete the image volume.
+// The response includes object storage credentials so it is not logged.
+// Private API.
+func (s *CustomMachineImageService) SearchPrivate(ctx context.Context, req *pb.CustomMachineImageSearchPrivateRequest) (*pb.CustomMachineImageSearchPrivateResponse, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.SearchPrivate").WithValues(logkeys.ClusterId, req.ClusterId).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.CustomMachineImageSearchPrivateResponse, error) {
+		if req.ClusterId == "" {
+			return nil, status.Error(codes.InvalidArgument, "missing clusterId")
+		}
+		query := fmt.Sprintf(`
+			select %s
+			from   custom_machine_image
+			where  deleted_timestamp = $1
+			  and  value->'status'->>'clusterId' in ($2, '')
+			order by resource_version
+		`, s.sqlTransformer.ColumnsForFromRow())
+		rows, err := s.db.QueryContext(ctx, query, common.TimestampInfinityStr, req.ClusterId)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		resp := &pb.CustomMachineImageSearchPrivateResponse{}
+		for rows.Next() {
+			item, err := s.sqlTransformer.FromRow(ctx, rows)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.readObjectUserSecretKey(ctx, item.Spec.ObjectStorageAccess); err != nil {
+				return nil, err
+			}
+			resp.Items = append(resp.Items, item)
+		}
+		return resp, nil
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.ResourceCount, len(resp.Items))
+	}
+	return resp, err
+}
+
+// UpdateStatus is used by a VM cluster to claim a custom machine image and to report progress.
+// A cluster claims an image by setting status.clusterId, using the resourceVersion returned by SearchPrivate.
+// When the image becomes ready or fails, the object user that was created for the import is deleted.
+// Private API.
+func (s *CustomMachineImageService) UpdateStatus(ctx context.Context, req *pb.CustomMachineImageUpdateStatusRequest) (*emptypb.Empty, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.UpdateStatus").WithValues(logkeys.CloudAccountId, req.GetMetadata().GetCloudAccountId(),
+		logkeys.ResourceId, req.GetMetadata().GetResourceId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*emptypb.Empty, error) {
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+		if req.Status == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing status")
+		}
+		if req.Status.ClusterId == "" {
+			return nil, status.Error(codes.InvalidArgument, "missing status.clusterId")
+		}
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		objectUserName := ""
+		updateFunc := func(image *pb.CustomMachineImagePrivate) error {
+			if image.Status.ClusterId != "" && image.Status.ClusterId != req.Status.ClusterId {
+				return status.Errorf(codes.FailedPrecondition, "custom machine image is assigned to cluster %s", image.Status.ClusterId)
+			}
+			image.Status = req.Status
+			objectUserName = ""
+			if isTerminalPhase(image.Status.Phase) && image.Spec.ObjectStorageAccess != nil {
+				// The credentials are no longer needed. The object user name is kept until the object user is deleted.
+				objectUserName = image.Spec.ObjectStorageAccess.ObjectUserName
+				image.Spec.ObjectStorageAccess = &pb.CustomMachineImageObjectStorageAccess{
+					ObjectUserName: objectUserName,
+				}
+			}
+			return nil
+		}
+		if err := s.update(ctx, cloudAccountId, req.Metadata.ResourceId, "", req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		if objectUserName != "" {
+			// If this fails, the object user will be deleted by RemoveFinalizer.
+			if err := s.deleteObjectUser(ctx, cloudAccountId, objectUserName); err != nil {
+				log.Error(err, "unable to delete object user", logkeys.Name, objectUserName)
+			}
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, err
+}
+
+// RemoveFinalizer marks a record as hidden from users and controllers. It sets the record's deleted_timestamp.
+// It must be called by the VM cluster after it deletes the image volume.
+// Private API.
+func (s *CustomMachineImageService) RemoveFinalizer(ctx context.Context, req *pb.CustomMachineImageRemoveFinalizerRequest) (*emptypb.Empty, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.RemoveFinalizer").WithValues(logkeys.CloudAccountId, req.GetMetadata().GetCloudAccountId(),
+		logkeys.ResourceId, req.GetMetadata().GetResourceId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*emptypb.Empty, error) {
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+		image, err := s.getPrivate(ctx, req.Metadata.CloudAccountId, "resource_id", req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		if image.Metadata.DeletionTimestamp == nil {
+			return nil, status.Error(codes.FailedPrecondition, "custom machine image has not been deleted")
+		}
+		if objectUserName := image.Spec.ObjectStorageAccess.GetObjectUserName(); objectUserName != "" {
+			if err := s.deleteObjectUser(ctx, image.Metadata.CloudAccountId, objectUserName); err != nil {
+				return nil, err
+			}
+		}
+		query := `
+			update custom_machine_image
+			set    deleted_timestamp = current_timestamp,
+			       resource_version = nextval('custom_machine_image_resource_version_seq')
+			where  cloud_account_id = $1
+			  and  resource_id = $2
+			  and  deleted_timestamp = $3
+		`
+		if _, err := s.db.ExecContext(ctx, query, req.Metadata.CloudAccountId, req.Metadata.ResourceId, common.TimestampInfinityStr); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, err
+}
+
+func isTerminalPhase(phase pb.CustomMachineImagePhase) bool {
+	return phase == pb.CustomMachineImagePhase_CustomMachineImageReady || phase == pb.CustomMachineImagePhase_CustomMachineImageFailed
+}
+
+func (s *CustomMachineImageService) create(ctx context.Context, image *pb.CustomMachineImagePrivate) error {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("CustomMachineImageService.create").WithValues(logkeys.CloudAccountId, image.Metadata.CloudAccountId,
+		logkeys.ResourceId, image.Metadata.ResourceId).Start()
+	defer span.End()
+	log.Info("Creating custom machine image", logkeys.Name, image.Metadata.Name)
+
+	flattened, err := s.sqlTransformer.Flatten(ctx, image)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`insert into custom_machine_image (resource_id, cloud_account_id, name, %s) values ($1, $2, $3, %s)`,
+		flattened.GetColumnsString(), flattened.GetInsertValuesString(4))
+	args := append([]any{image.Metadata.ResourceId, image.Metadata.CloudAccountId, image.Metadata.Name}, flattened.Values...)
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation {
+			return status.Errorf(codes.AlreadyExists, "machine image %s already exists", image.Metadata.Name)
+		}
+		return status.Errorf(codes.Internal, "insert: %v", err)
+	}
+	return nil
+}
+
+func (s *CustomMachineImageService) get(ctx context.Context, cloudAccountId string, argName string, arg string) (*pb.CustomMachineImage, error) {
+	image, err := s.getPrivate(ctx, cloudAccountId, argName, arg)
+	if err != nil {
+		return nil, err
+	}
+	return s.toCustomMachineImage(image)
+}
+
+func (s *CustomMachineImageService) getPrivate(ctx context.Context, cloudAccountId string, argName string, arg string) (*pb.CustomMachineImagePrivate, error) {
+	if argName == "resource_id" {
+		// Validate the resource id is a valid guid
+		if _, err := uuid.Parse(arg); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid resourceId")
+		}
+	}
+	rows, err := s.selectCustomMachineImage(ctx, cloudAccountId, argName, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	image, err := s.sqlTransformer.FromRow(ctx, rows)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getPrivate: %v", err)
+	}
+	return image, nil
+}
+
+// Update a custom machine image record using the provided updateFunc.
+// This uses optimistic concurrency control to ensure that the record has not been updated between the select and update.
+// Additionally, if the caller provides a resource version, optimistic concurrency control can be extended to
+// previous get or search calls.
+func (s *CustomMachineImageService) update(
+	ctx context.Context,
+	cloudAccountId string,
+	resourceId string,
+	name string,
+	resourceVersion string,
+	updateFunc func(*pb.CustomMachineImagePrivate) error) error {
+	argName, arg, err := common.ResourceUniqueColumnAndValue(resourceId, name)
+	if err != nil {
+		return err
+	}
+	if argName == "resource_id" {
+		// Validate the resource id is a valid guid
+		if _, err := uuid.Parse(resourceId); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid resourceId")
+		}
+	}
+
+	// Retry on conflict if caller did not provide resourceVersion.
+	isRetryable := func(err error) bool {
+		return resourceVersion == "" && status.Code(err) == codes.FailedPrecondition
+	}
+
+	err = retry.OnError(retry.DefaultRetry, isRetryable, func() error {
+		image, err := s.getPrivate(ctx, cloudAccountId, argName, arg)
+		if err != nil {
+			return err
+		}
+		metadata := image.Metadata
+		// If resource version was provided, ensure that stored version matches.
+		if resourceVersion != "" && resourceVersion != metadata.ResourceVersion {
+			return status.Error(codes.FailedPrecondition, "stored resource version does not match requested resource version")
+		}
+
+		if err := updateFunc(image); err != nil {
+			return err
+		}
+
+		flattened, err := s.sqlTransformer.Flatten(ctx, image)
+		if err != nil {
+			return err
+		}
+		args := append([]any{metadata.CloudAccountId, metadata.ResourceId, metadata.ResourceVersion}, flattened.Values...)
+		updateQuery := fmt.Sprintf(`
+			update custom_machine_image
+			set    resource_version = nextval('custom_machine_image_resource_version_seq'),
+			       %s
+			where  cloud_account_id = $1
+			  and  resource_id = $2
+			  and  resource_version = $3
+		`, flattened.GetUpdateSetString(4))
+		sqlResult, err := s.db.ExecContext(ctx, updateQuery, args...)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected < 1 {
+			return status.Error(codes.FailedPrecondition, "no records updated; possible update conflict")
+		}
+		return nil
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return status.Error(st.Code(), "update: "+st.Message())
+	}
+	return nil
+}
+
+// Caller must close the returned sql.Rows.
+func (s *CustomMachineImageService) selectCustomMachineImage(ctx context.Context, cloudAccountId string, argName string, arg string) (*sql.Rows, error) {
+	query := fmt.Sprintf(`
+		select %s
+		from   custom_machine_image
+		where  cloud_account_id = $1
+		  and  %s = $2
+		  and  deleted_timestamp = $3
+	`, s.sqlTransformer.ColumnsForFromRow(), argName)
+	rows, err := s.db.QueryContext(ctx, query, cloudAccountId, arg, common.TimestampInfinityStr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "selectCustomMachineImage: %v", err)
+	}
+	if !rows.Next() {
+		defer rows.Close()
+		return nil, status.Error(codes.NotFound, "resource not found")
+	}
+	return rows, nil
+}
+
+// Read a database row into a public CustomMachineImage. Used for public APIs.
+func (s *CustomMachineImageService) rowToCustomMachineImage(ctx context.Context, rows *sql.Rows) (*pb.CustomMachineImage, error) {
+	image, err := s.sqlTransformer.FromRow(ctx, rows)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "rowToCustomMachineImage: %v", err)
+	}
+	return s.toCustomMachineImage(image)
+}
+
+// Convert a CustomMachineImagePrivate to a public CustomMachineImage.
+// Spec and status fields have the same field numbers. Private fields are discarded.
+func (s *CustomMachineImageService) toCustomMachineImage(image *pb.CustomMachineImagePrivate) (*pb.CustomMachineImage, error) {
+	spec := &pb.CustomMachineImageSpec{}
+	if err := s.pbConverter.Transcode(image.Spec, spec); err != nil {
+		return nil, status.Errorf(codes.Internal, "toCustomMachineImage: %v", err)
+	}
+	imageStatus := &pb.CustomMachineImageStatus{}
+	if err := s.pbConverter.Transcode(image.Status, imageStatus); err != nil {
+		return nil, status.Errorf(codes.Internal, "toCustomMachineImage: %v", err)
+	}
+	if image.Metadata.DeletionTimestamp != nil {
+		imageStatus.Phase = pb.CustomMachineImagePhase_CustomMachineImageDeleting
+	}
+	return &pb.CustomMachineImage{
+		Metadata: &pb.ResourceMetadata{
+			CloudAccountId:    image.Metadata.CloudAccountId,
+			Name:              image.Metadata.Name,
+			ResourceId:        image.Metadata.ResourceId,
+			Labels:            image.Metadata.Labels,
+			CreationTimestamp: image.Metadata.CreationTimestamp,
+		},
+		Spec:   spec,
+		Status: imageStatus,
+	}, nil
+}
+
+// Create an object user that can read the image file.
+func (s *CustomMachineImageService) createObjectUser(
+	ctx context.Context,
+	cloudAccountId string,
+	resourceId string,
+	source *pb.CustomMachineImageObjectStorageSource,
+) (*pb.CustomMachineImageObjectStorageAccess, error) {
+	log := log.FromContext(ctx).WithName("CustomMachineImageService.createObjectUser")
+	objectUserName := importObjectUserNamePrefix + resourceId
+	objectUser, err := s.objectStorageServicePrivateClient.CreateObjectUserPrivate(ctx, &pb.CreateObjectUserPrivateRequest{
+		Metadata: &pb.ObjectUserMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           objectUserName,
+		},
+		Spec: []*pb.ObjectUserPermissionSpec{
+			{
+				BucketId:   source.BucketName,
+				Prefix:     source.ObjectKey,
+				Permission: []pb.BucketPermission{pb.BucketPermission_ReadBucket},
+			},
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.InvalidArgument, "bucket %s not found", source.BucketName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	principal := objectUser.GetStatus().GetPrincipal()
+	if principal.GetCluster().GetAccessEndpoint() == "" || principal.GetCredentials().GetAccessKey() == "" {
+		if err := s.deleteObjectUser(ctx, cloudAccountId, objectUserName); err != nil {
+			log.Error(err, "unable to delete object user", logkeys.Name, objectUserName)
+		}
+		return nil, status.Error(codes.Internal, "object user was created without credentials")
+	}
+	log.Info("Created object user", logkeys.Name, objectUserName, logkeys.BucketName, source.BucketName)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: objectUserName,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			objectUserSecretKeyKey: []byte(principal.Credentials.SecretKey),
+		},
+	}
+	if _, err := s.secrets.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		if err := s.deleteObjectUser(ctx, cloudAccountId, objectUserName); err != nil {
+			log.Error(err, "unable to delete object user", logkeys.Name, objectUserName)
+		}
+		return nil, status.Errorf(codes.Internal, "unable to store object user secret key: %v", err)
+	}
+	// The secret key is read from the Kubernetes secret by SearchPrivate.
+	return &pb.CustomMachineImageObjectStorageAccess{
+		Endpoint:       principal.Cluster.AccessEndpoint,
+		AccessKey:      principal.Credentials.AccessKey,
+		ObjectUserName: objectUserName,
+	}, nil
+}
+
+// Set the secret key of the object user from its Kubernetes secret.
+// The secret key is left empty if the secret does not exist.
+func (s *CustomMachineImageService) readObjectUserSecretKey(ctx context.Context, access *pb.CustomMachineImageObjectStorageAccess) error {
+	if access.GetAccessKey() == "" || s.secrets == nil {
+		return nil
+	}
+	secret, err := s.secrets.Get(ctx, access.ObjectUserName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to read object user secret key: %v", err)
+	}
+	access.SecretKey = string(secret.Data[objectUserSecretKeyKey])
+	return nil
+}
+
+// Delete an object user and the secret that holds its secret key. It is not an error if the object user does not exist.
+func (s *CustomMachineImageService) deleteObjectUser(ctx context.Context, cloudAccountId string, objectUserName string) error {
+	if s.objectStorageServicePrivateClient == nil {
+		return status.Error(codes.FailedPrecondition, "object storage service is not configured")
+	}
+	if s.secrets != nil {
+		if err := s.secrets.Delete(ctx, objectUserName, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	_, err := s.objectStorageServicePrivateClient.DeleteObjectUserPrivate(ctx, &pb.ObjectUserDeletePrivateRequest{
+		Metadata: &pb.ObjectUserMetadataRef{
+			CloudAccountId: cloudAccountId,
+			NameOrId: &pb.ObjectUserMetadataRef_UserName{
+				UserName: objectUserName,
+			},
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
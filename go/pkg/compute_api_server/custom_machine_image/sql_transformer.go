@@ -0,0 +1,81 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package custom_machine_image
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Transforms a CustomMachineImagePrivate to a form that can be written to a SQL database.
+// Also performs the inverse, reading from sql.Rows and creating a CustomMachineImagePrivate.
+// This uses the JSON serializer from the GRPC Gateway.
+type CustomMachineImageSqlTransformer struct {
+	marshaler *runtime.JSONPb
+}
+
+func NewCustomMachineImageSqlTransformer() *CustomMachineImageSqlTransformer {
+	return &CustomMachineImageSqlTransformer{
+		marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				// When writing JSON, emit fields that have default values, including for enums.
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				// When reading JSON, ignore fields with unknown names.
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+// Returns a Flattened object that can be used to construct a SQL INSERT or UPDATE statement.
+// The Flattened object omits columns that are never updated, such as the primary key columns.
+func (s *CustomMachineImageSqlTransformer) Flatten(ctx context.Context, image *pb.CustomMachineImagePrivate) (*protodb.Flattened, error) {
+	flattened := &protodb.Flattened{}
+	jsonImage, err := s.marshaler.Marshal(image)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to json: %w", err)
+	}
+	flattened.Add("value", jsonImage)
+	return flattened, nil
+}
+
+// Read a database row into a CustomMachineImagePrivate.
+func (s *CustomMachineImageSqlTransformer) FromRow(ctx context.Context, rows *sql.Rows) (*pb.CustomMachineImagePrivate, error) {
+	log := log.FromContext(ctx).WithName("CustomMachineImageSqlTransformer.FromRow")
+	metadata := &pb.CustomMachineImageMetadataPrivate{}
+	var deletedTimestamp string
+	var resourceJson []byte
+	if err := rows.Scan(&metadata.CloudAccountId, &metadata.ResourceId, &metadata.Name, &deletedTimestamp, &metadata.ResourceVersion, &resourceJson); err != nil {
+		return nil, fmt.Errorf("FromRow: Scan: %w", err)
+	}
+	log.V(9).Info("scanned", logkeys.ResourceId, metadata.ResourceId, logkeys.ResourceJson, string(resourceJson))
+	image := &pb.CustomMachineImagePrivate{}
+	if err := s.marshaler.Unmarshal(resourceJson, &image); err != nil {
+		return nil, err
+	}
+
+	// Copy fields directly in the row to the image.
+	image.Metadata.CloudAccountId = metadata.CloudAccountId
+	image.Metadata.ResourceId = metadata.ResourceId
+	image.Metadata.Name = metadata.Name
+	image.Metadata.ResourceVersion = metadata.ResourceVersion
+
+	return image, nil
+}
+
+// When using FromRow, the SQL SELECT query must select these columns.
+func (s *CustomMachineImageSqlTransformer) ColumnsForFromRow() string {
+	cols := []string{"cloud_account_id", "resource_id", "name", "deleted_timestamp", "resource_version", "value"}
+	return strings.Join(cols, ", ")
+}
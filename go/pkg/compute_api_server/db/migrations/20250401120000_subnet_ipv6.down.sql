@@ -0,0 +1,4 @@
+drop index subnet_ipv6_subnet_idx;
+alter table subnet drop column ipv6_gateway;
+alter table subnet drop column ipv6_prefix_length;
+alter table subnet drop column ipv6_subnet;
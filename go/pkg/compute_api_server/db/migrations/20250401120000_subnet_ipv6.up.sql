@@ -0,0 +1,6 @@
+-- IPv6 prefix of a dual-stack subnet such as "fd00:0:0:11::/64". Null for IPv4-only subnets.
+alter table subnet add column ipv6_subnet cidr null;
+alter table subnet add column ipv6_prefix_length smallint null;
+alter table subnet add column ipv6_gateway inet null;
+
+create unique index subnet_ipv6_subnet_idx on subnet (region, availability_zone, address_space, ipv6_subnet);
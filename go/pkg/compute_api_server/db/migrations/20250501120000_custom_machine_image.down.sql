@@ -0,0 +1,2 @@
+drop table if exists custom_machine_image;
+drop sequence if exists custom_machine_image_resource_version_seq;
@@ -0,0 +1,21 @@
+--------------------------------------------------------------------------------
+-- custom machine image
+--------------------------------------------------------------------------------
+
+create sequence if not exists custom_machine_image_resource_version_seq minvalue 1;
+
+create table if not exists custom_machine_image (
+    resource_id uuid primary key,
+    cloud_account_id varchar(12) not null,
+    -- will have same value as resourceId if not specified by user
+    name varchar(63) not null,
+    -- infinity means not deleted; set to 'now' when logically deleted
+    deleted_timestamp timestamp not null default ('infinity'),
+    -- provides the ordering of inserts and updates
+    resource_version bigint not null default nextval('custom_machine_image_resource_version_seq'),
+    -- Protobuf CustomMachineImagePrivate message serialized as JSON.
+    value jsonb not null
+);
+
+-- Unique index prevents a non-deleted custom machine image with the same name in the same cloud_account_id.
+create unique index custom_machine_image_idx on custom_machine_image (cloud_account_id, name, deleted_timestamp);
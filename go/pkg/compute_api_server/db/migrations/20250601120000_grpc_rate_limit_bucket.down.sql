@@ -0,0 +1 @@
+drop table if exists grpc_rate_limit_bucket;
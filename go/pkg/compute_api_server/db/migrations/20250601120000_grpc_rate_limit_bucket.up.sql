@@ -0,0 +1,9 @@
+--------------------------------------------------------------------------------
+-- token buckets of the shared grpc rate limit store
+--------------------------------------------------------------------------------
+
+create table if not exists grpc_rate_limit_bucket (
+    key text primary key,
+    tokens double precision not null,
+    updated_timestamp timestamp with time zone not null
+);
@@ -0,0 +1 @@
+drop table if exists grpc_idempotency_key;
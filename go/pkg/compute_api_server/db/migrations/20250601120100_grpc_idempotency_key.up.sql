@@ -0,0 +1,17 @@
+--------------------------------------------------------------------------------
+-- records of the shared grpc idempotency store
+--------------------------------------------------------------------------------
+
+create table if not exists grpc_idempotency_key (
+    cloud_account_id text not null,
+    idempotency_key text not null,
+    method text not null,
+    request_hash bytea not null,
+    completed boolean not null,
+    response_type text not null,
+    response bytea,
+    expiration_timestamp timestamp with time zone not null,
+    primary key (cloud_account_id, idempotency_key)
+);
+
+create index if not exists grpc_idempotency_key_expiration_timestamp_idx on grpc_idempotency_key (expiration_timestamp);
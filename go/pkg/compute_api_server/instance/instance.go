@@ -26,8 +26,10 @@ import (
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 )
@@ -40,6 +42,8 @@ const (
 	acceleratorClusterNetworkPrefixLength = 22
 	defaultUserData                       = "OMITTED"
 	ResourceExhaustedMessage              = "we are currently experiencing high demand for this instance type. Please try again later."
+	// quotaServiceName is the name of the compute service in the Quota Management Service.
+	quotaServiceName = "compute"
 )
 
 type InstanceService struct {
@@ -58,6 +62,15 @@ type InstanceService struct {
 	// fleetAdminServiceClient may be nil
 	fleetAdminServiceClient pb.FleetAdminServiceClient
 	qmsClient               pb.QuotaManagementPrivateServiceClient
+	// customMachineImageGetter may be nil
+	customMachineImageGetter CustomMachineImageGetter
+}
+
+// CustomMachineImageGetter returns machine images that are owned by a cloud account.
+type CustomMachineImageGetter interface {
+	// Returns a machine image that can be used to launch instances, and the VM cluster that stores it.
+	// Returns NotFound if the cloud account does not have a custom machine image with this name.
+	GetMachineImage(ctx context.Context, cloudAccountId string, name string) (*pb.MachineImage, string, error)
 }
 
 func NewInstanceService(
@@ -92,6 +105,12 @@ func NewInstanceService(
 	}, nil
 }
 
+// SetCustomMachineImageGetter allows instances to be launched from custom machine images.
+// This is not a constructor parameter because the custom machine image service depends on this service.
+func (s *InstanceService) SetCustomMachineImageGetter(customMachineImageGetter CustomMachineImageGetter) {
+	s.customMachineImageGetter = customMachineImageGetter
+}
+
 // Validate instanceName.
 // instanceName is valid when name is starting and ending with lowercase alphanumeric
 // and contains lowercase alphanumeric, '-' characters and should have at most 63 characters
@@ -119,91 +138,89 @@ func validateTopologySpreadConstraints(constraints []*pb.TopologySpreadConstrain
 }
 
 // This function verifies that the combined total of existing and requested instances does not surpass the quota limit for the specified instance type
+// When the Quota Management Service is used, the requested instances are reserved there, atomically across replicas,
+// and the returned reservation must be committed once the instances are created, or released otherwise.
 //
 // Parameters:
 // - ctx: A context.Context instance for carrying deadlines, cancellation signals, and other request-scoped values.
 // - cloudAccountId: A string representing the unique identifier of the user.
 // - inputInstance: A pointer to an InstancePrivate object representing the details of the instance being requested.
 // - requestedInstanceCount: An integer representing the number of new instances being requested of inputInstance type.
-func (s *InstanceService) validateQuotaLimits(ctx context.Context, cloudAccountId string, instanceType string, requestedInstanceCount int) error {
+func (s *InstanceService) validateQuotaLimits(ctx context.Context, cloudAccountId string, instanceType string, requestedInstanceCount int) (string, error) {
 	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("InstanceService.validateQuotaLimits").WithValues(logkeys.CloudAccountId, cloudAccountId).Start()
 	defer span.End()
 	log.Info("validateQuotaLimits", logkeys.CloudAccountId, cloudAccountId, logkeys.InstanceType, instanceType, logkeys.RequestedInstanceCount, requestedInstanceCount)
 	if requestedInstanceCount == 0 {
-		return nil
+		return "", nil
 	}
-	instances, err := s.Search(ctx, &pb.InstanceSearchRequest{
-		Metadata: &pb.InstanceMetadataSearch{
-			CloudAccountId:      cloudAccountId,
-			InstanceGroupFilter: pb.SearchFilterCriteria_Any,
-		},
+	instances, err := protodb.SearchAllPages(func(pageToken string) ([]*pb.Instance, string, error) {
+		resp, err := s.Search(ctx, &pb.InstanceSearchRequest{
+			Metadata: &pb.InstanceMetadataSearch{
+				CloudAccountId:      cloudAccountId,
+				InstanceGroupFilter: pb.SearchFilterCriteria_Any,
+			},
+			PageSize:  protodb.MaxPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
 	})
 	if err != nil {
 		log.Error(err, "error in search call")
-		return status.Error(codes.Internal, "error in quota processing")
+		return "", status.Error(codes.Internal, "error in quota processing")
 	}
 
 	currentInstanceCount := 0
-	for _, instance := range instances.Items {
+	for _, instance := range instances {
 		if instance.Spec.InstanceType == instanceType {
 			currentInstanceCount++
 		}
 	}
 	log.Info("validateQuotaLimits", logkeys.InstanceType, instanceType, logkeys.CurrentInstanceCount, currentInstanceCount)
 
-	allowedQuota := 0
 	if s.qmsClient != nil {
-		log.Info("Fetching quota limit from QMS service", "ResourceType", instanceType)
-		req := &pb.ServiceQuotaResourceRequestPrivate{
-			ServiceName:    "compute",
+		log.Info("Reserving quota from QMS service", "ResourceType", instanceType)
+		req := &pb.QuotaReservationRequestPrivate{
+			ServiceName:    quotaServiceName,
 			ResourceType:   instanceType,
 			CloudAccountId: cloudAccountId,
+			Quantity:       int64(requestedInstanceCount),
+			// Initializes the usage ledger of QMS the first time this instance type is reserved.
+			CurrentUsage: int64(currentInstanceCount),
+		}
+		resp, err := s.qmsClient.ReserveQuotaPrivate(ctx, req)
+		if status.Code(err) == codes.ResourceExhausted {
+			return "", status.Errorf(codes.OutOfRange, "Your account has reached the maximum allowed limit for the %s instance you requested", instanceType)
 		}
-		resp, err := s.qmsClient.GetResourceQuotaPrivate(ctx, req)
 		if err != nil {
 			log.Error(err, "error in quota processing")
-			return status.Errorf(codes.Internal, "error in quota processing")
-		}
-		if resp == nil {
-			log.Info("Received an empty response from the QMS service")
-			return status.Errorf(codes.Internal, "error in quota processing")
-		}
-
-		log.Info("Received ResourceQuota Response", "ServiceQuotasPrivate", resp)
-
-		var quotaResources []*pb.ServiceQuotaResource
-		if resp.CustomQuota != nil && resp.CustomQuota.ServiceResources != nil && len(resp.CustomQuota.ServiceResources) > 0 {
-			quotaResources = resp.CustomQuota.ServiceResources
-		} else if resp.DefaultQuota != nil && resp.DefaultQuota.ServiceResources != nil && len(resp.DefaultQuota.ServiceResources) > 0 {
-			quotaResources = resp.DefaultQuota.ServiceResources
+			return "", status.Errorf(codes.Internal, "error in quota processing")
 		}
+		log.Info("Received QuotaReservation Response", "QuotaReservationPrivate", resp)
+		return resp.ReservationId, nil
+	}
 
-		if len(quotaResources) > 0 && quotaResources[0] != nil && quotaResources[0].QuotaConfig != nil {
-			allowedQuota = int(quotaResources[0].QuotaConfig.Limits)
-		} else {
-			log.Info("Received an empty QuotaConfig limit from the QMS service")
-		}
-	} else {
-		log.Info("Fetching quota limit using legacy approach")
-		cAccount, err := s.cloudAccountServiceClient.GetById(ctx, &pb.CloudAccountId{Id: cloudAccountId})
-		if err != nil {
-			log.Error(err, "Error retrieving cloud account details")
-			return status.Error(codes.Internal, "error in quota processing")
-		}
+	log.Info("Fetching quota limit using legacy approach")
+	cAccount, err := s.cloudAccountServiceClient.GetById(ctx, &pb.CloudAccountId{Id: cloudAccountId})
+	if err != nil {
+		log.Error(err, "Error retrieving cloud account details")
+		return "", status.Error(codes.Internal, "error in quota processing")
+	}
 
-		accountType := GetAccountType(cAccount.GetType())
-		log.Info("Cloud account details", logkeys.CloudAccount, cAccount, logkeys.CloudAccountType, accountType)
+	accountType := GetAccountType(cAccount.GetType())
+	log.Info("Cloud account details", logkeys.CloudAccount, cAccount, logkeys.CloudAccountType, accountType)
 
-		allowedQuota = s.cfg.CloudAccountQuota.CloudAccounts[accountType].InstanceQuota[instanceType]
-	}
+	allowedQuota := s.cfg.CloudAccountQuota.CloudAccounts[accountType].InstanceQuota[instanceType]
 
 	log.Info("Quota validation", logkeys.AllowedQuota, allowedQuota, logkeys.CurrentInstanceCount, currentInstanceCount, logkeys.RequestedInstanceCount, requestedInstanceCount)
 
 	if allowedQuota < (currentInstanceCount + requestedInstanceCount) {
-		return status.Errorf(codes.OutOfRange, "Your account has reached the maximum allowed limit for the %s instance you requested", instanceType)
+		return "", status.Errorf(codes.OutOfRange, "Your account has reached the maximum allowed limit for the %s instance you requested", instanceType)
 	}
 
-	return nil
+	return "", nil
 
 }
 
@@ -413,17 +430,109 @@ func (s *InstanceService) CreatePrivate(ctx context.Context, req *pb.InstanceCre
 	return resp, err
 }
 
-func (s *InstanceService) checkQuota(ctx context.Context, cloudAccountId string, instances []*pb.InstancePrivate) error {
+// checkQuota returns the ids of the quota reservations made for the instances in the Quota Management Service, if it is used.
+func (s *InstanceService) checkQuota(ctx context.Context, cloudAccountId string, instances []*pb.InstancePrivate) ([]string, error) {
 	//This contains a list of instances (with no SkipQuotaCheck) requested for an InstanceType.
 	requestedInstanceCountMap := getInstanceCountMap(instances)
+	reservationIds := []string{}
 	//Check if cloudAccount can create instances for all the requested instanceTypes.
 	for instType, requestedCount := range requestedInstanceCountMap {
-		if err := s.validateQuotaLimits(ctx, cloudAccountId, instType, requestedCount); err != nil {
+		reservationId, err := s.validateQuotaLimits(ctx, cloudAccountId, instType, requestedCount)
+		if err != nil {
 			// return quota error and fail fast.
+			s.releaseQuotaReservations(ctx, reservationIds)
+			return nil, err
+		}
+		if reservationId != "" {
+			reservationIds = append(reservationIds, reservationId)
+		}
+	}
+	return reservationIds, nil
+}
+
+// quotaRetryBackoff retries the calls to the Quota Management Service that keep the usage of the cloud account in
+// line with the instances.
+var quotaRetryBackoff = wait.Backoff{
+	Steps:    6,
+	Duration: 50 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+func isRetryableQuotaError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// commitQuotaReservations adds the reserved quota to the usage of the cloud account.
+// It returns the reservations committed before an error, which must be released if the instances are not created.
+func (s *InstanceService) commitQuotaReservations(ctx context.Context, reservationIds []string) ([]*pb.QuotaReservationPrivate, error) {
+	log := log.FromContext(ctx).WithName("InstanceService.commitQuotaReservations")
+	committed := []*pb.QuotaReservationPrivate{}
+	for _, reservationId := range reservationIds {
+		var reservation *pb.QuotaReservationPrivate
+		err := retry.OnError(quotaRetryBackoff, isRetryableQuotaError, func() error {
+			var err error
+			reservation, err = s.qmsClient.CommitQuotaReservationPrivate(ctx, &pb.QuotaReservationReferencePrivate{ReservationId: reservationId})
 			return err
+		})
+		if err != nil {
+			log.Error(err, "error committing quota reservation", "reservationId", reservationId)
+			return committed, status.Error(codes.Internal, "error in quota processing")
 		}
+		committed = append(committed, reservation)
+	}
+	return committed, nil
+}
+
+// releaseQuotaReservations releases the reserved quota when the instances are not created.
+// Errors are only logged since the reservations expire anyway.
+func (s *InstanceService) releaseQuotaReservations(ctx context.Context, reservationIds []string) {
+	log := log.FromContext(ctx).WithName("InstanceService.releaseQuotaReservations")
+	for _, reservationId := range reservationIds {
+		if _, err := s.qmsClient.ReleaseQuotaPrivate(ctx, &pb.QuotaReleaseRequestPrivate{ReservationId: reservationId}); err != nil {
+			log.Error(err, "error releasing quota reservation", "reservationId", reservationId)
+		}
+	}
+}
+
+// releaseCommittedQuota removes committed reservations from the usage of the cloud account when the instances are not
+// created after all.
+func (s *InstanceService) releaseCommittedQuota(ctx context.Context, reservations []*pb.QuotaReservationPrivate) {
+	for _, reservation := range reservations {
+		s.releaseQuota(ctx, reservation.ResourceType, reservation.CloudAccountId, reservation.Quantity)
+	}
+}
+
+// releaseInstanceQuota removes a deleted instance from the quota usage of the cloud account.
+func (s *InstanceService) releaseInstanceQuota(ctx context.Context, instance *pb.InstancePrivate) {
+	if s.qmsClient == nil || instance.Metadata.SkipQuotaCheck {
+		return
+	}
+	s.releaseQuota(ctx, instance.Spec.InstanceType, instance.Metadata.CloudAccountId, 1)
+}
+
+// releaseQuota removes quantity from the usage of the cloud account, retrying while the Quota Management Service is
+// unavailable. The instance is already deleted or not created, so an error that persists is logged and the usage is
+// corrected by the next quota usage reconciliation.
+func (s *InstanceService) releaseQuota(ctx context.Context, instanceType string, cloudAccountId string, quantity int64) {
+	log := log.FromContext(ctx).WithName("InstanceService.releaseQuota")
+	err := retry.OnError(quotaRetryBackoff, isRetryableQuotaError, func() error {
+		_, err := s.qmsClient.ReleaseQuotaPrivate(ctx, &pb.QuotaReleaseRequestPrivate{
+			ServiceName:    quotaServiceName,
+			ResourceType:   instanceType,
+			CloudAccountId: cloudAccountId,
+			Quantity:       quantity,
+		})
+		return err
+	})
+	if err != nil {
+		log.Error(err, "error releasing instance quota", logkeys.CloudAccountId, cloudAccountId, logkeys.InstanceType, instanceType)
 	}
-	return nil
 }
 
 // Validates and sets defaults in the provided InstancePrivate object and stores it in the database.
@@ -434,11 +543,17 @@ func (s *InstanceService) create(ctx context.Context, instances []*pb.InstancePr
 	defer span.End()
 
 	// Check quota before creation.
-	if err := s.checkQuota(ctx, instance0.Metadata.CloudAccountId, instances); err != nil {
+	reservationIds, err := s.checkQuota(ctx, instance0.Metadata.CloudAccountId, instances)
+	if err != nil {
 		return err
 	}
-	// TODO: Perform an additional quota validation following the instance creation but before
-	// completing the transaction to address concurrent creation scenarios.
+	// The quota reserved for the instances is released unless they are created.
+	quotaCommitted := false
+	defer func() {
+		if !quotaCommitted {
+			s.releaseQuotaReservations(ctx, reservationIds)
+		}
+	}()
 
 	userData := instance0.Spec.UserData
 	instanceGroupSize := len(instances)
@@ -519,13 +634,9 @@ func (s *InstanceService) create(ctx context.Context, instances []*pb.InstancePr
 		instance.Spec.InstanceTypeSpec = instanceType.Spec
 
 		// Add MachineImageSpec.
-		machineImage, err := s.machineImageService.Get(ctx, &pb.MachineImageGetRequest{
-			Metadata: &pb.MachineImageGetRequest_Metadata{
-				Name: instance.Spec.MachineImage,
-			},
-		})
+		machineImage, err := s.getMachineImage(ctx, instance)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "unable to get machine image %q", instance.Spec.MachineImage)
+			return err
 		}
 		instance.Spec.MachineImageSpec = machineImage.Spec
 		s.addMachineImageChecksum(machineImage, instance) // add machineImage checksum
@@ -607,7 +718,7 @@ func (s *InstanceService) create(ctx context.Context, instances []*pb.InstancePr
 	if instance0.Spec.ClusterId == "" && instance0.Spec.NodeId == "" {
 		log.V(9).Info("Scheduling instances, starting with", logkeys.InstanceName, instance0.Metadata.Name, logkeys.InstanceSpec, instance0.Spec)
 		// Schedule instances atomically.
-		if err := s.scheduleResources(ctx, instances, dryRun); err != nil {
+		if err := s.scheduleResources(ctx, instances, dryRun, false); err != nil {
 			log.Error(err, "scheduling failure")
 			return status.Error(codes.ResourceExhausted, ResourceExhaustedMessage)
 		}
@@ -660,9 +771,17 @@ func (s *InstanceService) create(ctx context.Context, instances []*pb.InstancePr
 			return status.Errorf(codes.Unknown, "insert: %v", err)
 		}
 	}
+	// The quota is committed before the instances so that the instances are not created if it can't be.
+	committedQuota, err := s.commitQuotaReservations(ctx, reservationIds)
+	if err != nil {
+		s.releaseCommittedQuota(ctx, committedQuota)
+		return err
+	}
 	if err := tx.Commit(); err != nil {
+		s.releaseCommittedQuota(ctx, committedQuota)
 		return status.Errorf(codes.Unknown, "commit: %v", err)
 	}
+	quotaCommitted = true
 	return nil
 }
 
@@ -695,7 +814,9 @@ func (s *InstanceService) Get(ctx context.Context, req *pb.InstanceGetRequest) (
 	return resp, utils.SanitizeError(err)
 }
 
-func (s *InstanceService) getInstancePrivateRows(ctx context.Context, req *pb.InstanceSearchPrivateRequest) (*sql.Rows, error) {
+// getInstancePrivateRows returns the instances that match req, ordered by name and resource_id.
+// If pagination is nil, all matching instances are returned.
+func (s *InstanceService) getInstancePrivateRows(ctx context.Context, req *pb.InstanceSearchPrivateRequest, pagination *protodb.Pagination) (*sql.Rows, error) {
 	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("InstanceService.getInstancePrivateRows").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
 	defer span.End()
 
@@ -754,10 +875,13 @@ func (s *InstanceService) getInstancePrivateRows(ctx context.Context, req *pb.In
 			select %s
 			from   instance
 			where  %s
-			order by name
-		`, s.sqlTransformer.ColumnsForFromRow(), whereString)
+			and    %s
+			order by name, resource_id
+			%s
+		`, s.sqlTransformer.ColumnsForFromRow(), whereString,
+		pagination.GetWhereString(len(flattenedObject.Values)+1), pagination.GetLimitString())
 
-	args := flattenedObject.Values
+	args := append(flattenedObject.Values, pagination.GetValues()...)
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
@@ -774,7 +898,7 @@ func (s *InstanceService) SearchPrivate(ctx context.Context, req *pb.InstanceSea
 	logger.Info("Request", logkeys.Request, req)
 
 	resp, err := func() (*pb.InstanceSearchPrivateResponse, error) {
-		rows, err := s.getInstancePrivateRows(ctx, req)
+		rows, err := s.getInstancePrivateRows(ctx, req, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -807,6 +931,10 @@ func (s *InstanceService) Search(ctx context.Context, req *pb.InstanceSearchRequ
 
 	logger.Info("Request", logkeys.Request, req)
 	resp, err := func() (*pb.InstanceSearchResponse, error) {
+		pagination, err := protodb.NewPagination(req.PageSize, req.PageToken, "name", "resource_id")
+		if err != nil {
+			return nil, err
+		}
 		rows, err := s.getInstancePrivateRows(ctx, &pb.InstanceSearchPrivateRequest{
 			Metadata: &pb.InstanceMetadataSearch{
 				CloudAccountId:      req.Metadata.CloudAccountId,
@@ -814,7 +942,7 @@ func (s *InstanceService) Search(ctx context.Context, req *pb.InstanceSearchRequ
 				InstanceGroup:       req.Metadata.InstanceGroup,
 				InstanceGroupFilter: req.Metadata.InstanceGroupFilter,
 			},
-		})
+		}, pagination)
 		if err != nil {
 			return nil, err
 		}
@@ -831,8 +959,15 @@ func (s *InstanceService) Search(ctx context.Context, req *pb.InstanceSearchRequ
 			}
 			items = append(items, item)
 		}
+		items, nextPageToken, err := protodb.NextPage(pagination, items, func(item *pb.Instance) []string {
+			return []string{item.Metadata.Name, item.Metadata.ResourceId}
+		})
+		if err != nil {
+			return nil, err
+		}
 		resp := &pb.InstanceSearchResponse{
-			Items: items,
+			Items:         items,
+			NextPageToken: nextPageToken,
 		}
 		return resp, nil
 	}()
@@ -942,6 +1077,257 @@ func (s *InstanceService) Update(ctx context.Context, req *pb.InstanceUpdateRequ
 	return resp, utils.SanitizeError(err)
 }
 
+// Change the instance type of a virtual machine instance.
+// The new instance type must fit on the node that runs the instance. The instance operator stops the instance,
+// applies the new instance type, and starts it again.
+// Public API.
+func (s *InstanceService) Resize(ctx context.Context, req *pb.InstanceResizeRequest) (*emptypb.Empty, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("InstanceService.Resize").WithValues(logkeys.CloudAccountId, req.Metadata.GetCloudAccountId(),
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*emptypb.Empty, error) {
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+		if req.Spec == nil || req.Spec.InstanceType == "" {
+			return nil, status.Error(codes.InvalidArgument, "missing instance type")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		instance, err := s.GetInstancePrivate(ctx, cloudAccountId, req.Metadata.GetResourceId(), req.Metadata.GetName())
+		if err != nil {
+			return nil, err
+		}
+		if req.Metadata.ResourceVersion != "" && req.Metadata.ResourceVersion != instance.Metadata.ResourceVersion {
+			return nil, status.Error(codes.FailedPrecondition, "stored resource version does not match requested resource version")
+		}
+		if err := validateResizeInstance(instance); err != nil {
+			return nil, err
+		}
+		if req.Spec.InstanceType == instance.Spec.InstanceType {
+			return nil, status.Errorf(codes.InvalidArgument, "instance already has instance type %s", req.Spec.InstanceType)
+		}
+
+		instanceType, err := s.instanceTypeService.Get(ctx, &pb.InstanceTypeGetRequest{
+			Metadata: &pb.InstanceTypeGetRequest_Metadata{
+				Name: req.Spec.InstanceType,
+			},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to get instance type %q", req.Spec.InstanceType)
+		}
+		if instanceType.Spec.InstanceCategory != pb.InstanceCategory_VirtualMachine {
+			return nil, status.Errorf(codes.InvalidArgument, "instance type %s is not a virtual machine instance type", req.Spec.InstanceType)
+		}
+		if err := ensureDiskDoesNotShrink(instance.Spec.InstanceTypeSpec, instanceType.Spec); err != nil {
+			return nil, err
+		}
+
+		// The resized instance stays on the same cluster and node.
+		resizedInstance := proto.Clone(instance).(*pb.InstancePrivate)
+		resizedInstance.Spec.InstanceType = instanceType.Metadata.Name
+		resizedInstance.Spec.InstanceTypeSpec = instanceType.Spec
+		machineImage, err := s.getMachineImage(ctx, resizedInstance)
+		if err != nil {
+			return nil, err
+		}
+		if err := ensureMachineImageCompatibility(machineImage, instanceType); err != nil {
+			return nil, err
+		}
+
+		// Check quota of the new instance type.
+		reservationIds, err := s.checkQuota(ctx, cloudAccountId, []*pb.InstancePrivate{resizedInstance})
+		if err != nil {
+			return nil, err
+		}
+		quotaCommitted := false
+		defer func() {
+			if !quotaCommitted {
+				s.releaseQuotaReservations(ctx, reservationIds)
+			}
+		}()
+
+		// Ensure that the node has enough free resources for the new instance type.
+		// Resources used by this instance are counted as free.
+		resizedInstance.Spec.UserData = ""
+		if err := s.scheduleResources(ctx, []*pb.InstancePrivate{resizedInstance}, true, false); err != nil {
+			log.Error(err, "scheduling failure")
+			return nil, status.Error(codes.ResourceExhausted, ResourceExhaustedMessage)
+		}
+
+		resizeTimestamp := timestamppb.Now()
+		meteringResourceId, err := uuid.NewRandom()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error encountered while generating resourceId: %v", err)
+		}
+		updateFunc := func(stored *pb.InstancePrivate) error {
+			if err := validateResizeInstance(stored); err != nil {
+				return err
+			}
+			stored.Spec.InstanceType = instanceType.Metadata.Name
+			stored.Spec.InstanceTypeSpec = instanceType.Spec
+			stored.Spec.Metering = resizeInstanceMetering(instance, resizeTimestamp, meteringResourceId.String())
+			return nil
+		}
+		// The quota of the new instance type is committed before the instance is resized, like when it is created.
+		committedQuota, err := s.commitQuotaReservations(ctx, reservationIds)
+		if err != nil {
+			s.releaseCommittedQuota(ctx, committedQuota)
+			return nil, err
+		}
+		// Use the resource version that was checked above.
+		if err := s.update(ctx, cloudAccountId, instance.Metadata.ResourceId, "", instance.Metadata.ResourceVersion, updateFunc); err != nil {
+			s.releaseCommittedQuota(ctx, committedQuota)
+			return nil, err
+		}
+		quotaCommitted = true
+		// The previous instance type is no longer used by this instance.
+		s.releaseInstanceQuota(ctx, instance)
+		return &emptypb.Empty{}, nil
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, utils.SanitizeError(err)
+}
+
+// Ensure that the instance type of an instance can be changed.
+func validateResizeInstance(instance *pb.InstancePrivate) error {
+	if instance.Metadata.DeletionTimestamp != nil {
+		return status.Error(codes.FailedPrecondition, "instance is being deleted")
+	}
+	if instance.Spec.InstanceTypeSpec.GetInstanceCategory() != pb.InstanceCategory_VirtualMachine {
+		return status.Error(codes.InvalidArgument, "only virtual machine instances can be resized")
+	}
+	if instance.Spec.InstanceGroup != "" {
+		return status.Error(codes.InvalidArgument, "the instance cannot be resized because it is a member of an instance group")
+	}
+	if instance.Status.GetPhase() != pb.InstancePhase_Ready && instance.Status.GetPhase() != pb.InstancePhase_Stopped {
+		return status.Errorf(codes.FailedPrecondition, "unable to resize the instance during %s", instance.Status.GetPhase())
+	}
+	return nil
+}
+
+// Returns the metering of an instance whose instance type changes at resizeTimestamp.
+// The current metering segment ends and a new segment with a new metering resourceId starts.
+// Previous segments are kept until the metering monitor has written their final metering record.
+func resizeInstanceMetering(instance *pb.InstancePrivate, resizeTimestamp *timestamppb.Timestamp, meteringResourceId string) *pb.InstanceMetering {
+	closed := map[string]bool{}
+	for _, resourceId := range instance.Status.GetClosedMeteringResourceIds() {
+		closed[resourceId] = true
+	}
+	var previousSegments []*pb.InstanceMeteringSegment
+	for _, segment := range instance.Spec.Metering.GetPreviousSegments() {
+		if !closed[segment.ResourceId] {
+			previousSegments = append(previousSegments, segment)
+		}
+	}
+	current := &pb.InstanceMeteringSegment{
+		ResourceId:     instance.Spec.Metering.GetResourceId(),
+		InstanceType:   instance.Spec.InstanceType,
+		StartTimestamp: instance.Spec.Metering.GetStartTimestamp(),
+		EndTimestamp:   resizeTimestamp,
+	}
+	if current.ResourceId == "" {
+		current.ResourceId = instance.Metadata.ResourceId
+	}
+	return &pb.InstanceMetering{
+		ResourceId:       meteringResourceId,
+		StartTimestamp:   resizeTimestamp,
+		PreviousSegments: append(previousSegments, current),
+	}
+}
+
+// Choose a new node for a running virtual machine instance so that its current node can be drained for maintenance.
+// The new node is selected by the VM scheduler from the other nodes in the same cluster and it is stored in the spec.
+// The VM Instance Operator then moves the instance to the new node using live migration if possible.
+// Private API.
+func (s *InstanceService) MigratePrivate(ctx context.Context, req *pb.InstanceMigratePrivateRequest) (*pb.InstanceMigratePrivateResponse, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("InstanceService.MigratePrivate").WithValues(logkeys.CloudAccountId, req.Metadata.GetCloudAccountId(),
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+
+	log.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.InstanceMigratePrivateResponse, error) {
+		if req.Metadata == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		}
+		if req.SourceNodeId == "" {
+			return nil, status.Error(codes.InvalidArgument, "missing sourceNodeId")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		instance, err := s.GetInstancePrivate(ctx, cloudAccountId, req.Metadata.GetResourceId(), "")
+		if err != nil {
+			return nil, err
+		}
+		if req.Metadata.ResourceVersion != "" && req.Metadata.ResourceVersion != instance.Metadata.ResourceVersion {
+			return nil, status.Error(codes.FailedPrecondition, "stored resource version does not match requested resource version")
+		}
+		if err := validateMigrateInstance(instance); err != nil {
+			return nil, err
+		}
+		// A previous request has already moved the instance away from the source node.
+		if instance.Spec.NodeId != req.SourceNodeId {
+			return &pb.InstanceMigratePrivateResponse{NodeId: instance.Spec.NodeId}, nil
+		}
+
+		migratedInstance := proto.Clone(instance).(*pb.InstancePrivate)
+		migratedInstance.Spec.UserData = ""
+		if err := s.scheduleResources(ctx, []*pb.InstancePrivate{migratedInstance}, false, true); err != nil {
+			log.Error(err, "scheduling failure")
+			return nil, status.Error(codes.ResourceExhausted, ResourceExhaustedMessage)
+		}
+
+		updateFunc := func(stored *pb.InstancePrivate) error {
+			if err := validateMigrateInstance(stored); err != nil {
+				return err
+			}
+			if stored.Spec.ClusterId != migratedInstance.Spec.ClusterId {
+				return status.Error(codes.FailedPrecondition, "instance cannot be moved to another cluster")
+			}
+			stored.Spec.NodeId = migratedInstance.Spec.NodeId
+			stored.Spec.Partition = migratedInstance.Spec.Partition
+			stored.Spec.ComputeNodePools = migratedInstance.Spec.ComputeNodePools
+			return nil
+		}
+		// Use the resource version that was checked above.
+		if err := s.update(ctx, cloudAccountId, instance.Metadata.ResourceId, "", instance.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &pb.InstanceMigratePrivateResponse{NodeId: migratedInstance.Spec.NodeId}, nil
+	}()
+	if err != nil {
+		log.Error(err, logkeys.Error, logkeys.Request, req)
+	} else {
+		log.Info("Response", logkeys.Response, resp)
+	}
+	return resp, utils.SanitizeError(err)
+}
+
+// Ensure that an instance can be moved to another node.
+func validateMigrateInstance(instance *pb.InstancePrivate) error {
+	if instance.Metadata.DeletionTimestamp != nil {
+		return status.Error(codes.FailedPrecondition, "instance is being deleted")
+	}
+	if instance.Spec.InstanceTypeSpec.GetInstanceCategory() != pb.InstanceCategory_VirtualMachine {
+		return status.Error(codes.InvalidArgument, "only virtual machine instances can be migrated")
+	}
+	return nil
+}
+
 // Allow update of:
 //   - Status
 //
@@ -1045,9 +1431,13 @@ func (s *InstanceService) deleteInstance(ctx context.Context, metadata *pb.Insta
 }
 
 func (s *InstanceService) DeleteInstanceInternal(ctx context.Context, cloudAccountId string, resourceId string, name string, resourceVersion string) error {
+	// The instance whose deletion is requested by this call, if any.
+	var deletedInstance *pb.InstancePrivate
 	updateFunc := func(instance *pb.InstancePrivate) error {
+		deletedInstance = nil
 		if instance.Metadata.DeletionTimestamp == nil {
 			instance.Metadata.DeletionTimestamp = timestamppb.Now()
+			deletedInstance = instance
 		}
 		return nil
 	}
@@ -1055,6 +1445,9 @@ func (s *InstanceService) DeleteInstanceInternal(ctx context.Context, cloudAccou
 	if err := s.update(ctx, cloudAccountId, resourceId, name, resourceVersion, updateFunc); err != nil {
 		return err
 	}
+	if deletedInstance != nil {
+		s.releaseInstanceQuota(ctx, deletedInstance)
+	}
 	return nil
 }
 
@@ -1132,6 +1525,20 @@ func (s *InstanceService) get(ctx context.Context, cloudAccountId string, argNam
 	return s.rowToInstance(ctx, rows)
 }
 
+// GetInstancePrivate returns a non-deleted instance by resource id or name.
+func (s *InstanceService) GetInstancePrivate(ctx context.Context, cloudAccountId string, resourceId string, name string) (*pb.InstancePrivate, error) {
+	argName, arg, err := common.ResourceUniqueColumnAndValue(resourceId, name)
+	if err != nil {
+		return nil, err
+	}
+	if argName == "resource_id" {
+		if _, err := uuid.Parse(resourceId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid resourceId")
+		}
+	}
+	return s.getPrivate(ctx, cloudAccountId, argName, arg)
+}
+
 func (s *InstanceService) getPrivate(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*pb.InstancePrivate, error) {
 	rows, err := s.selectInstance(ctx, cloudAccountId, argName, arg)
 	if err != nil {
@@ -1306,7 +1713,8 @@ func (s *InstanceService) setAllowedComputeNodePoolsForScheduling(ctx context.Co
 
 // Schedule resources (1 or more instances).
 // This sets each instance's ClusterId and NodeId.
-func (s *InstanceService) scheduleResources(ctx context.Context, instances []*pb.InstancePrivate, dryRun bool) error {
+// If migrate is true, instances that have already been scheduled will be placed on another node in the same cluster.
+func (s *InstanceService) scheduleResources(ctx context.Context, instances []*pb.InstancePrivate, dryRun bool, migrate bool) error {
 	instance0 := instances[0]
 	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("InstanceService.scheduleResources").WithValues(logkeys.CloudAccountId, instance0.Metadata.CloudAccountId,
 		logkeys.ResourceId, instance0.Metadata.GetResourceId()).Start()
@@ -1317,6 +1725,7 @@ func (s *InstanceService) scheduleResources(ctx context.Context, instances []*pb
 	req := &pb.ScheduleRequest{
 		Instances: instances,
 		DryRun:    dryRun,
+		Migrate:   migrate,
 	}
 	log.Info("Scheduling resources", logkeys.Request, req)
 	isRetryable := func(err error) bool {
@@ -1476,6 +1885,38 @@ func (s *InstanceService) rowToInstancePrivate(ctx context.Context, rows *sql.Ro
 	return instance, nil
 }
 
+// Get the machine image for an instance that is being created.
+// A global machine image is used if it exists. Otherwise, a custom machine image in the same cloud account is used.
+// Instances that use a custom machine image are scheduled in the VM cluster that stores the image.
+func (s *InstanceService) getMachineImage(ctx context.Context, instance *pb.InstancePrivate) (*pb.MachineImage, error) {
+	machineImage, err := s.machineImageService.Get(ctx, &pb.MachineImageGetRequest{
+		Metadata: &pb.MachineImageGetRequest_Metadata{
+			Name: instance.Spec.MachineImage,
+		},
+	})
+	if err == nil {
+		return machineImage, nil
+	}
+	if status.Code(err) != codes.NotFound || s.customMachineImageGetter == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to get machine image %q", instance.Spec.MachineImage)
+	}
+	machineImage, clusterId, err := s.customMachineImageGetter.GetMachineImage(ctx, instance.Metadata.CloudAccountId, instance.Spec.MachineImage)
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, err
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to get machine image %q", instance.Spec.MachineImage)
+	}
+	if instance.Spec.ClusterId != "" && instance.Spec.ClusterId != clusterId {
+		return nil, status.Errorf(codes.InvalidArgument, "machine image %q is not available in cluster %s", instance.Spec.MachineImage, instance.Spec.ClusterId)
+	}
+	if err := ensureMachineImageFitsDisk(machineImage, instance.Spec.InstanceTypeSpec); err != nil {
+		return nil, err
+	}
+	instance.Spec.ClusterId = clusterId
+	return machineImage, nil
+}
+
 func ensureMachineImageCompatibility(machineImage *pb.MachineImage, instanceType *pb.InstanceType) error {
 	if len(machineImage.Spec.InstanceCategories) > 0 {
 		found := false
@@ -1504,6 +1945,45 @@ func ensureMachineImageCompatibility(machineImage *pb.MachineImage, instanceType
 	return nil
 }
 
+// Ensure that the instance disk is large enough for the machine image.
+func ensureMachineImageFitsDisk(machineImage *pb.MachineImage, instanceTypeSpec *pb.InstanceTypeSpec) error {
+	if machineImage.Spec.VirtualSizeBytes == 0 || len(instanceTypeSpec.Disks) == 0 {
+		return nil
+	}
+	diskSize, err := resource.ParseQuantity(instanceTypeSpec.Disks[0].Size)
+	if err != nil {
+		return status.Errorf(codes.Internal, "invalid disk size for instance type %s", instanceTypeSpec.Name)
+	}
+	if diskSize.CmpInt64(int64(machineImage.Spec.VirtualSizeBytes)) < 0 {
+		return status.Errorf(codes.InvalidArgument, "machine image %s requires a disk of at least %d bytes but instance type %s has a disk of %s",
+			machineImage.Metadata.Name, machineImage.Spec.VirtualSizeBytes, instanceTypeSpec.Name, instanceTypeSpec.Disks[0].Size)
+	}
+	return nil
+}
+
+// Ensure that the root disk of a resized instance is not smaller than its current root disk.
+func ensureDiskDoesNotShrink(currentSpec *pb.InstanceTypeSpec, newSpec *pb.InstanceTypeSpec) error {
+	if len(currentSpec.GetDisks()) == 0 {
+		return nil
+	}
+	if len(newSpec.Disks) == 0 {
+		return status.Errorf(codes.InvalidArgument, "instance type %s does not have a disk", newSpec.Name)
+	}
+	currentSize, err := resource.ParseQuantity(currentSpec.Disks[0].Size)
+	if err != nil {
+		return status.Errorf(codes.Internal, "invalid disk size for instance type %s", currentSpec.Name)
+	}
+	newSize, err := resource.ParseQuantity(newSpec.Disks[0].Size)
+	if err != nil {
+		return status.Errorf(codes.Internal, "invalid disk size for instance type %s", newSpec.Name)
+	}
+	if newSize.Cmp(currentSize) < 0 {
+		return status.Errorf(codes.InvalidArgument, "instance type %s has a disk of %s which is smaller than the current disk of %s",
+			newSpec.Name, newSpec.Disks[0].Size, currentSpec.Disks[0].Size)
+	}
+	return nil
+}
+
 // Calculates and returns a map of instance types to their respective counts.
 func getInstanceCountMap(instances []*pb.InstancePrivate) map[string]int {
 	instanceCounts := make(map[string]int)
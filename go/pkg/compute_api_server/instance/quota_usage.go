@@ -0,0 +1,62 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package instance
+
+import (
+	"context"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// quotaUsageReconcileMargin is subtracted from the time the instances are counted, so that the usage changed by
+// instances being created or deleted while they are counted, or by a clock skew with the Quota Management Service,
+// is left to the next reconciliation.
+const quotaUsageReconcileMargin = time.Minute
+
+// ReconcileQuotaUsage sets the usage of the Quota Management Service to the number of instances of each instance type
+// and cloud account. It corrects the usage left wrong by quota commits and releases that failed.
+func (s *InstanceService) ReconcileQuotaUsage(ctx context.Context) error {
+	log := log.FromContext(ctx).WithName("InstanceService.ReconcileQuotaUsage")
+	if s.qmsClient == nil {
+		return nil
+	}
+	updatedBefore := time.Now().Add(-quotaUsageReconcileMargin)
+
+	// Instances whose deletion was requested have released their quota.
+	query := `
+		select cloud_account_id, value->'spec'->>'instanceType', count(*)
+		from   instance
+		where  deleted_timestamp = $1
+		  and  coalesce(value->'metadata'->>'deletionTimestamp', '') = ''
+		  and  coalesce(value->'metadata'->>'skipQuotaCheck', 'false') = 'false'
+		group  by 1, 2
+	`
+	rows, err := s.db.QueryContext(ctx, query, common.TimestampInfinityStr)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	usages := []*pb.QuotaUsageCountPrivate{}
+	for rows.Next() {
+		usage := &pb.QuotaUsageCountPrivate{}
+		if err := rows.Scan(&usage.CloudAccountId, &usage.ResourceType, &usage.Usage); err != nil {
+			return err
+		}
+		usages = append(usages, usage)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	log.Info("Reconciling quota usage", "usages", len(usages))
+	_, err = s.qmsClient.ReconcileQuotaUsagePrivate(ctx, &pb.QuotaUsageReconcileRequestPrivate{
+		ServiceName:   quotaServiceName,
+		Usages:        usages,
+		UpdatedBefore: timestamppb.New(updatedBefore),
+	})
+	return err
+}
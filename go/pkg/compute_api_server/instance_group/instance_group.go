@@ -17,6 +17,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -219,19 +220,17 @@ func (s *InstanceGroupService) Update(ctx context.Context, req *pb.InstanceGroup
 		if err := validateClusterName(instanceGroup); err != nil {
 			return err
 		}
-		instances, err := s.instanceService.Search(ctx, &pb.InstanceSearchRequest{
-			Metadata: &pb.InstanceMetadataSearch{
-				CloudAccountId:      cloudAccountId,
-				InstanceGroup:       instanceGroup,
-				InstanceGroupFilter: pb.SearchFilterCriteria_ExactValue,
-			},
+		instances, err := s.searchAllInstances(ctx, &pb.InstanceMetadataSearch{
+			CloudAccountId:      cloudAccountId,
+			InstanceGroup:       instanceGroup,
+			InstanceGroupFilter: pb.SearchFilterCriteria_ExactValue,
 		})
 		if err != nil {
 			return err
 		}
 
 		var updateInstanceErr error
-		for _, instance := range instances.Items {
+		for _, instance := range instances {
 
 			if instance.Spec.InstanceGroup != instanceGroup {
 				return status.Errorf(codes.InvalidArgument, "instance of a different instance group %s found", instance.Spec.InstanceGroup)
@@ -277,20 +276,21 @@ func (s *InstanceGroupService) Search(ctx context.Context, req *pb.InstanceGroup
 		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
 			return nil, err
 		}
-
-		searchRequest := &pb.InstanceSearchRequest{
-			Metadata: &pb.InstanceMetadataSearch{
-				CloudAccountId:      req.Metadata.CloudAccountId,
-				InstanceGroupFilter: pb.SearchFilterCriteria_NonEmpty,
-			},
+		pagination, err := protodb.NewPagination(req.PageSize, req.PageToken, "name")
+		if err != nil {
+			return nil, err
 		}
-		searchResponse, err := s.instanceService.Search(ctx, searchRequest)
+
+		instances, err := s.searchAllInstances(ctx, &pb.InstanceMetadataSearch{
+			CloudAccountId:      req.Metadata.CloudAccountId,
+			InstanceGroupFilter: pb.SearchFilterCriteria_NonEmpty,
+		})
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "error encountered while getting all the instances for cloudaccountId %s: %v", req.Metadata.CloudAccountId, err)
 		}
 
 		instanceGroups := make(map[string][]*pb.Instance)
-		for _, instance := range searchResponse.Items {
+		for _, instance := range instances {
 			if instance.Spec.InstanceGroup != "" {
 				instanceGroups[instance.Spec.InstanceGroup] = append(instanceGroups[instance.Spec.InstanceGroup], instance)
 			}
@@ -321,8 +321,19 @@ func (s *InstanceGroupService) Search(ctx context.Context, req *pb.InstanceGroup
 			}
 			items = append(items, group)
 		}
+		// Instance groups are not stored in a table, so they are paginated in memory.
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].Metadata.Name < items[j].Metadata.Name
+		})
+		items, nextPageToken, err := protodb.Page(pagination, items, func(item *pb.InstanceGroup) []string {
+			return []string{item.Metadata.Name}
+		})
+		if err != nil {
+			return nil, err
+		}
 		resp := &pb.InstanceGroupSearchResponse{
-			Items: items,
+			Items:         items,
+			NextPageToken: nextPageToken,
 		}
 		return resp, nil
 	}()
@@ -330,6 +341,21 @@ func (s *InstanceGroupService) Search(ctx context.Context, req *pb.InstanceGroup
 	return resp, utils.SanitizeError(err)
 }
 
+// Returns all instances that match metadata. InstanceService.Search returns one page at a time.
+func (s *InstanceGroupService) searchAllInstances(ctx context.Context, metadata *pb.InstanceMetadataSearch) ([]*pb.Instance, error) {
+	return protodb.SearchAllPages(func(pageToken string) ([]*pb.Instance, string, error) {
+		resp, err := s.instanceService.Search(ctx, &pb.InstanceSearchRequest{
+			Metadata:  metadata,
+			PageSize:  protodb.MaxPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+}
+
 // Delete API implementation.
 func (s *InstanceGroupService) Delete(ctx context.Context, req *pb.InstanceGroupDeleteRequest) (*emptypb.Empty, error) {
 	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("InstanceGroupService.Delete").
@@ -356,19 +382,17 @@ func (s *InstanceGroupService) Delete(ctx context.Context, req *pb.InstanceGroup
 		}
 
 		// Search for instances
-		instances, err := s.instanceService.Search(ctx, &pb.InstanceSearchRequest{
-			Metadata: &pb.InstanceMetadataSearch{
-				CloudAccountId:      cloudAccountId,
-				InstanceGroup:       instanceGroup,
-				InstanceGroupFilter: pb.SearchFilterCriteria_ExactValue,
-			},
+		instances, err := s.searchAllInstances(ctx, &pb.InstanceMetadataSearch{
+			CloudAccountId:      cloudAccountId,
+			InstanceGroup:       instanceGroup,
+			InstanceGroupFilter: pb.SearchFilterCriteria_ExactValue,
 		})
 		if err != nil {
 			return err
 		}
 
 		var deleteInstanceError error
-		for _, instance := range instances.Items {
+		for _, instance := range instances {
 			if instance.Spec.InstanceGroup == req.Metadata.GetName() {
 				_, deleteInstanceError = s.privateInstanceService.DeletePrivate(ctx, &pb.InstanceDeletePrivateRequest{
 					Metadata: &pb.InstanceMetadataReference{
@@ -418,23 +442,21 @@ func (s *InstanceGroupService) DeleteMember(ctx context.Context, req *pb.Instanc
 		}
 
 		// check the existing instances in the instanceGroup
-		instances, err := s.instanceService.Search(ctx, &pb.InstanceSearchRequest{
-			Metadata: &pb.InstanceMetadataSearch{
-				CloudAccountId:      cloudAccountId,
-				InstanceGroup:       groupName,
-				InstanceGroupFilter: pb.SearchFilterCriteria_ExactValue,
-			},
+		instances, err := s.searchAllInstances(ctx, &pb.InstanceMetadataSearch{
+			CloudAccountId:      cloudAccountId,
+			InstanceGroup:       groupName,
+			InstanceGroupFilter: pb.SearchFilterCriteria_ExactValue,
 		})
 		if err != nil {
 			return err
 		}
-		instanceCount := len(instances.Items)
+		instanceCount := len(instances)
 		if instanceCount == 0 {
 			return status.Errorf(codes.NotFound, "no instances found for instanceGroup %v", groupName)
 		}
 
 		updateGroupSize := func(groupSize int) {
-			for _, instance := range instances.Items {
+			for _, instance := range instances {
 				instanceMetadataUpdate := &pb.InstanceMetadataUpdate{}
 				if req.GetInstanceResourceId() != "" {
 					instanceMetadataUpdate = &pb.InstanceMetadataUpdate{
@@ -470,7 +492,7 @@ func (s *InstanceGroupService) DeleteMember(ctx context.Context, req *pb.Instanc
 		instanceNameFoundInGroup := false
 		instanceResourceIdFoundInGroup := false
 
-		for _, instance := range instances.Items {
+		for _, instance := range instances {
 			// check that the instance to be deleted is part of the instanceGroup
 			if req.GetInstanceName() != "" {
 				if instance.Metadata.Name == req.GetInstanceName() {
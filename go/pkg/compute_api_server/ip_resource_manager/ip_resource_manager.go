@@ -85,10 +85,13 @@ func (s *IpResourceManagerService) PutSubnet(ctx context.Context, req *pb.Create
 				return nil, fmt.Errorf("select nextval: %w", err)
 			}
 			query := `
-				insert into subnet (subnet_id, region, availability_zone, address_space, subnet, prefix_length, gateway, vlan_id, vlan_domain)
-				values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				insert into subnet (subnet_id, region, availability_zone, address_space, subnet, prefix_length, gateway, vlan_id, vlan_domain,
+				                    ipv6_subnet, ipv6_prefix_length, ipv6_gateway)
+				values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 			`
-			_, err = tx.ExecContext(ctx, query, subnetId, req.Region, req.AvailabilityZone, req.AddressSpace, req.Subnet, req.PrefixLength, req.Gateway, req.VlanId, req.VlanDomain)
+			args := append([]any{subnetId, req.Region, req.AvailabilityZone, req.AddressSpace, req.Subnet, req.PrefixLength, req.Gateway, req.VlanId, req.VlanDomain},
+				ipv6SubnetArgs(req)...)
+			_, err = tx.ExecContext(ctx, query, args...)
 			if err != nil {
 				return nil, fmt.Errorf("insert into subnet: %w", err)
 			}
@@ -103,10 +106,14 @@ func (s *IpResourceManagerService) PutSubnet(ctx context.Context, req *pb.Create
 				set    prefix_length = $2,
 				       gateway = $3,
 					   vlan_id = $4,
-					   vlan_domain = $5
+					   vlan_domain = $5,
+					   ipv6_subnet = $6,
+					   ipv6_prefix_length = $7,
+					   ipv6_gateway = $8
 				where  subnet_id = $1
 			`
-			_, err = tx.ExecContext(ctx, query, subnetId, req.PrefixLength, req.Gateway, req.VlanId, req.VlanDomain)
+			args := append([]any{subnetId, req.PrefixLength, req.Gateway, req.VlanId, req.VlanDomain}, ipv6SubnetArgs(req)...)
+			_, err = tx.ExecContext(ctx, query, args...)
 			if err != nil {
 				return nil, fmt.Errorf("insert into subnet: %w", err)
 			}
@@ -225,7 +232,8 @@ func (s *IpResourceManagerService) SearchSubnetStream(req *pb.SearchSubnetReques
 	defer logger.Info("END")
 	err := func() error {
 		query := `
-			select region, availability_zone, address_space, subnet, prefix_length, gateway, vlan_id, subnet_consumer_id, vlan_domain
+			select region, availability_zone, address_space, subnet, prefix_length, gateway, vlan_id, subnet_consumer_id, vlan_domain,
+			       coalesce(host(ipv6_subnet), ''), coalesce(ipv6_prefix_length, 0), coalesce(host(ipv6_gateway), '')
 			from   subnet
 		`
 		rows, err := s.db.QueryContext(ctx, query)
@@ -236,7 +244,8 @@ func (s *IpResourceManagerService) SearchSubnetStream(req *pb.SearchSubnetReques
 		for rows.Next() {
 			resp := pb.Subnet{}
 			var subnetConsumerId *string
-			if err := rows.Scan(&resp.Region, &resp.AvailabilityZone, &resp.AddressSpace, &resp.Subnet, &resp.PrefixLength, &resp.Gateway, &resp.VlanId, &subnetConsumerId, &resp.VlanDomain); err != nil {
+			if err := rows.Scan(&resp.Region, &resp.AvailabilityZone, &resp.AddressSpace, &resp.Subnet, &resp.PrefixLength, &resp.Gateway, &resp.VlanId, &subnetConsumerId, &resp.VlanDomain,
+				&resp.Ipv6Subnet, &resp.Ipv6PrefixLength, &resp.Ipv6Gateway); err != nil {
 				return err
 			}
 			if subnetConsumerId != nil {
@@ -417,6 +426,7 @@ func (s *IpResourceManagerService) ReserveAddress(ctx context.Context, req *pb.R
 	logger := log.FromContext(ctx).WithName("IpResourceManagerService.ReserveAddress")
 	logger.Info("Request", logkeys.Request, req)
 	var address string
+	var subnet *pb.Subnet
 
 	resp, err := func() (*pb.ReserveAddressResponse, error) {
 		if err := validateAndNormalizeReserveAddressRequest(req); err != nil {
@@ -433,10 +443,11 @@ func (s *IpResourceManagerService) ReserveAddress(ctx context.Context, req *pb.R
 			defer tx.Rollback()
 
 			// Find subnet.
-			subnetId, _, err := s.findSubnetWithConsumerId(ctx, tx, req.SubnetReference.SubnetConsumerId)
+			subnetId, foundSubnet, err := s.findSubnetWithConsumerId(ctx, tx, req.SubnetReference.SubnetConsumerId)
 			if err != nil {
 				return err
 			}
+			subnet = foundSubnet
 
 			// Try to find an existing reserved address with the same AddressConsumerId.
 			address, err = s.findAddressWithConsumerId(ctx, tx, subnetId, req.AddressReference.AddressConsumerId, req.AddressReference.Address)
@@ -514,8 +525,15 @@ func (s *IpResourceManagerService) ReserveAddress(ctx context.Context, req *pb.R
 			return nil, err
 		}
 
+		// The IPv6 address of a dual-stack subnet is derived from the IPv4 address so it does not need to be stored.
+		ipv6Address, err := pairedIPv6Address(subnet, address)
+		if err != nil {
+			return nil, err
+		}
+
 		return &pb.ReserveAddressResponse{
-			Address: address,
+			Address:     address,
+			Ipv6Address: ipv6Address,
 		}, nil
 	}()
 
@@ -639,7 +657,8 @@ func (s *IpResourceManagerService) GetSubnetStatistics(ctx context.Context, req
 func (s *IpResourceManagerService) findSubnetWithConsumerId(ctx context.Context, tx *sql.Tx, subnetConsumerId string) (int, *pb.Subnet, error) {
 	logger := log.FromContext(ctx).WithName("IpResourceManagerService.findSubnetWithConsumerId")
 	query := `
-		select subnet_id, region, availability_zone, host(subnet) as subnet, prefix_length, gateway, vlan_id, vlan_domain, subnet_consumer_id
+		select subnet_id, region, availability_zone, host(subnet) as subnet, prefix_length, gateway, vlan_id, vlan_domain, subnet_consumer_id,
+		       coalesce(host(ipv6_subnet), ''), coalesce(ipv6_prefix_length, 0), coalesce(host(ipv6_gateway), '')
 		from   subnet
 		where  subnet_consumer_id = $1
 	`
@@ -648,7 +667,8 @@ func (s *IpResourceManagerService) findSubnetWithConsumerId(ctx context.Context,
 	subnetId := int(0)
 	resp := &pb.Subnet{}
 	err := tx.QueryRowContext(ctx, query, args...).
-		Scan(&subnetId, &resp.Region, &resp.AvailabilityZone, &resp.Subnet, &resp.PrefixLength, &resp.Gateway, &resp.VlanId, &resp.VlanDomain, &resp.SubnetConsumerId)
+		Scan(&subnetId, &resp.Region, &resp.AvailabilityZone, &resp.Subnet, &resp.PrefixLength, &resp.Gateway, &resp.VlanId, &resp.VlanDomain, &resp.SubnetConsumerId,
+			&resp.Ipv6Subnet, &resp.Ipv6PrefixLength, &resp.Ipv6Gateway)
 	if err == sql.ErrNoRows {
 		return 0, nil, status.Error(codes.NotFound, "findSubnetWithConsumerId: subnet not found")
 	} else if err != nil {
@@ -763,9 +783,106 @@ func validateAndNormalizeCreateSubnetRequest(req *pb.CreateSubnetRequest) error
 		normalizedAddresses = append(normalizedAddresses, ip.String())
 	}
 	req.Address = normalizedAddresses
+
+	return validateAndNormalizeIPv6Subnet(req, prefixLength)
+}
+
+// Validate and normalize the optional IPv6 prefix of a dual-stack subnet.
+// The IPv6 prefix must have room for every IPv4 address of the subnet at the same offset.
+func validateAndNormalizeIPv6Subnet(req *pb.CreateSubnetRequest, prefixLength int) error {
+	if req.Ipv6Subnet == "" {
+		if req.Ipv6Gateway != "" {
+			return fmt.Errorf("ipv6 gateway %q requires an ipv6 subnet", req.Ipv6Gateway)
+		}
+		return nil
+	}
+	ipv6Ip, ipv6Net, err := net.ParseCIDR(req.Ipv6Subnet)
+	if err != nil {
+		return fmt.Errorf("cannot parse ipv6 subnet %q: %s", req.Ipv6Subnet, err)
+	}
+	if ipv6Ip.To4() != nil {
+		return fmt.Errorf("ipv6 subnet %q is not an IPv6 subnet", req.Ipv6Subnet)
+	}
+	if !ipv6Ip.Equal(ipv6Net.IP) {
+		return fmt.Errorf("ipv6 subnet %q has non-zero host bits", req.Ipv6Subnet)
+	}
+	ipv6PrefixLength, _ := ipv6Net.Mask.Size()
+	if 128-ipv6PrefixLength < 32-prefixLength {
+		return fmt.Errorf("ipv6 subnet %q is smaller than subnet %q", req.Ipv6Subnet, req.Subnet)
+	}
+	req.Ipv6Subnet = ipv6Net.String()
+
+	if req.Ipv6Gateway == "" {
+		req.Ipv6Gateway = ipv6AddressAtOffset(ipv6Net.IP, 1).String()
+	}
+	ipv6GatewayIp := net.ParseIP(req.Ipv6Gateway)
+	if ipv6GatewayIp == nil {
+		return fmt.Errorf("cannot parse ipv6 gateway %q", req.Ipv6Gateway)
+	}
+	if !ipv6Net.Contains(ipv6GatewayIp) {
+		return fmt.Errorf("ipv6 gateway %q is not contained in ipv6 subnet %q", ipv6GatewayIp, ipv6Net)
+	}
+	req.Ipv6Gateway = ipv6GatewayIp.String()
+
+	// Each reservable address is paired with the IPv6 address at the same offset, which must not be the gateway.
+	subnetIpInt, err := ipconv.IPv4ToInt(net.ParseIP(strings.Split(req.Subnet, "/")[0]))
+	if err != nil {
+		return fmt.Errorf("invalid subnet %q: %w", req.Subnet, err)
+	}
+	for _, address := range req.Address {
+		addressIpInt, err := ipconv.IPv4ToInt(net.ParseIP(address))
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %w", address, err)
+		}
+		if ipv6AddressAtOffset(ipv6Net.IP, addressIpInt-subnetIpInt).Equal(ipv6GatewayIp) {
+			return fmt.Errorf("ipv6 gateway %q is paired with address %q", req.Ipv6Gateway, address)
+		}
+	}
 	return nil
 }
 
+// Returns nil values for IPv4-only subnets so that the columns are stored as null.
+func ipv6SubnetArgs(req *pb.CreateSubnetRequest) []any {
+	if req.Ipv6Subnet == "" {
+		return []any{nil, nil, nil}
+	}
+	_, ipv6Net, _ := net.ParseCIDR(req.Ipv6Subnet)
+	ipv6PrefixLength, _ := ipv6Net.Mask.Size()
+	return []any{req.Ipv6Subnet, ipv6PrefixLength, req.Ipv6Gateway}
+}
+
+// Returns the IPv6 address at the same offset in the IPv6 subnet as the IPv4 address in the IPv4 subnet.
+// Returns an empty string if the subnet is not dual-stack.
+func pairedIPv6Address(subnet *pb.Subnet, address string) (string, error) {
+	if subnet == nil || subnet.Ipv6Subnet == "" {
+		return "", nil
+	}
+	subnetIpInt, err := ipconv.IPv4ToInt(net.ParseIP(subnet.Subnet))
+	if err != nil {
+		return "", fmt.Errorf("invalid subnet %q: %w", subnet.Subnet, err)
+	}
+	addressIpInt, err := ipconv.IPv4ToInt(net.ParseIP(address))
+	if err != nil {
+		return "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	ipv6SubnetIp := net.ParseIP(subnet.Ipv6Subnet)
+	if ipv6SubnetIp == nil {
+		return "", fmt.Errorf("invalid ipv6 subnet %q", subnet.Ipv6Subnet)
+	}
+	return ipv6AddressAtOffset(ipv6SubnetIp, addressIpInt-subnetIpInt).String(), nil
+}
+
+func ipv6AddressAtOffset(ipv6SubnetIp net.IP, offset uint32) net.IP {
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, ipv6SubnetIp.To16())
+	for i := net.IPv6len - 1; i >= 0 && offset > 0; i-- {
+		sum := uint32(ip[i]) + (offset & 0xff)
+		ip[i] = byte(sum)
+		offset = (offset >> 8) + (sum >> 8)
+	}
+	return ip
+}
+
 func validateAndNormalizeDeleteSubnetRequest(req *pb.DeleteSubnetRequest) error {
 	// Validate and normalize subnet and prefix.
 	ipNet, prefixLength, err := normalizeSubnetAndPrefixLength(req.Subnet, int(req.PrefixLength))
@@ -169,6 +169,51 @@ var _ = Describe("IP Resource Manager Unit Tests", func() {
 		Expect(req.Gateway).Should(Equal("172.16.11.1"))
 		Expect(req.Address).Should(Equal([]string{"172.16.11.4", "172.16.11.5", "172.16.11.6"}))
 	})
+
+	It("validateAndNormalizeCreateSubnetRequest with IPv6 subnet should succeed", func() {
+		req := &pb.CreateSubnetRequest{
+			Subnet:     "172.16.11.0/29",
+			Ipv6Subnet: "fd00:0:0:11:0::/64",
+		}
+		Expect(validateAndNormalizeCreateSubnetRequest(req)).Should(Succeed())
+		Expect(req.Ipv6Subnet).Should(Equal("fd00:0:0:11::/64"))
+		Expect(req.Ipv6Gateway).Should(Equal("fd00:0:0:11::1"))
+	})
+
+	It("validateAndNormalizeCreateSubnetRequest with invalid IPv6 subnet should fail", func() {
+		for _, ipv6Subnet := range []string{"172.16.12.0/24", "fd00:0:0:11::1/64", "fd00:0:0:11::/126"} {
+			req := &pb.CreateSubnetRequest{
+				Subnet:     "172.16.11.0/24",
+				Ipv6Subnet: ipv6Subnet,
+			}
+			Expect(validateAndNormalizeCreateSubnetRequest(req)).ShouldNot(Succeed(), ipv6Subnet)
+		}
+	})
+
+	It("validateAndNormalizeCreateSubnetRequest with IPv6 gateway paired with an address should fail", func() {
+		req := &pb.CreateSubnetRequest{
+			Subnet:      "172.16.11.0/29",
+			Ipv6Subnet:  "fd00:0:0:11::/64",
+			Ipv6Gateway: "fd00:0:0:11::5",
+		}
+		Expect(validateAndNormalizeCreateSubnetRequest(req)).ShouldNot(Succeed())
+
+		req = &pb.CreateSubnetRequest{
+			Subnet:      "172.16.11.0/29",
+			Ipv6Subnet:  "fd00:0:0:11::/64",
+			Ipv6Gateway: "fd00:0:0:11::2",
+		}
+		Expect(validateAndNormalizeCreateSubnetRequest(req)).Should(Succeed())
+	})
+
+	It("pairedIPv6Address should return the address at the same offset", func() {
+		subnet := &pb.Subnet{
+			Subnet:     "172.16.0.0",
+			Ipv6Subnet: "fd00:0:0:11::",
+		}
+		Expect(pairedIPv6Address(subnet, "172.16.1.4")).Should(Equal("fd00:0:0:11::104"))
+		Expect(pairedIPv6Address(&pb.Subnet{Subnet: "172.16.0.0"}, "172.16.1.4")).Should(Equal(""))
+	})
 })
 
 var _ = Describe("IP Resource Manager Integration Tests", Serial, func() {
@@ -335,6 +380,29 @@ var _ = Describe("IP Resource Manager Integration Tests", Serial, func() {
 		Expect(status.Code(err)).Should(Equal(codes.NotFound))
 	})
 
+	It("Reserve address in dual-stack subnet should return IPv6 address", func() {
+		By("PutSubnet with IPv6 subnet should succeed")
+		putSubnetReq1 := NewPutSubnetRequest(1, 1)
+		putSubnetReq1.Ipv6Subnet = "fd00:0:0:1::/64"
+		_, err := ipResourceManagerClient.PutSubnet(ctx, putSubnetReq1)
+		Expect(err).Should(Succeed())
+
+		By("ReserveSubnet should return IPv6 subnet")
+		reserveSubnetReq := NewReserveSubnetRequest(putSubnetReq1)
+		subnet, err := ipResourceManagerClient.ReserveSubnet(ctx, reserveSubnetReq)
+		Expect(err).Should(Succeed())
+		Expect(subnet.Ipv6Subnet).Should(Equal("fd00:0:0:1::"))
+		Expect(subnet.Ipv6PrefixLength).Should(Equal(int32(64)))
+		Expect(subnet.Ipv6Gateway).Should(Equal("fd00:0:0:1::1"))
+
+		By("ReserveAddress should return IPv4 and IPv6 addresses")
+		reserveAddressReq := NewReserveAddressRequest(reserveSubnetReq)
+		reserveAddressResp, err := ipResourceManagerClient.ReserveAddress(ctx, reserveAddressReq)
+		Expect(err).Should(Succeed())
+		Expect(reserveAddressResp.Address).Should(Equal("172.17.0.2"))
+		Expect(reserveAddressResp.Ipv6Address).Should(Equal("fd00:0:0:1::2"))
+	})
+
 	It("Reserve address with 2 subnets should succeed (TWC4728-403)", MustPassRepeatedly(10), func() {
 		By("PutSubnet 1 should succeed")
 		putSubnetReq1 := NewPutSubnetRequest(1, 10)
@@ -307,16 +307,24 @@ func (lb *Service) Search(ctx context.Context, req *pb.LoadBalancerSearchRequest
 			flattenedObject.Add(column, value)
 		}
 
+		pagination, err := protodb.NewPagination(req.PageSize, req.PageToken, "name", "resource_id")
+		if err != nil {
+			return nil, err
+		}
+
 		whereString := flattenedObject.GetWhereString(1)
 
 		query := fmt.Sprintf(`
 			select %s
 			from   loadbalancer
 			where  %s
-			order by name
-		`, lb.sqlTransformer.ColumnsForFromRow(), whereString)
+			and    %s
+			%s
+			%s
+		`, lb.sqlTransformer.ColumnsForFromRow(), whereString,
+			pagination.GetWhereString(len(flattenedObject.Values)+1), pagination.GetOrderByString(), pagination.GetLimitString())
 
-		args := flattenedObject.Values
+		args := append(flattenedObject.Values, pagination.GetValues()...)
 		rows, err := lb.db.QueryContext(ctx, query, args...)
 		if err != nil {
 			return nil, err
@@ -330,8 +338,15 @@ func (lb *Service) Search(ctx context.Context, req *pb.LoadBalancerSearchRequest
 			}
 			items = append(items, item)
 		}
+		items, nextPageToken, err := protodb.NextPage(pagination, items, func(item *pb.LoadBalancer) []string {
+			return []string{item.Metadata.Name, item.Metadata.ResourceId}
+		})
+		if err != nil {
+			return nil, err
+		}
 		resp := &pb.LoadBalancerSearchResponse{
-			Items: items,
+			Items:         items,
+			NextPageToken: nextPageToken,
 		}
 		return resp, nil
 	}()
@@ -352,10 +367,18 @@ func (lb *Service) validateQuotaLimits(ctx context.Context, cloudAccountId strin
 	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("LoadbalancerService.validateQuotaLimits").WithValues(logkeys.CloudAccountId, cloudAccountId).Start()
 	defer span.End()
 	log.Info("validateQuotaLimits", logkeys.CloudAccountId, cloudAccountId)
-	loadbalancers, err := lb.Search(ctx, &pb.LoadBalancerSearchRequest{
-		Metadata: &pb.LoadBalancerMetadataSearch{
-			CloudAccountId: cloudAccountId,
-		},
+	loadbalancers, err := protodb.SearchAllPages(func(pageToken string) ([]*pb.LoadBalancer, string, error) {
+		resp, err := lb.Search(ctx, &pb.LoadBalancerSearchRequest{
+			Metadata: &pb.LoadBalancerMetadataSearch{
+				CloudAccountId: cloudAccountId,
+			},
+			PageSize:  protodb.MaxPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
 	})
 	if err != nil {
 		log.Error(err, "error in search call")
@@ -368,8 +391,8 @@ func (lb *Service) validateQuotaLimits(ctx context.Context, cloudAccountId strin
 	if cloudAccountQuota, found := lb.cfg.CloudAccountQuota.CloudAccountIDQuotas[cloudAccountId]; found {
 		allowedQuota = cloudAccountQuota.LoadbalancerQuota
 	}
-	log.Info("validateQuotaLimits", logkeys.AllowedQuota, allowedQuota, logkeys.CurrentLoadBalancerCount, len(loadbalancers.Items))
-	if allowedQuota <= len(loadbalancers.Items) {
+	log.Info("validateQuotaLimits", logkeys.AllowedQuota, allowedQuota, logkeys.CurrentLoadBalancerCount, len(loadbalancers))
+	if allowedQuota <= len(loadbalancers) {
 		return status.Error(codes.OutOfRange, "Your account has reached the maximum allowed load balancer limit")
 	}
 
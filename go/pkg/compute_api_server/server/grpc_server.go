@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/custom_machine_image"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/db"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/instance"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/instance_group"
@@ -30,14 +31,19 @@ import (
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 type GrpcService struct {
-	ManagedDb                          *manageddb.ManagedDb
-	VmInstanceSchedulingService        pb.InstanceSchedulingServiceClient
-	BillingDeactivateInstancesService  pb.BillingDeactivateInstancesServiceClient
-	InstanceService                    *instance.InstanceService
-	LoadBalancerService                *loadbalancer.Service
+	ManagedDb                         *manageddb.ManagedDb
+	VmInstanceSchedulingService       pb.InstanceSchedulingServiceClient
+	BillingDeactivateInstancesService pb.BillingDeactivateInstancesServiceClient
+	InstanceService                   *instance.InstanceService
+	LoadBalancerService               *loadbalancer.Service
+	// Stores the secret keys of the object users used to import custom machine images.
+	// If nil, it is created for the configured namespace when object storage is available.
+	CustomMachineImageSecrets          corev1client.SecretInterface
 	listener                           net.Listener
 	grpcServer                         *grpc.Server
 	db                                 *sql.DB
@@ -158,6 +164,37 @@ func (s *GrpcService) DeleteInstanceRecords(ctx context.Context, currentTime tim
 	return rowsAffected, nil
 }
 
+// defaultQuotaUsageReconcileInterval is used when quotaUsageReconcileInterval is not set.
+const defaultQuotaUsageReconcileInterval = 10 * time.Minute
+
+func (s *GrpcService) quotaUsageReconcileThread(ctx context.Context) {
+	log := log.FromContext(ctx).WithName("GrpcService.quotaUsageReconcileThread")
+
+	// Loop through periodically for every 'QuotaUsageReconcileInterval' of time to correct the quota usage of the cloud accounts
+	interval := s.cfg.QuotaUsageReconcileInterval
+	if interval == 0 {
+		interval = defaultQuotaUsageReconcileInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Reconcile once at startup so that the usage of instances created before the ledger existed is reported without
+	// waiting for the first interval.
+	if err := s.InstanceService.ReconcileQuotaUsage(ctx); err != nil {
+		log.Error(err, "Error occurred when reconciling quota usage")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.InstanceService.ReconcileQuotaUsage(ctx); err != nil {
+				log.Error(err, "Error occurred when reconciling quota usage")
+			}
+		}
+	}
+}
+
 func (s *GrpcService) deleteDeactivatedInstancesThread(ctx context.Context) {
 	log := log.FromContext(ctx).WithName("GrpcService.deleteDeactivatedInstancesThread")
 
@@ -269,9 +306,25 @@ func (s *GrpcService) Start(ctx context.Context) error {
 	go s.instancePurgeThread(ctx)
 	go s.deleteDeactivatedInstancesThread(ctx)
 
+	rateLimiter := grpcutil.NewRateLimiter(s.cfg.RateLimit)
+	if s.cfg.RateLimit.Enabled {
+		log.Info("Rate limiting enabled", "shared", s.cfg.RateLimit.Shared)
+		if s.cfg.RateLimit.Shared {
+			rateLimiter.SetStore(grpcutil.NewPostgresRateLimitStore(db))
+		}
+		if s.cloudAccountServiceClient != nil {
+			rateLimiter.SetAccountTypeFunc(grpcutil.CloudAccountTypeFunc(s.cloudAccountServiceClient))
+		}
+	}
+
+	idempotencyInterceptor := grpcutil.NewIdempotencyInterceptor(s.cfg.Idempotency)
+	if s.cfg.Idempotency.Enabled && s.cfg.Idempotency.Shared {
+		idempotencyInterceptor.SetStore(grpcutil.NewPostgresIdempotencyStore(db))
+	}
+
 	serverOptions := []grpc.ServerOption{
-		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), rateLimiter.UnaryServerInterceptor(), idempotencyInterceptor.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), rateLimiter.StreamServerInterceptor()),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			Time:    120 * time.Second, // The time a connection is kept alive without any activity.
 			Timeout: 20 * time.Second,  // Maximum time the server waits for activity before closing the connection.
@@ -309,6 +362,9 @@ func (s *GrpcService) Start(ctx context.Context) error {
 		return err
 	}
 	s.InstanceService = instanceService
+	if s.qmsClient != nil {
+		go s.quotaUsageReconcileThread(ctx)
+	}
 
 	instanceGroupService, err := instance_group.NewInstanceGroupService(instanceService)
 	if err != nil {
@@ -319,6 +375,23 @@ func (s *GrpcService) Start(ctx context.Context) error {
 		return err
 	}
 	s.LoadBalancerService = loadBalancerService
+	if s.CustomMachineImageSecrets == nil && s.objectStorageServicePrivateClient != nil && s.cfg.CustomMachineImageSecretNamespace != "" {
+		restConfig, err := config.GetKubeRestConfig()
+		if err != nil {
+			return err
+		}
+		clientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return err
+		}
+		s.CustomMachineImageSecrets = clientset.CoreV1().Secrets(s.cfg.CustomMachineImageSecretNamespace)
+	}
+	customMachineImageService, err := custom_machine_image.NewCustomMachineImageService(db, instanceService, machineImageService, s.objectStorageServicePrivateClient,
+		s.CustomMachineImageSecrets)
+	if err != nil {
+		return err
+	}
+	instanceService.SetCustomMachineImageGetter(customMachineImageService)
 
 	pb.RegisterSshPublicKeyServiceServer(s.grpcServer, sshPublicKeyService)
 	pb.RegisterInstanceServiceServer(s.grpcServer, instanceService)
@@ -332,6 +405,8 @@ func (s *GrpcService) Start(ctx context.Context) error {
 	pb.RegisterInstanceGroupPrivateServiceServer(s.grpcServer, instanceGroupService)
 	pb.RegisterLoadBalancerServiceServer(s.grpcServer, loadBalancerService)
 	pb.RegisterLoadBalancerPrivateServiceServer(s.grpcServer, loadBalancerService)
+	pb.RegisterCustomMachineImageServiceServer(s.grpcServer, customMachineImageService)
+	pb.RegisterCustomMachineImagePrivateServiceServer(s.grpcServer, customMachineImageService)
 	reflection.Register(s.grpcServer)
 	log.Info("Service running")
 	s.errc = make(chan error, 1)
@@ -20,6 +20,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/lib/pq"
@@ -298,14 +299,21 @@ func (s *SshPublicKeyService) Search(ctx context.Context, req *pb.SshPublicKeySe
 		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
 			return nil, err
 		}
+		pagination, err := protodb.NewPagination(req.PageSize, req.PageToken, "name", "resource_id")
+		if err != nil {
+			return nil, err
+		}
 
-		query := `
+		query := fmt.Sprintf(`
 			select cloud_account_id, resource_id, name, ssh_public_key, creation_timestamp, owner_email
 			from   ssh_public_key
 			where  cloud_account_id = $1
-			order by name
-		`
-		rows, err := s.db.QueryContext(ctx, query, cloudAccountId)
+			and    %s
+			%s
+			%s
+		`, pagination.GetWhereString(2), pagination.GetOrderByString(), pagination.GetLimitString())
+		args := append([]any{cloudAccountId}, pagination.GetValues()...)
+		rows, err := s.db.QueryContext(ctx, query, args...)
 		if err != nil {
 			return nil, err
 		}
@@ -328,8 +336,15 @@ func (s *SshPublicKeyService) Search(ctx context.Context, req *pb.SshPublicKeySe
 
 			items = append(items, item)
 		}
+		items, nextPageToken, err := protodb.NextPage(pagination, items, func(item *pb.SshPublicKey) []string {
+			return []string{item.Metadata.Name, item.Metadata.ResourceId}
+		})
+		if err != nil {
+			return nil, err
+		}
 		resp := &pb.SshPublicKeySearchResponse{
-			Items: items,
+			Items:         items,
+			NextPageToken: nextPageToken,
 		}
 		return resp, nil
 	}()
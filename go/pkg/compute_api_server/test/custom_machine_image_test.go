@@ -0,0 +1,316 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Custom machine images", func() {
+	const (
+		pubKey    = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDw1ndv4Qh9qChFMPCA6WPBpxHbcDt3LvmMKdpnqRRaE user1@example.org"
+		clusterId = "cluster1"
+		gibibyte  = uint64(1024 * 1024 * 1024)
+	)
+
+	ctx := context.Background()
+	sha256Sum := strings.Repeat("0123456789abcdef", 4)
+
+	importImage := func(cloudAccountId string, name string) (*pb.CustomMachineImage, error) {
+		return getCustomMachineImageGrpcClient().Import(ctx, &pb.CustomMachineImageImportRequest{
+			Metadata: &pb.ResourceMetadataCreate{
+				CloudAccountId: cloudAccountId,
+				Name:           name,
+			},
+			Spec: &pb.CustomMachineImageSpecCreate{
+				DisplayName: "My Image",
+				UserName:    "ubuntu",
+			},
+			Source: &pb.CustomMachineImageObjectStorageSource{
+				BucketName: cloudAccountId + "-images",
+				ObjectKey:  "images/my-image.qcow2",
+				Format:     pb.CustomMachineImageFormat_CustomMachineImageFormatQcow2,
+				Sha256Sum:  sha256Sum,
+			},
+		})
+	}
+
+	searchPrivate := func(clusterId string, resourceId string) *pb.CustomMachineImagePrivate {
+		resp, err := getCustomMachineImagePrivateGrpcClient().SearchPrivate(ctx, &pb.CustomMachineImageSearchPrivateRequest{
+			ClusterId: clusterId,
+		})
+		Expect(err).Should(Succeed())
+		for _, item := range resp.Items {
+			if item.Metadata.ResourceId == resourceId {
+				return item
+			}
+		}
+		return nil
+	}
+
+	updateStatus := func(image *pb.CustomMachineImagePrivate, status *pb.CustomMachineImageStatusPrivate) error {
+		_, err := getCustomMachineImagePrivateGrpcClient().UpdateStatus(ctx, &pb.CustomMachineImageUpdateStatusRequest{
+			Metadata: &pb.CustomMachineImageIdReference{
+				CloudAccountId:  image.Metadata.CloudAccountId,
+				ResourceId:      image.Metadata.ResourceId,
+				ResourceVersion: image.Metadata.ResourceVersion,
+			},
+			Status: status,
+		})
+		return err
+	}
+
+	createInstance := func(cloudAccountId string, instanceType string, machineImage string) (*pb.InstancePrivate, error) {
+		sshPublicKeyName := "name1-" + uuid.NewString()
+		createSshPublicKey(cloudAccountId, sshPublicKeyName, pubKey)
+		vNet := CreateVNet(ctx, cloudAccountId)
+		return getInstanceGrpcClient().CreatePrivate(ctx, &pb.InstanceCreatePrivateRequest{
+			Metadata: &pb.InstanceMetadataCreatePrivate{
+				CloudAccountId: cloudAccountId,
+				SkipQuotaCheck: true,
+			},
+			Spec: &pb.InstanceSpecPrivate{
+				AvailabilityZone:  "us-dev-1a",
+				InstanceType:      instanceType,
+				MachineImage:      machineImage,
+				SshPublicKeyNames: []string{sshPublicKeyName},
+				Interfaces:        []*pb.NetworkInterfacePrivate{{VNet: vNet}},
+			},
+		})
+	}
+
+	It("Imported image should be claimed by a cluster and used to launch instances", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+		name := "my-image-" + uuid.NewString()[:8]
+		instanceType := CreateInstanceType(ctx, "")
+
+		By("Importing an image")
+		image, err := importImage(cloudAccountId, name)
+		Expect(err).Should(Succeed())
+		Expect(image.Metadata.Name).Should(Equal(name))
+		Expect(image.Spec.GetObjectStorage().GetSha256Sum()).Should(Equal(sha256Sum))
+		Expect(image.Status.Phase).Should(Equal(pb.CustomMachineImagePhase_CustomMachineImagePending))
+		resourceId := image.Metadata.ResourceId
+
+		By("Any cluster should find the unassigned image with object storage credentials")
+		imagePrivate := searchPrivate("cluster2", resourceId)
+		Expect(imagePrivate).ShouldNot(BeNil())
+		imagePrivate = searchPrivate(clusterId, resourceId)
+		Expect(imagePrivate).ShouldNot(BeNil())
+		Expect(imagePrivate.Spec.StorageName).Should(Equal("cmi-" + resourceId))
+		Expect(imagePrivate.Spec.ObjectStorageAccess.AccessKey).ShouldNot(BeEmpty())
+		Expect(imagePrivate.Spec.ObjectStorageAccess.SecretKey).Should(Equal("secretKey1"))
+		Expect(imagePrivate.Spec.ObjectStorageAccess.Endpoint).ShouldNot(BeEmpty())
+
+		By("The secret key should be stored in a Kubernetes secret instead of the database")
+		var value string
+		Expect(sqlDb.QueryRowContext(ctx, `select value from custom_machine_image where resource_id = $1`, resourceId).Scan(&value)).Should(Succeed())
+		Expect(value).ShouldNot(ContainSubstring("secretKey1"))
+		_, err = customMachineImageSecrets.Get(ctx, imagePrivate.Spec.ObjectStorageAccess.ObjectUserName, metav1.GetOptions{})
+		Expect(err).Should(Succeed())
+
+		By("Launching an instance before the image is ready should fail")
+		_, err = createInstance(cloudAccountId, instanceType, name)
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+		By("Claiming the image")
+		Expect(updateStatus(imagePrivate, &pb.CustomMachineImageStatusPrivate{
+			Phase:     pb.CustomMachineImagePhase_CustomMachineImageCreating,
+			ClusterId: clusterId,
+		})).Should(Succeed())
+
+		By("Claiming the image with a stale resource version should fail")
+		err = updateStatus(imagePrivate, &pb.CustomMachineImageStatusPrivate{
+			Phase:     pb.CustomMachineImagePhase_CustomMachineImageCreating,
+			ClusterId: "cluster2",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+		By("Other clusters should not find the claimed image")
+		Expect(searchPrivate("cluster2", resourceId)).Should(BeNil())
+		imagePrivate = searchPrivate(clusterId, resourceId)
+		Expect(imagePrivate).ShouldNot(BeNil())
+
+		By("Another cluster should not be able to update the claimed image")
+		err = updateStatus(imagePrivate, &pb.CustomMachineImageStatusPrivate{
+			Phase:     pb.CustomMachineImagePhase_CustomMachineImageCreating,
+			ClusterId: "cluster2",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+		By("Marking the image as ready")
+		Expect(updateStatus(imagePrivate, &pb.CustomMachineImageStatusPrivate{
+			Phase:            pb.CustomMachineImagePhase_CustomMachineImageReady,
+			Sha256Sum:        sha256Sum,
+			VirtualSizeBytes: 10 * gibibyte,
+			ClusterId:        clusterId,
+		})).Should(Succeed())
+		image, err = getCustomMachineImageGrpcClient().Get(ctx, &pb.CustomMachineImageGetRequest{
+			Metadata: &pb.ResourceMetadataReference{
+				CloudAccountId: cloudAccountId,
+				NameOrId:       &pb.ResourceMetadataReference_Name{Name: name},
+			},
+		})
+		Expect(err).Should(Succeed())
+		Expect(image.Status.Phase).Should(Equal(pb.CustomMachineImagePhase_CustomMachineImageReady))
+		Expect(image.Status.VirtualSizeBytes).Should(Equal(10 * gibibyte))
+
+		By("Object storage credentials should be removed when the image is ready")
+		imagePrivate = searchPrivate(clusterId, resourceId)
+		Expect(imagePrivate.Spec.ObjectStorageAccess.AccessKey).Should(BeEmpty())
+		Expect(imagePrivate.Spec.ObjectStorageAccess.SecretKey).Should(BeEmpty())
+		_, err = customMachineImageSecrets.Get(ctx, imagePrivate.Spec.ObjectStorageAccess.ObjectUserName, metav1.GetOptions{})
+		Expect(k8serrors.IsNotFound(err)).Should(BeTrue())
+
+		By("Launching an instance from the image")
+		instance, err := createInstance(cloudAccountId, instanceType, name)
+		Expect(err).Should(Succeed())
+		Expect(instance.Spec.ClusterId).Should(Equal(clusterId))
+		Expect(instance.Spec.MachineImageSpec.StorageName).Should(Equal("cmi-" + resourceId))
+		Expect(instance.Spec.MachineImageSpec.UserName).Should(Equal("ubuntu"))
+
+		By("The image should not be visible to other cloud accounts")
+		_, err = createInstance(cloudaccount.MustNewId(), instanceType, name)
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		By("Deleting the image")
+		_, err = getCustomMachineImageGrpcClient().Delete(ctx, &pb.CustomMachineImageDeleteRequest{
+			Metadata: &pb.ResourceMetadataReference{
+				CloudAccountId: cloudAccountId,
+				NameOrId:       &pb.ResourceMetadataReference_ResourceId{ResourceId: resourceId},
+			},
+		})
+		Expect(err).Should(Succeed())
+		image, err = getCustomMachineImageGrpcClient().Get(ctx, &pb.CustomMachineImageGetRequest{
+			Metadata: &pb.ResourceMetadataReference{
+				CloudAccountId: cloudAccountId,
+				NameOrId:       &pb.ResourceMetadataReference_ResourceId{ResourceId: resourceId},
+			},
+		})
+		Expect(err).Should(Succeed())
+		Expect(image.Status.Phase).Should(Equal(pb.CustomMachineImagePhase_CustomMachineImageDeleting))
+
+		By("Removing the finalizer")
+		_, err = getCustomMachineImagePrivateGrpcClient().RemoveFinalizer(ctx, &pb.CustomMachineImageRemoveFinalizerRequest{
+			Metadata: &pb.CustomMachineImageIdReference{
+				CloudAccountId: cloudAccountId,
+				ResourceId:     resourceId,
+			},
+		})
+		Expect(err).Should(Succeed())
+		_, err = getCustomMachineImageGrpcClient().Get(ctx, &pb.CustomMachineImageGetRequest{
+			Metadata: &pb.ResourceMetadataReference{
+				CloudAccountId: cloudAccountId,
+				NameOrId:       &pb.ResourceMetadataReference_ResourceId{ResourceId: resourceId},
+			},
+		})
+		Expect(status.Code(err)).Should(Equal(codes.NotFound))
+	})
+
+	It("Instance disk must be at least as large as the image", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+		name := "my-image-" + uuid.NewString()[:8]
+		instanceType := CreateInstanceType(ctx, "")
+
+		image, err := importImage(cloudAccountId, name)
+		Expect(err).Should(Succeed())
+		imagePrivate := searchPrivate(clusterId, image.Metadata.ResourceId)
+		Expect(updateStatus(imagePrivate, &pb.CustomMachineImageStatusPrivate{
+			Phase:            pb.CustomMachineImagePhase_CustomMachineImageReady,
+			VirtualSizeBytes: 100 * gibibyte,
+			ClusterId:        clusterId,
+		})).Should(Succeed())
+
+		_, err = createInstance(cloudAccountId, instanceType, name)
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("Import should reject invalid requests", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+
+		By("Using an invalid checksum")
+		_, err := getCustomMachineImageGrpcClient().Import(ctx, &pb.CustomMachineImageImportRequest{
+			Metadata: &pb.ResourceMetadataCreate{
+				CloudAccountId: cloudAccountId,
+				Name:           "my-image",
+			},
+			Source: &pb.CustomMachineImageObjectStorageSource{
+				BucketName: cloudAccountId + "-images",
+				ObjectKey:  "images/my-image.qcow2",
+				Sha256Sum:  "abc",
+			},
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		By("Using the name of a global machine image")
+		globalMachineImageName := CreateVmMachineImage(ctx)
+		_, err = importImage(cloudAccountId, globalMachineImageName)
+		Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+
+		By("Using a duplicate name")
+		name := "my-image-" + uuid.NewString()[:8]
+		_, err = importImage(cloudAccountId, name)
+		Expect(err).Should(Succeed())
+		_, err = importImage(cloudAccountId, name)
+		Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+	})
+
+	It("Capture should require a stopped virtual machine instance", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+		instanceType := CreateInstanceType(ctx, "")
+		machineImage := CreateVmMachineImage(ctx)
+		instance, err := createInstance(cloudAccountId, instanceType, machineImage)
+		Expect(err).Should(Succeed())
+
+		capture := func() (*pb.CustomMachineImage, error) {
+			return getCustomMachineImageGrpcClient().Capture(ctx, &pb.CustomMachineImageCaptureRequest{
+				Metadata: &pb.ResourceMetadataCreate{
+					CloudAccountId: cloudAccountId,
+					Name:           "captured-" + uuid.NewString()[:8],
+				},
+				Source: &pb.CustomMachineImageCaptureSource{
+					InstanceName: instance.Metadata.Name,
+				},
+			})
+		}
+
+		By("Capturing a running instance should fail")
+		_, err = capture()
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+		By("Capturing a stopped instance")
+		// The VM operator reports Stopped once the instance is halted by its run strategy.
+		_, err = getInstanceGrpcClient().UpdateStatus(ctx, &pb.InstanceUpdateStatusRequest{
+			Metadata: &pb.InstanceIdReference{
+				CloudAccountId: cloudAccountId,
+				ResourceId:     instance.Metadata.ResourceId,
+			},
+			Status: &pb.InstanceStatusPrivate{
+				Phase: pb.InstancePhase_Stopped,
+			},
+		})
+		Expect(err).Should(Succeed())
+		image, err := capture()
+		Expect(err).Should(Succeed())
+		Expect(image.Spec.GetCapture().InstanceName).Should(Equal(instance.Metadata.Name))
+		Expect(image.Spec.GetCapture().InstanceResourceId).Should(Equal(instance.Metadata.ResourceId))
+
+		By("The image should be assigned to the cluster of the instance")
+		Expect(searchPrivate("cluster2", image.Metadata.ResourceId)).Should(BeNil())
+		imagePrivate := searchPrivate(instance.Spec.ClusterId, image.Metadata.ResourceId)
+		Expect(imagePrivate).ShouldNot(BeNil())
+		Expect(imagePrivate.Status.ClusterId).Should(Equal(instance.Spec.ClusterId))
+		Expect(imagePrivate.Spec.ObjectStorageAccess).Should(BeNil())
+	})
+})
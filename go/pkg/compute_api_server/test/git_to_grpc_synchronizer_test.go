@@ -5,10 +5,14 @@ package test
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"os"
+	"strings"
+	"testing/fstest"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/instance_type"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/machine_image"
+	gittogrpcsynchronizer "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/git_to_grpc_synchronizer"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -46,6 +50,70 @@ var _ = Describe("GitToGrpcSynchronizer MachineImage Tests", Serial, func() {
 		runSync("MachineImage2-delete")
 		runSync("MachineImage3-update")
 	})
+
+	newSynchronizer := func(fsys fs.FS) *gittogrpcsynchronizer.GitToGrpcSynchronizer {
+		clientConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", grpcListenPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).Should(Succeed())
+		synchronizer, err := machine_image.NewMachineImageSynchronizer(fsys, clientConn)
+		Expect(err).Should(Succeed())
+		return synchronizer
+	}
+
+	It("Plan and DetectDrift should not change the server", Serial, func() {
+		By("Synchronizing MachineImage1")
+		_, err := newSynchronizer(os.DirFS("../testdata/MachineImage1")).Synchronize(ctx)
+		Expect(err).Should(Succeed())
+
+		By("Planning MachineImage3-update")
+		plan, err := newSynchronizer(os.DirFS("../testdata/MachineImage3-update")).Plan(ctx)
+		Expect(err).Should(Succeed())
+		Expect(plan.Creates).Should(BeEmpty())
+		Expect(plan.Updates).Should(HaveLen(1))
+		Expect(plan.Updates[0].Key).Should(Equal("machine-image-1"))
+		Expect(plan.Updates[0].Diff).Should(ContainElement(gittogrpcsynchronizer.FieldDiff{
+			Path: "spec.labels.newLabel",
+			File: "NEW VALUE",
+		}))
+		Expect(plan.Deletes).Should(HaveLen(1))
+		Expect(plan.Deletes[0].Key).Should(Equal("ubuntu-2204-jammy-v20221204"))
+
+		By("Detecting drift of MachineImage2-delete")
+		drift, err := newSynchronizer(os.DirFS("../testdata/MachineImage2-delete")).DetectDrift(ctx)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(HaveLen(1))
+		Expect(drift[0].Key).Should(Equal("ubuntu-2204-jammy-v20221204"))
+
+		By("Planning MachineImage1 again should have no change")
+		plan, err = newSynchronizer(os.DirFS("../testdata/MachineImage1")).Plan(ctx)
+		Expect(err).Should(Succeed())
+		Expect(plan.Changed()).Should(BeFalse())
+		Expect(plan.Unmodified).Should(Equal(2))
+	})
+
+	It("Synchronize should abort when more than MaxDeletes messages would be deleted", Serial, func() {
+		By("Synchronizing MachineImage1")
+		_, err := newSynchronizer(os.DirFS("../testdata/MachineImage1")).Synchronize(ctx)
+		Expect(err).Should(Succeed())
+
+		By("Synchronizing a directory without the existing machine images")
+		fileBytes, err := os.ReadFile("../testdata/MachineImage1/machine-image-1.yaml")
+		Expect(err).Should(Succeed())
+		fsys := fstest.MapFS{
+			"machine-image-2.yaml": &fstest.MapFile{
+				Data: []byte(strings.Replace(string(fileBytes), "name: machine-image-1", "name: machine-image-2", 1)),
+			},
+		}
+		synchronizer := newSynchronizer(fsys)
+		synchronizer.MaxDeletes = 1
+		changed, err := synchronizer.Synchronize(ctx)
+		Expect(err).Should(MatchError(gittogrpcsynchronizer.ErrTooManyDeletes))
+		Expect(changed).Should(BeFalse())
+
+		By("Server should be unchanged")
+		plan, err := newSynchronizer(os.DirFS("../testdata/MachineImage1")).Plan(ctx)
+		Expect(err).Should(Succeed())
+		Expect(plan.Changed()).Should(BeFalse())
+	})
 })
 
 var _ = Describe("GitToGrpcSynchronizer InstanceType Tests", Serial, func() {
@@ -0,0 +1,72 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/openapi"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Instance quota usage reconciliation", func() {
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		clearDatabase(ctx)
+	})
+
+	It("Should send the number of instances that are not deleted", Serial, func() {
+		api := openApiClient.InstanceServiceApi
+		cloudAccountId := cloudaccount.MustNewId()
+		sshPublicKeyName := "name1-" + uuid.NewString()
+		pubKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOcD9sOymC7ki0s5DvM1MXwMxa4lFmI3pbhDZoNuTZu7 user1@example.org"
+		availabilityZone := "us-dev-1a"
+		instanceType := CreateInstanceType(ctx, "vm-spr-sml")
+		machineImage := CreateVmMachineImage(ctx)
+		vNet := CreateVNet(ctx, cloudAccountId)
+		createSshPublicKey(cloudAccountId, sshPublicKeyName, pubKey)
+
+		resourceIds := []string{}
+		for i := 0; i < 3; i++ {
+			createResp, _, err := api.InstanceServiceCreate(ctx, cloudAccountId).Body(
+				openapi.InstanceServiceCreateRequest{
+					Metadata: &openapi.InstanceServiceCreateRequestMetadata{},
+					Spec: &openapi.ProtoInstanceSpec{
+						AvailabilityZone:  &availabilityZone,
+						InstanceType:      &instanceType,
+						MachineImage:      &machineImage,
+						SshPublicKeyNames: []string{sshPublicKeyName},
+						Interfaces:        []openapi.ProtoNetworkInterface{{VNet: &vNet}},
+					},
+				}).Execute()
+			Expect(err).Should(Succeed())
+			resourceIds = append(resourceIds, *createResp.Metadata.ResourceId)
+		}
+
+		By("Deleting an instance")
+		_, _, err := api.InstanceServiceDelete(ctx, cloudAccountId, resourceIds[0]).Execute()
+		Expect(err).Should(Succeed())
+
+		By("Reconciling the quota usage")
+		Expect(grpcService.InstanceService.ReconcileQuotaUsage(ctx)).Should(Succeed())
+		quotaUsageReconcileMu.Lock()
+		req := lastQuotaUsageReconcileRequest
+		quotaUsageReconcileMu.Unlock()
+		Expect(req).ShouldNot(BeNil())
+		Expect(req.ServiceName).Should(Equal("compute"))
+		Expect(req.UpdatedBefore).ShouldNot(BeNil())
+		var usage *pb.QuotaUsageCountPrivate
+		for _, u := range req.Usages {
+			if u.CloudAccountId == cloudAccountId && u.ResourceType == instanceType {
+				usage = u
+			}
+		}
+		Expect(usage).ShouldNot(BeNil())
+		Expect(usage.Usage).Should(Equal(int64(2)))
+	})
+})
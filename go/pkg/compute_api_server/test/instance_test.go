@@ -16,7 +16,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 func createSshPublicKey(cloudAccountId string, name string, sshPublicKey string) {
@@ -1199,4 +1201,175 @@ var _ = Describe("GRPC-REST server with OpenAPI client", func() {
 			Expect(instanceResp.Spec.InstanceGroupSize).Should(Equal(int32(instanceCount)))
 		}
 	})
+
+	It("Resize should change the instance type and start a new metering segment", func() {
+		cloudAccountId1 := cloudaccount.MustNewId()
+		labels := make(map[string]string)
+		sshPublicKeyName1 := "name1-" + uuid.New().String()
+		sshPublicKeyNames1 := []string{sshPublicKeyName1}
+		instanceType := CreateInstanceType(ctx, "vm-spr-sml")
+		newInstanceType := CreateInstanceType(ctx, "vm-spr-med")
+		machineImage := CreateVmMachineImage(ctx)
+		vNet := CreateVNet(ctx, cloudAccountId1)
+		grpcClient := getInstanceGrpcClient()
+		instanceClient := getInstanceServiceGrpcClient()
+
+		By("SshPublicKeyServiceCreate")
+		createSshPublicKey(cloudAccountId1, sshPublicKeyName1, pubKey1)
+
+		By("InstanceServiceCreate")
+		createResp := createInstance(cloudAccountId1, runStrategy1Str, sshPublicKeyNames1, instanceType, machineImage, vNet, labels, "", "")
+		resourceId := *createResp.Metadata.ResourceId
+
+		resizeRequest := func(instanceType string) *pb.InstanceResizeRequest {
+			return &pb.InstanceResizeRequest{
+				Metadata: &pb.InstanceMetadataResize{
+					CloudAccountId: cloudAccountId1,
+					NameOrId:       &pb.InstanceMetadataResize_ResourceId{ResourceId: resourceId},
+				},
+				Spec: &pb.InstanceResizeSpec{
+					InstanceType: instanceType,
+				},
+			}
+		}
+
+		By("Resize should fail while the instance is provisioning")
+		_, err := instanceClient.Resize(ctx, resizeRequest(newInstanceType))
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+		By("UpdateStatus")
+		_, err = grpcClient.UpdateStatus(ctx, &pb.InstanceUpdateStatusRequest{
+			Metadata: &pb.InstanceIdReference{
+				CloudAccountId: cloudAccountId1,
+				ResourceId:     resourceId,
+			},
+			Status: &pb.InstanceStatusPrivate{
+				Phase: pb.InstancePhase_Ready,
+			},
+		})
+		Expect(err).Should(Succeed())
+
+		By("Resize should fail when the instance type is unchanged")
+		_, err = instanceClient.Resize(ctx, resizeRequest(instanceType))
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		By("Resize")
+		_, err = instanceClient.Resize(ctx, resizeRequest(newInstanceType))
+		Expect(err).Should(Succeed())
+
+		By("SearchPrivate should return the new instance type and metering segment")
+		searchResp, err := grpcClient.SearchPrivate(ctx, &pb.InstanceSearchPrivateRequest{
+			Metadata: &pb.InstanceMetadataSearch{
+				CloudAccountId: cloudAccountId1,
+			},
+		})
+		Expect(err).Should(Succeed())
+		Expect(searchResp.Items).Should(HaveLen(1))
+		instance := searchResp.Items[0]
+		Expect(instance.Spec.InstanceType).Should(Equal(newInstanceType))
+		Expect(instance.Spec.InstanceTypeSpec.Name).Should(Equal(newInstanceType))
+		Expect(instance.Spec.Metering).ShouldNot(BeNil())
+		Expect(instance.Spec.Metering.ResourceId).ShouldNot(Equal(resourceId))
+		Expect(instance.Spec.Metering.PreviousSegments).Should(HaveLen(1))
+		Expect(instance.Spec.Metering.PreviousSegments[0].ResourceId).Should(Equal(resourceId))
+		Expect(instance.Spec.Metering.PreviousSegments[0].InstanceType).Should(Equal(instanceType))
+		Expect(instance.Spec.Metering.PreviousSegments[0].EndTimestamp.AsTime()).Should(Equal(instance.Spec.Metering.StartTimestamp.AsTime()))
+		secondResourceId := instance.Spec.Metering.ResourceId
+
+		getMetering := func() *pb.InstanceMetering {
+			searchResp, err := grpcClient.SearchPrivate(ctx, &pb.InstanceSearchPrivateRequest{
+				Metadata: &pb.InstanceMetadataSearch{
+					CloudAccountId: cloudAccountId1,
+				},
+			})
+			Expect(err).Should(Succeed())
+			Expect(searchResp.Items).Should(HaveLen(1))
+			return searchResp.Items[0].Spec.Metering
+		}
+
+		By("Resize again before the final metering record of the first segment has been written")
+		_, err = instanceClient.Resize(ctx, resizeRequest(instanceType))
+		Expect(err).Should(Succeed())
+
+		By("SearchPrivate should return both previous metering segments")
+		metering := getMetering()
+		Expect(metering.PreviousSegments).Should(HaveLen(2))
+		Expect(metering.PreviousSegments[0].ResourceId).Should(Equal(resourceId))
+		Expect(metering.PreviousSegments[1].ResourceId).Should(Equal(secondResourceId))
+		Expect(metering.PreviousSegments[1].InstanceType).Should(Equal(newInstanceType))
+		thirdResourceId := metering.ResourceId
+
+		By("UpdateStatus with the first metering segment closed (simulate Metering Monitor)")
+		_, err = grpcClient.UpdateStatus(ctx, &pb.InstanceUpdateStatusRequest{
+			Metadata: &pb.InstanceIdReference{
+				CloudAccountId: cloudAccountId1,
+				ResourceId:     resourceId,
+			},
+			Status: &pb.InstanceStatusPrivate{
+				Phase:                     pb.InstancePhase_Ready,
+				ClosedMeteringResourceIds: []string{resourceId},
+			},
+		})
+		Expect(err).Should(Succeed())
+
+		By("Resize should remove the closed metering segment")
+		_, err = instanceClient.Resize(ctx, resizeRequest(newInstanceType))
+		Expect(err).Should(Succeed())
+		metering = getMetering()
+		Expect(metering.PreviousSegments).Should(HaveLen(2))
+		Expect(metering.PreviousSegments[0].ResourceId).Should(Equal(secondResourceId))
+		Expect(metering.PreviousSegments[1].ResourceId).Should(Equal(thirdResourceId))
+	})
+
+	It("MigratePrivate should move the instance to another node in the same cluster", func() {
+		cloudAccountId1 := cloudaccount.MustNewId()
+		labels := make(map[string]string)
+		sshPublicKeyName1 := "name1-" + uuid.New().String()
+		sshPublicKeyNames1 := []string{sshPublicKeyName1}
+		instanceType := CreateInstanceType(ctx, "vm-spr-sml")
+		machineImage := CreateVmMachineImage(ctx)
+		vNet := CreateVNet(ctx, cloudAccountId1)
+		grpcClient := getInstanceGrpcClient()
+
+		By("SshPublicKeyServiceCreate")
+		createSshPublicKey(cloudAccountId1, sshPublicKeyName1, pubKey1)
+
+		By("InstanceServiceCreate")
+		createResp := createInstance(cloudAccountId1, runStrategy1Str, sshPublicKeyNames1, instanceType, machineImage, vNet, labels, "", "")
+		resourceId := *createResp.Metadata.ResourceId
+
+		migrateRequest := &pb.InstanceMigratePrivateRequest{
+			Metadata: &pb.InstanceIdReference{
+				CloudAccountId: cloudAccountId1,
+				ResourceId:     resourceId,
+			},
+			SourceNodeId: "node1",
+		}
+
+		By("MigratePrivate should fail without a source node")
+		_, err := grpcClient.MigratePrivate(ctx, &pb.InstanceMigratePrivateRequest{Metadata: migrateRequest.Metadata})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		By("MigratePrivate")
+		migrateResp, err := grpcClient.MigratePrivate(ctx, migrateRequest)
+		Expect(err).Should(Succeed())
+		Expect(migrateResp.NodeId).Should(Equal("node1-migrated"))
+
+		By("SearchPrivate should return the new node")
+		searchResp, err := grpcClient.SearchPrivate(ctx, &pb.InstanceSearchPrivateRequest{
+			Metadata: &pb.InstanceMetadataSearch{
+				CloudAccountId: cloudAccountId1,
+			},
+		})
+		Expect(err).Should(Succeed())
+		Expect(searchResp.Items).Should(HaveLen(1))
+		instance := searchResp.Items[0]
+		Expect(instance.Spec.ClusterId).Should(Equal("cluster1"))
+		Expect(instance.Spec.NodeId).Should(Equal("node1-migrated"))
+
+		By("MigratePrivate should return the same node when repeated")
+		migrateResp, err = grpcClient.MigratePrivate(ctx, migrateRequest)
+		Expect(err).Should(Succeed())
+		Expect(migrateResp.NodeId).Should(Equal("node1-migrated"))
+	})
 })
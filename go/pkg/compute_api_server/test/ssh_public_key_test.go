@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -286,6 +287,41 @@ var _ = Describe("GRPC-REST server with OpenAPI client", func() {
 		Expect(len(resp.Items)).Should(Equal(numRows))
 	})
 
+	It("SshPublicKeyServiceSearch should return pages of keys", func() {
+		cloudAccountId1 := cloudaccount.MustNewId()
+		pubKey1 := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQC38LFb8lQmcT6KiDuvPu3N9XPvhE5ShbDtxcNtc1AqdsV7MRH7uxYIXDVd0tM80dgEKwyi3IzbNNILGWUkxhV9A3bEnVqPNG7Up6rHdo72uwK1koY3KIlu6BzBBB8QpDvGWUwP4DU84zwC4UJxHEtFL7qnUHKzfNAq8a1WCvABtUW3eaB1SjKzGfNWYR7X8/JwZUCUtTCAFy7gaFrNL7XXDNUzfBzXvlKiyOSbSxWXa51f66sPmPdPtLyveo+3PeruTTvWpCPXJW5zOmeDwbBx899pbc72f1U/KJt6fdwxMSDXdSbARC7ONhD2MRoHjdbbl5QkZbLxdtm/jq393vNCxSP6s8/RDg3+Xp/u0LMjW78JqjKMkKnwWIrSlABQEihM7AlsEKLHDXMredUhT9uXgdu/XOn5Q7zNAkHhOr10p8DpD2HqNkVfcmBf3HhWx6HWS6i7teJiinuAtlVvRD7Xaw8xZM1wue0V/lNF74dE9NKLxSBBhOAvsojr7kZorQLRoULWz8nMBSNoomrUdVVB+UGFdiYS07exYvHemCEXMDxRXl/72Cfkv+DCtkgACjbI2qk8h+kgzsAe9DUmOfxFO1JSDdOevU7eDGMEIDn5REbdsXztgjjdiokANwDTWyTWyDRJMoUhtK0TKM21PHxoEnW32AcHH7LeeHTJiI9sFQ== user1@example.org"
+		clientConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", grpcListenPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		client := pb.NewSshPublicKeyServiceClient(clientConn)
+
+		By("SshPublicKeyServiceCreate")
+		numRows := 5
+		for i := 0; i < numRows; i++ {
+			_, err := createSshPublicKey(cloudAccountId1, "", pubKey1)
+			Expect(err).Should(Succeed())
+		}
+
+		By("SshPublicKeyServiceSearch with page size")
+		var names []string
+		pageToken := ""
+		for page := 0; page < 3; page++ {
+			resp, err := client.Search(ctx, &pb.SshPublicKeySearchRequest{
+				Metadata:  &pb.ResourceMetadataSearch{CloudAccountId: cloudAccountId1},
+				PageSize:  2,
+				PageToken: pageToken,
+			})
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Items)).Should(BeNumerically("<=", 2))
+			for _, item := range resp.Items {
+				names = append(names, item.Metadata.Name)
+			}
+			pageToken = resp.NextPageToken
+		}
+		Expect(pageToken).Should(BeEmpty())
+		Expect(names).Should(HaveLen(numRows))
+		Expect(sort.StringsAreSorted(names)).Should(BeTrue())
+	})
+
 	It("SshPublicKey with a missing character should fail", func() {
 		cloudAccountId1 := cloudaccount.MustNewId()
 		pubKey1 := "ssh-r AAAAB3NzaC1yc2EAAAADAQABAAACAQC38LFb8lQmcT6KiDuvPu3N9XPvhE5ShbDtxcNtc1AqdsV7MRH7uxYIXDVd0tM80dgEKwyi3IzbNNILGWUkxhV9A3bEnVqPNG7Up6rHdo72uwK1koY3KIlu6BzBBB8QpDvGWUwP4DU84zwC4UJxHEtFL7qnUHKzfNAq8a1WCvABtUW3eaB1SjKzGfNWYR7X8/JwZUCUtTCAFy7gaFrNL7XXDNUzfBzXvlKiyOSbSxWXa51f66sPmPdPtLyveo+3PeruTTvWpCPXJW5zOmeDwbBx899pbc72f1U/KJt6fdwxMSDXdSbARC7ONhD2MRoHjdbbl5QkZbLxdtm/jq393vNCxSP6s8/RDg3+Xp/u0LMjW78JqjKMkKnwWIrSlABQEihM7AlsEKLHDXMredUhT9uXgdu/XOn5Q7zNAkHhOr10p8DpD2HqNkVfcmBf3HhWx6HWS6i7teJiinuAtlVvRD7Xaw8xZM1wue0V/lNF74dE9NKLxSBBhOAvsojr7kZorQLRoULWz8nMBSNoomrUdVVB+UGFdiYS07exYvHemCEXMDxRXl/72Cfkv+DCtkgACjbI2qk8h+kgzsAe9DUmOfxFO1JSDdOevU7eDGMEIDn5REbdsXztgjjdiokANwDTWyTWyDRJMoUhtK0TKM21PHxoEnW32AcHH7LeeHTJiI9sFQ== user1@example.org"
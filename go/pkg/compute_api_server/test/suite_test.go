@@ -11,11 +11,13 @@ import (
 	"io"
 	"net"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	_ "github.com/amacneil/dbmate/pkg/driver/postgres"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/config"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/db"
@@ -30,8 +32,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
@@ -51,6 +57,12 @@ var (
 	instanceTypeName1 string
 	instanceTypeName2 string
 	qmsQuotaMap       map[string]*pb.ServiceQuotaResource
+	// Secrets that hold the object storage credentials used to import custom machine images.
+	customMachineImageSecrets corev1client.SecretInterface
+
+	// The last quota usage reconciliation sent to the QMS mock.
+	quotaUsageReconcileMu          sync.Mutex
+	lastQuotaUsageReconcileRequest *pb.QuotaUsageReconcileRequestPrivate
 )
 
 const (
@@ -160,6 +172,8 @@ var _ = BeforeSuite(func() {
 		},
 	}, managedDb, vmInstanceSchedulingService, billingDeactivateInstancesService, cloudAccountService, cloudAccountAppClientService, objectStoragePrivateService, nil, qmsClient, grpcServerListener)
 	Expect(err).Should(Succeed())
+	customMachineImageSecrets = fake.NewSimpleClientset().CoreV1().Secrets("idcs-system")
+	grpcService.CustomMachineImageSecrets = customMachineImageSecrets
 	Expect(grpcService.Start(ctx)).Should(Succeed())
 
 	By("Starting GRPC-REST gateway")
@@ -208,6 +222,12 @@ func getInstanceGrpcClient() pb.InstancePrivateServiceClient {
 	return pb.NewInstancePrivateServiceClient(clientConn)
 }
 
+func getInstanceServiceGrpcClient() pb.InstanceServiceClient {
+	clientConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", grpcListenPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	Expect(err).NotTo(HaveOccurred())
+	return pb.NewInstanceServiceClient(clientConn)
+}
+
 func getInstanceGroupGrpcClient() pb.InstanceGroupServiceClient {
 	clientConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", grpcListenPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	Expect(err).NotTo(HaveOccurred())
@@ -220,6 +240,18 @@ func getLoadBalancerGrpcClient() pb.LoadBalancerPrivateServiceClient {
 	return pb.NewLoadBalancerPrivateServiceClient(clientConn)
 }
 
+func getCustomMachineImageGrpcClient() pb.CustomMachineImageServiceClient {
+	clientConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", grpcListenPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	Expect(err).NotTo(HaveOccurred())
+	return pb.NewCustomMachineImageServiceClient(clientConn)
+}
+
+func getCustomMachineImagePrivateGrpcClient() pb.CustomMachineImagePrivateServiceClient {
+	clientConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", grpcListenPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	Expect(err).NotTo(HaveOccurred())
+	return pb.NewCustomMachineImagePrivateServiceClient(clientConn)
+}
+
 func clearDatabase(ctx context.Context) {
 	By("Clearing database")
 	db, err := managedDb.Open(ctx)
@@ -235,6 +267,8 @@ func clearDatabase(ctx context.Context) {
 	Expect(err).Should(Succeed())
 	_, err = db.ExecContext(ctx, "delete from loadbalancer")
 	Expect(err).Should(Succeed())
+	_, err = db.ExecContext(ctx, "delete from custom_machine_image")
+	Expect(err).Should(Succeed())
 }
 
 func NewMockInstanceSchedulingServiceClient() pb.InstanceSchedulingServiceClient {
@@ -247,6 +281,16 @@ func NewMockInstanceSchedulingServiceClient() pb.InstanceSchedulingServiceClient
 		if len(req.Instances) == 0 {
 			return scheduleResponse, nil
 		}
+		if req.Migrate {
+			// Move each instance to another node in the same cluster.
+			for i, instance := range req.Instances {
+				instanceResults[i] = &pb.ScheduleInstanceResult{
+					ClusterId: instance.Spec.ClusterId,
+					NodeId:    instance.Spec.NodeId + "-migrated",
+				}
+			}
+			return &pb.ScheduleResponse{InstanceResults: instanceResults}, nil
+		}
 		if len(req.Instances) == 1 && req.Instances[0].Spec.ClusterGroupId == "" {
 			scheduleResponse = &pb.ScheduleResponse{
 				InstanceResults: []*pb.ScheduleInstanceResult{
@@ -343,6 +387,28 @@ func NewMockObjectStorageServicePrivateClient() pb.ObjectStorageServicePrivateCl
 
 	objectStorageServicePrivateClient.EXPECT().AddBucketSubnet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	objectStorageServicePrivateClient.EXPECT().RemoveBucketSubnet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	objectStorageServicePrivateClient.EXPECT().CreateObjectUserPrivate(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *pb.CreateObjectUserPrivateRequest, opts ...grpc.CallOption) (*pb.ObjectUserPrivate, error) {
+		return &pb.ObjectUserPrivate{
+			Metadata: &pb.ObjectUserMetadataPrivate{
+				CloudAccountId: req.Metadata.CloudAccountId,
+				Name:           req.Metadata.Name,
+			},
+			Spec: req.Spec,
+			Status: &pb.ObjectUserStatusPrivate{
+				Phase: pb.ObjectUserPhase_ObjectUserReady,
+				Principal: &pb.AccessPrincipalPrivate{
+					Cluster: &pb.ObjectCluster{
+						AccessEndpoint: "https://objectstore.example.com:9000",
+					},
+					Credentials: &pb.ObjectAccessCredentials{
+						AccessKey: "accessKey1",
+						SecretKey: "secretKey1",
+					},
+				},
+			},
+		}, nil
+	}).AnyTimes()
+	objectStorageServicePrivateClient.EXPECT().DeleteObjectUserPrivate(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
 	return objectStorageServicePrivateClient
 }
 
@@ -471,6 +537,43 @@ func NewMockQuotaManagementPrivateServiceClient() pb.QuotaManagementPrivateServi
 				},
 			}, nil
 		}).AnyTimes()
+	// Reservations are checked against the current usage given by the compute api server, like the first reservation
+	// of an instance type in the usage ledger of QMS.
+	client.EXPECT().
+		ReserveQuotaPrivate(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *pb.QuotaReservationRequestPrivate, opts ...grpc.CallOption) (*pb.QuotaReservationPrivate, error) {
+			if req == nil {
+				return nil, fmt.Errorf("request is nil")
+			}
+
+			limits := qmsQuotaMap[req.ResourceType].GetQuotaConfig().GetLimits()
+			if req.CurrentUsage+req.Quantity > limits {
+				return nil, status.Errorf(codes.ResourceExhausted, "quota limit of %d %s exceeded", limits, req.ResourceType)
+			}
+			return &pb.QuotaReservationPrivate{
+				ReservationId:  uuid.NewString(),
+				ServiceName:    req.ServiceName,
+				ResourceType:   req.ResourceType,
+				CloudAccountId: req.CloudAccountId,
+				Quantity:       req.Quantity,
+				Limits:         limits,
+				Usage:          req.CurrentUsage,
+			}, nil
+		}).AnyTimes()
+	client.EXPECT().
+		CommitQuotaReservationPrivate(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&pb.QuotaReservationPrivate{}, nil).AnyTimes()
+	client.EXPECT().
+		ReleaseQuotaPrivate(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&emptypb.Empty{}, nil).AnyTimes()
+	client.EXPECT().
+		ReconcileQuotaUsagePrivate(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *pb.QuotaUsageReconcileRequestPrivate, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			quotaUsageReconcileMu.Lock()
+			defer quotaUsageReconcileMu.Unlock()
+			lastQuotaUsageReconcileRequest = req
+			return &emptypb.Empty{}, nil
+		}).AnyTimes()
 
 	return client
 }
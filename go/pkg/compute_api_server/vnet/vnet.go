@@ -425,6 +425,9 @@ func (s *VNetService) ReserveSubnet(ctx context.Context, req *pb.VNetReserveSubn
 		vNetPrivate.Spec.VlanId = subnet.VlanId
 		vNetPrivate.Spec.VlanDomain = subnet.VlanDomain
 		vNetPrivate.Spec.AddressSpace = subnet.AddressSpace
+		vNetPrivate.Spec.Ipv6Subnet = subnet.Ipv6Subnet
+		vNetPrivate.Spec.Ipv6PrefixLength = subnet.Ipv6PrefixLength
+		vNetPrivate.Spec.Ipv6Gateway = subnet.Ipv6Gateway
 		if s.objectStorageServicePrivate != nil {
 			_, err = s.objectStorageServicePrivate.AddBucketSubnet(ctx, vNetPrivate)
 			if err != nil {
@@ -502,7 +505,8 @@ func (s *VNetService) ReserveAddress(ctx context.Context, req *pb.VNetReserveAdd
 			return nil, err
 		}
 		resp := &pb.VNetReserveAddressResponse{
-			Address: address.Address,
+			Address:     address.Address,
+			Ipv6Address: address.Ipv6Address,
 		}
 		return resp, nil
 	}()
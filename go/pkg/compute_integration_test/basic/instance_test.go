@@ -333,7 +333,7 @@ func (e *BasicTestEnv) Start() {
 	Expect(err).Should(Succeed())
 
 	By("Creating instance operator")
-	_, err = vminstancecontroller.NewVmInstanceReconciler(ctx, k8sManager, vNetPrivateClient, vNetClient, vmInstanceOperatorConfig)
+	_, err = vminstancecontroller.NewVmInstanceReconciler(ctx, k8sManager, vNetPrivateClient, vNetClient, instanceClient, vmInstanceOperatorConfig)
 	Expect(err).Should(Succeed())
 
 	By("Creating SshProxy operator")
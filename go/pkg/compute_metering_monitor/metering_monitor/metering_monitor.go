@@ -21,6 +21,7 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/strings/slices"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -61,6 +62,7 @@ func NewMeteringMonitor(ctx context.Context, mgr ctrl.Manager, grpcClient pb.Met
 }
 
 //+kubebuilder:rbac:groups=private.cloud.intel.com,resources=instances,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=private.cloud.intel.com,resources=instances/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=private.cloud.intel.com,resources=instances/finalizers,verbs=update
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -129,26 +131,33 @@ func (r *MeteringMonitor) processInstance(ctx context.Context, instance *cloudv1
 	failedCond := FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionFailed)
 	failed := failedCond != nil && failedCond.Status == k8sv1.ConditionTrue
 
-	var runningTime time.Duration
 	if startupComplete {
 		log.V(1).Info("Begin creating metering record once the instance starts running and has completed running startup scripts")
 
 		firstReadyTimestamp := startupCompleteCond.LastTransitionTime
+		endTimestamp := metav1.NewTime(timestamppb.Now().AsTime())
 		if failed {
-			runningTime = failedCond.LastTransitionTime.Sub(firstReadyTimestamp.Time)
-		} else {
-			runningTime = timestamppb.Now().AsTime().Sub(firstReadyTimestamp.Time)
+			endTimestamp = failedCond.LastTransitionTime
 		}
 
-		// runningTime may be negative in the case when the clock gets adjusted. If runningTime is negative,
-		// this should return immediately without an error i.e no need to requeue it right away
-		if runningTime < 0 {
-			return reconcile.Result{}, nil
+		var closedResourceIds []string
+		for _, segment := range GetMeteringSegments(instance, firstReadyTimestamp, endTimestamp, false) {
+			// runningTime may be negative in the case when the clock gets adjusted. If runningTime is negative,
+			// this should not create a record i.e no need to requeue it right away
+			if segment.RunningTime() < 0 {
+				continue
+			}
+			err := r.CreateRecordInDB(ctx, instance, segment)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed to create the metering record: %w", err)
+			}
+			// The record of a previous segment is its final record.
+			if segment.Deleted {
+				closedResourceIds = append(closedResourceIds, segment.ResourceId)
+			}
 		}
-
-		err := r.CreateRecordInDB(ctx, instance, runningTime, firstReadyTimestamp, false)
-		if err != nil {
-			return reconcile.Result{}, fmt.Errorf("failed to create the metering record: %w", err)
+		if err := r.closeMeteringSegments(ctx, instance, closedResourceIds); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to close the metering segments: %w", err)
 		}
 
 		log.V(1).Info("Finished creating metering record after the instance starts running and has completed running startup scripts")
@@ -161,21 +170,22 @@ func (r *MeteringMonitor) processDeleteInstance(ctx context.Context, instance *c
 	log := log.FromContext(ctx).WithName("MeteringMonitor.processDeleteInstance")
 	log.V(1).Info("Begin creating metering record before instance deletion")
 
-	var runningTime time.Duration
 	startupCompleteCond := FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionStartupComplete)
 	failedCond := FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionFailed)
 	failed := failedCond != nil && failedCond.Status == k8sv1.ConditionTrue
 	firstReadyTimestamp := startupCompleteCond.LastTransitionTime
+	endTimestamp := *instance.DeletionTimestamp
 	if failed {
-		runningTime = failedCond.LastTransitionTime.Sub(firstReadyTimestamp.Time)
-	} else {
-		runningTime = instance.DeletionTimestamp.Time.Sub(firstReadyTimestamp.Time)
+		endTimestamp = failedCond.LastTransitionTime
 	}
 
-	// runningTime may be negative in the case when the clock gets adjusted. If runningTime is negative,
-	// this should not create a record
-	if runningTime >= 0 {
-		err := r.CreateRecordInDB(ctx, instance, runningTime, firstReadyTimestamp, true)
+	for _, segment := range GetMeteringSegments(instance, firstReadyTimestamp, endTimestamp, true) {
+		// runningTime may be negative in the case when the clock gets adjusted. If runningTime is negative,
+		// this should not create a record
+		if segment.RunningTime() < 0 {
+			continue
+		}
+		err := r.CreateRecordInDB(ctx, instance, segment)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to create the metering record before deletion: %w", err)
 		}
@@ -191,30 +201,119 @@ func (r *MeteringMonitor) processDeleteInstance(ctx context.Context, instance *c
 	return reconcile.Result{}, nil
 }
 
-func (r *MeteringMonitor) CreateRecordInDB(ctx context.Context, instance *cloudv1alpha1.Instance, runningTime time.Duration, firstReadyTimestamp metav1.Time, isDeleted bool) error {
+// A period of time during which an instance is metered with the same resourceId and instance type.
+type MeteringSegment struct {
+	ResourceId     string
+	InstanceType   string
+	StartTimestamp metav1.Time
+	EndTimestamp   metav1.Time
+	// True if the instance will no longer be metered with this resourceId.
+	Deleted bool
+}
+
+func (s MeteringSegment) RunningTime() time.Duration {
+	return s.EndTimestamp.Sub(s.StartTimestamp.Time)
+}
+
+// Returns the metering segments of an instance that has been running from firstReadyTimestamp until endTimestamp.
+// When the instance type of an instance has changed, the previous instance type is metered until the time of the change,
+// and the current instance type is metered with a different resourceId from the time of the change.
+func GetMeteringSegments(instance *cloudv1alpha1.Instance, firstReadyTimestamp metav1.Time, endTimestamp metav1.Time, deleted bool) []MeteringSegment {
+	current := MeteringSegment{
+		ResourceId:     instance.ObjectMeta.Name,
+		InstanceType:   instance.Spec.InstanceTypeSpec.Name,
+		StartTimestamp: firstReadyTimestamp,
+		EndTimestamp:   endTimestamp,
+		Deleted:        deleted,
+	}
+	metering := instance.Spec.Metering
+	if metering == nil {
+		return []MeteringSegment{current}
+	}
+	if metering.ResourceId != "" {
+		current.ResourceId = metering.ResourceId
+	}
+	if metering.StartTimestamp != nil {
+		current.StartTimestamp = *metering.StartTimestamp
+	}
+	segments := []MeteringSegment{}
+	for _, previous := range metering.PreviousSegments {
+		// Segments whose final metering record has been written are no longer metered.
+		if previous.ResourceId == "" || previous.EndTimestamp == nil || slices.Contains(instance.Status.ClosedMeteringResourceIds, previous.ResourceId) {
+			continue
+		}
+		previousSegment := MeteringSegment{
+			ResourceId:     previous.ResourceId,
+			InstanceType:   previous.InstanceType,
+			StartTimestamp: firstReadyTimestamp,
+			EndTimestamp:   *previous.EndTimestamp,
+			Deleted:        true,
+		}
+		if previous.StartTimestamp != nil {
+			previousSegment.StartTimestamp = *previous.StartTimestamp
+		}
+		// The instance may have failed before the instance type changed.
+		if endTimestamp.Before(&previousSegment.EndTimestamp) {
+			previousSegment.EndTimestamp = endTimestamp
+		}
+		segments = append(segments, previousSegment)
+	}
+	return append(segments, current)
+}
+
+// Record in the instance status the metering resourceIds of previous segments whose final metering record has been written,
+// so that they are no longer metered and the Compute API Server can remove them from the instance spec.
+// ResourceIds of segments that are no longer in the instance spec are removed from the status.
+func (r *MeteringMonitor) closeMeteringSegments(ctx context.Context, instance *cloudv1alpha1.Instance, closedResourceIds []string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestInstance := &cloudv1alpha1.Instance{}
+		if err := r.K8sClient.Get(ctx, k8sclient.ObjectKeyFromObject(instance), latestInstance); err != nil {
+			return err
+		}
+		var previousResourceIds []string
+		if latestInstance.Spec.Metering != nil {
+			for _, previous := range latestInstance.Spec.Metering.PreviousSegments {
+				previousResourceIds = append(previousResourceIds, previous.ResourceId)
+			}
+		}
+		var closed []string
+		for _, resourceId := range append(latestInstance.Status.ClosedMeteringResourceIds, closedResourceIds...) {
+			if slices.Contains(previousResourceIds, resourceId) && !slices.Contains(closed, resourceId) {
+				closed = append(closed, resourceId)
+			}
+		}
+		if reflect.DeepEqual(closed, latestInstance.Status.ClosedMeteringResourceIds) {
+			return nil
+		}
+		latestInstance.Status.ClosedMeteringResourceIds = closed
+		return r.K8sClient.Status().Update(ctx, latestInstance)
+	})
+}
+
+func (r *MeteringMonitor) CreateRecordInDB(ctx context.Context, instance *cloudv1alpha1.Instance, segment MeteringSegment) error {
 	log := log.FromContext(ctx).WithName("MeteringMonitor.createRecordinDB")
 	log.V(1).Info("Begin creating metering record in DB")
 
 	// Since these usage records use cumulative counters, deduplication is not needed. It is effectively disabled by using a random transactionId.
 	transactionId := uuid.NewString()
-	runningTimeInSeconds := fmt.Sprintf("%v", runningTime.Seconds())
+	runningTimeInSeconds := fmt.Sprintf("%v", segment.RunningTime().Seconds())
 	properties := map[string]string{
 		"instanceName":        instance.Spec.InstanceName,
-		"instanceType":        instance.Spec.InstanceTypeSpec.Name,
+		"instanceType":        segment.InstanceType,
 		"region":              instance.Spec.Region,
 		"availabilityZone":    instance.Spec.AvailabilityZone,
 		"clusterId":           instance.Spec.ClusterId,
 		"serviceType":         instance.Spec.ServiceType,
-		"firstReadyTimestamp": firstReadyTimestamp.Format(time.RFC3339),
+		"firstReadyTimestamp": segment.StartTimestamp.Format(time.RFC3339),
 		"runningSeconds":      runningTimeInSeconds,
-		"deleted":             strconv.FormatBool(isDeleted),
+		"deleted":             strconv.FormatBool(segment.Deleted),
 		"instanceGroup":       instance.Spec.InstanceGroup,
 		"instanceGroupSize":   strconv.FormatInt(int64(instance.Spec.InstanceGroupSize), 10),
 	}
 
 	meteringRecord := &pb.UsageCreate{
 		TransactionId:  transactionId,
-		ResourceId:     instance.ObjectMeta.Name,
+		ResourceId:     segment.ResourceId,
 		CloudAccountId: instance.ObjectMeta.Namespace,
 		Timestamp:      timestamppb.Now(),
 		Properties:     properties,
@@ -307,4 +307,89 @@ var _ = Describe("Compute Metering Monitor", Serial, func() {
 		}
 	})
 
+	It("When the instance type of an Instance has changed, compute metering monitor should create records for the previous and current instance types", func() {
+		namespace := uuid.NewString()
+		instanceName := uuid.NewString()
+		previousResourceId := instanceName
+		currentResourceId := uuid.NewString()
+		resizeTimestamp := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+		instance := NewInstance(namespace, instanceName)
+		instance.Spec.Metering = &privatecloudv1alpha1.InstanceMetering{
+			ResourceId:     currentResourceId,
+			StartTimestamp: &resizeTimestamp,
+			PreviousSegments: []privatecloudv1alpha1.InstanceMeteringSegment{
+				{
+					ResourceId:   previousResourceId,
+					InstanceType: "previous-tiny",
+					EndTimestamp: &resizeTimestamp,
+				},
+			},
+		}
+		instanceLookupKey := types.NamespacedName{Namespace: namespace, Name: instanceName}
+		instanceRef := &privatecloudv1alpha1.Instance{}
+		nsObject := instanceoptest.NewNamespace(namespace)
+
+		By("Creating namespace successfully")
+		Expect(k8sClient.Create(ctx, nsObject)).Should(Succeed())
+
+		By("Creating Instance successfully")
+		Eventually(func(g Gomega) {
+			g.Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
+		}, timeout, interval).Should(Succeed())
+
+		By("Waiting for instance to be created in K8s")
+		Eventually(func(g Gomega) {
+			g.Expect(k8sClient.Get(ctx, instanceLookupKey, instanceRef)).Should(Succeed())
+		}, timeout, interval).Should(Succeed())
+
+		By("Updating instance status in K8s (simulate Instance Operator)")
+		firstReadyTimestamp := metav1.NewTime(resizeTimestamp.Add(-time.Hour))
+		startupCompleteCondition := privatecloudv1alpha1.InstanceCondition{
+			Type:               privatecloudv1alpha1.InstanceConditionStartupComplete,
+			Status:             k8sv1.ConditionTrue,
+			LastTransitionTime: firstReadyTimestamp,
+		}
+		instanceRef.Status.Conditions = append(instanceRef.Status.Conditions, startupCompleteCondition)
+		Expect(k8sClient.Status().Update(ctx, instanceRef)).Should(Succeed())
+
+		By("Waiting for metering record of the previous instance type to be recorded")
+		Eventually(func(g Gomega) {
+			value, ok := createMeteringRecorder.Load(previousResourceId)
+			g.Expect(ok).Should(BeTrue())
+			req, ok := value.(*pb.UsageCreate)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(req.Properties["instanceType"]).Should(Equal("previous-tiny"))
+			g.Expect(req.Properties["firstReadyTimestamp"]).Should(Equal(firstReadyTimestamp.Format(time.RFC3339)))
+			g.Expect(req.Properties["runningSeconds"]).Should(Equal("3600"))
+			g.Expect(req.Properties["deleted"]).Should(Equal("true"))
+		}, timeout, interval).Should(Succeed())
+
+		By("Waiting for the previous metering segment to be closed in the instance status")
+		Eventually(func(g Gomega) {
+			g.Expect(k8sClient.Get(ctx, instanceLookupKey, instanceRef)).Should(Succeed())
+			g.Expect(instanceRef.Status.ClosedMeteringResourceIds).Should(Equal([]string{previousResourceId}))
+		}, timeout, interval).Should(Succeed())
+
+		By("Closed metering segments should no longer be metered")
+		segments := metering_monitor.GetMeteringSegments(instanceRef, firstReadyTimestamp, metav1.Now(), false)
+		Expect(segments).Should(HaveLen(1))
+		Expect(segments[0].ResourceId).Should(Equal(currentResourceId))
+
+		By("Waiting for metering record of the current instance type to be recorded")
+		Eventually(func(g Gomega) {
+			value, ok := createMeteringRecorder.Load(currentResourceId)
+			g.Expect(ok).Should(BeTrue())
+			req, ok := value.(*pb.UsageCreate)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(req.Properties["instanceType"]).Should(Equal(instance.Spec.InstanceTypeSpec.Name))
+			g.Expect(req.Properties["firstReadyTimestamp"]).Should(Equal(resizeTimestamp.Format(time.RFC3339)))
+			g.Expect(req.Properties["deleted"]).Should(Equal("false"))
+		}, timeout, interval).Should(Succeed())
+
+		By("Waiting for instance to be deleted from K8s")
+		Eventually(func(g Gomega) {
+			g.Expect(k8sClient.Delete(ctx, instanceRef)).Should(Succeed())
+		}, timeout, interval).Should(Succeed())
+	})
+
 })
@@ -12,13 +12,14 @@ import (
 
 // Application configuration
 type Config struct {
-	ListenPort        uint16            `koanf:"listenPort"`
-	Database          manageddb.Config  `koanf:"database"`
-	DefaultRegistry   OCIRegistryConfig `koanf:"defaultRegistry"`
-	GrpcAPIServerAddr string            `koanf:"grpcAPIServerAddr"`
-	Tls               TlsConfig         `koanf:"tls"`
-	Dns               DnsConfig         `koanf:"dns"`
-	Encryption        EncryptionConfig  `koanf:"encryption"`
+	ListenPort        uint16               `koanf:"listenPort"`
+	Database          manageddb.Config     `koanf:"database"`
+	DefaultRegistry   OCIRegistryConfig    `koanf:"defaultRegistry"`
+	GrpcAPIServerAddr string               `koanf:"grpcAPIServerAddr"`
+	Tls               TlsConfig            `koanf:"tls"`
+	Dns               DnsConfig            `koanf:"dns"`
+	Encryption        EncryptionConfig     `koanf:"encryption"`
+	PostgresBackup    PostgresBackupConfig `koanf:"postgresBackup"`
 }
 
 type OCIRegistryConfig struct {
@@ -31,6 +32,19 @@ type EncryptionConfig struct {
 	KeyFile string `koanf:"keyFile"`
 }
 
+type ImageConfig struct {
+	Registry   string `koanf:"registry"`
+	Repository string `koanf:"repository"`
+	Tag        string `koanf:"tag"`
+}
+
+type PostgresBackupConfig struct {
+	// Postgres image bundling wal-g. Instances switch to it once WAL archiving is enabled.
+	Image ImageConfig `koanf:"image"`
+	// How often to look for instances due a scheduled backup.
+	SchedulerIntervalSeconds int `koanf:"schedulerIntervalSeconds"`
+}
+
 type TlsConfig struct {
 	HwSSLProfileID int `koanf:"hwSSLProfileID"`
 }
@@ -0,0 +1,7 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+drop index if exists postgres_backup_postgres_id_idx;
+
+drop table if exists postgres_backup;
+
+drop table if exists postgres_backup_policy;
@@ -0,0 +1,45 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+-- postgres backup
+create table if not exists postgres_backup_policy (
+    postgres_id text primary key,
+    cloud_account_id text not null,
+    workspace_id text not null,
+    is_enabled bool not null default true,
+    bucket_id text not null,
+    bucket_name text,
+    bucket_principal text,
+    storage_secret_name text,
+    schedule_interval_hours int not null,
+    retention_days int not null,
+    last_scheduled_at timestamptz,
+
+    created_at timestamptz NOT NULL DEFAULT (now()),
+    created_by text not null,
+    updated_at timestamptz,
+    updated_by text
+);
+
+create table if not exists postgres_backup (
+    id text primary key,
+    postgres_id text not null,
+    cloud_account_id text not null,
+    workspace_id text not null,
+    name text,
+    trigger_type text not null,
+    started_at timestamptz,
+    completed_at timestamptz,
+
+    deployment_id text not null,
+    deployment_status_state text not null DEFAULT 'DPAI_ACCEPTED',
+    deployment_status_display_name text,
+    deployment_status_message text,
+
+    is_active bool not null default true,
+    created_at timestamptz NOT NULL DEFAULT (now()),
+    created_by text not null,
+    updated_at timestamptz,
+    updated_by text
+);
+
+create index if not exists postgres_backup_postgres_id_idx on postgres_backup (postgres_id);
@@ -274,6 +274,44 @@ type Postgres struct {
 	UpdatedBy                    pgtype.Text
 }
 
+type PostgresBackup struct {
+	ID                          string
+	PostgresID                  string
+	CloudAccountID              string
+	WorkspaceID                 string
+	Name                        pgtype.Text
+	TriggerType                 string
+	StartedAt                   pgtype.Timestamptz
+	CompletedAt                 pgtype.Timestamptz
+	DeploymentID                string
+	DeploymentStatusState       string
+	DeploymentStatusDisplayName pgtype.Text
+	DeploymentStatusMessage     pgtype.Text
+	IsActive                    bool
+	CreatedAt                   pgtype.Timestamptz
+	CreatedBy                   string
+	UpdatedAt                   pgtype.Timestamptz
+	UpdatedBy                   pgtype.Text
+}
+
+type PostgresBackupPolicy struct {
+	PostgresID            string
+	CloudAccountID        string
+	WorkspaceID           string
+	IsEnabled             bool
+	BucketID              string
+	BucketName            pgtype.Text
+	BucketPrincipal       pgtype.Text
+	StorageSecretName     pgtype.Text
+	ScheduleIntervalHours int32
+	RetentionDays         int32
+	LastScheduledAt       pgtype.Timestamptz
+	CreatedAt             pgtype.Timestamptz
+	CreatedBy             string
+	UpdatedAt             pgtype.Timestamptz
+	UpdatedBy             pgtype.Text
+}
+
 type PostgresSize struct {
 	ID                             string
 	Name                           string
@@ -0,0 +1,118 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+-- name: GetPostgresBackupPolicy :one
+SELECT * FROM postgres_backup_policy WHERE postgres_id = $1 LIMIT 1;
+
+-- name: UpsertPostgresBackupPolicy :one
+INSERT INTO postgres_backup_policy (
+        postgres_id,
+        cloud_account_id,
+        workspace_id,
+        is_enabled,
+        bucket_id,
+        bucket_name,
+        bucket_principal,
+        storage_secret_name,
+        schedule_interval_hours,
+        retention_days,
+        created_by
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11
+    )
+ON CONFLICT (postgres_id) DO UPDATE
+set is_enabled = excluded.is_enabled,
+    bucket_id = excluded.bucket_id,
+    bucket_name = excluded.bucket_name,
+    bucket_principal = excluded.bucket_principal,
+    storage_secret_name = excluded.storage_secret_name,
+    schedule_interval_hours = excluded.schedule_interval_hours,
+    retention_days = excluded.retention_days,
+    updated_by = excluded.created_by,
+    updated_at = now()
+RETURNING *;
+
+-- name: ListDuePostgresBackupPolicies :many
+SELECT * FROM postgres_backup_policy
+WHERE is_enabled = true
+AND (last_scheduled_at is null or last_scheduled_at + make_interval(hours => schedule_interval_hours) <= now())
+ORDER BY last_scheduled_at NULLS FIRST;
+
+-- name: ClaimDuePostgresBackupPolicy :execrows
+UPDATE postgres_backup_policy
+set last_scheduled_at = now()
+WHERE postgres_id = $1
+AND is_enabled = true
+AND (last_scheduled_at is null or last_scheduled_at + make_interval(hours => schedule_interval_hours) <= now());
+
+-- name: CreatePostgresBackup :one
+INSERT INTO postgres_backup (
+        id,
+        postgres_id,
+        cloud_account_id,
+        workspace_id,
+        trigger_type,
+        deployment_id,
+        created_by
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7
+    ) RETURNING *;
+
+-- name: CommitPostgresBackup :one
+UPDATE postgres_backup
+set name = coalesce(sqlc.narg('name'), name),
+    started_at = coalesce(sqlc.narg('started_at'), started_at),
+    completed_at = coalesce(sqlc.narg('completed_at'), completed_at),
+    deployment_status_state = coalesce(sqlc.narg('deployment_status_state'), deployment_status_state),
+    deployment_status_display_name = coalesce(sqlc.narg('deployment_status_display_name'), deployment_status_display_name),
+    deployment_status_message = coalesce(sqlc.narg('deployment_status_message'), deployment_status_message),
+  updated_at = now()
+WHERE id = $1
+and is_active = true
+RETURNING *;
+
+-- name: GetPostgresBackupById :one
+SELECT * FROM postgres_backup WHERE id = $1 AND is_active = true LIMIT 1;
+
+-- name: GetPostgresBackupByDeploymentId :one
+SELECT * FROM postgres_backup WHERE deployment_id = $1 AND is_active = true LIMIT 1;
+
+-- name: ListPostgresBackups :many
+SELECT * FROM postgres_backup
+WHERE postgres_id = $1
+AND is_active = true
+ORDER BY created_at desc;
+
+-- name: GetLatestPostgresBackup :one
+SELECT * FROM postgres_backup
+WHERE postgres_id = sqlc.arg('postgres_id')
+AND deployment_status_state = 'DPAI_SUCCESS'
+AND completed_at <= coalesce(sqlc.narg('completed_before'), completed_at)
+AND is_active = true
+ORDER BY completed_at desc
+LIMIT 1;
+
+-- name: ExpirePostgresBackups :exec
+UPDATE postgres_backup
+set is_active = false,
+  updated_at = now()
+WHERE postgres_id = $1
+AND completed_at < $2
+AND is_active = true;
@@ -0,0 +1,64 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	model "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/db/models"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/deployment"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+)
+
+func GetDeploymentBackupInput(d deployment.Deployment) (*pb.DpaiPostgresBackupCreateRequest, error) {
+	var value pb.DpaiPostgresBackupCreateRequest
+	err := json.Unmarshal(d.RawInput, &value)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// BackupDeployment takes a base backup and then expires the backups that fell out of the retention window.
+func BackupDeployment(ctx deployment.DeploymentInputContext) (*deployment.Deployment, error) {
+	// Create New Deployment
+	deploy, err := deployment.NewDeployment(ctx, fmt.Sprintf("BackupPostgres:%s", ctx.ID))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create the DeploymentJob for Deployment: %s with error: %+v", ctx.ID, err)
+	}
+
+	// Create and Add Tasks
+	task1 := deployment.NewTask("BaseBackup", BaseBackup, nil, []*deployment.Task{})
+	task2 := deployment.NewTask("ApplyBackupRetention", ApplyBackupRetention, nil, []*deployment.Task{task1})
+	task3 := deployment.NewTask("CommitBackup", CommitBackup, nil, []*deployment.Task{task2})
+
+	deploy.AddTasks([]*deployment.Task{task1, task2, task3})
+
+	_, deploymentError := deploy.Run()
+	if deploymentError != nil {
+		// The backup record is listed to the user, so it has to reflect the failure as well.
+		sqlModel := model.New(ctx.SqlPool)
+		backup, err := sqlModel.GetPostgresBackupByDeploymentId(context.Background(), deploy.ID)
+		if err != nil {
+			log.Printf("No backup found for the deployment: %s. Error: %+v", deploy.ID, err)
+			return deploy, deploymentError
+		}
+		_, err = sqlModel.CommitPostgresBackup(context.Background(), model.CommitPostgresBackupParams{
+			ID:                          backup.ID,
+			DeploymentStatusState:       pgtype.Text{String: pb.DpaiDeploymentState_DPAI_FAILED.String(), Valid: true},
+			DeploymentStatusDisplayName: pgtype.Text{String: "Failed", Valid: true},
+			DeploymentStatusMessage:     pgtype.Text{String: deploymentError.Error(), Valid: true},
+		})
+		if err != nil {
+			log.Printf("Not able to commit the status of the backup %s to the backend DB. Error: %+v", backup.ID, err)
+		}
+		return deploy, deploymentError
+	}
+
+	return deploy, nil
+}
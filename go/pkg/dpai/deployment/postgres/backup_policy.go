@@ -0,0 +1,40 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/deployment"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+)
+
+func GetDeploymentBackupPolicyInput(d deployment.Deployment) (*pb.DpaiPostgresBackupPolicyUpdateRequest, error) {
+	var value pb.DpaiPostgresBackupPolicyUpdateRequest
+	err := json.Unmarshal(d.RawInput, &value)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// BackupPolicyDeployment turns WAL archiving on or off according to the backup policy and stores the policy.
+func BackupPolicyDeployment(ctx deployment.DeploymentInputContext) (*deployment.Deployment, error) {
+	// Create New Deployment
+	deploy, err := deployment.NewDeployment(ctx, fmt.Sprintf("UpdatePostgresBackupPolicy:%s", ctx.ID))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create the DeploymentJob for Deployment: %s with error: %+v", ctx.ID, err)
+	}
+
+	// Create and Add Tasks
+	task1 := deployment.NewTask("CreateBackupStorageSecret", CreateBackupStorageSecret, nil, []*deployment.Task{})
+	task2 := deployment.NewTask("HelmConfigureArchiving", HelmConfigureArchiving, nil, []*deployment.Task{task1})
+	task3 := deployment.NewTask("CommitBackupPolicy", CommitBackupPolicy, nil, []*deployment.Task{task2})
+
+	deploy.AddTasks([]*deployment.Task{task1, task2, task3})
+
+	_, deploymentError := deploy.Run()
+
+	return deploy, deploymentError
+}
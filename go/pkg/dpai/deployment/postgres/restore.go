@@ -0,0 +1,68 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/deployment"
+)
+
+// RestoreDeployment creates a new instance seeded from a base backup of another instance,
+// replaying its archived WAL up to the requested point in time.
+// The input is a DpaiPostgresCreateRequest with the restoreProperties set.
+func RestoreDeployment(ctx deployment.DeploymentInputContext) (string, error) {
+
+	var resourceId string
+
+	// Create New Deployment
+	deploy, err := deployment.NewDeployment(ctx, fmt.Sprintf("RestorePostgres:%s", ctx.ID))
+	if err != nil {
+		log.Printf("Failed to create the DeploymentJob for Deployment: %s with error: %+v", ctx.ID, err)
+		return resourceId, err
+	}
+
+	// Create and Add Tasks
+	createNodeGroup := deployment.NewTask("CreateNodeGroup", CreateNodeGroup, nil, []*deployment.Task{})
+	createNamespace := deployment.NewTask("CreateNamespace", CreateNamespace, nil, []*deployment.Task{})
+	CreateSecret := deployment.NewTask("CreateSecret", CreateSecret, nil, []*deployment.Task{createNamespace})
+	createRestoreStorageSecret := deployment.NewTask("CreateRestoreStorageSecret", CreateRestoreStorageSecret, nil, []*deployment.Task{createNamespace})
+	helmInstall := deployment.NewTask("HelmInstall", HelmInstall, nil, []*deployment.Task{CreateSecret, createRestoreStorageSecret, createNodeGroup})
+	validate := deployment.NewTask("Validate", Validate, nil, []*deployment.Task{helmInstall})
+	commitCreate := deployment.NewTask("CommitCreatePostgres", CommitCreate, nil, []*deployment.Task{validate})
+
+	deploy.AddTasks([]*deployment.Task{
+		createNodeGroup, createNamespace, CreateSecret, createRestoreStorageSecret,
+		helmInstall, validate, commitCreate,
+	})
+
+	output, deploymentError := deploy.Run()
+
+	var result TaskOutput
+	err = json.Unmarshal(output, &result)
+	if err != nil {
+		log.Printf("Failed to convert the result to Output object. Error message: %s", err)
+		log.Printf("Incase of failure in the deployment: %s, cleanup task can not be executed.", deploy.ID)
+		return resourceId, err
+	}
+
+	if deploymentError != nil {
+		// make sure the nodegroup is deleted.
+		log.Printf("Deployment failed with error: %+v", deploymentError)
+		err = deploy.CleanUp(&deployment.DeploymentCleanUpParams{
+			NodeGroupId: result.NodeGroupId,
+			Namespace:   result.Namespace,
+		})
+
+		if err != nil {
+			return resourceId, err
+		}
+		return resourceId, deploymentError
+	}
+
+	resourceId = result.ID
+	log.Printf("Restored a new Postgres Instance with id %s for the deployment id %s.", resourceId, deploy.ID)
+	return resourceId, err
+}
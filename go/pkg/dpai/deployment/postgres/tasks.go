@@ -4,22 +4,26 @@ package postgres
 
 import (
 	"context"
-	// "encoding/json"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	helmclient "github.com/mittwald/go-helm-client"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/repo"
 
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/config"
 	model "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/db/models"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/deployment"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/utils"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/utils/helm"
 	helmutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/utils/helm"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/utils/storage"
 
 	// "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/utils/k8s"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
@@ -50,6 +54,12 @@ type TaskOutput struct {
 	ServiceName             string
 	IksClusterUUID          string
 	CloudAccountID          string
+	// Backup and restore
+	BackupID          string
+	BackupName        string
+	BucketName        string
+	BucketPrincipal   string
+	StorageSecretName string
 }
 
 func mergeTaskOutput(t1, t2 TaskOutput) TaskOutput {
@@ -179,7 +189,7 @@ func generateHelmYamlValuesForCreate(ctx *deployment.TaskRunContext) (string, *p
 		"type": "ClusterIP",
 	}
 
-	yamlValues["postgresql"] = map[string]interface{}{
+	postgresql := map[string]interface{}{
 		"database": deploymentInput.OptionalProperties.InitialDatabaseName,
 		// TODO: Support custom admin username
 		"existingSecret": output.ReleaseName,
@@ -197,6 +207,13 @@ func generateHelmYamlValuesForCreate(ctx *deployment.TaskRunContext) (string, *p
 		// FIXME: Extended configuration breaks
 		// "configuration": extended_configuration,
 	}
+	if deploymentInput.GetRestoreProperties() != nil {
+		err = addRestoreValues(ctx, postgresql, deploymentInput)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	yamlValues["postgresql"] = postgresql
 
 	yamlValues["pgpool"] = map[string]interface{}{
 		"replicaCount":   deploymentInput.SizeProperties.NumberOfPgPoolInstances,
@@ -311,7 +328,11 @@ func HelmInstall(ctx *deployment.TaskRunContext) (any, error) {
 		return nil, err
 	}
 
-	yamlValues, deploymentInput, _ := generateHelmYamlValuesForCreate(ctx)
+	yamlValues, deploymentInput, err := generateHelmYamlValuesForCreate(ctx)
+	if err != nil {
+		log.Printf("Error while generating YAML values from the user input for the postgres install. %+v", err)
+		return nil, err
+	}
 	// Define the chart to be installed
 	chartSpec := helmclient.ChartSpec{
 		ReleaseName: output.ReleaseName,
@@ -726,3 +747,480 @@ func CommitRestart(ctx *deployment.TaskRunContext) (any, error) {
 	ctx.SqlModel.RestartPostgres(context.Background(), deploymentInput.Id)
 	return nil, nil
 }
+
+// backup
+
+const (
+	// Data directory of the bitnami postgresql-ha image, on the volume mounted at /bitnami/postgresql.
+	postgresDataDir = "/bitnami/postgresql/data"
+	// Forces a WAL segment switch at least this often, bounding how much WAL is not yet archived.
+	walArchiveTimeoutSeconds = 60
+)
+
+// Name of the first pod of the postgres statefulset, where base backups are taken and restored.
+func postgresPodName(postgresName string) string {
+	return fmt.Sprintf("%s-postgresql-ha-postgresql-0", postgresName)
+}
+
+func backupStorageSecretName(postgresName string) string {
+	return fmt.Sprintf("%s-backup-storage", postgresName)
+}
+
+func restoreStorageSecretName(postgresName string) string {
+	return fmt.Sprintf("%s-restore-storage", postgresName)
+}
+
+// walgCommand runs wal-g inside the postgres container, which has the storage settings in its environment.
+func walgCommand(args string) []string {
+	return []string{"sh", "-c", fmt.Sprintf("PGPASSWORD=\"$POSTGRES_PASSWORD\" wal-g %s", args)}
+}
+
+func walgImageValues(conf config.ImageConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"registry":   conf.Registry,
+		"repository": conf.Repository,
+		"tag":        conf.Tag,
+	}
+}
+
+// Environment of wal-g, stored as a secret in the namespace of the instance.
+func walgStorageSecretData(endpoint, accessKey, secretKey, bucketName, postgresId string) map[string][]byte {
+	return map[string][]byte{
+		"AWS_ENDPOINT":            []byte(endpoint),
+		"AWS_ACCESS_KEY_ID":       []byte(accessKey),
+		"AWS_SECRET_ACCESS_KEY":   []byte(secretKey),
+		"AWS_REGION":              []byte("us-east-1"),
+		"AWS_S3_FORCE_PATH_STYLE": []byte("true"),
+		"WALG_S3_PREFIX":          []byte(fmt.Sprintf("s3://%s/postgres/%s", bucketName, postgresId)),
+		"PGHOST":                  []byte("localhost"),
+		"PGUSER":                  []byte("postgres"),
+	}
+}
+
+func CreateBackupStorageSecret(ctx *deployment.TaskRunContext) (any, error) {
+	log.Printf("Inside CreateBackupStorageSecret...")
+
+	deploymentInput, _ := GetDeploymentBackupPolicyInput(ctx.DeploymentContext)
+	data, err := ctx.SqlModel.GetPostgresById(context.Background(), deploymentInput.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	output := TaskOutput{
+		ID:                data.ID,
+		Namespace:         data.DeploymentID,
+		StorageSecretName: backupStorageSecretName(data.Name),
+	}
+
+	policy := deploymentInput.GetPolicy()
+	if !policy.GetEnabled() {
+		log.Printf("Skipping CreateBackupStorageSecret as the backup is disabled for the postgres: %s", data.ID)
+		return output, nil
+	}
+
+	// Keep the principal of the current policy when the bucket does not change.
+	current, err := ctx.SqlModel.GetPostgresBackupPolicy(context.Background(), data.ID)
+	if err == nil && current.BucketID == policy.GetBucketId() && current.BucketPrincipal.String != "" {
+		log.Printf("Reusing the backup storage principal %s for the postgres: %s", current.BucketPrincipal.String, data.ID)
+		output = mergeTaskOutput(output, TaskOutput{
+			BucketName:      current.BucketName.String,
+			BucketPrincipal: current.BucketPrincipal.String,
+		})
+		return output, nil
+	}
+
+	st := storage.Storage{}
+	err = st.GetStorageClient(ctx.DeploymentContext.Context.Conf, data.CloudAccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer st.GrpcClientConn.Close()
+
+	bucket, err := st.GetBucket(policy.GetBucketId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := st.CreatePostgresBackupObjectUser(bucket.Metadata.Name, fmt.Sprintf("%s-backup", data.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	secretData := walgStorageSecretData(
+		user.Status.Principal.Cluster.AccessEndpoint,
+		user.Status.Principal.Credentials.AccessKey,
+		user.Status.Principal.Credentials.SecretKey,
+		bucket.Metadata.Name,
+		data.ID,
+	)
+	err = ctx.K8sClient.CreateSecret(data.DeploymentID, output.StorageSecretName, secretData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the backup storage secret. Error: %+v", err)
+	}
+
+	output = mergeTaskOutput(output, TaskOutput{
+		BucketName:      bucket.Metadata.Name,
+		BucketPrincipal: user.Metadata.Name,
+	})
+	log.Printf("Completed: Inside CreateBackupStorageSecret. Output: %v", output)
+
+	return output, nil
+}
+
+func generateHelmYamlValuesForBackupPolicy(ctx *deployment.TaskRunContext, postgresName string, storageSecretName string, enabled bool) (string, error) {
+	yamlValues := map[string]interface{}{}
+
+	postgresql := map[string]interface{}{
+		"existingSecret": postgresName,
+	}
+	if enabled {
+		postgresql["image"] = walgImageValues(ctx.DeploymentContext.Context.Conf.PostgresBackup.Image)
+		postgresql["extraEnvVarsSecret"] = storageSecretName
+		postgresql["extendedConf"] = fmt.Sprintf("archive_mode = on\narchive_command = 'wal-g wal-push %%p'\narchive_timeout = %d\n", walArchiveTimeoutSeconds)
+	} else {
+		postgresql["extendedConf"] = "archive_mode = off\n"
+	}
+	yamlValues["postgresql"] = postgresql
+
+	yamlString, err := utils.ConvertToYAMLString(yamlValues)
+	if err != nil {
+		return "", err
+	}
+	return yamlString, nil
+}
+
+func HelmConfigureArchiving(ctx *deployment.TaskRunContext) (any, error) {
+	log.Printf("Inside HelmConfigureArchiving...")
+
+	output, err := parseTaskOutput(ctx.GetTaskOutput("CreateBackupStorageSecret"))
+	if err != nil {
+		return nil, err
+	}
+	deploymentInput, _ := GetDeploymentBackupPolicyInput(ctx.DeploymentContext)
+	data, err := ctx.SqlModel.GetPostgresById(context.Background(), deploymentInput.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName := data.DeploymentID
+	helmClient, chartReference, err := getHelmClient(ctx, namespaceName, data.VersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	yamlValues, err := generateHelmYamlValuesForBackupPolicy(ctx, data.Name, output.StorageSecretName, deploymentInput.GetPolicy().GetEnabled())
+	if err != nil {
+		log.Printf("Error while generating YAML values for the postgres backup policy. %+v", err)
+		return nil, err
+	}
+
+	// Define the chart to be installed
+	chartSpec := helmclient.ChartSpec{
+		ReleaseName: data.Name,
+		ChartName:   chartReference.ChartName,
+		Version:     chartReference.Version,
+		Namespace:   namespaceName,
+		UpgradeCRDs: true,
+		ReuseValues: true,
+		ValuesYaml:  yamlValues,
+		Wait:        true,
+		Timeout:     10 * time.Minute,
+	}
+
+	// The image and configuration change rolls the postgres pods.
+	rel, err := helmClient.UpgradeChart(context.Background(), &chartSpec, nil)
+	if err != nil {
+		log.Printf("Error while configuring the WAL archiving. %+v", err)
+		return nil, err
+	}
+	output.Release = rel
+	log.Printf("Completed: Inside HelmConfigureArchiving. Output: %v", output)
+
+	return output, nil
+}
+
+func CommitBackupPolicy(ctx *deployment.TaskRunContext) (any, error) {
+	log.Printf("Commiting Postgres Backup Policy\n")
+
+	output, err := parseTaskOutput(ctx.GetTaskOutput("HelmConfigureArchiving"))
+	if err != nil {
+		return nil, err
+	}
+	deploymentInput, _ := GetDeploymentBackupPolicyInput(ctx.DeploymentContext)
+	data, err := ctx.SqlModel.GetPostgresById(context.Background(), deploymentInput.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	policy := deploymentInput.GetPolicy()
+	_, err = ctx.SqlModel.UpsertPostgresBackupPolicy(context.Background(), model.UpsertPostgresBackupPolicyParams{
+		PostgresID:            data.ID,
+		CloudAccountID:        data.CloudAccountID,
+		WorkspaceID:           data.WorkspaceID,
+		IsEnabled:             policy.GetEnabled(),
+		BucketID:              policy.GetBucketId(),
+		BucketName:            pgtype.Text{String: output.BucketName, Valid: output.BucketName != ""},
+		BucketPrincipal:       pgtype.Text{String: output.BucketPrincipal, Valid: output.BucketPrincipal != ""},
+		StorageSecretName:     pgtype.Text{String: output.StorageSecretName, Valid: true},
+		ScheduleIntervalHours: policy.GetScheduleIntervalHours(),
+		RetentionDays:         policy.GetRetentionDays(),
+		CreatedBy:             "Internal Process",
+	})
+	if err != nil {
+		log.Printf("Not able to commit the backup policy of the Postgres to the backend DB.")
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// walgBackup is an entry of `wal-g backup-list --json --detail`.
+type walgBackup struct {
+	BackupName string    `json:"backup_name"`
+	StartTime  time.Time `json:"start_time"`
+	FinishTime time.Time `json:"finish_time"`
+}
+
+// latestWalgBackup returns the most recently finished backup in the output of `wal-g backup-list --json --detail`.
+func latestWalgBackup(backupList string) (*walgBackup, error) {
+	var backups []walgBackup
+	err := json.Unmarshal([]byte(backupList), &backups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the wal-g backup list. Error: %+v", err)
+	}
+	if len(backups) == 0 {
+		return nil, fmt.Errorf("no backup found in the wal-g backup list")
+	}
+
+	latest := backups[0]
+	for _, backup := range backups[1:] {
+		if backup.FinishTime.After(latest.FinishTime) {
+			latest = backup
+		}
+	}
+	return &latest, nil
+}
+
+func BaseBackup(ctx *deployment.TaskRunContext) (any, error) {
+	log.Printf("Inside BaseBackup...")
+
+	deploymentInput, _ := GetDeploymentBackupInput(ctx.DeploymentContext)
+	data, err := ctx.SqlModel.GetPostgresById(context.Background(), deploymentInput.GetId())
+	if err != nil {
+		return nil, err
+	}
+	backup, err := ctx.SqlModel.GetPostgresBackupByDeploymentId(context.Background(), ctx.DeploymentContext.ID)
+	if err != nil {
+		return nil, fmt.Errorf("no backup found for the deployment %s. Error: %+v", ctx.DeploymentContext.ID, err)
+	}
+
+	_, err = ctx.SqlModel.CommitPostgresBackup(context.Background(), model.CommitPostgresBackupParams{
+		ID:                          backup.ID,
+		StartedAt:                   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		DeploymentStatusState:       pgtype.Text{String: pb.DpaiDeploymentState_DPAI_RUNNING.String(), Valid: true},
+		DeploymentStatusDisplayName: pgtype.Text{String: "Running", Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	podName := postgresPodName(data.Name)
+	_, err = ctx.K8sClient.ExecInPodWithOutput(data.DeploymentID, podName, walgCommand(fmt.Sprintf("backup-push %s", postgresDataDir)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to take the base backup. Error: %+v", err)
+	}
+
+	backupList, err := ctx.K8sClient.ExecInPodWithOutput(data.DeploymentID, podName, walgCommand("backup-list --json --detail"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the base backups. Error: %+v", err)
+	}
+	latest, err := latestWalgBackup(backupList)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ctx.SqlModel.CommitPostgresBackup(context.Background(), model.CommitPostgresBackupParams{
+		ID:          backup.ID,
+		Name:        pgtype.Text{String: latest.BackupName, Valid: true},
+		StartedAt:   pgtype.Timestamptz{Time: latest.StartTime, Valid: true},
+		CompletedAt: pgtype.Timestamptz{Time: latest.FinishTime, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := TaskOutput{
+		ID:         data.ID,
+		Namespace:  data.DeploymentID,
+		BackupID:   backup.ID,
+		BackupName: latest.BackupName,
+	}
+	log.Printf("Completed: Inside BaseBackup. Output: %v", output)
+
+	return output, nil
+}
+
+func ApplyBackupRetention(ctx *deployment.TaskRunContext) (any, error) {
+	log.Printf("Inside ApplyBackupRetention...")
+
+	output, err := parseTaskOutput(ctx.GetTaskOutput("BaseBackup"))
+	if err != nil {
+		return nil, err
+	}
+	data, err := ctx.SqlModel.GetPostgresById(context.Background(), output.ID)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := ctx.SqlModel.GetPostgresBackupPolicy(context.Background(), data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("no backup policy found for the postgres %s. Error: %+v", data.ID, err)
+	}
+
+	// Keep the newest backup completed before the cutoff, it is needed to restore to any point after the cutoff.
+	cutoff := time.Now().AddDate(0, 0, -int(policy.RetentionDays))
+	oldest, err := ctx.SqlModel.GetLatestPostgresBackup(context.Background(), model.GetLatestPostgresBackupParams{
+		PostgresID:      data.ID,
+		CompletedBefore: pgtype.Timestamptz{Time: cutoff, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Printf("No backup of the postgres %s is past the retention of %d days.", data.ID, policy.RetentionDays)
+		return output, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Removes the older base backups along with the WAL only they needed.
+	_, err = ctx.K8sClient.ExecInPodWithOutput(data.DeploymentID, postgresPodName(data.Name), walgCommand(fmt.Sprintf("delete before %s --confirm", oldest.Name.String)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete the expired backups. Error: %+v", err)
+	}
+
+	err = ctx.SqlModel.ExpirePostgresBackups(context.Background(), model.ExpirePostgresBackupsParams{
+		PostgresID:  data.ID,
+		CompletedAt: oldest.CompletedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Completed: Inside ApplyBackupRetention. Expired the backups of the postgres %s before %s", data.ID, oldest.Name.String)
+
+	return output, nil
+}
+
+func CommitBackup(ctx *deployment.TaskRunContext) (any, error) {
+	log.Printf("Commiting Postgres Backup\n")
+
+	output, err := parseTaskOutput(ctx.GetTaskOutput("ApplyBackupRetention"))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ctx.SqlModel.CommitPostgresBackup(context.Background(), model.CommitPostgresBackupParams{
+		ID:                          output.BackupID,
+		DeploymentStatusState:       pgtype.Text{String: pb.DpaiDeploymentState_DPAI_SUCCESS.String(), Valid: true},
+		DeploymentStatusDisplayName: pgtype.Text{String: "Success", Valid: true},
+		DeploymentStatusMessage:     pgtype.Text{String: "Successfully backed up the postgres instance.", Valid: true},
+	})
+	if err != nil {
+		log.Printf("Not able to commit the status of the Postgres backup to the backend DB.")
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// restore
+
+// recoveryTargetTimeFormat is the timestamp format accepted by recovery_target_time.
+const recoveryTargetTimeFormat = "2006-01-02 15:04:05.999999-07"
+
+// addRestoreValues seeds the first pod of a new instance from a base backup before postgres starts,
+// and replays the archived WAL of the source instance up to the target time.
+func addRestoreValues(ctx *deployment.TaskRunContext, postgresql map[string]interface{}, deploymentInput *pb.DpaiPostgresCreateRequest) error {
+	restore := deploymentInput.GetRestoreProperties()
+	backup, err := ctx.SqlModel.GetPostgresBackupById(context.Background(), restore.GetBackupId())
+	if err != nil {
+		return fmt.Errorf("no backup found for the id %s. Error: %+v", restore.GetBackupId(), err)
+	}
+
+	image := ctx.DeploymentContext.Context.Conf.PostgresBackup.Image
+	secretName := restoreStorageSecretName(deploymentInput.GetName())
+
+	// The other replicas are cloned from the first pod by repmgr.
+	script := fmt.Sprintf(`set -e
+case "$(hostname)" in
+  *-0) ;;
+  *) exit 0 ;;
+esac
+if [ ! -f %[1]s/PG_VERSION ]; then
+  wal-g backup-fetch %[1]s %[2]s
+  touch %[1]s/recovery.signal
+fi
+`, postgresDataDir, backup.Name.String)
+
+	postgresql["image"] = walgImageValues(image)
+	postgresql["extraEnvVarsSecret"] = secretName
+	postgresql["initContainers"] = []interface{}{
+		map[string]interface{}{
+			"name":    "walg-restore",
+			"image":   fmt.Sprintf("%s/%s:%s", image.Registry, image.Repository, image.Tag),
+			"command": []string{"sh", "-c", script},
+			"envFrom": []interface{}{
+				map[string]interface{}{
+					"secretRef": map[string]interface{}{"name": secretName},
+				},
+			},
+			"volumeMounts": []interface{}{
+				map[string]interface{}{"name": "data", "mountPath": "/bitnami/postgresql"},
+			},
+		},
+	}
+
+	recoveryConf := "restore_command = 'wal-g wal-fetch %f %p'\nrecovery_target_action = 'promote'\n"
+	if restore.GetTargetTime() != nil {
+		recoveryConf += fmt.Sprintf("recovery_target_time = '%s'\n", restore.GetTargetTime().AsTime().UTC().Format(recoveryTargetTimeFormat))
+	}
+	postgresql["extendedConf"] = recoveryConf
+
+	return nil
+}
+
+func CreateRestoreStorageSecret(ctx *deployment.TaskRunContext) (any, error) {
+	log.Printf("Inside CreateRestoreStorageSecret...")
+
+	output, err := parseTaskOutput(ctx.GetTaskOutput("CreateNamespace"))
+	if err != nil {
+		return nil, err
+	}
+	deploymentInput, _ := GetDeploymentCreateInput(ctx.DeploymentContext)
+	restore := deploymentInput.GetRestoreProperties()
+	if restore == nil {
+		return nil, fmt.Errorf("missing input: restoreProperties must be provided to restore the postgres")
+	}
+
+	source, err := ctx.SqlModel.GetPostgresById(context.Background(), restore.GetSourcePostgresId())
+	if err != nil {
+		return nil, err
+	}
+	policy, err := ctx.SqlModel.GetPostgresBackupPolicy(context.Background(), source.ID)
+	if err != nil {
+		return nil, fmt.Errorf("no backup policy found for the postgres %s. Error: %+v", source.ID, err)
+	}
+
+	// The new instance reads the backups of the source instance with the same principal.
+	secretData, err := ctx.K8sClient.GetSecret(source.DeploymentID, policy.StorageSecretName.String)
+	if err != nil {
+		return nil, err
+	}
+	secretName := restoreStorageSecretName(deploymentInput.GetName())
+	err = ctx.K8sClient.CreateSecret(output.Namespace, secretName, secretData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the restore storage secret. Error: %+v", err)
+	}
+
+	output = mergeTaskOutput(output, TaskOutput{StorageSecretName: secretName})
+	log.Printf("Completed: Inside CreateRestoreStorageSecret. Output: %v", output)
+
+	return output, nil
+}
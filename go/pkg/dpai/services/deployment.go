@@ -16,6 +16,7 @@ import (
 	db "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/db/models"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/deployment"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/deployment/airflow"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/deployment/postgres"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/deployment/workspace"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/utils"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
@@ -56,6 +57,18 @@ func TriggerDeployment(id string, serviceType pb.DpaiServiceType, changeIndicato
 		default:
 			return false, fmt.Errorf("provided service type %s doesnt have a deployment for change indicator `%s`", serviceType, changeIndicator)
 		}
+	// Postgres
+	case pb.DpaiServiceType_DPAI_POSTGRES:
+		switch changeIndicator {
+		case pb.DpaiDeploymentChangeIndicator_DPAI_BACKUP_POLICY_UPDATE:
+			go postgres.BackupPolicyDeployment(deploymentInputContext)
+		case pb.DpaiDeploymentChangeIndicator_DPAI_BACKUP:
+			go postgres.BackupDeployment(deploymentInputContext)
+		case pb.DpaiDeploymentChangeIndicator_DPAI_RESTORE:
+			go postgres.RestoreDeployment(deploymentInputContext)
+		default:
+			return false, fmt.Errorf("provided service type %s doesnt have a deployment for change indicator `%s`", serviceType, changeIndicator)
+		}
 	// // HMS
 	// case pb.DpaiServiceType_DPAI_HMS:
 	// 	switch changeIndicator {
@@ -107,6 +107,9 @@ func (s *DpaiServer) DpaiPostgresList(ctx context.Context, req *pb.DpaiPostgresL
 func (s *DpaiServer) DpaiPostgresCreate(ctx context.Context, req *pb.DpaiPostgresCreateRequest) (*pb.DpaiDeploymentResponse, error) {
 
 	log.Printf("Create Postgres : %s \n", req.GetName())
+	if req.GetRestoreProperties() != nil {
+		return nil, fmt.Errorf("invalid input: restoreProperties can only be set through the restore operation")
+	}
 	uuidStr := uuid.New().String()
 	deploymentId := fmt.Sprintf("postgres-%s-%s%s", req.GetName(), uuidStr[:8], uuidStr[len(uuidStr)-12:])
 
@@ -0,0 +1,488 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	db "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/db/models"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/utils"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/dpai/utils/k8s"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+)
+
+const (
+	defaultPostgresBackupScheduleIntervalHours = 24
+	defaultPostgresBackupRetentionDays         = 7
+	defaultPostgresBackupSchedulerInterval     = 5 * time.Minute
+)
+
+func postgresBackupSqlToProto(backup db.PostgresBackup) (*pb.DpaiPostgresBackup, error) {
+	state, err := utils.ConvertDpaiDeploymentStateToPbEnum(backup.DeploymentStatusState)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.DpaiPostgresBackup{
+		Id:          backup.ID,
+		PostgresId:  backup.PostgresID,
+		WorkspaceId: backup.WorkspaceID,
+		Name:        backup.Name.String,
+		Trigger:     pb.DpaiPostgresBackupTrigger(pb.DpaiPostgresBackupTrigger_value[backup.TriggerType]),
+		DeploymentMetadata: &pb.DpaiPostgresDeploymentMeta{
+			DeploymentId: backup.DeploymentID,
+			DeploymentStatus: &pb.DpaiDeploymentStatus{
+				State:       *state,
+				DisplayName: backup.DeploymentStatusDisplayName.String,
+				Message:     backup.DeploymentStatusMessage.String,
+			},
+		},
+		Metadata: &pb.DpaiMeta{
+			CreatedAt: timestamppb.New(backup.CreatedAt.Time),
+			CreatedBy: backup.CreatedBy,
+			UpdatedAt: timestamppb.New(backup.UpdatedAt.Time),
+			UpdatedBy: backup.UpdatedBy.String,
+		},
+	}
+	if backup.StartedAt.Valid {
+		result.StartedAt = timestamppb.New(backup.StartedAt.Time)
+	}
+	if backup.CompletedAt.Valid {
+		result.CompletedAt = timestamppb.New(backup.CompletedAt.Time)
+	}
+	return result, nil
+}
+
+func postgresBackupPolicySqlToProto(policy db.PostgresBackupPolicy) *pb.DpaiPostgresBackupPolicy {
+	return &pb.DpaiPostgresBackupPolicy{
+		Enabled:               policy.IsEnabled,
+		BucketId:              policy.BucketID,
+		ScheduleIntervalHours: policy.ScheduleIntervalHours,
+		RetentionDays:         policy.RetentionDays,
+	}
+}
+
+func (s *DpaiServer) DpaiPostgresBackupPolicyUpdate(ctx context.Context, req *pb.DpaiPostgresBackupPolicyUpdateRequest) (*pb.DpaiDeploymentResponse, error) {
+	id := req.GetId()
+	log.Printf("Update Backup Policy of Postgres by Id: %s", id)
+
+	policy := req.GetPolicy()
+	if policy == nil {
+		return nil, fmt.Errorf("missing input: policy is mandatory to update the backup policy")
+	}
+	if policy.GetEnabled() && policy.GetBucketId() == "" {
+		return nil, fmt.Errorf("missing input: bucketId is mandatory to enable the backup")
+	}
+	if policy.GetScheduleIntervalHours() < 0 || policy.GetRetentionDays() < 0 {
+		return nil, fmt.Errorf("invalid input: scheduleIntervalHours and retentionDays can not be negative")
+	}
+	if policy.GetScheduleIntervalHours() == 0 {
+		policy.ScheduleIntervalHours = defaultPostgresBackupScheduleIntervalHours
+	}
+	if policy.GetRetentionDays() == 0 {
+		policy.RetentionDays = defaultPostgresBackupRetentionDays
+	}
+
+	pg, err := s.Sql.GetPostgresById(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.GetEnabled() {
+		current, err := s.Sql.GetPostgresBackupPolicy(context.Background(), pg.ID)
+		if err != nil {
+			return nil, fmt.Errorf("no backup policy found for the postgres %s. Error: %+v", pg.ID, err)
+		}
+		// Archived WAL stays in the current bucket.
+		policy.BucketId = current.BucketID
+	}
+
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		log.Printf("Not able to parse the input request to Json data. Error: %+v", err)
+		return nil, err
+	}
+
+	deployment, err := s.DpaiDeploymentCreate(ctx, &pb.DpaiDeploymentCreateRequest{
+		CloudAccountId:  pg.CloudAccountID,
+		WorkspaceId:     pg.WorkspaceID,
+		ServiceId:       pg.ID,
+		ServiceType:     pb.DpaiServiceType_DPAI_POSTGRES,
+		ChangeIndicator: pb.DpaiDeploymentChangeIndicator_DPAI_BACKUP_POLICY_UPDATE,
+		Input:           jsonData,
+	})
+
+	if err != nil {
+		return &pb.DpaiDeploymentResponse{
+			Status: &pb.DpaiDeploymentStatus{
+				State:       pb.DpaiDeploymentState_DPAI_FAILED,
+				DisplayName: "Failed",
+				Message:     "Failed to create the deployment",
+			},
+		}, fmt.Errorf("failed to create the deployment. Error message: %+v", err)
+	}
+
+	return &pb.DpaiDeploymentResponse{
+		DeploymentId: deployment.GetDeploymentId(),
+		Status: &pb.DpaiDeploymentStatus{
+			State:       pb.DpaiDeploymentState_DPAI_ACCEPTED,
+			DisplayName: "Accepted",
+		},
+	}, nil
+}
+
+// createPostgresBackup records the backup and starts the deployment taking it.
+func (s *DpaiServer) createPostgresBackup(ctx context.Context, pg db.Postgres, trigger pb.DpaiPostgresBackupTrigger, createdBy string) (*pb.DpaiDeploymentResponse, error) {
+	policy, err := s.Sql.GetPostgresBackupPolicy(context.Background(), pg.ID)
+	if err != nil || !policy.IsEnabled {
+		return nil, fmt.Errorf("backup is not enabled for the postgres %s", pg.ID)
+	}
+
+	jsonData, err := json.Marshal(&pb.DpaiPostgresBackupCreateRequest{
+		Id:             pg.ID,
+		WorkspaceId:    pg.WorkspaceID,
+		CloudAccountId: pg.CloudAccountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The backup has to exist before the deployment starts, as its tasks look it up by the deployment id.
+	deploymentId := uuid.New().String()
+	_, err = s.SqlModel.CreatePostgresBackup(context.Background(), db.CreatePostgresBackupParams{
+		ID:             uuid.New().String(),
+		PostgresID:     pg.ID,
+		CloudAccountID: pg.CloudAccountID,
+		WorkspaceID:    pg.WorkspaceID,
+		TriggerType:    trigger.String(),
+		DeploymentID:   deploymentId,
+		CreatedBy:      createdBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the backup. Error message: %+v", err)
+	}
+
+	deployment, err := s.DpaiDeploymentCreate(ctx, &pb.DpaiDeploymentCreateRequest{
+		DeploymentId:    deploymentId,
+		CloudAccountId:  pg.CloudAccountID,
+		WorkspaceId:     pg.WorkspaceID,
+		ServiceId:       pg.ID,
+		ServiceType:     pb.DpaiServiceType_DPAI_POSTGRES,
+		ChangeIndicator: pb.DpaiDeploymentChangeIndicator_DPAI_BACKUP,
+		CreatedBy:       createdBy,
+		Input:           jsonData,
+	})
+
+	if err != nil {
+		return &pb.DpaiDeploymentResponse{
+			Status: &pb.DpaiDeploymentStatus{
+				State:       pb.DpaiDeploymentState_DPAI_FAILED,
+				DisplayName: "Failed",
+				Message:     "Failed to create the deployment",
+			},
+		}, fmt.Errorf("failed to create the deployment. Error message: %+v", err)
+	}
+
+	return &pb.DpaiDeploymentResponse{
+		DeploymentId: deployment.GetDeploymentId(),
+		Status: &pb.DpaiDeploymentStatus{
+			State:       pb.DpaiDeploymentState_DPAI_ACCEPTED,
+			DisplayName: "Accepted",
+		},
+	}, nil
+}
+
+func (s *DpaiServer) DpaiPostgresBackupCreate(ctx context.Context, req *pb.DpaiPostgresBackupCreateRequest) (*pb.DpaiDeploymentResponse, error) {
+	id := req.GetId()
+	log.Printf("Backup Postgres by Id: %s", id)
+
+	pg, err := s.Sql.GetPostgresById(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createPostgresBackup(ctx, pg, pb.DpaiPostgresBackupTrigger_DPAI_POSTGRES_BACKUP_ON_DEMAND, "CallerOfTheAPI")
+}
+
+func (s *DpaiServer) DpaiPostgresBackupList(ctx context.Context, req *pb.DpaiPostgresBackupListRequest) (*pb.DpaiPostgresBackupListResponse, error) {
+	id := req.GetId()
+	log.Printf("List Backups of Postgres by Id: %s", id)
+
+	pg, err := s.Sql.GetPostgresById(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.DpaiPostgresBackupListResponse{}
+	policy, err := s.Sql.GetPostgresBackupPolicy(context.Background(), pg.ID)
+	if err == nil {
+		response.Policy = postgresBackupPolicySqlToProto(policy)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	queryResults, err := s.Sql.ListPostgresBackups(context.Background(), pg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list Postgres backups with error : %+v", err)
+	}
+
+	for _, queryResult := range queryResults {
+		result, err := postgresBackupSqlToProto(queryResult)
+		if err != nil {
+			return nil, err
+		}
+		response.Data = append(response.Data, result)
+
+		// WAL is archived from the start of the oldest retained backup onwards.
+		if queryResult.DeploymentStatusState == pb.DpaiDeploymentState_DPAI_SUCCESS.String() &&
+			(response.EarliestRestoreTime == nil || queryResult.CompletedAt.Time.Before(response.EarliestRestoreTime.AsTime())) {
+			response.EarliestRestoreTime = timestamppb.New(queryResult.CompletedAt.Time)
+		}
+	}
+
+	return response, nil
+}
+
+func (s *DpaiServer) DpaiPostgresRestore(ctx context.Context, req *pb.DpaiPostgresRestoreRequest) (*pb.DpaiDeploymentResponse, error) {
+	log.Printf("Restore Postgres with Id: %s to a new instance: %s", req.GetId(), req.GetName())
+
+	if req.GetId() == "" || req.GetName() == "" {
+		return nil, fmt.Errorf("missing input: Id and Name are mandatory for the restore operation")
+	}
+	if req.GetTargetTime() != nil && req.GetTargetTime().AsTime().After(time.Now()) {
+		return nil, fmt.Errorf("invalid input: targetTime can not be in the future")
+	}
+
+	source, err := s.Sql.GetPostgresById(context.Background(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Sql.GetPostgresBackupPolicy(context.Background(), source.ID); err != nil {
+		return nil, fmt.Errorf("no backup policy found for the postgres %s", source.ID)
+	}
+
+	// The base backup has to be completed before the target time, the archived WAL covers the rest.
+	var backup db.PostgresBackup
+	if req.GetBackupId() != "" {
+		backup, err = s.Sql.GetPostgresBackupById(context.Background(), req.GetBackupId())
+		if err != nil || backup.PostgresID != source.ID || backup.DeploymentStatusState != pb.DpaiDeploymentState_DPAI_SUCCESS.String() {
+			return nil, fmt.Errorf("no completed backup found for the id %s", req.GetBackupId())
+		}
+		if req.GetTargetTime() != nil && backup.CompletedAt.Time.After(req.GetTargetTime().AsTime()) {
+			return nil, fmt.Errorf("invalid input: the backup %s completed after the targetTime", req.GetBackupId())
+		}
+	} else {
+		backup, err = s.Sql.GetLatestPostgresBackup(context.Background(), db.GetLatestPostgresBackupParams{
+			PostgresID:      source.ID,
+			CompletedBefore: pgtype.Timestamptz{Time: req.GetTargetTime().AsTime(), Valid: req.GetTargetTime() != nil},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("no completed backup found for the postgres %s before the targetTime", source.ID)
+		}
+	}
+
+	uuidStr := uuid.New().String()
+	deploymentId := fmt.Sprintf("postgres-%s-%s%s", req.GetName(), uuidStr[:8], uuidStr[len(uuidStr)-12:])
+
+	// The restored instance keeps the admin credentials of the source instance, they are part of the data.
+	k8sClusterID, err := k8s.GetIksClusterID(s.SqlModel, source.WorkspaceID, "")
+	if err != nil {
+		return nil, err
+	}
+	k8sClient := k8s.K8sClient{
+		ClusterID: k8sClusterID,
+	}
+	k8sClient.GetK8sClientSet()
+	secretData, err := k8sClient.GetSecret("secrets", source.DeploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the secret of the postgres %s", source.ID)
+	}
+	err = k8sClient.CreateSecret("secrets", deploymentId, secretData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the secret")
+	}
+
+	advanceConf, err := utils.ConvertBytesToTags(source.AdvanceConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := utils.ConvertBytesToTags(source.Tags)
+	if err != nil {
+		return nil, err
+	}
+	input := &pb.DpaiPostgresCreateRequest{
+		WorkspaceId: source.WorkspaceID,
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		VersionId:   source.VersionID,
+		SizeProperties: &pb.DpaiPostgresSizeProperties{
+			SizeId:                  source.SizeID,
+			NumberOfInstances:       source.NumberOfInstances.Int32,
+			NumberOfPgPoolInstances: source.NumberOfPgpoolInstances.Int32,
+			DiskSizeInGb:            source.DiskSizeInGb.Int32,
+		},
+		OptionalProperties: &pb.DpaiPostgresOptionalProperties{
+			InitialDatabaseName: source.InitialDatabaseName.String,
+		},
+		AdminProperties: &pb.DpaiPostgresAdminProperties{
+			AdminUsername: source.AdminUsername,
+			AdminPasswordSecretReference: &pb.DpaiSecretReference{
+				SecretName:    deploymentId,
+				SecretKeyName: "password",
+			},
+		},
+		AdvanceConfiguration: advanceConf,
+		Tags:                 tags,
+		CreatedBy:            req.GetCreatedBy(),
+		CloudAccountId:       source.CloudAccountID,
+		RestoreProperties: &pb.DpaiPostgresRestoreProperties{
+			SourcePostgresId: source.ID,
+			BackupId:         backup.ID,
+			TargetTime:       req.GetTargetTime(),
+		},
+	}
+
+	// convert input payload into the bytes
+	jsonData, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	adminSecret, err := utils.ConvertSecretReferenceToBytes(input.GetAdminProperties().GetAdminPasswordSecretReference())
+	if err != nil {
+		return nil, err
+	}
+
+	serviceId := uuid.New().String()
+	_, err = s.SqlModel.CreatePostgres(context.Background(), db.CreatePostgresParams{
+		CloudAccountID:               source.CloudAccountID,
+		WorkspaceID:                  source.WorkspaceID,
+		ID:                           serviceId,
+		Name:                         input.Name,
+		Description:                  pgtype.Text{String: input.Description, Valid: true},
+		VersionID:                    input.VersionId,
+		SizeID:                       input.SizeProperties.GetSizeId(),
+		NumberOfInstances:            source.NumberOfInstances,
+		NumberOfPgpoolInstances:      source.NumberOfPgpoolInstances,
+		DiskSizeInGb:                 source.DiskSizeInGb,
+		InitialDatabaseName:          source.InitialDatabaseName,
+		AdminUsername:                input.AdminProperties.GetAdminUsername(),
+		AdminPasswordSecretReference: adminSecret,
+		AdvanceConfiguration:         source.AdvanceConfiguration,
+		Tags:                         source.Tags,
+		NodeGroupID:                  source.NodeGroupID,
+		DeploymentID:                 deploymentId,
+		DeploymentStatusState:        pb.DpaiDeploymentState_DPAI_PENDING.String(),
+		DeploymentStatusDisplayName:  pgtype.Text{String: "Pending", Valid: true},
+		CreatedBy:                    req.GetCreatedBy(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the postgres. Error message: %+v", err)
+	}
+
+	deployment, err := s.DpaiDeploymentCreate(ctx, &pb.DpaiDeploymentCreateRequest{
+		DeploymentId:    deploymentId,
+		CloudAccountId:  source.CloudAccountID,
+		WorkspaceId:     source.WorkspaceID,
+		ServiceId:       serviceId,
+		ServiceType:     pb.DpaiServiceType_DPAI_POSTGRES,
+		ChangeIndicator: pb.DpaiDeploymentChangeIndicator_DPAI_RESTORE,
+		CreatedBy:       req.GetCreatedBy(),
+		Input:           jsonData,
+	})
+
+	if err != nil {
+		return &pb.DpaiDeploymentResponse{
+			Status: &pb.DpaiDeploymentStatus{
+				State:       pb.DpaiDeploymentState_DPAI_FAILED,
+				DisplayName: "Failed",
+				Message:     "Failed to create the deployment",
+			},
+		}, fmt.Errorf("failed to create the deployment. Error message: %+v", err)
+	}
+
+	return &pb.DpaiDeploymentResponse{
+		DeploymentId: deployment.GetDeploymentId(),
+		Status: &pb.DpaiDeploymentStatus{
+			State:       pb.DpaiDeploymentState_DPAI_ACCEPTED,
+			DisplayName: "Accepted",
+		},
+	}, nil
+}
+
+// PostgresBackupScheduler starts a backup of every postgres instance whose backup policy is due.
+type PostgresBackupScheduler struct {
+	server   *DpaiServer
+	interval time.Duration
+}
+
+func NewPostgresBackupScheduler(server *DpaiServer, interval time.Duration) *PostgresBackupScheduler {
+	if interval <= 0 {
+		interval = defaultPostgresBackupSchedulerInterval
+	}
+	return &PostgresBackupScheduler{
+		server:   server,
+		interval: interval,
+	}
+}
+
+// Schedule backups every interval until the context is cancelled.
+func (s *PostgresBackupScheduler) Start(ctx context.Context) {
+	log.Printf("Starting the postgres backup scheduler with interval: %s", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Schedule(ctx); err != nil {
+				log.Printf("Failed to schedule the postgres backups. Error: %+v", err)
+			}
+		}
+	}
+}
+
+// Schedule starts the backups that are due.
+func (s *PostgresBackupScheduler) Schedule(ctx context.Context) error {
+	policies, err := s.server.SqlModel.ListDuePostgresBackupPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		// Every replica runs the scheduler, only the one claiming the due policy starts the backup.
+		// A failed attempt waits for the next interval too, instead of retrying on every tick.
+		claimed, err := s.server.SqlModel.ClaimDuePostgresBackupPolicy(ctx, policy.PostgresID)
+		if err != nil {
+			log.Printf("Failed to update the backup schedule of the postgres %s. Error: %+v", policy.PostgresID, err)
+			continue
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		pg, err := s.server.Sql.GetPostgresById(ctx, policy.PostgresID)
+		if err != nil {
+			log.Printf("No postgres found for the backup policy %s. Error: %+v", policy.PostgresID, err)
+			continue
+		}
+
+		response, err := s.server.createPostgresBackup(ctx, pg, pb.DpaiPostgresBackupTrigger_DPAI_POSTGRES_BACKUP_SCHEDULED, "Backup Scheduler")
+		if err != nil {
+			log.Printf("Failed to start the scheduled backup of the postgres %s. Error: %+v", pg.ID, err)
+			continue
+		}
+		log.Printf("Started the scheduled backup of the postgres %s with the deployment %s", pg.ID, response.GetDeploymentId())
+	}
+
+	return nil
+}
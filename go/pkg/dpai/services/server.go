@@ -178,6 +178,10 @@ func (s *GrpcService) Start(ctx context.Context) error {
 	// pb.RegisterDpaiHmsVersionServiceServer(s, server)
 	// pb.RegisterDpaiHmsServiceServer(s, server)
 
+	// start the scheduled backups of the postgres instances
+	backupScheduler := NewPostgresBackupScheduler(dpai, time.Duration(s.cfg.PostgresBackup.SchedulerIntervalSeconds)*time.Second)
+	go backupScheduler.Start(ctx)
+
 	reflection.Register(s.grpcServer)
 	listener, err := net.Listen("tcp", s.ListenAddr)
 	if err != nil {
@@ -41,9 +41,21 @@ func (k *K8sClient) isPodExists(namespace, name string) (exists bool, pod *corev
 }
 
 func (k *K8sClient) ExecInPod(namespace string, name string, command []string) error {
+	stdout, err := k.ExecInPodWithOutput(namespace, name, command)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Output: %v\n", stdout)
+
+	return nil
+}
+
+// ExecInPodWithOutput runs the command in the first container of the pod and returns its stdout.
+func (k *K8sClient) ExecInPodWithOutput(namespace string, name string, command []string) (string, error) {
 	exists, pod := k.isPodExists(namespace, name)
 	if !exists {
-		return fmt.Errorf("pod %s does not exist in namespace %s", name, namespace)
+		return "", fmt.Errorf("pod %s does not exist in namespace %s", name, namespace)
 	}
 
 	// Create exec request
@@ -66,7 +78,7 @@ func (k *K8sClient) ExecInPod(namespace string, name string, command []string) e
 	// Create executor
 	exec, err := remotecommand.NewSPDYExecutor(k.ClientConfig, "POST", req.URL())
 	if err != nil {
-		return fmt.Errorf("error creating the executor: %v", err)
+		return "", fmt.Errorf("error creating the executor: %v", err)
 	}
 
 	// Set up buffers for output
@@ -78,10 +90,8 @@ func (k *K8sClient) ExecInPod(namespace string, name string, command []string) e
 		Stderr: &stderr,
 	})
 	if err != nil {
-		return fmt.Errorf("error: %v\n Stderr: %v", err, stderr.String())
+		return "", fmt.Errorf("error: %v\n Stderr: %v", err, stderr.String())
 	}
 
-	fmt.Printf("Output: %v\n", stdout.String())
-
-	return nil
+	return stdout.String(), nil
 }
@@ -147,3 +147,30 @@ func (s *Storage) CreateAirflowObjectUser(bucketId string, userName string) (*pb
 	// Create
 	return user, nil
 }
+
+// CreatePostgresBackupObjectUser creates a principal that can archive WAL and base backups to the bucket and expire them.
+func (s *Storage) CreatePostgresBackupObjectUser(bucketId string, userName string) (*pb.ObjectUserPrivate, error) {
+	spec := pb.ObjectUserPermissionSpec{
+		BucketId:   bucketId,
+		Permission: []pb.BucketPermission{pb.BucketPermission_ReadBucket, pb.BucketPermission_WriteBucket, pb.BucketPermission_DeleteBucket},
+		Actions: []pb.ObjectBucketActions{
+			pb.ObjectBucketActions_GetBucketLocation,
+			pb.ObjectBucketActions_ListBucket,
+			pb.ObjectBucketActions_ListBucketMultipartUploads,
+			pb.ObjectBucketActions_ListMultipartUploadParts,
+		},
+	}
+
+	user, err := s.CreateObjectUser(&pb.CreateObjectUserPrivateRequest{
+		Metadata: &pb.ObjectUserMetadataCreate{
+			CloudAccountId: s.CloudAccountID,
+			Name:           userName,
+		},
+		Spec: []*pb.ObjectUserPermissionSpec{&spec},
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
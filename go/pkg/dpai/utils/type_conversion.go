@@ -52,6 +52,12 @@ func ConvertDpaiDeploymentChangeIndicatorToPbEnum(val string) (*pb.DpaiDeploymen
 		enumValue = pb.DpaiDeploymentChangeIndicator_DPAI_UPDATE
 	case "DPAI_DELETE":
 		enumValue = pb.DpaiDeploymentChangeIndicator_DPAI_DELETE
+	case "DPAI_BACKUP":
+		enumValue = pb.DpaiDeploymentChangeIndicator_DPAI_BACKUP
+	case "DPAI_RESTORE":
+		enumValue = pb.DpaiDeploymentChangeIndicator_DPAI_RESTORE
+	case "DPAI_BACKUP_POLICY_UPDATE":
+		enumValue = pb.DpaiDeploymentChangeIndicator_DPAI_BACKUP_POLICY_UPDATE
 	default:
 		return nil, fmt.Errorf("invalid changeIndicator value: %s", val)
 	}
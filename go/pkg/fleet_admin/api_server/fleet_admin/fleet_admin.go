@@ -153,7 +153,7 @@ func (s *FleetAdminService) GetResourcePatches(ctx context.Context, req *pb.GetR
 
 	logger.Info("Request", logkeys.Request, req)
 	resp, err := func() (*pb.GetResourcePatchesResponse, error) {
-		query := `select node_id, node_name, namespace from node where cluster_id = $1 and region = $2 and availability_zone = $3`
+		query := `select node_id, node_name, namespace, maintenance from node where cluster_id = $1 and region = $2 and availability_zone = $3`
 		rows, err := s.db.QueryContext(ctx, query, req.ClusterId, req.Region, req.AvailabilityZone)
 		if err != nil {
 			return nil, err
@@ -164,11 +164,12 @@ func (s *FleetAdminService) GetResourcePatches(ctx context.Context, req *pb.GetR
 			var nodeId int
 			var nodeName string
 			var namespace string
+			var maintenance bool
 			var sourceGroup string
 			var sourceVersion string
 			var sourceResource string
 
-			if err := rows.Scan(&nodeId, &nodeName, &namespace); err != nil {
+			if err := rows.Scan(&nodeId, &nodeName, &namespace, &maintenance); err != nil {
 				return nil, err
 			}
 
@@ -200,6 +201,10 @@ func (s *FleetAdminService) GetResourcePatches(ctx context.Context, req *pb.GetR
 				labels[key] = "true"
 			}
 
+			if maintenance {
+				labels[util.NodeMaintenanceLabel] = "true"
+			}
+
 			resourcePatch := &pb.ResourcePatch{
 				NodeName:  nodeName,
 				Namespace: namespace,
@@ -211,6 +216,7 @@ func (s *FleetAdminService) GetResourcePatches(ctx context.Context, req *pb.GetR
 				OwnedLabelsRegex: []string{
 					util.InstanceTypeLabelPrefix + ".*",
 					util.ComputeNodePoolLabelPrefix + ".*",
+					util.NodeMaintenanceLabelPrefix + ".*",
 				},
 				Labels: labels,
 			}
@@ -0,0 +1,4 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+ALTER TABLE node ADD maintenance boolean not null default false;
@@ -131,7 +131,7 @@ func (s *FleetAdminUIService) SearchNodes(ctx context.Context, req *pb.SearchNod
 		var err error
 		query := `
 				select
-				node.node_id, node.node_name, node.region, node.availability_zone, node.cluster_id, node.namespace,
+				node.node_id, node.node_name, node.region, node.availability_zone, node.cluster_id, node.namespace, node.maintenance,
 				node_stats.instance_category, node_stats.free_millicpu, node_stats.used_millicpu, 
 				node_stats.free_memory_bytes, node_stats.used_memory_bytes, node_stats.free_gpu, node_stats.used_gpu
 				from node
@@ -161,6 +161,7 @@ func (s *FleetAdminUIService) SearchNodes(ctx context.Context, req *pb.SearchNod
 			var clusterId string
 			var namespace string
 			var availabilityZone string
+			var maintenance bool
 			var instanceCategory string
 			var freeMillicpu int32
 			var usedMillicpu int32
@@ -169,7 +170,7 @@ func (s *FleetAdminUIService) SearchNodes(ctx context.Context, req *pb.SearchNod
 			var freeGpu int32
 			var usedGpu int32
 
-			if err := rows.Scan(&nodeId, &nodeName, &region, &availabilityZone, &clusterId, &namespace,
+			if err := rows.Scan(&nodeId, &nodeName, &region, &availabilityZone, &clusterId, &namespace, &maintenance,
 				&instanceCategory, &freeMillicpu, &usedMillicpu, &freeMemoryBytes, &usedMemoryBytes, &freeGpu, &usedGpu); err != nil {
 				return nil, err
 			}
@@ -192,6 +193,7 @@ func (s *FleetAdminUIService) SearchNodes(ctx context.Context, req *pb.SearchNod
 				PoolIds:                 pools,
 				PercentageResourcesUsed: percentageResourcesUsed,
 				NodeId:                  int32(nodeId),
+				Maintenance:             maintenance,
 			}
 			computeNodes = append(computeNodes, computeNode)
 		}
@@ -258,6 +260,15 @@ func (s *FleetAdminUIService) UpdateNode(ctx context.Context, req *pb.UpdateNode
 			return nil, fmt.Errorf("update override_pools into node: %w", err)
 		}
 
+		// Maintenance
+		// Running VM instances are moved off the node by VM Instance Operator when the node is labeled by K8s Resource Patcher.
+		if req.Maintenance != nil {
+			queryUpdateNodeMaintenance := `update node set maintenance = $1 where node_id = $2`
+			if _, err := tx.ExecContext(ctx, queryUpdateNodeMaintenance, req.GetMaintenance(), req.NodeId); err != nil {
+				return nil, fmt.Errorf("update maintenance into node: %w", err)
+			}
+		}
+
 		if err := tx.Commit(); err != nil {
 			return nil, fmt.Errorf("commit: %w", err)
 		}
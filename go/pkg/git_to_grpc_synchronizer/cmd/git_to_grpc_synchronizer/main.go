@@ -4,6 +4,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io/fs"
@@ -25,6 +26,13 @@ const instanceTypeKind = "InstanceType"
 const subnetKind = "Subnet"
 const productKind = "Product"
 
+const syncMode = "sync"
+const planMode = "plan"
+const driftMode = "drift"
+
+const textOutput = "text"
+const jsonOutput = "json"
+
 type KindInfo struct {
 	NewSynchronizer func(fs.FS, *grpc.ClientConn) (*gittogrpcsynchronizer.GitToGrpcSynchronizer, error)
 }
@@ -49,9 +57,16 @@ func main() {
 	var kind string
 	var dir string
 	var target string
+	var mode string
+	var output string
+	var maxDeletes int
 	flag.StringVar(&kind, "kind", "", "one of: "+strings.Join(kinds, ", "))
 	flag.StringVar(&dir, "dir", "", "directory that contains all yaml files")
 	flag.StringVar(&target, "target", "", "address of GRPC server in format host:port")
+	flag.StringVar(&mode, "mode", syncMode, "one of: "+syncMode+" (apply changes), "+planMode+" (print changes without applying them), "+
+		driftMode+" (print server objects not backed by files without deleting them)")
+	flag.StringVar(&output, "output", textOutput, "format of the plan and drift report, one of: "+textOutput+", "+jsonOutput)
+	flag.IntVar(&maxDeletes, "max-deletes", 0, "abort synchronization without making changes if more objects would be deleted (0 for no limit)")
 
 	log.BindFlags()
 	flag.Parse()
@@ -74,7 +89,13 @@ func main() {
 		if !ok {
 			return fmt.Errorf("unsupported kind %s", kind)
 		}
-		log.Info("Synchronization started", "kind", kind, "dir", dir, "target", target)
+		if mode != syncMode && mode != planMode && mode != driftMode {
+			return fmt.Errorf("unsupported mode %s", mode)
+		}
+		if output != textOutput && output != jsonOutput {
+			return fmt.Errorf("unsupported output %s", output)
+		}
+		log.Info("Synchronization started", "kind", kind, "dir", dir, "target", target, "mode", mode)
 
 		dialOptions := []grpc.DialOption{}
 		clientConn, err := grpcutil.NewClient(ctx, target, dialOptions...)
@@ -86,11 +107,40 @@ func main() {
 		if err != nil {
 			return err
 		}
-		changed, err := synchronizer.Synchronize(ctx)
-		if err != nil {
-			return err
+		synchronizer.MaxDeletes = maxDeletes
+
+		switch mode {
+		case planMode:
+			plan, err := synchronizer.Plan(ctx)
+			if err != nil {
+				return err
+			}
+			if output == jsonOutput {
+				return printJson(plan)
+			}
+			fmt.Print(plan)
+			if maxDeletes > 0 && len(plan.Deletes) > maxDeletes {
+				fmt.Printf("Synchronization would be aborted: %d objects would be deleted but max-deletes is %d.\n", len(plan.Deletes), maxDeletes)
+			}
+		case driftMode:
+			drift, err := synchronizer.DetectDrift(ctx)
+			if err != nil {
+				return err
+			}
+			if output == jsonOutput {
+				return printJson(drift)
+			}
+			fmt.Printf("Drift: %d server objects are not backed by files.\n", len(drift))
+			for _, change := range drift {
+				fmt.Printf("! %s\n", change.Key)
+			}
+		default:
+			changed, err := synchronizer.Synchronize(ctx)
+			if err != nil {
+				return err
+			}
+			log.Info("Synchronization complete", "changed", changed)
 		}
-		log.Info("Synchronization complete", "changed", changed)
 		return nil
 	}()
 	if err != nil {
@@ -98,3 +148,12 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+func printJson(value any) error {
+	jsonBytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(jsonBytes))
+	return nil
+}
@@ -59,81 +59,122 @@ type GitToGrpcSynchronizer struct {
 	// key returned by the gRPC server. If not specified, proto.Equal() function will be used for comparison.
 	// Ignored if SearchMethod is empty.
 	MessageComparatorFunc func(fileMessage proto.Message, serverMessage proto.Message) (bool, error)
+	// A function that returns the field differences between a Protobuf message deserialized from a file and a Protobuf
+	// message with matching key returned by the gRPC server. Used to describe updates in a Plan.
+	// If not specified, the JSON representations of both messages are compared field by field. If the messages are of
+	// different types, the server message is first converted to the type of EmptyMessage.
+	// Ignored if SearchMethod is empty.
+	MessageDiffFunc func(fileMessage proto.Message, serverMessage proto.Message) ([]FieldDiff, error)
+	// The maximum number of messages that Synchronize may delete. If the plan would delete more messages, Synchronize
+	// fails without making any changes. This protects against a truncated or incorrect directory.
+	// If zero, there is no limit.
+	MaxDeletes int
 }
 
+// Returned (wrapped) by Synchronize when more than MaxDeletes messages would be deleted.
+var ErrTooManyDeletes = errors.New("too many deletes")
+
 // Perform synchronization.
 // Returns (true, nil) if changes were made.
 // Returns (false, nil) if no changes were needed.
+// If the plan exceeds MaxDeletes, an error wrapping ErrTooManyDeletes is returned and no changes are made.
 func (g *GitToGrpcSynchronizer) Synchronize(ctx context.Context) (bool, error) {
-	g.init(ctx)
 	log := log.FromContext(ctx).WithName("GitToGrpcSynchronizer.Synchronize")
-	fileMessages, err := g.listFileMessages(ctx)
+	plan, err := g.Plan(ctx)
 	if err != nil {
 		return false, fmt.Errorf("GitToGrpcSynchronizer.Synchronize: %w", err)
 	}
+	if g.MaxDeletes > 0 && len(plan.Deletes) > g.MaxDeletes {
+		return false, fmt.Errorf("GitToGrpcSynchronizer.Synchronize: %w: %d messages would be deleted but MaxDeletes is %d",
+			ErrTooManyDeletes, len(plan.Deletes), g.MaxDeletes)
+	}
+
+	invocations := []struct {
+		Method  string
+		Changes []PlanChange
+	}{
+		{Method: g.DeleteMethod, Changes: plan.Deletes},
+		{Method: g.CreateMethod, Changes: plan.Creates},
+		{Method: g.UpdateMethod, Changes: plan.Updates},
+	}
+	for _, invoc := range invocations {
+		log.V(1).Info("Calling API", "Method", invoc.Method, "Changes", fmt.Sprintf("%#v", invoc.Changes))
+		if err := g.sendMessages(ctx, invoc.Method, invoc.Changes); err != nil {
+			return false, fmt.Errorf("GitToGrpcSynchronizer.Synchronize: %w", err)
+		}
+	}
+	return plan.Changed(), nil
+}
+
+// Plan determines the changes that Synchronize would make, without making them.
+func (g *GitToGrpcSynchronizer) Plan(ctx context.Context) (*Plan, error) {
+	g.init(ctx)
+	log := log.FromContext(ctx).WithName("GitToGrpcSynchronizer.Plan")
+	fileMessages, err := g.listFileMessages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GitToGrpcSynchronizer.Plan: %w", err)
+	}
 	serverMessages, err := g.listServerMessages(ctx)
 	if err != nil {
-		return false, fmt.Errorf("GitToGrpcSynchronizer.Synchronize: %w", err)
+		return nil, fmt.Errorf("GitToGrpcSynchronizer.Plan: %w", err)
 	}
 	log.V(1).Info("List results", "serverMessages", fmt.Sprintf("%#v", serverMessages), "fileMessages", fmt.Sprintf("%#v", fileMessages))
+	plan := &Plan{}
 	// Determine messages that need to be Created or Updated.
-	createMessages := make(map[any]proto.Message)
-	updateMessages := make(map[any]proto.Message)
 	for key, fileMessage := range fileMessages {
 		serverMessage, exists := serverMessages[key]
 		if exists {
 			equals, err := g.MessageComparatorFunc(fileMessage, serverMessage)
 			if err != nil {
-				return false, fmt.Errorf("GitToGrpcSynchronizer.Synchronize: %w", err)
+				return nil, fmt.Errorf("GitToGrpcSynchronizer.Plan: %w", err)
 			}
 			if equals {
 				log.Info("Unmodified", "key", key)
+				plan.Unmodified++
 			} else {
 				log.Info("Modified", "key", key)
 				updateMessage, err := g.MessageToUpdateRequestFunc(fileMessage, serverMessage)
 				if err != nil {
-					return false, fmt.Errorf("GitToGrpcSynchronizer.Synchronize: %w", err)
+					return nil, fmt.Errorf("GitToGrpcSynchronizer.Plan: %w", err)
 				}
-				updateMessages[key] = updateMessage
+				diff, err := g.MessageDiffFunc(fileMessage, serverMessage)
+				if err != nil {
+					return nil, fmt.Errorf("GitToGrpcSynchronizer.Plan: MessageDiffFunc: %w", err)
+				}
+				plan.Updates = append(plan.Updates, newPlanChange(PlanActionUpdate, key, updateMessage, diff))
 			}
 			// Delete from serverMessages so we can identify extra messages.
 			delete(serverMessages, key)
 		} else {
 			log.Info("New", "key", key)
-			createMessages[key] = fileMessage
+			plan.Creates = append(plan.Creates, newPlanChange(PlanActionCreate, key, fileMessage, nil))
 		}
 	}
 	// Determine messages that need to be Deleted.
-	deleteMessages := make(map[any]proto.Message)
 	for key, serverMessage := range serverMessages {
 		deleteMessage, err := g.MessageToDeleteRequestFunc(serverMessage)
 		if err != nil {
-			return false, fmt.Errorf("GitToGrpcSynchronizer.Synchronize: %w", err)
+			return nil, fmt.Errorf("GitToGrpcSynchronizer.Plan: %w", err)
 		}
-		log.Info("Extra (will be deleted from server)", "key", key)
-		deleteMessages[key] = deleteMessage
+		log.Info("Extra (not backed by a file)", "key", key)
+		plan.Deletes = append(plan.Deletes, newPlanChange(PlanActionDelete, key, deleteMessage, nil))
 	}
+	plan.sort()
 	log.V(1).Info("Actions",
-		"createMessages", fmt.Sprintf("%#v", createMessages),
-		"updateMessages", fmt.Sprintf("%#v", updateMessages),
-		"deleteMessages", fmt.Sprintf("%#v", deleteMessages))
+		"creates", fmt.Sprintf("%#v", plan.Creates),
+		"updates", fmt.Sprintf("%#v", plan.Updates),
+		"deletes", fmt.Sprintf("%#v", plan.Deletes))
+	return plan, nil
+}
 
-	invocations := []struct {
-		Method   string
-		Messages map[any]proto.Message
-	}{
-		{Method: g.DeleteMethod, Messages: deleteMessages},
-		{Method: g.CreateMethod, Messages: createMessages},
-		{Method: g.UpdateMethod, Messages: updateMessages},
-	}
-	for _, invoc := range invocations {
-		log.V(1).Info("Calling API", "Method", invoc.Method, "Messages", fmt.Sprintf("%#v", invoc.Messages))
-		if err := g.sendMessages(ctx, invoc.Method, invoc.Messages); err != nil {
-			return false, fmt.Errorf("GitToGrpcSynchronizer.Synchronize: %w", err)
-		}
+// DetectDrift returns the server messages that are not backed by a file.
+// Unlike Synchronize, these messages are only reported and never deleted.
+func (g *GitToGrpcSynchronizer) DetectDrift(ctx context.Context) ([]PlanChange, error) {
+	plan, err := g.Plan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GitToGrpcSynchronizer.DetectDrift: %w", err)
 	}
-	changed := len(createMessages)+len(updateMessages)+len(deleteMessages) > 0
-	return changed, nil
+	return plan.Deletes, nil
 }
 
 func (g *GitToGrpcSynchronizer) init(ctx context.Context) {
@@ -143,6 +184,9 @@ func (g *GitToGrpcSynchronizer) init(ctx context.Context) {
 			return proto.Equal(fileMessage, serverMessage), nil
 		}
 	}
+	if g.MessageDiffFunc == nil {
+		g.MessageDiffFunc = g.diffMessages
+	}
 }
 
 func (g *GitToGrpcSynchronizer) listFileMessages(ctx context.Context) (map[any]proto.Message, error) {
@@ -271,11 +315,11 @@ func (g *GitToGrpcSynchronizer) listServerMessages(ctx context.Context) (map[any
 	return messages, nil
 }
 
-func (g *GitToGrpcSynchronizer) sendMessages(ctx context.Context, method string, messages map[any]proto.Message) error {
-	for key, message := range messages {
+func (g *GitToGrpcSynchronizer) sendMessages(ctx context.Context, method string, changes []PlanChange) error {
+	for _, change := range changes {
 		reply := &emptypb.Empty{}
-		if err := g.ClientConn.Invoke(ctx, method, message, reply); err != nil {
-			return fmt.Errorf("GitToGrpcSynchronizer.sendMessages: %s: %w", key, err)
+		if err := g.ClientConn.Invoke(ctx, method, change.request, reply); err != nil {
+			return fmt.Errorf("GitToGrpcSynchronizer.sendMessages: %s: %w", change.Key, err)
 		}
 	}
 	return nil
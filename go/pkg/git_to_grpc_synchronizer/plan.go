@@ -0,0 +1,186 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package machine_image
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type PlanAction string
+
+const (
+	PlanActionCreate PlanAction = "create"
+	PlanActionUpdate PlanAction = "update"
+	PlanActionDelete PlanAction = "delete"
+)
+
+// Plan is the set of changes that Synchronize would make on the gRPC server.
+type Plan struct {
+	Creates []PlanChange `json:"creates"`
+	Updates []PlanChange `json:"updates"`
+	Deletes []PlanChange `json:"deletes"`
+	// The number of files that match the server.
+	Unmodified int `json:"unmodified"`
+}
+
+// PlanChange is a single message that would be created, updated, or deleted.
+type PlanChange struct {
+	Action PlanAction `json:"action"`
+	// The message key as returned by MessageKeyFunc, formatted as a string.
+	Key string `json:"key"`
+	// The changed fields. Only set for updates.
+	Diff []FieldDiff `json:"diff,omitempty"`
+	// The request that will be sent to the gRPC method.
+	request proto.Message
+}
+
+// FieldDiff is a field that differs between a file and the server.
+// Path uses the JSON field names, with nested fields separated by "." and list indexes in brackets.
+// A nil value means that the field is not set.
+type FieldDiff struct {
+	Path   string `json:"path"`
+	Server any    `json:"server"`
+	File   any    `json:"file"`
+}
+
+func newPlanChange(action PlanAction, key any, request proto.Message, diff []FieldDiff) PlanChange {
+	return PlanChange{
+		Action:  action,
+		Key:     fmt.Sprintf("%+v", key),
+		Diff:    diff,
+		request: request,
+	}
+}
+
+// Returns true if the plan contains any creates, updates, or deletes.
+func (p *Plan) Changed() bool {
+	return len(p.Creates)+len(p.Updates)+len(p.Deletes) > 0
+}
+
+// Sort changes by key so that the output is stable.
+func (p *Plan) sort() {
+	for _, changes := range [][]PlanChange{p.Creates, p.Updates, p.Deletes} {
+		sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	}
+}
+
+// String formats the plan as a human-readable diff.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Plan: %d to create, %d to update, %d to delete, %d unmodified.\n",
+		len(p.Creates), len(p.Updates), len(p.Deletes), p.Unmodified)
+	for _, change := range p.Creates {
+		fmt.Fprintf(&sb, "+ %s\n", change.Key)
+	}
+	for _, change := range p.Updates {
+		fmt.Fprintf(&sb, "~ %s\n", change.Key)
+		for _, fieldDiff := range change.Diff {
+			fmt.Fprintf(&sb, "    %s: %s -> %s\n", fieldDiff.Path, formatFieldValue(fieldDiff.Server), formatFieldValue(fieldDiff.File))
+		}
+	}
+	for _, change := range p.Deletes {
+		fmt.Fprintf(&sb, "- %s\n", change.Key)
+	}
+	return sb.String()
+}
+
+func formatFieldValue(value any) string {
+	if value == nil {
+		return "(unset)"
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
+
+// The default MessageDiffFunc.
+func (g *GitToGrpcSynchronizer) diffMessages(fileMessage proto.Message, serverMessage proto.Message) ([]FieldDiff, error) {
+	if fileMessage.ProtoReflect().Descriptor() != serverMessage.ProtoReflect().Descriptor() {
+		// Keep only the server fields that can be set by a file.
+		converted, err := convertMessage(serverMessage, g.EmptyMessage)
+		if err != nil {
+			return nil, err
+		}
+		serverMessage = converted
+	}
+	fileFields, err := flattenMessage(fileMessage)
+	if err != nil {
+		return nil, err
+	}
+	serverFields, err := flattenMessage(serverMessage)
+	if err != nil {
+		return nil, err
+	}
+	var diff []FieldDiff
+	for path, fileValue := range fileFields {
+		serverValue := serverFields[path]
+		if !reflect.DeepEqual(fileValue, serverValue) {
+			diff = append(diff, FieldDiff{Path: path, Server: serverValue, File: fileValue})
+		}
+	}
+	for path, serverValue := range serverFields {
+		if _, exists := fileFields[path]; !exists {
+			diff = append(diff, FieldDiff{Path: path, Server: serverValue})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Path < diff[j].Path })
+	return diff, nil
+}
+
+// Convert a Protobuf message to the type of emptyMessage using the JSON representation.
+// Fields that do not exist in emptyMessage are discarded.
+func convertMessage(message proto.Message, emptyMessage proto.Message) (proto.Message, error) {
+	jsonBytes, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("convertMessage: Marshal: %w", err)
+	}
+	converted := proto.Clone(emptyMessage)
+	proto.Reset(converted)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(jsonBytes, converted); err != nil {
+		return nil, fmt.Errorf("convertMessage: Unmarshal: %w", err)
+	}
+	return converted, nil
+}
+
+// Flatten the JSON representation of a Protobuf message to a map from field path to scalar value.
+func flattenMessage(message proto.Message) (map[string]any, error) {
+	jsonBytes, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("flattenMessage: Marshal: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(jsonBytes, &value); err != nil {
+		return nil, fmt.Errorf("flattenMessage: Unmarshal: %w", err)
+	}
+	fields := make(map[string]any)
+	flattenValue("", value, fields)
+	return fields, nil
+}
+
+func flattenValue(path string, value any, fields map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for name, child := range v {
+			childPath := name
+			if path != "" {
+				childPath = path + "." + name
+			}
+			flattenValue(childPath, child, fields)
+		}
+	case []any:
+		for i, child := range v {
+			flattenValue(fmt.Sprintf("%s[%d]", path, i), child, fields)
+		}
+	default:
+		fields[path] = v
+	}
+}
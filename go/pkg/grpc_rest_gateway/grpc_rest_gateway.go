@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/grpcutil"
@@ -62,7 +63,7 @@ func (s *RestService) Start(ctx context.Context) error {
 	mux.HandleFunc("/readyz", readyz)
 	mux.HandleFunc("/livez", readyz)
 
-	gwmux := runtime.NewServeMux()
+	gwmux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher))
 	clientConn, err := grpcutil.NewClient(ctx, s.TargetAddr)
 	if err != nil {
 		return err
@@ -87,6 +88,15 @@ func (s *RestService) Start(ctx context.Context) error {
 	return nil
 }
 
+// IncomingHeaderMatcher forwards the Idempotency-Key HTTP header to gRPC metadata,
+// in addition to the headers forwarded by runtime.DefaultHeaderMatcher.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, grpcutil.IdempotencyKeyMetadataKey) {
+		return grpcutil.IdempotencyKeyMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func (s *RestService) Stop(ctx context.Context) error {
 	log := log.FromContext(ctx).WithName("RestService.Stop")
 	log.Info("BEGIN")
@@ -0,0 +1,476 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpc_rest_gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// OpenAI compatible paths of the models of the MaasGateway service. OpenAI SDKs can use them unchanged, with the base
+// url https://<host>/v1/cloudaccounts/<cloud account id>/maas and an IDC token as API key.
+const (
+	OpenAIChatCompletionsPath = "/v1/cloudaccounts/{cloudAccountId}/maas/chat/completions"
+	OpenAIModelsPath          = "/v1/cloudaccounts/{cloudAccountId}/maas/models"
+)
+
+// RegisterOpenAIHandlers registers the handlers of the OpenAI compatible API, which call the MaasGateway service.
+// Unlike the handlers generated by protoc, they follow the OpenAI wire format, with server-sent events when streaming.
+func RegisterOpenAIHandlers(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	h := &openAIHandler{
+		mux:    mux,
+		client: pb.NewMaasGatewayClient(conn),
+	}
+	if err := mux.HandlePath(http.MethodPost, OpenAIChatCompletionsPath, h.chatCompletions); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, OpenAIModelsPath, h.models)
+}
+
+type openAIHandler struct {
+	mux    *runtime.ServeMux
+	client pb.MaasGatewayClient
+}
+
+type openAIChatCompletionRequest struct {
+	Model               string              `json:"model"`
+	Messages            []openAIChatMessage `json:"messages"`
+	MaxTokens           *uint32             `json:"max_tokens"`
+	MaxCompletionTokens *uint32             `json:"max_completion_tokens"`
+	Temperature         *float32            `json:"temperature"`
+	TopP                *float32            `json:"top_p"`
+	N                   *uint32             `json:"n"`
+	Stop                openAIStop          `json:"stop"`
+	Stream              bool                `json:"stream"`
+	StreamOptions       *struct {
+		IncludeUsage bool `json:"include_usage"`
+	} `json:"stream_options"`
+	FrequencyPenalty *float32 `json:"frequency_penalty"`
+	PresencePenalty  *float32 `json:"presence_penalty"`
+	Seed             *uint64  `json:"seed"`
+	Logprobs         *bool    `json:"logprobs"`
+	TopLogprobs      *uint32  `json:"top_logprobs"`
+	// Extensions selecting the product of the model. By default, the first product of the model is used.
+	ProductName string `json:"product_name"`
+	ProductId   string `json:"product_id"`
+}
+
+type openAIChatMessage struct {
+	Role    string        `json:"role"`
+	Content openAIContent `json:"content"`
+}
+
+// openAIContent is the content of a message, either a string or a list of text parts.
+type openAIContent string
+
+func (c *openAIContent) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*c = openAIContent(text)
+		return nil
+	}
+	var parts []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return errors.New("content must be a string or a list of content parts")
+	}
+	sb := strings.Builder{}
+	for _, part := range parts {
+		if part.Type != "text" {
+			return fmt.Errorf("content parts of type %q are not supported", part.Type)
+		}
+		sb.WriteString(part.Text)
+	}
+	*c = openAIContent(sb.String())
+	return nil
+}
+
+// openAIStop is either a stop sequence or a list of stop sequences.
+type openAIStop []string
+
+func (s *openAIStop) UnmarshalJSON(data []byte) error {
+	var stop string
+	if err := json.Unmarshal(data, &stop); err == nil {
+		*s = openAIStop{stop}
+		return nil
+	}
+	var stops []string
+	if err := json.Unmarshal(data, &stops); err != nil {
+		return errors.New("stop must be a string or a list of strings")
+	}
+	*s = stops
+	return nil
+}
+
+type openAIChatCompletion struct {
+	Id                string         `json:"id"`
+	Object            string         `json:"object"`
+	Created           uint64         `json:"created"`
+	Model             string         `json:"model"`
+	SystemFingerprint string         `json:"system_fingerprint,omitempty"`
+	Choices           []openAIChoice `json:"choices"`
+	Usage             *openAIUsage   `json:"usage,omitempty"`
+}
+
+type openAIChoice struct {
+	Index        uint32          `json:"index"`
+	Message      *openAIMessage  `json:"message,omitempty"`
+	Delta        *openAIMessage  `json:"delta,omitempty"`
+	Logprobs     *openAILogprobs `json:"logprobs"`
+	FinishReason *string         `json:"finish_reason"`
+}
+
+type openAIMessage struct {
+	Role    string `json:"role,omitempty"`
+	Content string `json:"content"`
+}
+
+type openAILogprobs struct {
+	Content []openAILogprob `json:"content"`
+}
+
+type openAILogprob struct {
+	Token       string          `json:"token"`
+	Logprob     float32         `json:"logprob"`
+	TopLogprobs []openAILogprob `json:"top_logprobs"`
+}
+
+type openAIUsage struct {
+	PromptTokens     uint32 `json:"prompt_tokens"`
+	CompletionTokens uint32 `json:"completion_tokens"`
+	TotalTokens      uint32 `json:"total_tokens"`
+}
+
+type openAIModel struct {
+	Id      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+}
+
+type openAIModelList struct {
+	Object string        `json:"object"`
+	Data   []openAIModel `json:"data"`
+}
+
+type openAIErrorResponse struct {
+	Error openAIError `json:"error"`
+}
+
+type openAIError struct {
+	Message string  `json:"message"`
+	Type    string  `json:"type"`
+	Param   *string `json:"param"`
+	Code    string  `json:"code"`
+}
+
+func (h *openAIHandler) chatCompletions(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx, err := runtime.AnnotateContext(r.Context(), h.mux, r, "/proto.MaasGateway/ChatCompletionStream", runtime.WithHTTPPathPattern(OpenAIChatCompletionsPath))
+	if err != nil {
+		writeOpenAIError(w, err)
+		return
+	}
+	logger := log.FromContext(ctx).WithName("openAIHandler.chatCompletions")
+
+	body := openAIChatCompletionRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeOpenAIError(w, status.Errorf(codes.InvalidArgument, "invalid request body: %v", err))
+		return
+	}
+	req, err := h.maasChatCompletionRequest(ctx, pathParams["cloudAccountId"], &body)
+	if err != nil {
+		writeOpenAIError(w, err)
+		return
+	}
+
+	// Completions can take longer than the write timeout of the server.
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		logger.Error(err, "unable to clear the write deadline")
+	}
+
+	stream, err := h.client.ChatCompletionStream(ctx, req)
+	if err != nil {
+		writeOpenAIError(w, err)
+		return
+	}
+	// Errors of the request are only returned when receiving, so the first response is received before writing the
+	// status of the response.
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		writeOpenAIError(w, err)
+		return
+	}
+	responses := &chatCompletionResponses{first: first, stream: stream}
+
+	if !body.Stream {
+		completion, err := aggregateChatCompletion(responses.forEach)
+		if err != nil {
+			writeOpenAIError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, completion)
+		return
+	}
+
+	includeUsage := body.StreamOptions != nil && body.StreamOptions.IncludeUsage
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	writeEvent := func(data []byte) bool {
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+			logger.Error(err, "unable to write event")
+			return false
+		}
+		if err := rc.Flush(); err != nil {
+			logger.Error(err, "unable to flush event")
+			return false
+		}
+		return true
+	}
+	err = responses.forEach(func(resp *pb.MaasChatCompletionResponse) bool {
+		if resp.Usage != nil && !includeUsage {
+			return true
+		}
+		data, err := json.Marshal(openAIChatCompletionChunk(resp))
+		if err != nil {
+			logger.Error(err, "unable to marshal chunk")
+			return false
+		}
+		return writeEvent(data)
+	})
+	if err != nil {
+		// The status of the response was already written, so the error is sent as an event, as OpenAI does.
+		data, _ := json.Marshal(newOpenAIErrorResponse(err))
+		writeEvent(data)
+		return
+	}
+	writeEvent([]byte("[DONE]"))
+}
+
+// maasChatCompletionRequest returns the request of the MaasGateway service for an OpenAI request, finding the product
+// of the model when it is not given.
+func (h *openAIHandler) maasChatCompletionRequest(ctx context.Context, cloudAccountId string, body *openAIChatCompletionRequest) (*pb.MaasChatCompletionRequest, error) {
+	req := &pb.MaasChatCompletionRequest{
+		Model:            body.Model,
+		MaxTokens:        body.MaxTokens,
+		Temperature:      body.Temperature,
+		TopP:             body.TopP,
+		N:                body.N,
+		Stop:             body.Stop,
+		FrequencyPenalty: body.FrequencyPenalty,
+		PresencePenalty:  body.PresencePenalty,
+		Seed:             body.Seed,
+		Logprobs:         body.Logprobs,
+		TopLogprobs:      body.TopLogprobs,
+		CloudAccountId:   cloudAccountId,
+		ProductName:      body.ProductName,
+		ProductId:        body.ProductId,
+	}
+	if req.MaxTokens == nil {
+		req.MaxTokens = body.MaxCompletionTokens
+	}
+	for _, message := range body.Messages {
+		role := message.Role
+		// Newer OpenAI models use the developer role for system messages.
+		if role == "developer" {
+			role = "system"
+		}
+		req.Messages = append(req.Messages, &pb.ChatCompletionMessage{Role: role, Content: string(message.Content)})
+	}
+
+	if req.ProductName != "" && req.ProductId != "" {
+		return req, nil
+	}
+	models, err := h.client.GetSupportedModels(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	for _, model := range models.GetModels() {
+		if model.ModelName != req.Model ||
+			(req.ProductName != "" && model.ProductName != req.ProductName) ||
+			(req.ProductId != "" && model.ProductId != req.ProductId) {
+			continue
+		}
+		req.ProductName = model.ProductName
+		req.ProductId = model.ProductId
+		return req, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "the model %q does not exist", req.Model)
+}
+
+// chatCompletionResponses are the responses of a chat completion stream, after its first response.
+type chatCompletionResponses struct {
+	first  *pb.MaasChatCompletionResponse
+	stream pb.MaasGateway_ChatCompletionStreamClient
+}
+
+// forEach calls f for each response until it returns false, and returns the error of the stream.
+func (r *chatCompletionResponses) forEach(f func(*pb.MaasChatCompletionResponse) bool) error {
+	for resp := r.first; resp != nil; {
+		if !f(resp) {
+			return nil
+		}
+		var err error
+		resp, err = r.stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func openAIChatCompletionChunk(resp *pb.MaasChatCompletionResponse) *openAIChatCompletion {
+	chunk := &openAIChatCompletion{
+		Id:                resp.Id,
+		Object:            resp.Object,
+		Created:           resp.Created,
+		Model:             resp.Model,
+		SystemFingerprint: resp.SystemFingerprint,
+		Choices:           []openAIChoice{},
+		Usage:             newOpenAIUsage(resp.Usage),
+	}
+	for _, choice := range resp.Choices {
+		chunk.Choices = append(chunk.Choices, openAIChoice{
+			Index:        choice.Index,
+			Delta:        &openAIMessage{Role: choice.GetDelta().GetRole(), Content: choice.GetDelta().GetContent()},
+			Logprobs:     newOpenAILogprobs(choice.Logprobs),
+			FinishReason: choice.FinishReason,
+		})
+	}
+	return chunk
+}
+
+// aggregateChatCompletion returns the chat completion made of all the responses of the stream.
+func aggregateChatCompletion(forEach func(f func(*pb.MaasChatCompletionResponse) bool) error) (*openAIChatCompletion, error) {
+	completion := &openAIChatCompletion{Object: "chat.completion", Choices: []openAIChoice{}}
+	choices := map[uint32]*openAIChoice{}
+	contents := map[uint32]*strings.Builder{}
+	err := forEach(func(resp *pb.MaasChatCompletionResponse) bool {
+		completion.Id = resp.Id
+		completion.Created = resp.Created
+		completion.Model = resp.Model
+		completion.SystemFingerprint = resp.SystemFingerprint
+		if resp.Usage != nil {
+			completion.Usage = newOpenAIUsage(resp.Usage)
+		}
+		for _, respChoice := range resp.Choices {
+			choice, ok := choices[respChoice.Index]
+			if !ok {
+				choice = &openAIChoice{Index: respChoice.Index, Message: &openAIMessage{Role: "assistant"}}
+				choices[respChoice.Index] = choice
+				contents[respChoice.Index] = &strings.Builder{}
+			}
+			contents[respChoice.Index].WriteString(respChoice.GetDelta().GetContent())
+			if logprobs := newOpenAILogprobs(respChoice.Logprobs); logprobs != nil {
+				if choice.Logprobs == nil {
+					choice.Logprobs = &openAILogprobs{}
+				}
+				choice.Logprobs.Content = append(choice.Logprobs.Content, logprobs.Content...)
+			}
+			if respChoice.FinishReason != nil {
+				choice.FinishReason = respChoice.FinishReason
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	for index, choice := range choices {
+		choice.Message.Content = contents[index].String()
+		completion.Choices = append(completion.Choices, *choice)
+	}
+	sort.Slice(completion.Choices, func(i, j int) bool { return completion.Choices[i].Index < completion.Choices[j].Index })
+	return completion, nil
+}
+
+func newOpenAIUsage(usage *pb.ChatCompletionUsage) *openAIUsage {
+	if usage == nil {
+		return nil
+	}
+	return &openAIUsage{
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		TotalTokens:      usage.PromptTokens + usage.CompletionTokens,
+	}
+}
+
+func newOpenAILogprobs(logprobs *pb.ChatCompletionStreamLogprobsContent) *openAILogprobs {
+	if logprobs == nil {
+		return nil
+	}
+	result := &openAILogprobs{Content: []openAILogprob{}}
+	for _, logprob := range logprobs.Content {
+		result.Content = append(result.Content, openAILogprob{Token: logprob.Token, Logprob: logprob.Logprob, TopLogprobs: []openAILogprob{}})
+	}
+	return result
+}
+
+func (h *openAIHandler) models(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx, err := runtime.AnnotateContext(r.Context(), h.mux, r, "/proto.MaasGateway/GetSupportedModels", runtime.WithHTTPPathPattern(OpenAIModelsPath))
+	if err != nil {
+		writeOpenAIError(w, err)
+		return
+	}
+	models, err := h.client.GetSupportedModels(ctx, &emptypb.Empty{})
+	if err != nil {
+		writeOpenAIError(w, err)
+		return
+	}
+	list := openAIModelList{Object: "list", Data: []openAIModel{}}
+	seen := map[string]bool{}
+	for _, model := range models.GetModels() {
+		if model.ModelName == "" || seen[model.ModelName] {
+			continue
+		}
+		seen[model.ModelName] = true
+		list.Data = append(list.Data, openAIModel{Id: model.ModelName, Object: "model", OwnedBy: "intel"})
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+func newOpenAIErrorResponse(err error) *openAIErrorResponse {
+	st := status.Convert(err)
+	errorType := "server_error"
+	if httpStatus := runtime.HTTPStatusFromCode(st.Code()); httpStatus >= 400 && httpStatus < 500 {
+		errorType = "invalid_request_error"
+	}
+	return &openAIErrorResponse{
+		Error: openAIError{
+			Message: st.Message(),
+			Type:    errorType,
+			Code:    strings.ToLower(st.Code().String()),
+		},
+	}
+}
+
+func writeOpenAIError(w http.ResponseWriter, err error) {
+	writeJSON(w, runtime.HTTPStatusFromCode(status.Code(err)), newOpenAIErrorResponse(err))
+}
+
+func writeJSON(w http.ResponseWriter, httpStatus int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,187 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpc_rest_gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeMaasGatewayServer struct {
+	pb.UnimplementedMaasGatewayServer
+	request       *pb.MaasChatCompletionRequest
+	authorization []string
+}
+
+func (s *fakeMaasGatewayServer) GetSupportedModels(context.Context, *emptypb.Empty) (*pb.ListSupportedModels, error) {
+	return &pb.ListSupportedModels{Models: []*pb.Model{
+		{ModelName: "model-a", ProductId: "1", ProductName: "product-a"},
+		{ModelName: "model-a", ProductId: "2", ProductName: "product-a-2"},
+		{ModelName: "model-b", ProductId: "3", ProductName: "product-b"},
+	}}, nil
+}
+
+func (s *fakeMaasGatewayServer) ChatCompletionStream(req *pb.MaasChatCompletionRequest, stream pb.MaasGateway_ChatCompletionStreamServer) error {
+	s.request = req
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.authorization = md.Get("authorization")
+	if req.Model == "model-b" {
+		return status.Error(codes.InvalidArgument, "invalid argument. Please try again")
+	}
+	stop := "stop"
+	for _, resp := range []*pb.MaasChatCompletionResponse{
+		{Choices: []*pb.MaasChatCompletionChoice{{Delta: &pb.ChatCompletionMessage{Role: "assistant", Content: "Hello"}}}},
+		{Choices: []*pb.MaasChatCompletionChoice{{Delta: &pb.ChatCompletionMessage{Content: " World"}, FinishReason: &stop}}},
+		{Choices: []*pb.MaasChatCompletionChoice{}, Usage: &pb.ChatCompletionUsage{PromptTokens: 3, CompletionTokens: 2}},
+	} {
+		resp.Id = "chat-1"
+		resp.Object = "chat.completion.chunk"
+		resp.Model = req.Model
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestOpenAIMux(t *testing.T) (*runtime.ServeMux, *fakeMaasGatewayServer) {
+	ln := bufconn.Listen(1024 * 1024)
+	server := &fakeMaasGatewayServer{}
+	grpcServer := grpc.NewServer()
+	pb.RegisterMaasGatewayServer(grpcServer, server)
+	go grpcServer.Serve(ln)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return ln.DialContext(ctx) }))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher))
+	require.NoError(t, RegisterOpenAIHandlers(context.Background(), mux, conn))
+	return mux, server
+}
+
+func postChatCompletion(mux *runtime.ServeMux, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/v1/cloudaccounts/123456789012/maas/chat/completions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestOpenAIChatCompletions(t *testing.T) {
+	mux, server := newTestOpenAIMux(t)
+
+	rec := postChatCompletion(mux, `{"model": "model-a", "messages": [{"role": "developer", "content": "Be brief."},
+		{"role": "user", "content": [{"type": "text", "text": "Hi"}]}], "stop": "\n", "max_completion_tokens": 10}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	assert.Equal(t, "123456789012", server.request.CloudAccountId)
+	assert.Equal(t, "product-a", server.request.ProductName, "the first product of the model is used")
+	assert.Equal(t, "1", server.request.ProductId)
+	assert.Equal(t, []string{"\n"}, server.request.Stop)
+	assert.Equal(t, uint32(10), server.request.GetMaxTokens())
+	assert.Equal(t, "system", server.request.Messages[0].Role)
+	assert.Equal(t, "Hi", server.request.Messages[1].Content)
+	assert.Equal(t, []string{"Bearer token"}, server.authorization)
+
+	completion := openAIChatCompletion{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &completion))
+	assert.Equal(t, "chat.completion", completion.Object)
+	require.Len(t, completion.Choices, 1)
+	assert.Equal(t, "Hello World", completion.Choices[0].Message.Content)
+	assert.Equal(t, "assistant", completion.Choices[0].Message.Role)
+	assert.Equal(t, "stop", *completion.Choices[0].FinishReason)
+	assert.Equal(t, uint32(5), completion.Usage.TotalTokens)
+
+	rec = postChatCompletion(mux, `{"model": "model-a", "product_name": "product-a-2", "messages": [{"role": "user", "content": "Hi"}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "2", server.request.ProductId)
+}
+
+func TestOpenAIChatCompletionsStream(t *testing.T) {
+	mux, _ := newTestOpenAIMux(t)
+
+	for _, includeUsage := range []bool{false, true} {
+		body := `{"model": "model-a", "messages": [{"role": "user", "content": "Hi"}], "stream": true}`
+		if includeUsage {
+			body = `{"model": "model-a", "messages": [{"role": "user", "content": "Hi"}], "stream": true, "stream_options": {"include_usage": true}}`
+		}
+		rec := postChatCompletion(mux, body)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+
+		events := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n\n"), "\n\n")
+		assert.Equal(t, "data: [DONE]", events[len(events)-1])
+		content := ""
+		var usage *openAIUsage
+		for _, event := range events[:len(events)-1] {
+			chunk := openAIChatCompletion{}
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(event, "data: ")), &chunk))
+			assert.Equal(t, "chat.completion.chunk", chunk.Object)
+			for _, choice := range chunk.Choices {
+				content += choice.Delta.Content
+			}
+			if chunk.Usage != nil {
+				usage = chunk.Usage
+			}
+		}
+		assert.Equal(t, "Hello World", content)
+		assert.Equal(t, includeUsage, usage != nil)
+	}
+}
+
+func TestOpenAIChatCompletionsErrors(t *testing.T) {
+	mux, _ := newTestOpenAIMux(t)
+
+	for _, test := range []struct {
+		body           string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{body: `{"model": "model-c", "messages": [{"role": "user", "content": "Hi"}]}`, expectedStatus: http.StatusNotFound, expectedCode: "notfound"},
+		{body: `{"model": "model-b", "messages": [{"role": "user", "content": "Hi"}], "stream": true}`, expectedStatus: http.StatusBadRequest, expectedCode: "invalidargument"},
+		{body: `{"model": "model-a", "messages": [{"role": "user", "content": [{"type": "image_url"}]}]}`, expectedStatus: http.StatusBadRequest, expectedCode: "invalidargument"},
+	} {
+		rec := postChatCompletion(mux, test.body)
+		assert.Equal(t, test.expectedStatus, rec.Code, test.body)
+		resp := openAIErrorResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, test.expectedCode, resp.Error.Code)
+		assert.Equal(t, "invalid_request_error", resp.Error.Type)
+	}
+}
+
+func TestOpenAIModels(t *testing.T) {
+	mux, _ := newTestOpenAIMux(t)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/cloudaccounts/123456789012/maas/models", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	list := openAIModelList{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	assert.Equal(t, "list", list.Object)
+	require.Len(t, list.Data, 2, "models with several products are listed once")
+	assert.Equal(t, "model-a", list.Data[0].Id)
+	assert.Equal(t, "model-b", list.Data[1].Id)
+}
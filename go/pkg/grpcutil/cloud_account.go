@@ -0,0 +1,86 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpcutil
+
+import (
+	"sync"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// cloudAccountFieldPaths caches the path to the (idc.field).cloudAccount field of each message type.
+// A nil path is cached for message types that do not have such a field.
+var cloudAccountFieldPaths sync.Map // protoreflect.FullName -> []protoreflect.FieldDescriptor
+
+// CloudAccountIdFromRequest returns the value of the field annotated with (idc.field).cloudAccount = true
+// in the request message, looking into nested messages such as metadata.
+// An empty string is returned when the message does not have such a field or it is not set.
+func CloudAccountIdFromRequest(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+	m := msg.ProtoReflect()
+	if !m.IsValid() {
+		return ""
+	}
+	path := cloudAccountFieldPath(m.Descriptor())
+	for i, fd := range path {
+		if i == len(path)-1 {
+			return m.Get(fd).String()
+		}
+		if !m.Has(fd) {
+			return ""
+		}
+		m = m.Get(fd).Message()
+	}
+	return ""
+}
+
+func cloudAccountFieldPath(desc protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	if path, ok := cloudAccountFieldPaths.Load(desc.FullName()); ok {
+		return path.([]protoreflect.FieldDescriptor)
+	}
+	path := findCloudAccountField(desc, map[protoreflect.FullName]bool{})
+	cloudAccountFieldPaths.Store(desc.FullName(), path)
+	return path
+}
+
+// findCloudAccountField returns the first field annotated with (idc.field).cloudAccount,
+// preceded by the message fields leading to it.
+func findCloudAccountField(desc protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) []protoreflect.FieldDescriptor {
+	if visited[desc.FullName()] {
+		return nil
+	}
+	visited[desc.FullName()] = true
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated && isCloudAccountField(fd) {
+			return []protoreflect.FieldDescriptor{fd}
+		}
+	}
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			continue
+		}
+		if path := findCloudAccountField(fd.Message(), visited); path != nil {
+			return append([]protoreflect.FieldDescriptor{fd}, path...)
+		}
+	}
+	return nil
+}
+
+func isCloudAccountField(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return false
+	}
+	fo, ok := proto.GetExtension(opts, pb.E_Field).(*pb.IdcFieldOptions)
+	return ok && fo.GetCloudAccount()
+}
@@ -0,0 +1,224 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpcutil
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// IdempotencyKeyMetadataKey is the request header that holds the idempotency key chosen by the client.
+	IdempotencyKeyMetadataKey = "idempotency-key"
+	// IdempotentReplayedMetadataKey is set in the response header when the response is a replay of an earlier response.
+	IdempotentReplayedMetadataKey = "idempotent-replayed"
+	// Maximum length of an idempotency key.
+	maxIdempotencyKeyLength = 255
+)
+
+// DefaultIdempotentMethods are the methods that honor idempotency keys when IdempotencyConfig.Methods is empty.
+var DefaultIdempotentMethods = []string{
+	"/proto.InstanceService/Create",
+	"/proto.InstanceGroupService/Create",
+	"/proto.FileStorageService/Create",
+	"/proto.ObjectStorageService/CreateBucket",
+	"/proto.LoadBalancerService/Create",
+}
+
+// IdempotencyConfig configures the idempotency interceptor.
+type IdempotencyConfig struct {
+	Enabled bool `koanf:"enabled"`
+	// If true, idempotency records are stored in Postgres and shared by all replicas.
+	// Otherwise they are kept in memory.
+	Shared bool `koanf:"shared"`
+	// How long a response is kept for replay. Defaults to 24 hours.
+	TTL time.Duration `koanf:"ttl"`
+	// How long a request with an idempotency key may run before another request with the same key
+	// is allowed to run again, for example because the replica that processed it was restarted. Defaults to 5 minutes.
+	PendingTimeout time.Duration `koanf:"pendingTimeout"`
+	// Full gRPC method names that honor idempotency keys. Defaults to DefaultIdempotentMethods.
+	Methods []string `koanf:"methods"`
+}
+
+// IdempotencyRecord is the stored state of an idempotency key.
+type IdempotencyRecord struct {
+	Method      string
+	RequestHash []byte
+	// Completed is false while the original request is being processed.
+	Completed bool
+	// Full name of the response message type and the serialized response.
+	ResponseType string
+	Response     []byte
+}
+
+// IdempotencyStore keeps idempotency records per cloud account.
+type IdempotencyStore interface {
+	// Reserve creates a pending record for the key that expires after the given duration.
+	// If an unexpired record exists already, it is returned instead and the second return value is false.
+	Reserve(ctx context.Context, cloudAccountId string, key string, record IdempotencyRecord, expires time.Duration) (*IdempotencyRecord, bool, error)
+	// Complete stores the response of a pending record and extends its expiration.
+	Complete(ctx context.Context, cloudAccountId string, key string, responseType string, response []byte, expires time.Duration) error
+	// Release deletes a pending record so that the request can be retried.
+	Release(ctx context.Context, cloudAccountId string, key string) error
+}
+
+// IdempotencyInterceptor replays the response of an earlier request with the same idempotency key and cloud account.
+// Only successful responses are stored. A key reused with a different request is rejected.
+type IdempotencyInterceptor struct {
+	cfg     IdempotencyConfig
+	store   IdempotencyStore
+	methods map[string]bool
+}
+
+func NewIdempotencyInterceptor(cfg IdempotencyConfig) *IdempotencyInterceptor {
+	if cfg.TTL == 0 {
+		cfg.TTL = 24 * time.Hour
+	}
+	if cfg.PendingTimeout == 0 {
+		cfg.PendingTimeout = 5 * time.Minute
+	}
+	methods := cfg.Methods
+	if len(methods) == 0 {
+		methods = DefaultIdempotentMethods
+	}
+	methodSet := map[string]bool{}
+	for _, m := range methods {
+		methodSet[m] = true
+	}
+	return &IdempotencyInterceptor{
+		cfg:     cfg,
+		store:   NewMemoryIdempotencyStore(),
+		methods: methodSet,
+	}
+}
+
+// SetStore replaces the in-memory store. It must be called before the server starts.
+func (i *IdempotencyInterceptor) SetStore(store IdempotencyStore) {
+	i.store = store
+}
+
+func (i *IdempotencyInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !i.cfg.Enabled || !i.methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		key := idempotencyKeyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key must not be longer than %d characters", maxIdempotencyKeyLength)
+		}
+		reqMsg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		cloudAccountId := CloudAccountIdFromRequest(req)
+		if cloudAccountId == "" {
+			return handler(ctx, req)
+		}
+		logger := log.FromContext(ctx).WithName("IdempotencyInterceptor").
+			WithValues(logkeys.CloudAccountId, cloudAccountId, "idempotencyKey", key, "method", info.FullMethod)
+
+		requestHash, err := hashRequest(info.FullMethod, reqMsg)
+		if err != nil {
+			logger.Error(err, "unable to hash request")
+			return nil, status.Error(codes.Internal, "unable to process idempotency key")
+		}
+
+		existing, reserved, err := i.store.Reserve(ctx, cloudAccountId, key, IdempotencyRecord{
+			Method:      info.FullMethod,
+			RequestHash: requestHash,
+		}, i.cfg.PendingTimeout)
+		if err != nil {
+			logger.Error(err, "unable to reserve idempotency key")
+			return nil, status.Error(codes.Unavailable, "unable to process idempotency key, try again later")
+		}
+		if !reserved {
+			return i.replay(ctx, existing, info.FullMethod, requestHash)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			// Let the client retry failed requests with the same key.
+			if releaseErr := i.store.Release(ctx, cloudAccountId, key); releaseErr != nil {
+				logger.Error(releaseErr, "unable to release idempotency key")
+			}
+			return resp, err
+		}
+
+		respMsg, ok := resp.(proto.Message)
+		if !ok {
+			if releaseErr := i.store.Release(ctx, cloudAccountId, key); releaseErr != nil {
+				logger.Error(releaseErr, "unable to release idempotency key")
+			}
+			return resp, nil
+		}
+		respBytes, err := proto.Marshal(respMsg)
+		if err == nil {
+			err = i.store.Complete(ctx, cloudAccountId, key, string(respMsg.ProtoReflect().Descriptor().FullName()), respBytes, i.cfg.TTL)
+		}
+		if err != nil {
+			// The request succeeded, so its response is returned even though it can't be replayed.
+			logger.Error(err, "unable to store response for idempotency key")
+		}
+		return resp, nil
+	}
+}
+
+// replay returns the stored response if the request matches the original request.
+func (i *IdempotencyInterceptor) replay(ctx context.Context, record *IdempotencyRecord, fullMethod string, requestHash []byte) (any, error) {
+	if record.Method != fullMethod || !bytes.Equal(record.RequestHash, requestHash) {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key was already used with a different request")
+	}
+	if !record.Completed {
+		return nil, status.Error(codes.Aborted, "a request with the same idempotency key is being processed")
+	}
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(record.ResponseType))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to replay response for idempotency key: %v", err)
+	}
+	resp := msgType.New().Interface()
+	if err := proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to replay response for idempotency key: %v", err)
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayedMetadataKey, "true"))
+	return resp, nil
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(IdempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// hashRequest returns a hash of the method and the deterministic serialization of the request.
+func hashRequest(fullMethod string, req proto.Message) ([]byte, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	h.Write([]byte(fullMethod))
+	h.Write([]byte{0})
+	h.Write(b)
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,185 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpcutil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Interval between removals of expired idempotency records.
+const idempotencyStoreCleanupInterval = 10 * time.Minute
+
+type memoryIdempotencyRecord struct {
+	IdempotencyRecord
+	expires time.Time
+}
+
+// MemoryIdempotencyStore keeps idempotency records in memory. Records are only visible to the replica that created them.
+type MemoryIdempotencyStore struct {
+	mu          sync.Mutex
+	records     map[string]*memoryIdempotencyRecord
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		records: map[string]*memoryIdempotencyRecord{},
+		now:     time.Now,
+	}
+}
+
+func idempotencyRecordKey(cloudAccountId string, key string) string {
+	return cloudAccountId + "|" + key
+}
+
+func (s *MemoryIdempotencyStore) Reserve(ctx context.Context, cloudAccountId string, key string, record IdempotencyRecord, expires time.Duration) (*IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.cleanup(now)
+	recordKey := idempotencyRecordKey(cloudAccountId, key)
+	if existing, ok := s.records[recordKey]; ok && now.Before(existing.expires) {
+		r := existing.IdempotencyRecord
+		return &r, false, nil
+	}
+	record.Completed = false
+	record.ResponseType = ""
+	record.Response = nil
+	s.records[recordKey] = &memoryIdempotencyRecord{IdempotencyRecord: record, expires: now.Add(expires)}
+	return nil, true, nil
+}
+
+func (s *MemoryIdempotencyStore) Complete(ctx context.Context, cloudAccountId string, key string, responseType string, response []byte, expires time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.records[idempotencyRecordKey(cloudAccountId, key)]
+	if !ok {
+		return fmt.Errorf("idempotency key %s of cloud account %s not found", key, cloudAccountId)
+	}
+	existing.Completed = true
+	existing.ResponseType = responseType
+	existing.Response = response
+	existing.expires = s.now().Add(expires)
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) Release(ctx context.Context, cloudAccountId string, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	recordKey := idempotencyRecordKey(cloudAccountId, key)
+	if existing, ok := s.records[recordKey]; ok && !existing.Completed {
+		delete(s.records, recordKey)
+	}
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) cleanup(now time.Time) {
+	if now.Sub(s.lastCleanup) < idempotencyStoreCleanupInterval {
+		return
+	}
+	s.lastCleanup = now
+	for key, record := range s.records {
+		if !now.Before(record.expires) {
+			delete(s.records, key)
+		}
+	}
+}
+
+// PostgresIdempotencyStore keeps idempotency records in a Postgres table so that all replicas of a service share them.
+// The grpc_idempotency_key table is created by the database migrations of the service.
+type PostgresIdempotencyStore struct {
+	db          *sql.DB
+	mu          sync.Mutex
+	lastCleanup time.Time
+}
+
+func NewPostgresIdempotencyStore(db *sql.DB) *PostgresIdempotencyStore {
+	return &PostgresIdempotencyStore{db: db}
+}
+
+func (s *PostgresIdempotencyStore) Reserve(ctx context.Context, cloudAccountId string, key string, record IdempotencyRecord, expires time.Duration) (*IdempotencyRecord, bool, error) {
+	s.cleanup(ctx)
+
+	// Insert a pending record, replacing an expired record with the same key.
+	query := `
+		insert into grpc_idempotency_key as k
+			(cloud_account_id, idempotency_key, method, request_hash, completed, response_type, response, expiration_timestamp)
+		values ($1, $2, $3, $4, false, '', null, clock_timestamp() + $5 * interval '1 second')
+		on conflict (cloud_account_id, idempotency_key) do update set
+			method = excluded.method,
+			request_hash = excluded.request_hash,
+			completed = false,
+			response_type = '',
+			response = null,
+			expiration_timestamp = excluded.expiration_timestamp
+		where k.expiration_timestamp <= clock_timestamp()
+	`
+	result, err := s.db.ExecContext(ctx, query, cloudAccountId, key, record.Method, record.RequestHash, expires.Seconds())
+	if err != nil {
+		return nil, false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+	if rowsAffected == 1 {
+		return nil, true, nil
+	}
+
+	var existing IdempotencyRecord
+	query = `
+		select method, request_hash, completed, response_type, response
+		from grpc_idempotency_key
+		where cloud_account_id = $1 and idempotency_key = $2
+	`
+	if err := s.db.QueryRowContext(ctx, query, cloudAccountId, key).Scan(
+		&existing.Method, &existing.RequestHash, &existing.Completed, &existing.ResponseType, &existing.Response); err != nil {
+		return nil, false, err
+	}
+	return &existing, false, nil
+}
+
+func (s *PostgresIdempotencyStore) Complete(ctx context.Context, cloudAccountId string, key string, responseType string, response []byte, expires time.Duration) error {
+	query := `
+		update grpc_idempotency_key
+		set completed = true, response_type = $3, response = $4, expiration_timestamp = clock_timestamp() + $5 * interval '1 second'
+		where cloud_account_id = $1 and idempotency_key = $2
+	`
+	result, err := s.db.ExecContext(ctx, query, cloudAccountId, key, responseType, response, expires.Seconds())
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("idempotency key %s of cloud account %s not found", key, cloudAccountId)
+	}
+	return nil
+}
+
+func (s *PostgresIdempotencyStore) Release(ctx context.Context, cloudAccountId string, key string) error {
+	query := `delete from grpc_idempotency_key where cloud_account_id = $1 and idempotency_key = $2 and not completed`
+	_, err := s.db.ExecContext(ctx, query, cloudAccountId, key)
+	return err
+}
+
+// cleanup deletes expired records, at most once per cleanup interval.
+func (s *PostgresIdempotencyStore) cleanup(ctx context.Context) {
+	s.mu.Lock()
+	now := time.Now()
+	if now.Sub(s.lastCleanup) < idempotencyStoreCleanupInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastCleanup = now
+	s.mu.Unlock()
+	// Expired records are replaced by Reserve, so a failure only delays their removal.
+	_, _ = s.db.ExecContext(ctx, `delete from grpc_idempotency_key where expiration_timestamp <= clock_timestamp()`)
+}
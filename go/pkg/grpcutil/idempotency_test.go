@@ -0,0 +1,190 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpcutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const createMethod = "/proto.InstanceService/Create"
+
+func createRequest(cloudAccountId string, name string) *pb.InstanceCreateRequest {
+	return &pb.InstanceCreateRequest{
+		Metadata: &pb.InstanceMetadataCreate{CloudAccountId: cloudAccountId, Name: name},
+		Spec:     &pb.InstanceSpec{InstanceType: "vm-spr-sml"},
+	}
+}
+
+func contextWithIdempotencyKey(key string) (context.Context, *fakeServerTransportStream) {
+	stream := &fakeServerTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyMetadataKey, key)), stream
+}
+
+// countingHandler creates an instance with a new resource id for each call.
+type countingHandler struct {
+	calls int
+	err   error
+}
+
+func (h *countingHandler) handle(ctx context.Context, req any) (any, error) {
+	h.calls++
+	if h.err != nil {
+		return nil, h.err
+	}
+	r := req.(*pb.InstanceCreateRequest)
+	return &pb.Instance{Metadata: &pb.InstanceMetadata{
+		CloudAccountId: r.Metadata.CloudAccountId,
+		Name:           r.Metadata.Name,
+		ResourceId:     time.Now().String(),
+	}}, nil
+}
+
+func TestIdempotencyInterceptorReplay(t *testing.T) {
+	interceptor := NewIdempotencyInterceptor(IdempotencyConfig{Enabled: true}).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: createMethod}
+	handler := &countingHandler{}
+
+	ctx, _ := contextWithIdempotencyKey("key-1")
+	first, err := interceptor(ctx, createRequest("123456789012", "my-instance"), info, handler.handle)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, stream := contextWithIdempotencyKey("key-1")
+	second, err := interceptor(ctx, createRequest("123456789012", "my-instance"), info, handler.handle)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handler.calls != 1 {
+		t.Errorf("expected handler to be called once, got %d", handler.calls)
+	}
+	if !proto.Equal(first.(proto.Message), second.(proto.Message)) {
+		t.Errorf("expected replayed response %v, got %v", first, second)
+	}
+	if got := stream.header.Get(IdempotentReplayedMetadataKey); len(got) != 1 || got[0] != "true" {
+		t.Errorf("expected replayed header, got %v", got)
+	}
+
+	// The same key is reused with a different request.
+	ctx, _ = contextWithIdempotencyKey("key-1")
+	_, err = interceptor(ctx, createRequest("123456789012", "other-instance"), info, handler.handle)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+
+	// Keys are scoped by cloud account.
+	ctx, _ = contextWithIdempotencyKey("key-1")
+	if _, err := interceptor(ctx, createRequest("210987654321", "my-instance"), info, handler.handle); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handler.calls != 2 {
+		t.Errorf("expected handler to be called twice, got %d", handler.calls)
+	}
+}
+
+func TestIdempotencyInterceptorPassThrough(t *testing.T) {
+	interceptor := NewIdempotencyInterceptor(IdempotencyConfig{Enabled: true}).UnaryServerInterceptor()
+	handler := &countingHandler{}
+
+	// No idempotency key.
+	for i := 0; i < 2; i++ {
+		if _, err := interceptor(context.Background(), createRequest("123456789012", "my-instance"),
+			&grpc.UnaryServerInfo{FullMethod: createMethod}, handler.handle); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// Method without idempotency support.
+	for i := 0; i < 2; i++ {
+		ctx, _ := contextWithIdempotencyKey("key-1")
+		if _, err := interceptor(ctx, createRequest("123456789012", "my-instance"),
+			&grpc.UnaryServerInfo{FullMethod: "/proto.InstanceService/Update"}, handler.handle); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if handler.calls != 4 {
+		t.Errorf("expected handler to be called 4 times, got %d", handler.calls)
+	}
+}
+
+func TestIdempotencyInterceptorFailedRequestCanBeRetried(t *testing.T) {
+	interceptor := NewIdempotencyInterceptor(IdempotencyConfig{Enabled: true}).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: createMethod}
+	handler := &countingHandler{err: status.Error(codes.Unavailable, "try again")}
+
+	ctx, _ := contextWithIdempotencyKey("key-1")
+	if _, err := interceptor(ctx, createRequest("123456789012", "my-instance"), info, handler.handle); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+	handler.err = nil
+	if _, err := interceptor(ctx, createRequest("123456789012", "my-instance"), info, handler.handle); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handler.calls != 2 {
+		t.Errorf("expected handler to be called twice, got %d", handler.calls)
+	}
+}
+
+func TestIdempotencyInterceptorRequestInProgress(t *testing.T) {
+	interceptor := NewIdempotencyInterceptor(IdempotencyConfig{Enabled: true}).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: createMethod}
+
+	started := make(chan struct{})
+	done := make(chan struct{})
+	errc := make(chan error)
+	go func() {
+		ctx, _ := contextWithIdempotencyKey("key-1")
+		_, err := interceptor(ctx, createRequest("123456789012", "my-instance"), info, func(ctx context.Context, req any) (any, error) {
+			close(started)
+			<-done
+			return &pb.Instance{}, nil
+		})
+		errc <- err
+	}()
+	<-started
+
+	ctx, _ := contextWithIdempotencyKey("key-1")
+	_, err := interceptor(ctx, createRequest("123456789012", "my-instance"), info, okHandler)
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("expected Aborted, got %v", err)
+	}
+	close(done)
+	if err := <-errc; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMemoryIdempotencyStoreExpiration(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	store := NewMemoryIdempotencyStore()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	record := IdempotencyRecord{Method: createMethod, RequestHash: []byte("hash")}
+
+	if _, reserved, _ := store.Reserve(ctx, "123456789012", "key-1", record, time.Minute); !reserved {
+		t.Fatal("expected key to be reserved")
+	}
+	if err := store.Complete(ctx, "123456789012", "key-1", "proto.Instance", []byte{}, time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	now = now.Add(30 * time.Minute)
+	existing, reserved, _ := store.Reserve(ctx, "123456789012", "key-1", record, time.Minute)
+	if reserved || existing == nil || !existing.Completed {
+		t.Fatalf("expected completed record, got %v", existing)
+	}
+
+	now = now.Add(time.Hour)
+	if _, reserved, _ := store.Reserve(ctx, "123456789012", "key-1", record, time.Minute); !reserved {
+		t.Fatal("expected expired key to be reserved again")
+	}
+}
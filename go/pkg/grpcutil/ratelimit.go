@@ -0,0 +1,333 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpcutil
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterMetadataKey is the response header that tells a rate limited client how many seconds to wait before retrying.
+const RetryAfterMetadataKey = "retry-after"
+
+// RateLimit is a token bucket limit.
+type RateLimit struct {
+	// Number of tokens added to the bucket per second. 0 disables rate limiting.
+	RequestsPerSecond float64 `koanf:"requestsPerSecond"`
+	// Maximum number of tokens in the bucket. Defaults to RequestsPerSecond rounded up.
+	Burst int `koanf:"burst"`
+	// Maximum number of requests being processed at the same time by a single replica. 0 is unlimited.
+	MaxConcurrent int `koanf:"maxConcurrent"`
+}
+
+func (l RateLimit) burst() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return math.Max(1, math.Ceil(l.RequestsPerSecond))
+}
+
+// MethodRateLimit is a limit that applies to the matching gRPC methods.
+type MethodRateLimit struct {
+	// Full gRPC method name such as "/proto.InstanceService/Create".
+	// A trailing "*" matches all methods with the given prefix, e.g. "/proto.InstanceService/*".
+	Method    string `koanf:"method"`
+	RateLimit `koanf:",squash"`
+}
+
+func (l MethodRateLimit) matches(fullMethod string) bool {
+	if prefix, ok := strings.CutSuffix(l.Method, "*"); ok {
+		return strings.HasPrefix(fullMethod, prefix)
+	}
+	return l.Method == fullMethod
+}
+
+// RateLimitPolicy is the set of limits applied to a cloud account.
+type RateLimitPolicy struct {
+	// Limit shared by all methods that do not match an entry in Methods.
+	Default RateLimit `koanf:"default"`
+	// Limits of specific methods. Each entry has its own bucket per cloud account. The first match is used.
+	Methods []MethodRateLimit `koanf:"methods"`
+}
+
+// RateLimitConfig configures the rate limiting interceptors.
+type RateLimitConfig struct {
+	Enabled bool `koanf:"enabled"`
+	// If true, token buckets are stored in Postgres and shared by all replicas.
+	// Otherwise they are kept in memory. Concurrency limits are always per replica.
+	Shared bool `koanf:"shared"`
+	// How long the account type of a cloud account is cached.
+	AccountTypeCacheTTL time.Duration `koanf:"accountTypeCacheTTL"`
+	// Maximum number of cached account types. Defaults to 10000.
+	AccountTypeCacheSize int `koanf:"accountTypeCacheSize"`
+	// Policy for cloud accounts whose account type has no entry in AccountTypes.
+	Default RateLimitPolicy `koanf:"default"`
+	// AccountTypes is keyed by account type (STANDARD, PREMIUM, ENTERPRISE, ENTERPRISE_PENDING, INTEL).
+	AccountTypes map[string]RateLimitPolicy `koanf:"accountTypes"`
+}
+
+// AccountTypeFunc returns the account type of a cloud account.
+type AccountTypeFunc func(ctx context.Context, cloudAccountId string) (pb.AccountType, error)
+
+// RateLimitStore keeps the token buckets.
+type RateLimitStore interface {
+	// Take removes one token from the bucket identified by key.
+	// If the bucket is empty, it returns false and the time until a token will be available.
+	Take(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error)
+}
+
+// RateLimiter provides unary and stream server interceptors that limit the request rate and the number of
+// concurrent requests of each cloud account. The cloud account is read from the request field annotated
+// with (idc.field).cloudAccount. Requests without a cloud account and private methods called by other services
+// are not limited.
+type RateLimiter struct {
+	cfg             RateLimitConfig
+	store           RateLimitStore
+	accountTypeFunc AccountTypeFunc
+
+	mu           sync.Mutex
+	accountTypes map[string]cachedAccountType
+	inFlight     map[string]int
+}
+
+type cachedAccountType struct {
+	accountType string
+	expires     time.Time
+}
+
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	if cfg.AccountTypeCacheTTL == 0 {
+		cfg.AccountTypeCacheTTL = 10 * time.Minute
+	}
+	if cfg.AccountTypeCacheSize <= 0 {
+		cfg.AccountTypeCacheSize = 10000
+	}
+	return &RateLimiter{
+		cfg:          cfg,
+		store:        NewMemoryRateLimitStore(),
+		accountTypes: map[string]cachedAccountType{},
+		inFlight:     map[string]int{},
+	}
+}
+
+// SetStore replaces the in-memory store. It must be called before the server starts.
+func (r *RateLimiter) SetStore(store RateLimitStore) {
+	r.store = store
+}
+
+// SetAccountTypeFunc sets the function used to find the account type of a cloud account.
+// If it is not set, the default policy is used for all cloud accounts. It must be called before the server starts.
+func (r *RateLimiter) SetAccountTypeFunc(accountTypeFunc AccountTypeFunc) {
+	r.accountTypeFunc = accountTypeFunc
+}
+
+func (r *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, retryAfter, err := r.acquire(ctx, info.FullMethod, req)
+		if err != nil {
+			if retryAfter > 0 {
+				_ = grpc.SetHeader(ctx, retryAfterMetadata(retryAfter))
+			}
+			return nil, err
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor limits streams when the first request message is received.
+func (r *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		stream := &rateLimitedServerStream{ServerStream: ss, limiter: r, fullMethod: info.FullMethod}
+		defer stream.release()
+		return handler(srv, stream)
+	}
+}
+
+type rateLimitedServerStream struct {
+	grpc.ServerStream
+	limiter    *RateLimiter
+	fullMethod string
+	checked    bool
+	releaseFn  func()
+}
+
+func (s *rateLimitedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.checked {
+		return nil
+	}
+	s.checked = true
+	release, retryAfter, err := s.limiter.acquire(s.Context(), s.fullMethod, m)
+	if err != nil {
+		if retryAfter > 0 {
+			_ = s.SetHeader(retryAfterMetadata(retryAfter))
+		}
+		return err
+	}
+	s.releaseFn = release
+	return nil
+}
+
+func (s *rateLimitedServerStream) release() {
+	if s.releaseFn != nil {
+		s.releaseFn()
+	}
+}
+
+// acquire takes a token and a concurrency slot for the request.
+// When the request is rejected, it returns the error and how long the client should wait before retrying.
+func (r *RateLimiter) acquire(ctx context.Context, fullMethod string, req any) (func(), time.Duration, error) {
+	noop := func() {}
+	if !r.cfg.Enabled || isPrivateMethod(fullMethod) {
+		return noop, 0, nil
+	}
+	cloudAccountId := CloudAccountIdFromRequest(req)
+	if cloudAccountId == "" {
+		return noop, 0, nil
+	}
+	logger := log.FromContext(ctx).WithName("RateLimiter.acquire").WithValues(logkeys.CloudAccountId, cloudAccountId, "method", fullMethod)
+
+	accountType := r.accountType(ctx, cloudAccountId)
+	bucket, limit := r.limitFor(accountType, fullMethod)
+	key := cloudAccountId + "|" + bucket
+
+	if limit.RequestsPerSecond > 0 {
+		allowed, retryAfter, err := r.store.Take(ctx, key, limit)
+		if err != nil {
+			// Do not reject requests because the store is not available.
+			logger.Error(err, "unable to take rate limit token")
+		} else if !allowed {
+			logger.Info("rate limit exceeded", logkeys.CloudAccountType, accountType, "retryAfter", retryAfter)
+			return nil, retryAfter, status.Errorf(codes.ResourceExhausted,
+				"request rate limit exceeded for cloud account %s, retry after %d seconds", cloudAccountId, retryAfterSeconds(retryAfter))
+		}
+	}
+
+	if limit.MaxConcurrent <= 0 {
+		return noop, 0, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.inFlight[key] >= limit.MaxConcurrent {
+		logger.Info("concurrent request limit exceeded", logkeys.CloudAccountType, accountType, "maxConcurrent", limit.MaxConcurrent)
+		return nil, time.Second, status.Errorf(codes.ResourceExhausted,
+			"too many concurrent requests for cloud account %s, retry after %d seconds", cloudAccountId, 1)
+	}
+	r.inFlight[key]++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.inFlight[key]--
+			if r.inFlight[key] <= 0 {
+				delete(r.inFlight, key)
+			}
+		})
+	}, 0, nil
+}
+
+// limitFor returns the bucket name and the limit for the method.
+func (r *RateLimiter) limitFor(accountType string, fullMethod string) (string, RateLimit) {
+	policy, ok := r.cfg.AccountTypes[accountType]
+	if !ok {
+		policy = r.cfg.Default
+	}
+	for _, m := range policy.Methods {
+		if m.matches(fullMethod) {
+			return m.Method, m.RateLimit
+		}
+	}
+	return "", policy.Default
+}
+
+func (r *RateLimiter) accountType(ctx context.Context, cloudAccountId string) string {
+	if r.accountTypeFunc == nil {
+		return ""
+	}
+	now := time.Now()
+	r.mu.Lock()
+	cached, ok := r.accountTypes[cloudAccountId]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.accountType
+	}
+
+	typ, err := r.accountTypeFunc(ctx, cloudAccountId)
+	if err != nil {
+		log.FromContext(ctx).WithName("RateLimiter.accountType").Error(err, "unable to get account type, using default rate limits",
+			logkeys.CloudAccountId, cloudAccountId)
+		return ""
+	}
+	accountType := AccountTypeName(typ)
+	r.mu.Lock()
+	if _, ok := r.accountTypes[cloudAccountId]; !ok && len(r.accountTypes) >= r.cfg.AccountTypeCacheSize {
+		r.evictAccountTypes(now)
+	}
+	r.accountTypes[cloudAccountId] = cachedAccountType{accountType: accountType, expires: now.Add(r.cfg.AccountTypeCacheTTL)}
+	r.mu.Unlock()
+	return accountType
+}
+
+// evictAccountTypes makes room in the full account type cache by removing the expired entries, or the entry
+// which expires first if none has expired. r.mu must be held.
+func (r *RateLimiter) evictAccountTypes(now time.Time) {
+	var oldestId string
+	var oldest time.Time
+	for cloudAccountId, cached := range r.accountTypes {
+		if !now.Before(cached.expires) {
+			delete(r.accountTypes, cloudAccountId)
+		} else if oldestId == "" || cached.expires.Before(oldest) {
+			oldestId, oldest = cloudAccountId, cached.expires
+		}
+	}
+	if len(r.accountTypes) >= r.cfg.AccountTypeCacheSize {
+		delete(r.accountTypes, oldestId)
+	}
+}
+
+// isPrivateMethod reports whether the method is called by other services rather than users, such as
+// "/proto.InstancePrivateService/Create" or "/proto.InstanceService/SearchPrivate".
+func isPrivateMethod(fullMethod string) bool {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return strings.HasSuffix(service, "PrivateService") || strings.HasSuffix(method, "Private")
+}
+
+// AccountTypeName returns the account type without the ACCOUNT_TYPE_ prefix, e.g. STANDARD.
+func AccountTypeName(typ pb.AccountType) string {
+	return strings.TrimPrefix(typ.String(), "ACCOUNT_TYPE_")
+}
+
+// CloudAccountTypeFunc returns an AccountTypeFunc that gets the account type from the cloud account service.
+func CloudAccountTypeFunc(client pb.CloudAccountServiceClient) AccountTypeFunc {
+	return func(ctx context.Context, cloudAccountId string) (pb.AccountType, error) {
+		acc, err := client.GetById(ctx, &pb.CloudAccountId{Id: cloudAccountId})
+		if err != nil {
+			return pb.AccountType_ACCOUNT_TYPE_UNSPECIFIED, err
+		}
+		return acc.GetType(), nil
+	}
+}
+
+func retryAfterSeconds(d time.Duration) int64 {
+	return int64(math.Max(1, math.Ceil(d.Seconds())))
+}
+
+func retryAfterMetadata(d time.Duration) metadata.MD {
+	return metadata.Pairs(RetryAfterMetadataKey, strconv.FormatInt(retryAfterSeconds(d), 10))
+}
@@ -0,0 +1,139 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpcutil
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sync"
+	"time"
+)
+
+// tokenBucket is the state of a single bucket.
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// refill adds the tokens accumulated since the last update.
+func (b *tokenBucket) refill(now time.Time, limit RateLimit) {
+	if elapsed := now.Sub(b.updated).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(limit.burst(), b.tokens+elapsed*limit.RequestsPerSecond)
+		b.updated = now
+	}
+}
+
+// take refills the bucket and removes one token if possible.
+func (b *tokenBucket) take(now time.Time, limit RateLimit) (bool, time.Duration) {
+	b.refill(now, limit)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	missing := 1 - b.tokens
+	return false, time.Duration(missing / limit.RequestsPerSecond * float64(time.Second))
+}
+
+// MemoryRateLimitStore keeps token buckets in memory. Buckets are only shared by requests served by the same replica.
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	limits    map[string]RateLimit
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// Interval between removals of full buckets.
+const memoryRateLimitStoreSweepInterval = time.Minute
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets: map[string]*tokenBucket{},
+		limits:  map[string]RateLimit{},
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error) {
+	if limit.RequestsPerSecond <= 0 {
+		return true, 0, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.sweep(now)
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: limit.burst(), updated: now}
+		s.buckets[key] = b
+	}
+	s.limits[key] = limit
+	allowed, retryAfter := b.take(now, limit)
+	return allowed, retryAfter, nil
+}
+
+// sweep removes buckets that have been refilled, as they are identical to new buckets.
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memoryRateLimitStoreSweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		limit := s.limits[key]
+		b.refill(now, limit)
+		if b.tokens >= limit.burst() {
+			delete(s.buckets, key)
+			delete(s.limits, key)
+		}
+	}
+}
+
+// PostgresRateLimitStore keeps token buckets in a Postgres table so that all replicas of a service share them.
+// The grpc_rate_limit_bucket table is created by the database migrations of the service.
+type PostgresRateLimitStore struct {
+	db *sql.DB
+}
+
+func NewPostgresRateLimitStore(db *sql.DB) *PostgresRateLimitStore {
+	return &PostgresRateLimitStore{db: db}
+}
+
+func (s *PostgresRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error) {
+	if limit.RequestsPerSecond <= 0 {
+		return true, 0, nil
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, 0, err
+	}
+	defer tx.Rollback()
+
+	// The database clock is used so that replicas with skewed clocks refill the buckets consistently.
+	query := `
+		insert into grpc_rate_limit_bucket (key, tokens, updated_timestamp)
+		values ($1, $2, clock_timestamp())
+		on conflict (key) do nothing
+	`
+	if _, err := tx.ExecContext(ctx, query, key, limit.burst()); err != nil {
+		return false, 0, err
+	}
+
+	var b tokenBucket
+	var now time.Time
+	query = `select tokens, updated_timestamp, clock_timestamp() from grpc_rate_limit_bucket where key = $1 for update`
+	if err := tx.QueryRowContext(ctx, query, key).Scan(&b.tokens, &b.updated, &now); err != nil {
+		return false, 0, err
+	}
+
+	allowed, retryAfter := b.take(now, limit)
+
+	query = `update grpc_rate_limit_bucket set tokens = $2, updated_timestamp = $3 where key = $1`
+	if _, err := tx.ExecContext(ctx, query, key, b.tokens, b.updated); err != nil {
+		return false, 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, 0, err
+	}
+	return allowed, retryAfter, nil
+}
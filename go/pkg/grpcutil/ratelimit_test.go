@@ -0,0 +1,316 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package grpcutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	getMethod    = "/proto.InstanceService/Get"
+	searchMethod = "/proto.InstanceService/Search"
+)
+
+func getRequest(cloudAccountId string) *pb.InstanceGetRequest {
+	return &pb.InstanceGetRequest{Metadata: &pb.InstanceMetadataReference{CloudAccountId: cloudAccountId}}
+}
+
+func okHandler(ctx context.Context, req any) (any, error) {
+	return "ok", nil
+}
+
+func TestCloudAccountIdFromRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      any
+		expected string
+	}{
+		{name: "NestedField", req: getRequest("123456789012"), expected: "123456789012"},
+		{name: "NilMetadata", req: &pb.InstanceGetRequest{}, expected: ""},
+		{name: "TopLevelField", req: &pb.CloudAccountId{Id: "123456789012"}, expected: "123456789012"},
+		{name: "NotAnnotated", req: &pb.InstanceTypeGetRequest{Metadata: &pb.InstanceTypeGetRequest_Metadata{Name: "vm-spr-sml"}}, expected: ""},
+		{name: "NotProto", req: "123456789012", expected: ""},
+		{name: "NilMessage", req: (*pb.InstanceGetRequest)(nil), expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CloudAccountIdFromRequest(tt.req); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRateLimiterUnaryServerInterceptor(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{
+		Enabled: true,
+		Default: RateLimitPolicy{
+			Default: RateLimit{RequestsPerSecond: 1, Burst: 2},
+			Methods: []MethodRateLimit{
+				{Method: "/proto.InstanceService/Search*", RateLimit: RateLimit{RequestsPerSecond: 1, Burst: 1}},
+			},
+		},
+		AccountTypes: map[string]RateLimitPolicy{
+			"PREMIUM": {Default: RateLimit{RequestsPerSecond: 100, Burst: 100}},
+		},
+	})
+	limiter.SetAccountTypeFunc(func(ctx context.Context, cloudAccountId string) (pb.AccountType, error) {
+		if cloudAccountId == "premium" {
+			return pb.AccountType_ACCOUNT_TYPE_PREMIUM, nil
+		}
+		if cloudAccountId == "unknown" {
+			return pb.AccountType_ACCOUNT_TYPE_UNSPECIFIED, errors.New("not found")
+		}
+		return pb.AccountType_ACCOUNT_TYPE_STANDARD, nil
+	})
+	interceptor := limiter.UnaryServerInterceptor()
+
+	call := func(method string, cloudAccountId string) error {
+		_, err := interceptor(context.Background(), getRequest(cloudAccountId), &grpc.UnaryServerInfo{FullMethod: method}, okHandler)
+		return err
+	}
+
+	// The burst of the default limit is 2.
+	for i := 0; i < 2; i++ {
+		if err := call(getMethod, "standard"); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+	err := call(getMethod, "standard")
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+
+	// Search has its own bucket.
+	if err := call(searchMethod, "standard"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := call(searchMethod, "standard"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+
+	// Other cloud accounts are not affected and use the limits of their account type.
+	for i := 0; i < 10; i++ {
+		if err := call(getMethod, "premium"); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+
+	// The default policy is used when the account type can't be found.
+	for i := 0; i < 2; i++ {
+		if err := call(getMethod, "unknown"); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+	if err := call(getMethod, "unknown"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+
+	// Requests without a cloud account are not limited.
+	for i := 0; i < 10; i++ {
+		if err := call(getMethod, ""); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+
+	// Private methods called by other services are not limited.
+	for _, method := range []string{"/proto.InstancePrivateService/GetPrivate", "/proto.InstanceService/SearchPrivate"} {
+		for i := 0; i < 10; i++ {
+			if err := call(method, "standard"); err != nil {
+				t.Fatalf("%s request %d: unexpected error: %v", method, i, err)
+			}
+		}
+	}
+}
+
+func TestRateLimiterAccountTypeCache(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{
+		Enabled:              true,
+		AccountTypeCacheTTL:  time.Hour,
+		AccountTypeCacheSize: 2,
+	})
+	lookups := 0
+	limiter.SetAccountTypeFunc(func(ctx context.Context, cloudAccountId string) (pb.AccountType, error) {
+		lookups++
+		return pb.AccountType_ACCOUNT_TYPE_STANDARD, nil
+	})
+
+	ctx := context.Background()
+	for _, cloudAccountId := range []string{"a", "b", "a", "c", "d"} {
+		limiter.accountType(ctx, cloudAccountId)
+	}
+	if lookups != 4 {
+		t.Fatalf("expected 4 lookups, got %d", lookups)
+	}
+	if len(limiter.accountTypes) != 2 {
+		t.Fatalf("expected the cache to be bounded to 2 entries, got %d", len(limiter.accountTypes))
+	}
+
+	// Expired entries are looked up again.
+	limiter.accountTypes["d"] = cachedAccountType{accountType: "STANDARD", expires: time.Now().Add(-time.Second)}
+	limiter.accountType(ctx, "d")
+	if lookups != 5 {
+		t.Fatalf("expected 5 lookups, got %d", lookups)
+	}
+}
+
+func TestRateLimiterRetryAfterHeader(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{
+		Enabled: true,
+		Default: RateLimitPolicy{Default: RateLimit{RequestsPerSecond: 0.1, Burst: 1}},
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: getMethod}
+	interceptor := limiter.UnaryServerInterceptor()
+
+	if _, err := interceptor(context.Background(), getRequest("123456789012"), info, okHandler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stream := &fakeServerTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err := interceptor(ctx, getRequest("123456789012"), info, okHandler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if got := stream.header.Get(RetryAfterMetadataKey); len(got) != 1 || got[0] != "10" {
+		t.Errorf("expected retry-after of 10 seconds, got %v", got)
+	}
+}
+
+func TestRateLimiterMaxConcurrent(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{
+		Enabled: true,
+		Default: RateLimitPolicy{Default: RateLimit{MaxConcurrent: 1}},
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: getMethod}
+	interceptor := limiter.UnaryServerInterceptor()
+
+	started := make(chan struct{})
+	done := make(chan struct{})
+	errc := make(chan error)
+	go func() {
+		_, err := interceptor(context.Background(), getRequest("123456789012"), info, func(ctx context.Context, req any) (any, error) {
+			close(started)
+			<-done
+			return "ok", nil
+		})
+		errc <- err
+	}()
+	<-started
+
+	_, err := interceptor(context.Background(), getRequest("123456789012"), info, okHandler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if _, err := interceptor(context.Background(), getRequest("other"), info, okHandler); err != nil {
+		t.Fatalf("unexpected error for other cloud account: %v", err)
+	}
+
+	close(done)
+	if err := <-errc; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := interceptor(context.Background(), getRequest("123456789012"), info, okHandler); err != nil {
+		t.Fatalf("unexpected error after the first request completed: %v", err)
+	}
+}
+
+func TestRateLimiterStreamServerInterceptor(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{
+		Enabled: true,
+		Default: RateLimitPolicy{Default: RateLimit{RequestsPerSecond: 1, Burst: 1}},
+	})
+	interceptor := limiter.StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/proto.InstanceService/Watch", IsServerStream: true}
+	handler := func(srv any, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&pb.InstanceGetRequest{})
+	}
+
+	if err := interceptor(nil, &fakeServerStream{req: getRequest("123456789012")}, info, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ss := &fakeServerStream{req: getRequest("123456789012")}
+	err := interceptor(nil, ss, info, handler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if got := ss.header.Get(RetryAfterMetadataKey); len(got) != 1 {
+		t.Errorf("expected retry-after header, got %v", got)
+	}
+}
+
+func TestMemoryRateLimitStoreRefill(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	store := NewMemoryRateLimitStore()
+	store.now = func() time.Time { return now }
+	limit := RateLimit{RequestsPerSecond: 2, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		if allowed, _, _ := store.Take(context.Background(), "key", limit); !allowed {
+			t.Fatalf("request %d: expected to be allowed", i)
+		}
+	}
+	allowed, retryAfter, _ := store.Take(context.Background(), "key", limit)
+	if allowed {
+		t.Fatal("expected to be rate limited")
+	}
+	if retryAfter != 500*time.Millisecond {
+		t.Errorf("expected retry after 500ms, got %v", retryAfter)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if allowed, _, _ := store.Take(context.Background(), "key", limit); !allowed {
+		t.Fatal("expected to be allowed after refill")
+	}
+
+	// Full buckets are removed.
+	now = now.Add(2 * memoryRateLimitStoreSweepInterval)
+	if allowed, _, _ := store.Take(context.Background(), "other", limit); !allowed {
+		t.Fatal("expected to be allowed")
+	}
+	if _, ok := store.buckets["key"]; ok {
+		t.Error("expected idle bucket to be removed")
+	}
+}
+
+type fakeServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *fakeServerTransportStream) Method() string { return getMethod }
+
+func (s *fakeServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *fakeServerTransportStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *fakeServerTransportStream) SetTrailer(md metadata.MD) error { return nil }
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	req    *pb.InstanceGetRequest
+	header metadata.MD
+}
+
+func (s *fakeServerStream) Context() context.Context { return context.Background() }
+
+func (s *fakeServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *fakeServerStream) RecvMsg(m any) error {
+	m.(*pb.InstanceGetRequest).Metadata = s.req.Metadata
+	return nil
+}
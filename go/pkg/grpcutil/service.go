@@ -50,6 +50,12 @@ func ServiceDone[C Config](svc Service[C]) {
 	}
 }
 
+// Optional ServerInterceptors method for Service that adds interceptors to the gRPC server.
+// It is called before Init, after the default interceptors.
+type ServiceInterceptors[C Config] interface {
+	ServerInterceptors(ctx context.Context, config C) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor)
+}
+
 func LoadConfig[C Config](ctx context.Context, cfg C) error {
 	log.BindFlags()
 	configFile := ""
@@ -73,9 +79,17 @@ func RunService[C Config](ctx context.Context, svc Service[C], config C) error {
 		return fmt.Errorf("listenPort must be set in the config")
 	}
 
+	unaryInterceptors := []grpc.UnaryServerInterceptor{otelgrpc.UnaryServerInterceptor(), GrpcAuthzServerInterceptor()}
+	streamInterceptors := []grpc.StreamServerInterceptor{otelgrpc.StreamServerInterceptor()}
+	if si, ok := svc.(ServiceInterceptors[C]); ok {
+		unary, stream := si.ServerInterceptors(ctx, config)
+		unaryInterceptors = append(unaryInterceptors, unary...)
+		streamInterceptors = append(streamInterceptors, stream...)
+	}
+
 	serverOptions := []grpc.ServerOption{
-		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), GrpcAuthzServerInterceptor()),
-		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			Time:    120 * time.Second, // The time a connection is kept alive without any activity.
 			Timeout: 20 * time.Second,  // Maximum time the server waits for activity before closing the connection.
@@ -0,0 +1,189 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrNotLoggedIn is returned when no token file is given and no credentials were stored by "idccli auth login".
+var ErrNotLoggedIn = errors.New("not logged in, run \"idccli auth login\" or pass --token-file")
+
+// Credentials are stored by "idccli auth login" so that the access token can be refreshed by later commands.
+type Credentials struct {
+	Issuer   string        `json:"issuer"`
+	ClientId string        `json:"clientId"`
+	Scopes   []string      `json:"scopes,omitempty"`
+	Token    *oauth2.Token `json:"token"`
+}
+
+type providerMetadata struct {
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+// CredentialsFile returns the path of the stored credentials, $HOME/.idccli/credentials.json.
+func CredentialsFile() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".idccli", "credentials.json"), nil
+}
+
+// DeviceLogin runs the OAuth 2.0 device authorization grant (RFC 8628) against an OIDC issuer.
+// prompt is called with the verification uri and the user code that the user must enter.
+func DeviceLogin(ctx context.Context, issuer string, clientId string, scopes []string, prompt func(verificationUri string, userCode string)) (*Credentials, error) {
+	config, err := oauthConfig(ctx, issuer, clientId, scopes)
+	if err != nil {
+		return nil, err
+	}
+	if config.Endpoint.DeviceAuthURL == "" {
+		return nil, fmt.Errorf("issuer %s does not support the device authorization grant", issuer)
+	}
+	deviceAuth, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization failed: %w", err)
+	}
+	verificationUri := deviceAuth.VerificationURIComplete
+	if verificationUri == "" {
+		verificationUri = deviceAuth.VerificationURI
+	}
+	prompt(verificationUri, deviceAuth.UserCode)
+	token, err := config.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization failed: %w", err)
+	}
+	return &Credentials{Issuer: issuer, ClientId: clientId, Scopes: scopes, Token: token}, nil
+}
+
+// TokenSource returns the source of the access tokens sent with each request.
+// If tokenFile is set, the token is read from it on every request, so that it can be rotated by other tools.
+// Otherwise, the credentials stored by DeviceLogin are used and refreshed when they expire.
+func TokenSource(ctx context.Context, tokenFile string) (oauth2.TokenSource, error) {
+	if tokenFile != "" {
+		return fileTokenSource(tokenFile), nil
+	}
+	credentialsFile, err := CredentialsFile()
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := LoadCredentials(credentialsFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotLoggedIn
+		}
+		return nil, err
+	}
+	if credentials.Token == nil {
+		return nil, ErrNotLoggedIn
+	}
+	if credentials.Token.Valid() || credentials.Token.RefreshToken == "" {
+		return oauth2.StaticTokenSource(credentials.Token), nil
+	}
+	config, err := oauthConfig(ctx, credentials.Issuer, credentials.ClientId, credentials.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	token, err := config.TokenSource(ctx, credentials.Token).Token()
+	if err != nil {
+		return nil, fmt.Errorf("unable to refresh the access token, run \"idccli auth login\": %w", err)
+	}
+	credentials.Token = token
+	if err := SaveCredentials(credentialsFile, credentials); err != nil {
+		return nil, err
+	}
+	return oauth2.StaticTokenSource(token), nil
+}
+
+func LoadCredentials(file string) (*Credentials, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	credentials := &Credentials{}
+	if err := json.Unmarshal(data, credentials); err != nil {
+		return nil, fmt.Errorf("invalid credentials file %s: %w", file, err)
+	}
+	return credentials, nil
+}
+
+// SaveCredentials writes the credentials to a file that is only readable by the current user.
+func SaveCredentials(file string, credentials *Credentials) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(credentials, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, data, 0600)
+}
+
+func oauthConfig(ctx context.Context, issuer string, clientId string, scopes []string) (*oauth2.Config, error) {
+	if issuer == "" || clientId == "" {
+		return nil, errors.New("issuer and client id are required")
+	}
+	metadata, err := discover(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Config{
+		ClientID: clientId,
+		Scopes:   scopes,
+		Endpoint: oauth2.Endpoint{
+			TokenURL:      metadata.TokenEndpoint,
+			DeviceAuthURL: metadata.DeviceAuthorizationEndpoint,
+		},
+	}, nil
+}
+
+func discover(ctx context.Context, issuer string) (*providerMetadata, error) {
+	discoveryUrl := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the OIDC configuration of %s: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch the OIDC configuration of %s: %s", issuer, resp.Status)
+	}
+	metadata := &providerMetadata{}
+	if err := json.NewDecoder(resp.Body).Decode(metadata); err != nil {
+		return nil, fmt.Errorf("invalid OIDC configuration of %s: %w", issuer, err)
+	}
+	if metadata.TokenEndpoint == "" {
+		return nil, fmt.Errorf("OIDC configuration of %s has no token endpoint", issuer)
+	}
+	return metadata, nil
+}
+
+type fileTokenSource string
+
+func (file fileTokenSource) Token() (*oauth2.Token, error) {
+	data, err := os.ReadFile(string(file))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read token file: %w", err)
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(string(data)), "Bearer "))
+	if token == "" {
+		return nil, fmt.Errorf("token file %s is empty", string(file))
+	}
+	return &oauth2.Token{AccessToken: token, TokenType: "Bearer"}, nil
+}
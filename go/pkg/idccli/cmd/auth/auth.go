@@ -0,0 +1,106 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/auth"
+	"github.com/spf13/cobra"
+)
+
+type loginOpts struct {
+	issuer   string
+	clientId string
+	scopes   []string
+}
+
+func NewCmdAuth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth <command>",
+		Short: "Authenticate to the IDC API.",
+		Example: heredoc.Doc(`
+			$ idccli auth login --issuer <issuer url> --client-id <client id>
+			$ idccli auth print-token
+			$ idccli auth logout
+		`),
+		GroupID: "core",
+	}
+	cmd.AddCommand(newCmdLogin())
+	cmd.AddCommand(newCmdLogout())
+	cmd.AddCommand(newCmdPrintToken())
+	return cmd
+}
+
+func newCmdLogin() *cobra.Command {
+	opts := loginOpts{}
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Log in with the OIDC device authorization flow and store the credentials",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			credentials, err := auth.DeviceLogin(cmd.Context(), opts.issuer, opts.clientId, opts.scopes, func(verificationUri string, userCode string) {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Open %s in a browser and enter the code %s\n", verificationUri, userCode)
+			})
+			if err != nil {
+				return err
+			}
+			credentialsFile, err := auth.CredentialsFile()
+			if err != nil {
+				return err
+			}
+			if err := auth.SaveCredentials(credentialsFile, credentials); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Logged in, credentials stored in %s\n", credentialsFile)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&opts.issuer, "issuer", os.Getenv("IDC_OIDC_ISSUER"), "OIDC issuer url [$IDC_OIDC_ISSUER]")
+	cmd.Flags().StringVar(&opts.clientId, "client-id", os.Getenv("IDC_OIDC_CLIENT_ID"), "OIDC client id [$IDC_OIDC_CLIENT_ID]")
+	cmd.Flags().StringSliceVar(&opts.scopes, "scopes", []string{"openid", "offline_access"}, "OIDC scopes")
+	return cmd
+}
+
+func newCmdLogout() *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "Remove the stored credentials",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			credentialsFile, err := auth.CredentialsFile()
+			if err != nil {
+				return err
+			}
+			if err := os.Remove(credentialsFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "Logged out")
+			return nil
+		},
+	}
+}
+
+func newCmdPrintToken() *cobra.Command {
+	return &cobra.Command{
+		Use:   "print-token",
+		Short: "Print the access token, refreshing it if it has expired",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tokenSource, err := auth.TokenSource(cmd.Context(), "")
+			if err != nil {
+				return err
+			}
+			token, err := tokenSource.Token()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), strings.TrimSpace(token.AccessToken))
+			return nil
+		},
+	}
+}
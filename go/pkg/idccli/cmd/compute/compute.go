@@ -0,0 +1,129 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package compute
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmdutil"
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewCmdInstance(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "instance",
+		Aliases: []string{"instances"},
+		Short:   "Manage instances.",
+		Example: heredoc.Doc(`
+			$ idccli instance create my-instance -f instance.yaml --wait
+			$ idccli instance list -o yaml
+			$ idccli instance get my-instance
+			$ idccli instance update my-instance -f stop.yaml
+			$ idccli instance delete my-instance --wait
+		`),
+		Service:      "proto.InstanceService",
+		Create:       &cmdutil.Method{Name: "Create", Request: &pb.InstanceCreateRequest{}, Response: &pb.Instance{}},
+		Get:          &cmdutil.Method{Name: "Get", Request: &pb.InstanceGetRequest{}, Response: &pb.Instance{}},
+		Search:       &cmdutil.Method{Name: "Search", Request: &pb.InstanceSearchRequest{}, Response: &pb.InstanceSearchResponse{}},
+		Update:       &cmdutil.Method{Name: "Update", Request: &pb.InstanceUpdateRequest{}, Response: &emptypb.Empty{}},
+		Delete:       &cmdutil.Method{Name: "Delete", Request: &pb.InstanceDeleteRequest{}, Response: &emptypb.Empty{}},
+		ReadyPhases:  []string{pb.InstancePhase_Ready.String(), pb.InstancePhase_Stopped.String()},
+		FailedPhases: []string{pb.InstancePhase_Failed.String()},
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "metadata.name"},
+			{Header: "ID", Path: "metadata.resourceId"},
+			{Header: "INSTANCE TYPE", Path: "spec.instanceType"},
+			{Header: "IMAGE", Path: "spec.machineImage"},
+			{Header: "IP", Path: "status.interfaces.0.addresses.0"},
+			{Header: "PHASE", Path: "status.phase"},
+			{Header: "CREATED", Path: "metadata.creationTimestamp"},
+		},
+	})
+}
+
+func NewCmdInstanceGroup(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "instance-group",
+		Aliases: []string{"instance-groups", "ig"},
+		Short:   "Manage instance groups.",
+		Example: heredoc.Doc(`
+			$ idccli instance-group create my-group -f instance-group.yaml --wait
+			$ idccli instance-group list
+			$ idccli instance-group update my-group -f instance-group.yaml
+			$ idccli instance-group delete my-group
+		`),
+		Service: "proto.InstanceGroupService",
+		Create:  &cmdutil.Method{Name: "Create", Request: &pb.InstanceGroupCreateRequest{}, Response: &pb.InstanceGroup{}},
+		// Instance groups have no Get method and are only referenced by name.
+		Search:      &cmdutil.Method{Name: "Search", Request: &pb.InstanceGroupSearchRequest{}, Response: &pb.InstanceGroupSearchResponse{}},
+		Update:      &cmdutil.Method{Name: "Update", Request: &pb.InstanceGroupUpdateRequest{}, Response: &emptypb.Empty{}},
+		Delete:      &cmdutil.Method{Name: "Delete", Request: &pb.InstanceGroupDeleteRequest{}, Response: &emptypb.Empty{}},
+		RefNamePath: "metadata.name",
+		IsId:        func(string) bool { return false },
+		Ready: func(msg proto.Message) (bool, error) {
+			instanceGroup := msg.(*pb.InstanceGroup)
+			return instanceGroup.GetStatus().GetReadyCount() >= instanceGroup.GetSpec().GetInstanceCount(), nil
+		},
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "metadata.name"},
+			{Header: "INSTANCE TYPE", Path: "spec.instanceSpec.instanceType"},
+			{Header: "INSTANCES", Path: "spec.instanceCount"},
+			{Header: "READY", Path: "status.readyCount"},
+		},
+	})
+}
+
+func NewCmdSshKey(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "ssh-key",
+		Aliases: []string{"ssh-keys"},
+		Short:   "Manage SSH public keys.",
+		Example: heredoc.Doc(`
+			$ idccli ssh-key create my-key -f ssh-key.yaml
+			$ idccli ssh-key list
+			$ idccli ssh-key delete my-key
+		`),
+		Service: "proto.SshPublicKeyService",
+		Create:  &cmdutil.Method{Name: "Create", Request: &pb.SshPublicKeyCreateRequest{}, Response: &pb.SshPublicKey{}},
+		Get:     &cmdutil.Method{Name: "Get", Request: &pb.SshPublicKeyGetRequest{}, Response: &pb.SshPublicKey{}},
+		Search:  &cmdutil.Method{Name: "Search", Request: &pb.SshPublicKeySearchRequest{}, Response: &pb.SshPublicKeySearchResponse{}},
+		Delete:  &cmdutil.Method{Name: "Delete", Request: &pb.SshPublicKeyDeleteRequest{}, Response: &emptypb.Empty{}},
+		Ready:   func(proto.Message) (bool, error) { return true, nil },
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "metadata.name"},
+			{Header: "ID", Path: "metadata.resourceId"},
+			{Header: "OWNER", Path: "spec.ownerEmail"},
+			{Header: "CREATED", Path: "metadata.creationTimestamp"},
+		},
+	})
+}
+
+func NewCmdLoadBalancer(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "load-balancer",
+		Aliases: []string{"load-balancers", "lb"},
+		Short:   "Manage load balancers.",
+		Example: heredoc.Doc(`
+			$ idccli load-balancer create my-lb -f load-balancer.yaml --wait
+			$ idccli load-balancer get my-lb -o json
+			$ idccli load-balancer delete my-lb --wait
+		`),
+		Service:     "proto.LoadBalancerService",
+		Create:      &cmdutil.Method{Name: "Create", Request: &pb.LoadBalancerCreateRequest{}, Response: &pb.LoadBalancer{}},
+		Get:         &cmdutil.Method{Name: "Get", Request: &pb.LoadBalancerGetRequest{}, Response: &pb.LoadBalancer{}},
+		Search:      &cmdutil.Method{Name: "Search", Request: &pb.LoadBalancerSearchRequest{}, Response: &pb.LoadBalancerSearchResponse{}},
+		Update:      &cmdutil.Method{Name: "Update", Request: &pb.LoadBalancerUpdateRequest{}, Response: &emptypb.Empty{}},
+		Delete:      &cmdutil.Method{Name: "Delete", Request: &pb.LoadBalancerDeleteRequest{}, Response: &emptypb.Empty{}},
+		PhasePath:   "status.state",
+		ReadyPhases: []string{pb.LoadBalancerState_Active.String()},
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "metadata.name"},
+			{Header: "ID", Path: "metadata.resourceId"},
+			{Header: "VIP", Path: "status.vip"},
+			{Header: "STATE", Path: "status.state"},
+			{Header: "CREATED", Path: "metadata.creationTimestamp"},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package iks
+
+import (
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmdutil"
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewCmdCluster(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "iks-cluster",
+		Aliases: []string{"iks-clusters", "iks"},
+		Short:   "Manage Intel Kubernetes Service clusters.",
+		Example: heredoc.Doc(`
+			$ idccli iks-cluster create my-cluster -f cluster.yaml --wait
+			$ idccli iks-cluster list
+			$ idccli iks-cluster get my-cluster -o yaml
+			$ idccli iks-cluster delete my-cluster --wait
+		`),
+		Service: "proto.Iks",
+		Create:  &cmdutil.Method{Name: "CreateNewCluster", Request: &pb.ClusterRequest{}, Response: &pb.ClusterCreateResponseForm{}},
+		Get:     &cmdutil.Method{Name: "GetCluster", Request: &pb.ClusterID{}, Response: &pb.ClusterResponseForm{}},
+		Search:  &cmdutil.Method{Name: "GetClusters", Request: &pb.IksCloudAccountId{}, Response: &pb.ClustersResponse{}},
+		Update:  &cmdutil.Method{Name: "PutCluster", Request: &pb.UpdateClusterRequest{}, Response: &pb.ClusterCreateResponseForm{}},
+		Delete:  &cmdutil.Method{Name: "DeleteCluster", Request: &pb.ClusterID{}, Response: &emptypb.Empty{}},
+		// Clusters are only referenced by uuid, names are resolved with a search.
+		NamePath:     "name",
+		RefIdPath:    "clusteruuid",
+		IsId:         func(ref string) bool { return strings.HasPrefix(ref, "cl-") },
+		ItemsPath:    "clusters",
+		ItemNamePath: "name",
+		ItemIdPath:   "uuid",
+		PhasePath:    "clusterstate",
+		ReadyPhases:  []string{"Active"},
+		FailedPhases: []string{"Error"},
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "name"},
+			{Header: "UUID", Path: "uuid"},
+			{Header: "K8S VERSION", Path: "k8sversion"},
+			{Header: "STATE", Path: "clusterstate"},
+			{Header: "CREATED", Path: "createddate"},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package network
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmdutil"
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewCmdVpc(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "vpc",
+		Aliases: []string{"vpcs"},
+		Short:   "Manage VPCs.",
+		Example: heredoc.Doc(`
+			$ idccli vpc create my-vpc -f vpc.yaml --wait
+			$ idccli vpc list
+			$ idccli vpc delete my-vpc
+		`),
+		Service:     "proto.VPCService",
+		Create:      &cmdutil.Method{Name: "Create", Request: &pb.VPCCreateRequest{}, Response: &pb.VPC{}},
+		Get:         &cmdutil.Method{Name: "Get", Request: &pb.VPCGetRequest{}, Response: &pb.VPC{}},
+		Search:      &cmdutil.Method{Name: "Search", Request: &pb.VPCSearchRequest{}, Response: &pb.VPCSearchResponse{}},
+		Update:      &cmdutil.Method{Name: "Update", Request: &pb.VPCUpdateRequest{}, Response: &emptypb.Empty{}},
+		Delete:      &cmdutil.Method{Name: "Delete", Request: &pb.VPCDeleteRequest{}, Response: &emptypb.Empty{}},
+		ReadyPhases: []string{pb.VPCPhase_VPCPhase_Ready.String()},
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "metadata.name"},
+			{Header: "ID", Path: "metadata.resourceId"},
+			{Header: "CIDR", Path: "spec.cidrBlock"},
+			{Header: "PHASE", Path: "status.phase"},
+			{Header: "CREATED", Path: "metadata.creationTimestamp"},
+		},
+	})
+}
+
+func NewCmdSubnet(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "subnet",
+		Aliases: []string{"subnets"},
+		Short:   "Manage the subnets of a VPC.",
+		Example: heredoc.Doc(`
+			$ idccli subnet create my-subnet --vpc <vpc id> -f subnet.yaml --wait
+			$ idccli subnet list --vpc <vpc id>
+			$ idccli subnet delete my-subnet --vpc <vpc id>
+		`),
+		Service: "proto.SubnetService",
+		Create:  &cmdutil.Method{Name: "Create", Request: &pb.SubnetCreateRequest{}, Response: &pb.VPCSubnet{}},
+		Get:     &cmdutil.Method{Name: "Get", Request: &pb.SubnetGetRequest{}, Response: &pb.VPCSubnet{}},
+		Search:  &cmdutil.Method{Name: "Search", Request: &pb.SubnetSearchRequest{}, Response: &pb.SubnetSearchResponse{}},
+		Update:  &cmdutil.Method{Name: "Update", Request: &pb.SubnetUpdateRequest{}, Response: &emptypb.Empty{}},
+		Delete:  &cmdutil.Method{Name: "Delete", Request: &pb.SubnetDeleteRequest{}, Response: &emptypb.Empty{}},
+		// Subnets are only referenced by id, names are resolved with a search.
+		RefIdPath:   "metadata.resourceId",
+		ReadyPhases: []string{pb.SubnetPhase_SubnetPhase_Ready.String()},
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "metadata.name"},
+			{Header: "ID", Path: "metadata.resourceId"},
+			{Header: "CIDR", Path: "spec.cidrBlock"},
+			{Header: "AVAILABILITY ZONE", Path: "spec.availabilityZone"},
+			{Header: "PHASE", Path: "status.phase"},
+		},
+		RequestFlags: []cmdutil.RequestFlag{
+			{Name: "vpc", Usage: "id of the VPC of the subnet", Path: "spec.vpcId", Required: true},
+		},
+	})
+}
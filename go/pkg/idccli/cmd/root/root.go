@@ -3,7 +3,13 @@
 package root
 
 import (
+	authcmd "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmd/auth"
+	computecmd "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmd/compute"
+	ikscmd "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmd/iks"
+	networkcmd "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmd/network"
 	sshcmd "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmd/ssh"
+	storagecmd "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmd/storage"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmdutil"
 	"github.com/spf13/cobra"
 )
 
@@ -19,9 +25,26 @@ func NewCmdRoot() (*cobra.Command, error) {
 		ID:    "core",
 		Title: "Core commands",
 	})
+	cmd.AddGroup(&cobra.Group{
+		ID:    "resources",
+		Title: "Resource commands",
+	})
 
 	// Child commands
 	cmd.AddCommand(sshcmd.NewCmdSsh())
+	cmd.AddCommand(authcmd.NewCmdAuth())
+
+	// The flags of the resource commands are shared, as only one command runs.
+	opts := cmdutil.NewOptions()
+	cmd.AddCommand(computecmd.NewCmdInstance(opts))
+	cmd.AddCommand(computecmd.NewCmdInstanceGroup(opts))
+	cmd.AddCommand(computecmd.NewCmdSshKey(opts))
+	cmd.AddCommand(computecmd.NewCmdLoadBalancer(opts))
+	cmd.AddCommand(storagecmd.NewCmdFilesystem(opts))
+	cmd.AddCommand(storagecmd.NewCmdBucket(opts))
+	cmd.AddCommand(networkcmd.NewCmdVpc(opts))
+	cmd.AddCommand(networkcmd.NewCmdSubnet(opts))
+	cmd.AddCommand(ikscmd.NewCmdCluster(opts))
 
 	return cmd, nil
 }
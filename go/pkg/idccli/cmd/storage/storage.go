@@ -0,0 +1,70 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package storage
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/cmdutil"
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewCmdFilesystem(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "filesystem",
+		Aliases: []string{"filesystems", "fs"},
+		Short:   "Manage filesystems.",
+		Example: heredoc.Doc(`
+			$ idccli filesystem create my-fs -f filesystem.yaml --wait
+			$ idccli filesystem list
+			$ idccli filesystem update my-fs -f resize.yaml --wait
+			$ idccli filesystem delete my-fs --wait
+		`),
+		Service:      "proto.FileStorageService",
+		Create:       &cmdutil.Method{Name: "Create", Request: &pb.FilesystemCreateRequest{}, Response: &pb.Filesystem{}},
+		Get:          &cmdutil.Method{Name: "Get", Request: &pb.FilesystemGetRequest{}, Response: &pb.Filesystem{}},
+		Search:       &cmdutil.Method{Name: "Search", Request: &pb.FilesystemSearchRequest{}, Response: &pb.FilesystemSearchResponse{}},
+		Update:       &cmdutil.Method{Name: "Update", Request: &pb.FilesystemUpdateRequest{}, Response: &pb.Filesystem{}},
+		Delete:       &cmdutil.Method{Name: "Delete", Request: &pb.FilesystemDeleteRequest{}, Response: &emptypb.Empty{}},
+		ReadyPhases:  []string{pb.FilesystemPhase_FSReady.String()},
+		FailedPhases: []string{pb.FilesystemPhase_FSFailed.String()},
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "metadata.name"},
+			{Header: "ID", Path: "metadata.resourceId"},
+			{Header: "SIZE", Path: "spec.request.storage"},
+			{Header: "STORAGE CLASS", Path: "spec.storageClass"},
+			{Header: "PHASE", Path: "status.phase"},
+			{Header: "CREATED", Path: "metadata.creationTimestamp"},
+		},
+	})
+}
+
+func NewCmdBucket(opts *cmdutil.Options) *cobra.Command {
+	return cmdutil.NewCmdResource(opts, &cmdutil.Resource{
+		Use:     "bucket",
+		Aliases: []string{"buckets"},
+		Short:   "Manage object storage buckets.",
+		Example: heredoc.Doc(`
+			$ idccli bucket create my-bucket -f bucket.yaml --wait
+			$ idccli bucket list
+			$ idccli bucket delete my-bucket
+		`),
+		Service:      "proto.ObjectStorageService",
+		Create:       &cmdutil.Method{Name: "CreateBucket", Request: &pb.ObjectBucketCreateRequest{}, Response: &pb.ObjectBucket{}},
+		Get:          &cmdutil.Method{Name: "GetBucket", Request: &pb.ObjectBucketGetRequest{}, Response: &pb.ObjectBucket{}},
+		Search:       &cmdutil.Method{Name: "SearchBucket", Request: &pb.ObjectBucketSearchRequest{}, Response: &pb.ObjectBucketSearchResponse{}},
+		Delete:       &cmdutil.Method{Name: "DeleteBucket", Request: &pb.ObjectBucketDeleteRequest{}, Response: &emptypb.Empty{}},
+		RefNamePath:  "metadata.bucketName",
+		RefIdPath:    "metadata.bucketId",
+		ReadyPhases:  []string{pb.BucketPhase_BucketReady.String()},
+		FailedPhases: []string{pb.BucketPhase_BucketFailed.String()},
+		Columns: []cmdutil.Column{
+			{Header: "NAME", Path: "metadata.name"},
+			{Header: "ID", Path: "metadata.resourceId"},
+			{Header: "VERSIONED", Path: "spec.versioned"},
+			{Header: "PHASE", Path: "status.phase"},
+			{Header: "CREATED", Path: "metadata.creationTimestamp"},
+		},
+	})
+}
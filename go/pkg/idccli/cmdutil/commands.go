@@ -0,0 +1,268 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package cmdutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+type waitOpts struct {
+	wait    bool
+	timeout time.Duration
+}
+
+func (w *waitOpts) addFlags(cmd *cobra.Command, what string) {
+	cmd.Flags().BoolVar(&w.wait, "wait", false, "wait until the resource is "+what)
+	cmd.Flags().DurationVar(&w.timeout, "wait-timeout", 30*time.Minute, "maximum time to wait")
+}
+
+// NewCmdResource returns the command to manage a type of resource, with create, get, list, update, delete and wait
+// subcommands for the methods supported by the service.
+func NewCmdResource(opts *Options, r *Resource) *cobra.Command {
+	r.setDefaults()
+	c := &client{Resource: r, opts: opts, requestFlags: map[string]*string{}}
+	cmd := &cobra.Command{
+		Use:     r.Use + " <command>",
+		Aliases: r.Aliases,
+		Short:   r.Short,
+		Example: r.Example,
+		GroupID: "resources",
+	}
+	opts.AddFlags(cmd.PersistentFlags())
+	for _, flag := range r.RequestFlags {
+		c.requestFlags[flag.Name] = cmd.PersistentFlags().String(flag.Name, "", flag.Usage)
+	}
+
+	if r.Create != nil {
+		cmd.AddCommand(newCmdCreate(c))
+	}
+	if r.Get != nil || r.Search != nil {
+		cmd.AddCommand(newCmdGet(c))
+	}
+	if r.Search != nil {
+		cmd.AddCommand(newCmdList(c))
+	}
+	if r.Update != nil {
+		cmd.AddCommand(newCmdUpdate(c))
+	}
+	if r.Delete != nil {
+		cmd.AddCommand(newCmdDelete(c))
+	}
+	if r.Get != nil || r.Search != nil {
+		cmd.AddCommand(newCmdWait(c))
+	}
+	return cmd
+}
+
+// run connects to the API before running f.
+func (c *client) run(cmd *cobra.Command, f func(ctx context.Context) error) error {
+	// Errors past this point are not caused by the usage of the command.
+	cmd.SilenceUsage = true
+	ctx := cmd.Context()
+	conn, err := c.opts.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c.conn = conn
+	return f(ctx)
+}
+
+func (c *client) print(cmd *cobra.Command, msgs ...proto.Message) error {
+	return PrintMessages(cmd.OutOrStdout(), c.opts.Output, c.Columns, msgs, true)
+}
+
+func (c *client) progress(cmd *cobra.Command, ref string) func(string) {
+	return func(phase string) {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Waiting for %s %s: %s\n", c.Use, ref, phase)
+	}
+}
+
+func newCmdCreate(c *client) *cobra.Command {
+	var file string
+	w := waitOpts{}
+	cmd := &cobra.Command{
+		Use:   "create [name] -f <file>",
+		Short: "Create a " + c.Use + " from a JSON or YAML file with the body of the REST request",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context) error {
+				req := newMessage(c.Create.Request)
+				if file != "" {
+					if err := ReadMessage(file, req); err != nil {
+						return err
+					}
+				}
+				if len(args) == 1 {
+					if err := SetField(req, c.NamePath, args[0]); err != nil {
+						return err
+					}
+				}
+				if err := c.prepare(req); err != nil {
+					return err
+				}
+				resp, err := c.invoke(ctx, c.Create, req)
+				if err != nil {
+					return err
+				}
+				if w.wait {
+					ref := GetField(resp, c.ItemIdPath)
+					if ref == "" {
+						ref = GetField(resp, c.ItemNamePath)
+					}
+					if resp, err = c.wait(ctx, ref, false, w.timeout, c.progress(cmd, ref)); err != nil {
+						return err
+					}
+				}
+				return c.print(cmd, resp)
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "file with the request body, or - for stdin")
+	w.addFlags(cmd, "ready")
+	return cmd
+}
+
+func newCmdGet(c *client) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <name|id>",
+		Short: "Get a " + c.Use,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context) error {
+				resp, err := c.get(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return c.print(cmd, resp)
+			})
+		},
+	}
+}
+
+func newCmdList(c *client) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the " + c.Use + " resources of the cloud account",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context) error {
+				items, err := c.list(ctx)
+				if err != nil {
+					return err
+				}
+				return PrintMessages(cmd.OutOrStdout(), c.opts.Output, c.Columns, items, false)
+			})
+		},
+	}
+}
+
+func newCmdUpdate(c *client) *cobra.Command {
+	var file string
+	w := waitOpts{}
+	cmd := &cobra.Command{
+		Use:   "update <name|id> -f <file>",
+		Short: "Update a " + c.Use + " from a JSON or YAML file with the body of the REST request",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" {
+				return errors.New("--filename is required")
+			}
+			return c.run(cmd, func(ctx context.Context) error {
+				ref, err := c.reference(ctx, c.Update, args[0])
+				if err != nil {
+					return err
+				}
+				req := newMessage(c.Update.Request)
+				if err := ReadMessage(file, req); err != nil {
+					return err
+				}
+				// The reference given on the command line takes precedence over the file.
+				proto.Merge(req, ref)
+				if _, err := c.invoke(ctx, c.Update, req); err != nil {
+					return err
+				}
+				var resp proto.Message
+				if w.wait {
+					resp, err = c.wait(ctx, args[0], false, w.timeout, c.progress(cmd, args[0]))
+				} else {
+					resp, err = c.get(ctx, args[0])
+				}
+				if err != nil {
+					return err
+				}
+				return c.print(cmd, resp)
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "file with the request body, or - for stdin")
+	w.addFlags(cmd, "ready")
+	return cmd
+}
+
+func newCmdDelete(c *client) *cobra.Command {
+	w := waitOpts{}
+	cmd := &cobra.Command{
+		Use:   "delete <name|id>...",
+		Short: "Delete " + c.Use + " resources",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context) error {
+				for _, ref := range args {
+					req, err := c.reference(ctx, c.Delete, ref)
+					if err != nil {
+						return err
+					}
+					if _, err := c.invoke(ctx, c.Delete, req); err != nil {
+						return err
+					}
+					fmt.Fprintf(cmd.OutOrStdout(), "Deleting %s %s\n", c.Use, ref)
+				}
+				if !w.wait {
+					return nil
+				}
+				for _, ref := range args {
+					if _, err := c.wait(ctx, ref, true, w.timeout, c.progress(cmd, ref)); err != nil {
+						return err
+					}
+					fmt.Fprintf(cmd.OutOrStdout(), "Deleted %s %s\n", c.Use, ref)
+				}
+				return nil
+			})
+		},
+	}
+	w.addFlags(cmd, "deleted")
+	return cmd
+}
+
+func newCmdWait(c *client) *cobra.Command {
+	var condition string
+	var timeout time.Duration
+	cmd := &cobra.Command{
+		Use:   "wait <name|id>",
+		Short: "Wait until a " + c.Use + " is ready or deleted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if condition != "ready" && condition != "delete" {
+				return fmt.Errorf("invalid condition %q, must be ready or delete", condition)
+			}
+			return c.run(cmd, func(ctx context.Context) error {
+				resp, err := c.wait(ctx, args[0], condition == "delete", timeout, c.progress(cmd, args[0]))
+				if err != nil || resp == nil {
+					return err
+				}
+				return c.print(cmd, resp)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&condition, "for", "ready", "condition to wait for: ready or delete")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "maximum time to wait")
+	return cmd
+}
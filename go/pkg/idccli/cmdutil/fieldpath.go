@@ -0,0 +1,185 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package cmdutil
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Field paths are dot separated field names, such as "metadata.name" or "status.interfaces.0.addresses.0".
+// They allow the resource commands to handle the request and response messages of all the services the same way.
+
+func findField(desc protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if field := desc.Fields().ByName(protoreflect.Name(name)); field != nil {
+		return field
+	}
+	return desc.Fields().ByJSONName(name)
+}
+
+// HasField returns true if the message type has the field path.
+func HasField(msg proto.Message, path string) bool {
+	desc := msg.ProtoReflect().Descriptor()
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		field := findField(desc, part)
+		if field == nil {
+			return false
+		}
+		if i == len(parts)-1 {
+			return true
+		}
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			return false
+		}
+		desc = field.Message()
+	}
+	return false
+}
+
+// SetField sets a scalar field of the message from its string representation, creating the parent messages as needed.
+// Setting a member of a oneof clears the other members.
+func SetField(msg proto.Message, path string, value string) error {
+	m := msg.ProtoReflect()
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		field := findField(m.Descriptor(), part)
+		if field == nil || field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			return fmt.Errorf("%s has no message field %s", m.Descriptor().FullName(), part)
+		}
+		m = m.Mutable(field).Message()
+	}
+	field := findField(m.Descriptor(), parts[len(parts)-1])
+	if field == nil || field.IsList() || field.IsMap() {
+		return fmt.Errorf("%s has no scalar field %s", m.Descriptor().FullName(), parts[len(parts)-1])
+	}
+	v, err := parseValue(field, value)
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", path, err)
+	}
+	m.Set(field, v)
+	return nil
+}
+
+func parseValue(field protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(i)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(i), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		i, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(i)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		i, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(i), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByName(protoreflect.Name(value)); enumValue != nil {
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+		return protoreflect.Value{}, fmt.Errorf("unknown %s %q", field.Enum().Name(), value)
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", field.Kind())
+}
+
+// GetField returns the string representation of a field of the message.
+// Enums are formatted with their names, timestamps in RFC 3339 and lists are comma separated.
+// An empty string is returned if the path does not exist or is not set.
+func GetField(msg proto.Message, path string) string {
+	m := msg.ProtoReflect()
+	parts := strings.Split(path, ".")
+	for i := 0; i < len(parts); i++ {
+		field := findField(m.Descriptor(), parts[i])
+		if field == nil {
+			return ""
+		}
+		value := m.Get(field)
+		if field.IsList() {
+			list := value.List()
+			if i+1 < len(parts) {
+				index, err := strconv.Atoi(parts[i+1])
+				if err != nil || index < 0 || index >= list.Len() {
+					return ""
+				}
+				i++
+				if field.Kind() == protoreflect.MessageKind && i+1 < len(parts) {
+					m = list.Get(index).Message()
+					continue
+				}
+				return formatValue(field, list.Get(index))
+			}
+			values := make([]string, 0, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				values = append(values, formatValue(field, list.Get(j)))
+			}
+			return strings.Join(values, ",")
+		}
+		if field.IsMap() {
+			if i+1 < len(parts) {
+				key := protoreflect.ValueOfString(strings.Join(parts[i+1:], ".")).MapKey()
+				if field.MapKey().Kind() != protoreflect.StringKind || !value.Map().Has(key) {
+					return ""
+				}
+				return formatValue(field.MapValue(), value.Map().Get(key))
+			}
+			values := []string{}
+			value.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				values = append(values, k.String()+"="+formatValue(field.MapValue(), v))
+				return true
+			})
+			sort.Strings(values)
+			return strings.Join(values, ",")
+		}
+		if i+1 < len(parts) {
+			if field.Kind() != protoreflect.MessageKind || !m.Has(field) {
+				return ""
+			}
+			m = value.Message()
+			continue
+		}
+		if field.Kind() == protoreflect.MessageKind && !m.Has(field) {
+			return ""
+		}
+		return formatValue(field, value)
+	}
+	return ""
+}
+
+func formatValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	case protoreflect.MessageKind:
+		if ts, ok := value.Message().Interface().(*timestamppb.Timestamp); ok {
+			if ts.GetSeconds() == 0 && ts.GetNanos() == 0 {
+				return ""
+			}
+			return ts.AsTime().UTC().Format(time.RFC3339)
+		}
+		return fmt.Sprint(value.Message().Interface())
+	}
+	return value.String()
+}
@@ -0,0 +1,145 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package cmdutil
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestInstance() *pb.Instance {
+	return &pb.Instance{
+		Metadata: &pb.InstanceMetadata{
+			CloudAccountId:    "123456789012",
+			Name:              "my-instance",
+			ResourceId:        "9f857a32-407e-4123-b434-119a018cc101",
+			Labels:            map[string]string{"b": "2", "a": "1"},
+			CreationTimestamp: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		},
+		Spec: &pb.InstanceSpec{InstanceType: "vm-spr-sml"},
+		Status: &pb.InstanceStatus{
+			Phase: pb.InstancePhase_Ready,
+			Interfaces: []*pb.InstanceInterfaceStatus{
+				{Name: "eth0", Addresses: []string{"10.0.0.1", "10.0.0.2"}},
+			},
+		},
+	}
+}
+
+func TestGetField(t *testing.T) {
+	instance := newTestInstance()
+	for path, expected := range map[string]string{
+		"metadata.name":                   "my-instance",
+		"metadata.creationTimestamp":      "2024-01-02T03:04:05Z",
+		"metadata.labels":                 "a=1,b=2",
+		"metadata.labels.b":               "2",
+		"metadata.labels.c":               "",
+		"status.phase":                    "Ready",
+		"status.interfaces.0.addresses":   "10.0.0.1,10.0.0.2",
+		"status.interfaces.0.addresses.1": "10.0.0.2",
+		"status.interfaces.1.addresses.0": "",
+		"status.sshProxy.proxyAddress":    "",
+		"spec.unknown":                    "",
+	} {
+		if value := GetField(instance, path); value != expected {
+			t.Errorf("%s: expected %q, got %q", path, expected, value)
+		}
+	}
+}
+
+func TestSetField(t *testing.T) {
+	req := &pb.InstanceGetRequest{}
+	if err := SetField(req, "metadata.cloudAccountId", "123456789012"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetField(req, "metadata.name", "my-instance"); err != nil {
+		t.Fatal(err)
+	}
+	// Setting a member of a oneof clears the others.
+	if err := SetField(req, "metadata.resourceId", "9f857a32-407e-4123-b434-119a018cc101"); err != nil {
+		t.Fatal(err)
+	}
+	if req.Metadata.GetCloudAccountId() != "123456789012" || req.Metadata.GetName() != "" ||
+		req.Metadata.GetResourceId() != "9f857a32-407e-4123-b434-119a018cc101" {
+		t.Fatalf("unexpected request: %v", req)
+	}
+
+	search := &pb.InstanceSearchRequest{}
+	if err := SetField(search, "pageSize", "10"); err != nil || search.PageSize != 10 {
+		t.Fatalf("unexpected page size %d: %v", search.PageSize, err)
+	}
+	if err := SetField(search, "pageSize", "ten"); err == nil {
+		t.Fatal("expected an error for an invalid integer")
+	}
+	if err := SetField(&pb.InstanceStatus{}, "phase", "Stopped"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetField(&pb.InstanceStatus{}, "phase", "Unknown"); err == nil {
+		t.Fatal("expected an error for an invalid enum")
+	}
+	if err := SetField(req, "metadata.unknown", "x"); err == nil {
+		t.Fatal("expected an error for an unknown field")
+	}
+	if !HasField(req, "metadata.cloudAccountId") || HasField(req, "cloudAccountId") || HasField(&pb.ClusterID{}, "metadata.cloudAccountId") {
+		t.Fatal("unexpected HasField result")
+	}
+}
+
+func TestPrintMessages(t *testing.T) {
+	instance := newTestInstance()
+	columns := []Column{{Header: "NAME", Path: "metadata.name"}, {Header: "PHASE", Path: "status.phase"}}
+
+	out := &bytes.Buffer{}
+	if err := PrintMessages(out, OutputTable, columns, []proto.Message{instance}, false); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || strings.Fields(lines[0])[0] != "NAME" || strings.Fields(lines[1])[1] != "Ready" {
+		t.Fatalf("unexpected table: %q", out.String())
+	}
+
+	out.Reset()
+	if err := PrintMessages(out, OutputYaml, columns, []proto.Message{instance}, true); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "name: my-instance") || strings.HasPrefix(out.String(), "-") {
+		t.Fatalf("unexpected yaml: %q", out.String())
+	}
+
+	out.Reset()
+	if err := PrintMessages(out, OutputJson, columns, []proto.Message{instance, instance}, false); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out.String(), "[") {
+		t.Fatalf("expected an array: %q", out.String())
+	}
+}
+
+func TestReadMessage(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "instance.yaml")
+	data := "metadata:\n  name: my-instance\nspec:\n  instanceType: vm-spr-sml\n  runStrategy: Halted\n"
+	if err := os.WriteFile(file, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.InstanceCreateRequest{}
+	if err := ReadMessage(file, req); err != nil {
+		t.Fatal(err)
+	}
+	if req.Metadata.GetName() != "my-instance" || req.Spec.GetInstanceType() != "vm-spr-sml" || req.Spec.GetRunStrategy() != pb.RunStrategy_Halted {
+		t.Fatalf("unexpected request: %v", req)
+	}
+	if err := os.WriteFile(file, []byte("spec:\n  unknownField: 1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ReadMessage(file, req); err == nil {
+		t.Fatal("expected an error for an unknown field")
+	}
+}
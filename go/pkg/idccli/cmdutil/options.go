@@ -0,0 +1,102 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package cmdutil
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/idccli/auth"
+	"github.com/spf13/pflag"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	OutputTable = "table"
+	OutputJson  = "json"
+	OutputYaml  = "yaml"
+)
+
+// Options are the flags shared by all the resource commands.
+// Defaults are read from the environment so that they do not need to be repeated on each command.
+type Options struct {
+	Address        string
+	CloudAccountId string
+	TokenFile      string
+	Output         string
+	Insecure       bool
+	RequestTimeout time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{}
+}
+
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Address, "address", os.Getenv("IDC_ADDRESS"), "address (host:port) of the IDC gRPC API [$IDC_ADDRESS]")
+	flags.StringVar(&o.CloudAccountId, "cloudaccount", os.Getenv("IDC_CLOUDACCOUNT"), "cloud account id [$IDC_CLOUDACCOUNT]")
+	flags.StringVar(&o.TokenFile, "token-file", os.Getenv("IDC_TOKEN_FILE"), "file containing the bearer token, instead of the credentials stored by \"idccli auth login\" [$IDC_TOKEN_FILE]")
+	flags.StringVarP(&o.Output, "output", "o", OutputTable, "output format: table, json or yaml")
+	flags.BoolVar(&o.Insecure, "insecure", false, "connect without TLS, for local development only")
+	flags.DurationVar(&o.RequestTimeout, "request-timeout", time.Minute, "timeout of each API request")
+}
+
+func (o *Options) Validate() error {
+	if o.Address == "" {
+		return errors.New("--address or $IDC_ADDRESS is required")
+	}
+	if o.CloudAccountId == "" {
+		return errors.New("--cloudaccount or $IDC_CLOUDACCOUNT is required")
+	}
+	switch o.Output {
+	case OutputTable, OutputJson, OutputYaml:
+	default:
+		return fmt.Errorf("invalid output format %q", o.Output)
+	}
+	return nil
+}
+
+// Connect returns a client connection that sends the access token with each request.
+func (o *Options) Connect(ctx context.Context) (*grpc.ClientConn, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	tokenSource, err := auth.TokenSource(ctx, o.TokenFile)
+	if err != nil {
+		return nil, err
+	}
+	transportCredentials := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if o.Insecure {
+		transportCredentials = insecure.NewCredentials()
+	}
+	return grpc.NewClient(o.Address,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithPerRPCCredentials(&tokenCredentials{tokenSource: tokenSource, insecure: o.Insecure}),
+	)
+}
+
+// tokenCredentials is like oauth.TokenSource in google.golang.org/grpc/credentials/oauth,
+// but it also allows plaintext connections to local servers.
+type tokenCredentials struct {
+	tokenSource oauth2.TokenSource
+	insecure    bool
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"authorization": "Bearer " + token.AccessToken}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return !c.insecure
+}
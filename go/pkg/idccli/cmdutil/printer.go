@@ -0,0 +1,101 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package cmdutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// Column is a column of the table output.
+type Column struct {
+	Header string
+	Path   string
+}
+
+// PrintMessages writes the messages in the output format.
+// In JSON and YAML, a single message is written as an object and several messages as an array.
+func PrintMessages(w io.Writer, format string, columns []Column, msgs []proto.Message, single bool) error {
+	switch format {
+	case OutputJson, OutputYaml:
+		data, err := marshalMessages(msgs, single)
+		if err != nil {
+			return err
+		}
+		if format == OutputYaml {
+			if data, err = yaml.JSONToYAML(data); err != nil {
+				return err
+			}
+		} else {
+			data = append(data, '\n')
+		}
+		_, err = w.Write(data)
+		return err
+	default:
+		tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		headers := make([]string, len(columns))
+		for i, column := range columns {
+			headers[i] = column.Header
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+		for _, msg := range msgs {
+			values := make([]string, len(columns))
+			for i, column := range columns {
+				values[i] = GetField(msg, column.Path)
+			}
+			fmt.Fprintln(tw, strings.Join(values, "\t"))
+		}
+		return tw.Flush()
+	}
+}
+
+func marshalMessages(msgs []proto.Message, single bool) ([]byte, error) {
+	items := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, data)
+	}
+	var v any = items
+	if single && len(items) == 1 {
+		v = items[0]
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
+
+// ReadMessage reads a message from a JSON or YAML file, or from stdin if file is "-".
+// The format is the same as the body of the corresponding REST request.
+func ReadMessage(file string, msg proto.Message) error {
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return fmt.Errorf("invalid file %s: %w", file, err)
+	}
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("invalid file %s: %w", file, err)
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package cmdutil
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// PollInterval is the interval between two Get requests while waiting for a resource.
+var PollInterval = 5 * time.Second
+
+// Method is a unary method of a public gRPC service.
+// Request and Response are empty messages of the request and response types.
+type Method struct {
+	Name     string
+	Request  proto.Message
+	Response proto.Message
+}
+
+// RequestFlag is a flag that sets a field of all the requests of a resource, such as the VPC of a subnet.
+type RequestFlag struct {
+	Name     string
+	Usage    string
+	Path     string
+	Required bool
+}
+
+// Resource describes how to manage a type of resource with a public gRPC service.
+// Field paths that are empty use the conventions of the compute API, where resources have
+// metadata.name, metadata.resourceId and status.phase.
+type Resource struct {
+	Use     string
+	Aliases []string
+	Short   string
+	Example string
+	// Full name of the gRPC service, such as proto.InstanceService.
+	Service string
+	// Methods of the service. A nil method is not supported by the service.
+	Create *Method
+	Get    *Method
+	Search *Method
+	Update *Method
+	Delete *Method
+	// Path of the name in the create request.
+	NamePath string
+	// Paths of the name and id in the get, update and delete requests.
+	// An empty path is not supported by the service, and the resource is found by a search.
+	RefNamePath string
+	RefIdPath   string
+	// IsId returns true if the reference given by the user is an id. The default is to check for a UUID.
+	IsId func(ref string) bool
+	// Paths of the items in the search response, and of their name and id.
+	ItemsPath    string
+	ItemNamePath string
+	ItemIdPath   string
+	// Path of the phase in the resource, and the phases that end a wait.
+	PhasePath    string
+	ReadyPhases  []string
+	FailedPhases []string
+	// Ready overrides the phases to decide if the resource is ready.
+	Ready func(msg proto.Message) (bool, error)
+	// Columns of the table output.
+	Columns      []Column
+	RequestFlags []RequestFlag
+}
+
+func (r *Resource) setDefaults() {
+	if r.NamePath == "" {
+		r.NamePath = "metadata.name"
+	}
+	if r.RefNamePath == "" && r.RefIdPath == "" {
+		r.RefNamePath = "metadata.name"
+		r.RefIdPath = "metadata.resourceId"
+	}
+	if r.IsId == nil {
+		r.IsId = func(ref string) bool {
+			_, err := uuid.Parse(ref)
+			return err == nil
+		}
+	}
+	if r.ItemsPath == "" {
+		r.ItemsPath = "items"
+	}
+	if r.ItemNamePath == "" {
+		r.ItemNamePath = "metadata.name"
+	}
+	if r.ItemIdPath == "" {
+		r.ItemIdPath = "metadata.resourceId"
+	}
+	if r.PhasePath == "" {
+		r.PhasePath = "status.phase"
+	}
+}
+
+// client binds a resource to a connection and to the values of the command flags.
+type client struct {
+	*Resource
+	opts         *Options
+	conn         *grpc.ClientConn
+	requestFlags map[string]*string
+}
+
+func newMessage(msg proto.Message) proto.Message {
+	return msg.ProtoReflect().New().Interface()
+}
+
+func (c *client) invoke(ctx context.Context, method *Method, req proto.Message) (proto.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.opts.RequestTimeout)
+	defer cancel()
+	resp := newMessage(method.Response)
+	if err := c.conn.Invoke(ctx, "/"+c.Service+"/"+method.Name, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// prepare sets the cloud account and the request flags of a request.
+func (c *client) prepare(req proto.Message) error {
+	cloudAccountPath := "metadata.cloudAccountId"
+	if !HasField(req, cloudAccountPath) {
+		cloudAccountPath = "cloudAccountId"
+	}
+	if err := SetField(req, cloudAccountPath, c.opts.CloudAccountId); err != nil {
+		return err
+	}
+	for _, flag := range c.RequestFlags {
+		value := *c.requestFlags[flag.Name]
+		if value == "" {
+			if flag.Required {
+				return fmt.Errorf("--%s is required", flag.Name)
+			}
+			continue
+		}
+		if !HasField(req, flag.Path) {
+			continue
+		}
+		if err := SetField(req, flag.Path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reference returns a request of the method that references the resource by the name or id given by the user.
+func (c *client) reference(ctx context.Context, method *Method, ref string) (proto.Message, error) {
+	req := newMessage(method.Request)
+	if err := c.prepare(req); err != nil {
+		return nil, err
+	}
+	isId := c.IsId(ref)
+	switch {
+	case isId && c.RefIdPath != "":
+		return req, SetField(req, c.RefIdPath, ref)
+	case !isId && c.RefNamePath != "":
+		return req, SetField(req, c.RefNamePath, ref)
+	}
+	item, err := c.find(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if c.RefIdPath != "" {
+		return req, SetField(req, c.RefIdPath, GetField(item, c.ItemIdPath))
+	}
+	return req, SetField(req, c.RefNamePath, GetField(item, c.ItemNamePath))
+}
+
+func (c *client) list(ctx context.Context) ([]proto.Message, error) {
+	req := newMessage(c.Search.Request)
+	if err := c.prepare(req); err != nil {
+		return nil, err
+	}
+	var items []proto.Message
+	for {
+		resp, err := c.invoke(ctx, c.Search, req)
+		if err != nil {
+			return nil, err
+		}
+		field := findField(resp.ProtoReflect().Descriptor(), c.ItemsPath)
+		if field == nil || !field.IsList() {
+			return nil, fmt.Errorf("%s has no list field %s", resp.ProtoReflect().Descriptor().FullName(), c.ItemsPath)
+		}
+		list := resp.ProtoReflect().Get(field).List()
+		for i := 0; i < list.Len(); i++ {
+			items = append(items, list.Get(i).Message().Interface())
+		}
+		nextPageToken := GetField(resp, "nextPageToken")
+		if nextPageToken == "" || !HasField(req, "pageToken") {
+			return items, nil
+		}
+		if err := SetField(req, "pageToken", nextPageToken); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// find searches the resource with the name or id given by the user.
+func (c *client) find(ctx context.Context, ref string) (proto.Message, error) {
+	items, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if GetField(item, c.ItemNamePath) == ref || GetField(item, c.ItemIdPath) == ref {
+			return item, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "%s %s not found", c.Use, ref)
+}
+
+func (c *client) get(ctx context.Context, ref string) (proto.Message, error) {
+	if c.Get == nil {
+		return c.find(ctx, ref)
+	}
+	req, err := c.reference(ctx, c.Get, ref)
+	if err != nil {
+		return nil, err
+	}
+	return c.invoke(ctx, c.Get, req)
+}
+
+func (c *client) ready(msg proto.Message) (bool, error) {
+	if c.Ready != nil {
+		return c.Ready(msg)
+	}
+	phase := GetField(msg, c.PhasePath)
+	if slices.Contains(c.FailedPhases, phase) {
+		return false, fmt.Errorf("%s %s is %s: %s", c.Use, GetField(msg, c.ItemNamePath), phase, GetField(msg, "status.message"))
+	}
+	return slices.Contains(c.ReadyPhases, phase), nil
+}
+
+// wait polls the resource until it is ready, or until it is deleted if deleted is true.
+func (c *client) wait(ctx context.Context, ref string, deleted bool, timeout time.Duration, progress func(string)) (proto.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	lastPhase := ""
+	for {
+		msg, err := c.get(ctx, ref)
+		switch {
+		case deleted && status.Code(err) == codes.NotFound:
+			return nil, nil
+		case status.Code(err) == codes.Unavailable || status.Code(err) == codes.DeadlineExceeded && ctx.Err() == nil:
+			// Retry transient errors.
+		case err != nil:
+			return nil, err
+		case !deleted:
+			ready, err := c.ready(msg)
+			if err != nil || ready {
+				return msg, err
+			}
+		}
+		if msg != nil {
+			if phase := GetField(msg, c.PhasePath); phase != lastPhase {
+				progress(phase)
+				lastPhase = phase
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for %s %s", c.Use, ref)
+		case <-time.After(PollInterval):
+		}
+	}
+}
@@ -11,15 +11,16 @@ import (
 
 // Application configuration
 type Config struct {
-	ListenPort               uint16           `koanf:"listenPort"`
-	Database                 manageddb.Config `koanf:"database"`
-	UsernameRwFile           string           `koanf:"usernameRwFile"`
-	PasswordRwFile           string           `koanf:"passwordRwFile"`
-	EncryptionKeys           string           `koanf:"encryptionKeys"`
-	AdminKey                 string           `koanf:"adminKey"`
-	ComputeServerAddr        string           `koanf:"computeServerAddr"`
-	ProductcatalogServerAddr string           `koanf:"productcatalogServerAddr"`
-	DbSeed                   DbSeed           `koanf:"dbSeed"`
+	ListenPort                uint16           `koanf:"listenPort"`
+	Database                  manageddb.Config `koanf:"database"`
+	UsernameRwFile            string           `koanf:"usernameRwFile"`
+	PasswordRwFile            string           `koanf:"passwordRwFile"`
+	EncryptionKeys            string           `koanf:"encryptionKeys"`
+	AdminKey                  string           `koanf:"adminKey"`
+	ComputeServerAddr         string           `koanf:"computeServerAddr"`
+	ProductcatalogServerAddr  string           `koanf:"productcatalogServerAddr"`
+	QuotaManagementServerAddr string           `koanf:"quotaManagementServerAddr"`
+	DbSeed                    DbSeed           `koanf:"dbSeed"`
 }
 
 type DbSeed struct {
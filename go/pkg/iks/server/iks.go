@@ -31,6 +31,7 @@ type GrpcService struct {
 	SshKeyService                v1.SshPublicKeyServiceClient
 	VnetClient                   v1.VNetServiceClient
 	ProductcatalogServiceClient  v1.ProductCatalogServiceClient
+	QuotaManagementClient        v1.QuotaManagementPrivateServiceClient
 	db                           *sql.DB
 	errc                         chan error
 	cfg                          config.Config
@@ -43,7 +44,8 @@ func New(ctx context.Context,
 	sshclient v1.SshPublicKeyServiceClient,
 	vnetClient v1.VNetServiceClient,
 	productcatalogServiceClient v1.ProductCatalogServiceClient,
-	computeInstanceServiceClient v1.InstanceServiceClient) (*GrpcService, error) {
+	computeInstanceServiceClient v1.InstanceServiceClient,
+	quotaManagementClient v1.QuotaManagementPrivateServiceClient) (*GrpcService, error) {
 	if cfg.ListenPort <= 0 {
 		return nil, fmt.Errorf("ListenPort must be greater than 0")
 	}
@@ -56,6 +58,7 @@ func New(ctx context.Context,
 		SshKeyService:                sshclient,
 		VnetClient:                   vnetClient,
 		ProductcatalogServiceClient:  productcatalogServiceClient,
+		QuotaManagementClient:        quotaManagementClient,
 		cfg:                          *cfg,
 	}, nil
 }
@@ -97,6 +100,7 @@ func (s *GrpcService) Start(ctx context.Context, managedDbSO *manageddb.ManagedD
 		s.VnetClient,
 		s.ProductcatalogServiceClient,
 		s.ComputeInstanceServiceClient,
+		s.QuotaManagementClient,
 		s.cfg)
 	if err != nil {
 		log.Error(err, "error initializing iks service")
@@ -0,0 +1,80 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/iks/db/query"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	quotaServiceName         = "iks"
+	quotaClusterResourceType = "clusters"
+)
+
+// reserveClusterQuota reserves a cluster in the quota management service. It returns an empty reservation id
+// when the quota management service is not configured, in which case the cluster count is only validated
+// by CreateClusterRecord.
+func (c *Server) reserveClusterQuota(ctx context.Context, cloudAccountId string) (string, error) {
+	logger := log.FromContext(ctx).WithName("Server.reserveClusterQuota")
+	if c.qmsClient == nil {
+		return "", nil
+	}
+
+	// The current cluster count seeds the usage the first time the cloudaccount reserves a cluster.
+	var clusterCount int64
+	if err := c.session.QueryRowContext(ctx, query.GetClusterCounts, cloudAccountId).Scan(&clusterCount); err != nil {
+		logger.Error(err, "error getting cluster count")
+		return "", status.Error(codes.Internal, "failed to validate cluster quota")
+	}
+	reservation, err := c.qmsClient.ReserveQuotaPrivate(ctx, &pb.QuotaReservationRequestPrivate{
+		ServiceName:    quotaServiceName,
+		ResourceType:   quotaClusterResourceType,
+		CloudAccountId: cloudAccountId,
+		Quantity:       1,
+		CurrentUsage:   clusterCount,
+	})
+	if err != nil {
+		logger.Error(err, "error reserving cluster quota")
+		if status.Code(err) == codes.ResourceExhausted {
+			return "", status.Error(codes.PermissionDenied, "Can not create more clusters for this cloud account")
+		}
+		return "", status.Error(codes.Internal, "failed to validate cluster quota")
+	}
+	return reservation.ReservationId, nil
+}
+
+func (c *Server) commitClusterQuota(ctx context.Context, reservationId string) {
+	logger := log.FromContext(ctx).WithName("Server.commitClusterQuota")
+	if c.qmsClient == nil || reservationId == "" {
+		return
+	}
+	if _, err := c.qmsClient.CommitQuotaReservationPrivate(ctx, &pb.QuotaReservationReferencePrivate{ReservationId: reservationId}); err != nil {
+		logger.Error(err, "error committing cluster quota reservation", "reservationId", reservationId)
+	}
+}
+
+// releaseClusterQuota releases the reservation if reservationId is set, or else a cluster of the usage of the cloudaccount.
+func (c *Server) releaseClusterQuota(ctx context.Context, cloudAccountId string, reservationId string) {
+	logger := log.FromContext(ctx).WithName("Server.releaseClusterQuota")
+	if c.qmsClient == nil {
+		return
+	}
+	req := &pb.QuotaReleaseRequestPrivate{ReservationId: reservationId}
+	if reservationId == "" {
+		req = &pb.QuotaReleaseRequestPrivate{
+			ServiceName:    quotaServiceName,
+			ResourceType:   quotaClusterResourceType,
+			CloudAccountId: cloudAccountId,
+			Quantity:       1,
+		}
+	}
+	if _, err := c.qmsClient.ReleaseQuotaPrivate(ctx, req); err != nil {
+		logger.Error(err, "error releasing cluster quota", "reservationId", reservationId)
+	}
+}
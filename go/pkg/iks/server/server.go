@@ -27,6 +27,7 @@ import (
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	pb "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -38,6 +39,7 @@ type Server struct {
 	sshkeyClient                pb.SshPublicKeyServiceClient
 	productcatalogServiceClient pb.ProductCatalogServiceClient
 	vnetClient                  pb.VNetServiceClient
+	qmsClient                   pb.QuotaManagementPrivateServiceClient
 	session                     *sql.DB
 	cfg                         config.Config
 }
@@ -49,6 +51,7 @@ func NewIksService(session *sql.DB,
 	vnetClient pb.VNetServiceClient,
 	productcatalogServiceClient v1.ProductCatalogServiceClient,
 	computeInstanceSvcClient pb.InstanceServiceClient,
+	qmsClient pb.QuotaManagementPrivateServiceClient,
 	cfg config.Config) (*Server, error) {
 	if session == nil {
 		return nil, fmt.Errorf("db session is required")
@@ -61,6 +64,7 @@ func NewIksService(session *sql.DB,
 		vnetClient:                  vnetClient,
 		productcatalogServiceClient: productcatalogServiceClient,
 		computeInstanceSvsClient:    computeInstanceSvcClient,
+		qmsClient:                   qmsClient,
 	}, nil
 }
 
@@ -103,11 +107,18 @@ func (c *Server) CreateNewCluster(ctx context.Context, req *pb.ClusterRequest) (
 		return &pb.ClusterCreateResponseForm{}, fmt.Errorf("Ssh key upload for control plane failed %s ", err)
 	}
 
+	reservationId, err := c.reserveClusterQuota(ctx, req.CloudAccountId)
+	if err != nil {
+		return &pb.ClusterCreateResponseForm{}, err
+	}
+
 	res, err := query.CreateClusterRecord(ctx, dbSession, req, key, keypub, sshpubkey, c.cfg.EncryptionKeys)
 	logger.Info("Clusterresponse", logkeys.Response, res)
 	if err != nil {
+		c.releaseClusterQuota(ctx, req.CloudAccountId, reservationId)
 		return &pb.ClusterCreateResponseForm{}, err
 	}
+	c.commitClusterQuota(ctx, reservationId)
 
 	return res, nil
 }
@@ -343,6 +354,7 @@ func (c *Server) DeleteCluster(ctx context.Context, req *pb.ClusterID) (*empty.E
 	if err != nil {
 		return &empty.Empty{}, err
 	}
+	c.releaseClusterQuota(ctx, req.CloudAccountId, "")
 	return &empty.Empty{}, nil
 }
 
@@ -1024,17 +1036,25 @@ func (c *Server) UploadSshkeysForCP(ctx context.Context, pubkey []byte, cloudacc
 		keyname = clustername + "-" + cloudaccount + "-" + string(rndb)
 	}
 
-	getsshkeys, err := c.sshkeyClient.Search(ctx, &pb.SshPublicKeySearchRequest{
-		Metadata: &pb.ResourceMetadataSearch{
-			CloudAccountId: cloudaccount,
-		},
+	getsshkeys, err := protodb.SearchAllPages(func(pageToken string) ([]*pb.SshPublicKey, string, error) {
+		resp, err := c.sshkeyClient.Search(ctx, &pb.SshPublicKeySearchRequest{
+			Metadata: &pb.ResourceMetadataSearch{
+				CloudAccountId: cloudaccount,
+			},
+			PageSize:  protodb.MaxPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
 	})
 	if err != nil {
 		return keyname, err
 	}
-	if len(getsshkeys.Items) != 0 {
-		for i, _ := range getsshkeys.Items {
-			if getsshkeys.Items[i].Metadata.Name == keyname {
+	if len(getsshkeys) != 0 {
+		for i, _ := range getsshkeys {
+			if getsshkeys[i].Metadata.Name == keyname {
 				logger.Info("Ssh key with the name already exists.Creating cp with existing ssh key", logkeys.SSHKeyName, keyname)
 				return keyname, nil
 			}
@@ -14,6 +14,7 @@ import (
 	supercompute_query "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/iks/db/supercompute_query"
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 )
 
 type SuperComputeServer struct {
@@ -128,17 +129,25 @@ func (c *SuperComputeServer) UploadSshkeysForCP(ctx context.Context, pubkey []by
 		keyname = clustername + "-" + cloudaccount + "-" + string(rndb)
 	}
 
-	getsshkeys, err := c.sshkeyClient.Search(ctx, &pb.SshPublicKeySearchRequest{
-		Metadata: &pb.ResourceMetadataSearch{
-			CloudAccountId: cloudaccount,
-		},
+	getsshkeys, err := protodb.SearchAllPages(func(pageToken string) ([]*pb.SshPublicKey, string, error) {
+		resp, err := c.sshkeyClient.Search(ctx, &pb.SshPublicKeySearchRequest{
+			Metadata: &pb.ResourceMetadataSearch{
+				CloudAccountId: cloudaccount,
+			},
+			PageSize:  protodb.MaxPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
 	})
 	if err != nil {
 		return keyname, err
 	}
-	if len(getsshkeys.Items) != 0 {
-		for i, _ := range getsshkeys.Items {
-			if getsshkeys.Items[i].Metadata.Name == keyname {
+	if len(getsshkeys) != 0 {
+		for i, _ := range getsshkeys {
+			if getsshkeys[i].Metadata.Name == keyname {
 				log.Info("Ssh key with the name ", keyname, "already exists.Creating cp with existing ssh key")
 				return keyname, nil
 			}
@@ -559,7 +559,7 @@ func InitSshMockClient(ctx context.Context, t *testing.T) (*server.Server, error
 		ListenPort:     443,
 		EncryptionKeys: "./encryption_keys",
 	}
-	iksSrv, err := server.NewIksService(sqlDb, nil, sshclient, nil, nil, nil, cfg)
+	iksSrv, err := server.NewIksService(sqlDb, nil, sshclient, nil, nil, nil, nil, cfg)
 	if err != nil {
 		t.Fatalf("Failed to initialize Mock compute Client")
 	}
@@ -572,7 +572,7 @@ func InitVnetMockClient(ctx context.Context, t *testing.T) (*server.Server, erro
 		ListenPort:     443,
 		EncryptionKeys: "./encryption_keys",
 	}
-	iksSrv, err := server.NewIksService(sqlDb, computeclient, nil, vnetclient, nil, nil, cfg)
+	iksSrv, err := server.NewIksService(sqlDb, computeclient, nil, vnetclient, nil, nil, nil, cfg)
 	if err != nil {
 		t.Fatalf("Failed to initialize Mock compute Client")
 	}
@@ -586,7 +586,7 @@ func InitComputeMockClient(ctx context.Context, t *testing.T) (*server.Server, e
 		ListenPort:     443,
 		EncryptionKeys: "./encryption_keys",
 	}
-	iksSrv, err := server.NewIksService(sqlDb, computeClient, nil, nil, nil, nil, cfg)
+	iksSrv, err := server.NewIksService(sqlDb, computeClient, nil, nil, nil, nil, nil, cfg)
 	if err != nil {
 		t.Fatalf("Failed to initialize Mock compute Client")
 	}
@@ -600,7 +600,7 @@ func InitProductCatalogClient(ctx context.Context, t *testing.T) (*server.Server
 		ListenPort:     443,
 		EncryptionKeys: "./encryption_keys",
 	}
-	iksSrv, err := server.NewIksService(sqlDb, nil, nil, nil, productCatalogServiceClient, nil, cfg)
+	iksSrv, err := server.NewIksService(sqlDb, nil, nil, nil, productCatalogServiceClient, nil, nil, cfg)
 	if err != nil {
 		t.Fatalf("Failed to initialize Mock product catalog Client")
 	}
@@ -615,6 +615,7 @@ func InitInstanceServiceMockClient(t *testing.T, mockDelMethod bool, c codes.Cod
 		nil,
 		nil,
 		NewMockInstanceServiceClient(t, mockDelMethod, c, errMsg),
+		nil,
 		config.Config{
 			ListenPort:     443,
 			EncryptionKeys: "./encryption_keys",
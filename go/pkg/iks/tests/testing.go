@@ -26,6 +26,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
 	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	_ "github.com/lib/pq"
 	"github.com/ory/dockertest"
 
@@ -115,6 +116,7 @@ func (test *Test) grpcInit() {
 		VnetClient,
 		nil,
 		InstanceServiceClient,
+		nil,
 		cfg)
 	if err != nil {
 		log.Error(err, "error initializing iks service")
@@ -292,7 +294,7 @@ func NewMockSshServiceClient(ctx context.Context, t *testing.T) *v1.MockSshPubli
 		},
 	}
 
-	sshClient.EXPECT().Search(gomock.Any(), &v1.SshPublicKeySearchRequest{Metadata: &v1.ResourceMetadataSearch{}}).Return(sshkeysearchresponse, nil).AnyTimes()
+	sshClient.EXPECT().Search(gomock.Any(), &v1.SshPublicKeySearchRequest{Metadata: &v1.ResourceMetadataSearch{}, PageSize: protodb.MaxPageSize}).Return(sshkeysearchresponse, nil).AnyTimes()
 	sshClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(sshkey, nil).AnyTimes()
 	return sshClient
 }
@@ -39,6 +39,7 @@ type AgentConfig struct {
 
 type InferenceInterface interface {
 	GenerateStream(ctx context.Context, request *pb.GenerateStreamRequest) (pb.TextGenerator_GenerateStreamClient, error)
+	ChatCompletionStream(ctx context.Context, request *pb.ChatCompletionStreamRequest) (pb.TextGenerator_ChatCompletionStreamClient, error)
 }
 
 func New(ctx context.Context, conf AgentConfig, log logr.Logger) (*Agent, error) {
@@ -70,7 +70,7 @@ func (w *Worker) handleInferenceRequest(ctx context.Context, dispatcherStream pb
 	// call inference service and return result
 	inferenceReqCtx, cancel := context.WithTimeout(ctx, req.Timeout.AsDuration())
 	defer cancel()
-	generateRespStream, err := w.agent.inferenceService.GenerateStream(inferenceReqCtx, req.Request)
+	recv, err := w.callInferenceService(inferenceReqCtx, req)
 	if err != nil {
 		log.Error(err, "failed to get inference service response")
 		return
@@ -80,7 +80,7 @@ func (w *Worker) handleInferenceRequest(ctx context.Context, dispatcherStream pb
 	chunksReceived := 0
 	chunksSent := 0
 	for {
-		resp, err := generateRespStream.Recv()
+		resp, err := recv()
 		if err == io.EOF {
 			w.log.V(1).Info("inference service stream ended", "chunksSent", chunksSent, "chunksReceived", chunksReceived)
 			break // end of inference service response stream
@@ -96,11 +96,9 @@ func (w *Worker) handleInferenceRequest(ctx context.Context, dispatcherStream pb
 		chunksReceived++
 
 		log.V(1).Info("returning current response item to dispatcher...", "chunksSent", chunksSent, "chunksReceived", chunksReceived)
-		err = dispatcherStream.Send(&pb.DispatcherResponse{
-			Model:     w.agent.model,
-			Response:  resp,
-			RequestID: req.RequestID,
-		})
+		resp.Model = w.agent.model
+		resp.RequestID = req.RequestID
+		err = dispatcherStream.Send(resp)
 		if err != nil {
 			log.Error(err, "failed to send response to caller", "chunksSent", chunksSent, "chunksReceived", chunksReceived)
 			return
@@ -110,3 +108,27 @@ func (w *Worker) handleInferenceRequest(ctx context.Context, dispatcherStream pb
 
 	log.Info("cycle complete!", "chunksSent", chunksSent, "chunksReceived", chunksReceived)
 }
+
+// callInferenceService calls the inference service method matching the request, chat completion or text generation,
+// and returns a function receiving its responses wrapped for the dispatcher.
+func (w *Worker) callInferenceService(ctx context.Context, req *pb.DispatcherRequest) (func() (*pb.DispatcherResponse, error), error) {
+	if req.ChatRequest != nil {
+		chatRespStream, err := w.agent.inferenceService.ChatCompletionStream(ctx, req.ChatRequest)
+		if err != nil {
+			return nil, err
+		}
+		return func() (*pb.DispatcherResponse, error) {
+			resp, err := chatRespStream.Recv()
+			return &pb.DispatcherResponse{ChatResponse: resp}, err
+		}, nil
+	}
+
+	generateRespStream, err := w.agent.inferenceService.GenerateStream(ctx, req.Request)
+	if err != nil {
+		return nil, err
+	}
+	return func() (*pb.DispatcherResponse, error) {
+		resp, err := generateRespStream.Recv()
+		return &pb.DispatcherResponse{Response: resp}, err
+	}, nil
+}
@@ -83,6 +83,10 @@ func (c inferenceServiceMock) GenerateStream(_ context.Context, _ *pb.GenerateSt
 	return nil, nil
 }
 
+func (c inferenceServiceMock) ChatCompletionStream(_ context.Context, _ *pb.ChatCompletionStreamRequest) (pb.TextGenerator_ChatCompletionStreamClient, error) {
+	return nil, nil
+}
+
 type dispatcherMock struct {
 	mock.Mock
 }
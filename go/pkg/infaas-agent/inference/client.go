@@ -37,3 +37,12 @@ func (c Client) GenerateStream(ctx context.Context, request *pb.GenerateStreamRe
 
 	return respStream, nil
 }
+
+func (c Client) ChatCompletionStream(ctx context.Context, request *pb.ChatCompletionStreamRequest) (pb.TextGenerator_ChatCompletionStreamClient, error) {
+	respStream, err := c.inferenceClient.ChatCompletionStream(ctx, request, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call inference service")
+	}
+
+	return respStream, nil
+}
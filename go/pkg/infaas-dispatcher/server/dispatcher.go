@@ -155,11 +155,16 @@ func (d *Dispatcher) GenerateStream(req *pb.DispatcherRequest, respStream pb.Dis
 	defer outstandingReqGauge.Dec()
 
 	log := d.log.WithValues("requestID", req.RequestID, "model", req.Model)
-	if req.Request == nil {
+	if req.Request == nil && req.ChatRequest == nil {
 		err := errors.New("request field must not be empty")
 		log.Error(err, "request is invalid", "error", err)
 		return status.Error(codes.InvalidArgument, "request field must not be empty")
 	}
+	if req.Request != nil && req.ChatRequest != nil {
+		err := errors.New("request and chatRequest fields must not be both set")
+		log.Error(err, "request is invalid", "error", err)
+		return status.Error(codes.InvalidArgument, "request and chatRequest fields must not be both set")
+	}
 
 	log.Info("got new request")
 
@@ -186,7 +191,7 @@ func (d *Dispatcher) GenerateStream(req *pb.DispatcherRequest, respStream pb.Dis
 		inferCtx:  ctxWithTO,
 	}
 
-	log.Info("enqueueing request; awaiting capacity...", "prompt-size", len(req.Request.Prompt))
+	log.Info("enqueueing request; awaiting capacity...", "prompt-size", promptSize(req))
 	select {
 	case <-ctxWithTO.Done():
 		// The client request was cancelled
@@ -225,6 +230,18 @@ func (d *Dispatcher) GenerateStream(req *pb.DispatcherRequest, respStream pb.Dis
 	}
 }
 
+// promptSize returns the size of the prompt or of the chat messages of the request.
+func promptSize(req *pb.DispatcherRequest) int {
+	if req.ChatRequest == nil {
+		return len(req.GetRequest().GetPrompt())
+	}
+	size := 0
+	for _, message := range req.ChatRequest.Messages {
+		size += len(message.GetContent())
+	}
+	return size
+}
+
 func (d *Dispatcher) DoWork(stream pb.Dispatcher_DoWorkServer) (retErr error) {
 	model := "unknown_model"
 	defer func() {
@@ -90,6 +90,33 @@ func TestGenerateStream(t *testing.T) {
 			},
 			expectedStreamErr: "",
 			expectedTokenFunc: func(i int) string { return fmt.Sprintf("mock text %d", i) },
+		}, {
+			name: "chat completion",
+			ctx:  ctx,
+			req: &pb.DispatcherRequest{
+				Model:     supportedModel,
+				RequestID: "test_request",
+				ChatRequest: &pb.ChatCompletionStreamRequest{
+					Messages: []*pb.ChatCompletionMessage{{Role: "user", Content: "test prompt"}},
+				},
+			},
+			expectedStreamErr: "",
+			expectedTokenFunc: func(i int) string { return fmt.Sprintf("mock chat text %d", i) },
+		}, {
+			name: "both requests validation error",
+			ctx:  ctx,
+			req: &pb.DispatcherRequest{
+				Model:     supportedModel,
+				RequestID: "test_request",
+				Request: &pb.GenerateStreamRequest{
+					Prompt: "test prompt",
+				},
+				ChatRequest: &pb.ChatCompletionStreamRequest{
+					Messages: []*pb.ChatCompletionMessage{{Role: "user", Content: "test prompt"}},
+				},
+			},
+			expectedStreamErr: "must not be both set",
+			expectedTokenFunc: nil,
 		}, {
 			name: "nil request validation error",
 			ctx:  ctx,
@@ -149,7 +176,7 @@ func TestGenerateStream(t *testing.T) {
 				if tc.expectedStreamErr == "" {
 					assert.NoError(t, err)
 					log.V(-2).Info("got response", "response", generatedResp)
-					assert.Equal(t, tc.expectedTokenFunc(i), generatedResp.Response.Token.Text)
+					assert.Equal(t, tc.expectedTokenFunc(i), responseText(generatedResp))
 				} else {
 					assert.ErrorContains(t, err, tc.expectedStreamErr)
 				}
@@ -250,6 +277,28 @@ func (m mockTextGenerator) GenerateStream(req *pb.GenerateStreamRequest, respStr
 	return nil
 }
 
+func (m mockTextGenerator) ChatCompletionStream(req *pb.ChatCompletionStreamRequest, respStream pb.TextGenerator_ChatCompletionStreamServer) error {
+	for i := 0; i < numResponses; i++ {
+		respStream.Send(&pb.ChatCompletionStreamResponse{
+			Choices: []*pb.ChatCompletionStreamChoice{{
+				Delta: &pb.ChatCompletionMessage{
+					Role:    "assistant",
+					Content: fmt.Sprintf("mock chat text %d", i),
+				},
+			}},
+		})
+	}
+
+	return nil
+}
+
+func responseText(resp *pb.DispatcherResponse) string {
+	if resp.ChatResponse != nil {
+		return resp.ChatResponse.GetChoices()[0].GetDelta().GetContent()
+	}
+	return resp.GetResponse().GetToken().GetText()
+}
+
 func createClientConnection(ctx context.Context, t *testing.T, ln *bufconn.Listener) *grpc.ClientConn {
 	clientConn, err := grpc.DialContext(ctx, "", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
 		return ln.DialContext(ctx)
@@ -171,6 +171,7 @@ type NetworkDataRoutev4 struct {
 }
 
 // NetworkDataIPv4 represents an ipv4 static network object.
+// It is also used for ipv6 static network objects, which have the same fields.
 type NetworkDataIPv4 struct {
 
 	// ID is the network ID (name)
@@ -1152,6 +1153,19 @@ func (b BmInstanceBackend) setNetworkData(ctx context.Context, host *baremetalv1
 	networkIp4.Routes = append(networkIp4.Routes, networkRoute)
 	networkData.Links = append(networkData.Links, networkDataLinkEthernet)
 	networkData.Networks = append(networkData.Networks, networkIp4)
+	// ipv6
+	if ipv6Address := util.Ipv6InterfaceAddress(intfStatus); ipv6Address != "" {
+		networkIp6 := NetworkDataIPv4{}
+		networkIp6.ID = fmt.Sprintf("%s-ipv6", interfaceName)
+		networkIp6.Link = interfaceName
+		networkIp6.Type = "ipv6"
+		networkIp6.IPAddress = ipv6Address
+		networkIp6.Routes = append(networkIp6.Routes, NetworkDataRoutev4{
+			Network: "::/0",
+			Gateway: intfStatus.Ipv6Gateway,
+		})
+		networkData.Networks = append(networkData.Networks, networkIp6)
+	}
 
 	// Add storage network
 	for _, intfStatus := range instance.Status.Interfaces {
@@ -291,6 +291,8 @@ func (r *InstanceReconciler) updateStatusPhaseAndMessage(ctx context.Context, in
 	stopping := stoppingCond != nil && stoppingCond.Status == k8sv1.ConditionTrue
 	stoppedCond := util.FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionStopped)
 	stopped := stoppedCond != nil && stoppedCond.Status == k8sv1.ConditionTrue
+	migratingCond := util.FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionMigrating)
+	migrating := migratingCond != nil && migratingCond.Status == k8sv1.ConditionTrue
 	terminating := !instance.ObjectMeta.DeletionTimestamp.IsZero()
 
 	var phase cloudv1alpha1.InstancePhase
@@ -313,6 +315,10 @@ func (r *InstanceReconciler) updateStatusPhaseAndMessage(ctx context.Context, in
 	} else if starting {
 		phase = cloudv1alpha1.PhaseStarting
 		message = fmt.Sprintf("%s. %v", cloudv1alpha1.InstanceMessageStarting, startingCond.Message)
+	} else if migrating {
+		// The instance remains available during live migration.
+		phase = cloudv1alpha1.PhaseReady
+		message = fmt.Sprintf("%s. %v", cloudv1alpha1.InstanceMessageMigrating, migratingCond.Message)
 	} else if started {
 		phase = cloudv1alpha1.PhaseReady
 		message = fmt.Sprintf("%s. %v", cloudv1alpha1.InstanceMessageStarted, startedCond.Message)
@@ -565,6 +571,9 @@ func (r *InstanceReconciler) allocateSubnets(ctx context.Context, instance *clou
 			out.Gateway = subnetResp.Spec.Gateway
 			out.PrefixLength = int(subnetResp.Spec.PrefixLength)
 			out.VlanId = int(subnetResp.Spec.VlanId)
+			out.Ipv6Subnet = subnetResp.Spec.Ipv6Subnet
+			out.Ipv6PrefixLength = int(subnetResp.Spec.Ipv6PrefixLength)
+			out.Ipv6Gateway = subnetResp.Spec.Ipv6Gateway
 			log.Info("IPAM: Reserved subnet", logkeys.SubnetResp, subnetResp)
 		}
 	}
@@ -593,6 +602,10 @@ func (r *InstanceReconciler) allocateIpAddresses(ctx context.Context, instance *
 				return fmt.Errorf("allocateIpAddresses: ReserveAddress for %s: %w", spec.Name, err)
 			}
 			out.Addresses = []string{addressResp.Address}
+			out.Ipv6Addresses = nil
+			if addressResp.Ipv6Address != "" {
+				out.Ipv6Addresses = []string{addressResp.Ipv6Address}
+			}
 			log.Info("IPAM: Reserved address", logkeys.AddressConsumerId, addressConsumerId, logkeys.Address, addressResp.Address)
 		}
 	}
@@ -112,8 +112,11 @@ var _ = BeforeSuite(func() {
 	By("Creating Mock VNet Service Client")
 	vNetClient := NewMockVNetServiceClient()
 
+	By("Creating Mock Instance Private Service Client")
+	instancePrivateClient := pb.NewMockInstancePrivateServiceClient(gomock.NewController(GinkgoT()))
+
 	By("Creating VM instance controller")
-	_, err = vminstancecontroller.NewVmInstanceReconciler(ctx, k8sManager, vNetPrivateClient, vNetClient, vmOperatorConfig)
+	_, err = vminstancecontroller.NewVmInstanceReconciler(ctx, k8sManager, vNetPrivateClient, vNetClient, instancePrivateClient, vmOperatorConfig)
 	Expect(err).Should(Succeed())
 
 	By("Starting manager")
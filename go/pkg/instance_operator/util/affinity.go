@@ -17,6 +17,10 @@ const TopologySpreadTopologyKey string = "cloud.intel.com/partition"
 const ComputeNodePoolLabelPrefix string = "pool.cloud.intel.com/"
 const InstanceTypeLabelPrefix string = "instance-type.cloud.intel.com/"
 const NetworkModeKey string = "cloud.intel.com/network-mode"
+const NodeMaintenanceLabelPrefix string = "maintenance.cloud.intel.com/"
+
+// Label on nodes that are under maintenance. Running VM instances are moved off these nodes.
+const NodeMaintenanceLabel string = NodeMaintenanceLabelPrefix + "evacuate"
 
 // Add required affinity for instance type.
 func UpdateAffinityForInstanceType(instance *cloudv1alpha1.Instance, affinity *k8sv1.Affinity) error {
@@ -96,6 +100,22 @@ func UpdateAffinityForPartition(instance *cloudv1alpha1.Instance, affinity *k8sv
 	return nil
 }
 
+// Add required affinity to avoid nodes that are under maintenance.
+func UpdateAffinityForNodeMaintenance(instance *cloudv1alpha1.Instance, affinity *k8sv1.Affinity) error {
+	ensureNonEmptyRequiredAffinity(affinity)
+	// Add MatchExpression to each NodeSelectorTerm.
+	for i := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		expr := k8sv1.NodeSelectorRequirement{
+			Key:      NodeMaintenanceLabel,
+			Operator: k8sv1.NodeSelectorOpNotIn,
+			Values:   []string{"true"},
+		}
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[i].MatchExpressions =
+			append(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[i].MatchExpressions, expr)
+	}
+	return nil
+}
+
 // Add preferred affinity for NodeId determined by scheduler.
 func UpdateAffinityForNodeId(instance *cloudv1alpha1.Instance, affinity *k8sv1.Affinity) error {
 	// Add preferred affinity for NodeId.
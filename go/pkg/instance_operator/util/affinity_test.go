@@ -165,4 +165,24 @@ var _ = Describe("Affinity Utils", func() {
 			))
 		})
 	})
+
+	Context("when updating affinity for node maintenance", func() {
+		It("should exclude nodes under maintenance from each affinity term", func() {
+			affinity := generateTestAffinity(2)
+			instance := &cloudv1alpha1.Instance{}
+			err := UpdateAffinityForNodeMaintenance(instance, affinity)
+			Expect(err).NotTo(HaveOccurred())
+			maintenanceExpr := k8sv1.NodeSelectorRequirement{
+				Key:      "maintenance.cloud.intel.com/evacuate",
+				Operator: k8sv1.NodeSelectorOpNotIn,
+				Values:   []string{"true"},
+			}
+			terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(2))
+			for _, term := range terms {
+				Expect(term.MatchExpressions).To(HaveLen(2))
+				Expect(term.MatchExpressions).To(ContainElement(maintenanceExpr))
+			}
+		})
+	})
 })
@@ -5,6 +5,7 @@ package util
 import (
 	"fmt"
 
+	cloudv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/apis/private.cloud/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -507,4 +508,36 @@ write_files:
 		yaml := setUserData(cloudConfig)
 		Expect(expected).To(Equal(yaml))
 	})
+
+	It("AddIpv6CloudInitSubnet", func() {
+		template := cloudv1alpha1.CloudInitNetworkData{
+			Network: cloudv1alpha1.CloudInitNetworkConfig{
+				Version: 1,
+				Config: []cloudv1alpha1.CloudInitSubnetConfig{{
+					Type:    "physical",
+					Name:    "enp1s0",
+					Subnets: []cloudv1alpha1.CloudInitSubnet{{Type: "static"}},
+				}},
+			},
+		}
+
+		By("IPv4-only interface should not add a subnet")
+		networkData := template
+		AddIpv6CloudInitSubnet(&networkData, cloudv1alpha1.InstanceInterfaceStatus{Addresses: []string{"172.16.0.2"}})
+		Expect(networkData.Network.Config[0].Subnets).Should(HaveLen(1))
+
+		By("Dual-stack interface should add a static6 subnet without changing the template")
+		networkData = template
+		AddIpv6CloudInitSubnet(&networkData, cloudv1alpha1.InstanceInterfaceStatus{
+			Addresses:        []string{"172.16.0.2"},
+			Ipv6Addresses:    []string{"fd00:0:0:1::2"},
+			Ipv6PrefixLength: 64,
+			Ipv6Gateway:      "fd00:0:0:1::1",
+		})
+		Expect(networkData.Network.Config[0].Subnets).Should(Equal([]cloudv1alpha1.CloudInitSubnet{
+			{Type: "static"},
+			{Type: "static6", Address: "fd00:0:0:1::2/64", Gateway: "fd00:0:0:1::1"},
+		}))
+		Expect(template.Network.Config[0].Subnets).Should(HaveLen(1))
+	})
 })
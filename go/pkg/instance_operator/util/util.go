@@ -291,3 +291,28 @@ func GetVmOverheadMemory(ctx context.Context, memoryQty resource.Quantity, gpuCo
 	reservedMemoryQty := *resource.NewQuantity(reservedMemoryBytes, resource.BinarySI)
 	return reservedMemoryQty
 }
+
+// Returns the first IPv6 address of a dual-stack interface with its prefix length, such as "fd00:1:2:3::4/64".
+// Returns an empty string if the interface does not have an IPv6 address.
+func Ipv6InterfaceAddress(intfStatus cloudv1alpha1.InstanceInterfaceStatus) string {
+	if len(intfStatus.Ipv6Addresses) == 0 || intfStatus.Ipv6Addresses[0] == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d", intfStatus.Ipv6Addresses[0], intfStatus.Ipv6PrefixLength)
+}
+
+// Adds a static IPv6 subnet to the first network config of the VM network data if the interface is dual-stack.
+// The network configs are copied because the network data is a shallow copy of the operator configuration.
+func AddIpv6CloudInitSubnet(networkData *cloudv1alpha1.CloudInitNetworkData, intfStatus cloudv1alpha1.InstanceInterfaceStatus) {
+	address := Ipv6InterfaceAddress(intfStatus)
+	if address == "" || len(networkData.Network.Config) == 0 {
+		return
+	}
+	config := append([]cloudv1alpha1.CloudInitSubnetConfig{}, networkData.Network.Config...)
+	config[0].Subnets = append(append([]cloudv1alpha1.CloudInitSubnet{}, config[0].Subnets...), cloudv1alpha1.CloudInitSubnet{
+		Type:    "static6",
+		Address: address,
+		Gateway: intfStatus.Ipv6Gateway,
+	})
+	networkData.Network.Config = config
+}
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 )
@@ -57,6 +58,9 @@ func main() {
 		if err := kubevirtv1.AddToScheme(scheme); err != nil {
 			return err
 		}
+		if err := cdiv1beta1.AddToScheme(scheme); err != nil {
+			return err
+		}
 
 		cfg := &privatecloudv1alpha1.VmInstanceOperatorConfig{}
 		options := ctrl.Options{
@@ -116,11 +120,21 @@ func main() {
 			return fmt.Errorf("unable to ping VNet service: %w", err)
 		}
 
-		_, err = controllers.NewVmInstanceReconciler(ctx, k8sManager, vNetPrivateClient, vNetClient, cfg)
+		// Connect to Instance private Service client to choose new nodes for instances on nodes under maintenance.
+		instancePrivateClient := pb.NewInstancePrivateServiceClient(computeApiServerClientConn)
+
+		_, err = controllers.NewVmInstanceReconciler(ctx, k8sManager, vNetPrivateClient, vNetClient, instancePrivateClient, cfg)
 		if err != nil {
 			return fmt.Errorf("error creating instance reconciler: %w", err)
 		}
 
+		// Connect to Custom Machine Image private Service client to create image volumes.
+		customMachineImagePrivateClient := pb.NewCustomMachineImagePrivateServiceClient(computeApiServerClientConn)
+		_, err = controllers.NewCustomMachineImageReconciler(ctx, k8sManager, customMachineImagePrivateClient, cfg)
+		if err != nil {
+			return fmt.Errorf("error creating custom machine image reconciler: %w", err)
+		}
+
 		if err := k8sManager.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 			return fmt.Errorf("unable to set up health check: %w", err)
 		}
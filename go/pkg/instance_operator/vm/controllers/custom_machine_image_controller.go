@@ -0,0 +1,605 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package privatecloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	vmbuilder "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/instance_operator/vm/builder"
+	cloudv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/apis/private.cloud/v1alpha1"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8sv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	customMachineImagePollInterval = 30 * time.Second
+	// Presigned URLs must remain valid until the VM cluster has downloaded the image.
+	customMachineImagePresignExpiry = 24 * time.Hour
+
+	annotationCustomMachineImageSha256Sum        = "private.cloud.intel.com/sha256sum"
+	annotationCustomMachineImageVirtualSizeBytes = "private.cloud.intel.com/virtual-size-bytes"
+
+	clusterIdLabel = "cluster-id"
+
+	// https://github.com/qemu/qemu/blob/master/docs/interop/qcow2.txt
+	qcow2Magic      = "QFI\xfb"
+	qcow2HeaderSize = 32
+)
+
+var harvesterVirtualMachineImageGVK = schema.GroupVersionKind{
+	Group:   "harvesterhci.io",
+	Version: "v1beta1",
+	Kind:    "VirtualMachineImage",
+}
+
+// CustomMachineImageReconciler creates and deletes image volumes in the VM cluster for custom machine images
+// stored in the Compute API Server.
+// Custom machine images are polled because they are not stored in Kubernetes.
+type CustomMachineImageReconciler struct {
+	customMachineImagePrivateClient pb.CustomMachineImagePrivateServiceClient
+	vmProviderClient                client.Client
+	k8Client                        *kubernetes.Clientset
+	clusterId                       string
+	useKubeVirtCluster              bool
+	// Background verifications of imported image files, keyed by resource id.
+	verificationsMu sync.Mutex
+	verifications   map[string]*objectStorageVerification
+}
+
+// The background download of an imported image file. The result fields are set when done is closed.
+type objectStorageVerification struct {
+	cancel      context.CancelFunc
+	done        chan struct{}
+	source      customMachineImageSource
+	annotations map[string]string
+	failMessage string
+	err         error
+}
+
+func NewCustomMachineImageReconciler(ctx context.Context, mgr ctrl.Manager, customMachineImagePrivateClient pb.CustomMachineImagePrivateServiceClient, cfg *cloudv1alpha1.VmInstanceOperatorConfig) (*CustomMachineImageReconciler, error) {
+	clusterId := cfg.InstanceOperator.Filter.Labels[clusterIdLabel]
+	if clusterId == "" {
+		return nil, fmt.Errorf("NewCustomMachineImageReconciler: instanceOperator.filter.labels must include %s", clusterIdLabel)
+	}
+	vmInfraConfig, err := CreateVmProvisionClusterConfig(ctx, mgr, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("NewCustomMachineImageReconciler: %w", err)
+	}
+	vmProviderClient, err := client.New(vmInfraConfig, client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		return nil, fmt.Errorf("NewCustomMachineImageReconciler: error in client: %w", err)
+	}
+	k8Client, err := kubernetes.NewForConfig(vmInfraConfig)
+	if err != nil {
+		return nil, fmt.Errorf("NewCustomMachineImageReconciler: error in k8client: %w", err)
+	}
+	r := &CustomMachineImageReconciler{
+		customMachineImagePrivateClient: customMachineImagePrivateClient,
+		vmProviderClient:                vmProviderClient,
+		k8Client:                        k8Client,
+		clusterId:                       clusterId,
+		useKubeVirtCluster:              cfg.InstanceOperator.OperatorFeatureFlags.UseKubeVirtCluster,
+		verifications:                   map[string]*objectStorageVerification{},
+	}
+	if err := mgr.Add(manager.RunnableFunc(r.Run)); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *CustomMachineImageReconciler) Run(ctx context.Context) error {
+	log := log.FromContext(ctx).WithName("CustomMachineImageReconciler.Run")
+	log.Info("BEGIN", logkeys.ClusterId, r.clusterId)
+	defer log.Info("END")
+	ticker := time.NewTicker(customMachineImagePollInterval)
+	defer ticker.Stop()
+	for {
+		if err := r.ReconcileAll(ctx); err != nil {
+			log.Error(err, "unable to reconcile custom machine images")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReconcileAll reconciles all custom machine images assigned to this cluster or not yet assigned to any cluster.
+func (r *CustomMachineImageReconciler) ReconcileAll(ctx context.Context) error {
+	resp, err := r.customMachineImagePrivateClient.SearchPrivate(ctx, &pb.CustomMachineImageSearchPrivateRequest{
+		ClusterId: r.clusterId,
+	})
+	if err != nil {
+		return fmt.Errorf("SearchPrivate: %w", err)
+	}
+	for _, image := range resp.Items {
+		if err := r.Reconcile(ctx, image); err != nil {
+			log.FromContext(ctx).Error(err, "unable to reconcile custom machine image",
+				logkeys.CloudAccountId, image.Metadata.CloudAccountId, logkeys.ResourceId, image.Metadata.ResourceId)
+		}
+	}
+	return nil
+}
+
+func (r *CustomMachineImageReconciler) Reconcile(ctx context.Context, image *pb.CustomMachineImagePrivate) error {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("CustomMachineImageReconciler.Reconcile").
+		WithValues(logkeys.CloudAccountId, image.Metadata.CloudAccountId, logkeys.ResourceId, image.Metadata.ResourceId).Start()
+	defer span.End()
+	log.Info("BEGIN", logkeys.StatusPhase, image.Status.Phase)
+	defer log.Info("END")
+
+	if image.Metadata.DeletionTimestamp != nil {
+		return r.deleteImage(ctx, image)
+	}
+	if image.Status.Phase == pb.CustomMachineImagePhase_CustomMachineImageReady ||
+		image.Status.Phase == pb.CustomMachineImagePhase_CustomMachineImageFailed {
+		return nil
+	}
+	if image.Status.ClusterId == "" {
+		// Claim the image for this cluster. If another cluster claimed it first, the resource version will not match.
+		err := r.updateStatus(ctx, image, &pb.CustomMachineImageStatusPrivate{
+			Phase:     pb.CustomMachineImagePhase_CustomMachineImageCreating,
+			Message:   "Creating image",
+			ClusterId: r.clusterId,
+		})
+		if status.Code(err) == codes.FailedPrecondition {
+			log.Info("Custom machine image was claimed by another cluster", logkeys.Error, err)
+			return nil
+		}
+		return err
+	}
+
+	var err error
+	if r.useKubeVirtCluster {
+		err = r.reconcileDataVolume(ctx, image)
+	} else {
+		err = r.reconcileHarvesterImage(ctx, image)
+	}
+	return err
+}
+
+func (r *CustomMachineImageReconciler) deleteImage(ctx context.Context, image *pb.CustomMachineImagePrivate) error {
+	r.cancelObjectStorageVerification(image.Metadata.ResourceId)
+	if image.Status.ClusterId == r.clusterId {
+		var obj client.Object
+		if r.useKubeVirtCluster {
+			obj = &cdiv1beta1.DataVolume{}
+		} else {
+			u := &unstructured.Unstructured{}
+			u.SetGroupVersionKind(harvesterVirtualMachineImageGVK)
+			obj = u
+		}
+		obj.SetNamespace(vmbuilder.DefaultVMNamespace)
+		obj.SetName(image.Spec.StorageName)
+		if err := r.vmProviderClient.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("deleteImage: %w", err)
+		}
+	}
+	_, err := r.customMachineImagePrivateClient.RemoveFinalizer(ctx, &pb.CustomMachineImageRemoveFinalizerRequest{
+		Metadata: &pb.CustomMachineImageIdReference{
+			CloudAccountId: image.Metadata.CloudAccountId,
+			ResourceId:     image.Metadata.ResourceId,
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
+
+func (r *CustomMachineImageReconciler) reconcileDataVolume(ctx context.Context, image *pb.CustomMachineImagePrivate) error {
+	dataVolume := &cdiv1beta1.DataVolume{}
+	key := types.NamespacedName{Namespace: vmbuilder.DefaultVMNamespace, Name: image.Spec.StorageName}
+	err := r.vmProviderClient.Get(ctx, key, dataVolume)
+	if errors.IsNotFound(err) {
+		return r.createDataVolume(ctx, image)
+	}
+	if err != nil {
+		return err
+	}
+	switch dataVolume.Status.Phase {
+	case cdiv1beta1.Succeeded:
+		return r.updateStatusReady(ctx, image, dataVolume.GetAnnotations())
+	case cdiv1beta1.Failed:
+		return r.updateStatusFailed(ctx, image, "unable to create image volume")
+	}
+	return nil
+}
+
+func (r *CustomMachineImageReconciler) createDataVolume(ctx context.Context, image *pb.CustomMachineImagePrivate) error {
+	source, annotations, failMessage, err := r.getImageSource(ctx, image)
+	if err != nil {
+		return err
+	}
+	if failMessage != "" {
+		return r.updateStatusFailed(ctx, image, failMessage)
+	}
+	if source.pending {
+		return nil
+	}
+	dataVolumeSource := &cdiv1beta1.DataVolumeSource{}
+	if source.url != "" {
+		dataVolumeSource.HTTP = &cdiv1beta1.DataVolumeSourceHTTP{URL: source.url}
+		if source.etag != "" {
+			// The download fails if the object was replaced after it was verified.
+			dataVolumeSource.HTTP.ExtraHeaders = []string{fmt.Sprintf("If-Match: %q", source.etag)}
+		}
+	} else {
+		if err := r.createCaptureRoleBindingIfNeeded(ctx, source.pvcNamespace); err != nil {
+			return err
+		}
+		dataVolumeSource.PVC = &cdiv1beta1.DataVolumeSourcePVC{Namespace: source.pvcNamespace, Name: source.pvcName}
+	}
+	sizeBytes, err := strconv.ParseInt(annotations[annotationCustomMachineImageVirtualSizeBytes], 10, 64)
+	if err != nil {
+		return fmt.Errorf("createDataVolume: invalid virtual size: %w", err)
+	}
+	dataVolume := &cdiv1beta1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        image.Spec.StorageName,
+			Namespace:   vmbuilder.DefaultVMNamespace,
+			Annotations: annotations,
+		},
+		Spec: cdiv1beta1.DataVolumeSpec{
+			Source: dataVolumeSource,
+			Storage: &cdiv1beta1.StorageSpec{
+				AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadOnlyMany},
+				Resources: k8sv1.ResourceRequirements{
+					Requests: k8sv1.ResourceList{
+						k8sv1.ResourceStorage: *resource.NewQuantity(sizeBytes, resource.BinarySI),
+					},
+				},
+			},
+		},
+	}
+	if err := r.vmProviderClient.Create(ctx, dataVolume); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("createDataVolume: %w", err)
+	}
+	return nil
+}
+
+// Allows CDI to clone the root disk PVC of an instance into the default namespace.
+func (r *CustomMachineImageReconciler) createCaptureRoleBindingIfNeeded(ctx context.Context, namespace string) error {
+	roleBindingResource := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-cdi-cloner", vmbuilder.DefaultVMNamespace),
+			Namespace: namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     "cdi-cloner",
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      "default",
+				Namespace: vmbuilder.DefaultVMNamespace,
+			},
+		},
+	}
+	roleBindingClient := r.k8Client.RbacV1().RoleBindings(namespace)
+	if _, err := roleBindingClient.Create(ctx, roleBindingResource, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *CustomMachineImageReconciler) reconcileHarvesterImage(ctx context.Context, image *pb.CustomMachineImagePrivate) error {
+	vmImage := &unstructured.Unstructured{}
+	vmImage.SetGroupVersionKind(harvesterVirtualMachineImageGVK)
+	key := types.NamespacedName{Namespace: vmbuilder.DefaultVMNamespace, Name: image.Spec.StorageName}
+	err := r.vmProviderClient.Get(ctx, key, vmImage)
+	if errors.IsNotFound(err) {
+		return r.createHarvesterImage(ctx, image)
+	}
+	if err != nil {
+		return err
+	}
+	conditions, _, _ := unstructured.NestedSlice(vmImage.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Imported" {
+			continue
+		}
+		switch condition["status"] {
+		case "True":
+			annotations := vmImage.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			if virtualSize, found, _ := unstructured.NestedInt64(vmImage.Object, "status", "virtualSize"); found && virtualSize > 0 {
+				annotations[annotationCustomMachineImageVirtualSizeBytes] = strconv.FormatInt(virtualSize, 10)
+			}
+			return r.updateStatusReady(ctx, image, annotations)
+		case "False":
+			message, _ := condition["message"].(string)
+			return r.updateStatusFailed(ctx, image, fmt.Sprintf("unable to create image volume: %s", message))
+		}
+	}
+	return nil
+}
+
+func (r *CustomMachineImageReconciler) createHarvesterImage(ctx context.Context, image *pb.CustomMachineImagePrivate) error {
+	source, annotations, failMessage, err := r.getImageSource(ctx, image)
+	if err != nil {
+		return err
+	}
+	if failMessage != "" {
+		return r.updateStatusFailed(ctx, image, failMessage)
+	}
+	if source.pending {
+		return nil
+	}
+	spec := map[string]interface{}{
+		"displayName": image.Spec.StorageName,
+	}
+	if source.url != "" {
+		spec["sourceType"] = "download"
+		spec["url"] = source.url
+		// Harvester fails the import if the downloaded file does not match the verified file.
+		spec["checksum"] = source.sha512Sum
+	} else {
+		spec["sourceType"] = "export-from-volume"
+		spec["pvcName"] = source.pvcName
+		spec["pvcNamespace"] = source.pvcNamespace
+	}
+	vmImage := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	vmImage.SetGroupVersionKind(harvesterVirtualMachineImageGVK)
+	vmImage.SetNamespace(vmbuilder.DefaultVMNamespace)
+	vmImage.SetName(image.Spec.StorageName)
+	vmImage.SetLabels(map[string]string{
+		"harvesterhci.io/image-type":       "raw_qcow2",
+		"harvesterhci.io/imageDisplayName": image.Spec.StorageName,
+	})
+	vmImage.SetAnnotations(annotations)
+	if err := r.vmProviderClient.Create(ctx, vmImage); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("createHarvesterImage: %w", err)
+	}
+	return nil
+}
+
+type customMachineImageSource struct {
+	// Set while an imported image file is being verified.
+	pending bool
+	// Set for imported images.
+	url       string
+	etag      string
+	sha512Sum string
+	// Set for captured images.
+	pvcNamespace string
+	pvcName      string
+}
+
+// Returns the source of the image volume and the annotations to store on it.
+// If the image cannot be created, a non-empty failMessage is returned.
+func (r *CustomMachineImageReconciler) getImageSource(ctx context.Context, image *pb.CustomMachineImagePrivate) (source customMachineImageSource, annotations map[string]string, failMessage string, err error) {
+	switch spec := image.Spec.Source.(type) {
+	case *pb.CustomMachineImageSpecPrivate_Capture:
+		return r.getCaptureSource(ctx, image, spec.Capture)
+	case *pb.CustomMachineImageSpecPrivate_ObjectStorage:
+		return r.getObjectStorageSource(ctx, image, spec.ObjectStorage)
+	}
+	return source, nil, "missing image source", nil
+}
+
+func (r *CustomMachineImageReconciler) getCaptureSource(ctx context.Context, image *pb.CustomMachineImagePrivate, capture *pb.CustomMachineImageCaptureSource) (source customMachineImageSource, annotations map[string]string, failMessage string, err error) {
+	source.pvcNamespace = image.Metadata.CloudAccountId
+	source.pvcName = capture.InstanceResourceId + "-" + defaultVolumeDisk
+	pvc, err := r.k8Client.CoreV1().PersistentVolumeClaims(source.pvcNamespace).Get(ctx, source.pvcName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return source, nil, fmt.Sprintf("root disk of instance %s not found", capture.InstanceName), nil
+	}
+	if err != nil {
+		return source, nil, "", fmt.Errorf("getCaptureSource: %w", err)
+	}
+	size := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+	annotations = map[string]string{
+		annotationCustomMachineImageVirtualSizeBytes: strconv.FormatInt(size.Value(), 10),
+	}
+	return source, annotations, "", nil
+}
+
+// Returns the result of the background verification of the image file, starting it if needed.
+// The source is pending until the verification is done.
+func (r *CustomMachineImageReconciler) getObjectStorageSource(ctx context.Context, image *pb.CustomMachineImagePrivate, objectStorage *pb.CustomMachineImageObjectStorageSource) (source customMachineImageSource, annotations map[string]string, failMessage string, err error) {
+	resourceId := image.Metadata.ResourceId
+	r.verificationsMu.Lock()
+	defer r.verificationsMu.Unlock()
+	v, found := r.verifications[resourceId]
+	if !found {
+		verifyCtx, cancel := context.WithCancel(ctx)
+		v = &objectStorageVerification{cancel: cancel, done: make(chan struct{})}
+		r.verifications[resourceId] = v
+		go func() {
+			defer close(v.done)
+			v.source, v.annotations, v.failMessage, v.err = r.verifyObjectStorageImage(verifyCtx, image, objectStorage)
+		}()
+	}
+	select {
+	case <-v.done:
+		delete(r.verifications, resourceId)
+		v.cancel()
+		return v.source, v.annotations, v.failMessage, v.err
+	default:
+		source.pending = true
+		return source, nil, "", nil
+	}
+}
+
+// Cancels the background verification of the image file, if any.
+func (r *CustomMachineImageReconciler) cancelObjectStorageVerification(resourceId string) {
+	r.verificationsMu.Lock()
+	defer r.verificationsMu.Unlock()
+	if v, found := r.verifications[resourceId]; found {
+		v.cancel()
+		delete(r.verifications, resourceId)
+	}
+}
+
+// Downloads the image file to verify its checksum and determine its virtual size.
+// The VM cluster downloads the image using a presigned URL. The URL is pinned to the verified version of the object
+// when the bucket is versioned. Otherwise, the importer verifies the object, since the object could be replaced after
+// it was verified: CDI requests the object with the verified ETag and Harvester verifies its SHA512 checksum.
+func (r *CustomMachineImageReconciler) verifyObjectStorageImage(ctx context.Context, image *pb.CustomMachineImagePrivate, objectStorage *pb.CustomMachineImageObjectStorageSource) (source customMachineImageSource, annotations map[string]string, failMessage string, err error) {
+	log := log.FromContext(ctx).WithName("CustomMachineImageReconciler.verifyObjectStorageImage")
+	log.Info("BEGIN")
+	defer log.Info("END")
+
+	access := image.Spec.ObjectStorageAccess
+	if access == nil || access.AccessKey == "" || access.SecretKey == "" {
+		return source, nil, "object storage credentials are not available", nil
+	}
+	endpoint, err := url.Parse(access.Endpoint)
+	if err != nil {
+		return source, nil, "", fmt.Errorf("verifyObjectStorageImage: invalid endpoint: %w", err)
+	}
+	minioClient, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4(access.AccessKey, access.SecretKey, ""),
+		Secure: endpoint.Scheme == "https",
+	})
+	if err != nil {
+		return source, nil, "", fmt.Errorf("verifyObjectStorageImage: %w", err)
+	}
+	bucketName := image.Metadata.CloudAccountId + "-" + objectStorage.BucketName
+	object, err := minioClient.GetObject(ctx, bucketName, objectStorage.ObjectKey, minio.GetObjectOptions{})
+	if err != nil {
+		return source, nil, "", fmt.Errorf("verifyObjectStorageImage: %w", err)
+	}
+	defer object.Close()
+	objectInfo, err := object.Stat()
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return source, nil, fmt.Sprintf("object %s not found in bucket %s", objectStorage.ObjectKey, objectStorage.BucketName), nil
+	}
+	if err != nil {
+		return source, nil, "", fmt.Errorf("verifyObjectStorageImage: %w", err)
+	}
+	file, err := readImageFile(object, objectStorage.Format)
+	if err != nil {
+		return source, nil, "", fmt.Errorf("verifyObjectStorageImage: %w", err)
+	}
+	if file.virtualSizeBytes == 0 {
+		return source, nil, "image file is not in the expected format", nil
+	}
+	if file.sha256Sum != objectStorage.Sha256Sum {
+		return source, nil, fmt.Sprintf("sha256sum of image file is %s but expected %s", file.sha256Sum, objectStorage.Sha256Sum), nil
+	}
+	reqParams := url.Values{}
+	if objectInfo.VersionID != "" {
+		reqParams.Set("versionId", objectInfo.VersionID)
+	}
+	presignedUrl, err := minioClient.PresignedGetObject(ctx, bucketName, objectStorage.ObjectKey, customMachineImagePresignExpiry, reqParams)
+	if err != nil {
+		return source, nil, "", fmt.Errorf("verifyObjectStorageImage: %w", err)
+	}
+	source.url = presignedUrl.String()
+	source.etag = objectInfo.ETag
+	source.sha512Sum = file.sha512Sum
+	annotations = map[string]string{
+		annotationCustomMachineImageSha256Sum:        file.sha256Sum,
+		annotationCustomMachineImageVirtualSizeBytes: strconv.FormatUint(file.virtualSizeBytes, 10),
+	}
+	return source, annotations, "", nil
+}
+
+// An image file read from object storage.
+type imageFile struct {
+	sha256Sum string
+	sha512Sum string
+	// The virtual size of a qcow2 file is read from its header. The virtual size of a raw file is its file size.
+	// A virtual size of 0 indicates that the file is not a valid qcow2 file.
+	virtualSizeBytes uint64
+}
+
+// Returns the hex SHA256 and SHA512 checksums and the virtual size of an image file.
+func readImageFile(reader io.Reader, format pb.CustomMachineImageFormat) (imageFile, error) {
+	sha256Hash := sha256.New()
+	sha512Hash := sha512.New()
+	hash := io.MultiWriter(sha256Hash, sha512Hash)
+	header := make([]byte, qcow2HeaderSize)
+	headerLen, err := io.ReadFull(io.TeeReader(reader, hash), header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return imageFile{}, err
+	}
+	restLen, err := io.Copy(hash, reader)
+	if err != nil {
+		return imageFile{}, err
+	}
+	file := imageFile{
+		sha256Sum: hex.EncodeToString(sha256Hash.Sum(nil)),
+		sha512Sum: hex.EncodeToString(sha512Hash.Sum(nil)),
+	}
+	if format == pb.CustomMachineImageFormat_CustomMachineImageFormatRaw {
+		file.virtualSizeBytes = uint64(int64(headerLen) + restLen)
+		return file, nil
+	}
+	if headerLen < qcow2HeaderSize || string(header[:len(qcow2Magic)]) != qcow2Magic {
+		return file, nil
+	}
+	file.virtualSizeBytes = binary.BigEndian.Uint64(header[24:32])
+	return file, nil
+}
+
+func (r *CustomMachineImageReconciler) updateStatusReady(ctx context.Context, image *pb.CustomMachineImagePrivate, annotations map[string]string) error {
+	virtualSizeBytes, err := strconv.ParseUint(annotations[annotationCustomMachineImageVirtualSizeBytes], 10, 64)
+	if err != nil {
+		return fmt.Errorf("updateStatusReady: invalid virtual size: %w", err)
+	}
+	return r.updateStatus(ctx, image, &pb.CustomMachineImageStatusPrivate{
+		Phase:            pb.CustomMachineImagePhase_CustomMachineImageReady,
+		Message:          "Image is ready",
+		Sha256Sum:        annotations[annotationCustomMachineImageSha256Sum],
+		VirtualSizeBytes: virtualSizeBytes,
+		ClusterId:        r.clusterId,
+	})
+}
+
+func (r *CustomMachineImageReconciler) updateStatusFailed(ctx context.Context, image *pb.CustomMachineImagePrivate, message string) error {
+	return r.updateStatus(ctx, image, &pb.CustomMachineImageStatusPrivate{
+		Phase:     pb.CustomMachineImagePhase_CustomMachineImageFailed,
+		Message:   message,
+		ClusterId: r.clusterId,
+	})
+}
+
+func (r *CustomMachineImageReconciler) updateStatus(ctx context.Context, image *pb.CustomMachineImagePrivate, imageStatus *pb.CustomMachineImageStatusPrivate) error {
+	_, err := r.customMachineImagePrivateClient.UpdateStatus(ctx, &pb.CustomMachineImageUpdateStatusRequest{
+		Metadata: &pb.CustomMachineImageIdReference{
+			CloudAccountId:  image.Metadata.CloudAccountId,
+			ResourceId:      image.Metadata.ResourceId,
+			ResourceVersion: image.Metadata.ResourceVersion,
+		},
+		Status: imageStatus,
+	})
+	return err
+}
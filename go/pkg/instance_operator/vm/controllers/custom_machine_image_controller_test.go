@@ -0,0 +1,53 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package privatecloud
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("readImageFile", func() {
+	sha256Hex := func(b []byte) string {
+		sum := sha256.Sum256(b)
+		return hex.EncodeToString(sum[:])
+	}
+	sha512Hex := func(b []byte) string {
+		sum := sha512.Sum512(b)
+		return hex.EncodeToString(sum[:])
+	}
+
+	It("should read the virtual size from a qcow2 header", func() {
+		image := make([]byte, 1024)
+		copy(image, qcow2Magic)
+		binary.BigEndian.PutUint64(image[24:32], 10*1024*1024*1024)
+		file, err := readImageFile(bytes.NewReader(image), pb.CustomMachineImageFormat_CustomMachineImageFormatQcow2)
+		Expect(err).Should(Succeed())
+		Expect(file.sha256Sum).Should(Equal(sha256Hex(image)))
+		Expect(file.sha512Sum).Should(Equal(sha512Hex(image)))
+		Expect(file.virtualSizeBytes).Should(Equal(uint64(10 * 1024 * 1024 * 1024)))
+	})
+
+	It("should return a virtual size of 0 for a qcow2 file without a valid header", func() {
+		image := []byte("not a qcow2 file")
+		file, err := readImageFile(bytes.NewReader(image), pb.CustomMachineImageFormat_CustomMachineImageFormatQcow2)
+		Expect(err).Should(Succeed())
+		Expect(file.sha256Sum).Should(Equal(sha256Hex(image)))
+		Expect(file.virtualSizeBytes).Should(Equal(uint64(0)))
+	})
+
+	It("should use the file size as the virtual size of a raw file", func() {
+		image := bytes.Repeat([]byte{1}, 4096)
+		file, err := readImageFile(bytes.NewReader(image), pb.CustomMachineImageFormat_CustomMachineImageFormatRaw)
+		Expect(err).Should(Succeed())
+		Expect(file.sha256Sum).Should(Equal(sha256Hex(image)))
+		Expect(file.virtualSizeBytes).Should(Equal(uint64(4096)))
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 
@@ -29,7 +30,9 @@ import (
 	nadclient "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/clientset/versioned"
 	"gopkg.in/yaml.v2"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
@@ -51,6 +54,9 @@ const (
 	defaultNetworkMTU    = 1500
 	storageInterfaceName = "storage0"
 	defaultOSUser        = "ubuntu"
+	resizeRequeueAfter   = 10 * time.Second
+	migrateRequeueAfter  = 10 * time.Second
+	haltRequeueAfter     = 10 * time.Second
 )
 
 var (
@@ -58,8 +64,8 @@ var (
 	ipPattern = regexp.MustCompile(`\b((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\b`)
 )
 
-func NewVmInstanceReconciler(ctx context.Context, mgr ctrl.Manager, vNetPrivateClient pb.VNetPrivateServiceClient, vNetClient pb.VNetServiceClient, cfg *cloudv1alpha1.VmInstanceOperatorConfig) (*controllers.InstanceReconciler, error) {
-	instanceBackend, err := NewVmInstanceBackend(ctx, mgr, cfg)
+func NewVmInstanceReconciler(ctx context.Context, mgr ctrl.Manager, vNetPrivateClient pb.VNetPrivateServiceClient, vNetClient pb.VNetServiceClient, instancePrivateClient pb.InstancePrivateServiceClient, cfg *cloudv1alpha1.VmInstanceOperatorConfig) (*controllers.InstanceReconciler, error) {
+	instanceBackend, err := NewVmInstanceBackend(ctx, mgr, instancePrivateClient, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +81,8 @@ type VmInstanceBackend struct {
 	NadClient         *nadclient.Clientset
 	Cfg               *cloudv1alpha1.VmInstanceOperatorConfig
 	VmProviderHelper  vmProviderInterface
+	// Used to choose a new node for instances on nodes under maintenance.
+	InstancePrivateClient pb.InstancePrivateServiceClient
 }
 
 type vmProviderInterface interface {
@@ -92,7 +100,7 @@ type harvesterProvider struct {
 	vmBuilderCreator string
 }
 
-func NewVmInstanceBackend(ctx context.Context, mgr ctrl.Manager, cfg *cloudv1alpha1.VmInstanceOperatorConfig) (*VmInstanceBackend, error) {
+func NewVmInstanceBackend(ctx context.Context, mgr ctrl.Manager, instancePrivateClient pb.InstancePrivateServiceClient, cfg *cloudv1alpha1.VmInstanceOperatorConfig) (*VmInstanceBackend, error) {
 	if err := ValidateVmConfig(cfg); err != nil {
 		return nil, err
 	}
@@ -134,14 +142,15 @@ func NewVmInstanceBackend(ctx context.Context, mgr ctrl.Manager, cfg *cloudv1alp
 	}
 
 	return &VmInstanceBackend{
-		InstanceClient:    mgr.GetClient(),
-		VmProviderClient:  vmInfraCluster.GetClient(),
-		VmProviderCluster: vmInfraCluster,
-		KubevirtClient:    vmInfraKubevirtClient,
-		K8Client:          vmInfraK8Client,
-		NadClient:         nadClient,
-		Cfg:               cfg,
-		VmProviderHelper:  vmProviderHelper,
+		InstanceClient:        mgr.GetClient(),
+		VmProviderClient:      vmInfraCluster.GetClient(),
+		VmProviderCluster:     vmInfraCluster,
+		KubevirtClient:        vmInfraKubevirtClient,
+		K8Client:              vmInfraK8Client,
+		NadClient:             nadClient,
+		Cfg:                   cfg,
+		VmProviderHelper:      vmProviderHelper,
+		InstancePrivateClient: instancePrivateClient,
 	}, nil
 }
 
@@ -164,14 +173,41 @@ func CreateVmProvisionClusterConfig(ctx context.Context, mgr ctrl.Manager, cfg *
 }
 
 // Reconcile Instance when kubevirt VirtualMachine with same name changes.
+// Reconcile all instances on a node when the node is under maintenance.
 func (b *VmInstanceBackend) BuildController(ctx context.Context, ctrlBuilder *builder.Builder) *builder.Builder {
 	return ctrlBuilder.
 		WatchesRawSource(
 			source.Kind(b.VmProviderCluster.GetCache(), &kubevirtv1.VirtualMachine{}),
 			&handler.EnqueueRequestForObject{},
+		).
+		WatchesRawSource(
+			source.Kind(b.VmProviderCluster.GetCache(), &k8sv1.Node{}),
+			handler.EnqueueRequestsFromMapFunc(b.mapNodeToInstances),
 		)
 }
 
+// Returns the instances that run on a node under maintenance.
+// VirtualMachineInstances have the same namespace and name as the instance.
+func (b *VmInstanceBackend) mapNodeToInstances(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := log.FromContext(ctx).WithName("VmInstanceBackend.mapNodeToInstances")
+	node, ok := obj.(*k8sv1.Node)
+	if !ok || !isNodeUnderMaintenance(node) {
+		return nil
+	}
+	vmiList := &kubevirtv1.VirtualMachineInstanceList{}
+	if err := b.VmProviderClient.List(ctx, vmiList, client.MatchingLabels{kubevirtv1.NodeNameLabel: node.Name}); err != nil {
+		log.Error(err, "unable to list VirtualMachineInstances", logkeys.NodeName, node.Name)
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, vmi := range vmiList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name},
+		})
+	}
+	return requests
+}
+
 // Check if the error message contain a possible IP address.
 // In some cases, this may return true even if the string contains an invalid IP address.
 func (op VmInstanceBackend) errorContainsIp(err error) bool {
@@ -198,7 +234,18 @@ func (op VmInstanceBackend) CreateOrUpdateInstance(ctx context.Context, instance
 	if util.IsInstanceFailed(instance) {
 		logger.Info("Skipping creation of VM because instance has failed")
 	} else if virtualMachine != nil {
+		if isInstanceResizing(instance, virtualMachine) {
+			return op.resizeVM(ctx, instance, virtualMachine)
+		}
+		migrationNeeded, err := op.isMigrationNeeded(ctx, instance)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("CreateOrUpdateInstance failed: %w", err)
+		}
+		if migrationNeeded {
+			return op.migrateVM(ctx, instance, virtualMachine)
+		}
 		logger.Info("Skipping creation of VM because it already exists")
+		return op.reconcileRunStrategy(ctx, instance, virtualMachine)
 	} else {
 		logger.Info("Creating VM", logkeys.Instance, fmt.Sprintf("%v", utils.TrimInstanceCloneForLogs(instance)))
 		// Creating VM Artifacts in KubeVirt/Harvester (Idempotent Harvester APIs)
@@ -323,8 +370,10 @@ func (op VmInstanceBackend) updateInstanceConditions(ctx context.Context, instan
 
 	// When the virt-laucher pod gets terminated for a VirtualMachine, the Kubervirt VirtualMachine status for condition 'running' changes to 'False'
 	// and instance is marked as Failed
+	// The VirtualMachine is stopped while the instance is resized, while it is moved to another node if live migration is not possible,
+	// and while the instance is stopped by its run strategy.
 	isKubevirtVirtualMachineReady := util.IsKubevirtVirtualMachineReady(virtualMachine)
-	if startupComplete && !isKubevirtVirtualMachineReady {
+	if startupComplete && !isKubevirtVirtualMachineReady && !isInstanceResizing(instance, virtualMachine) && !isInstanceMigrating(instance) && !isInstanceHalting(instance) {
 		condition := cloudv1alpha1.InstanceCondition{
 			Type:               cloudv1alpha1.InstanceConditionFailed,
 			Status:             k8sv1.ConditionTrue,
@@ -337,6 +386,460 @@ func (op VmInstanceBackend) updateInstanceConditions(ctx context.Context, instan
 	return nil
 }
 
+// Returns true if the VirtualMachine has not yet been updated to the instance type of the instance,
+// or if the VirtualMachine has been updated but has not started yet.
+func isInstanceResizing(instance *cloudv1alpha1.Instance, virtualMachine *kubevirtv1.VirtualMachine) bool {
+	if virtualMachine.Labels[util.LabelKeyForInstanceType(instance.Spec.InstanceTypeSpec.Name)] != "true" {
+		return true
+	}
+	startingCond := util.FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionStarting)
+	return startingCond != nil && startingCond.Status == k8sv1.ConditionTrue && startingCond.Reason == cloudv1alpha1.ConditionReasonResizing
+}
+
+// Change the instance type of a running or stopped VirtualMachine.
+// The VirtualMachine is halted, updated with the CPU, memory, GPUs, and disk size of the new instance type, and started again
+// unless the instance is stopped.
+// Each call performs the next step and requeues until the VirtualMachine is running with the new instance type.
+func (op VmInstanceBackend) resizeVM(ctx context.Context, instance *cloudv1alpha1.Instance, virtualMachine *kubevirtv1.VirtualMachine) (reconcile.Result, error) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("VmInstanceBackend.resizeVM").Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+	namespace := instance.ObjectMeta.Namespace
+	name := instance.ObjectMeta.Name
+	instanceType := instance.Spec.InstanceTypeSpec.Name
+
+	if virtualMachine.Labels[util.LabelKeyForInstanceType(instanceType)] == "true" {
+		// The VirtualMachine has been updated. Wait for it to start.
+		if !util.IsKubevirtVirtualMachineReady(virtualMachine) {
+			log.Info("Waiting for resized VM to start", logkeys.InstanceType, instanceType)
+			return reconcile.Result{RequeueAfter: resizeRequeueAfter}, nil
+		}
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonResizing, cloudv1alpha1.InstanceConditionStarting, k8sv1.ConditionFalse, "")
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonResizing, cloudv1alpha1.InstanceConditionStarted, k8sv1.ConditionTrue, fmt.Sprintf("Resized to instance type %s", instanceType))
+		log.Info("VM has been resized", logkeys.InstanceType, instanceType)
+		return reconcile.Result{}, nil
+	}
+
+	// Halt the VirtualMachine.
+	setInstanceCondition(instance, cloudv1alpha1.ConditionReasonResizing, cloudv1alpha1.InstanceConditionStarted, k8sv1.ConditionFalse, "")
+	setInstanceCondition(instance, cloudv1alpha1.ConditionReasonResizing, cloudv1alpha1.InstanceConditionStopping, k8sv1.ConditionTrue, fmt.Sprintf("Resizing to instance type %s", instanceType))
+	if virtualMachine.Spec.RunStrategy == nil || *virtualMachine.Spec.RunStrategy != kubevirtv1.RunStrategyHalted {
+		log.Info("Halting VM for resize", logkeys.InstanceType, instanceType)
+		patch := []byte(fmt.Sprintf(`{"spec":{"runStrategy":%q}}`, kubevirtv1.RunStrategyHalted))
+		if _, err := op.KubevirtClient.KubevirtV1().VirtualMachines(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("resizeVM: unable to halt VirtualMachine: %w", err)
+		}
+		return reconcile.Result{RequeueAfter: resizeRequeueAfter}, nil
+	}
+	_, err := op.KubevirtClient.KubevirtV1().VirtualMachineInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		log.Info("Waiting for VM to stop before resize")
+		return reconcile.Result{RequeueAfter: resizeRequeueAfter}, nil
+	}
+	if !errors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("resizeVM: %w", err)
+	}
+
+	if err := op.expandPVC(ctx, instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Update the VirtualMachine and start it unless the instance is stopped.
+	resizedVM, err := op.buildVirtualMachine(ctx, instance)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("resizeVM: error while building VirtualMachine: %w", err)
+	}
+	vmToUpdate := virtualMachine.DeepCopy()
+	for key := range vmToUpdate.Labels {
+		if strings.HasPrefix(key, util.LabelKeyForInstanceType("")) {
+			delete(vmToUpdate.Labels, key)
+		}
+	}
+	if vmToUpdate.Labels == nil {
+		vmToUpdate.Labels = map[string]string{}
+	}
+	for key, value := range resizedVM.Labels {
+		vmToUpdate.Labels[key] = value
+	}
+	if vmToUpdate.Annotations == nil {
+		vmToUpdate.Annotations = map[string]string{}
+	}
+	delete(vmToUpdate.Annotations, vmbuilder.AnnotationKeyReservedMemory)
+	if reservedMemory, ok := resizedVM.Annotations[vmbuilder.AnnotationKeyReservedMemory]; ok {
+		vmToUpdate.Annotations[vmbuilder.AnnotationKeyReservedMemory] = reservedMemory
+	}
+	templateSpec := &vmToUpdate.Spec.Template.Spec
+	templateSpec.Domain.Resources = resizedVM.Spec.Template.Spec.Domain.Resources
+	templateSpec.Domain.CPU = resizedVM.Spec.Template.Spec.Domain.CPU
+	templateSpec.Domain.Devices.HostDevices = resizedVM.Spec.Template.Spec.Domain.Devices.HostDevices
+	templateSpec.Affinity = resizedVM.Spec.Template.Spec.Affinity
+	vmToUpdate.Spec.RunStrategy = resizedVM.Spec.RunStrategy
+	log.Info("Updating resized VM", logkeys.VirtualMachine, vmToUpdate)
+	if _, err := op.KubevirtClient.KubevirtV1().VirtualMachines(namespace).Update(ctx, vmToUpdate, metav1.UpdateOptions{}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("resizeVM: unable to update VirtualMachine: %w", err)
+	}
+	setInstanceCondition(instance, cloudv1alpha1.ConditionReasonResizing, cloudv1alpha1.InstanceConditionStopping, k8sv1.ConditionFalse, "")
+	if instance.Spec.RunStrategy == cloudv1alpha1.RunStrategyHalted {
+		// A stopped instance stays stopped with the new instance type.
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonResizing, cloudv1alpha1.InstanceConditionStopped, k8sv1.ConditionTrue, fmt.Sprintf("Resized to instance type %s", instanceType))
+		log.Info("Stopped VM has been resized", logkeys.InstanceType, instanceType)
+		return reconcile.Result{}, nil
+	}
+	setInstanceCondition(instance, cloudv1alpha1.ConditionReasonResizing, cloudv1alpha1.InstanceConditionStarting, k8sv1.ConditionTrue, fmt.Sprintf("Starting with instance type %s", instanceType))
+	return reconcile.Result{RequeueAfter: resizeRequeueAfter}, nil
+}
+
+func setInstanceCondition(instance *cloudv1alpha1.Instance, reason cloudv1alpha1.ConditionReason, conditionType cloudv1alpha1.InstanceConditionType, status k8sv1.ConditionStatus, message string) {
+	util.SetStatusCondition(&instance.Status.Conditions, cloudv1alpha1.InstanceCondition{
+		Type:          conditionType,
+		Status:        status,
+		LastProbeTime: metav1.Now(),
+		Reason:        reason,
+		Message:       message,
+	})
+}
+
+// Returns true if the node has been marked for maintenance in the fleet admin service.
+func isNodeUnderMaintenance(node *k8sv1.Node) bool {
+	return node.Labels[util.NodeMaintenanceLabel] == "true"
+}
+
+// Returns true if the instance is being moved to another node.
+func isInstanceMigrating(instance *cloudv1alpha1.Instance) bool {
+	migratingCond := util.FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionMigrating)
+	return migratingCond != nil && migratingCond.Status == k8sv1.ConditionTrue
+}
+
+// Returns true if the instance is being moved to another node or if it runs on a node under maintenance.
+func (op VmInstanceBackend) isMigrationNeeded(ctx context.Context, instance *cloudv1alpha1.Instance) (bool, error) {
+	if isInstanceMigrating(instance) {
+		return true, nil
+	}
+	vmi := &kubevirtv1.VirtualMachineInstance{}
+	if err := op.VmProviderClient.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}, vmi); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("isMigrationNeeded: %w", err)
+	}
+	if vmi.Status.NodeName == "" {
+		return false, nil
+	}
+	node := &k8sv1.Node{}
+	if err := op.VmProviderClient.Get(ctx, types.NamespacedName{Name: vmi.Status.NodeName}, node); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("isMigrationNeeded: %w", err)
+	}
+	return isNodeUnderMaintenance(node), nil
+}
+
+// Returns the name of the VirtualMachineInstanceMigration that moves the instance off a node under maintenance.
+func migrationName(instance *cloudv1alpha1.Instance) string {
+	return instance.Name + "-maintenance"
+}
+
+// Move a VirtualMachine off a node under maintenance.
+// A new node is chosen by the VM scheduler through the Compute API Server and it is replicated to the instance spec.
+// The VirtualMachine is then live migrated. If live migration is not possible or it fails, the VirtualMachine
+// is halted and started again with the new node affinity.
+// Each call performs the next step and requeues until the VirtualMachine is running on a node that is not under maintenance.
+// The VirtualMachine and its interfaces are not recreated, so the instance keeps its IP addresses and VNet.
+func (op VmInstanceBackend) migrateVM(ctx context.Context, instance *cloudv1alpha1.Instance, virtualMachine *kubevirtv1.VirtualMachine) (reconcile.Result, error) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("VmInstanceBackend.migrateVM").Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+	namespace := instance.ObjectMeta.Namespace
+	name := instance.ObjectMeta.Name
+
+	vmi, err := op.KubevirtClient.KubevirtV1().VirtualMachineInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("migrateVM: %w", err)
+	}
+	if errors.IsNotFound(err) {
+		// The VirtualMachine has been halted because live migration was not possible.
+		return op.startMigratedVM(ctx, instance, virtualMachine)
+	}
+
+	sourceNodeName := vmi.Status.NodeName
+	if sourceNodeName == "" {
+		log.Info("Waiting for VM to be scheduled")
+		return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+	}
+	sourceNode := &k8sv1.Node{}
+	if err := op.VmProviderClient.Get(ctx, types.NamespacedName{Name: sourceNodeName}, sourceNode); err != nil && !errors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("migrateVM: %w", err)
+	}
+	if !isNodeUnderMaintenance(sourceNode) {
+		// The VirtualMachine is no longer on a node under maintenance. Wait for it to be ready.
+		if !util.IsKubevirtVirtualMachineReady(virtualMachine) {
+			log.Info("Waiting for migrated VM to be ready", logkeys.NodeName, sourceNodeName)
+			return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+		}
+		if err := op.deleteMigration(ctx, instance); err != nil {
+			return reconcile.Result{}, err
+		}
+		startingCond := util.FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionStarting)
+		if startingCond != nil && startingCond.Status == k8sv1.ConditionTrue && startingCond.Reason == cloudv1alpha1.ConditionReasonMigrating {
+			setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionStarting, k8sv1.ConditionFalse, "")
+			setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionStarted, k8sv1.ConditionTrue, fmt.Sprintf("Moved to node %s", sourceNodeName))
+		}
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionMigrating, k8sv1.ConditionFalse, fmt.Sprintf("Moved to node %s", sourceNodeName))
+		log.Info("VM has been moved", logkeys.NodeName, sourceNodeName)
+		return reconcile.Result{}, nil
+	}
+
+	// Choose a new node. The Compute API Server stores it in the instance spec, which is then replicated to this instance.
+	if instance.Spec.NodeId == sourceNodeName || !isInstanceMigrating(instance) {
+		resp, err := op.InstancePrivateClient.MigratePrivate(ctx, &pb.InstanceMigratePrivateRequest{
+			Metadata: &pb.InstanceIdReference{
+				CloudAccountId: controllers.CloudAccountId(instance),
+				ResourceId:     name,
+			},
+			SourceNodeId: sourceNodeName,
+		})
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("migrateVM: unable to choose a new node: %w", err)
+		}
+		log.Info("Moving VM to a new node", logkeys.NodeName, sourceNodeName, logkeys.InstanceNodeId, resp.NodeId)
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionMigrating, k8sv1.ConditionTrue,
+			fmt.Sprintf("Moving from node %s to node %s", sourceNodeName, resp.NodeId))
+		return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+	}
+
+	// Prefer the new node when the VirtualMachine is started again. Nodes under maintenance are excluded.
+	affinity, err := op.getAffinityForInstance(instance)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("migrateVM: %w", err)
+	}
+	if !equality.Semantic.DeepEqual(virtualMachine.Spec.Template.Spec.Affinity, affinity) {
+		vmToUpdate := virtualMachine.DeepCopy()
+		vmToUpdate.Spec.Template.Spec.Affinity = affinity
+		if _, err := op.KubevirtClient.KubevirtV1().VirtualMachines(namespace).Update(ctx, vmToUpdate, metav1.UpdateOptions{}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("migrateVM: unable to update VirtualMachine affinity: %w", err)
+		}
+		return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+	}
+
+	if virtualMachine.Spec.RunStrategy != nil && *virtualMachine.Spec.RunStrategy == kubevirtv1.RunStrategyHalted {
+		log.Info("Waiting for VM to stop before moving it")
+		return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+	}
+
+	// Live migrate the VirtualMachineInstance.
+	// The target pod is placed by the Kubernetes scheduler using the affinity of the VirtualMachineInstance.
+	// Kubevirt never places it on the source node.
+	liveMigrationFailed := false
+	if vmi.IsMigratable() {
+		migration, err := op.KubevirtClient.KubevirtV1().VirtualMachineInstanceMigrations(namespace).Get(ctx, migrationName(instance), metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			log.Info("Live migrating VM", logkeys.NodeName, sourceNodeName, logkeys.InstanceNodeId, instance.Spec.NodeId)
+			migration = &kubevirtv1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      migrationName(instance),
+					Namespace: namespace,
+				},
+				Spec: kubevirtv1.VirtualMachineInstanceMigrationSpec{
+					VMIName: name,
+				},
+			}
+			if _, err := op.KubevirtClient.KubevirtV1().VirtualMachineInstanceMigrations(namespace).Create(ctx, migration, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+				return reconcile.Result{}, fmt.Errorf("migrateVM: unable to create VirtualMachineInstanceMigration: %w", err)
+			}
+			return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+		}
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("migrateVM: %w", err)
+		}
+		switch migration.Status.Phase {
+		case kubevirtv1.MigrationFailed:
+			log.Info("Live migration failed", logkeys.NodeName, sourceNodeName)
+			liveMigrationFailed = true
+		case kubevirtv1.MigrationSucceeded:
+			// The VirtualMachineInstance was moved to another node under maintenance. Migrate it again.
+			if err := op.deleteMigration(ctx, instance); err != nil {
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+		default:
+			log.Info("Waiting for live migration", logkeys.NodeName, sourceNodeName, logkeys.StatusPhase, migration.Status.Phase)
+			return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+		}
+	}
+	if !liveMigrationFailed {
+		log.Info("VM cannot be live migrated", logkeys.NodeName, sourceNodeName)
+	}
+
+	// Halt the VirtualMachine. It is started on the new node by startMigratedVM.
+	if err := op.deleteMigration(ctx, instance); err != nil {
+		return reconcile.Result{}, err
+	}
+	setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionStarted, k8sv1.ConditionFalse, "")
+	setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionStopping, k8sv1.ConditionTrue,
+		fmt.Sprintf("Stopping to move to node %s", instance.Spec.NodeId))
+	log.Info("Halting VM to move it", logkeys.NodeName, sourceNodeName)
+	patch := []byte(fmt.Sprintf(`{"spec":{"runStrategy":%q}}`, kubevirtv1.RunStrategyHalted))
+	if _, err := op.KubevirtClient.KubevirtV1().VirtualMachines(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("migrateVM: unable to halt VirtualMachine: %w", err)
+	}
+	return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+}
+
+// Start a VirtualMachine that was halted by migrateVM.
+func (op VmInstanceBackend) startMigratedVM(ctx context.Context, instance *cloudv1alpha1.Instance, virtualMachine *kubevirtv1.VirtualMachine) (reconcile.Result, error) {
+	log := log.FromContext(ctx).WithName("VmInstanceBackend.startMigratedVM")
+	namespace := instance.ObjectMeta.Namespace
+
+	if virtualMachine.Spec.RunStrategy == nil || *virtualMachine.Spec.RunStrategy != kubevirtv1.RunStrategyHalted {
+		log.Info("Waiting for migrated VM to start")
+		return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+	}
+	if instance.Spec.RunStrategy == cloudv1alpha1.RunStrategyHalted {
+		// The instance was stopped while it was moved. It starts on the new node when it is started again.
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionStopping, k8sv1.ConditionFalse, "")
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionMigrating, k8sv1.ConditionFalse, fmt.Sprintf("Moved to node %s", instance.Spec.NodeId))
+		return reconcile.Result{Requeue: true}, nil
+	}
+	migratedVM, err := op.buildVirtualMachine(ctx, instance)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("startMigratedVM: error while building VirtualMachine: %w", err)
+	}
+	vmToUpdate := virtualMachine.DeepCopy()
+	vmToUpdate.Spec.Template.Spec.Affinity = migratedVM.Spec.Template.Spec.Affinity
+	vmToUpdate.Spec.RunStrategy = migratedVM.Spec.RunStrategy
+	log.Info("Starting migrated VM", logkeys.InstanceNodeId, instance.Spec.NodeId)
+	if _, err := op.KubevirtClient.KubevirtV1().VirtualMachines(namespace).Update(ctx, vmToUpdate, metav1.UpdateOptions{}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("startMigratedVM: unable to update VirtualMachine: %w", err)
+	}
+	setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionStopping, k8sv1.ConditionFalse, "")
+	setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionStarting, k8sv1.ConditionTrue,
+		fmt.Sprintf("Starting on node %s", instance.Spec.NodeId))
+	return reconcile.Result{RequeueAfter: migrateRequeueAfter}, nil
+}
+
+// Returns true if the VirtualMachine is stopped, or is being stopped or started, because of the run strategy of the instance.
+func isInstanceHalting(instance *cloudv1alpha1.Instance) bool {
+	if instance.Spec.RunStrategy == cloudv1alpha1.RunStrategyHalted || util.IsInstanceStoppedCompleted(instance) {
+		return true
+	}
+	startingCond := util.FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionStarting)
+	return startingCond != nil && startingCond.Status == k8sv1.ConditionTrue && startingCond.Reason == cloudv1alpha1.ConditionReasonRunStrategy
+}
+
+// Stop or start a VirtualMachine according to the run strategy of the instance.
+// The VirtualMachine is halted when the run strategy of the instance is Halted, its disks and interfaces are kept.
+// It is started again when the run strategy is changed back.
+// Each call performs the next step and requeues until the VirtualMachine has stopped or is running.
+func (op VmInstanceBackend) reconcileRunStrategy(ctx context.Context, instance *cloudv1alpha1.Instance, virtualMachine *kubevirtv1.VirtualMachine) (reconcile.Result, error) {
+	log := log.FromContext(ctx).WithName("VmInstanceBackend.reconcileRunStrategy")
+	halted := virtualMachine.Spec.RunStrategy != nil && *virtualMachine.Spec.RunStrategy == kubevirtv1.RunStrategyHalted
+
+	if instance.Spec.RunStrategy == cloudv1alpha1.RunStrategyHalted {
+		// Provisioning is not interrupted. The Compute API Server does not accept Halted until the instance is ready.
+		if !util.IsInstanceStartupCompleted(instance) || (halted && util.IsInstanceStoppedCompleted(instance)) {
+			return reconcile.Result{}, nil
+		}
+		if !halted {
+			log.Info("Halting VM")
+			setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStarting, k8sv1.ConditionFalse, "")
+			setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStarted, k8sv1.ConditionFalse, "")
+			setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStopping, k8sv1.ConditionTrue, "Stopping")
+			if err := op.patchRunStrategy(ctx, virtualMachine, kubevirtv1.RunStrategyHalted); err != nil {
+				return reconcile.Result{}, fmt.Errorf("reconcileRunStrategy: unable to halt VirtualMachine: %w", err)
+			}
+			return reconcile.Result{RequeueAfter: haltRequeueAfter}, nil
+		}
+		vmi := &kubevirtv1.VirtualMachineInstance{}
+		err := op.VmProviderClient.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}, vmi)
+		if err == nil {
+			log.Info("Waiting for VM to stop")
+			return reconcile.Result{RequeueAfter: haltRequeueAfter}, nil
+		}
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("reconcileRunStrategy: %w", err)
+		}
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStopping, k8sv1.ConditionFalse, "")
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStopped, k8sv1.ConditionTrue, "Stopped")
+		log.Info("VM has stopped")
+		return reconcile.Result{}, nil
+	}
+
+	if halted {
+		startedVM, err := op.buildVirtualMachine(ctx, instance)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("reconcileRunStrategy: error while building VirtualMachine: %w", err)
+		}
+		log.Info("Starting VM", logkeys.InstanceRunStrategy, instance.Spec.RunStrategy)
+		if err := op.patchRunStrategy(ctx, virtualMachine, *startedVM.Spec.RunStrategy); err != nil {
+			return reconcile.Result{}, fmt.Errorf("reconcileRunStrategy: unable to start VirtualMachine: %w", err)
+		}
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStopping, k8sv1.ConditionFalse, "")
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStopped, k8sv1.ConditionFalse, "")
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStarting, k8sv1.ConditionTrue, "Starting")
+		return reconcile.Result{RequeueAfter: haltRequeueAfter}, nil
+	}
+
+	startingCond := util.FindStatusCondition(instance.Status.Conditions, cloudv1alpha1.InstanceConditionStarting)
+	if startingCond != nil && startingCond.Status == k8sv1.ConditionTrue && startingCond.Reason == cloudv1alpha1.ConditionReasonRunStrategy {
+		if !util.IsKubevirtVirtualMachineReady(virtualMachine) {
+			log.Info("Waiting for VM to start")
+			return reconcile.Result{RequeueAfter: haltRequeueAfter}, nil
+		}
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStarting, k8sv1.ConditionFalse, "")
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonRunStrategy, cloudv1alpha1.InstanceConditionStarted, k8sv1.ConditionTrue, "Started")
+		log.Info("VM has started")
+	}
+	return reconcile.Result{}, nil
+}
+
+func (op VmInstanceBackend) patchRunStrategy(ctx context.Context, virtualMachine *kubevirtv1.VirtualMachine, runStrategy kubevirtv1.VirtualMachineRunStrategy) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"runStrategy":%q}}`, runStrategy))
+	return op.VmProviderClient.Patch(ctx, virtualMachine, client.RawPatch(types.MergePatchType, patch))
+}
+
+// Delete the VirtualMachineInstanceMigration of the instance if it exists.
+func (op VmInstanceBackend) deleteMigration(ctx context.Context, instance *cloudv1alpha1.Instance) error {
+	err := op.KubevirtClient.KubevirtV1().VirtualMachineInstanceMigrations(instance.Namespace).Delete(ctx, migrationName(instance), metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("deleteMigration: %w", err)
+	}
+	return nil
+}
+
+// Expand the root disk PVC if the instance type has a larger disk. Disks are never shrunk.
+func (op VmInstanceBackend) expandPVC(ctx context.Context, instance *cloudv1alpha1.Instance) error {
+	log := log.FromContext(ctx).WithName("VmInstanceBackend.expandPVC")
+	namespace := instance.ObjectMeta.Namespace
+	pvcName := instance.ObjectMeta.Name + "-" + defaultVolumeDisk
+	if len(instance.Spec.InstanceTypeSpec.Disks) == 0 {
+		return nil
+	}
+	requestedSize, err := resource.ParseQuantity(instance.Spec.InstanceTypeSpec.Disks[0].Size)
+	if err != nil {
+		return fmt.Errorf("expandPVC: invalid disk size: %w", err)
+	}
+	pvc, err := op.K8Client.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, pvcName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("expandPVC: %w", err)
+	}
+	currentSize := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+	if requestedSize.Cmp(currentSize) <= 0 {
+		return nil
+	}
+	log.Info("Expanding PVC", logkeys.PvcName, pvcName, logkeys.ExistingSize, currentSize.String(), logkeys.StorageSize, requestedSize.String())
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = k8sv1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[k8sv1.ResourceStorage] = requestedSize
+	if _, err := op.K8Client.CoreV1().PersistentVolumeClaims(namespace).Update(ctx, pvc, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("expandPVC: %w", err)
+	}
+	return nil
+}
+
 // This function deletes the VM, associated volumes and secrets as well.
 func (op VmInstanceBackend) DeleteResources(ctx context.Context, instance *cloudv1alpha1.Instance) (reconcile.Result, error) {
 	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("VmInstanceBackend.DeleteResources").Start()
@@ -500,6 +1003,7 @@ func (op VmInstanceBackend) CreateVmSecrets(ctx context.Context, instance *cloud
 	networkData.Network.Config[0].Subnets[0].Address = fmt.Sprintf("%s/%d", address, intfStatus.PrefixLength)
 	networkData.Network.Config[0].Subnets[0].Gateway = intfStatus.Gateway
 	networkData.Network.Config[0].Subnets[0].Dns_nameservers = intfSpec.Nameservers
+	util.AddIpv6CloudInitSubnet(&networkData, intfStatus)
 
 	needStorageConfig := len(instance.Spec.Interfaces) > 1 &&
 		len(instance.Status.Interfaces) > 1 &&
@@ -672,7 +1176,7 @@ func (op VmInstanceBackend) buildVirtualMachine(ctx context.Context, instance *c
 			UserDataSecretName:    name,
 			NetworkDataSecretName: name,
 		}).
-		Run(true).
+		Run(instance.Spec.RunStrategy != cloudv1alpha1.RunStrategyHalted).
 		Affinity(affinity).
 		TopologySpreadConstraints(topologySpreadConstraint)
 
@@ -781,9 +1285,18 @@ func (op VmInstanceBackend) DeletePVC(ctx context.Context, instance *cloudv1alph
 	return err
 }
 
+// Returns the name of the image volume in the VM cluster.
+// Custom machine images are stored with a name that differs from the machine image name.
+func machineImageStorageName(instance *cloudv1alpha1.Instance) string {
+	if instance.Spec.MachineImageSpec.StorageName != "" {
+		return instance.Spec.MachineImageSpec.StorageName
+	}
+	return instance.Spec.MachineImage
+}
+
 func (kv kubeVirtProvider) buildVolume(instance *cloudv1alpha1.Instance, vmBuilder *vmbuilder.VMBuilder) *vmbuilder.VMBuilder {
 	name := instance.ObjectMeta.Name
-	vmImageKey := instance.Spec.MachineImage
+	vmImageKey := machineImageStorageName(instance)
 	instanceTypeSpec := instance.Spec.InstanceTypeSpec
 	dvName := name + "-" + defaultVolumeDisk
 	dvOpt := vmbuilder.DataVolumeTemplateOption{
@@ -797,7 +1310,7 @@ func (kv kubeVirtProvider) buildVolume(instance *cloudv1alpha1.Instance, vmBuild
 
 func (hp harvesterProvider) buildVolume(instance *cloudv1alpha1.Instance, vmBuilder *vmbuilder.VMBuilder) *vmbuilder.VMBuilder {
 	name := instance.ObjectMeta.Name
-	vmImageKey := instance.Spec.MachineImage
+	vmImageKey := machineImageStorageName(instance)
 	storageClass := vmbuilder.BuildImageStorageClassName("", vmImageKey)
 	instanceTypeSpec := instance.Spec.InstanceTypeSpec
 	pvcName := name + "-" + defaultVolumeDisk
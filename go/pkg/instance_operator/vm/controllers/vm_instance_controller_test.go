@@ -4,10 +4,20 @@
 package privatecloud
 
 import (
+	"context"
 	"testing"
 
+	util "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/instance_operator/util"
+	cloudv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/apis/private.cloud/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestVmController(t *testing.T) {
@@ -34,3 +44,193 @@ var _ = Describe("CreateVmErrors, Validating IPv4 Addresses in error messages",
 		})
 	})
 })
+
+var _ = Describe("isInstanceResizing", func() {
+	newInstance := func(instanceType string, conditions ...cloudv1alpha1.InstanceCondition) *cloudv1alpha1.Instance {
+		return &cloudv1alpha1.Instance{
+			Spec: cloudv1alpha1.InstanceSpec{
+				InstanceTypeSpec: cloudv1alpha1.InstanceTypeSpec{Name: instanceType},
+			},
+			Status: cloudv1alpha1.InstanceStatus{Conditions: conditions},
+		}
+	}
+	newVirtualMachine := func(instanceType string) *kubevirtv1.VirtualMachine {
+		return &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{util.LabelKeyForInstanceType(instanceType): "true"},
+			},
+		}
+	}
+	resizeStarting := cloudv1alpha1.InstanceCondition{
+		Type:   cloudv1alpha1.InstanceConditionStarting,
+		Status: k8sv1.ConditionTrue,
+		Reason: cloudv1alpha1.ConditionReasonResizing,
+	}
+
+	It("should be false when the VM has the instance type of the instance", func() {
+		Expect(isInstanceResizing(newInstance("vm-spr-sml"), newVirtualMachine("vm-spr-sml"))).Should(BeFalse())
+	})
+
+	It("should be true when the VM has a different instance type", func() {
+		Expect(isInstanceResizing(newInstance("vm-spr-med"), newVirtualMachine("vm-spr-sml"))).Should(BeTrue())
+	})
+
+	It("should be true until the resized VM has started", func() {
+		Expect(isInstanceResizing(newInstance("vm-spr-med", resizeStarting), newVirtualMachine("vm-spr-med"))).Should(BeTrue())
+	})
+})
+
+var _ = Describe("Node maintenance", func() {
+	It("isNodeUnderMaintenance should be true only when the maintenance label is set", func() {
+		node := &k8sv1.Node{}
+		Expect(isNodeUnderMaintenance(node)).Should(BeFalse())
+		node.Labels = map[string]string{util.NodeMaintenanceLabel: "false"}
+		Expect(isNodeUnderMaintenance(node)).Should(BeFalse())
+		node.Labels = map[string]string{util.NodeMaintenanceLabel: "true"}
+		Expect(isNodeUnderMaintenance(node)).Should(BeTrue())
+	})
+
+	It("isInstanceMigrating should follow the Migrating condition", func() {
+		instance := &cloudv1alpha1.Instance{}
+		Expect(isInstanceMigrating(instance)).Should(BeFalse())
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionMigrating, k8sv1.ConditionTrue, "Moving from node node1 to node node2")
+		Expect(isInstanceMigrating(instance)).Should(BeTrue())
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonMigrating, cloudv1alpha1.InstanceConditionMigrating, k8sv1.ConditionFalse, "Moved to node node2")
+		Expect(isInstanceMigrating(instance)).Should(BeFalse())
+	})
+})
+
+var _ = Describe("reconcileRunStrategy", func() {
+	ctx := context.Background()
+	objectMeta := metav1.ObjectMeta{Name: "vm1", Namespace: "123456789012"}
+	var (
+		vmProviderClient client.Client
+		backend          VmInstanceBackend
+		instance         *cloudv1alpha1.Instance
+	)
+
+	getVirtualMachine := func() *kubevirtv1.VirtualMachine {
+		virtualMachine := &kubevirtv1.VirtualMachine{}
+		Expect(vmProviderClient.Get(ctx, types.NamespacedName{Namespace: objectMeta.Namespace, Name: objectMeta.Name}, virtualMachine)).Should(Succeed())
+		return virtualMachine
+	}
+	expectPhaseConditions := func(trueCondition cloudv1alpha1.InstanceConditionType) {
+		for _, conditionType := range []cloudv1alpha1.InstanceConditionType{
+			cloudv1alpha1.InstanceConditionStarting,
+			cloudv1alpha1.InstanceConditionStarted,
+			cloudv1alpha1.InstanceConditionStopping,
+			cloudv1alpha1.InstanceConditionStopped,
+		} {
+			cond := util.FindStatusCondition(instance.Status.Conditions, conditionType)
+			if conditionType == trueCondition {
+				Expect(cond).ShouldNot(BeNil())
+				Expect(cond.Status).Should(Equal(k8sv1.ConditionTrue), string(conditionType))
+			} else if cond != nil {
+				Expect(cond.Status).Should(Equal(k8sv1.ConditionFalse), string(conditionType))
+			}
+		}
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(kubevirtv1.AddToScheme(scheme)).Should(Succeed())
+		runStrategy := kubevirtv1.RunStrategyOnce
+		virtualMachine := &kubevirtv1.VirtualMachine{
+			ObjectMeta: objectMeta,
+			Spec:       kubevirtv1.VirtualMachineSpec{RunStrategy: &runStrategy},
+		}
+		vmi := &kubevirtv1.VirtualMachineInstance{ObjectMeta: objectMeta}
+		vmProviderClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(virtualMachine, vmi).Build()
+		backend = VmInstanceBackend{
+			VmProviderClient: vmProviderClient,
+			VmProviderHelper: kubeVirtProvider{},
+		}
+		instance = &cloudv1alpha1.Instance{
+			ObjectMeta: objectMeta,
+			Spec: cloudv1alpha1.InstanceSpec{
+				RunStrategy: cloudv1alpha1.RunStrategyAlways,
+				InstanceTypeSpec: cloudv1alpha1.InstanceTypeSpec{
+					Name:   "vm-spr-sml",
+					Cpu:    cloudv1alpha1.CpuSpec{Cores: 2, Sockets: 1, Threads: 1},
+					Memory: cloudv1alpha1.MemorySpec{Size: "8Gi"},
+					Disks:  []cloudv1alpha1.DiskSpec{{Size: "20Gi"}},
+				},
+			},
+		}
+		setInstanceCondition(instance, cloudv1alpha1.ConditionReasonNone, cloudv1alpha1.InstanceConditionStartupComplete, k8sv1.ConditionTrue, "")
+	})
+
+	It("should not change a running instance", func() {
+		result, err := backend.reconcileRunStrategy(ctx, instance, getVirtualMachine())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).Should(BeZero())
+		Expect(*getVirtualMachine().Spec.RunStrategy).Should(Equal(kubevirtv1.RunStrategyOnce))
+		Expect(isInstanceHalting(instance)).Should(BeFalse())
+	})
+
+	It("should stop and start the VM when the run strategy changes", func() {
+		By("Halting the VM")
+		instance.Spec.RunStrategy = cloudv1alpha1.RunStrategyHalted
+		result, err := backend.reconcileRunStrategy(ctx, instance, getVirtualMachine())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).ShouldNot(BeZero())
+		Expect(*getVirtualMachine().Spec.RunStrategy).Should(Equal(kubevirtv1.RunStrategyHalted))
+		expectPhaseConditions(cloudv1alpha1.InstanceConditionStopping)
+		Expect(isInstanceHalting(instance)).Should(BeTrue())
+
+		By("Waiting for the VirtualMachineInstance to be deleted")
+		result, err = backend.reconcileRunStrategy(ctx, instance, getVirtualMachine())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).ShouldNot(BeZero())
+		expectPhaseConditions(cloudv1alpha1.InstanceConditionStopping)
+
+		Expect(vmProviderClient.Delete(ctx, &kubevirtv1.VirtualMachineInstance{ObjectMeta: objectMeta})).Should(Succeed())
+		result, err = backend.reconcileRunStrategy(ctx, instance, getVirtualMachine())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).Should(BeZero())
+		expectPhaseConditions(cloudv1alpha1.InstanceConditionStopped)
+
+		By("Starting the VM")
+		instance.Spec.RunStrategy = cloudv1alpha1.RunStrategyAlways
+		Expect(isInstanceHalting(instance)).Should(BeTrue())
+		result, err = backend.reconcileRunStrategy(ctx, instance, getVirtualMachine())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).ShouldNot(BeZero())
+		Expect(*getVirtualMachine().Spec.RunStrategy).Should(Equal(kubevirtv1.RunStrategyOnce))
+		expectPhaseConditions(cloudv1alpha1.InstanceConditionStarting)
+		Expect(isInstanceHalting(instance)).Should(BeTrue())
+
+		By("Waiting for the VM to be ready")
+		virtualMachine := getVirtualMachine()
+		result, err = backend.reconcileRunStrategy(ctx, instance, virtualMachine)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).ShouldNot(BeZero())
+		expectPhaseConditions(cloudv1alpha1.InstanceConditionStarting)
+
+		virtualMachine.Status.Conditions = []kubevirtv1.VirtualMachineCondition{{Type: kubevirtv1.VirtualMachineReady, Status: k8sv1.ConditionTrue}}
+		result, err = backend.reconcileRunStrategy(ctx, instance, virtualMachine)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).Should(BeZero())
+		expectPhaseConditions(cloudv1alpha1.InstanceConditionStarted)
+		Expect(isInstanceHalting(instance)).Should(BeFalse())
+	})
+
+	It("should keep a stopped instance halted when its VM is rebuilt", func() {
+		virtualMachine, err := backend.buildVirtualMachine(ctx, instance)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*virtualMachine.Spec.RunStrategy).Should(Equal(kubevirtv1.RunStrategyOnce))
+
+		instance.Spec.RunStrategy = cloudv1alpha1.RunStrategyHalted
+		virtualMachine, err = backend.buildVirtualMachine(ctx, instance)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*virtualMachine.Spec.RunStrategy).Should(Equal(kubevirtv1.RunStrategyHalted))
+	})
+
+	It("should not interrupt provisioning", func() {
+		instance.Status.Conditions = nil
+		instance.Spec.RunStrategy = cloudv1alpha1.RunStrategyHalted
+		_, err := backend.reconcileRunStrategy(ctx, instance, getVirtualMachine())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*getVirtualMachine().Spec.RunStrategy).Should(Equal(kubevirtv1.RunStrategyOnce))
+	})
+})
@@ -18,6 +18,9 @@ func AffinityForInstance(instance *cloudv1alpha1.Instance) (*k8sv1.Affinity, err
 	if err := util.UpdateAffinityForPartition(instance, affinity); err != nil {
 		return nil, err
 	}
+	if err := util.UpdateAffinityForNodeMaintenance(instance, affinity); err != nil {
+		return nil, err
+	}
 	if err := util.UpdateAffinityForNodeId(instance, affinity); err != nil {
 		return nil, err
 	}
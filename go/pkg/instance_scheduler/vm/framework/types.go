@@ -793,6 +793,9 @@ func (n *NodeInfo) FilterOutPods(pods []*v1.Pod) []*v1.Pod {
 
 const ResourceIdPodLabel string = "vm.kubevirt.io/name"
 
+// Label set by Kubevirt on the target pod of a live migration.
+const MigrationJobPodLabel string = "kubevirt.io/migrationJobUID"
+
 // GetPodKey returns the string key of a pod.
 // This is used to match scheduled pods with running pods so that the reserved resources are not double counted.
 func GetPodKey(pod *v1.Pod) (string, error) {
@@ -801,6 +804,11 @@ func GetPodKey(pod *v1.Pod) (string, error) {
 	if pod.Labels != nil {
 		resourceId, ok := pod.Labels[ResourceIdPodLabel]
 		if ok {
+			// During a live migration, Kubevirt runs a target pod with the same resourceId label next to the source pod.
+			// The target pod has a separate key so that both pods are counted until the source pod is deleted.
+			if migrationJobUid, ok := pod.Labels[MigrationJobPodLabel]; ok {
+				return resourceId + "/" + migrationJobUid, nil
+			}
 			return resourceId, nil
 		}
 	}
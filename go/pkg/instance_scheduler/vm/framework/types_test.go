@@ -1127,3 +1127,56 @@ func TestGetNamespacesFromPodAffinityTerm(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPodKey(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *v1.Pod
+		want string
+	}{
+		{
+			name: "instance_pod",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:    types.UID("uid1"),
+					Labels: map[string]string{ResourceIdPodLabel: "resourceId1"},
+				},
+			},
+			want: "resourceId1",
+		},
+		{
+			name: "migration_target_pod",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: types.UID("uid2"),
+					Labels: map[string]string{
+						ResourceIdPodLabel:   "resourceId1",
+						MigrationJobPodLabel: "migration1",
+					},
+				},
+			},
+			want: "resourceId1/migration1",
+		},
+		{
+			name: "other_pod",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: types.UID("uid3"),
+				},
+			},
+			want: "uid3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := GetPodKey(test.pod)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
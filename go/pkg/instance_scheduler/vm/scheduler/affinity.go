@@ -23,6 +23,9 @@ func AffinityForInstanceScheduling(instance *cloudv1alpha1.Instance, allowInGrou
 		if err := instanceoperatorutil.UpdateAffinityForInstanceType(instance, affinity); err != nil {
 			return nil, err
 		}
+		if err := instanceoperatorutil.UpdateAffinityForNodeMaintenance(instance, affinity); err != nil {
+			return nil, err
+		}
 	}
 
 	// Ensure the instance runs on nodes that possess given pool-labels
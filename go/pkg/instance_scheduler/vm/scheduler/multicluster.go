@@ -14,6 +14,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/instance_scheduler/vm/framework"
 	metal3Informerfactory "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/generated/metal3client/informers/externalversions"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
@@ -61,6 +62,18 @@ func (sched *Scheduler) WaitForCacheSync(ctx context.Context) error {
 
 const nodeIdSeparator = "/"
 
+// Pod label with the clusterId of the instance, if the instance has a preset clusterId.
+// See convert.InstanceConverter.PbToK8s.
+const clusterIdPodLabel = "cluster-id"
+
+// Pod label with the nodeId of the instance, if the instance has already been scheduled.
+// See convert.InstanceConverter.PbToK8s.
+const nodeIdPodLabel = "node-id"
+
+// Pod label with the nodeId that the instance is moving off.
+// This is only set on pods of instances that are being migrated.
+const migrateFromNodeIdPodLabel = "migrate-from-node-id"
+
 // Ensure nodeName has "clusterId/" prefix.
 func nodeNameWithClusterId(clusterId string, nodeName string) string {
 	if _, _, err := extractClusterFromNodeName(nodeName); err != nil {
@@ -87,3 +100,56 @@ func extractClusterFromNodeName(nodeName string) (clusterId string, nodeId strin
 	nodeId = components[1]
 	return
 }
+
+// Returns the nodes that belong to the cluster.
+func filterNodesByClusterId(nodes []*framework.NodeInfo, clusterId string) []*framework.NodeInfo {
+	filtered := make([]*framework.NodeInfo, 0, len(nodes))
+	for _, node := range nodes {
+		nodeClusterId, _, err := extractClusterFromNodeName(node.Node().Name)
+		if err == nil && nodeClusterId == clusterId {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
+// Returns the nodes other than nodeName.
+func filterOutNode(nodes []*framework.NodeInfo, nodeName string) []*framework.NodeInfo {
+	filtered := make([]*framework.NodeInfo, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Node().Name != nodeName {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
+// Returns the node that an existing instance runs on, as if the instance was not running.
+// This is used to check whether the instance still fits on its node after it is changed.
+// The returned NodeInfo is a copy so the scheduler cache is not modified.
+func nodeWithoutPod(ctx context.Context, nodes []*framework.NodeInfo, nodeName string, pod *corev1.Pod) ([]*framework.NodeInfo, error) {
+	podKey, err := framework.GetPodKey(pod)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		if node.Node().Name != nodeName {
+			continue
+		}
+		clone := node.Clone()
+		clone.PVCRefCounts = make(map[string]int, len(node.PVCRefCounts))
+		for k, v := range node.PVCRefCounts {
+			clone.PVCRefCounts[k] = v
+		}
+		for _, podInfo := range node.Pods {
+			key, err := framework.GetPodKey(podInfo.Pod)
+			if err == nil && key == podKey {
+				if err := clone.RemovePod(ctx, podInfo.Pod); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return []*framework.NodeInfo{clone}, nil
+	}
+	return []*framework.NodeInfo{}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/google/uuid"
 	bmenroll "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/baremetal_enrollment/tasks"
 	enroll "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/baremetal_enrollment/tasks"
 	instanceoperatorutil "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/instance_operator/util"
@@ -397,7 +398,8 @@ func (sched *Scheduler) getGPUStatistics(ctx context.Context, nodeInfo *framewor
 }
 
 // Schedule resources (1 or more instances).
-func (sched *Scheduler) Schedule(ctx context.Context, instances []*pb.InstancePrivate, dryRun bool) (*pb.ScheduleResponse, error) {
+// If migrate is true, instances that have already been scheduled are placed on another node in their cluster.
+func (sched *Scheduler) Schedule(ctx context.Context, instances []*pb.InstancePrivate, dryRun bool, migrate bool) (*pb.ScheduleResponse, error) {
 	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("Scheduler.Schedule").Start()
 	defer span.End()
 	log.Info("Request", logkeys.InstanceCount, len(instances))
@@ -426,13 +428,36 @@ func (sched *Scheduler) Schedule(ctx context.Context, instances []*pb.InstancePr
 				return nil, err
 			}
 			log.Info("podToSchedule request", logkeys.InstanceIndex, instanceIndex, logkeys.Pod, pod)
-			scheduleResult, err := sched.scheduleOnePod(ctx, pod, requestedSize)
-			if err != nil {
-				return nil, err
+			var scheduleResult ScheduleResult
+			if instance.Spec.NodeId != "" && !migrate {
+				if !dryRun {
+					return nil, fmt.Errorf("instance %s has already been scheduled", instance.Metadata.ResourceId)
+				}
+				// The instance is already running on a node. Only check whether the changed instance still fits.
+				scheduleResult, err = sched.fitOnePod(ctx, pod)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				if instance.Spec.NodeId != "" {
+					// The instance is moving off its node. Find another node in the same cluster.
+					if instance.Spec.ClusterId == "" {
+						return nil, fmt.Errorf("instance %s cannot be migrated without a clusterId", instance.Metadata.ResourceId)
+					}
+					pod.Labels[migrateFromNodeIdPodLabel] = instance.Spec.NodeId
+					delete(pod.Labels, nodeIdPodLabel)
+					// Resources on the new node are reserved with a pod that is counted separately from the running pod,
+					// like the target pod of the live migration. The reservation expires after the target pod has been created.
+					pod.Labels[framework.MigrationJobPodLabel] = uuid.NewString()
+				}
+				scheduleResult, err = sched.scheduleOnePod(ctx, pod, requestedSize)
+				if err != nil {
+					return nil, err
+				}
+				pod.Spec.NodeName = scheduleResult.SuggestedHost
+				assumedPods = append(assumedPods, pod)
 			}
 			log.Info("podToSchedule result", logkeys.InstanceIndex, instanceIndex, logkeys.Result, scheduleResult)
-			pod.Spec.NodeName = scheduleResult.SuggestedHost
-			assumedPods = append(assumedPods, pod)
 			clusterId, nodeId, err := extractClusterFromNodeName(scheduleResult.SuggestedHost)
 			if err != nil {
 				return nil, err
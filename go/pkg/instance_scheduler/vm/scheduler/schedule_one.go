@@ -182,6 +182,27 @@ func (sched *Scheduler) scheduleOnePod(ctx context.Context, pod *v1.Pod, request
 	return scheduleResult, nil
 }
 
+// fitOnePod checks whether a pod of an existing instance fits on the node that runs the instance.
+// Unlike scheduleOnePod, the pod is not assumed since the cache already has the running pod.
+func (sched *Scheduler) fitOnePod(ctx context.Context, pod *v1.Pod) (ScheduleResult, error) {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("Scheduler.fitOnePod").Start()
+	defer span.End()
+	sched.scheduleOneLock.Lock()
+	defer sched.scheduleOneLock.Unlock()
+
+	fwk, err := sched.frameworkForPod(ctx, pod)
+	if err != nil {
+		return ScheduleResult{}, err
+	}
+	state := framework.NewCycleState()
+	scheduleResult, err := sched.SchedulePod(ctx, fwk, state, pod, 1)
+	if err != nil {
+		log.Error(err, "Pod of existing instance does not fit", logkeys.Pod, pod)
+		return ScheduleResult{}, err
+	}
+	return scheduleResult, nil
+}
+
 func (sched *Scheduler) frameworkForPod(ctx context.Context, pod *v1.Pod) (framework.Framework, error) {
 	log := log.FromContext(ctx).WithName("Scheduler.frameworkForPod")
 	fwk, ok := sched.Profiles[pod.Spec.SchedulerName]
@@ -323,6 +344,21 @@ func (sched *Scheduler) findNodesThatFitPod(ctx context.Context, fwk framework.F
 			nodes = append(nodes, nInfo)
 		}
 	}
+	// An instance with a preset cluster, such as one launched from a custom machine image, must run in that cluster.
+	if clusterId := pod.Labels[clusterIdPodLabel]; clusterId != "" {
+		nodes = filterNodesByClusterId(nodes, clusterId)
+		// An instance that has already been scheduled, such as one being resized, must fit on its node.
+		if nodeId := pod.Labels[nodeIdPodLabel]; nodeId != "" {
+			nodes, err = nodeWithoutPod(ctx, nodes, nodeNameWithClusterId(clusterId, nodeId), pod)
+			if err != nil {
+				return nil, diagnosis, err
+			}
+		}
+		// An instance that is being migrated, such as one on a node under maintenance, must move to another node.
+		if nodeId := pod.Labels[migrateFromNodeIdPodLabel]; nodeId != "" {
+			nodes = filterOutNode(nodes, nodeNameWithClusterId(clusterId, nodeId))
+		}
+	}
 	feasibleNodes, err := sched.findNodesThatPassFilters(ctx, fwk, state, pod, diagnosis, nodes)
 	if err != nil {
 		return nil, diagnosis, err
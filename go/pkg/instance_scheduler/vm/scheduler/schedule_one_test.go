@@ -6,9 +6,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	bmenroll "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/baremetal_enrollment/tasks"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/instance_scheduler/vm/framework"
 	cloudv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/apis/private.cloud/v1alpha1"
 )
 
@@ -158,3 +160,82 @@ func TestBinpackBmNodes(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterNodesByClusterId(t *testing.T) {
+	newNodeInfo := func(name string) *framework.NodeInfo {
+		nodeInfo := framework.NewNodeInfo()
+		nodeInfo.SetNode(&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		})
+		return nodeInfo
+	}
+	node1 := newNodeInfo("cluster1/node1")
+	node2 := newNodeInfo("cluster2/node2")
+	node3 := newNodeInfo("cluster1/node3")
+	nodes := []*framework.NodeInfo{node1, node2, node3}
+
+	assert.Equal(t, []*framework.NodeInfo{node1, node3}, filterNodesByClusterId(nodes, "cluster1"))
+	assert.Equal(t, []*framework.NodeInfo{node2}, filterNodesByClusterId(nodes, "cluster2"))
+	assert.Empty(t, filterNodesByClusterId(nodes, "cluster3"))
+}
+
+func TestFilterOutNode(t *testing.T) {
+	newNodeInfo := func(name string) *framework.NodeInfo {
+		nodeInfo := framework.NewNodeInfo()
+		nodeInfo.SetNode(&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		})
+		return nodeInfo
+	}
+	node1 := newNodeInfo("cluster1/node1")
+	node2 := newNodeInfo("cluster1/node2")
+	nodes := []*framework.NodeInfo{node1, node2}
+
+	assert.Equal(t, []*framework.NodeInfo{node2}, filterOutNode(nodes, "cluster1/node1"))
+	assert.Equal(t, []*framework.NodeInfo{node1, node2}, filterOutNode(nodes, "cluster1/node3"))
+}
+
+func TestNodeWithoutPod(t *testing.T) {
+	ctx := context.Background()
+	newPod := func(resourceId string, cpu string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "virt-launcher-" + resourceId,
+				Labels: map[string]string{framework.ResourceIdPodLabel: resourceId},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+					},
+				}},
+			},
+		}
+	}
+	pod1 := newPod("instance1", "2")
+	pod2 := newPod("instance2", "4")
+	node1 := framework.NewNodeInfo(pod1, pod2)
+	node1.SetNode(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cluster1/node1"}})
+	node2 := framework.NewNodeInfo()
+	node2.SetNode(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cluster1/node2"}})
+	nodes := []*framework.NodeInfo{node1, node2}
+
+	resizedPod := newPod("instance1", "8")
+	filtered, err := nodeWithoutPod(ctx, nodes, "cluster1/node1", resizedPod)
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "cluster1/node1", filtered[0].Node().Name)
+	assert.Len(t, filtered[0].Pods, 1)
+	assert.Equal(t, int64(4000), filtered[0].Requested.MilliCPU)
+	// The cached node is unchanged.
+	assert.Len(t, node1.Pods, 2)
+	assert.Equal(t, int64(6000), node1.Requested.MilliCPU)
+
+	filtered, err = nodeWithoutPod(ctx, nodes, "cluster2/node1", resizedPod)
+	assert.NoError(t, err)
+	assert.Empty(t, filtered)
+}
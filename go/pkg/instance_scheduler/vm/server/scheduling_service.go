@@ -229,7 +229,7 @@ func (s *SchedulingService) Schedule(ctx context.Context, req *pb.ScheduleReques
 
 	logger.Info("Request", logkeys.Request, req)
 	resp, err := func() (*pb.ScheduleResponse, error) {
-		return s.Sched.Schedule(ctx, req.Instances, req.DryRun)
+		return s.Sched.Schedule(ctx, req.Instances, req.DryRun, req.Migrate)
 	}()
 	log.LogResponseOrError(logger, req, resp, err)
 	return resp, sanitizeScheduleError(err)
@@ -523,6 +523,46 @@ var _ = Describe("VM Instance Scheduler", func() {
 		Expect(err.Error()).Should(MatchRegexp(".*" + server.InsufficientResourcesError))
 	})
 
+	It("Migrating instances should reserve resources on the new node (1 cluster, 2 nodes)", func() {
+		schedulerTestEnv := NewSchedulerTestEnv(SchedulerOptions{
+			Clusters: []ClusterOptions{{
+				Nodes: []*NodeOptions{
+					{
+						AllocatableCpu:    "10",
+						AllocatableMemory: "10Gi",
+					},
+					{
+						AllocatableCpu:    "10",
+						AllocatableMemory: "10Gi",
+					},
+				},
+			}},
+		})
+		defer schedulerTestEnv.Stop()
+		schedulerTestEnv.Start()
+
+		newMigrateRequest := func(name string) *pb.ScheduleRequest {
+			req := NewScheduleRequest(name, "tiny", 4, "8Gi")
+			req.Instances[0].Spec.ClusterId = "cluster0"
+			req.Instances[0].Spec.NodeId = "node0"
+			req.Migrate = true
+			return req
+		}
+
+		By("Migrating 1st instance should succeed, on node1")
+		resp, err := schedulerTestEnv.ScheduleOneInstance(ctx, newMigrateRequest("i1"))
+		Expect(err).Should(Succeed())
+		log.Info("resp", "resp", resp)
+		Expect(resp.ClusterId).Should(Equal("cluster0"))
+		Expect(resp.NodeId).Should(Equal("node1"))
+
+		By("Migrating 2nd instance should fail because resources on node1 are reserved for the 1st instance")
+		resp, err = schedulerTestEnv.ScheduleOneInstance(ctx, newMigrateRequest("i2"))
+		log.Info("resp", "resp", resp)
+		Expect(err).ShouldNot(Succeed())
+		Expect(err.Error()).Should(MatchRegexp(".*" + server.InsufficientResourcesError))
+	})
+
 	It("Scheduler cache should be consistent when instance runs on a node different from what was scheduled. (1 cluster, 2 nodes, 1 pod)", func() {
 		schedulerTestEnv := NewSchedulerTestEnv(SchedulerOptions{
 			Clusters: []ClusterOptions{{
@@ -36,6 +36,29 @@ type InstanceSpec struct {
 	InstanceGroupSize         int32                       `json:"instanceGroupSize,omitempty"`
 	ComputeNodePools          []string                    `json:"computeNodePools,omitempty"`
 	QuickConnectEnabled       string                      `json:"quickConnectEnabled,omitempty"`
+	Metering                  *InstanceMetering           `json:"metering,omitempty"`
+}
+
+// Metering records for an instance are cumulative for each metering resourceId.
+// When the instance type changes, the previous instance type is metered until the change
+// and the new instance type is metered with a new metering resourceId from the time of the change.
+type InstanceMetering struct {
+	// The resourceId used in metering records. If empty, the instance resourceId is used.
+	ResourceId string `json:"resourceId,omitempty"`
+	// Metering of the current instance type starts at this time.
+	// If empty, metering starts when the instance first becomes ready.
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// The metering segments of previous instance types, oldest first.
+	// A segment is kept until the metering monitor has written its final metering record.
+	PreviousSegments []InstanceMeteringSegment `json:"previousSegments,omitempty"`
+}
+
+type InstanceMeteringSegment struct {
+	ResourceId   string `json:"resourceId,omitempty"`
+	InstanceType string `json:"instanceType,omitempty"`
+	// If empty, the segment started when the instance first became ready.
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time `json:"endTimestamp,omitempty"`
 }
 
 // RunStrategy is a label for the requested Instance Running State.
@@ -110,6 +133,8 @@ type MachineImageSpec struct {
 	Md5sum    string `json:"md5sum,omitempty"`
 	Sha256sum string `json:"sha256sum,omitempty"`
 	Sha512sum string `json:"sha512sum,omitempty"`
+	// The name of the image volume in the VM cluster. If empty, InstanceSpec.MachineImage is used.
+	StorageName string `json:"storageName,omitempty"`
 }
 
 type SshPublicKeySpec struct {
@@ -134,6 +159,8 @@ type InstanceStatus struct {
 	Conditions []InstanceCondition       `json:"conditions,omitempty"`
 	SshProxy   SshProxyTunnelStatus      `json:"sshProxy,omitempty"`
 	UserName   string                    `json:"userName,omitempty"`
+	// The metering resourceIds of previous metering segments whose final metering record has been written.
+	ClosedMeteringResourceIds []string `json:"closedMeteringResourceIds,omitempty"`
 }
 
 type InstanceInterfaceStatus struct {
@@ -146,6 +173,11 @@ type InstanceInterfaceStatus struct {
 	Subnet    string   `json:"subnet"`
 	Gateway   string   `json:"gateway"`
 	VlanId    int      `json:"vlanId"`
+	// List of IPv6 addresses. Empty if the subnet is not dual-stack.
+	Ipv6Addresses    []string `json:"ipv6Addresses,omitempty"`
+	Ipv6Subnet       string   `json:"ipv6Subnet,omitempty"`
+	Ipv6PrefixLength int      `json:"ipv6PrefixLength,omitempty"`
+	Ipv6Gateway      string   `json:"ipv6Gateway,omitempty"`
 }
 
 type InstanceConditionType string
@@ -193,6 +225,9 @@ const (
 
 	// The instance has verified SSH access and has completed SSH ping
 	InstanceConditionVerifiedSshAccess InstanceConditionType = "VerifiedSshAccess"
+
+	// The instance is being moved to another node because its node is under maintenance.
+	InstanceConditionMigrating InstanceConditionType = "Migrating"
 )
 
 // These are the prefixes of InstanceStatus.Message. The field may have additional details.
@@ -208,6 +243,7 @@ const (
 	InstanceMessageStopping                string = "The instance is stopping"
 	InstanceMessageStarting                string = "The instance is starting"
 	InstanceMessageStarted                 string = "The instance has completed startup and is available to use"
+	InstanceMessageMigrating               string = "The instance is being moved to another node"
 )
 
 type ConditionReason string
@@ -216,6 +252,12 @@ const (
 	ConditionReasonNone        ConditionReason = ""
 	ConditionReasonNotAccepted ConditionReason = "NotAccepted"
 	ConditionReasonAccepted    ConditionReason = "Accepted"
+	// The instance is being stopped and started to change its instance type.
+	ConditionReasonResizing ConditionReason = "Resizing"
+	// The instance is being moved to another node because its node is under maintenance.
+	ConditionReasonMigrating ConditionReason = "Migrating"
+	// The instance is being stopped or started because its run strategy was changed.
+	ConditionReasonRunStrategy ConditionReason = "RunStrategy"
 )
 
 type InstanceCondition struct {
@@ -30,6 +30,13 @@ type StorageMeteringMonitorConfig struct {
 
 	// Region
 	Region string `json:"region"`
+
+	// The address of the storage api server in format "host:port".
+	// Snapshot usage records are only sent when set.
+	StorageAPIServerAddr string `json:"storageAPIServerAddr,omitempty"`
+
+	// product catalog match expression value for filesystem snapshots
+	SnapshotServiceType string `json:"snapshotServiceType,omitempty"`
 }
 
 func init() {
@@ -813,6 +813,11 @@ func (in *InstanceInterfaceStatus) DeepCopyInto(out *InstanceInterfaceStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Ipv6Addresses != nil {
+		in, out := &in.Ipv6Addresses, &out.Ipv6Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceInterfaceStatus.
@@ -857,6 +862,55 @@ func (in *InstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMetering) DeepCopyInto(out *InstanceMetering) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousSegments != nil {
+		in, out := &in.PreviousSegments, &out.PreviousSegments
+		*out = make([]InstanceMeteringSegment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetering.
+func (in *InstanceMetering) DeepCopy() *InstanceMetering {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMetering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMeteringSegment) DeepCopyInto(out *InstanceMeteringSegment) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMeteringSegment.
+func (in *InstanceMeteringSegment) DeepCopy() *InstanceMeteringSegment {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMeteringSegment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceOperatorConfig) DeepCopyInto(out *InstanceOperatorConfig) {
 	*out = *in
@@ -966,6 +1020,11 @@ func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Metering != nil {
+		in, out := &in.Metering, &out.Metering
+		*out = new(InstanceMetering)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
@@ -996,6 +1055,11 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 		}
 	}
 	out.SshProxy = in.SshProxy
+	if in.ClosedMeteringResourceIds != nil {
+		in, out := &in.ClosedMeteringResourceIds, &out.ClosedMeteringResourceIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
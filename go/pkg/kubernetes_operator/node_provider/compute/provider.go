@@ -15,6 +15,7 @@ import (
 	nodeprovider "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/kubernetes_operator/node_provider"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	"github.com/pborman/uuid"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -186,19 +187,27 @@ func (p *ComputeProvider) GetNodes(ctx context.Context, selector string, cloudac
 
 	// TODO: currently compute api doesn't suppor filtering instances by labels, once supported
 	// we can stop iterating over the list of instances to find those that belong to the nodegroup.
-	instances, err := p.ComputeClient.Search(ctx, &pb.InstanceSearchRequest{
-		Metadata: &pb.InstanceMetadataSearch{
-			CloudAccountId: cloudaccountid,
-			Labels: map[string]string{
-				nodegroupNameLabelKey: selector,
+	instances, err := protodb.SearchAllPages(func(pageToken string) ([]*pb.Instance, string, error) {
+		resp, err := p.ComputeClient.Search(ctx, &pb.InstanceSearchRequest{
+			Metadata: &pb.InstanceMetadataSearch{
+				CloudAccountId: cloudaccountid,
+				Labels: map[string]string{
+					nodegroupNameLabelKey: selector,
+				},
 			},
-		},
+			PageSize:  protodb.MaxPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, instance := range instances.Items {
+	for _, instance := range instances {
 		if value, found := instance.Metadata.Labels[nodegroupNameLabelKey]; found {
 			if value == selector {
 				status := privatecloudv1alpha1.NodeStatus{
@@ -609,18 +618,24 @@ func (p *ComputeProvider) ScaleUpInstanceGroup(ctx context.Context, registration
 // SearchInstanceGroup looks for all the instance group members available for a cloud account from compute and validates the give instance group is still available
 func (p *ComputeProvider) SearchInstanceGroup(ctx context.Context, cloudaccountid string, instanceGroup string) (bool, error) {
 
-	instanceReq := &pb.InstanceGroupSearchRequest{
-		Metadata: &pb.InstanceGroupMetadataSearch{
-			CloudAccountId: cloudaccountid,
-		},
-	}
-
-	resp, err := p.InstanceGroupClient.Search(ctx, instanceReq)
+	instanceGroups, err := protodb.SearchAllPages(func(pageToken string) ([]*pb.InstanceGroup, string, error) {
+		resp, err := p.InstanceGroupClient.Search(ctx, &pb.InstanceGroupSearchRequest{
+			Metadata: &pb.InstanceGroupMetadataSearch{
+				CloudAccountId: cloudaccountid,
+			},
+			PageSize:  protodb.MaxPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
 	if err != nil {
 		return false, err
 	}
 
-	for _, instanceMembers := range resp.Items {
+	for _, instanceMembers := range instanceGroups {
 		if instanceMembers.Metadata.Name == instanceGroup {
 			return true, nil
 		}
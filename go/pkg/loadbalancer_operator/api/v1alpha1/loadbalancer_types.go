@@ -132,6 +132,9 @@ type LoadbalancerListener struct {
 type PoolStatusMember struct {
 	InstanceResourceId string `json:"instanceRef"`
 	IPAddress          string `json:"ip"`
+	// MonitorStatus is the health check status of the member reported by the provider.
+	// +optional
+	MonitorStatus string `json:"monitorStatus,omitempty"`
 }
 
 // LoadbalancerStatus defines the observed state of Loadbalancer
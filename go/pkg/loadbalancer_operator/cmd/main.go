@@ -43,6 +43,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	k8scontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -137,13 +138,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	// VIP claims are read and written without the manager cache, which would watch ConfigMaps of all namespaces.
+	claimClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		setupLog.Error(err, "unable to create vip claim client")
+		os.Exit(1)
+	}
+
 	// Initialize the Loadbalancer Provider API which is responsible for managing actual LBs via an API.
 	providerAPI, err := provider.NewLoadbalancerProvider(inputConfig.ProviderType, &provider.Config{
-		BaseURL:       inputConfig.BaseURL,
-		Domain:        inputConfig.Domain,
-		Configuration: config,
-		Environment:   inputConfig.Environment,
-		UserGroup:     inputConfig.UserGroup,
+		BaseURL:            inputConfig.BaseURL,
+		Domain:             inputConfig.Domain,
+		Configuration:      config,
+		Environment:        inputConfig.Environment,
+		UserGroup:          inputConfig.UserGroup,
+		VIPPool:            inputConfig.HAProxy.VIPPool,
+		RequestTimeout:     inputConfig.HAProxy.RequestTimeout,
+		InsecureSkipVerify: inputConfig.HAProxy.InsecureSkipVerify,
+		Client:             claimClient,
+		VIPClaimNamespace:  inputConfig.HAProxy.VIPClaimNamespace,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to initialize load balancer provider")
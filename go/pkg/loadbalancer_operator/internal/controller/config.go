@@ -2,11 +2,13 @@
 // Copyright (C) 2023 Intel Corporation
 package controller
 
+import "time"
+
 type LoadbalancerProviderConfig struct {
-	// URL of the api endpoint for LB API
+	// URL of the api endpoint for LB API. For the haproxy provider this is the HAProxy Data Plane API, eg. https://haproxy:5555/v2/
 	BaseURL string `json:"baseURL"`
 
-	// Type of LB provider.
+	// Type of LB provider, "highwire", "mock" or "haproxy".
 	ProviderType string `json:"providerType"`
 	Domain       string `json:"domain"`
 
@@ -32,6 +34,23 @@ type LoadbalancerProviderConfig struct {
 
 	// The availability zone in which the operator is deployed.
 	AvailabilityZoneId string `json:"availabilityZoneId"`
+
+	// Configuration of the haproxy provider.
+	HAProxy HAProxyProviderConfig `json:"haproxy"`
+}
+
+type HAProxyProviderConfig struct {
+	// IP addresses or CIDRs the VIPs of load balancers are allocated from.
+	VIPPool []string `json:"vipPool"`
+
+	// Timeout of requests to the HAProxy Data Plane API.
+	RequestTimeout time.Duration `json:"requestTimeout"`
+
+	// Skip verification of the HAProxy Data Plane API certificate.
+	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+
+	// Namespace of the ConfigMaps which claim the allocated VIPs.
+	VIPClaimNamespace string `json:"vipClaimNamespace"`
 }
 
 type LoadbalancerPoolConfig struct {
@@ -36,10 +36,11 @@ func (p *Processor) ReconcileListenerPoolMembers(ctx context.Context, namespace
 
 	var err error
 	var updatedStatusMessage string
+	var observedMembers []loadbalancerv1alpha1.PoolStatusMember
 
 	// Can only reconcile a pool if the pool exists
 	if listenerStatus.ListenerStatusConditions.PoolCreated {
-		updatedStatusMessage, _, err = p.LBProvider.ObserveCurrentAndReconcile(ctx, namespace, listener,
+		updatedStatusMessage, observedMembers, err = p.LBProvider.ObserveCurrentAndReconcile(ctx, namespace, listener,
 			listenerStatus.ListenerStatus.PoolID, poolMembersReady)
 		if err != nil {
 			log.Error(err, "failed to observe and reconcile: "+updatedStatusMessage)
@@ -47,6 +48,12 @@ func (p *Processor) ReconcileListenerPoolMembers(ctx context.Context, namespace
 		}
 	}
 
+	// Health check status of the members as reported by the provider.
+	monitorStatuses := make(map[string]string)
+	for _, member := range observedMembers {
+		monitorStatuses[member.InstanceResourceId] = member.MonitorStatus
+	}
+
 	// Update status of the pool members in the Load Balancer object
 	var poolStatusMembers []loadbalancerv1alpha1.PoolStatusMember
 	for _, instance := range poolMembersReady {
@@ -55,6 +62,7 @@ func (p *Processor) ReconcileListenerPoolMembers(ctx context.Context, namespace
 		poolStatusMembers = append(poolStatusMembers, loadbalancerv1alpha1.PoolStatusMember{
 			InstanceResourceId: instance.Name,
 			IPAddress:          instance.Status.Interfaces[0].Addresses[0],
+			MonitorStatus:      monitorStatuses[instance.Name],
 		})
 
 		// Add finalizers to all instances that are part of the pool if not already added
@@ -0,0 +1,318 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package haproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/internal/config"
+)
+
+// HAProxy Data Plane API (v2) end points, relative to the configured base URL.
+const (
+	configurationVersion = "services/haproxy/configuration/version"
+	transactions         = "services/haproxy/transactions"
+	frontends            = "services/haproxy/configuration/frontends"
+	backends             = "services/haproxy/configuration/backends"
+	binds                = "services/haproxy/configuration/binds"
+	servers              = "services/haproxy/configuration/servers"
+	runtimeServers       = "services/haproxy/runtime/servers"
+)
+
+const (
+	enabled  = "enabled"
+	disabled = "disabled"
+)
+
+type frontend struct {
+	Name           string `json:"name"`
+	ID             int    `json:"id,omitempty"`
+	Mode           string `json:"mode,omitempty"`
+	DefaultBackend string `json:"default_backend,omitempty"`
+}
+
+type bind struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Port    *int   `json:"port,omitempty"`
+}
+
+type balance struct {
+	Algorithm string `json:"algorithm"`
+}
+
+type httpCheckParams struct {
+	Method string `json:"method,omitempty"`
+	URI    string `json:"uri,omitempty"`
+}
+
+type backend struct {
+	Name          string           `json:"name"`
+	ID            int              `json:"id,omitempty"`
+	Mode          string           `json:"mode,omitempty"`
+	Balance       *balance         `json:"balance,omitempty"`
+	AdvCheck      string           `json:"adv_check,omitempty"`
+	HttpchkParams *httpCheckParams `json:"httpchk_params,omitempty"`
+	Allbackups    string           `json:"allbackups,omitempty"`
+}
+
+type server struct {
+	Name        string `json:"name"`
+	Address     string `json:"address"`
+	Port        *int   `json:"port,omitempty"`
+	Check       string `json:"check,omitempty"`
+	CheckSsl    string `json:"check-ssl,omitempty"`
+	Verify      string `json:"verify,omitempty"`
+	Maxconn     *int   `json:"maxconn,omitempty"`
+	Weight      *int   `json:"weight,omitempty"`
+	Backup      string `json:"backup,omitempty"`
+	Maintenance string `json:"maintenance,omitempty"`
+}
+
+type runtimeServer struct {
+	Name             string `json:"name"`
+	Address          string `json:"address"`
+	Port             *int   `json:"port,omitempty"`
+	AdminState       string `json:"admin_state"`
+	OperationalState string `json:"operational_state"`
+}
+
+type transaction struct {
+	ID      string `json:"id"`
+	Version int    `json:"_version"`
+	Status  string `json:"status"`
+}
+
+// apiError is returned when the Data Plane API responds with a non 2xx status code.
+type apiError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("haproxy data plane api returned status code: %d with message: %s", e.StatusCode, e.Message)
+}
+
+func isNotFound(err error) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// dataPlaneClient is a minimal client of the HAProxy Data Plane API.
+type dataPlaneClient struct {
+	baseURL       string
+	configuration *config.Configuration
+	httpClient    *http.Client
+}
+
+func newDataPlaneClient(baseURL string, configuration *config.Configuration, timeout time.Duration, insecureSkipVerify bool) *dataPlaneClient {
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: insecureSkipVerify}
+	return &dataPlaneClient{
+		baseURL:       baseURL,
+		configuration: configuration,
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		},
+	}
+}
+
+// do sends a request to the Data Plane API, json encoding in and decoding the response into out when they are not nil.
+func (c *dataPlaneClient) do(ctx context.Context, method string, path string, query url.Values, in interface{}, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("%s: %w", MarshallingFailed, err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	// The credentials are read on every request so that rotated secrets are picked up.
+	if c.configuration != nil {
+		username, err := c.configuration.GetAPIUsername()
+		if err != nil {
+			return err
+		}
+		password, err := c.configuration.GetAPIPassword()
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(strings.TrimSpace(username), strings.TrimSpace(password))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ReadResponseFailed, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message := strings.TrimSpace(string(respBody))
+		var errResp struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(respBody, &errResp) == nil && errResp.Message != "" {
+			message = errResp.Message
+		}
+		return &apiError{StatusCode: resp.StatusCode, Message: message}
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("%s: %w", UnmarshallingFailed, err)
+		}
+	}
+	return nil
+}
+
+func (c *dataPlaneClient) getVersion(ctx context.Context) (int, error) {
+	var version int
+	if err := c.do(ctx, http.MethodGet, configurationVersion, nil, nil, &version); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// withTransaction runs fn in a new configuration transaction which is committed when fn succeeds and deleted otherwise.
+func (c *dataPlaneClient) withTransaction(ctx context.Context, fn func(transactionID string) error) error {
+	version, err := c.getVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	var t transaction
+	if err := c.do(ctx, http.MethodPost, transactions, url.Values{"version": {strconv.Itoa(version)}}, nil, &t); err != nil {
+		return err
+	}
+
+	if err := fn(t.ID); err != nil {
+		if deleteErr := c.do(ctx, http.MethodDelete, transactions+"/"+t.ID, nil, nil, nil); deleteErr != nil && !isNotFound(deleteErr) {
+			return fmt.Errorf("%w (failed to delete transaction %s: %v)", err, t.ID, deleteErr)
+		}
+		return err
+	}
+
+	return c.do(ctx, http.MethodPut, transactions+"/"+t.ID, nil, nil, nil)
+}
+
+func (c *dataPlaneClient) listFrontends(ctx context.Context) ([]frontend, error) {
+	var resp struct {
+		Data []frontend `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, frontends, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *dataPlaneClient) createFrontend(ctx context.Context, transactionID string, fe frontend) error {
+	return c.do(ctx, http.MethodPost, frontends, url.Values{"transaction_id": {transactionID}}, fe, nil)
+}
+
+func (c *dataPlaneClient) replaceFrontend(ctx context.Context, transactionID string, fe frontend) error {
+	return c.do(ctx, http.MethodPut, frontends+"/"+url.PathEscape(fe.Name), url.Values{"transaction_id": {transactionID}}, fe, nil)
+}
+
+func (c *dataPlaneClient) deleteFrontend(ctx context.Context, transactionID string, name string) error {
+	return c.do(ctx, http.MethodDelete, frontends+"/"+url.PathEscape(name), url.Values{"transaction_id": {transactionID}}, nil, nil)
+}
+
+func (c *dataPlaneClient) listBinds(ctx context.Context, frontendName string) ([]bind, error) {
+	var resp struct {
+		Data []bind `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, binds, url.Values{"frontend": {frontendName}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *dataPlaneClient) createBind(ctx context.Context, transactionID string, frontendName string, b bind) error {
+	return c.do(ctx, http.MethodPost, binds, url.Values{"frontend": {frontendName}, "transaction_id": {transactionID}}, b, nil)
+}
+
+func (c *dataPlaneClient) listBackends(ctx context.Context) ([]backend, error) {
+	var resp struct {
+		Data []backend `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, backends, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *dataPlaneClient) createBackend(ctx context.Context, transactionID string, be backend) error {
+	return c.do(ctx, http.MethodPost, backends, url.Values{"transaction_id": {transactionID}}, be, nil)
+}
+
+func (c *dataPlaneClient) replaceBackend(ctx context.Context, transactionID string, be backend) error {
+	return c.do(ctx, http.MethodPut, backends+"/"+url.PathEscape(be.Name), url.Values{"transaction_id": {transactionID}}, be, nil)
+}
+
+func (c *dataPlaneClient) deleteBackend(ctx context.Context, transactionID string, name string) error {
+	return c.do(ctx, http.MethodDelete, backends+"/"+url.PathEscape(name), url.Values{"transaction_id": {transactionID}}, nil, nil)
+}
+
+func (c *dataPlaneClient) listServers(ctx context.Context, backendName string) ([]server, error) {
+	var resp struct {
+		Data []server `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, servers, url.Values{"backend": {backendName}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *dataPlaneClient) createServer(ctx context.Context, transactionID string, backendName string, s server) error {
+	return c.do(ctx, http.MethodPost, servers, url.Values{"backend": {backendName}, "transaction_id": {transactionID}}, s, nil)
+}
+
+func (c *dataPlaneClient) replaceServer(ctx context.Context, transactionID string, backendName string, s server) error {
+	return c.do(ctx, http.MethodPut, servers+"/"+url.PathEscape(s.Name), url.Values{"backend": {backendName}, "transaction_id": {transactionID}}, s, nil)
+}
+
+func (c *dataPlaneClient) deleteServer(ctx context.Context, transactionID string, backendName string, name string) error {
+	return c.do(ctx, http.MethodDelete, servers+"/"+url.PathEscape(name), url.Values{"backend": {backendName}, "transaction_id": {transactionID}}, nil, nil)
+}
+
+func (c *dataPlaneClient) listRuntimeServers(ctx context.Context, backendName string) ([]runtimeServer, error) {
+	var resp []runtimeServer
+	if err := c.do(ctx, http.MethodGet, runtimeServers, url.Values{"backend": {backendName}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
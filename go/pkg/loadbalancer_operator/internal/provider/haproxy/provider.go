@@ -0,0 +1,928 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+
+// Package haproxy provides a load balancer provider which renders Loadbalancer listeners into HAProxy frontends
+// and backends using the HAProxy Data Plane API.
+//
+// Every listener of a Loadbalancer is rendered as a frontend (the virtual server) bound to the VIP and port of the
+// listener, and a backend (the pool) with a server per pool member. The numeric HAProxy proxy ids of the frontend and
+// backend are used as the VipID and PoolID of the listener status.
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	firewallv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/firewall_operator/api/v1alpha1"
+	cloudv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/apis/private.cloud/v1alpha1"
+	loadbalancerv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/api/v1alpha1"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/internal/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type stdMessage string
+
+const (
+	//Failures
+	MarshallingFailed           stdMessage = "failed to marshal the data"
+	ReadResponseFailed          stdMessage = "failed to read the response from http request"
+	UnmarshallingFailed         stdMessage = "failed to unmarshal the data"
+	PoolCreationFailed          stdMessage = "failed to create the pool"
+	VirtualServerFailed         stdMessage = "failed to create the virtual server"
+	VirtualServerToPoolFailed   stdMessage = "failed to link Virtual Server to desired Pool"
+	PoolUpdateFailed            stdMessage = "failed to update the pool"
+	LoadbalancerDeletionFailed  stdMessage = "failed to delete the load balancer"
+	VirtualServerDeletionFailed stdMessage = "failed to delete the desired virtual server"
+	//success
+	PoolCreationSucceeded          stdMessage = "successfully created the desired pool"
+	VirtualServerCreationSucceeded stdMessage = "successfully created the desired virtual server"
+	VirtualServerToPoolSucceeded   stdMessage = "successfully linked virtual server to the pool"
+	PoolUpdateSucceeded            stdMessage = "successfully updates the desired pool"
+	LoadbalancerDeletionSucceeded  stdMessage = "successfully deleted the load balancer"
+)
+
+const (
+	frontendPrefix = "fe"
+	backendPrefix  = "be"
+	vipBindName    = "vip"
+
+	// Proxy ids assigned by the provider start at firstProxyID to stay clear of the ids HAProxy assigns to
+	// statically configured proxies.
+	firstProxyID = 1000
+
+	defaultRequestTimeout = 30 * time.Second
+)
+
+// HAProxyProvider manages load balancers through the HAProxy Data Plane API.
+type HAProxyProvider struct {
+	client  *dataPlaneClient
+	vipPool []netip.Prefix
+	// claims stores the VIP claims in claimNamespace.
+	claims         client.Client
+	claimNamespace string
+	// mu serializes configuration changes, the Data Plane API rejects a transaction when the configuration
+	// version it was started from has changed.
+	mu sync.Mutex
+}
+
+// NewHAProxyProvider creates a provider for the Data Plane API at baseURL (for example https://haproxy:5555/v2/).
+// The API credentials are read from configuration, and VIPs are allocated from vipPool, a list of IP addresses or CIDRs.
+// Allocated VIPs are claimed with ConfigMaps in claimNamespace.
+func NewHAProxyProvider(baseURL string, configuration *config.Configuration, vipPool []string, requestTimeout time.Duration, insecureSkipVerify bool,
+	claims client.Client, claimNamespace string) (*HAProxyProvider, error) {
+	if baseURL == "" {
+		return nil, fmt.Errorf("haproxy data plane api base url is required")
+	}
+	if len(vipPool) == 0 {
+		return nil, fmt.Errorf("haproxy vip pool is required")
+	}
+	if claims == nil || claimNamespace == "" {
+		return nil, fmt.Errorf("haproxy vip claim client and namespace are required")
+	}
+
+	var prefixes []netip.Prefix
+	for _, entry := range vipPool {
+		prefix, err := parseVIPPoolEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	return &HAProxyProvider{
+		client:         newDataPlaneClient(baseURL, configuration, requestTimeout, insecureSkipVerify),
+		vipPool:        prefixes,
+		claims:         claims,
+		claimNamespace: claimNamespace,
+	}, nil
+}
+
+func parseVIPPoolEntry(entry string) (netip.Prefix, error) {
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid vip pool entry %s: %w", entry, err)
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid vip pool entry %s: %w", entry, err)
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// GetStatus calculates the status of the load balancer from the frontends and backends which exist in HAProxy.
+func (hp *HAProxyProvider) GetStatus(ctx context.Context, loadbalancer *loadbalancerv1alpha1.Loadbalancer, fwRules []firewallv1alpha1.FirewallRule) error {
+	log := log.FromContext(ctx).WithName("HAProxyProvider.GetStatus")
+
+	fes, err := hp.client.listFrontends(ctx)
+	if err != nil {
+		return err
+	}
+	bes, err := hp.client.listBackends(ctx)
+	if err != nil {
+		return err
+	}
+
+	frontendsByName := make(map[string]frontend)
+	for _, fe := range fes {
+		frontendsByName[fe.Name] = fe
+	}
+	backendsByName := make(map[string]backend)
+	for _, be := range bes {
+		backendsByName[be.Name] = be
+	}
+
+	// Pool members are calculated by the processor, keep the last known members of each listener.
+	previousPoolMembers := make(map[int][]loadbalancerv1alpha1.PoolStatusMember)
+	for _, listenerStatus := range loadbalancer.Status.Listeners {
+		previousPoolMembers[listenerStatus.Port] = listenerStatus.PoolMembers
+	}
+
+	status := loadbalancerv1alpha1.LoadbalancerStatus{
+		Vip: loadbalancer.Status.Vip,
+		Conditions: loadbalancerv1alpha1.ConditionsStatus{
+			Listeners:           []loadbalancerv1alpha1.ConditionsListenerStatus{},
+			FirewallRuleCreated: firewallRulesCreated(loadbalancer, fwRules),
+		},
+		Listeners: []loadbalancerv1alpha1.ListenerStatus{},
+	}
+
+	vipFound := false
+	allListenersReady := true
+	for _, listener := range loadbalancer.Spec.Listeners {
+		port := listener.VIP.Port
+		conditions := loadbalancerv1alpha1.ConditionsListenerStatus{Port: port}
+		listenerStatus := loadbalancerv1alpha1.ListenerStatus{
+			Port:        port,
+			Name:        fmt.Sprintf("%s-%d", loadbalancer.Name, port),
+			State:       loadbalancerv1alpha1.PENDING,
+			Message:     "provisioning",
+			PoolMembers: previousPoolMembers[port],
+		}
+
+		fe, feFound := frontendsByName[proxyName(frontendPrefix, loadbalancer.Namespace, loadbalancer.Name, port)]
+		if feFound {
+			conditions.VIPCreated = true
+			listenerStatus.VipID = fe.ID
+
+			if !vipFound {
+				bs, err := hp.client.listBinds(ctx, fe.Name)
+				if err != nil {
+					return err
+				}
+				for _, b := range bs {
+					if b.Name == vipBindName {
+						status.Vip = b.Address
+						vipFound = true
+					}
+				}
+			}
+		}
+
+		be, beFound := backendsByName[proxyName(backendPrefix, loadbalancer.Namespace, loadbalancer.Name, port)]
+		if beFound {
+			conditions.PoolCreated = true
+			listenerStatus.PoolID = be.ID
+		}
+
+		if feFound && beFound && fe.DefaultBackend == be.Name {
+			conditions.VIPPoolLinked = true
+			listenerStatus.State = loadbalancerv1alpha1.READY
+			listenerStatus.Message = "listener ready"
+		} else {
+			allListenersReady = false
+		}
+
+		status.Conditions.Listeners = append(status.Conditions.Listeners, conditions)
+		status.Listeners = append(status.Listeners, listenerStatus)
+	}
+
+	if allListenersReady && status.Conditions.FirewallRuleCreated {
+		status.State = loadbalancerv1alpha1.READY
+		status.Message = "Load balancer ready"
+	} else {
+		status.State = loadbalancerv1alpha1.PENDING
+		status.Message = "Provisioning load balancer"
+	}
+
+	log.V(1).Info("load balancer status", logkeys.LoadBalancerStatus, status)
+	loadbalancer.Status = status
+	return nil
+}
+
+// firewallRulesCreated returns true when a firewall rule exists for every listener of the load balancer.
+func firewallRulesCreated(loadbalancer *loadbalancerv1alpha1.Loadbalancer, fwRules []firewallv1alpha1.FirewallRule) bool {
+	ruleNames := make(map[string]bool)
+	for _, rule := range fwRules {
+		ruleNames[rule.Name] = true
+	}
+	for _, listener := range loadbalancer.Spec.Listeners {
+		if !ruleNames[fmt.Sprintf("%s-%d", loadbalancer.Name, listener.VIP.Port)] {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateVirtualServer creates the frontend of a listener. The VIP is allocated from the vip pool unless an existing
+// VIP of the load balancer should be used.
+func (hp *HAProxyProvider) CreateVirtualServer(ctx context.Context, name string, namespace string, vServer loadbalancerv1alpha1.VServer, poolID int, ipType string, vip string) (string, error) {
+	log := log.FromContext(ctx).WithName("HAProxyProvider.CreateVirtualServer").WithValues(logkeys.LoadBalancerName, name, logkeys.ListenPort, vServer.Port)
+
+	if err := validateProtocol(vServer.IPProtocol); err != nil {
+		return string(VirtualServerFailed), err
+	}
+	if vServer.SSLConfig != nil {
+		log.Info("ssl profiles are not supported by the haproxy provider, traffic is passed through to the pool members")
+	}
+
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	fes, err := hp.client.listFrontends(ctx)
+	if err != nil {
+		return string(VirtualServerFailed), err
+	}
+	bes, err := hp.client.listBackends(ctx)
+	if err != nil {
+		return string(VirtualServerFailed), err
+	}
+
+	feName := proxyName(frontendPrefix, namespace, name, vServer.Port)
+	for _, fe := range fes {
+		if fe.Name == feName {
+			return string(VirtualServerCreationSucceeded), nil
+		}
+	}
+
+	address := vip
+	if ipType != string(loadbalancerv1alpha1.IPType_EXISTING) || vip == "" {
+		address, err = hp.allocateVIP(ctx, fes, namespace, name)
+		if err != nil {
+			return string(VirtualServerFailed), err
+		}
+	}
+
+	fe := frontend{
+		Name: feName,
+		ID:   nextProxyID(fes, bes),
+		Mode: "tcp",
+	}
+	if be, found := backendByID(bes, poolID); found {
+		fe.DefaultBackend = be.Name
+	}
+
+	port := vServer.Port
+	err = hp.client.withTransaction(ctx, func(transactionID string) error {
+		if err := hp.client.createFrontend(ctx, transactionID, fe); err != nil {
+			return err
+		}
+		return hp.client.createBind(ctx, transactionID, fe.Name, bind{Name: vipBindName, Address: address, Port: &port})
+	})
+	if err != nil {
+		log.Error(err, string(VirtualServerFailed))
+		return string(VirtualServerFailed), err
+	}
+
+	log.Info(string(VirtualServerCreationSucceeded), "frontend", fe.Name, "vip", address)
+	return string(VirtualServerCreationSucceeded), nil
+}
+
+// allocateVIP returns the VIP claimed by the load balancer, or claims the first address of the vip pool which is
+// neither claimed nor bound by a frontend managed by this provider.
+func (hp *HAProxyProvider) allocateVIP(ctx context.Context, fes []frontend, namespace string, name string) (string, error) {
+	if address, err := hp.claimedVIP(ctx, namespace, name); err != nil || address != "" {
+		return address, err
+	}
+
+	used := make(map[netip.Addr]bool)
+	for _, fe := range fes {
+		if _, _, _, _, ok := parseProxyName(frontendPrefix, fe.Name); !ok {
+			continue
+		}
+		bs, err := hp.client.listBinds(ctx, fe.Name)
+		if err != nil {
+			return "", err
+		}
+		for _, b := range bs {
+			if addr, err := netip.ParseAddr(b.Address); err == nil {
+				used[addr] = true
+			}
+		}
+	}
+
+	for _, prefix := range hp.vipPool {
+		for addr := prefix.Addr(); prefix.Contains(addr); addr = addr.Next() {
+			// Skip the network and broadcast addresses of IPv4 subnets.
+			if addr.Is4() && prefix.Bits() < 31 && (addr == prefix.Addr() || !prefix.Contains(addr.Next())) {
+				continue
+			}
+			if used[addr] {
+				continue
+			}
+			claimed, err := hp.claimVIP(ctx, namespace, name, addr)
+			if err != nil {
+				return "", err
+			}
+			if claimed {
+				return addr.String(), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no free vip is available in the vip pool")
+}
+
+// CreatePool creates the backend of a listener with a server for each of the instances.
+func (hp *HAProxyProvider) CreatePool(ctx context.Context, name string, namespace string, pool loadbalancerv1alpha1.VPool, instances []cloudv1alpha1.Instance, vipPort int) (string, error) {
+	log := log.FromContext(ctx).WithName("HAProxyProvider.CreatePool").WithValues(logkeys.LoadBalancerName, name, logkeys.ListenPort, vipPort)
+
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	fes, err := hp.client.listFrontends(ctx)
+	if err != nil {
+		return string(PoolCreationFailed), err
+	}
+	bes, err := hp.client.listBackends(ctx)
+	if err != nil {
+		return string(PoolCreationFailed), err
+	}
+
+	beName := proxyName(backendPrefix, namespace, name, vipPort)
+	for _, be := range bes {
+		if be.Name == beName {
+			return string(PoolCreationSucceeded), nil
+		}
+	}
+
+	// Persistence is configured on the virtual server, it is applied when the pool is reconciled.
+	be, err := desiredBackend(beName, nextProxyID(fes, bes), pool, "")
+	if err != nil {
+		return string(PoolCreationFailed), err
+	}
+	servers, err := desiredServers(pool, instances, vipPort)
+	if err != nil {
+		return string(PoolCreationFailed), err
+	}
+
+	err = hp.client.withTransaction(ctx, func(transactionID string) error {
+		if err := hp.client.createBackend(ctx, transactionID, be); err != nil {
+			return err
+		}
+		for _, s := range servers {
+			if err := hp.client.createServer(ctx, transactionID, be.Name, s); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(err, string(PoolCreationFailed))
+		return string(PoolCreationFailed), err
+	}
+
+	log.Info(string(PoolCreationSucceeded), "backend", be.Name, "members", len(servers))
+	return string(PoolCreationSucceeded), nil
+}
+
+// LinkVSToPool sets the backend as the default backend of the frontend.
+func (hp *HAProxyProvider) LinkVSToPool(ctx context.Context, vipID int, poolID int) (string, error) {
+	log := log.FromContext(ctx).WithName("HAProxyProvider.LinkVSToPool").WithValues(logkeys.VipId, vipID, logkeys.PoolId, poolID)
+
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	fes, err := hp.client.listFrontends(ctx)
+	if err != nil {
+		return string(VirtualServerToPoolFailed), err
+	}
+	bes, err := hp.client.listBackends(ctx)
+	if err != nil {
+		return string(VirtualServerToPoolFailed), err
+	}
+
+	fe, found := frontendByID(fes, vipID)
+	if !found {
+		return string(VirtualServerToPoolFailed), fmt.Errorf("virtual server %d not found", vipID)
+	}
+	be, found := backendByID(bes, poolID)
+	if !found {
+		return string(VirtualServerToPoolFailed), fmt.Errorf("pool %d not found", poolID)
+	}
+
+	if fe.DefaultBackend == be.Name {
+		return string(VirtualServerToPoolSucceeded), nil
+	}
+
+	fe.DefaultBackend = be.Name
+	err = hp.client.withTransaction(ctx, func(transactionID string) error {
+		return hp.client.replaceFrontend(ctx, transactionID, fe)
+	})
+	if err != nil {
+		log.Error(err, string(VirtualServerToPoolFailed))
+		return string(VirtualServerToPoolFailed), err
+	}
+
+	log.Info(string(VirtualServerToPoolSucceeded))
+	return string(VirtualServerToPoolSucceeded), nil
+}
+
+// ObserveCurrentAndReconcile reconciles the backend and its servers with the listener and the ready instances,
+// and returns the pool members with the health check status reported by HAProxy.
+func (hp *HAProxyProvider) ObserveCurrentAndReconcile(ctx context.Context, namespace string, listener loadbalancerv1alpha1.LoadbalancerListener,
+	poolID int, instances []cloudv1alpha1.Instance) (string, []loadbalancerv1alpha1.PoolStatusMember, error) {
+	log := log.FromContext(ctx).WithName("HAProxyProvider.ObserveCurrentAndReconcile").WithValues(logkeys.PoolId, poolID)
+
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	bes, err := hp.client.listBackends(ctx)
+	if err != nil {
+		return string(PoolUpdateFailed), nil, err
+	}
+	currentBackend, found := backendByID(bes, poolID)
+	if !found {
+		return string(PoolUpdateFailed), nil, fmt.Errorf("pool %d not found", poolID)
+	}
+
+	be, err := desiredBackend(currentBackend.Name, currentBackend.ID, listener.Pool, listener.VIP.Persist)
+	if err != nil {
+		return string(PoolUpdateFailed), nil, err
+	}
+	desired, err := desiredServers(listener.Pool, instances, listener.VIP.Port)
+	if err != nil {
+		return string(PoolUpdateFailed), nil, err
+	}
+	current, err := hp.client.listServers(ctx, be.Name)
+	if err != nil {
+		return string(PoolUpdateFailed), nil, err
+	}
+
+	currentByName := make(map[string]server)
+	for _, s := range current {
+		currentByName[s.Name] = s
+	}
+	desiredByName := make(map[string]server)
+	for _, s := range desired {
+		desiredByName[s.Name] = s
+	}
+
+	var toCreate, toReplace []server
+	var toDelete []string
+	for _, s := range desired {
+		c, found := currentByName[s.Name]
+		if !found {
+			toCreate = append(toCreate, s)
+		} else if !serversEqual(c, s) {
+			toReplace = append(toReplace, s)
+		}
+	}
+	for _, s := range current {
+		if _, found := desiredByName[s.Name]; !found {
+			toDelete = append(toDelete, s.Name)
+		}
+	}
+	backendChanged := !backendsEqual(currentBackend, be)
+
+	if backendChanged || len(toCreate) > 0 || len(toReplace) > 0 || len(toDelete) > 0 {
+		log.Info("updating pool", "backend", be.Name, "backendChanged", backendChanged,
+			"create", len(toCreate), "replace", len(toReplace), "delete", len(toDelete))
+		err = hp.client.withTransaction(ctx, func(transactionID string) error {
+			if backendChanged {
+				if err := hp.client.replaceBackend(ctx, transactionID, be); err != nil {
+					return err
+				}
+			}
+			for _, name := range toDelete {
+				if err := hp.client.deleteServer(ctx, transactionID, be.Name, name); err != nil && !isNotFound(err) {
+					return err
+				}
+			}
+			for _, s := range toReplace {
+				if err := hp.client.replaceServer(ctx, transactionID, be.Name, s); err != nil {
+					return err
+				}
+			}
+			for _, s := range toCreate {
+				if err := hp.client.createServer(ctx, transactionID, be.Name, s); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Error(err, string(PoolUpdateFailed))
+			return string(PoolUpdateFailed), nil, err
+		}
+	}
+
+	// Servers which were just added may not be reported until HAProxy has reloaded, they are reported without a status.
+	runtime, err := hp.client.listRuntimeServers(ctx, be.Name)
+	if err != nil {
+		return string(PoolUpdateFailed), nil, err
+	}
+	operationalStates := make(map[string]string)
+	for _, rs := range runtime {
+		operationalStates[rs.Name] = rs.OperationalState
+	}
+
+	members := []loadbalancerv1alpha1.PoolStatusMember{}
+	for _, s := range desired {
+		members = append(members, loadbalancerv1alpha1.PoolStatusMember{
+			InstanceResourceId: s.Name,
+			IPAddress:          s.Address,
+			MonitorStatus:      operationalStates[s.Name],
+		})
+	}
+
+	return string(PoolUpdateSucceeded), members, nil
+}
+
+// ProcessFinalizers removes all frontends and backends of the load balancer.
+func (hp *HAProxyProvider) ProcessFinalizers(ctx context.Context, loadbalancer *loadbalancerv1alpha1.Loadbalancer) (string, error) {
+	log := log.FromContext(ctx).WithName("HAProxyProvider.ProcessFinalizers").WithValues(logkeys.LoadBalancerName, loadbalancer.Name)
+
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	fes, err := hp.client.listFrontends(ctx)
+	if err != nil {
+		return string(LoadbalancerDeletionFailed), err
+	}
+	bes, err := hp.client.listBackends(ctx)
+	if err != nil {
+		return string(LoadbalancerDeletionFailed), err
+	}
+
+	var feNames, beNames []string
+	for _, fe := range fes {
+		if _, namespace, name, _, ok := parseProxyName(frontendPrefix, fe.Name); ok && namespace == loadbalancer.Namespace && name == loadbalancer.Name {
+			feNames = append(feNames, fe.Name)
+		}
+	}
+	for _, be := range bes {
+		if _, namespace, name, _, ok := parseProxyName(backendPrefix, be.Name); ok && namespace == loadbalancer.Namespace && name == loadbalancer.Name {
+			beNames = append(beNames, be.Name)
+		}
+	}
+
+	if len(feNames) == 0 && len(beNames) == 0 {
+		if err := hp.releaseVIPs(ctx, loadbalancer.Namespace, loadbalancer.Name); err != nil {
+			return string(LoadbalancerDeletionFailed), err
+		}
+		return string(LoadbalancerDeletionSucceeded), nil
+	}
+
+	// Frontends are removed first since a backend can't be removed while it is in use.
+	err = hp.client.withTransaction(ctx, func(transactionID string) error {
+		for _, name := range feNames {
+			if err := hp.client.deleteFrontend(ctx, transactionID, name); err != nil && !isNotFound(err) {
+				return err
+			}
+		}
+		for _, name := range beNames {
+			if err := hp.client.deleteBackend(ctx, transactionID, name); err != nil && !isNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(err, string(LoadbalancerDeletionFailed))
+		return string(LoadbalancerDeletionFailed), err
+	}
+	// The VIP is released once nothing is bound to it anymore.
+	if err := hp.releaseVIPs(ctx, loadbalancer.Namespace, loadbalancer.Name); err != nil {
+		log.Error(err, string(LoadbalancerDeletionFailed))
+		return string(LoadbalancerDeletionFailed), err
+	}
+
+	log.Info(string(LoadbalancerDeletionSucceeded), "frontends", feNames, "backends", beNames)
+	return string(LoadbalancerDeletionSucceeded), nil
+}
+
+// ReconcileListeners removes the frontends and backends of listeners which were removed from the load balancer,
+// and returns the ports of the removed listeners.
+func (hp *HAProxyProvider) ReconcileListeners(ctx context.Context, name string, vip string, listeners []loadbalancerv1alpha1.LoadbalancerListener) ([]int, error) {
+	log := log.FromContext(ctx).WithName("HAProxyProvider.ReconcileListeners").WithValues(logkeys.LoadBalancerName, name)
+
+	removedPorts := []int{}
+	if vip == "" {
+		return removedPorts, nil
+	}
+
+	desiredPorts := make(map[int]bool)
+	for _, listener := range listeners {
+		desiredPorts[listener.VIP.Port] = true
+	}
+
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	fes, err := hp.client.listFrontends(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bes, err := hp.client.listBackends(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backendNames := make(map[string]bool)
+	for _, be := range bes {
+		backendNames[be.Name] = true
+	}
+
+	var feNames, beNames []string
+	for _, fe := range fes {
+		_, namespace, feLoadbalancerName, port, ok := parseProxyName(frontendPrefix, fe.Name)
+		if !ok || feLoadbalancerName != name || desiredPorts[port] {
+			continue
+		}
+
+		// The namespace isn't known here, the VIP identifies the load balancer.
+		bs, err := hp.client.listBinds(ctx, fe.Name)
+		if err != nil {
+			return nil, err
+		}
+		boundToVIP := false
+		for _, b := range bs {
+			if b.Address == vip {
+				boundToVIP = true
+			}
+		}
+		if !boundToVIP {
+			continue
+		}
+
+		feNames = append(feNames, fe.Name)
+		if beName := proxyName(backendPrefix, namespace, name, port); backendNames[beName] {
+			beNames = append(beNames, beName)
+		}
+		removedPorts = append(removedPorts, port)
+	}
+
+	if len(feNames) == 0 {
+		return removedPorts, nil
+	}
+
+	err = hp.client.withTransaction(ctx, func(transactionID string) error {
+		for _, feName := range feNames {
+			if err := hp.client.deleteFrontend(ctx, transactionID, feName); err != nil && !isNotFound(err) {
+				return err
+			}
+		}
+		for _, beName := range beNames {
+			if err := hp.client.deleteBackend(ctx, transactionID, beName); err != nil && !isNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(err, string(VirtualServerDeletionFailed))
+		return nil, err
+	}
+
+	sort.Ints(removedPorts)
+	log.Info("removed listeners", "ports", removedPorts)
+	return removedPorts, nil
+}
+
+// proxyName returns the name of the frontend or backend of a listener, eg. fe_123456789012_my-lb_443.
+// Kubernetes names can't contain "_", which keeps the name parsable.
+func proxyName(prefix string, namespace string, name string, port int) string {
+	return fmt.Sprintf("%s_%s_%s_%d", prefix, namespace, name, port)
+}
+
+// parseProxyName is the inverse of proxyName, ok is false if the proxy isn't managed by this provider.
+func parseProxyName(prefix string, proxy string) (string, string, string, int, bool) {
+	parts := strings.Split(proxy, "_")
+	if len(parts) != 4 || parts[0] != prefix {
+		return "", "", "", 0, false
+	}
+	port, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return "", "", "", 0, false
+	}
+	return parts[0], parts[1], parts[2], port, true
+}
+
+func nextProxyID(fes []frontend, bes []backend) int {
+	id := firstProxyID - 1
+	for _, fe := range fes {
+		if fe.ID > id {
+			id = fe.ID
+		}
+	}
+	for _, be := range bes {
+		if be.ID > id {
+			id = be.ID
+		}
+	}
+	return id + 1
+}
+
+func frontendByID(fes []frontend, id int) (frontend, bool) {
+	for _, fe := range fes {
+		if id != 0 && fe.ID == id {
+			return fe, true
+		}
+	}
+	return frontend{}, false
+}
+
+func backendByID(bes []backend, id int) (backend, bool) {
+	for _, be := range bes {
+		if id != 0 && be.ID == id {
+			return be, true
+		}
+	}
+	return backend{}, false
+}
+
+func validateProtocol(protocol string) error {
+	switch strings.ToLower(protocol) {
+	case "", string(loadbalancerv1alpha1.IPProtocol_TCP):
+		return nil
+	default:
+		return fmt.Errorf("ip protocol %s is not supported by the haproxy provider", protocol)
+	}
+}
+
+// monitorType translates the pool monitor, eg. "http" or "i_http", to a monitor type. Pools without a monitor use tcp checks.
+func monitorType(monitor string) (loadbalancerv1alpha1.MonitorType, error) {
+	m := loadbalancerv1alpha1.MonitorType(strings.TrimPrefix(strings.ToLower(monitor), "i_"))
+	switch m {
+	case "":
+		return loadbalancerv1alpha1.MonitorType_TCP, nil
+	case loadbalancerv1alpha1.MonitorType_TCP, loadbalancerv1alpha1.MonitorType_HTTP, loadbalancerv1alpha1.MonitorType_HTTPS:
+		return m, nil
+	default:
+		return "", fmt.Errorf("monitor %s is not supported by the haproxy provider", monitor)
+	}
+}
+
+// balanceAlgorithm translates the pool load balancing mode to a HAProxy balance algorithm. Client IP persistence
+// is implemented with source hashing.
+func balanceAlgorithm(loadBalancingMode string, persist string) (string, error) {
+	if persist != "" {
+		return "source", nil
+	}
+	switch strings.ToLower(loadBalancingMode) {
+	case "", "round-robin", "ratio-member", "ratio-node":
+		return "roundrobin", nil
+	case "least-connections", "least-connections-member", "least-connections-node":
+		return "leastconn", nil
+	default:
+		return "", fmt.Errorf("load balancing mode %s is not supported by the haproxy provider", loadBalancingMode)
+	}
+}
+
+func desiredBackend(name string, id int, pool loadbalancerv1alpha1.VPool, persist string) (backend, error) {
+	algorithm, err := balanceAlgorithm(pool.LoadBalancingMode, persist)
+	if err != nil {
+		return backend{}, err
+	}
+	monitor, err := monitorType(pool.Monitor)
+	if err != nil {
+		return backend{}, err
+	}
+
+	be := backend{
+		Name:    name,
+		ID:      id,
+		Mode:    "tcp",
+		Balance: &balance{Algorithm: algorithm},
+	}
+	if monitor == loadbalancerv1alpha1.MonitorType_HTTP || monitor == loadbalancerv1alpha1.MonitorType_HTTPS {
+		be.AdvCheck = "httpchk"
+		be.HttpchkParams = &httpCheckParams{Method: "GET", URI: "/"}
+	}
+	// Members of lower priority groups are backup servers, which are all used once too few members are active.
+	if pool.MinActiveMembers > 0 {
+		be.Allbackups = enabled
+	}
+	return be, nil
+}
+
+// desiredServers translates the ready instances of a pool into servers. Static members carry the per member settings,
+// members selected by labels use the defaults.
+func desiredServers(pool loadbalancerv1alpha1.VPool, instances []cloudv1alpha1.Instance, vipPort int) ([]server, error) {
+	monitor, err := monitorType(pool.Monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	port := pool.Port
+	if port == 0 {
+		port = vipPort
+	}
+
+	members := make(map[string]loadbalancerv1alpha1.VMember)
+	for _, member := range pool.Members {
+		members[member.InstanceResourceId] = member
+	}
+
+	highestPriorityGroup := 0
+	for _, instance := range instances {
+		if member, found := members[instance.Name]; found && member.PriorityGroup > highestPriorityGroup {
+			highestPriorityGroup = member.PriorityGroup
+		}
+	}
+
+	var servers []server
+	for _, instance := range instances {
+		if len(instance.Status.Interfaces) == 0 || len(instance.Status.Interfaces[0].Addresses) == 0 {
+			continue
+		}
+		member := members[instance.Name]
+
+		s := server{
+			Name:        instance.Name,
+			Address:     instance.Status.Interfaces[0].Addresses[0],
+			Port:        intPtr(port),
+			Check:       enabled,
+			Backup:      disabled,
+			Maintenance: disabled,
+		}
+		if monitor == loadbalancerv1alpha1.MonitorType_HTTPS {
+			s.CheckSsl = enabled
+			s.Verify = "none"
+		}
+		if member.ConnectionLimit > 0 {
+			s.Maxconn = intPtr(member.ConnectionLimit)
+		}
+		if member.Ratio > 0 {
+			s.Weight = intPtr(member.Ratio)
+		}
+		if pool.MinActiveMembers > 0 && member.PriorityGroup < highestPriorityGroup {
+			s.Backup = enabled
+		}
+		switch strings.ToLower(member.AdminState) {
+		case "disabled", "forced_offline", "forced-offline":
+			s.Maintenance = enabled
+		}
+		servers = append(servers, s)
+	}
+
+	sort.Slice(servers, func(i, j int) bool { return servers[i].Name < servers[j].Name })
+	return servers, nil
+}
+
+func backendsEqual(current backend, desired backend) bool {
+	if current.Mode != desired.Mode || current.AdvCheck != desired.AdvCheck || flag(current.Allbackups) != flag(desired.Allbackups) {
+		return false
+	}
+	if (current.Balance == nil) != (desired.Balance == nil) || (current.Balance != nil && *current.Balance != *desired.Balance) {
+		return false
+	}
+	if (current.HttpchkParams == nil) != (desired.HttpchkParams == nil) || (current.HttpchkParams != nil && *current.HttpchkParams != *desired.HttpchkParams) {
+		return false
+	}
+	return true
+}
+
+func serversEqual(current server, desired server) bool {
+	return current.Address == desired.Address &&
+		intValue(current.Port) == intValue(desired.Port) &&
+		intValue(current.Maxconn) == intValue(desired.Maxconn) &&
+		intValue(current.Weight) == intValue(desired.Weight) &&
+		flag(current.Check) == flag(desired.Check) &&
+		flag(current.CheckSsl) == flag(desired.CheckSsl) &&
+		flag(current.Backup) == flag(desired.Backup) &&
+		flag(current.Maintenance) == flag(desired.Maintenance) &&
+		current.Verify == desired.Verify
+}
+
+// flag normalizes an enabled/disabled field, the Data Plane API omits disabled fields.
+func flag(value string) string {
+	if value == "" {
+		return disabled
+	}
+	return value
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
@@ -0,0 +1,568 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package haproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	firewallv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/firewall_operator/api/v1alpha1"
+	cloudv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/apis/private.cloud/v1alpha1"
+	loadbalancerv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/api/v1alpha1"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8sfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNamespace = "123456789012"
+	testName      = "lb-1"
+	testUsername  = "admin"
+	testPassword  = "secret"
+
+	testClaimNamespace = "idcs-system"
+)
+
+// dataPlaneState is the configuration held by the fake Data Plane API.
+type dataPlaneState struct {
+	Frontends map[string]frontend
+	Binds     map[string][]bind
+	Backends  map[string]backend
+	Servers   map[string]map[string]server
+}
+
+func (s *dataPlaneState) copy() *dataPlaneState {
+	b, _ := json.Marshal(s)
+	c := &dataPlaneState{}
+	_ = json.Unmarshal(b, c)
+	return c
+}
+
+// fakeDataPlane is an in memory implementation of the parts of the HAProxy Data Plane API used by the provider.
+type fakeDataPlane struct {
+	mu           sync.Mutex
+	version      int
+	state        *dataPlaneState
+	transactions map[string]*dataPlaneState
+	nextTxID     int
+	// operationalStates overrides the operational state of runtime servers, which is "up" by default.
+	operationalStates map[string]string
+}
+
+func newFakeDataPlane() *fakeDataPlane {
+	return &fakeDataPlane{
+		version: 1,
+		state: &dataPlaneState{
+			Frontends: map[string]frontend{},
+			Binds:     map[string][]bind{},
+			Backends:  map[string]backend{},
+			Servers:   map[string]map[string]server{},
+		},
+		transactions:      map[string]*dataPlaneState{},
+		operationalStates: map[string]string{},
+	}
+}
+
+func (f *fakeDataPlane) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if username, password, ok := r.BasicAuth(); !ok || username != testUsername || password != testPassword {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "invalid credentials"})
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v2/")
+	query := r.URL.Query()
+
+	// Reads see the committed configuration, writes go to the transaction.
+	state := f.state
+	if txID := query.Get("transaction_id"); txID != "" {
+		tx, found := f.transactions[txID]
+		if !found {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "transaction not found"})
+			return
+		}
+		state = tx
+	}
+
+	switch {
+	case path == configurationVersion && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, f.version)
+	case path == transactions && r.Method == http.MethodPost:
+		if query.Get("version") != strconv.Itoa(f.version) {
+			writeJSON(w, http.StatusConflict, map[string]string{"message": "version mismatch"})
+			return
+		}
+		f.nextTxID++
+		id := fmt.Sprintf("tx-%d", f.nextTxID)
+		f.transactions[id] = f.state.copy()
+		writeJSON(w, http.StatusCreated, transaction{ID: id, Version: f.version, Status: "in_progress"})
+	case strings.HasPrefix(path, transactions+"/"):
+		id := strings.TrimPrefix(path, transactions+"/")
+		tx, found := f.transactions[id]
+		if !found {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "transaction not found"})
+			return
+		}
+		delete(f.transactions, id)
+		if r.Method == http.MethodPut {
+			f.state = tx
+			f.version++
+			writeJSON(w, http.StatusOK, transaction{ID: id, Version: f.version, Status: "success"})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case path == frontends && r.Method == http.MethodGet:
+		var data []frontend
+		for _, fe := range state.Frontends {
+			data = append(data, fe)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"_version": f.version, "data": data})
+	case path == frontends && r.Method == http.MethodPost:
+		var fe frontend
+		_ = json.NewDecoder(r.Body).Decode(&fe)
+		if _, found := state.Frontends[fe.Name]; found {
+			writeJSON(w, http.StatusConflict, map[string]string{"message": "frontend exists"})
+			return
+		}
+		state.Frontends[fe.Name] = fe
+		writeJSON(w, http.StatusAccepted, fe)
+	case strings.HasPrefix(path, frontends+"/"):
+		name := strings.TrimPrefix(path, frontends+"/")
+		if _, found := state.Frontends[name]; !found {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "frontend not found"})
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(state.Frontends, name)
+			delete(state.Binds, name)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		var fe frontend
+		_ = json.NewDecoder(r.Body).Decode(&fe)
+		state.Frontends[name] = fe
+		writeJSON(w, http.StatusAccepted, fe)
+	case path == binds && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"_version": f.version, "data": state.Binds[query.Get("frontend")]})
+	case path == binds && r.Method == http.MethodPost:
+		var b bind
+		_ = json.NewDecoder(r.Body).Decode(&b)
+		state.Binds[query.Get("frontend")] = append(state.Binds[query.Get("frontend")], b)
+		writeJSON(w, http.StatusAccepted, b)
+	case path == backends && r.Method == http.MethodGet:
+		var data []backend
+		for _, be := range state.Backends {
+			data = append(data, be)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"_version": f.version, "data": data})
+	case path == backends && r.Method == http.MethodPost:
+		var be backend
+		_ = json.NewDecoder(r.Body).Decode(&be)
+		state.Backends[be.Name] = be
+		state.Servers[be.Name] = map[string]server{}
+		writeJSON(w, http.StatusAccepted, be)
+	case strings.HasPrefix(path, backends+"/"):
+		name := strings.TrimPrefix(path, backends+"/")
+		if _, found := state.Backends[name]; !found {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "backend not found"})
+			return
+		}
+		if r.Method == http.MethodDelete {
+			for _, fe := range state.Frontends {
+				if fe.DefaultBackend == name {
+					writeJSON(w, http.StatusBadRequest, map[string]string{"message": "backend in use"})
+					return
+				}
+			}
+			delete(state.Backends, name)
+			delete(state.Servers, name)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		var be backend
+		_ = json.NewDecoder(r.Body).Decode(&be)
+		state.Backends[name] = be
+		writeJSON(w, http.StatusAccepted, be)
+	case path == servers && r.Method == http.MethodGet:
+		var data []server
+		for _, s := range state.Servers[query.Get("backend")] {
+			data = append(data, s)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"_version": f.version, "data": data})
+	case path == servers && r.Method == http.MethodPost:
+		var s server
+		_ = json.NewDecoder(r.Body).Decode(&s)
+		state.Servers[query.Get("backend")][s.Name] = s
+		writeJSON(w, http.StatusAccepted, s)
+	case strings.HasPrefix(path, servers+"/"):
+		name := strings.TrimPrefix(path, servers+"/")
+		backendServers := state.Servers[query.Get("backend")]
+		if _, found := backendServers[name]; !found {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "server not found"})
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(backendServers, name)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		var s server
+		_ = json.NewDecoder(r.Body).Decode(&s)
+		backendServers[name] = s
+		writeJSON(w, http.StatusAccepted, s)
+	case path == runtimeServers && r.Method == http.MethodGet:
+		data := []runtimeServer{}
+		for _, s := range state.Servers[query.Get("backend")] {
+			rs := runtimeServer{Name: s.Name, Address: s.Address, Port: s.Port, AdminState: "ready", OperationalState: "up"}
+			if s.Maintenance == enabled {
+				rs.AdminState = "maint"
+				rs.OperationalState = "down"
+			}
+			if state, found := f.operationalStates[s.Name]; found {
+				rs.OperationalState = state
+			}
+			data = append(data, rs)
+		}
+		writeJSON(w, http.StatusOK, data)
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "not found"})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestProvider(t *testing.T, vipPool ...string) (*HAProxyProvider, *fakeDataPlane) {
+	t.Helper()
+	return newTestProviderWithClaims(t, k8sfake.NewClientBuilder().Build(), vipPool...)
+}
+
+// newTestProviderWithClaims returns a provider with its own Data Plane API which stores VIP claims in claims.
+func newTestProviderWithClaims(t *testing.T, claims client.Client, vipPool ...string) (*HAProxyProvider, *fakeDataPlane) {
+	t.Helper()
+
+	dir := t.TempDir()
+	usernameFile := filepath.Join(dir, "username")
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(usernameFile, []byte(testUsername+"\n"), 0600))
+	require.NoError(t, os.WriteFile(passwordFile, []byte(testPassword+"\n"), 0600))
+	configuration, err := config.NewConfiguration(usernameFile, passwordFile)
+	require.NoError(t, err)
+
+	fake := newFakeDataPlane()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	if len(vipPool) == 0 {
+		vipPool = []string{"10.0.0.0/30"}
+	}
+	hp, err := NewHAProxyProvider(server.URL+"/v2", configuration, vipPool, 0, false, claims, testClaimNamespace)
+	require.NoError(t, err)
+	return hp, fake
+}
+
+func newTestInstance(name string, ip string) cloudv1alpha1.Instance {
+	return cloudv1alpha1.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Status: cloudv1alpha1.InstanceStatus{
+			Interfaces: []cloudv1alpha1.InstanceInterfaceStatus{{Addresses: []string{ip}}},
+		},
+	}
+}
+
+func newTestLoadbalancer(ports ...int) *loadbalancerv1alpha1.Loadbalancer {
+	lb := &loadbalancerv1alpha1.Loadbalancer{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Namespace: testNamespace},
+	}
+	for _, port := range ports {
+		lb.Spec.Listeners = append(lb.Spec.Listeners, loadbalancerv1alpha1.LoadbalancerListener{
+			VIP: loadbalancerv1alpha1.VServer{Port: port, IPProtocol: "tcp", IPType: string(loadbalancerv1alpha1.IPType_PUBLIC)},
+			Pool: loadbalancerv1alpha1.VPool{
+				Port:              8080,
+				LoadBalancingMode: "least-connections-member",
+				Monitor:           "http",
+				Members: []loadbalancerv1alpha1.VMember{
+					{InstanceResourceId: "vm-1", ConnectionLimit: 100, Ratio: 2, AdminState: "enabled"},
+					{InstanceResourceId: "vm-2", AdminState: "enabled"},
+				},
+			},
+		})
+	}
+	return lb
+}
+
+func TestNewHAProxyProvider(t *testing.T) {
+	claims := k8sfake.NewClientBuilder().Build()
+	_, err := NewHAProxyProvider("", nil, []string{"10.0.0.0/24"}, 0, false, claims, testClaimNamespace)
+	assert.Error(t, err)
+
+	_, err = NewHAProxyProvider("http://localhost:5555/v2/", nil, nil, 0, false, claims, testClaimNamespace)
+	assert.Error(t, err)
+
+	_, err = NewHAProxyProvider("http://localhost:5555/v2/", nil, []string{"10.0.0.300"}, 0, false, claims, testClaimNamespace)
+	assert.Error(t, err)
+
+	_, err = NewHAProxyProvider("http://localhost:5555/v2/", nil, []string{"10.0.0.5"}, 0, false, nil, testClaimNamespace)
+	assert.Error(t, err)
+
+	hp, err := NewHAProxyProvider("http://localhost:5555/v2/", nil, []string{"10.0.0.5", "10.0.1.0/24"}, 0, false, claims, testClaimNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.5/32", hp.vipPool[0].String())
+	assert.Equal(t, "10.0.1.0/24", hp.vipPool[1].String())
+}
+
+func TestHAProxyProviderLifecycle(t *testing.T) {
+	ctx := context.Background()
+	hp, fake := newTestProvider(t)
+	lb := newTestLoadbalancer(443)
+	listener := lb.Spec.Listeners[0]
+	instances := []cloudv1alpha1.Instance{newTestInstance("vm-1", "192.168.0.11"), newTestInstance("vm-2", "192.168.0.12")}
+
+	// Nothing is provisioned yet.
+	require.NoError(t, hp.GetStatus(ctx, lb, nil))
+	assert.Equal(t, loadbalancerv1alpha1.PENDING, lb.Status.State)
+	require.Len(t, lb.Status.Listeners, 1)
+	require.Len(t, lb.Status.Conditions.Listeners, 1)
+	assert.Equal(t, loadbalancerv1alpha1.ConditionsListenerStatus{Port: 443}, lb.Status.Conditions.Listeners[0])
+
+	// Create the virtual server, the VIP is the first host address of the pool.
+	_, err := hp.CreateVirtualServer(ctx, lb.Name, lb.Namespace, listener.VIP, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	require.NoError(t, err)
+	require.NoError(t, hp.GetStatus(ctx, lb, nil))
+	assert.Equal(t, "10.0.0.1", lb.Status.Vip)
+	assert.True(t, lb.Status.Conditions.Listeners[0].VIPCreated)
+	assert.False(t, lb.Status.Conditions.Listeners[0].PoolCreated)
+	assert.Equal(t, firstProxyID, lb.Status.Listeners[0].VipID)
+
+	// Creating it again is a no-op.
+	_, err = hp.CreateVirtualServer(ctx, lb.Name, lb.Namespace, listener.VIP, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	require.NoError(t, err)
+	assert.Len(t, fake.state.Frontends, 1)
+
+	// Create the pool.
+	_, err = hp.CreatePool(ctx, lb.Name, lb.Namespace, listener.Pool, instances, listener.VIP.Port)
+	require.NoError(t, err)
+	require.NoError(t, hp.GetStatus(ctx, lb, nil))
+	assert.True(t, lb.Status.Conditions.Listeners[0].PoolCreated)
+	assert.False(t, lb.Status.Conditions.Listeners[0].VIPPoolLinked)
+	assert.Equal(t, firstProxyID+1, lb.Status.Listeners[0].PoolID)
+
+	be := fake.state.Backends["be_123456789012_lb-1_443"]
+	assert.Equal(t, "leastconn", be.Balance.Algorithm)
+	assert.Equal(t, "httpchk", be.AdvCheck)
+	vm1 := fake.state.Servers[be.Name]["vm-1"]
+	assert.Equal(t, "192.168.0.11", vm1.Address)
+	assert.Equal(t, 8080, *vm1.Port)
+	assert.Equal(t, 100, *vm1.Maxconn)
+	assert.Equal(t, 2, *vm1.Weight)
+	assert.Equal(t, enabled, vm1.Check)
+
+	// Link the virtual server to the pool, the load balancer is ready once the firewall rule exists.
+	_, err = hp.LinkVSToPool(ctx, lb.Status.Listeners[0].VipID, lb.Status.Listeners[0].PoolID)
+	require.NoError(t, err)
+	require.NoError(t, hp.GetStatus(ctx, lb, nil))
+	assert.True(t, lb.Status.Conditions.Listeners[0].VIPPoolLinked)
+	assert.Equal(t, loadbalancerv1alpha1.READY, lb.Status.Listeners[0].State)
+	assert.Equal(t, loadbalancerv1alpha1.PENDING, lb.Status.State)
+
+	fwRules := []firewallv1alpha1.FirewallRule{{ObjectMeta: metav1.ObjectMeta{Name: "lb-1-443", Namespace: testNamespace}}}
+	require.NoError(t, hp.GetStatus(ctx, lb, fwRules))
+	assert.True(t, lb.Status.Conditions.FirewallRuleCreated)
+	assert.Equal(t, loadbalancerv1alpha1.READY, lb.Status.State)
+
+	// Remove a member, disable another and enable persistence.
+	fake.operationalStates["vm-3"] = "down"
+	listener.VIP.Persist = "i_client_ip_5min"
+	listener.Pool.Members = append(listener.Pool.Members, loadbalancerv1alpha1.VMember{InstanceResourceId: "vm-3", AdminState: "disabled"})
+	instances = []cloudv1alpha1.Instance{newTestInstance("vm-1", "192.168.0.11"), newTestInstance("vm-3", "192.168.0.13")}
+	_, members, err := hp.ObserveCurrentAndReconcile(ctx, lb.Namespace, listener, lb.Status.Listeners[0].PoolID, instances)
+	require.NoError(t, err)
+	assert.Equal(t, []loadbalancerv1alpha1.PoolStatusMember{
+		{InstanceResourceId: "vm-1", IPAddress: "192.168.0.11", MonitorStatus: "up"},
+		{InstanceResourceId: "vm-3", IPAddress: "192.168.0.13", MonitorStatus: "down"},
+	}, members)
+	be = fake.state.Backends[be.Name]
+	assert.Equal(t, "source", be.Balance.Algorithm)
+	assert.Len(t, fake.state.Servers[be.Name], 2)
+	assert.Equal(t, enabled, fake.state.Servers[be.Name]["vm-3"].Maintenance)
+
+	// A reconcile without changes doesn't create a transaction.
+	version := fake.version
+	_, _, err = hp.ObserveCurrentAndReconcile(ctx, lb.Namespace, listener, lb.Status.Listeners[0].PoolID, instances)
+	require.NoError(t, err)
+	assert.Equal(t, version, fake.version)
+
+	// Add a second listener on the existing VIP, then remove it again.
+	lb2 := newTestLoadbalancer(443, 8443)
+	_, err = hp.CreateVirtualServer(ctx, lb.Name, lb.Namespace, lb2.Spec.Listeners[1].VIP, 0, string(loadbalancerv1alpha1.IPType_EXISTING), lb.Status.Vip)
+	require.NoError(t, err)
+	_, err = hp.CreatePool(ctx, lb.Name, lb.Namespace, lb2.Spec.Listeners[1].Pool, instances, 8443)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", fake.state.Binds["fe_123456789012_lb-1_8443"][0].Address)
+
+	removedPorts, err := hp.ReconcileListeners(ctx, lb.Name, lb.Status.Vip, lb.Spec.Listeners)
+	require.NoError(t, err)
+	assert.Equal(t, []int{8443}, removedPorts)
+	assert.Len(t, fake.state.Frontends, 1)
+	assert.Len(t, fake.state.Backends, 1)
+
+	// Delete the load balancer.
+	_, err = hp.ProcessFinalizers(ctx, lb)
+	require.NoError(t, err)
+	assert.Empty(t, fake.state.Frontends)
+	assert.Empty(t, fake.state.Backends)
+	assert.Empty(t, fake.transactions)
+}
+
+func TestHAProxyProviderVIPAllocation(t *testing.T) {
+	ctx := context.Background()
+	hp, _ := newTestProvider(t, "10.0.0.0/30")
+
+	vServer := loadbalancerv1alpha1.VServer{Port: 443, IPProtocol: "tcp"}
+	_, err := hp.CreateVirtualServer(ctx, "lb-a", testNamespace, vServer, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	require.NoError(t, err)
+	_, err = hp.CreateVirtualServer(ctx, "lb-b", testNamespace, vServer, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	require.NoError(t, err)
+
+	// 10.0.0.0/30 only has two host addresses.
+	_, err = hp.CreateVirtualServer(ctx, "lb-c", testNamespace, vServer, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	assert.Error(t, err)
+
+	lbB := &loadbalancerv1alpha1.Loadbalancer{
+		ObjectMeta: metav1.ObjectMeta{Name: "lb-b", Namespace: testNamespace},
+		Spec:       loadbalancerv1alpha1.LoadbalancerSpec{Listeners: []loadbalancerv1alpha1.LoadbalancerListener{{VIP: vServer}}},
+	}
+	require.NoError(t, hp.GetStatus(ctx, lbB, nil))
+	assert.Equal(t, "10.0.0.2", lbB.Status.Vip)
+
+	// UDP listeners are rejected.
+	_, err = hp.CreateVirtualServer(ctx, "lb-d", testNamespace, loadbalancerv1alpha1.VServer{Port: 53, IPProtocol: "udp"}, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	assert.Error(t, err)
+}
+
+func TestHAProxyProviderVIPClaims(t *testing.T) {
+	ctx := context.Background()
+	claims := k8sfake.NewClientBuilder().Build()
+	// Two replicas don't see the frontends of each other, the claims keep them from allocating the same VIP.
+	hpA, fakeA := newTestProviderWithClaims(t, claims, "10.0.0.0/29")
+	hpB, fakeB := newTestProviderWithClaims(t, claims, "10.0.0.0/29")
+
+	vServer := loadbalancerv1alpha1.VServer{Port: 443, IPProtocol: "tcp"}
+	_, err := hpA.CreateVirtualServer(ctx, "lb-a", testNamespace, vServer, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	require.NoError(t, err)
+	_, err = hpB.CreateVirtualServer(ctx, "lb-b", testNamespace, vServer, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", fakeA.state.Binds["fe_123456789012_lb-a_443"][0].Address)
+	assert.Equal(t, "10.0.0.2", fakeB.state.Binds["fe_123456789012_lb-b_443"][0].Address)
+
+	// A retry of the load balancer gets the VIP it claimed before.
+	address, err := hpB.allocateVIP(ctx, nil, testNamespace, "lb-a")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", address)
+
+	claim := &corev1.ConfigMap{}
+	require.NoError(t, claims.Get(ctx, client.ObjectKey{Namespace: testClaimNamespace, Name: "haproxy-vip-10-0-0-1"}, claim))
+	assert.Equal(t, "lb-a", claim.Labels[vipClaimLoadbalancerNameLabel])
+
+	// Deleting the load balancer releases its VIP.
+	lbA := &loadbalancerv1alpha1.Loadbalancer{ObjectMeta: metav1.ObjectMeta{Name: "lb-a", Namespace: testNamespace}}
+	_, err = hpA.ProcessFinalizers(ctx, lbA)
+	require.NoError(t, err)
+	claimList := &corev1.ConfigMapList{}
+	require.NoError(t, claims.List(ctx, claimList, client.InNamespace(testClaimNamespace)))
+	require.Len(t, claimList.Items, 1)
+	assert.Equal(t, "10.0.0.2", claimList.Items[0].Data[vipClaimAddressKey])
+
+	_, err = hpB.CreateVirtualServer(ctx, "lb-c", testNamespace, vServer, 0, string(loadbalancerv1alpha1.IPType_PUBLIC), "")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", fakeB.state.Binds["fe_123456789012_lb-c_443"][0].Address)
+}
+
+func TestHAProxyProviderInvalidCredentials(t *testing.T) {
+	hp, _ := newTestProvider(t)
+
+	dir := t.TempDir()
+	usernameFile := filepath.Join(dir, "username")
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(usernameFile, []byte(testUsername), 0600))
+	require.NoError(t, os.WriteFile(passwordFile, []byte("wrong"), 0600))
+	configuration, err := config.NewConfiguration(usernameFile, passwordFile)
+	require.NoError(t, err)
+	hp.client.configuration = configuration
+
+	err = hp.GetStatus(context.Background(), newTestLoadbalancer(443), nil)
+	var apiErr *apiError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
+
+func TestDesiredServers(t *testing.T) {
+	pool := loadbalancerv1alpha1.VPool{
+		Monitor:          "i_https",
+		MinActiveMembers: 1,
+		Members: []loadbalancerv1alpha1.VMember{
+			{InstanceResourceId: "vm-1", PriorityGroup: 10},
+			{InstanceResourceId: "vm-2", PriorityGroup: 0, AdminState: "forced_offline"},
+		},
+	}
+	instances := []cloudv1alpha1.Instance{
+		newTestInstance("vm-2", "192.168.0.12"),
+		newTestInstance("vm-1", "192.168.0.11"),
+		{ObjectMeta: metav1.ObjectMeta{Name: "vm-no-address"}},
+	}
+
+	servers, err := desiredServers(pool, instances, 443)
+	require.NoError(t, err)
+	require.Len(t, servers, 2)
+
+	assert.Equal(t, "vm-1", servers[0].Name)
+	assert.Equal(t, 443, *servers[0].Port)
+	assert.Equal(t, enabled, servers[0].CheckSsl)
+	assert.Equal(t, disabled, servers[0].Backup)
+	assert.Equal(t, disabled, servers[0].Maintenance)
+
+	assert.Equal(t, "vm-2", servers[1].Name)
+	assert.Equal(t, enabled, servers[1].Backup)
+	assert.Equal(t, enabled, servers[1].Maintenance)
+
+	_, err = desiredServers(loadbalancerv1alpha1.VPool{Monitor: "icmp"}, instances, 443)
+	assert.Error(t, err)
+
+	_, err = desiredBackend("be", 1, loadbalancerv1alpha1.VPool{LoadBalancingMode: "fastest"}, "")
+	assert.Error(t, err)
+}
+
+func TestParseProxyName(t *testing.T) {
+	name := proxyName(frontendPrefix, testNamespace, "my-lb", 443)
+	assert.Equal(t, "fe_123456789012_my-lb_443", name)
+
+	prefix, namespace, lbName, port, ok := parseProxyName(frontendPrefix, name)
+	assert.True(t, ok)
+	assert.Equal(t, frontendPrefix, prefix)
+	assert.Equal(t, testNamespace, namespace)
+	assert.Equal(t, "my-lb", lbName)
+	assert.Equal(t, 443, port)
+
+	_, _, _, _, ok = parseProxyName(backendPrefix, name)
+	assert.False(t, ok)
+	_, _, _, _, ok = parseProxyName(frontendPrefix, "stats")
+	assert.False(t, ok)
+}
@@ -0,0 +1,88 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package haproxy
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// A VIP is claimed by creating a ConfigMap named after the address before it is bound by a frontend. The create
+// fails if the address is already claimed, which keeps operator replicas and reconciles of different load
+// balancers from allocating the same VIP.
+const (
+	vipClaimPrefix                     = "haproxy-vip-"
+	vipClaimAddressKey                 = "address"
+	vipClaimLoadbalancerNamespaceLabel = "private.cloud.intel.com/loadbalancer-namespace"
+	vipClaimLoadbalancerNameLabel      = "private.cloud.intel.com/loadbalancer-name"
+)
+
+func vipClaimName(addr netip.Addr) string {
+	return vipClaimPrefix + strings.NewReplacer(".", "-", ":", "-").Replace(addr.StringExpanded())
+}
+
+func vipClaimLabels(namespace string, name string) client.MatchingLabels {
+	return client.MatchingLabels{
+		vipClaimLoadbalancerNamespaceLabel: namespace,
+		vipClaimLoadbalancerNameLabel:      name,
+	}
+}
+
+// claimVIP claims addr for the load balancer, claimed is false if the address is claimed by another load balancer.
+func (hp *HAProxyProvider) claimVIP(ctx context.Context, namespace string, name string, addr netip.Addr) (bool, error) {
+	claim := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vipClaimName(addr),
+			Namespace: hp.claimNamespace,
+			Labels:    vipClaimLabels(namespace, name),
+		},
+		Data: map[string]string{vipClaimAddressKey: addr.String()},
+	}
+	err := hp.claims.Create(ctx, claim)
+	if err == nil {
+		return true, nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return false, err
+	}
+
+	existing := &corev1.ConfigMap{}
+	if err := hp.claims.Get(ctx, client.ObjectKeyFromObject(claim), existing); err != nil {
+		return false, err
+	}
+	return existing.Labels[vipClaimLoadbalancerNamespaceLabel] == namespace && existing.Labels[vipClaimLoadbalancerNameLabel] == name, nil
+}
+
+// claimedVIP returns the VIP claimed by the load balancer, or "" if it hasn't claimed one.
+func (hp *HAProxyProvider) claimedVIP(ctx context.Context, namespace string, name string) (string, error) {
+	claims := &corev1.ConfigMapList{}
+	if err := hp.claims.List(ctx, claims, client.InNamespace(hp.claimNamespace), vipClaimLabels(namespace, name)); err != nil {
+		return "", err
+	}
+	for _, claim := range claims.Items {
+		if address := claim.Data[vipClaimAddressKey]; address != "" {
+			return address, nil
+		}
+	}
+	return "", nil
+}
+
+// releaseVIPs removes the claims of the load balancer.
+func (hp *HAProxyProvider) releaseVIPs(ctx context.Context, namespace string, name string) error {
+	claims := &corev1.ConfigMapList{}
+	if err := hp.claims.List(ctx, claims, client.InNamespace(hp.claimNamespace), vipClaimLabels(namespace, name)); err != nil {
+		return err
+	}
+	for i := range claims.Items {
+		if err := hp.claims.Delete(ctx, &claims.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -16,7 +16,7 @@ type MockProvider struct {
 
 // NewMockProvider creates a new mock instance.
 func NewMockProvider() *MockProvider {
-	return nil
+	return &MockProvider{}
 }
 
 // CreatePool mocks base method.
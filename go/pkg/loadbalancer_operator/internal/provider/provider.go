@@ -4,12 +4,24 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	firewallv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/firewall_operator/api/v1alpha1"
-	mock_provider "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/firewall_operator/internal/provider/mock"
 	cloudv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/apis/private.cloud/v1alpha1"
 	loadbalancerv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/api/v1alpha1"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/internal/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/internal/provider/haproxy"
+	mock_provider "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/loadbalancer_operator/internal/provider/mock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Supported provider types.
+const (
+	ProviderTypeMock    = "mock"
+	ProviderTypeHAProxy = "haproxy"
+	// The highwire provider isn't implemented yet, it is served by the mock provider.
+	ProviderTypeHighwire = "highwire"
 )
 
 type LoadbalancerProvider struct {
@@ -22,6 +34,16 @@ type Config struct {
 	*config.Configuration
 	Environment int
 	UserGroup   int
+
+	// VIPPool is the list of IP addresses or CIDRs VIPs are allocated from by the haproxy provider.
+	VIPPool []string
+	// RequestTimeout is the timeout of requests to the provider API.
+	RequestTimeout time.Duration
+	// InsecureSkipVerify disables verification of the provider API certificate.
+	InsecureSkipVerify bool
+	// Client and VIPClaimNamespace store the VIP claims of the haproxy provider.
+	Client            client.Client
+	VIPClaimNamespace string
 }
 
 type Provider interface {
@@ -34,10 +56,18 @@ type Provider interface {
 	ReconcileListeners(ctx context.Context, name string, vip string, listeners []loadbalancerv1alpha1.LoadbalancerListener) ([]int, error)
 }
 
+// NewLoadbalancerProvider returns the provider of the given type.
 func NewLoadbalancerProvider(provider string, c *Config) (*LoadbalancerProvider, error) {
-		p, err := mock_provider.NewMockProvider(c.BaseURL, c.Domain, c.Configuration, c.Environment, c.UserGroup)
+	switch provider {
+	case ProviderTypeMock, ProviderTypeHighwire:
+		return &LoadbalancerProvider{mock_provider.NewMockProvider()}, nil
+	case ProviderTypeHAProxy:
+		p, err := haproxy.NewHAProxyProvider(c.BaseURL, c.Configuration, c.VIPPool, c.RequestTimeout, c.InsecureSkipVerify, c.Client, c.VIPClaimNamespace)
 		if err != nil {
 			return nil, err
 		}
 		return &LoadbalancerProvider{p}, nil
+	default:
+		return nil, fmt.Errorf("unsupported load balancer provider type %q", provider)
+	}
 }
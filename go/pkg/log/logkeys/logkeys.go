@@ -123,6 +123,7 @@ const (
 	InstanceSpec              = "instanceSpec"
 	InstanceStatus            = "instanceStatus"
 	InstanceNodeId            = "instanceNodeId"
+	InstanceRunStrategy       = "instanceRunStrategy"
 	InstancePrivateMetadata   = "instancePrivateMetadata"
 	InstancePrivateSpec       = "instancePrivateSpec"
 	InstanceIndex             = "instanceIndex"
@@ -260,6 +261,11 @@ const (
 	PORT                = "port"
 	RESOURCE            = "resource"
 	ADDRESS_TRANSLATION = "addressTranslation"
+	SECURITY_GROUP      = "securityGroup"
+	SECURITY_RULE       = "securityRule"
+	VPC_PEERING         = "vpcPeering"
+	ROUTE_TABLE         = "routeTable"
+	ELASTIC_IP          = "elasticIp"
 
 	// Framework
 	Extension = "extension"
@@ -412,6 +418,10 @@ const (
 	FilesystemCount            = "filesystemCount"
 	TotalDeletedQuota          = "totalDeletedQuota"
 	FilesystemTypeFilter       = "filesystemTypeFilter"
+	SnapshotName               = "snapshotName"
+	SnapshotId                 = "snapshotId"
+	SnapshotPolicy             = "snapshotPolicy"
+	FilesystemId               = "filesystemId"
 	NetSize                    = "netSize"
 	ExistingSize               = "existingSize"
 	AdditionalSize             = "additionalSize"
@@ -0,0 +1,373 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package gateway
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/maas-gateway/internal/contextmeta"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const chatCompletionChunkObject = "chat.completion.chunk"
+
+var chatMessageRoles = []string{"system", "user", "assistant"}
+
+// ChatCompletionStream generates chat completions with the ChatCompletionStream method of the inference service,
+// through the dispatcher. Stop sequences are passed to the backend and also applied by the gateway, whatever the backend
+// of the model, and the request is metered by its prompt and completion tokens.
+func (s *Server) ChatCompletionStream(req *pb.MaasChatCompletionRequest, stream pb.MaasGateway_ChatCompletionStreamServer) (retErr error) {
+	outstandingRequestsGauge := s.metrics.OutstandingRequests.WithLabelValues("ChatCompletionStream", req.GetModel())
+	outstandingRequestsGauge.Inc()
+	defer outstandingRequestsGauge.Dec()
+
+	errorLabel := ""
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		s.metrics.RequestsDurations.WithLabelValues("ChatCompletionStream", req.Model, errorLabel).Observe(v)
+	}))
+	defer timer.ObserveDuration()
+
+	// compute errorLabel - last defer executes first
+	defer func() {
+		if retErr != nil {
+			errorLabel = retErr.Error()
+			s.metrics.FailedRequests.WithLabelValues("ChatCompletionStream", req.GetModel(), errorLabel).Inc()
+		}
+	}()
+
+	ctx := stream.Context()
+	requestId, err := contextmeta.GetRequestIdFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "couldn't get requestId from context")
+	}
+	cloudAccountId := req.CloudAccountId
+
+	logger := s.logger.WithName("ChatCompletionStream").WithValues("requestId", requestId).WithValues("cloudAccountId", cloudAccountId)
+
+	logger.Info("started")
+	defer logger.Info("completed")
+
+	for _, message := range req.Messages {
+		if !slices.Contains(chatMessageRoles, message.GetRole()) {
+			return status.Errorf(codes.InvalidArgument, "invalid role %q, must be one of %s", message.GetRole(), strings.Join(chatMessageRoles, ", "))
+		}
+	}
+
+	dispatcherRequest := &pb.DispatcherRequest{
+		Model:     req.Model,
+		RequestID: requestId,
+		ChatRequest: &pb.ChatCompletionStreamRequest{
+			RequestID:        requestId,
+			Messages:         req.Messages,
+			FrequencyPenalty: req.FrequencyPenalty,
+			ReturnLogprobs:   req.Logprobs,
+			TopLogprobs:      req.TopLogprobs,
+			MaxTokens:        req.MaxTokens,
+			NumCompletions:   req.N,
+			PresencePenalty:  req.PresencePenalty,
+			Seed:             req.Seed,
+			Temperature:      req.Temperature,
+			TopP:             req.TopP,
+			Stop:             req.Stop,
+		},
+	}
+
+	// The generation is canceled once every choice finished, so that the inference service does not generate the
+	// tokens after a stop sequence.
+	generateCtx, cancelGenerate := context.WithCancel(ctx)
+	defer cancelGenerate()
+
+	startTime := timestamppb.Now()
+	response, err := s.DispatcherClient.GenerateStream(generateCtx, dispatcherRequest)
+	if err != nil {
+		logger.Error(err, "couldn't invoke InferStream")
+		return s.friendlyError(logger, err, requestId)
+	}
+
+	completion := newChatCompletion(req.Stop, req.GetN())
+	for !completion.finished() {
+		dispatcherResponse, err := response.Recv()
+		if err == io.EOF {
+			// End of stream
+			break
+		}
+		if err != nil {
+			logger.Error(err, "error receiving from InferStream")
+			return s.friendlyError(logger, err, requestId)
+		}
+
+		maasResponse := completion.update(dispatcherResponse.GetChatResponse())
+		if maasResponse == nil {
+			continue
+		}
+		maasResponse.RequestID = requestId
+		if err := stream.Send(maasResponse); err != nil {
+			logger.Error(err, "error sending response to client")
+			return s.friendlyError(logger, err, requestId)
+		}
+	}
+	cancelGenerate()
+	endTime := timestamppb.Now()
+
+	for _, maasResponse := range []*pb.MaasChatCompletionResponse{completion.finish(), completion.usageResponse()} {
+		if maasResponse == nil {
+			continue
+		}
+		maasResponse.RequestID = requestId
+		if err := stream.Send(maasResponse); err != nil {
+			logger.Error(err, "error sending response to client")
+			return s.friendlyError(logger, err, requestId)
+		}
+	}
+
+	usage := completion.usage()
+	if err := s.generateUsageRecord(ctx, req.Model, func(ctx context.Context) error {
+		return s.usageRecordGenerator.CreateChatUsageRecord(ctx, requestId, cloudAccountId, req.ProductName, usage.PromptTokens, usage.CompletionTokens, startTime, endTime)
+	}); err != nil {
+		logger.Error(err, "couldn't create usage record", "requestId", requestId,
+			"cloudAccountId", cloudAccountId)
+	}
+
+	return nil
+}
+
+// chatCompletion turns the responses of the inference service into the responses of the gateway, applying the stop
+// sequences and counting the tokens.
+type chatCompletion struct {
+	stops []string
+	// The number of choices requested.
+	n       uint32
+	choices map[uint32]*chatChoice
+	// The fields common to all the responses, from the first response of the inference service.
+	header       *pb.MaasChatCompletionResponse
+	promptTokens uint32
+}
+
+type chatChoice struct {
+	stop stopMatcher
+	// The chunks received up to the end of the choice, a chunk may hold several tokens.
+	completionTokens uint32
+	// The completion tokens last reported by the inference service up to the end of the choice.
+	backendCompletionTokens uint32
+	roleSent                bool
+	finished                bool
+}
+
+func newChatCompletion(stops []string, n uint32) *chatCompletion {
+	return &chatCompletion{
+		stops:   stops,
+		n:       max(n, 1),
+		choices: map[uint32]*chatChoice{},
+	}
+}
+
+// finished returns whether every requested choice ended, the rest of the stream is not needed.
+func (c *chatCompletion) finished() bool {
+	if uint32(len(c.choices)) < c.n {
+		return false
+	}
+	for _, choice := range c.choices {
+		if !choice.finished {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *chatCompletion) newResponse() *pb.MaasChatCompletionResponse {
+	if c.header == nil {
+		return &pb.MaasChatCompletionResponse{Object: chatCompletionChunkObject}
+	}
+	return &pb.MaasChatCompletionResponse{
+		Id:                c.header.Id,
+		Object:            chatCompletionChunkObject,
+		Created:           c.header.Created,
+		Model:             c.header.Model,
+		SystemFingerprint: c.header.SystemFingerprint,
+	}
+}
+
+func (c *chatCompletion) choice(index uint32) *chatChoice {
+	choice, ok := c.choices[index]
+	if !ok {
+		choice = &chatChoice{stop: stopMatcher{stops: c.stops}}
+		c.choices[index] = choice
+	}
+	return choice
+}
+
+// update returns the response to send for a response of the inference service, or nil if there is nothing to send.
+func (c *chatCompletion) update(chatResponse *pb.ChatCompletionStreamResponse) *pb.MaasChatCompletionResponse {
+	if chatResponse == nil {
+		return nil
+	}
+	if c.header == nil {
+		c.header = &pb.MaasChatCompletionResponse{
+			Id:                chatResponse.CompletionID,
+			Created:           chatResponse.Created,
+			Model:             chatResponse.Model,
+			SystemFingerprint: chatResponse.SystemFingerprint,
+		}
+	}
+
+	maasResponse := c.newResponse()
+	for _, backendChoice := range chatResponse.Choices {
+		choice := c.choice(backendChoice.Index)
+		if choice.finished {
+			// The tokens after the end of the choice are not sent, nor metered.
+			continue
+		}
+		if usage := backendChoice.GetUsage(); usage != nil {
+			c.promptTokens = max(c.promptTokens, usage.PromptTokens)
+			choice.backendCompletionTokens = max(choice.backendCompletionTokens, usage.CompletionTokens)
+		}
+
+		content := backendChoice.GetDelta().GetContent()
+		if content != "" {
+			choice.completionTokens++
+		}
+		text, stopped := choice.stop.push(content)
+		finishReason := ""
+		switch {
+		case stopped:
+			finishReason = "stop"
+		case backendChoice.FinishReason != nil:
+			text += choice.stop.flush()
+			finishReason = chatFinishReason(backendChoice.GetFinishReason())
+		}
+		// Like the OpenAI API, the role is only sent in the first response of the choice.
+		role := ""
+		if !choice.roleSent {
+			role = backendChoice.GetDelta().GetRole()
+		}
+		if text == "" && finishReason == "" && role == "" {
+			continue
+		}
+		choice.roleSent = true
+
+		maasChoice := &pb.MaasChatCompletionChoice{
+			Index:    backendChoice.Index,
+			Delta:    &pb.ChatCompletionMessage{Role: role, Content: text},
+			Logprobs: backendChoice.Logprobs,
+		}
+		if finishReason != "" {
+			maasChoice.FinishReason = &finishReason
+			choice.finished = true
+		}
+		maasResponse.Choices = append(maasResponse.Choices, maasChoice)
+	}
+
+	if len(maasResponse.Choices) == 0 {
+		return nil
+	}
+	return maasResponse
+}
+
+// finish returns the response ending the choices the inference service did not give a finish reason for, or nil if
+// there are none.
+func (c *chatCompletion) finish() *pb.MaasChatCompletionResponse {
+	maasResponse := c.newResponse()
+	indexes := make([]uint32, 0, len(c.choices))
+	for index := range c.choices {
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	for _, index := range indexes {
+		choice := c.choices[index]
+		if choice.finished {
+			continue
+		}
+		finishReason := "stop"
+		maasResponse.Choices = append(maasResponse.Choices, &pb.MaasChatCompletionChoice{
+			Index:        index,
+			Delta:        &pb.ChatCompletionMessage{Content: choice.stop.flush()},
+			FinishReason: &finishReason,
+		})
+		choice.finished = true
+	}
+	if len(maasResponse.Choices) == 0 {
+		return nil
+	}
+	return maasResponse
+}
+
+// usage returns the prompt tokens reported by the inference service and the completion tokens up to the end of each
+// choice, the ones reported by the inference service or the chunks received if there are more of them, e.g. when the
+// usage was only reported with the first token.
+func (c *chatCompletion) usage() *pb.ChatCompletionUsage {
+	usage := &pb.ChatCompletionUsage{PromptTokens: c.promptTokens}
+	for _, choice := range c.choices {
+		usage.CompletionTokens += max(choice.backendCompletionTokens, choice.completionTokens)
+	}
+	return usage
+}
+
+func (c *chatCompletion) usageResponse() *pb.MaasChatCompletionResponse {
+	maasResponse := c.newResponse()
+	maasResponse.Usage = c.usage()
+	return maasResponse
+}
+
+// chatFinishReason maps the finish reasons of the inference service to the ones of the OpenAI API.
+func chatFinishReason(finishReason string) string {
+	switch finishReason {
+	case "eos_token", "stop_sequence":
+		return "stop"
+	default:
+		return finishReason
+	}
+}
+
+// stopMatcher finds the stop sequences in the text generated for a choice. The text that could be the beginning of a
+// stop sequence is held until the next tokens tell whether it is.
+type stopMatcher struct {
+	stops []string
+	held  string
+}
+
+// push adds generated text, and returns the text that can be sent and whether a stop sequence was found, in which case
+// the text from the stop sequence is dropped.
+func (m *stopMatcher) push(text string) (string, bool) {
+	if len(m.stops) == 0 {
+		return text, false
+	}
+	text = m.held + text
+	m.held = ""
+
+	stopIndex := -1
+	for _, stop := range m.stops {
+		if i := strings.Index(text, stop); i >= 0 && (stopIndex < 0 || i < stopIndex) {
+			stopIndex = i
+		}
+	}
+	if stopIndex >= 0 {
+		return text[:stopIndex], true
+	}
+
+	heldLen := 0
+	for _, stop := range m.stops {
+		for n := min(len(stop)-1, len(text)); n > heldLen; n-- {
+			if strings.HasSuffix(text, stop[:n]) {
+				heldLen = n
+				break
+			}
+		}
+	}
+	m.held = text[len(text)-heldLen:]
+	return text[:len(text)-heldLen], false
+}
+
+// flush returns the held text, once the generation ended without a stop sequence.
+func (m *stopMatcher) flush() string {
+	held := m.held
+	m.held = ""
+	return held
+}
@@ -0,0 +1,176 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package gateway
+
+import (
+	"testing"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStopMatcher(t *testing.T) {
+	tests := []struct {
+		name            string
+		stops           []string
+		tokens          []string
+		expectedText    string
+		expectedStopped bool
+	}{
+		{
+			name:         "no stop sequences",
+			tokens:       []string{"Hello", " World"},
+			expectedText: "Hello World",
+		},
+		{
+			name:            "stop sequence in a token",
+			stops:           []string{"\n"},
+			tokens:          []string{"Hello", " World\nBye"},
+			expectedText:    "Hello World",
+			expectedStopped: true,
+		},
+		{
+			name:            "stop sequence over several tokens",
+			stops:           []string{"END"},
+			tokens:          []string{"Hello E", "N", "D World"},
+			expectedText:    "Hello ",
+			expectedStopped: true,
+		},
+		{
+			name:         "partial stop sequence at the end",
+			stops:        []string{"END"},
+			tokens:       []string{"Hello E", "N"},
+			expectedText: "Hello EN",
+		},
+		{
+			name:         "partial stop sequence followed by other text",
+			stops:        []string{"END"},
+			tokens:       []string{"Hello E", "Nd"},
+			expectedText: "Hello ENd",
+		},
+		{
+			name:            "earliest of several stop sequences",
+			stops:           []string{"World", "lo"},
+			tokens:          []string{"Hello World"},
+			expectedText:    "Hel",
+			expectedStopped: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := stopMatcher{stops: test.stops}
+			text := ""
+			stopped := false
+			for _, token := range test.tokens {
+				var sent string
+				sent, stopped = m.push(token)
+				text += sent
+				if stopped {
+					break
+				}
+			}
+			if !stopped {
+				text += m.flush()
+			}
+			assert.Equal(t, test.expectedText, text)
+			assert.Equal(t, test.expectedStopped, stopped)
+		})
+	}
+}
+
+func TestChatCompletionUpdate(t *testing.T) {
+	finishReason := "length"
+	c := newChatCompletion(nil, 2)
+	assert.Nil(t, c.update(nil))
+
+	// Two choices, the second one finishes first.
+	resp := c.update(&pb.ChatCompletionStreamResponse{
+		CompletionID: "id",
+		Choices: []*pb.ChatCompletionStreamChoice{
+			{Index: 0, Delta: &pb.ChatCompletionMessage{Role: "assistant", Content: "a"}},
+			{Index: 1, Delta: &pb.ChatCompletionMessage{Role: "assistant", Content: "b"}, FinishReason: &finishReason},
+		},
+	})
+	assert.Equal(t, "id", resp.Id)
+	assert.Len(t, resp.Choices, 2)
+	assert.Equal(t, "assistant", resp.Choices[0].Delta.Role)
+	assert.Equal(t, "length", resp.Choices[1].GetFinishReason())
+	assert.False(t, c.finished())
+
+	resp = c.update(&pb.ChatCompletionStreamResponse{
+		Choices: []*pb.ChatCompletionStreamChoice{
+			{Index: 0, Delta: &pb.ChatCompletionMessage{Role: "assistant", Content: "c"}, Usage: &pb.ChatCompletionUsage{PromptTokens: 7, CompletionTokens: 5}},
+		},
+	})
+	assert.Equal(t, "id", resp.Id, "fields are kept from the first response")
+	assert.Equal(t, "", resp.Choices[0].Delta.Role, "the role is only sent once")
+
+	// The first choice has no finish reason when the stream ends.
+	resp = c.finish()
+	assert.Len(t, resp.Choices, 1)
+	assert.Equal(t, "stop", resp.Choices[0].GetFinishReason())
+	assert.Nil(t, c.finish())
+	assert.True(t, c.finished())
+
+	// The first choice is metered with the usage of the inference service, the second one with the counted chunks.
+	usage := c.usage()
+	assert.Equal(t, uint32(7), usage.PromptTokens)
+	assert.Equal(t, uint32(6), usage.CompletionTokens)
+}
+
+func TestChatCompletionUsage(t *testing.T) {
+	c := newChatCompletion([]string{"STOP"}, 1)
+
+	// A chunk may hold several tokens, the usage of the inference service is what it generated.
+	c.update(&pb.ChatCompletionStreamResponse{
+		Choices: []*pb.ChatCompletionStreamChoice{
+			{Index: 0, Delta: &pb.ChatCompletionMessage{Role: "assistant", Content: "a few words"}},
+		},
+	})
+	resp := c.update(&pb.ChatCompletionStreamResponse{
+		Choices: []*pb.ChatCompletionStreamChoice{
+			{Index: 0, Delta: &pb.ChatCompletionMessage{Content: " STOP more"}, Usage: &pb.ChatCompletionUsage{PromptTokens: 4, CompletionTokens: 6}},
+		},
+	})
+	assert.Equal(t, "stop", resp.Choices[0].GetFinishReason())
+	assert.True(t, c.finished())
+
+	// The tokens after the stop sequence are neither sent nor metered.
+	assert.Nil(t, c.update(&pb.ChatCompletionStreamResponse{
+		Choices: []*pb.ChatCompletionStreamChoice{
+			{Index: 0, Delta: &pb.ChatCompletionMessage{Content: " words"}, Usage: &pb.ChatCompletionUsage{PromptTokens: 4, CompletionTokens: 8}},
+		},
+	}))
+
+	usage := c.usage()
+	assert.Equal(t, uint32(4), usage.PromptTokens)
+	assert.Equal(t, uint32(6), usage.CompletionTokens)
+}
+
+func TestChatCompletionUsageWithFirstToken(t *testing.T) {
+	c := newChatCompletion([]string{"STOP"}, 1)
+
+	// The usage is reported with the first token, the generation is canceled on the stop sequence.
+	c.update(&pb.ChatCompletionStreamResponse{
+		Choices: []*pb.ChatCompletionStreamChoice{
+			{Index: 0, Delta: &pb.ChatCompletionMessage{Role: "assistant", Content: "a"}, Usage: &pb.ChatCompletionUsage{PromptTokens: 4, CompletionTokens: 1}},
+		},
+	})
+	c.update(&pb.ChatCompletionStreamResponse{
+		Choices: []*pb.ChatCompletionStreamChoice{
+			{Index: 0, Delta: &pb.ChatCompletionMessage{Content: " b"}},
+		},
+	})
+	resp := c.update(&pb.ChatCompletionStreamResponse{
+		Choices: []*pb.ChatCompletionStreamChoice{
+			{Index: 0, Delta: &pb.ChatCompletionMessage{Content: " STOP"}},
+		},
+	})
+	assert.Equal(t, "stop", resp.Choices[0].GetFinishReason())
+	assert.True(t, c.finished())
+
+	usage := c.usage()
+	assert.Equal(t, uint32(4), usage.PromptTokens)
+	assert.Equal(t, uint32(3), usage.CompletionTokens)
+}
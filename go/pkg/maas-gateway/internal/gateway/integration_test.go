@@ -32,6 +32,7 @@ type TestSuite struct {
 	logger             logr.Logger
 	gateway            *Gateway
 	usageRecordServer  *mock.UsageRecordServer
+	dispatcherServer   *mock.DispatcherServer
 	mockConnector      *client.MockGrpcServiceConnector
 	gatewayLN          *bufconn.Listener
 	gatewayClient      pb.MaasGatewayClient
@@ -57,7 +58,7 @@ func (s *TestSuite) SetupSuite() {
 	}
 
 	mockServer := mock.NewGrpcServer()
-	dispatcherServer := mock.NewDispatcherServer()
+	s.dispatcherServer = mock.NewDispatcherServer()
 
 	s.predefinedProducts = []*pb.Product{
 		{
@@ -81,7 +82,7 @@ func (s *TestSuite) SetupSuite() {
 	healthServer := mock.NewHealthServer()
 
 	grpcMockServer := mockServer.GetGrpcServer()
-	pb.RegisterDispatcherServer(grpcMockServer, dispatcherServer)
+	pb.RegisterDispatcherServer(grpcMockServer, s.dispatcherServer)
 	pb.RegisterUsageRecordServiceServer(grpcMockServer, s.usageRecordServer)
 	pb.RegisterProductCatalogServiceServer(grpcMockServer, productCatalogServer)
 	grpc_health_v1.RegisterHealthServer(grpcMockServer, healthServer)
@@ -186,6 +187,71 @@ func (s *TestSuite) Test_Gateway_GenerateStream() {
 	}
 }
 
+func (s *TestSuite) Test_Gateway_ChatCompletionStream() {
+	s.logger.Info("Test_Gateway_ChatCompletionStream")
+
+	tests := []struct {
+		name                     string
+		stop                     []string
+		expectedContent          string
+		expectedCompletionTokens uint32
+	}{
+		{
+			name:                     "without stop sequences",
+			expectedContent:          "Hello World!",
+			expectedCompletionTokens: 4,
+		},
+		{
+			name:            "stop sequence over several tokens",
+			stop:            []string{"orl"},
+			expectedContent: "Hello W",
+			// the generation is canceled on the stop sequence, "!" is not metered
+			expectedCompletionTokens: 3,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			stream, err := s.gatewayClient.ChatCompletionStream(s.ctx, &pb.MaasChatCompletionRequest{
+				Model:    "model_test_123",
+				Messages: []*pb.ChatCompletionMessage{{Role: "user", Content: "Hello"}},
+				Stop:     test.stop,
+			})
+			require.NoError(s.T(), err)
+
+			content := ""
+			finishReason := ""
+			var usage *pb.ChatCompletionUsage
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(s.T(), err)
+				s.Equal("chat.completion.chunk", response.Object)
+				for _, choice := range response.Choices {
+					content += choice.GetDelta().GetContent()
+					finishReason += choice.GetFinishReason()
+				}
+				if response.Usage != nil {
+					usage = response.Usage
+				}
+			}
+			s.Equal(test.stop, s.dispatcherServer.LastChatRequest().GetStop(), "the stop sequences are passed to the inference service")
+			s.Equal(test.expectedContent, content)
+			s.Equal("stop", finishReason)
+			s.Require().NotNil(usage)
+			s.Equal(uint32(5), usage.PromptTokens)
+			s.Equal(test.expectedCompletionTokens, usage.CompletionTokens)
+		})
+	}
+
+	stream, err := s.gatewayClient.ChatCompletionStream(s.ctx, &pb.MaasChatCompletionRequest{Model: "model_test_123"})
+	require.NoError(s.T(), err)
+	_, err = stream.Recv()
+	s.Equal(codes.InvalidArgument, status.Code(err), "messages are required")
+}
+
 func TestFetcherSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
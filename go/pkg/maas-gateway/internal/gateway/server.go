@@ -164,7 +164,11 @@ func (s *Server) GenerateStream(req *pb.MaasRequest, stream pb.MaasGateway_Gener
 		quantity = float64(maasResponse.Response.Details.GeneratedTokens)
 	}
 
-	if err := s.generateUsageRecord(ctx, requestId, cloudAccountId, req.Model, req.ProductName, quantity, startTime, endTime); err != nil {
+	// Convert quantity to millions to match Usage Record rates
+	quantityInMillions := quantity / 1_000_000
+	if err := s.generateUsageRecord(ctx, req.Model, func(ctx context.Context) error {
+		return s.usageRecordGenerator.CreateUsageRecord(ctx, requestId, cloudAccountId, req.ProductName, quantityInMillions, startTime, endTime)
+	}); err != nil {
 		logger.Error(err, "couldn't create usage record", "requestId", requestId,
 			"cloudAccountId", cloudAccountId)
 	}
@@ -172,7 +176,8 @@ func (s *Server) GenerateStream(req *pb.MaasRequest, stream pb.MaasGateway_Gener
 	return nil
 }
 
-func (s *Server) generateUsageRecord(ctx context.Context, requestId, cloudAccountId, model string, productName string, quantity float64, startTime, endTime *timestamppb.Timestamp) error {
+// generateUsageRecord calls createUsageRecord with the timeout of the usage server, and records its metrics.
+func (s *Server) generateUsageRecord(ctx context.Context, model string, createUsageRecord func(ctx context.Context) error) error {
 	errorLabel := ""
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
 		s.metrics.RequestsDurations.WithLabelValues("generateUsageRecord", model, errorLabel).Observe(v)
@@ -182,11 +187,7 @@ func (s *Server) generateUsageRecord(ctx context.Context, requestId, cloudAccoun
 	ctxWithTO, cancel := context.WithTimeout(ctx, s.config.UsageServerTimeout)
 	defer cancel()
 
-	// Convert quantity to millions to match Usage Record rates
-	var quantityInMillions float64
-	quantityInMillions = quantity / 1_000_000
-
-	if err := s.usageRecordGenerator.CreateUsageRecord(ctxWithTO, requestId, cloudAccountId, productName, quantityInMillions, startTime, endTime); err != nil {
+	if err := createUsageRecord(ctxWithTO); err != nil {
 		errorLabel = err.Error()
 		s.metrics.FailedRequests.WithLabelValues("generateUsageRecord", model, errorLabel).Inc()
 		return err
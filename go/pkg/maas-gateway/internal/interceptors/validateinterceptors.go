@@ -17,6 +17,15 @@ type Validator interface {
 	ValidateAll() error
 }
 
+// ProductRequest is implemented by the requests for a model, which are validated against the products of the model
+// available to the cloud account.
+type ProductRequest interface {
+	GetModel() string
+	GetCloudAccountId() string
+	GetProductId() string
+	GetProductName() string
+}
+
 type ValidateInterceptors struct {
 	log                  logr.Logger
 	productCatalogClient pb.ProductCatalogServiceClient
@@ -29,17 +38,19 @@ func NewValidateInterceptors(log logr.Logger, productCatalogClient pb.ProductCat
 	}
 }
 
-func (v *ValidateInterceptors) validateRequestedProduct(ctx context.Context, logger logr.Logger, maasRequest *pb.MaasRequest) error {
+func (v *ValidateInterceptors) validateRequestedProduct(ctx context.Context, logger logr.Logger, productRequest ProductRequest) error {
 	logger = logger.WithName("validateRequestedProduct")
 	logger.Info("called")
 
+	productId := productRequest.GetProductId()
+	productName := productRequest.GetProductName()
 	filter := &pb.ProductUserFilter{
-		CloudaccountId: maasRequest.CloudAccountId,
+		CloudaccountId: productRequest.GetCloudAccountId(),
 		ProductFilter: &pb.ProductFilter{
-			Id:   &maasRequest.ProductId,
-			Name: &maasRequest.ProductName,
+			Id:   &productId,
+			Name: &productName,
 			Metadata: map[string]string{
-				"hfModelName": maasRequest.Model,
+				"hfModelName": productRequest.GetModel(),
 			},
 		},
 	}
@@ -75,8 +86,8 @@ func (v *ValidateInterceptors) ValidateUnaryServerInterceptor() grpc.UnaryServer
 			}
 		}
 
-		if maasRequest, ok := req.(*pb.MaasRequest); ok {
-			if err := v.validateRequestedProduct(ctx, log, maasRequest); err != nil {
+		if productRequest, ok := req.(ProductRequest); ok {
+			if err := v.validateRequestedProduct(ctx, log, productRequest); err != nil {
 				return nil, status.Errorf(codes.InvalidArgument, "validation error: %s", err.Error())
 			}
 		}
@@ -129,8 +140,8 @@ func (s *recvWrapper) RecvMsg(m any) error {
 		}
 	}
 
-	if maasRequest, ok := m.(*pb.MaasRequest); ok {
-		if err := s.validateRequestedProduct(ctx, logger, maasRequest); err != nil {
+	if productRequest, ok := m.(ProductRequest); ok {
+		if err := s.validateRequestedProduct(ctx, logger, productRequest); err != nil {
 			return status.Errorf(codes.InvalidArgument, "validation error: %s", err.Error())
 		}
 	}
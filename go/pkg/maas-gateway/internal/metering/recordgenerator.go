@@ -10,6 +10,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/maas-gateway/internal/config"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
 )
 
 type RecordGenerator struct {
@@ -29,8 +30,27 @@ func NewRecordGenerator(log logr.Logger, config *config.Config, usageRecordClien
 // CreateUsageRecord for every successful request we send usage record to usage record service so later
 // these request will be billed
 func (r *RecordGenerator) CreateUsageRecord(ctx context.Context, requestId, cloudAccountId, productName string, quantity float64, startTime, endTime *timestamppb.Timestamp) error {
+	return r.createUsageRecord(ctx, requestId, cloudAccountId, productName, quantity, startTime, endTime, map[string]string{
+		"processingType": "text",
+	})
+}
+
+// CreateChatUsageRecord creates the usage record of a chat completion, billed by the total of its prompt and
+// completion tokens, in millions to match the rates of the products. Both counts are kept in the properties.
+func (r *RecordGenerator) CreateChatUsageRecord(ctx context.Context, requestId, cloudAccountId, productName string, promptTokens, completionTokens uint32, startTime, endTime *timestamppb.Timestamp) error {
+	quantity := float64(promptTokens+completionTokens) / 1_000_000
+	return r.createUsageRecord(ctx, requestId, cloudAccountId, productName, quantity, startTime, endTime, map[string]string{
+		"processingType":   "chat",
+		"promptTokens":     strconv.FormatUint(uint64(promptTokens), 10),
+		"completionTokens": strconv.FormatUint(uint64(completionTokens), 10),
+	})
+}
+
+func (r *RecordGenerator) createUsageRecord(ctx context.Context, requestId, cloudAccountId, productName string, quantity float64, startTime, endTime *timestamppb.Timestamp, properties map[string]string) error {
 	logger := r.logger.WithValues("requestId", requestId).WithValues("cloudAccountId", cloudAccountId)
 
+	properties["serviceType"] = "ModelAsAService"
+	properties["modelType"] = productName
 	usageRecord := &pb.ProductUsageRecordCreate{
 		TransactionId:  requestId,
 		CloudAccountId: cloudAccountId,
@@ -40,11 +60,7 @@ func (r *RecordGenerator) CreateUsageRecord(ctx context.Context, requestId, clou
 		EndTime:        endTime,
 		ProductName:    &productName,
 		Quantity:       quantity,
-		Properties: map[string]string{
-			"serviceType":    "ModelAsAService",
-			"processingType": "text",
-			"modelType":      productName,
-		},
+		Properties:     properties,
 	}
 
 	err := retry.Do(func() error {
@@ -3,18 +3,29 @@
 package mock
 
 import (
+	"sync"
+
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 )
 
 type DispatcherServer struct {
 	pb.UnimplementedDispatcherServer
+	mu              sync.Mutex
+	lastChatRequest *pb.ChatCompletionStreamRequest
 }
 
 func NewDispatcherServer() *DispatcherServer {
 	return &DispatcherServer{}
 }
 
+// ChatCompletionTokens are the tokens generated by the mock for chat completions, the first and the last ones with the
+// usage.
+var ChatCompletionTokens = []string{"Hello", " Wor", "ld", "!"}
+
 func (m *DispatcherServer) GenerateStream(req *pb.DispatcherRequest, stream pb.Dispatcher_GenerateStreamServer) error {
+	if req.ChatRequest != nil {
+		return m.chatCompletionStream(req, stream)
+	}
 	// Implement your mock behavior here
 	return stream.Send(&pb.DispatcherResponse{
 		Model: req.Model,
@@ -26,3 +37,43 @@ func (m *DispatcherServer) GenerateStream(req *pb.DispatcherRequest, stream pb.D
 		RequestID: req.RequestID,
 	})
 }
+
+// LastChatRequest returns the last chat completion request received by the mock.
+func (m *DispatcherServer) LastChatRequest() *pb.ChatCompletionStreamRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastChatRequest
+}
+
+func (m *DispatcherServer) chatCompletionStream(req *pb.DispatcherRequest, stream pb.Dispatcher_GenerateStreamServer) error {
+	m.mu.Lock()
+	m.lastChatRequest = req.ChatRequest
+	m.mu.Unlock()
+	for i, token := range ChatCompletionTokens {
+		choice := &pb.ChatCompletionStreamChoice{
+			Delta: &pb.ChatCompletionMessage{Role: "assistant", Content: token},
+		}
+		if i == 0 || i == len(ChatCompletionTokens)-1 {
+			choice.Usage = &pb.ChatCompletionUsage{PromptTokens: 5, CompletionTokens: uint32(i + 1)}
+		}
+		if i == len(ChatCompletionTokens)-1 {
+			finishReason := "eos_token"
+			choice.FinishReason = &finishReason
+		}
+		err := stream.Send(&pb.DispatcherResponse{
+			Model: req.Model,
+			ChatResponse: &pb.ChatCompletionStreamResponse{
+				CompletionID: "mock-completion",
+				Object:       "chat.completion.chunk",
+				Created:      1700000000,
+				Model:        req.Model,
+				Choices:      []*pb.ChatCompletionStreamChoice{choice},
+			},
+			RequestID: req.RequestID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
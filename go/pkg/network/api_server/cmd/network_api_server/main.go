@@ -121,12 +121,20 @@ func main() {
 		defer cloudaccountClientConn.Close()
 		cloudAccountServiceClient := pb.NewCloudAccountServiceClient(cloudaccountClientConn)
 
+		// Connect to Metering Service. Elastic IPs are not metered if it is not configured.
+		var meteringServiceClient pb.MeteringServiceClient
+		if cfg.MeteringServerAddr != "" {
+			meteringClientConn := newClient(ctx, cfg.MeteringServerAddr, dialOptions...)
+			defer meteringClientConn.Close()
+			meteringServiceClient = pb.NewMeteringServiceClient(meteringClientConn)
+		}
+
 		// Start GRPC server.
 		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.ListenPort))
 		if err != nil {
 			return fmt.Errorf("unable to listen on port %d: %w", cfg.ListenPort, err)
 		}
-		grpcService, err := server.New(ctx, &cfg, managedDb, listener, cloudAccountServiceClient, cfg.AvailabilityZones)
+		grpcService, err := server.New(ctx, &cfg, managedDb, listener, cloudAccountServiceClient, meteringServiceClient, cfg.AvailabilityZones)
 		if err != nil {
 			return err
 		}
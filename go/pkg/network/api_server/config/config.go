@@ -3,18 +3,40 @@
 package config
 
 import (
+	"time"
+
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
 )
 
 // Application configuration
 type Config struct {
-	ListenPort             uint16           `koanf:"listenPort"`
-	Database               manageddb.Config `koanf:"database"`
-	PrometheusListenPort   uint16           `koanf:"prometheusListenPort"`
-	Region                 string           `koanf:"region"`
-	AvailabilityZones      []string         `koanf:"availabilityZones"`
-	FeatureFlags           FeatureFlags     `koanf:"featureFlags"`
-	CloudaccountServerAddr string           `koanf:"cloudaccountServerAddr"`
+	ListenPort             uint16               `koanf:"listenPort"`
+	Database               manageddb.Config     `koanf:"database"`
+	PrometheusListenPort   uint16               `koanf:"prometheusListenPort"`
+	Region                 string               `koanf:"region"`
+	AvailabilityZones      []string             `koanf:"availabilityZones"`
+	FeatureFlags           FeatureFlags         `koanf:"featureFlags"`
+	CloudaccountServerAddr string               `koanf:"cloudaccountServerAddr"`
+	MeteringServerAddr     string               `koanf:"meteringServerAddr"`
+	ElasticIP              ElasticIPConfig      `koanf:"elasticIP"`
+	LeaderElection         LeaderElectionConfig `koanf:"leaderElection"`
+}
+
+type ElasticIPConfig struct {
+	// CIDR blocks of the public IP addresses that can be allocated to elastic IPs.
+	PublicIPPools []string `koanf:"publicIPPools"`
+	// The service type of the usage records of elastic IPs.
+	ServiceType string `koanf:"serviceType"`
+	// The interval between usage records of each elastic IP.
+	MeteringInterval time.Duration `koanf:"meteringInterval"`
+}
+
+// Only the replica that holds the lease runs the background tasks, such as the usage reports of elastic IPs.
+type LeaderElectionConfig struct {
+	// If false, every replica runs the background tasks.
+	Enabled        bool   `koanf:"enabled"`
+	LeaseName      string `koanf:"leaseName"`
+	LeaseNamespace string `koanf:"leaseNamespace"`
 }
 
 type FeatureFlags struct {
@@ -89,6 +89,13 @@ func (s *AddressTranslationPrivateService) CreatePrivate(ctx context.Context, re
 		profileId := uuid.New().String()
 		ipAddress := fmt.Sprintf("192.168.1.%d", rand.Intn(255))
 		macAddress := fmt.Sprintf("02:00:5e:%02x:%02x:%02x", rand.Intn(256), rand.Intn(256), rand.Intn(256))
+		// Elastic IPs are allocated by the caller.
+		if translationType == Elastic {
+			if req.Spec.IpAddress == "" {
+				return nil, status.Error(codes.InvalidArgument, "ipAddress is required for elastic address translations")
+			}
+			ipAddress = req.Spec.IpAddress
+		}
 
 		resourceId, err := uuid.NewRandom()
 		if err != nil {
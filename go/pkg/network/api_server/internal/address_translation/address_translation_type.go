@@ -15,12 +15,15 @@ const (
 	Internet  AddressTranslationType = "internet"
 	Storage   AddressTranslationType = "storage"
 	Transient AddressTranslationType = "transient"
+	// One-to-one translation between an elastic IP and the IP address of a port.
+	Elastic AddressTranslationType = "elastic"
 )
 
 var mapAddressTranslationType = map[string]AddressTranslationType{
 	"internet":  Internet,
 	"storage":   Storage,
 	"transient": Transient,
+	"elastic":   Elastic,
 }
 
 func IsValidTranslationType(translationType string) (AddressTranslationType, error) {
@@ -0,0 +1,685 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package elastic_ip
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/address_translation"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/iprm"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/transformer"
+	networkutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/utils"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	elasticIPIdKey = "resource_id"
+	// The unique index that prevents the allocation of the same public IP address to more than one elastic IP.
+	ipAddressIndexName = "ip_address_idx_elastic_ip"
+	// The number of times an allocation is retried when another request allocated the same public IP address.
+	maxAllocateAttempts = 5
+)
+
+// An elastic IP is allocated from the public IP pool of IPRM.
+// Associating an elastic IP with a port creates an address translation of type elastic.
+type ElasticIPService struct {
+	pb.UnimplementedElasticIPServiceServer
+	pb.UnimplementedElasticIPPrivateServiceServer
+	db                        *sql.DB
+	cfg                       config.Config
+	cloudAccountServiceClient pb.CloudAccountServiceClient
+	sqlTransformer            *ElasticIPSQLTransformer
+	iprmService               *iprm.IPRMService
+	addressTranslationService *address_translation.AddressTranslationPrivateService
+}
+
+func NewElasticIPService(
+	db *sql.DB,
+	config config.Config,
+	cloudAccountServiceClient pb.CloudAccountServiceClient,
+	iprmService *iprm.IPRMService,
+	addressTranslationService *address_translation.AddressTranslationPrivateService,
+) (*ElasticIPService, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	return &ElasticIPService{
+		db:                        db,
+		cfg:                       config,
+		cloudAccountServiceClient: cloudAccountServiceClient,
+		sqlTransformer:            NewElasticIPSQLTransformer(),
+		iprmService:               iprmService,
+		addressTranslationService: addressTranslationService,
+	}, nil
+}
+
+func (s *ElasticIPService) Ping(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("ElasticIPService.Ping")
+	log.Info("Ping")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ElasticIPService) PingPrivate(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("ElasticIPService.PingPrivate")
+	log.Info("PingPrivate")
+	return &emptypb.Empty{}, nil
+}
+
+// Public API: Allocate a new elastic IP.
+func (s *ElasticIPService) Create(ctx context.Context, req *pb.ElasticIPCreateRequest) (*pb.ElasticIP, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("ElasticIPService.Create").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.ElasticIP, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		elasticIP := &pb.ElasticIPPrivate{
+			Metadata: &pb.ElasticIPMetadataPrivate{
+				CloudAccountId: cloudAccountId,
+				Name:           req.Metadata.Name,
+				Labels:         req.Metadata.Labels,
+			},
+			Spec: &pb.ElasticIPSpecPrivate{},
+			Status: &pb.ElasticIPStatus{
+				Phase:   pb.ElasticIPPhase_ElasticIPPhase_Allocated,
+				Message: "Elastic IP is allocated",
+			},
+		}
+
+		if err := s.create(ctx, elasticIP); err != nil {
+			return nil, err
+		}
+
+		return toElasticIP(elasticIP), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *ElasticIPService) Get(ctx context.Context, req *pb.ElasticIPGetRequest) (*pb.ElasticIP, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("ElasticIPService.Get").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.ElasticIP, error) {
+		elasticIP, err := s.get(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		return toElasticIP(elasticIP), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *ElasticIPService) Search(ctx context.Context, req *pb.ElasticIPSearchRequest) (*pb.ElasticIPSearchResponse, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("ElasticIPService.Search").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.ElasticIPSearchResponse, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		query := fmt.Sprintf(`
+			select %s
+			from   elastic_ip
+			where  cloud_account_id = $1
+			  and  deleted_timestamp = $2
+			order by name, resource_id
+		`, transformer.ColumnsForFromRow())
+
+		rows, err := s.db.QueryContext(ctx, query, cloudAccountId, common.TimestampInfinityStr)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		resp := &pb.ElasticIPSearchResponse{}
+		for rows.Next() {
+			elasticIP, err := s.sqlTransformer.FromRow(ctx, rows)
+			if err != nil {
+				return nil, err
+			}
+			resp.Items = append(resp.Items, toElasticIP(elasticIP))
+		}
+		return resp, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Associate an elastic IP with a port of the same cloud account.
+func (s *ElasticIPService) Associate(ctx context.Context, req *pb.ElasticIPAssociateRequest) (*pb.ElasticIP, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("ElasticIPService.Associate").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*pb.ElasticIP, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		elasticIP, err := s.get(ctx, cloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		if elasticIP.Spec.PortId != "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "elastic ip is already associated with port %s", elasticIP.Spec.PortId)
+		}
+
+		if _, err := uuid.Parse(req.PortId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid port id")
+		}
+		port, err := s.iprmService.GetPortPrivate(ctx, &pb.GetPortPrivateRequest{
+			Metadata: &pb.PortMetadataReference{
+				CloudAccountId: cloudAccountId,
+				ResourceId:     req.PortId,
+			},
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Errorf(codes.InvalidArgument, "port %s not found", req.PortId)
+			}
+			return nil, err
+		}
+		if port.Metadata.DeletionTimestamp != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "port %s is being deleted", req.PortId)
+		}
+
+		count, err := s.countByPort(ctx, req.PortId)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "port %s is already associated with an elastic ip", req.PortId)
+		}
+
+		// The address translation index ensures that a port has at most one elastic address translation.
+		addressTranslation, err := s.addressTranslationService.CreatePrivate(ctx, &pb.AddressTranslationCreatePrivateRequest{
+			Metadata: &pb.AddressTranslationMetadataCreatePrivate{
+				CloudAccountId: cloudAccountId,
+			},
+			Spec: &pb.AddressTranslationSpecPrivate{
+				PortId:          req.PortId,
+				TranslationType: string(address_translation.Elastic),
+				IpAddress:       elasticIP.Spec.IpAddress,
+			},
+		})
+		if err != nil {
+			if status.Code(err) == codes.AlreadyExists {
+				return nil, status.Errorf(codes.FailedPrecondition, "port %s is already associated with an elastic ip", req.PortId)
+			}
+			return nil, err
+		}
+
+		updateFunc := func(elasticIP *pb.ElasticIPPrivate) error {
+			if elasticIP.Spec.PortId != "" {
+				return status.Errorf(codes.FailedPrecondition, "elastic ip is already associated with port %s", elasticIP.Spec.PortId)
+			}
+			elasticIP.Spec.PortId = req.PortId
+			elasticIP.Spec.AddressTranslationId = addressTranslation.Metadata.ResourceId
+			elasticIP.Status = &pb.ElasticIPStatus{
+				Phase:            pb.ElasticIPPhase_ElasticIPPhase_Associated,
+				Message:          "Elastic IP is associated",
+				IpAddress:        elasticIP.Spec.IpAddress,
+				PortId:           req.PortId,
+				PrivateIpAddress: port.Spec.IpAddress,
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, cloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			// Remove the address translation that is not referenced by the elastic IP.
+			if _, deleteErr := s.addressTranslationService.DeletePrivate(ctx, &pb.AddressTranslationGetPrivateRequest{
+				Metadata: &pb.AddressTranslationIdReference{
+					CloudAccountId: cloudAccountId,
+					ResourceId:     addressTranslation.Metadata.ResourceId,
+				},
+			}); deleteErr != nil {
+				logger.Error(deleteErr, "unable to delete address translation", logkeys.ResourceId, addressTranslation.Metadata.ResourceId)
+			}
+			return nil, err
+		}
+
+		elasticIP, err = s.get(ctx, cloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		return toElasticIP(elasticIP), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Disassociate an elastic IP from its port.
+// Disassociating an elastic IP that is not associated succeeds.
+func (s *ElasticIPService) Disassociate(ctx context.Context, req *pb.ElasticIPDisassociateRequest) (*pb.ElasticIP, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("ElasticIPService.Disassociate").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*pb.ElasticIP, error) {
+		elasticIP, err := s.get(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		if elasticIP.Spec.PortId == "" {
+			return toElasticIP(elasticIP), nil
+		}
+
+		if err := s.disassociate(ctx, elasticIP, req.Metadata.ResourceVersion); err != nil {
+			return nil, err
+		}
+
+		elasticIP, err = s.get(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		return toElasticIP(elasticIP), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Release an elastic IP.
+// An associated elastic IP is disassociated before it is released.
+func (s *ElasticIPService) Delete(ctx context.Context, req *pb.ElasticIPDeleteRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("ElasticIPService.Delete").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*emptypb.Empty, error) {
+		elasticIP, err := s.get(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceVersion := req.Metadata.ResourceVersion
+		if elasticIP.Spec.PortId != "" {
+			if err := s.disassociate(ctx, elasticIP, resourceVersion); err != nil {
+				return nil, err
+			}
+			// The resource version was checked by disassociate.
+			resourceVersion = ""
+		}
+
+		updateFunc := func(elasticIP *pb.ElasticIPPrivate) error {
+			if elasticIP.Spec.PortId != "" {
+				return status.Error(codes.FailedPrecondition, "elastic ip was associated while it was being released")
+			}
+			elasticIP.Metadata.DeletedTimestamp = timestamppb.Now()
+			return nil
+		}
+
+		if err := s.update(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId, resourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Private API.
+func (s *ElasticIPService) GetPrivate(ctx context.Context, req *pb.ElasticIPGetPrivateRequest) (*pb.ElasticIPPrivate, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("ElasticIPService.GetPrivate").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.ElasticIPPrivate, error) {
+		return s.get(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, err
+}
+
+// Delete the address translation of an associated elastic IP and remove the port from the elastic IP.
+func (s *ElasticIPService) disassociate(ctx context.Context, elasticIP *pb.ElasticIPPrivate, resourceVersion string) error {
+	// Check the resource version before the address translation is deleted.
+	if resourceVersion != "" && resourceVersion != elasticIP.Metadata.ResourceVersion {
+		return status.Error(codes.FailedPrecondition, "stored resource version does not match requested resource version")
+	}
+
+	cloudAccountId := elasticIP.Metadata.CloudAccountId
+	addressTranslationId := elasticIP.Spec.AddressTranslationId
+	if addressTranslationId != "" {
+		if _, err := s.addressTranslationService.DeletePrivate(ctx, &pb.AddressTranslationGetPrivateRequest{
+			Metadata: &pb.AddressTranslationIdReference{
+				CloudAccountId: cloudAccountId,
+				ResourceId:     addressTranslationId,
+			},
+		}); err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+	}
+
+	updateFunc := func(elasticIP *pb.ElasticIPPrivate) error {
+		if elasticIP.Spec.AddressTranslationId != addressTranslationId {
+			return status.Error(codes.FailedPrecondition, "elastic ip association has changed")
+		}
+		elasticIP.Spec.PortId = ""
+		elasticIP.Spec.AddressTranslationId = ""
+		elasticIP.Status = &pb.ElasticIPStatus{
+			Phase:     pb.ElasticIPPhase_ElasticIPPhase_Allocated,
+			Message:   "Elastic IP is allocated",
+			IpAddress: elasticIP.Spec.IpAddress,
+		}
+		return nil
+	}
+
+	return s.update(ctx, cloudAccountId, elasticIP.Metadata.ResourceId, "", updateFunc)
+}
+
+// Return the number of elastic IPs that are associated with the port.
+func (s *ElasticIPService) countByPort(ctx context.Context, portId string) (int, error) {
+	var count int
+	query := `
+		select count(*)
+		from   elastic_ip
+		where  deleted_timestamp = $1
+		  and  value->'spec'->>'portId' = $2
+	`
+	if err := s.db.QueryRowContext(ctx, query, common.TimestampInfinityStr, portId).Scan(&count); err != nil {
+		return 0, fmt.Errorf("countByPort: %w", err)
+	}
+	return count, nil
+}
+
+// Return the public IP addresses of all elastic IPs that have not been released.
+func (s *ElasticIPService) allocatedIPAddresses(ctx context.Context) (map[string]bool, error) {
+	query := `
+		select value->'spec'->>'ipAddress'
+		from   elastic_ip
+		where  deleted_timestamp = $1
+	`
+	rows, err := s.db.QueryContext(ctx, query, common.TimestampInfinityStr)
+	if err != nil {
+		return nil, fmt.Errorf("allocatedIPAddresses: %w", err)
+	}
+	defer rows.Close()
+	allocated := map[string]bool{}
+	for rows.Next() {
+		var ipAddress string
+		if err := rows.Scan(&ipAddress); err != nil {
+			return nil, fmt.Errorf("allocatedIPAddresses: %w", err)
+		}
+		allocated[ipAddress] = true
+	}
+	return allocated, rows.Err()
+}
+
+func (s *ElasticIPService) get(ctx context.Context, cloudAccountId string, resourceId string) (*pb.ElasticIPPrivate, error) {
+	if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(resourceId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid resource id")
+	}
+	rows, err := s.selectElasticIP(ctx, cloudAccountId, elasticIPIdKey, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return s.sqlTransformer.FromRow(ctx, rows)
+}
+
+// Update an elastic IP record using the user-provided updateFunc to update the elastic IP.
+// This uses optimistic concurrency control to ensure that the record has not been updated between the select and update.
+// Additionally, if the caller provides a resource version, optimistic concurrency control can be extended to
+// previous get or search calls.
+func (s *ElasticIPService) update(
+	ctx context.Context,
+	cloudAccountId string,
+	resourceId string,
+	resourceVersion string,
+	updateFunc func(*pb.ElasticIPPrivate) error) error {
+
+	query := fmt.Sprintf(`
+		select %s
+		from   elastic_ip
+		where  cloud_account_id = $1
+			and  %s = $2
+			and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow(), elasticIPIdKey)
+
+	// Retry on conflict if caller did not provide resourceVersion.
+	isRetryable := func(err error) bool {
+		return resourceVersion == "" && status.Code(err) == codes.FailedPrecondition
+	}
+
+	err := retry.OnError(retry.DefaultRetry, isRetryable, func() error {
+		rows, err := s.db.QueryContext(ctx, query, cloudAccountId, resourceId, common.TimestampInfinityStr)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		if !rows.Next() {
+			return status.Error(codes.NotFound, "resource not found")
+		}
+		elasticIP, err := s.sqlTransformer.FromRow(ctx, rows)
+		if err != nil {
+			return err
+		}
+		metadata := elasticIP.Metadata
+
+		// If resource version was provided, ensure that stored version matches.
+		if resourceVersion != "" && resourceVersion != metadata.ResourceVersion {
+			return status.Error(codes.FailedPrecondition, "stored resource version does not match requested resource version")
+		}
+
+		// Update ElasticIP object.
+		if err := updateFunc(elasticIP); err != nil {
+			return err
+		}
+
+		// Flatten elastic IP into columns.
+		flattened, err := s.sqlTransformer.Flatten(ctx, elasticIP)
+		if err != nil {
+			return err
+		}
+
+		args := append([]any{metadata.CloudAccountId, metadata.ResourceId, metadata.ResourceVersion}, flattened.Values...)
+
+		deletedTimestamp := ""
+		if metadata.DeletedTimestamp != nil {
+			deletedTimestamp = "deleted_timestamp = '" + metadata.DeletedTimestamp.AsTime().Format(time.RFC3339) + "',"
+		}
+
+		// Update database.
+		updateQuery := fmt.Sprintf(`
+		update elastic_ip
+		set    resource_version = nextval('elastic_ip_resource_version_seq'),
+			   updated_timestamp = now(),
+			   %s
+			   %s
+		where  cloud_account_id = $1
+		and    resource_id = $2
+		and    resource_version = $3
+		`, deletedTimestamp, flattened.GetUpdateSetString(4))
+		sqlResult, err := s.db.ExecContext(ctx, updateQuery, args...)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected < 1 {
+			return status.Error(codes.FailedPrecondition, "no records updated; possible update conflict")
+		}
+
+		return nil
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return status.Error(st.Code(), "update: "+st.Message())
+	}
+	return nil
+}
+
+// Validates and sets defaults in the provided ElasticIP object, allocates a public IP address, and stores it in the database.
+func (s *ElasticIPService) create(ctx context.Context, elasticIP *pb.ElasticIPPrivate) error {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("ElasticIPService.create").WithValues(logkeys.CloudAccountId, elasticIP.Metadata.CloudAccountId).Start()
+	defer span.End()
+
+	// Validate
+	if err := utils.ValidateLabels(elasticIP.Metadata.Labels); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := networkutils.ValidateSubnetName(elasticIP.Metadata.Name); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Calculate resourceId
+	resourceId, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	elasticIP.Metadata.ResourceId = resourceId.String()
+
+	// Calculate name if not provided.
+	if elasticIP.Metadata.Name == "" {
+		elasticIP.Metadata.Name = elasticIP.Metadata.ResourceId
+	}
+	name := elasticIP.Metadata.Name
+	elasticIP.Metadata.CreationTimestamp = timestamppb.Now()
+
+	// Concurrent requests may allocate the same public IP address. Only one of them can be inserted.
+	for attempt := 1; ; attempt++ {
+		allocated, err := s.allocatedIPAddresses(ctx)
+		if err != nil {
+			return err
+		}
+		ipAddress, err := s.iprmService.AllocatePublicIP(allocated)
+		if err != nil {
+			return err
+		}
+		elasticIP.Spec.IpAddress = ipAddress
+		elasticIP.Status.IpAddress = ipAddress
+
+		// Flatten elastic IP into columns.
+		flattened, err := s.sqlTransformer.Flatten(ctx, elasticIP)
+		if err != nil {
+			return err
+		}
+
+		// Insert into database.
+		query := fmt.Sprintf(`insert into elastic_ip (resource_id, cloud_account_id, name, %s) values ($1, $2, $3, %s) returning resource_version`,
+			flattened.GetColumnsString(), flattened.GetInsertValuesString(4))
+		args := append([]any{elasticIP.Metadata.ResourceId, elasticIP.Metadata.CloudAccountId, name}, flattened.Values...)
+		err = s.db.QueryRowContext(ctx, query, args...).Scan(&elasticIP.Metadata.ResourceVersion)
+		if err == nil {
+			return nil
+		}
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation {
+			if pgErr.ConstraintName == ipAddressIndexName && attempt < maxAllocateAttempts {
+				log.Info("public ip address was allocated by another request, retrying", logkeys.ResourceId, elasticIP.Metadata.ResourceId)
+				continue
+			}
+			if pgErr.ConstraintName == ipAddressIndexName {
+				return status.Error(codes.Unavailable, "insert: unable to allocate a public ip address, try again")
+			}
+			return status.Error(codes.AlreadyExists, "insert: elastic ip "+name+" already exists")
+		}
+		return fmt.Errorf("insert: %w", err)
+	}
+}
+
+// Caller must close the returned sql.Rows.
+func (s *ElasticIPService) selectElasticIP(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*sql.Rows, error) {
+	query := fmt.Sprintf(`
+		select %s
+		from   elastic_ip
+		where  cloud_account_id = $1
+		  and  %s = $2
+		  and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow(), argName)
+
+	rows, err := s.db.QueryContext(ctx, query, cloudAccountId, arg, common.TimestampInfinityStr)
+	if err != nil {
+		return nil, fmt.Errorf("selectElasticIP: %w", err)
+	}
+	if !rows.Next() {
+		defer rows.Close()
+		return nil, status.Error(codes.NotFound, "resource not found")
+	}
+	return rows, nil
+}
+
+// Convert an ElasticIPPrivate to a public ElasticIP.
+func toElasticIP(elasticIP *pb.ElasticIPPrivate) *pb.ElasticIP {
+	return &pb.ElasticIP{
+		Metadata: &pb.ElasticIPMetadata{
+			CloudAccountId:    elasticIP.Metadata.CloudAccountId,
+			Name:              elasticIP.Metadata.Name,
+			ResourceId:        elasticIP.Metadata.ResourceId,
+			ResourceVersion:   elasticIP.Metadata.ResourceVersion,
+			Labels:            elasticIP.Metadata.Labels,
+			CreationTimestamp: elasticIP.Metadata.CreationTimestamp,
+		},
+		Status: &pb.ElasticIPStatus{
+			Phase:            elasticIP.Status.GetPhase(),
+			Message:          elasticIP.Status.GetMessage(),
+			IpAddress:        elasticIP.Spec.GetIpAddress(),
+			PortId:           elasticIP.Spec.GetPortId(),
+			PrivateIpAddress: elasticIP.Status.GetPrivateIpAddress(),
+		},
+	}
+}
@@ -0,0 +1,175 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package elastic_ip
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/transformer"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultServiceType      = "ElasticIPAsAService"
+	defaultMeteringInterval = time.Hour
+)
+
+// ElasticIPMeteringReporter periodically reports the lifetime of each elastic IP to the metering service.
+// Elastic IPs are metered from allocation until release, whether or not they are associated.
+// The time of the last report is stored in the database so that released elastic IPs are reported after a restart.
+type ElasticIPMeteringReporter struct {
+	db             *sql.DB
+	sqlTransformer *ElasticIPSQLTransformer
+	meteringClient pb.MeteringServiceClient
+	serviceType    string
+	region         string
+	interval       time.Duration
+}
+
+func NewElasticIPMeteringReporter(db *sql.DB, cfg config.Config, meteringClient pb.MeteringServiceClient) (*ElasticIPMeteringReporter, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	if meteringClient == nil {
+		return nil, fmt.Errorf("meteringClient is required")
+	}
+	r := &ElasticIPMeteringReporter{
+		db:             db,
+		sqlTransformer: NewElasticIPSQLTransformer(),
+		meteringClient: meteringClient,
+		serviceType:    cfg.ElasticIP.ServiceType,
+		region:         cfg.Region,
+		interval:       cfg.ElasticIP.MeteringInterval,
+	}
+	if r.serviceType == "" {
+		r.serviceType = defaultServiceType
+	}
+	if r.interval <= 0 {
+		r.interval = defaultMeteringInterval
+	}
+	return r, nil
+}
+
+// Report usage every interval until the context is cancelled.
+func (r *ElasticIPMeteringReporter) Start(ctx context.Context) {
+	log := log.FromContext(ctx).WithName("ElasticIPMeteringReporter.Start")
+	log.Info("starting elastic ip metering reporter", "interval", r.interval)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Report(ctx); err != nil {
+				log.Error(err, "error reporting elastic ip usage")
+			}
+		}
+	}
+}
+
+// Report creates a usage record for every elastic IP that was allocated since the previous report.
+// The time of the report is only stored if all usage records were created, otherwise the next report retries them.
+func (r *ElasticIPMeteringReporter) Report(ctx context.Context) error {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("ElasticIPMeteringReporter.Report").Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+
+	now := time.Now()
+	var lastUsageRecordTimestamp time.Time
+	if err := r.db.QueryRowContext(ctx, `select last_usage_record_timestamp from elastic_ip_metering`).Scan(&lastUsageRecordTimestamp); err != nil {
+		return fmt.Errorf("error reading the time of the last elastic ip usage report: %w", err)
+	}
+	// Released elastic IPs are reported one last time.
+	query := fmt.Sprintf(`
+		select %s
+		from   elastic_ip
+		where  deleted_timestamp > $1
+	`, transformer.ColumnsForFromRow())
+	rows, err := r.db.QueryContext(ctx, query, lastUsageRecordTimestamp)
+	if err != nil {
+		return fmt.Errorf("error searching elastic ips: %w", err)
+	}
+	defer rows.Close()
+
+	var elasticIPs []*pb.ElasticIPPrivate
+	for rows.Next() {
+		elasticIP, err := r.sqlTransformer.FromRow(ctx, rows)
+		if err != nil {
+			return err
+		}
+		elasticIPs = append(elasticIPs, elasticIP)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, elasticIP := range elasticIPs {
+		meteringRecord := r.usageRecord(elasticIP, now)
+		if meteringRecord == nil {
+			continue
+		}
+		if _, err := r.meteringClient.Create(ctx, meteringRecord); err != nil {
+			log.Error(err, "error creating elastic ip metering record", logkeys.ResourceId, elasticIP.Metadata.ResourceId)
+			failed++
+			continue
+		}
+		log.Info("Created metering record", logkeys.MeteringRecord, meteringRecord)
+	}
+	if failed > 0 {
+		return fmt.Errorf("error creating %d of %d elastic ip metering records", failed, len(elasticIPs))
+	}
+
+	if _, err := r.db.ExecContext(ctx, `update elastic_ip_metering set last_usage_record_timestamp = $1`, now); err != nil {
+		return fmt.Errorf("error storing the time of the elastic ip usage report: %w", err)
+	}
+	return nil
+}
+
+// Returns nil when the elastic IP has no billable lifetime.
+func (r *ElasticIPMeteringReporter) usageRecord(elasticIP *pb.ElasticIPPrivate, now time.Time) *pb.UsageCreate {
+	isDeleted := elasticIP.Metadata.DeletedTimestamp != nil
+	endTime := now
+	if isDeleted {
+		endTime = elasticIP.Metadata.DeletedTimestamp.AsTime()
+	}
+	lifetime := endTime.Sub(elasticIP.Metadata.CreationTimestamp.AsTime())
+	if lifetime <= 0 {
+		return nil
+	}
+	lifetimeInHours := "1" // lifetime minimum 1 hour
+	if lifetime.Hours() > 1 {
+		lifetimeInHours = fmt.Sprintf("%v", lifetime.Hours())
+	}
+
+	// Since these usage records use cumulative counters, deduplication is not needed. It is effectively disabled by using a random transactionId.
+	return &pb.UsageCreate{
+		TransactionId:  uuid.NewString(),
+		ResourceId:     elasticIP.Metadata.ResourceId,
+		CloudAccountId: elasticIP.Metadata.CloudAccountId,
+		Timestamp:      timestamppb.New(now),
+		Properties: map[string]string{
+			"elasticIpName":     elasticIP.Metadata.Name,
+			"ipAddress":         elasticIP.Spec.IpAddress,
+			"associated":        strconv.FormatBool(elasticIP.Spec.PortId != ""),
+			"creationTimestamp": elasticIP.Metadata.CreationTimestamp.AsTime().Format(time.RFC3339),
+			"deleted":           strconv.FormatBool(isDeleted),
+			"serviceType":       r.serviceType,
+			"hour":              lifetimeInHours,
+			"region":            r.region,
+		},
+	}
+}
@@ -0,0 +1,93 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package elastic_ip
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Transforms an ElasticIP to a form that can be written to a SQL database.
+// Also performs the inverse, reading from sql.Rows and creating an ElasticIP.
+// This uses the JSON serializer from the GRPC Gateway.
+type ElasticIPSQLTransformer struct {
+	marshaler *runtime.JSONPb
+}
+
+func NewElasticIPSQLTransformer() *ElasticIPSQLTransformer {
+	return &ElasticIPSQLTransformer{
+		marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				// When writing JSON, emit fields that have default values, including for enums.
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				// When reading JSON, ignore fields with unknown names.
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+// Returns a Flattened object that can be used to construct a SQL INSERT or UPDATE statement.
+// The Flattened object omits columns that are never updated, such as the primary key columns.
+func (s *ElasticIPSQLTransformer) Flatten(ctx context.Context, elasticIP *pb.ElasticIPPrivate) (*protodb.Flattened, error) {
+	flattened := &protodb.Flattened{}
+	jsonElasticIP, err := s.marshaler.Marshal(elasticIP)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to json: %w", err)
+	}
+	flattened.Add("value", jsonElasticIP)
+	return flattened, nil
+}
+
+// Read a database row into an ElasticIP.
+func (s *ElasticIPSQLTransformer) FromRow(ctx context.Context, rows *sql.Rows) (*pb.ElasticIPPrivate, error) {
+	log := log.FromContext(ctx).WithName("ElasticIPSQLTransformer.FromRow")
+	metadata := &pb.ElasticIPMetadataPrivate{}
+	var deletedTimestamp string
+	var resourceJson []byte
+	if err := rows.Scan(&metadata.CloudAccountId, &metadata.ResourceId, &metadata.Name, &deletedTimestamp, &metadata.ResourceVersion, &resourceJson); err != nil {
+		return nil, fmt.Errorf("RowToElasticIPPrivate: Scan: %w", err)
+	}
+	log.V(9).Info("scanned", logkeys.ResourceId, metadata.ResourceId, logkeys.ResourceJson, string(resourceJson))
+	elasticIP := &pb.ElasticIPPrivate{}
+	if err := s.marshaler.Unmarshal(resourceJson, &elasticIP); err != nil {
+		return nil, err
+	}
+	log.V(9).Info("decoded", logkeys.ELASTIC_IP, elasticIP)
+	// Copy fields directly in the row to the elastic IP.
+	elasticIP.Metadata.CloudAccountId = metadata.CloudAccountId
+	elasticIP.Metadata.ResourceId = metadata.ResourceId
+	elasticIP.Metadata.ResourceVersion = metadata.ResourceVersion
+	var err error
+	elasticIP.Metadata.DeletedTimestamp, err = timestampStrToPbTimestamp(deletedTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return elasticIP, nil
+}
+
+// Convert a timestamp from Postgres format to Protobuf.
+// The special time "infinity" is returned as (nil, nil).
+func timestampStrToPbTimestamp(ts string) (*timestamppb.Timestamp, error) {
+	if ts == common.TimestampInfinityStr {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
@@ -0,0 +1,78 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package iprm
+
+import (
+	"fmt"
+	"net/netip"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IPPool allocates IP addresses from a list of CIDR blocks.
+// The pool does not store allocations. The caller provides the addresses that are already allocated.
+type IPPool struct {
+	prefixes []netip.Prefix
+}
+
+func NewIPPool(cidrBlocks []string) (*IPPool, error) {
+	pool := &IPPool{}
+	for _, cidrBlock := range cidrBlocks {
+		prefix, err := netip.ParsePrefix(cidrBlock)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip pool cidr block %s: %w", cidrBlock, err)
+		}
+		pool.prefixes = append(pool.prefixes, prefix.Masked())
+	}
+	return pool, nil
+}
+
+// Returns true if the address belongs to the pool.
+func (p *IPPool) Contains(ipAddress string) bool {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range p.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the first usable address of the pool that is not allocated.
+// The network and broadcast addresses of IPv4 CIDR blocks are not usable.
+// Returns ResourceExhausted if all addresses are allocated.
+func (p *IPPool) Allocate(allocated map[string]bool) (string, error) {
+	for _, prefix := range p.prefixes {
+		first := prefix.Addr()
+		last := lastAddr(prefix)
+		if first.Is4() && prefix.Bits() < 31 {
+			first = first.Next()
+			last = last.Prev()
+		}
+		for addr := first; addr.IsValid() && addr.Compare(last) <= 0; addr = addr.Next() {
+			if !allocated[addr.String()] {
+				return addr.String(), nil
+			}
+		}
+	}
+	return "", status.Error(codes.ResourceExhausted, "no ip addresses are available")
+}
+
+// Returns the last address of a masked prefix.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for i := range bytes {
+		hostBits := len(bytes)*8 - prefix.Bits() - (len(bytes)-1-i)*8
+		if hostBits >= 8 {
+			bytes[i] = 0xff
+		} else if hostBits > 0 {
+			bytes[i] |= byte(1<<hostBits) - 1
+		}
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
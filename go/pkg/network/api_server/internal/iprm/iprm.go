@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net/netip"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +16,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/security_group"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/subnet"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/transformer"
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
@@ -29,6 +31,8 @@ import (
 
 const (
 	portIdKey = "resource_id"
+	// Unique index of the IPv6 addresses of the ports of a subnet.
+	ipv6AddressIndexName = "idx_port_ipv6_address"
 )
 
 type IPRMService struct {
@@ -39,6 +43,8 @@ type IPRMService struct {
 	cloudAccountServiceClient pb.CloudAccountServiceClient
 	sqlTransformer            *PortSQLTransformer
 	subnetService             *subnet.SubnetService
+	securityGroupService      *security_group.SecurityGroupService
+	publicIPPool              *IPPool
 }
 
 func NewIPRMService(
@@ -46,10 +52,15 @@ func NewIPRMService(
 	config config.Config,
 	cloudAccountServiceClient pb.CloudAccountServiceClient,
 	subnetService *subnet.SubnetService,
+	securityGroupService *security_group.SecurityGroupService,
 ) (*IPRMService, error) {
 	if db == nil {
 		return nil, fmt.Errorf("db is required")
 	}
+	publicIPPool, err := NewIPPool(config.ElasticIP.PublicIPPools)
+	if err != nil {
+		return nil, err
+	}
 
 	return &IPRMService{
 		db:                        db,
@@ -57,6 +68,8 @@ func NewIPRMService(
 		cloudAccountServiceClient: cloudAccountServiceClient,
 		sqlTransformer:            NewPortSQLTransformer(),
 		subnetService:             subnetService,
+		securityGroupService:      securityGroupService,
+		publicIPPool:              publicIPPool,
 	}, nil
 }
 
@@ -87,7 +100,7 @@ func (s *IPRMService) ReservePort(ctx context.Context, req *pb.ReservePortReques
 		}
 
 		// validate subnet.
-		_, err := s.subnetService.Get(ctx, &pb.SubnetGetRequest{
+		subnet, err := s.subnetService.Get(ctx, &pb.SubnetGetRequest{
 			Metadata: &pb.SubnetMetadataReference{
 				CloudAccountId: cloudAccountId,
 				NameOrId:       &pb.SubnetMetadataReference_ResourceId{ResourceId: req.Spec.SubnetId},
@@ -97,37 +110,78 @@ func (s *IPRMService) ReservePort(ctx context.Context, req *pb.ReservePortReques
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, "invalid subnet")
 		}
+
+		// validate ipv6 address.
+		ipv6Address := req.Spec.Ipv6Address
+		if ipv6Address != "" {
+			if subnet.Spec.Ipv6CidrBlock == "" {
+				return nil, status.Error(codes.InvalidArgument, "subnet does not have an ipv6 cidr block")
+			}
+			ipv6Pool, err := NewIPPool([]string{subnet.Spec.Ipv6CidrBlock})
+			if err != nil {
+				return nil, err
+			}
+			addr, err := netip.ParseAddr(ipv6Address)
+			if err != nil || !addr.Is6() || !ipv6Pool.Contains(ipv6Address) {
+				return nil, status.Error(codes.InvalidArgument, "invalid ipv6 address")
+			}
+			ipv6Address = addr.String()
+		}
+
+		// validate security groups.
+		securityGroupIds, err := s.securityGroupService.ValidateSecurityGroups(ctx, cloudAccountId, subnet.Spec.VpcId, req.Spec.SecurityGroupIds)
+		if err != nil {
+			return nil, err
+		}
 		port := &pb.PortPrivate{
 			Metadata: &pb.PortMetadataPrivate{
 				CloudAccountId: cloudAccountId,
 			},
 			Spec: &pb.PortSpecPrivate{
-				SubnetId:        req.Spec.SubnetId,
-				IpuSerialNumber: req.Spec.IpuSerialNumber,
-				ChassisId:       req.Spec.ChassisId,
-				IpAddress:       req.Spec.IpAddress,
-				MacAddress:      req.Spec.MacAddress,
-				SshEnabled:      req.Spec.SshEnabled,
-				InternetAccess:  req.Spec.InternetAccess,
+				SubnetId:         req.Spec.SubnetId,
+				IpuSerialNumber:  req.Spec.IpuSerialNumber,
+				ChassisId:        req.Spec.ChassisId,
+				IpAddress:        req.Spec.IpAddress,
+				MacAddress:       req.Spec.MacAddress,
+				SshEnabled:       req.Spec.SshEnabled,
+				InternetAccess:   req.Spec.InternetAccess,
+				SecurityGroupIds: securityGroupIds,
+				Ipv6Address:      ipv6Address,
 			},
 			Status: &pb.PortStatusPrivate{
 				Phase:   pb.PortPhase_PortPhase_Provisioning,
 				Message: "Port is provisioning",
 			},
 		}
-		if err := s.create(ctx, port); err != nil {
+		if err := s.create(ctx, port, subnet.Spec.Ipv6CidrBlock); err != nil {
 			pgErr := &pgconn.PgError{}
+			if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation && pgErr.ConstraintName == ipv6AddressIndexName {
+				return nil, status.Error(codes.AlreadyExists, "ipv6 address is already in use")
+			}
 			// Unique violation means that the port already exists.
 			if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation {
 				// Return the port that already exists.
-				return s.get(ctx, cloudAccountId, map[string]interface{}{
+				existingPort, err := s.get(ctx, cloudAccountId, map[string]interface{}{
 					"value->'spec'->>'ipuSerialNumber'": port.Spec.IpuSerialNumber,
 					"value->'spec'->>'chassisId'":       port.Spec.ChassisId,
 					"value->'spec'->>'macAddress'":      port.Spec.MacAddress,
 				})
+				if err != nil {
+					return nil, err
+				}
+				// Complete the attachment of the security groups in case a previous request failed.
+				if err := s.securityGroupService.UpdatePortSecurityGroups(ctx, cloudAccountId, existingPort.Metadata.ResourceId,
+					nil, existingPort.Spec.SecurityGroupIds); err != nil {
+					return nil, err
+				}
+				return existingPort, nil
 			}
 			return nil, err
 		}
+		// Attach the port to its security groups.
+		if err := s.securityGroupService.UpdatePortSecurityGroups(ctx, cloudAccountId, port.Metadata.ResourceId, nil, securityGroupIds); err != nil {
+			return nil, err
+		}
 		// Query database and return response.
 		return s.get(ctx, cloudAccountId, map[string]interface{}{
 			portIdKey: port.Metadata.ResourceId,
@@ -154,7 +208,9 @@ func (s *IPRMService) ReleasePort(ctx context.Context, req *pb.ReleasePortReques
 		return nil, err
 	}
 
+	var securityGroupIds []string
 	updateFunc := func(port *pb.PortPrivate) error {
+		securityGroupIds = port.Spec.SecurityGroupIds
 		// TODO: use status only ?!
 		if port.Metadata.DeletionTimestamp == nil {
 			port.Metadata.DeletionTimestamp = timestamppb.Now()
@@ -170,9 +226,78 @@ func (s *IPRMService) ReleasePort(ctx context.Context, req *pb.ReleasePortReques
 		return nil, err
 	}
 
+	// Detach the port from its security groups.
+	if err := s.securityGroupService.UpdatePortSecurityGroups(ctx, cloudAccountId, req.Metadata.GetResourceId(), securityGroupIds, nil); err != nil {
+		return nil, err
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
+// Replace the security groups attached to a port.
+// Private API.
+func (s *IPRMService) UpdateSecurityGroups(ctx context.Context, req *pb.PortUpdateSecurityGroupsRequest) (*pb.PortPrivate, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("IPRMService.UpdateSecurityGroups").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*pb.PortPrivate, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		port, err := s.get(ctx, cloudAccountId, map[string]interface{}{
+			portIdKey: req.Metadata.GetResourceId(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if port.Metadata.DeletionTimestamp != nil {
+			return nil, status.Error(codes.FailedPrecondition, "port is being released")
+		}
+
+		subnet, err := s.subnetService.Get(ctx, &pb.SubnetGetRequest{
+			Metadata: &pb.SubnetMetadataReference{
+				CloudAccountId: cloudAccountId,
+				NameOrId:       &pb.SubnetMetadataReference_ResourceId{ResourceId: port.Spec.SubnetId},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		securityGroupIds, err := s.securityGroupService.ValidateSecurityGroups(ctx, cloudAccountId, subnet.Spec.VpcId, req.SecurityGroupIds)
+		if err != nil {
+			return nil, err
+		}
+
+		updateFunc := func(port *pb.PortPrivate) error {
+			port.Spec.SecurityGroupIds = securityGroupIds
+			return nil
+		}
+		if err := s.update(ctx, cloudAccountId, port.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+
+		if err := s.securityGroupService.UpdatePortSecurityGroups(ctx, cloudAccountId, port.Metadata.ResourceId,
+			port.Spec.SecurityGroupIds, securityGroupIds); err != nil {
+			return nil, err
+		}
+
+		return s.get(ctx, cloudAccountId, map[string]interface{}{
+			portIdKey: port.Metadata.ResourceId,
+		})
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, err
+}
+
 // Allow update of:
 //   - Status
 //
@@ -243,6 +368,12 @@ func (s *IPRMService) GetPortPrivate(ctx context.Context, req *pb.GetPortPrivate
 	return resp, err
 }
 
+// Allocate a public IP address that is not in allocated.
+// Returns ResourceExhausted if all addresses of the public IP pool are allocated.
+func (s *IPRMService) AllocatePublicIP(allocated map[string]bool) (string, error) {
+	return s.publicIPPool.Allocate(allocated)
+}
+
 func (s *IPRMService) get(ctx context.Context, cloudAccountId string, args map[string]interface{}) (*pb.PortPrivate, error) {
 	rows, err := s.selectPort(ctx, cloudAccountId, args)
 
@@ -295,7 +426,8 @@ func (s *IPRMService) selectPort(ctx context.Context, cloudAccountId string, arg
 	return rows, nil
 }
 
-func (s *IPRMService) create(ctx context.Context, port *pb.PortPrivate) error {
+// If ipv6CidrBlock is not empty and the port does not have an IPv6 address, one is allocated from the block.
+func (s *IPRMService) create(ctx context.Context, port *pb.PortPrivate, ipv6CidrBlock string) error {
 	ctx, _, span := obs.LogAndSpanFromContext(ctx).WithName("IPRMService.create").WithValues(logkeys.CloudAccountId, port.Metadata.CloudAccountId).Start()
 	defer span.End()
 
@@ -315,6 +447,14 @@ func (s *IPRMService) create(ctx context.Context, port *pb.PortPrivate) error {
 	}
 	defer tx.Rollback()
 
+	if ipv6CidrBlock != "" && port.Spec.Ipv6Address == "" {
+		ipv6Address, err := s.allocateIPv6Address(ctx, tx, port.Spec.SubnetId, ipv6CidrBlock)
+		if err != nil {
+			return err
+		}
+		port.Spec.Ipv6Address = ipv6Address
+	}
+
 	// Flatten instance into columns.
 	flattened, err := s.sqlTransformer.Flatten(ctx, port)
 	if err != nil {
@@ -336,6 +476,62 @@ func (s *IPRMService) create(ctx context.Context, port *pb.PortPrivate) error {
 	return nil
 }
 
+// Returns the first IPv6 address of the subnet that is not used by another port.
+// The subnet row is locked until the transaction ends so that concurrent requests do not allocate the same address.
+// The subnet-router anycast address (::0) and the gateway address (::1) are never allocated.
+func (s *IPRMService) allocateIPv6Address(ctx context.Context, tx *sql.Tx, subnetId string, ipv6CidrBlock string) (string, error) {
+	query := `
+		select resource_id
+		from   subnet
+		where  resource_id = $1
+		for update
+	`
+	rows, err := tx.QueryContext(ctx, query, subnetId)
+	if err != nil {
+		return "", fmt.Errorf("lock subnet: %w", err)
+	}
+	rows.Close()
+
+	pool, err := NewIPPool([]string{ipv6CidrBlock})
+	if err != nil {
+		return "", err
+	}
+	prefix, err := netip.ParsePrefix(ipv6CidrBlock)
+	if err != nil {
+		return "", err
+	}
+	subnetRouterAddress := prefix.Masked().Addr()
+	allocated := map[string]bool{
+		subnetRouterAddress.String():        true,
+		subnetRouterAddress.Next().String(): true,
+	}
+
+	query = `
+		select value->'spec'->>'ipv6Address'
+		from   port
+		where  value->'spec'->>'subnetId' = $1
+		  and  coalesce(value->'spec'->>'ipv6Address', '') <> ''
+		  and  deleted_timestamp = $2
+	`
+	rows, err = tx.QueryContext(ctx, query, subnetId, common.TimestampInfinityStr)
+	if err != nil {
+		return "", fmt.Errorf("select ipv6 addresses: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var ipv6Address string
+		if err := rows.Scan(&ipv6Address); err != nil {
+			return "", err
+		}
+		allocated[ipv6Address] = true
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	return pool.Allocate(allocated)
+}
+
 // Update a port record using the user-provided updateFunc to update the port.
 // This uses optimistic concurrency control to ensure that the record has not been updated between the select and update.
 // Additionally, if the caller provides a resource version, optimistic concurrency control can be extended to
@@ -0,0 +1,808 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package route_table
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/pbconvert"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/subnet"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/transformer"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/vpc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/vpc_peering"
+	networkutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/utils"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	routeTableIdKey = "resource_id"
+)
+
+type RouteTableService struct {
+	pb.UnimplementedRouteTableServiceServer
+	pb.UnimplementedRouteTablePrivateServiceServer
+	db                        *sql.DB
+	cfg                       config.Config
+	cloudAccountServiceClient pb.CloudAccountServiceClient
+	sqlTransformer            *RouteTableSQLTransformer
+	pbConverter               *pbconvert.PbConverter
+	vpcService                *vpc.VPCService
+	subnetService             *subnet.SubnetService
+	vpcPeeringService         *vpc_peering.VPCPeeringService
+}
+
+func NewRouteTableService(
+	db *sql.DB,
+	config config.Config,
+	cloudAccountServiceClient pb.CloudAccountServiceClient,
+	vpcService *vpc.VPCService,
+	subnetService *subnet.SubnetService,
+	vpcPeeringService *vpc_peering.VPCPeeringService,
+) (*RouteTableService, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	return &RouteTableService{
+		db:                        db,
+		cfg:                       config,
+		cloudAccountServiceClient: cloudAccountServiceClient,
+		sqlTransformer:            NewRouteTableSQLTransformer(),
+		pbConverter:               pbconvert.NewPbConverter(),
+		vpcService:                vpcService,
+		subnetService:             subnetService,
+		vpcPeeringService:         vpcPeeringService,
+	}, nil
+}
+
+func (s *RouteTableService) Ping(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("RouteTableService.Ping")
+	log.Info("Ping")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RouteTableService) PingPrivate(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("RouteTableService.PingPrivate")
+	log.Info("PingPrivate")
+	return &emptypb.Empty{}, nil
+}
+
+// Public API: Create a new route table
+func (s *RouteTableService) Create(ctx context.Context, req *pb.RouteTableCreateRequest) (*pb.RouteTable, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableService.Create").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.RouteTable, error) {
+		// Validate input.
+		if req.Spec == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing spec")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		// Validate the VPCId passed is valid for this cloud account
+		vpc, err := s.vpcService.ValidateVPC(ctx, cloudAccountId, req.Spec.VpcId)
+		if err != nil {
+			return nil, err
+		}
+
+		spec, err := s.newSpec(ctx, vpc, "", req.Spec.Routes, req.Spec.SubnetIds)
+		if err != nil {
+			return nil, err
+		}
+
+		routeTable := &pb.RouteTablePrivate{
+			Metadata: &pb.RouteTableMetadataPrivate{
+				CloudAccountId: cloudAccountId,
+				Name:           req.Metadata.Name,
+				Labels:         req.Metadata.Labels,
+			},
+			Spec: spec,
+			Status: &pb.RouteTableStatusPrivate{
+				Phase:   pb.RouteTablePhase_RouteTablePhase_Provisioning,
+				Message: "Route table is provisioning",
+			},
+		}
+
+		if err := s.create(ctx, routeTable); err != nil {
+			return nil, err
+		}
+
+		// Query database and return response.
+		return s.get(ctx, cloudAccountId, routeTableIdKey, routeTable.Metadata.ResourceId)
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *RouteTableService) Get(ctx context.Context, req *pb.RouteTableGetRequest) (*pb.RouteTable, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableService.Get").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.RouteTable, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		argName, arg, err := s.uniqueColumnAndValue(req.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.get(ctx, cloudAccountId, argName, arg)
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *RouteTableService) Search(ctx context.Context, req *pb.RouteTableSearchRequest) (*pb.RouteTableSearchResponse, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableService.Search").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.RouteTableSearchResponse, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		if err := utils.ValidateLabels(req.Metadata.Labels); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		flattenedObject := protodb.Flattened{
+			Columns: []string{"cloud_account_id", "deleted_timestamp"},
+			Values:  []any{cloudAccountId, common.TimestampInfinityStr},
+		}
+
+		if req.VpcId != "" {
+			if _, err := uuid.Parse(req.VpcId); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid vpc id")
+			}
+			flattenedObject.Add("value->'spec'->>'vpcId'", req.VpcId)
+		}
+
+		labels := req.Metadata.Labels
+		for key, value := range labels {
+			column := fmt.Sprintf("value->'metadata'->'labels'->>'%s'", key)
+			flattenedObject.Add(column, value)
+		}
+
+		query := fmt.Sprintf(`
+			select %s
+			from   route_table
+			where  %s
+			order by name, resource_id
+		`, transformer.ColumnsForFromRow(), flattenedObject.GetWhereString(1))
+
+		rows, err := s.db.QueryContext(ctx, query, flattenedObject.Values...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		resp := &pb.RouteTableSearchResponse{}
+		for rows.Next() {
+			item, err := s.rowToRouteTable(ctx, rows)
+			if err != nil {
+				return nil, err
+			}
+			resp.Items = append(resp.Items, item)
+		}
+		return resp, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Allows update of:
+//   - Name
+//   - Labels
+//   - Routes
+//   - Associated subnets
+//
+// Public API
+func (s *RouteTableService) Update(ctx context.Context, req *pb.RouteTableUpdateRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableService.Update").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*emptypb.Empty, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		if _, err := uuid.Parse(req.Metadata.ResourceId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid resource id")
+		}
+
+		if err := networkutils.ValidateSubnetName(req.Metadata.Name); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if err := utils.ValidateLabels(req.Metadata.Labels); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		var spec *pb.RouteTableSpecPrivate
+		if req.Spec != nil {
+			routeTable, err := s.getPrivate(ctx, cloudAccountId, routeTableIdKey, req.Metadata.ResourceId)
+			if err != nil {
+				return nil, err
+			}
+			vpc, err := s.vpcService.ValidateVPC(ctx, cloudAccountId, routeTable.Spec.VpcId)
+			if err != nil {
+				return nil, err
+			}
+			spec, err = s.newSpec(ctx, vpc, req.Metadata.ResourceId, req.Spec.Routes, req.Spec.SubnetIds)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		updateFunc := func(routeTable *pb.RouteTablePrivate) error {
+			if req.Metadata.Name != "" {
+				routeTable.Metadata.Name = req.Metadata.Name
+			}
+			routeTable.Metadata.Labels = req.Metadata.Labels
+			if spec != nil {
+				if routeTable.Metadata.DeletionTimestamp != nil {
+					return status.Error(codes.FailedPrecondition, "route table is being deleted")
+				}
+				routeTable.Spec = spec
+				routeTable.Status.Phase = pb.RouteTablePhase_RouteTablePhase_Provisioning
+				routeTable.Status.Message = "Route table is provisioning"
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, cloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Delete a route table.
+func (s *RouteTableService) Delete(ctx context.Context, req *pb.RouteTableDeleteRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableService.Delete").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*emptypb.Empty, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		argName, arg, err := s.uniqueColumnAndValue(req.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		// Resolve the name to a resource id.
+		resourceId := req.Metadata.GetResourceId()
+		if argName != routeTableIdKey {
+			routeTable, err := s.getPrivate(ctx, cloudAccountId, argName, arg)
+			if err != nil {
+				return nil, err
+			}
+			resourceId = routeTable.Metadata.ResourceId
+		}
+
+		updateFunc := func(routeTable *pb.RouteTablePrivate) error {
+			if routeTable.Metadata.DeletionTimestamp == nil {
+				routeTable.Metadata.DeletionTimestamp = timestamppb.Now()
+				routeTable.Status.Phase = pb.RouteTablePhase_RouteTablePhase_Deleting
+				routeTable.Status.Message = "Route table is deleting"
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, cloudAccountId, resourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Private API.
+func (s *RouteTableService) GetPrivate(ctx context.Context, req *pb.RouteTableGetPrivateRequest) (*pb.RouteTablePrivate, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableService.GetPrivate").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.RouteTablePrivate, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		argName, arg, err := s.uniqueColumnAndValue(req.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.getPrivate(ctx, cloudAccountId, argName, arg)
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, err
+}
+
+// Allow update of:
+//   - Status
+//
+// If a resource version is provided and the route table has changed since, FailedPrecondition is returned.
+// Private API.
+func (s *RouteTableService) UpdateStatus(ctx context.Context, req *pb.RouteTableUpdateStatusRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableService.UpdateStatus").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*emptypb.Empty, error) {
+		if req.Status == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing status")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		updateFunc := func(routeTable *pb.RouteTablePrivate) error {
+			routeTable.Status = req.Status
+			if req.Metadata.DeletedTimestamp != nil {
+				routeTable.Metadata.DeletedTimestamp = req.Metadata.DeletedTimestamp
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, cloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, err
+}
+
+// Validate the routes and the associated subnets of a route table in the VPC.
+// routeTableId is the route table being updated, or empty if the route table is being created.
+// Restrictions:
+//   - The subnets must be in the VPC and must not be associated with another route table.
+//   - Destinations must be unique.
+//   - A route to a VPC peering requires that the peering involves the VPC, has been accepted,
+//     and that the route table is associated with the subnet that attaches the peering to the VPC.
+//     The destination must be within the CIDR block of the peer VPC.
+func (s *RouteTableService) newSpec(ctx context.Context, vpc *pb.VPCPrivate, routeTableId string, routes []*pb.Route, subnetIds []string) (*pb.RouteTableSpecPrivate, error) {
+	cloudAccountId := vpc.Metadata.CloudAccountId
+	spec := &pb.RouteTableSpecPrivate{
+		VpcId: vpc.Metadata.ResourceId,
+	}
+
+	if len(subnetIds) > maxSubnetsPerRouteTable {
+		return nil, status.Errorf(codes.InvalidArgument, "a route table can be associated with at most %d subnets", maxSubnetsPerRouteTable)
+	}
+	for _, subnetId := range subnetIds {
+		if _, err := uuid.Parse(subnetId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid subnet id")
+		}
+		if slices.Contains(spec.SubnetIds, subnetId) {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate subnet %s", subnetId)
+		}
+		subnet, err := s.subnetService.GetPrivate(ctx, &pb.SubnetGetPrivateRequest{
+			Metadata: &pb.SubnetMetadataReference{
+				CloudAccountId: cloudAccountId,
+				NameOrId: &pb.SubnetMetadataReference_ResourceId{
+					ResourceId: subnetId,
+				},
+			},
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Errorf(codes.InvalidArgument, "subnet %s not found", subnetId)
+			}
+			return nil, err
+		}
+		if subnet.Spec.VpcId != spec.VpcId {
+			return nil, status.Errorf(codes.InvalidArgument, "subnet %s is not in vpc %s", subnetId, spec.VpcId)
+		}
+		if err := s.checkSubnetNotAssociated(ctx, routeTableId, subnetId); err != nil {
+			return nil, err
+		}
+
+		// The route table router uses the first usable address of the subnet, which is the subnet gateway.
+		interfaceIP, err := networkutils.FirstUsableIP(subnet.Spec.CidrBlock)
+		if err != nil {
+			return nil, err
+		}
+		interfaceAddress, err := networkutils.AddressWithPrefixLength(interfaceIP, subnet.Spec.CidrBlock)
+		if err != nil {
+			return nil, err
+		}
+		spec.SubnetIds = append(spec.SubnetIds, subnetId)
+		spec.Subnets = append(spec.Subnets, &pb.RouteTableSubnetPrivate{
+			SubnetId:         subnetId,
+			CidrBlock:        subnet.Spec.CidrBlock,
+			InterfaceAddress: interfaceAddress,
+		})
+	}
+
+	if len(routes) > maxRoutesPerRouteTable {
+		return nil, status.Errorf(codes.InvalidArgument, "a route table can have at most %d routes", maxRoutesPerRouteTable)
+	}
+	for _, route := range routes {
+		if err := validateDestinationCidrBlock(route.DestinationCidrBlock, vpc.Spec.CidrBlock); err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(spec.Routes, func(r *pb.RoutePrivate) bool { return r.DestinationCidrBlock == route.DestinationCidrBlock }) {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate destination cidr block %s", route.DestinationCidrBlock)
+		}
+		if route.GetVpcPeeringId() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "missing target of route to %s", route.DestinationCidrBlock)
+		}
+		nextHopAddress, err := s.vpcPeeringNextHop(ctx, cloudAccountId, spec, route)
+		if err != nil {
+			return nil, err
+		}
+		spec.Routes = append(spec.Routes, &pb.RoutePrivate{
+			DestinationCidrBlock: route.DestinationCidrBlock,
+			VpcPeeringId:         route.GetVpcPeeringId(),
+			NextHopAddress:       nextHopAddress,
+		})
+	}
+
+	return spec, nil
+}
+
+// Return the address of the VPC peering router in the subnet that attaches the peering to the VPC of the route table.
+func (s *RouteTableService) vpcPeeringNextHop(ctx context.Context, cloudAccountId string, spec *pb.RouteTableSpecPrivate, route *pb.Route) (string, error) {
+	vpcPeeringId := route.GetVpcPeeringId()
+	if _, err := uuid.Parse(vpcPeeringId); err != nil {
+		return "", status.Error(codes.InvalidArgument, "invalid vpc peering id")
+	}
+	vpcPeering, err := s.vpcPeeringService.GetPrivate(ctx, &pb.VPCPeeringGetPrivateRequest{
+		Metadata: &pb.VPCPeeringMetadataReference{
+			CloudAccountId: cloudAccountId,
+			ResourceId:     vpcPeeringId,
+		},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", status.Errorf(codes.InvalidArgument, "vpc peering %s not found", vpcPeeringId)
+		}
+		return "", err
+	}
+	if vpcPeering.Metadata.DeletionTimestamp != nil {
+		return "", status.Errorf(codes.FailedPrecondition, "vpc peering %s is being deleted", vpcPeeringId)
+	}
+	if vpcPeering.Status.Phase == pb.VPCPeeringPhase_VPCPeeringPhase_Rejected {
+		return "", status.Errorf(codes.FailedPrecondition, "vpc peering %s was rejected", vpcPeeringId)
+	}
+
+	var local, peer *pb.VPCPeeringAttachmentPrivate
+	switch spec.VpcId {
+	case vpcPeering.Spec.Requester.VpcId:
+		local, peer = vpcPeering.Spec.Requester, vpcPeering.Spec.Accepter
+	case vpcPeering.Spec.Accepter.VpcId:
+		local, peer = vpcPeering.Spec.Accepter, vpcPeering.Spec.Requester
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "vpc peering %s does not involve vpc %s", vpcPeeringId, spec.VpcId)
+	}
+	if local.SubnetId == "" {
+		return "", status.Errorf(codes.FailedPrecondition, "vpc peering %s has not been accepted", vpcPeeringId)
+	}
+	if !slices.Contains(spec.SubnetIds, local.SubnetId) {
+		return "", status.Errorf(codes.InvalidArgument, "route table must be associated with subnet %s to use vpc peering %s", local.SubnetId, vpcPeeringId)
+	}
+	within, err := networkutils.IsCIDRWithinCIDR(peer.VpcCidrBlock, route.DestinationCidrBlock)
+	if err != nil {
+		return "", err
+	}
+	if !within {
+		return "", status.Errorf(codes.InvalidArgument, "destination cidr block %s is not within the cidr block %s of the peer vpc", route.DestinationCidrBlock, peer.VpcCidrBlock)
+	}
+
+	interfaceIP, _, err := net.ParseCIDR(local.InterfaceAddress)
+	if err != nil {
+		return "", err
+	}
+	return interfaceIP.String(), nil
+}
+
+// Return FailedPrecondition if the subnet is associated with a route table other than routeTableId.
+func (s *RouteTableService) checkSubnetNotAssociated(ctx context.Context, routeTableId string, subnetId string) error {
+	var count int
+	query := `
+		select count(*)
+		from   route_table
+		where  deleted_timestamp = $1
+		  and  resource_id::text != $2
+		  and  value->'spec'->'subnetIds' @> jsonb_build_array($3::text)
+	`
+	if err := s.db.QueryRowContext(ctx, query, common.TimestampInfinityStr, routeTableId, subnetId).Scan(&count); err != nil {
+		return fmt.Errorf("checkSubnetNotAssociated: %w", err)
+	}
+	if count > 0 {
+		return status.Errorf(codes.FailedPrecondition, "subnet %s is associated with another route table", subnetId)
+	}
+	return nil
+}
+
+func (s *RouteTableService) uniqueColumnAndValue(metadata *pb.RouteTableMetadataReference) (string, any, error) {
+	argName, arg, err := common.ResourceUniqueColumnAndValue(metadata.GetResourceId(), metadata.GetName())
+	if err != nil {
+		return "", nil, err
+	}
+	if argName == routeTableIdKey {
+		if _, err := uuid.Parse(metadata.GetResourceId()); err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, "invalid resource id")
+		}
+	}
+	return argName, arg, nil
+}
+
+func (s *RouteTableService) get(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*pb.RouteTable, error) {
+	rows, err := s.selectRouteTable(ctx, cloudAccountId, argName, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return s.rowToRouteTable(ctx, rows)
+}
+
+func (s *RouteTableService) getPrivate(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*pb.RouteTablePrivate, error) {
+	rows, err := s.selectRouteTable(ctx, cloudAccountId, argName, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return s.sqlTransformer.FromRow(ctx, rows)
+}
+
+// Update a route table record using the user-provided updateFunc to update the route table.
+// This uses optimistic concurrency control to ensure that the record has not been updated between the select and update.
+// Additionally, if the caller provides a resource version, optimistic concurrency control can be extended to
+// previous get or search calls.
+func (s *RouteTableService) update(
+	ctx context.Context,
+	cloudAccountId string,
+	resourceId string,
+	resourceVersion string,
+	updateFunc func(*pb.RouteTablePrivate) error) error {
+
+	query := fmt.Sprintf(`
+		select %s
+		from   route_table
+		where  cloud_account_id = $1
+			and  %s = $2
+			and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow(), routeTableIdKey)
+
+	// Retry on conflict if caller did not provide resourceVersion.
+	isRetryable := func(err error) bool {
+		return resourceVersion == "" && status.Code(err) == codes.FailedPrecondition
+	}
+
+	err := retry.OnError(retry.DefaultRetry, isRetryable, func() error {
+		rows, err := s.db.QueryContext(ctx, query, cloudAccountId, resourceId, common.TimestampInfinityStr)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		if !rows.Next() {
+			return status.Error(codes.NotFound, "resource not found")
+		}
+		routeTable, err := s.sqlTransformer.FromRow(ctx, rows)
+		if err != nil {
+			return err
+		}
+		metadata := routeTable.Metadata
+
+		// If resource version was provided, ensure that stored version matches.
+		if resourceVersion != "" && resourceVersion != metadata.ResourceVersion {
+			return status.Error(codes.FailedPrecondition, "stored resource version does not match requested resource version")
+		}
+
+		// Update RouteTable object.
+		if err := updateFunc(routeTable); err != nil {
+			return err
+		}
+
+		// Flatten route table into columns.
+		flattened, err := s.sqlTransformer.Flatten(ctx, routeTable)
+		if err != nil {
+			return err
+		}
+
+		args := append([]any{metadata.CloudAccountId, metadata.ResourceId, metadata.ResourceVersion, metadata.Name}, flattened.Values...)
+
+		deletedTimestamp := ""
+		if metadata.DeletedTimestamp != nil {
+			deletedTimestamp = "deleted_timestamp = '" + metadata.DeletedTimestamp.AsTime().Format(time.RFC3339) + "',"
+		}
+
+		// Update database.
+		updateQuery := fmt.Sprintf(`
+		update route_table
+		set    resource_version = nextval('route_table_resource_version_seq'),
+			   name = $4,
+			   %s
+			   %s
+		where  cloud_account_id = $1
+		and    resource_id = $2
+		and    resource_version = $3
+		`, deletedTimestamp, flattened.GetUpdateSetString(5))
+		sqlResult, err := s.db.ExecContext(ctx, updateQuery, args...)
+		if err != nil {
+			pgErr := &pgconn.PgError{}
+			if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation {
+				return status.Error(codes.AlreadyExists, "route table name already exists")
+			}
+			return err
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected < 1 {
+			return status.Error(codes.FailedPrecondition, "no records updated; possible update conflict")
+		}
+
+		return nil
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return status.Error(st.Code(), "update: "+st.Message())
+	}
+	return nil
+}
+
+// Validates and sets defaults in the provided RouteTable object and stores it in the database.
+func (s *RouteTableService) create(ctx context.Context, routeTable *pb.RouteTablePrivate) error {
+	ctx, _, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableService.create").WithValues(logkeys.CloudAccountId, routeTable.Metadata.CloudAccountId).Start()
+	defer span.End()
+
+	// Validate
+	if err := utils.ValidateLabels(routeTable.Metadata.Labels); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := networkutils.ValidateSubnetName(routeTable.Metadata.Name); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Calculate resourceId
+	resourceId, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	routeTable.Metadata.ResourceId = resourceId.String()
+
+	// Calculate name if not provided.
+	if routeTable.Metadata.Name == "" {
+		routeTable.Metadata.Name = routeTable.Metadata.ResourceId
+	}
+	name := routeTable.Metadata.Name
+	routeTable.Metadata.CreationTimestamp = timestamppb.Now()
+
+	// Flatten route table into columns.
+	flattened, err := s.sqlTransformer.Flatten(ctx, routeTable)
+	if err != nil {
+		return err
+	}
+
+	// Insert into database.
+	query := fmt.Sprintf(`insert into route_table (resource_id, cloud_account_id, name, %s) values ($1, $2, $3, %s)`,
+		flattened.GetColumnsString(), flattened.GetInsertValuesString(4))
+	args := append([]any{routeTable.Metadata.ResourceId, routeTable.Metadata.CloudAccountId, name}, flattened.Values...)
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation {
+			return status.Error(codes.AlreadyExists, "insert: route table "+name+" already exists")
+		}
+		return fmt.Errorf("insert: %w", err)
+	}
+
+	return nil
+}
+
+// Caller must close the returned sql.Rows.
+func (s *RouteTableService) selectRouteTable(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*sql.Rows, error) {
+	query := fmt.Sprintf(`
+		select %s
+		from   route_table
+		where  cloud_account_id = $1
+		  and  %s = $2
+		  and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow(), argName)
+
+	rows, err := s.db.QueryContext(ctx, query, cloudAccountId, arg, common.TimestampInfinityStr)
+	if err != nil {
+		return nil, fmt.Errorf("selectRouteTable: %w", err)
+	}
+	if !rows.Next() {
+		defer rows.Close()
+		return nil, status.Error(codes.NotFound, "resource not found")
+	}
+	return rows, nil
+}
+
+// Read a database row into a public RouteTable. Used for public APIs.
+func (s *RouteTableService) rowToRouteTable(ctx context.Context, rows *sql.Rows) (*pb.RouteTable, error) {
+	log := log.FromContext(ctx).WithName("RouteTableService.rowToRouteTable")
+	routeTablePrivate, err := s.sqlTransformer.FromRow(ctx, rows)
+	if err != nil {
+		return nil, fmt.Errorf("rowToRouteTable: %w", err)
+	}
+	routeTable := &pb.RouteTable{}
+	if err := s.pbConverter.Transcode(routeTablePrivate, routeTable); err != nil {
+		return nil, fmt.Errorf("rowToRouteTable: %w", err)
+	}
+	log.V(9).Info("Read from database", logkeys.ROUTE_TABLE, routeTable)
+	return routeTable, nil
+}
@@ -0,0 +1,137 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package route_table
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Transforms a RouteTable to a form that can be written to a SQL database.
+// Also performs the inverse, reading from sql.Rows and creating a RouteTable.
+// This uses the JSON serializer from the GRPC Gateway.
+type RouteTableSQLTransformer struct {
+	marshaler *runtime.JSONPb
+}
+
+func NewRouteTableSQLTransformer() *RouteTableSQLTransformer {
+	return &RouteTableSQLTransformer{
+		marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				// When writing JSON, emit fields that have default values, including for enums.
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				// When reading JSON, ignore fields with unknown names.
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+// Returns a Flattened object that can be used to construct a SQL INSERT or UPDATE statement.
+// The Flattened object omits columns that are never updated, such as the primary key columns.
+func (s *RouteTableSQLTransformer) Flatten(ctx context.Context, routeTable *pb.RouteTablePrivate) (*protodb.Flattened, error) {
+	flattened := &protodb.Flattened{}
+	jsonRouteTable, err := s.marshaler.Marshal(routeTable)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to json: %w", err)
+	}
+	flattened.Add("value", jsonRouteTable)
+	return flattened, nil
+}
+
+// Read a database row into a RouteTable.
+func (s *RouteTableSQLTransformer) FromRow(ctx context.Context, rows *sql.Rows) (*pb.RouteTablePrivate, error) {
+	log := log.FromContext(ctx).WithName("RouteTableSQLTransformer.FromRow")
+	metadata := &pb.RouteTableMetadataPrivate{}
+	var deletedTimestamp string
+	var resourceJson []byte
+	if err := rows.Scan(&metadata.CloudAccountId, &metadata.ResourceId, &metadata.Name, &deletedTimestamp, &metadata.ResourceVersion, &resourceJson); err != nil {
+		return nil, fmt.Errorf("RowToRouteTablePrivate: Scan: %w", err)
+	}
+	log.V(9).Info("scanned", logkeys.ResourceId, metadata.ResourceId, logkeys.ResourceJson, string(resourceJson))
+	routeTable := &pb.RouteTablePrivate{}
+	if err := s.marshaler.Unmarshal(resourceJson, &routeTable); err != nil {
+		return nil, err
+	}
+	log.V(9).Info("decoded", logkeys.ROUTE_TABLE, routeTable)
+	// Copy fields directly in the row to the route table.
+	routeTable.Metadata.CloudAccountId = metadata.CloudAccountId
+	routeTable.Metadata.ResourceId = metadata.ResourceId
+	routeTable.Metadata.ResourceVersion = metadata.ResourceVersion
+	return routeTable, nil
+}
+
+// Read a database row into a RouteTablePrivateWatchResponse.
+// This encodes the Spec & Status as json blobs to allow informer to handle the proto style resources.
+func (s *RouteTableSQLTransformer) FromRowWatchResponse(ctx context.Context, rows *sql.Rows) (*pb.RouteTablePrivateWatchResponse, error) {
+	log := log.FromContext(ctx).WithName("RouteTableSQLTransformer.FromRowWatchResponse")
+	metadata := &pb.RouteTableMetadataPrivate{}
+	var deletedTimestamp string
+	var resourceJson []byte
+	if err := rows.Scan(&metadata.CloudAccountId, &metadata.ResourceId, &metadata.Name, &deletedTimestamp, &metadata.ResourceVersion, &resourceJson); err != nil {
+		return nil, fmt.Errorf("FromRowWatchResponse: Scan: %w", err)
+	}
+	log.V(9).Info("scanned", logkeys.ResourceId, metadata.ResourceId, logkeys.ResourceJson, string(resourceJson))
+
+	// Unmarshal into a RouteTablePrivate
+	routeTablePrivate := &pb.RouteTablePrivate{}
+	if err := s.marshaler.Unmarshal(resourceJson, &routeTablePrivate); err != nil {
+		return nil, err
+	}
+
+	// Convert the RouteTablePrivate into a RouteTablePrivateWatchResponse
+	spec, err := s.marshaler.Marshal(routeTablePrivate.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.marshaler.Marshal(routeTablePrivate.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	routeTable := &pb.RouteTablePrivateWatchResponse{
+		Metadata: routeTablePrivate.Metadata,
+		Spec:     string(spec),
+		Status:   string(status),
+	}
+
+	log.V(9).Info("decoded", logkeys.ROUTE_TABLE, routeTable)
+	// Copy fields directly in the row to the route table.
+	routeTable.Metadata.CloudAccountId = metadata.CloudAccountId
+	routeTable.Metadata.ResourceId = metadata.ResourceId
+	routeTable.Metadata.Name = metadata.Name
+	routeTable.Metadata.ResourceVersion = metadata.ResourceVersion
+	routeTable.Metadata.DeletionTimestamp = routeTablePrivate.Metadata.DeletionTimestamp
+	routeTable.Metadata.DeletedTimestamp, err = timestampStrToPbTimestamp(deletedTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return routeTable, nil
+}
+
+// Convert a timestamp from Postgres format to Protobuf.
+// The special time "infinity" is returned as (nil, nil).
+func timestampStrToPbTimestamp(ts string) (*timestamppb.Timestamp, error) {
+	if ts == common.TimestampInfinityStr {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
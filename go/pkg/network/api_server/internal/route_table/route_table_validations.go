@@ -0,0 +1,35 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package route_table
+
+import (
+	"net"
+
+	networkutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRoutesPerRouteTable  = 50
+	maxSubnetsPerRouteTable = 50
+)
+
+// Validate the destination of a route.
+// Restrictions:
+//   - The destination must be an IPv4 network in canonical form, such as 10.1.0.0/16.
+//   - The destination must not overlap the CIDR block of the VPC. Traffic within the VPC is always routed locally.
+func validateDestinationCidrBlock(destinationCidrBlock string, vpcCidrBlock string) error {
+	_, ipNet, err := net.ParseCIDR(destinationCidrBlock)
+	if err != nil || ipNet.IP.To4() == nil || ipNet.String() != destinationCidrBlock {
+		return status.Errorf(codes.InvalidArgument, "invalid destination cidr block %s", destinationCidrBlock)
+	}
+	overlap, err := networkutils.IsCIDROverlap(destinationCidrBlock, vpcCidrBlock)
+	if err != nil {
+		return err
+	}
+	if overlap {
+		return status.Errorf(codes.InvalidArgument, "destination cidr block %s overlaps the cidr block %s of the vpc", destinationCidrBlock, vpcCidrBlock)
+	}
+	return nil
+}
@@ -0,0 +1,729 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package security_group
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/pbconvert"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/transformer"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/vpc"
+	networkutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/utils"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	securityGroupIdKey = "resource_id"
+)
+
+type SecurityGroupService struct {
+	pb.UnimplementedSecurityGroupServiceServer
+	pb.UnimplementedSecurityGroupPrivateServiceServer
+	db                        *sql.DB
+	cfg                       config.Config
+	cloudAccountServiceClient pb.CloudAccountServiceClient
+	sqlTransformer            *SecurityGroupSQLTransformer
+	pbConverter               *pbconvert.PbConverter
+	vpcService                *vpc.VPCService
+}
+
+func NewSecurityGroupService(
+	db *sql.DB,
+	config config.Config,
+	cloudAccountServiceClient pb.CloudAccountServiceClient,
+	vpcService *vpc.VPCService,
+) (*SecurityGroupService, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	return &SecurityGroupService{
+		db:                        db,
+		cfg:                       config,
+		cloudAccountServiceClient: cloudAccountServiceClient,
+		sqlTransformer:            NewSecurityGroupSQLTransformer(),
+		pbConverter:               pbconvert.NewPbConverter(),
+		vpcService:                vpcService,
+	}, nil
+}
+
+func (s *SecurityGroupService) Ping(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("SecurityGroupService.Ping")
+	log.Info("Ping")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SecurityGroupService) PingPrivate(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("SecurityGroupService.PingPrivate")
+	log.Info("PingPrivate")
+	return &emptypb.Empty{}, nil
+}
+
+// Public API: Create a new security group
+func (s *SecurityGroupService) Create(ctx context.Context, req *pb.SecurityGroupCreateRequest) (*pb.SecurityGroup, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupService.Create").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.SecurityGroup, error) {
+		// Validate input.
+		if req.Spec == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing spec")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		securityGroup := &pb.SecurityGroupPrivate{
+			Metadata: &pb.SecurityGroupMetadataPrivate{
+				CloudAccountId: cloudAccountId,
+				Name:           req.Metadata.Name,
+				Labels:         req.Metadata.Labels,
+			},
+			Spec: &pb.SecurityGroupSpecPrivate{
+				VpcId:       req.Spec.VpcId,
+				Description: req.Spec.Description,
+				FlowLogging: req.Spec.FlowLogging,
+			},
+			Status: &pb.SecurityGroupStatusPrivate{
+				Phase:   pb.SecurityGroupPhase_SecurityGroupPhase_Provisioning,
+				Message: "Security group is provisioning",
+			},
+		}
+
+		if err := s.create(ctx, securityGroup); err != nil {
+			return nil, err
+		}
+
+		// Query database and return response.
+		return s.get(ctx, cloudAccountId, securityGroupIdKey, securityGroup.Metadata.ResourceId)
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *SecurityGroupService) Get(ctx context.Context, req *pb.SecurityGroupGetRequest) (*pb.SecurityGroup, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupService.Get").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.SecurityGroup, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		argName, arg, err := s.uniqueColumnAndValue(req.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.get(ctx, cloudAccountId, argName, arg)
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *SecurityGroupService) Search(ctx context.Context, req *pb.SecurityGroupSearchRequest) (*pb.SecurityGroupSearchResponse, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupService.Search").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.SecurityGroupSearchResponse, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		if err := utils.ValidateLabels(req.Metadata.Labels); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		flattenedObject := protodb.Flattened{
+			Columns: []string{"cloud_account_id", "deleted_timestamp"},
+			Values:  []any{cloudAccountId, common.TimestampInfinityStr},
+		}
+
+		if req.VpcId != "" {
+			if _, err := uuid.Parse(req.VpcId); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid vpc id")
+			}
+			flattenedObject.Add("value->'spec'->>'vpcId'", req.VpcId)
+		}
+
+		labels := req.Metadata.Labels
+		for key, value := range labels {
+			column := fmt.Sprintf("value->'metadata'->'labels'->>'%s'", key)
+			flattenedObject.Add(column, value)
+		}
+
+		pagination, err := protodb.NewPagination(req.PageSize, req.PageToken, "name", "resource_id")
+		if err != nil {
+			return nil, err
+		}
+
+		whereString := flattenedObject.GetWhereString(1)
+
+		query := fmt.Sprintf(`
+			select %s
+			from   security_group
+			where  %s
+			and    %s
+			%s
+			%s
+		`, transformer.ColumnsForFromRow(), whereString,
+			pagination.GetWhereString(len(flattenedObject.Values)+1), pagination.GetOrderByString(), pagination.GetLimitString())
+
+		args := append(flattenedObject.Values, pagination.GetValues()...)
+		rows, err := s.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		var items []*pb.SecurityGroup
+		for rows.Next() {
+			item, err := s.rowToSecurityGroup(ctx, rows)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		items, nextPageToken, err := protodb.NextPage(pagination, items, func(item *pb.SecurityGroup) []string {
+			return []string{item.Metadata.Name, item.Metadata.ResourceId}
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp := &pb.SecurityGroupSearchResponse{
+			Items:         items,
+			NextPageToken: nextPageToken,
+		}
+		return resp, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Allows update of:
+//   - Name
+//   - Labels
+//   - Description
+//
+// Public API
+func (s *SecurityGroupService) Update(ctx context.Context, req *pb.SecurityGroupUpdateRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupService.Update").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*emptypb.Empty, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		if _, err := uuid.Parse(req.Metadata.ResourceId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid resource id")
+		}
+
+		if err := networkutils.ValidateSubnetName(req.Metadata.Name); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if err := utils.ValidateLabels(req.Metadata.Labels); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if req.Spec != nil {
+			if err := validateDescription(req.Spec.Description); err != nil {
+				return nil, err
+			}
+		}
+
+		updateFunc := func(securityGroup *pb.SecurityGroupPrivate) error {
+			if req.Metadata.Name != "" {
+				securityGroup.Metadata.Name = req.Metadata.Name
+			}
+			securityGroup.Metadata.Labels = req.Metadata.Labels
+			if req.Spec != nil {
+				securityGroup.Spec.Description = req.Spec.Description
+				securityGroup.Spec.FlowLogging = req.Spec.FlowLogging
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, cloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Delete a security group.
+// The security group must not be attached to any ports.
+func (s *SecurityGroupService) Delete(ctx context.Context, req *pb.SecurityGroupDeleteRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupService.Delete").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*emptypb.Empty, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		argName, arg, err := s.uniqueColumnAndValue(req.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		// Resolve the name to a resource id.
+		resourceId := req.Metadata.GetResourceId()
+		if argName != securityGroupIdKey {
+			securityGroup, err := s.getPrivate(ctx, cloudAccountId, argName, arg)
+			if err != nil {
+				return nil, err
+			}
+			resourceId = securityGroup.Metadata.ResourceId
+		}
+
+		updateFunc := func(securityGroup *pb.SecurityGroupPrivate) error {
+			if len(securityGroup.Spec.PortIds) > 0 {
+				return status.Errorf(codes.FailedPrecondition, "security group is attached to %d interfaces", len(securityGroup.Spec.PortIds))
+			}
+			if securityGroup.Metadata.DeletionTimestamp == nil {
+				securityGroup.Metadata.DeletionTimestamp = timestamppb.Now()
+				securityGroup.Status.Phase = pb.SecurityGroupPhase_SecurityGroupPhase_Deleting
+				securityGroup.Status.Message = "Security group is deleting"
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, cloudAccountId, resourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Private API.
+func (s *SecurityGroupService) GetPrivate(ctx context.Context, req *pb.SecurityGroupGetPrivateRequest) (*pb.SecurityGroupPrivate, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupService.GetPrivate").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.SecurityGroupPrivate, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		argName, arg, err := s.uniqueColumnAndValue(req.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.getPrivate(ctx, cloudAccountId, argName, arg)
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, err
+}
+
+// Allow update of:
+//   - Status
+//
+// If a resource version is provided and the security group has changed since, FailedPrecondition is returned.
+// Private API.
+func (s *SecurityGroupService) UpdateStatus(ctx context.Context, req *pb.SecurityGroupUpdateStatusRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupService.UpdateStatus").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.GetResourceId()).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*emptypb.Empty, error) {
+		if req.Status == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing status")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		updateFunc := func(securityGroup *pb.SecurityGroupPrivate) error {
+			securityGroup.Status = req.Status
+			if req.Metadata.DeletedTimestamp != nil {
+				securityGroup.Metadata.DeletedTimestamp = req.Metadata.DeletedTimestamp
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, cloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, err
+}
+
+// Check that the security groups exist in the VPC and can be attached to a port.
+// Returns the security group ids without duplicates.
+func (s *SecurityGroupService) ValidateSecurityGroups(ctx context.Context, cloudAccountId string, vpcId string, securityGroupIds []string) ([]string, error) {
+	var validIds []string
+	for _, securityGroupId := range securityGroupIds {
+		if _, err := uuid.Parse(securityGroupId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid security group id")
+		}
+		if slices.Contains(validIds, securityGroupId) {
+			continue
+		}
+		securityGroup, err := s.getPrivate(ctx, cloudAccountId, securityGroupIdKey, securityGroupId)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Errorf(codes.InvalidArgument, "security group %s not found", securityGroupId)
+			}
+			return nil, err
+		}
+		if securityGroup.Metadata.DeletionTimestamp != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "security group %s is being deleted", securityGroupId)
+		}
+		if securityGroup.Spec.VpcId != vpcId {
+			return nil, status.Errorf(codes.InvalidArgument, "security group %s is not in vpc %s", securityGroupId, vpcId)
+		}
+		validIds = append(validIds, securityGroupId)
+	}
+	if len(validIds) > maxSecurityGroupsPerPort {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d security groups can be attached to a port", maxSecurityGroupsPerPort)
+	}
+	return validIds, nil
+}
+
+// Update the ports of the security groups when a port is moved from oldSecurityGroupIds to newSecurityGroupIds.
+// The new security groups should have been checked with ValidateSecurityGroups.
+// This is idempotent and can be retried with the same arguments.
+func (s *SecurityGroupService) UpdatePortSecurityGroups(ctx context.Context, cloudAccountId string, portId string, oldSecurityGroupIds []string, newSecurityGroupIds []string) error {
+	for _, securityGroupId := range newSecurityGroupIds {
+		updateFunc := func(securityGroup *pb.SecurityGroupPrivate) error {
+			if securityGroup.Metadata.DeletionTimestamp != nil {
+				return status.Errorf(codes.FailedPrecondition, "security group %s is being deleted", securityGroupId)
+			}
+			if !slices.Contains(securityGroup.Spec.PortIds, portId) {
+				securityGroup.Spec.PortIds = append(securityGroup.Spec.PortIds, portId)
+				setProvisioning(securityGroup)
+			}
+			return nil
+		}
+		if err := s.update(ctx, cloudAccountId, securityGroupId, "", updateFunc); err != nil {
+			return err
+		}
+	}
+	for _, securityGroupId := range oldSecurityGroupIds {
+		if slices.Contains(newSecurityGroupIds, securityGroupId) {
+			continue
+		}
+		updateFunc := func(securityGroup *pb.SecurityGroupPrivate) error {
+			if i := slices.Index(securityGroup.Spec.PortIds, portId); i >= 0 {
+				securityGroup.Spec.PortIds = slices.Delete(securityGroup.Spec.PortIds, i, i+1)
+				setProvisioning(securityGroup)
+			}
+			return nil
+		}
+		// Ignore security groups that no longer exist.
+		if err := s.update(ctx, cloudAccountId, securityGroupId, "", updateFunc); err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+// Set the status of a security group whose rules or ports have changed and must be applied again.
+func setProvisioning(securityGroup *pb.SecurityGroupPrivate) {
+	if securityGroup.Metadata.DeletionTimestamp != nil {
+		return
+	}
+	securityGroup.Status = &pb.SecurityGroupStatusPrivate{
+		Phase:   pb.SecurityGroupPhase_SecurityGroupPhase_Provisioning,
+		Message: "Security group is provisioning",
+	}
+}
+
+func (s *SecurityGroupService) uniqueColumnAndValue(metadata *pb.SecurityGroupMetadataReference) (string, any, error) {
+	argName, arg, err := common.ResourceUniqueColumnAndValue(metadata.GetResourceId(), metadata.GetName())
+	if err != nil {
+		return "", nil, err
+	}
+	if argName == securityGroupIdKey {
+		if _, err := uuid.Parse(metadata.GetResourceId()); err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, "invalid resource id")
+		}
+	}
+	return argName, arg, nil
+}
+
+func (s *SecurityGroupService) get(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*pb.SecurityGroup, error) {
+	rows, err := s.selectSecurityGroup(ctx, cloudAccountId, argName, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return s.rowToSecurityGroup(ctx, rows)
+}
+
+func (s *SecurityGroupService) getPrivate(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*pb.SecurityGroupPrivate, error) {
+	rows, err := s.selectSecurityGroup(ctx, cloudAccountId, argName, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return s.sqlTransformer.FromRow(ctx, rows)
+}
+
+// Update a security group record using the user-provided updateFunc to update the security group.
+// This uses optimistic concurrency control to ensure that the record has not been updated between the select and update.
+// Additionally, if the caller provides a resource version, optimistic concurrency control can be extended to
+// previous get or search calls.
+func (s *SecurityGroupService) update(
+	ctx context.Context,
+	cloudAccountId string,
+	resourceId string,
+	resourceVersion string,
+	updateFunc func(*pb.SecurityGroupPrivate) error) error {
+
+	query := fmt.Sprintf(`
+		select %s
+		from   security_group
+		where  cloud_account_id = $1
+			and  %s = $2
+			and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow(), securityGroupIdKey)
+
+	// Retry on conflict if caller did not provide resourceVersion.
+	isRetryable := func(err error) bool {
+		return resourceVersion == "" && status.Code(err) == codes.FailedPrecondition
+	}
+
+	err := retry.OnError(retry.DefaultRetry, isRetryable, func() error {
+		rows, err := s.db.QueryContext(ctx, query, cloudAccountId, resourceId, common.TimestampInfinityStr)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		if !rows.Next() {
+			return status.Error(codes.NotFound, "resource not found")
+		}
+		securityGroup, err := s.sqlTransformer.FromRow(ctx, rows)
+		if err != nil {
+			return err
+		}
+		metadata := securityGroup.Metadata
+
+		// If resource version was provided, ensure that stored version matches.
+		if resourceVersion != "" && resourceVersion != metadata.ResourceVersion {
+			return status.Error(codes.FailedPrecondition, "stored resource version does not match requested resource version")
+		}
+
+		// Update SecurityGroup object.
+		if err := updateFunc(securityGroup); err != nil {
+			return err
+		}
+
+		// Flatten security group into columns.
+		flattened, err := s.sqlTransformer.Flatten(ctx, securityGroup)
+		if err != nil {
+			return err
+		}
+
+		args := append([]any{metadata.CloudAccountId, metadata.ResourceId, metadata.ResourceVersion, metadata.Name}, flattened.Values...)
+
+		deletedTimestamp := ""
+		if metadata.DeletedTimestamp != nil {
+			deletedTimestamp = "deleted_timestamp = '" + metadata.DeletedTimestamp.AsTime().Format(time.RFC3339) + "',"
+		}
+
+		// Update database.
+		updateQuery := fmt.Sprintf(`
+		update security_group
+		set    resource_version = nextval('security_group_resource_version_seq'),
+			   name = $4,
+			   %s
+			   %s
+		where  cloud_account_id = $1
+		and    resource_id = $2
+		and    resource_version = $3
+		`, deletedTimestamp, flattened.GetUpdateSetString(5))
+		sqlResult, err := s.db.ExecContext(ctx, updateQuery, args...)
+		if err != nil {
+			pgErr := &pgconn.PgError{}
+			if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation {
+				return status.Error(codes.AlreadyExists, "security group name already exists")
+			}
+			return err
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected < 1 {
+			return status.Error(codes.FailedPrecondition, "no records updated; possible update conflict")
+		}
+
+		return nil
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return status.Error(st.Code(), "update: "+st.Message())
+	}
+	return nil
+}
+
+// Validates and sets defaults in the provided SecurityGroup object and stores it in the database.
+func (s *SecurityGroupService) create(ctx context.Context, securityGroup *pb.SecurityGroupPrivate) error {
+	ctx, _, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupService.create").WithValues(logkeys.CloudAccountId, securityGroup.Metadata.CloudAccountId).Start()
+	defer span.End()
+
+	// Validate
+	if err := utils.ValidateLabels(securityGroup.Metadata.Labels); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := networkutils.ValidateSubnetName(securityGroup.Metadata.Name); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateDescription(securityGroup.Spec.Description); err != nil {
+		return err
+	}
+
+	// Validate the VPCId passed is valid for this cloud account
+	if _, err := s.vpcService.ValidateVPC(ctx, securityGroup.Metadata.CloudAccountId, securityGroup.Spec.VpcId); err != nil {
+		return err
+	}
+
+	// Calculate resourceId
+	resourceId, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	securityGroup.Metadata.ResourceId = resourceId.String()
+
+	// Calculate name if not provided.
+	if securityGroup.Metadata.Name == "" {
+		securityGroup.Metadata.Name = securityGroup.Metadata.ResourceId
+	}
+	name := securityGroup.Metadata.Name
+	securityGroup.Metadata.CreationTimestamp = timestamppb.Now()
+
+	// Flatten security group into columns.
+	flattened, err := s.sqlTransformer.Flatten(ctx, securityGroup)
+	if err != nil {
+		return err
+	}
+
+	// Insert into database.
+	query := fmt.Sprintf(`insert into security_group (resource_id, cloud_account_id, name, %s) values ($1, $2, $3, %s)`,
+		flattened.GetColumnsString(), flattened.GetInsertValuesString(4))
+	args := append([]any{securityGroup.Metadata.ResourceId, securityGroup.Metadata.CloudAccountId, name}, flattened.Values...)
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation {
+			return status.Error(codes.AlreadyExists, "insert: security group "+name+" already exists")
+		}
+		return fmt.Errorf("insert: %w", err)
+	}
+
+	return nil
+}
+
+// Caller must close the returned sql.Rows.
+func (s *SecurityGroupService) selectSecurityGroup(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*sql.Rows, error) {
+	query := fmt.Sprintf(`
+		select %s
+		from   security_group
+		where  cloud_account_id = $1
+		  and  %s = $2
+		  and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow(), argName)
+
+	rows, err := s.db.QueryContext(ctx, query, cloudAccountId, arg, common.TimestampInfinityStr)
+	if err != nil {
+		return nil, fmt.Errorf("selectSecurityGroup: %w", err)
+	}
+	if !rows.Next() {
+		defer rows.Close()
+		return nil, status.Error(codes.NotFound, "resource not found")
+	}
+	return rows, nil
+}
+
+// Read a database row into a public SecurityGroup. Used for public APIs.
+func (s *SecurityGroupService) rowToSecurityGroup(ctx context.Context, rows *sql.Rows) (*pb.SecurityGroup, error) {
+	log := log.FromContext(ctx).WithName("SecurityGroupService.rowToSecurityGroup")
+	securityGroupPrivate, err := s.sqlTransformer.FromRow(ctx, rows)
+	if err != nil {
+		return nil, fmt.Errorf("rowToSecurityGroup: %w", err)
+	}
+	securityGroup := &pb.SecurityGroup{}
+	if err := s.pbConverter.Transcode(securityGroupPrivate, securityGroup); err != nil {
+		return nil, fmt.Errorf("rowToSecurityGroup: %w", err)
+	}
+	log.V(9).Info("Read from database", logkeys.SECURITY_GROUP, securityGroup)
+	return securityGroup, nil
+}
@@ -0,0 +1,137 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package security_group
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Transforms a SecurityGroup to a form that can be written to a SQL database.
+// Also performs the inverse, reading from sql.Rows and creating a SecurityGroup.
+// This uses the JSON serializer from the GRPC Gateway.
+type SecurityGroupSQLTransformer struct {
+	marshaler *runtime.JSONPb
+}
+
+func NewSecurityGroupSQLTransformer() *SecurityGroupSQLTransformer {
+	return &SecurityGroupSQLTransformer{
+		marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				// When writing JSON, emit fields that have default values, including for enums.
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				// When reading JSON, ignore fields with unknown names.
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+// Returns a Flattened object that can be used to construct a SQL INSERT or UPDATE statement.
+// The Flattened object omits columns that are never updated, such as the primary key columns.
+func (s *SecurityGroupSQLTransformer) Flatten(ctx context.Context, securityGroup *pb.SecurityGroupPrivate) (*protodb.Flattened, error) {
+	flattened := &protodb.Flattened{}
+	jsonSecurityGroup, err := s.marshaler.Marshal(securityGroup)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to json: %w", err)
+	}
+	flattened.Add("value", jsonSecurityGroup)
+	return flattened, nil
+}
+
+// Read a database row into a SecurityGroup.
+func (s *SecurityGroupSQLTransformer) FromRow(ctx context.Context, rows *sql.Rows) (*pb.SecurityGroupPrivate, error) {
+	log := log.FromContext(ctx).WithName("SecurityGroupSQLTransformer.FromRow")
+	metadata := &pb.SecurityGroupMetadataPrivate{}
+	var deletedTimestamp string
+	var resourceJson []byte
+	if err := rows.Scan(&metadata.CloudAccountId, &metadata.ResourceId, &metadata.Name, &deletedTimestamp, &metadata.ResourceVersion, &resourceJson); err != nil {
+		return nil, fmt.Errorf("RowToSecurityGroupPrivate: Scan: %w", err)
+	}
+	log.V(9).Info("scanned", logkeys.ResourceId, metadata.ResourceId, logkeys.ResourceJson, string(resourceJson))
+	securityGroup := &pb.SecurityGroupPrivate{}
+	if err := s.marshaler.Unmarshal(resourceJson, &securityGroup); err != nil {
+		return nil, err
+	}
+	log.V(9).Info("decoded", logkeys.SECURITY_GROUP, securityGroup)
+	// Copy fields directly in the row to the security group.
+	securityGroup.Metadata.CloudAccountId = metadata.CloudAccountId
+	securityGroup.Metadata.ResourceId = metadata.ResourceId
+	securityGroup.Metadata.ResourceVersion = metadata.ResourceVersion
+	return securityGroup, nil
+}
+
+// Read a database row into a SecurityGroupPrivateWatchResponse.
+// This encodes the Spec & Status as json blobs to allow informer to handle the proto style resources.
+func (s *SecurityGroupSQLTransformer) FromRowWatchResponse(ctx context.Context, rows *sql.Rows) (*pb.SecurityGroupPrivateWatchResponse, error) {
+	log := log.FromContext(ctx).WithName("SecurityGroupSQLTransformer.FromRowWatchResponse")
+	metadata := &pb.SecurityGroupMetadataPrivate{}
+	var deletedTimestamp string
+	var resourceJson []byte
+	if err := rows.Scan(&metadata.CloudAccountId, &metadata.ResourceId, &metadata.Name, &deletedTimestamp, &metadata.ResourceVersion, &resourceJson); err != nil {
+		return nil, fmt.Errorf("FromRowWatchResponse: Scan: %w", err)
+	}
+	log.V(9).Info("scanned", logkeys.ResourceId, metadata.ResourceId, logkeys.ResourceJson, string(resourceJson))
+
+	// Unmarshal into a SecurityGroupPrivate
+	securityGroupPrivate := &pb.SecurityGroupPrivate{}
+	if err := s.marshaler.Unmarshal(resourceJson, &securityGroupPrivate); err != nil {
+		return nil, err
+	}
+
+	// Convert the SecurityGroupPrivate into a SecurityGroupPrivateWatchResponse
+	spec, err := s.marshaler.Marshal(securityGroupPrivate.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.marshaler.Marshal(securityGroupPrivate.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	securityGroup := &pb.SecurityGroupPrivateWatchResponse{
+		Metadata: securityGroupPrivate.Metadata,
+		Spec:     string(spec),
+		Status:   string(status),
+	}
+
+	log.V(9).Info("decoded", logkeys.SECURITY_GROUP, securityGroup)
+	// Copy fields directly in the row to the security group.
+	securityGroup.Metadata.CloudAccountId = metadata.CloudAccountId
+	securityGroup.Metadata.ResourceId = metadata.ResourceId
+	securityGroup.Metadata.Name = metadata.Name
+	securityGroup.Metadata.ResourceVersion = metadata.ResourceVersion
+	securityGroup.Metadata.DeletionTimestamp = securityGroupPrivate.Metadata.DeletionTimestamp
+	securityGroup.Metadata.DeletedTimestamp, err = timestampStrToPbTimestamp(deletedTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return securityGroup, nil
+}
+
+// Convert a timestamp from Postgres format to Protobuf.
+// The special time "infinity" is returned as (nil, nil).
+func timestampStrToPbTimestamp(ts string) (*timestamppb.Timestamp, error) {
+	if ts == common.TimestampInfinityStr {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
@@ -0,0 +1,91 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package security_group
+
+import (
+	"net"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	descriptionMaxLength     = 255
+	maxRulesPerSecurityGroup = 100
+	maxCidrsPerRule          = 16
+	maxSecurityGroupsPerPort = 5
+	minRulePriority          = 1
+	maxRulePriority          = 1000
+	maxPort                  = 65535
+)
+
+func validateDescription(description string) error {
+	if len(description) > descriptionMaxLength {
+		return status.Errorf(codes.InvalidArgument, "description must be at most %d characters", descriptionMaxLength)
+	}
+	return nil
+}
+
+// Validate a security rule spec and set defaults.
+// Restrictions:
+//   - Direction is required.
+//   - Remote CIDRs must be IPv4 networks in canonical form, such as 10.0.0.0/8.
+//   - Port ranges can only be used with TCP and UDP.
+//     If only portRangeMin is provided, the range is the single port portRangeMin.
+//   - Priority must be between 1 and 1000.
+func validateSecurityRuleSpec(spec *pb.SecurityRuleSpec) error {
+	if _, err := uuid.Parse(spec.SecurityGroupId); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid security group id")
+	}
+
+	if _, ok := pb.SecurityRuleDirection_name[int32(spec.Direction)]; !ok || spec.Direction == pb.SecurityRuleDirection_SecurityRuleDirection_Unspecified {
+		return status.Error(codes.InvalidArgument, "invalid direction")
+	}
+	if _, ok := pb.SecurityRuleProtocol_name[int32(spec.Protocol)]; !ok {
+		return status.Error(codes.InvalidArgument, "invalid protocol")
+	}
+	if _, ok := pb.SecurityRuleAction_name[int32(spec.Action)]; !ok {
+		return status.Error(codes.InvalidArgument, "invalid action")
+	}
+
+	if len(spec.RemoteCidrs) > maxCidrsPerRule {
+		return status.Errorf(codes.InvalidArgument, "at most %d remote CIDRs can be provided", maxCidrsPerRule)
+	}
+	for _, cidr := range spec.RemoteCidrs {
+		ip, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid remote CIDR %s", cidr)
+		}
+		if ip.To4() == nil {
+			return status.Errorf(codes.InvalidArgument, "invalid remote CIDR %s: should be in IPv4 format", cidr)
+		}
+		if ipNet.String() != cidr {
+			return status.Errorf(codes.InvalidArgument, "invalid remote CIDR %s: did you mean %s", cidr, ipNet.String())
+		}
+	}
+
+	switch spec.Protocol {
+	case pb.SecurityRuleProtocol_SecurityRuleProtocol_TCP, pb.SecurityRuleProtocol_SecurityRuleProtocol_UDP:
+		if spec.PortRangeMin == 0 && spec.PortRangeMax == 0 {
+			break
+		}
+		if spec.PortRangeMax == 0 {
+			spec.PortRangeMax = spec.PortRangeMin
+		}
+		if spec.PortRangeMin < 1 || spec.PortRangeMax > maxPort || spec.PortRangeMin > spec.PortRangeMax {
+			return status.Errorf(codes.InvalidArgument, "invalid port range %d-%d", spec.PortRangeMin, spec.PortRangeMax)
+		}
+	default:
+		if spec.PortRangeMin != 0 || spec.PortRangeMax != 0 {
+			return status.Error(codes.InvalidArgument, "port range can only be used with TCP and UDP")
+		}
+	}
+
+	if spec.Priority < minRulePriority || spec.Priority > maxRulePriority {
+		return status.Errorf(codes.InvalidArgument, "priority must be between %d and %d", minRulePriority, maxRulePriority)
+	}
+
+	return nil
+}
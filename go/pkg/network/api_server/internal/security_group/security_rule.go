@@ -0,0 +1,231 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package security_group
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	networkutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/utils"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Security rules are stored in the spec of their security group.
+// Any change to the rules sets the security group to Provisioning until the Network Operator has applied them.
+type SecurityRuleService struct {
+	pb.UnimplementedSecurityRuleServiceServer
+	securityGroupService *SecurityGroupService
+}
+
+func NewSecurityRuleService(securityGroupService *SecurityGroupService) (*SecurityRuleService, error) {
+	return &SecurityRuleService{
+		securityGroupService: securityGroupService,
+	}, nil
+}
+
+func (s *SecurityRuleService) Ping(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("SecurityRuleService.Ping")
+	log.Info("Ping")
+	return &emptypb.Empty{}, nil
+}
+
+// Public API: Add a rule to a security group.
+func (s *SecurityRuleService) Create(ctx context.Context, req *pb.SecurityRuleCreateRequest) (*pb.SecurityRule, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityRuleService.Create").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.SecurityRule, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		if err := networkutils.ValidateSubnetName(req.Metadata.Name); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if req.Spec == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing spec")
+		}
+		spec := proto.Clone(req.Spec).(*pb.SecurityRuleSpec)
+		if err := validateSecurityRuleSpec(spec); err != nil {
+			return nil, err
+		}
+
+		resourceId, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		rule := &pb.SecurityRulePrivate{
+			Metadata: &pb.SecurityRuleMetadata{
+				CloudAccountId:    cloudAccountId,
+				Name:              req.Metadata.Name,
+				ResourceId:        resourceId.String(),
+				CreationTimestamp: timestamppb.Now(),
+			},
+			Spec: spec,
+		}
+		if rule.Metadata.Name == "" {
+			rule.Metadata.Name = rule.Metadata.ResourceId
+		}
+
+		var securityGroupStatus *pb.SecurityGroupStatusPrivate
+		updateFunc := func(securityGroup *pb.SecurityGroupPrivate) error {
+			if securityGroup.Metadata.DeletionTimestamp != nil {
+				return status.Error(codes.FailedPrecondition, "security group is being deleted")
+			}
+			if len(securityGroup.Spec.Rules) >= maxRulesPerSecurityGroup {
+				return status.Errorf(codes.ResourceExhausted, "a security group can have at most %d rules", maxRulesPerSecurityGroup)
+			}
+			for _, existingRule := range securityGroup.Spec.Rules {
+				if existingRule.Spec.Priority == spec.Priority {
+					return status.Errorf(codes.AlreadyExists, "a rule with priority %d already exists", spec.Priority)
+				}
+				if existingRule.Metadata.Name == rule.Metadata.Name {
+					return status.Errorf(codes.AlreadyExists, "a rule named %s already exists", rule.Metadata.Name)
+				}
+			}
+			securityGroup.Spec.Rules = append(securityGroup.Spec.Rules, rule)
+			slices.SortFunc(securityGroup.Spec.Rules, func(a, b *pb.SecurityRulePrivate) int {
+				return int(a.Spec.Priority) - int(b.Spec.Priority)
+			})
+			setProvisioning(securityGroup)
+			securityGroupStatus = securityGroup.Status
+			return nil
+		}
+
+		if err := s.securityGroupService.update(ctx, cloudAccountId, spec.SecurityGroupId, "", updateFunc); err != nil {
+			return nil, err
+		}
+
+		return toSecurityRule(rule, securityGroupStatus), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *SecurityRuleService) Get(ctx context.Context, req *pb.SecurityRuleGetRequest) (*pb.SecurityRule, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityRuleService.Get").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.SecurityRule, error) {
+		securityGroup, err := s.getSecurityGroup(ctx, req.Metadata.CloudAccountId, req.SecurityGroupId)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range securityGroup.Spec.Rules {
+			if rule.Metadata.ResourceId == req.Metadata.ResourceId {
+				return toSecurityRule(rule, securityGroup.Status), nil
+			}
+		}
+		return nil, status.Error(codes.NotFound, "security rule not found")
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *SecurityRuleService) Search(ctx context.Context, req *pb.SecurityRuleSearchRequest) (*pb.SecurityRuleSearchResponse, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityRuleService.Search").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.SecurityRuleSearchResponse, error) {
+		securityGroup, err := s.getSecurityGroup(ctx, req.Metadata.CloudAccountId, req.SecurityGroupId)
+		if err != nil {
+			return nil, err
+		}
+		resp := &pb.SecurityRuleSearchResponse{}
+		for _, rule := range securityGroup.Spec.Rules {
+			resp.Items = append(resp.Items, toSecurityRule(rule, securityGroup.Status))
+		}
+		return resp, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Remove a rule from a security group.
+func (s *SecurityRuleService) Delete(ctx context.Context, req *pb.SecurityRuleDeleteRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityRuleService.Delete").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*emptypb.Empty, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+		if _, err := uuid.Parse(req.SecurityGroupId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid security group id")
+		}
+
+		updateFunc := func(securityGroup *pb.SecurityGroupPrivate) error {
+			i := slices.IndexFunc(securityGroup.Spec.Rules, func(rule *pb.SecurityRulePrivate) bool {
+				return rule.Metadata.ResourceId == req.Metadata.ResourceId
+			})
+			if i < 0 {
+				return status.Error(codes.NotFound, "security rule not found")
+			}
+			securityGroup.Spec.Rules = slices.Delete(securityGroup.Spec.Rules, i, i+1)
+			setProvisioning(securityGroup)
+			return nil
+		}
+
+		if err := s.securityGroupService.update(ctx, cloudAccountId, req.SecurityGroupId, "", updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+func (s *SecurityRuleService) getSecurityGroup(ctx context.Context, cloudAccountId string, securityGroupId string) (*pb.SecurityGroupPrivate, error) {
+	if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(securityGroupId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid security group id")
+	}
+	return s.securityGroupService.getPrivate(ctx, cloudAccountId, securityGroupIdKey, securityGroupId)
+}
+
+func toSecurityRule(rule *pb.SecurityRulePrivate, securityGroupStatus *pb.SecurityGroupStatusPrivate) *pb.SecurityRule {
+	return &pb.SecurityRule{
+		Metadata: rule.Metadata,
+		Spec:     rule.Spec,
+		Status: &pb.SecurityRuleStatus{
+			Phase:   securityGroupStatus.GetPhase(),
+			Message: securityGroupStatus.GetMessage(),
+		},
+	}
+}
@@ -226,16 +226,24 @@ func (s *SubnetService) Search(ctx context.Context, req *pb.SubnetSearchRequest)
 			flattenedObject.Add(column, value)
 		}
 
+		pagination, err := protodb.NewPagination(req.PageSize, req.PageToken, "name", "resource_id")
+		if err != nil {
+			return nil, err
+		}
+
 		whereString := flattenedObject.GetWhereString(1)
 
 		query := fmt.Sprintf(`
 			select %s
 			from   subnet
 			where  %s
-			order by name
-		`, transformer.ColumnsForFromRow(), whereString)
+			and    %s
+			%s
+			%s
+		`, transformer.ColumnsForFromRow(), whereString,
+			pagination.GetWhereString(len(flattenedObject.Values)+1), pagination.GetOrderByString(), pagination.GetLimitString())
 
-		args := flattenedObject.Values
+		args := append(flattenedObject.Values, pagination.GetValues()...)
 		rows, err := s.db.QueryContext(ctx, query, args...)
 		if err != nil {
 			return nil, err
@@ -249,8 +257,15 @@ func (s *SubnetService) Search(ctx context.Context, req *pb.SubnetSearchRequest)
 			}
 			items = append(items, item)
 		}
+		items, nextPageToken, err := protodb.NextPage(pagination, items, func(item *pb.VPCSubnet) []string {
+			return []string{item.Metadata.Name, item.Metadata.ResourceId}
+		})
+		if err != nil {
+			return nil, err
+		}
 		resp := &pb.SubnetSearchResponse{
-			Items: items,
+			Items:         items,
+			NextPageToken: nextPageToken,
 		}
 		return resp, nil
 	}()
@@ -569,6 +584,13 @@ func (s *SubnetService) create(ctx context.Context, subnet *pb.SubnetPrivate) er
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// validate subnet ipv6 cidr. It is optional and makes the subnet dual-stack.
+	if subnet.Spec.Ipv6CidrBlock != "" {
+		if err := networkutils.ValidateIPv6CIDR(subnet.Spec.Ipv6CidrBlock); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// Validate the VPCId passed is valid for this cloud account
 	vpc, err := s.vpcService.ValidateVPC(ctx, subnet.Metadata.CloudAccountId, subnet.Spec.VpcId)
 	if err != nil {
@@ -585,6 +607,18 @@ func (s *SubnetService) create(ctx context.Context, subnet *pb.SubnetPrivate) er
 		return status.Error(codes.InvalidArgument, "subnet CIDR is not within VPC CIDR.")
 	}
 
+	// Validate subnet is within VPC IPv6 CIDR
+	if subnet.Spec.Ipv6CidrBlock != "" {
+		if vpc.Spec.Ipv6CidrBlock == "" {
+			return status.Error(codes.InvalidArgument, "subnet IPv6 CIDR requires a VPC with an IPv6 CIDR.")
+		}
+		if isSubnetWithinVPC, err := networkutils.IsCIDRWithinCIDR(vpc.Spec.Ipv6CidrBlock, subnet.Spec.Ipv6CidrBlock); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		} else if !isSubnetWithinVPC {
+			return status.Error(codes.InvalidArgument, "subnet IPv6 CIDR is not within VPC IPv6 CIDR.")
+		}
+	}
+
 	// Use single transaction for:
 	// - Locking DB row (Prevents race condition between requests on same or different instances of this service)
 	// - Verify no overlaps with existing CIDRS
@@ -607,6 +641,14 @@ func (s *SubnetService) create(ctx context.Context, subnet *pb.SubnetPrivate) er
 		return status.Error(codes.InvalidArgument, "subnet CIDR overlaps with existing subnet CIDR within the VPC.")
 	}
 
+	if subnet.Spec.Ipv6CidrBlock != "" {
+		if overlapsExistingCIDR, err := networkutils.OverlapsExistingIPv6CIDRs(ctx, tx, subnet); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		} else if overlapsExistingCIDR {
+			return status.Error(codes.InvalidArgument, "subnet IPv6 CIDR overlaps with existing subnet IPv6 CIDR within the VPC.")
+		}
+	}
+
 	// Calculate resourceId
 	resourceId, err := uuid.NewRandom()
 	if err != nil {
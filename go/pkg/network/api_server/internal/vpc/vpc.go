@@ -199,16 +199,24 @@ func (s *VPCService) Search(ctx context.Context, req *pb.VPCSearchRequest) (*pb.
 			flattenedObject.Add(column, value)
 		}
 
+		pagination, err := protodb.NewPagination(req.PageSize, req.PageToken, "name", "resource_id")
+		if err != nil {
+			return nil, err
+		}
+
 		whereString := flattenedObject.GetWhereString(1)
 
 		query := fmt.Sprintf(`
 			select %s
 			from   vpc
 			where  %s
-			order by name
-		`, s.sqlTransformer.ColumnsForFromRow(), whereString)
+			and    %s
+			%s
+			%s
+		`, s.sqlTransformer.ColumnsForFromRow(), whereString,
+			pagination.GetWhereString(len(flattenedObject.Values)+1), pagination.GetOrderByString(), pagination.GetLimitString())
 
-		args := flattenedObject.Values
+		args := append(flattenedObject.Values, pagination.GetValues()...)
 		rows, err := s.db.QueryContext(ctx, query, args...)
 		if err != nil {
 			return nil, err
@@ -222,8 +230,15 @@ func (s *VPCService) Search(ctx context.Context, req *pb.VPCSearchRequest) (*pb.
 			}
 			items = append(items, item)
 		}
+		items, nextPageToken, err := protodb.NextPage(pagination, items, func(item *pb.VPC) []string {
+			return []string{item.Metadata.Name, item.Metadata.ResourceId}
+		})
+		if err != nil {
+			return nil, err
+		}
 		resp := &pb.VPCSearchResponse{
-			Items: items,
+			Items:         items,
+			NextPageToken: nextPageToken,
 		}
 		return resp, nil
 	}()
@@ -511,6 +526,13 @@ func (s *VPCService) create(ctx context.Context, vpc *pb.VPCPrivate) error {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// The IPv6 CIDR block is optional. It makes the VPC dual-stack.
+	if vpc.Spec.Ipv6CidrBlock != "" {
+		if err := networkutils.ValidateIPv6CIDR(vpc.Spec.Ipv6CidrBlock); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// Calculate resourceId
 	resourceId, err := uuid.NewRandom()
 	if err != nil {
@@ -0,0 +1,799 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package vpc_peering
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/subnet"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/transformer"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/vpc"
+	networkutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/utils"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	vpcPeeringIdKey = "resource_id"
+	// The unique index that prevents more than one VPC peering between the same two VPCs.
+	vpcPairIndexName = "idx_vpc_peering_vpc_pair"
+)
+
+// A VPC peering is stored with the requester cloud account.
+// It is visible to both the requester and the accepter cloud account.
+type VPCPeeringService struct {
+	pb.UnimplementedVPCPeeringServiceServer
+	pb.UnimplementedVPCPeeringPrivateServiceServer
+	db                        *sql.DB
+	cfg                       config.Config
+	cloudAccountServiceClient pb.CloudAccountServiceClient
+	sqlTransformer            *VPCPeeringSQLTransformer
+	vpcService                *vpc.VPCService
+	subnetService             *subnet.SubnetService
+}
+
+func NewVPCPeeringService(
+	db *sql.DB,
+	config config.Config,
+	cloudAccountServiceClient pb.CloudAccountServiceClient,
+	vpcService *vpc.VPCService,
+	subnetService *subnet.SubnetService,
+) (*VPCPeeringService, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	return &VPCPeeringService{
+		db:                        db,
+		cfg:                       config,
+		cloudAccountServiceClient: cloudAccountServiceClient,
+		sqlTransformer:            NewVPCPeeringSQLTransformer(),
+		vpcService:                vpcService,
+		subnetService:             subnetService,
+	}, nil
+}
+
+func (s *VPCPeeringService) Ping(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("VPCPeeringService.Ping")
+	log.Info("Ping")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *VPCPeeringService) PingPrivate(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	log := log.FromContext(ctx).WithName("VPCPeeringService.PingPrivate")
+	log.Info("PingPrivate")
+	return &emptypb.Empty{}, nil
+}
+
+// Public API: Request a new VPC peering.
+func (s *VPCPeeringService) Create(ctx context.Context, req *pb.VPCPeeringCreateRequest) (*pb.VPCPeering, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.Create").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.VPCPeering, error) {
+		// Validate input.
+		if req.Spec == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing spec")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		accepterCloudAccountId := req.Spec.AccepterCloudAccountId
+		if accepterCloudAccountId == "" {
+			accepterCloudAccountId = cloudAccountId
+		}
+		if err := cloudaccount.CheckValidId(accepterCloudAccountId); err != nil {
+			return nil, err
+		}
+
+		if req.Spec.RequesterVpcId == req.Spec.AccepterVpcId {
+			return nil, status.Error(codes.InvalidArgument, "a vpc cannot be peered with itself")
+		}
+
+		requesterVpc, err := s.validatePeeredVPC(ctx, cloudAccountId, req.Spec.RequesterVpcId, "requesterVpcId")
+		if err != nil {
+			return nil, err
+		}
+		accepterVpc, err := s.validatePeeredVPC(ctx, accepterCloudAccountId, req.Spec.AccepterVpcId, "accepterVpcId")
+		if err != nil {
+			return nil, err
+		}
+
+		overlap, err := networkutils.IsCIDROverlap(requesterVpc.Spec.CidrBlock, accepterVpc.Spec.CidrBlock)
+		if err != nil {
+			return nil, err
+		}
+		if overlap {
+			return nil, status.Errorf(codes.InvalidArgument, "the cidr block %s of the requester vpc overlaps the cidr block %s of the accepter vpc",
+				requesterVpc.Spec.CidrBlock, accepterVpc.Spec.CidrBlock)
+		}
+
+		requester, err := s.newAttachment(ctx, requesterVpc, req.Spec.RequesterSubnetId)
+		if err != nil {
+			return nil, err
+		}
+
+		vpcPeering := &pb.VPCPeeringPrivate{
+			Metadata: &pb.VPCPeeringMetadataPrivate{
+				CloudAccountId: cloudAccountId,
+				Name:           req.Metadata.Name,
+				Labels:         req.Metadata.Labels,
+			},
+			Spec: &pb.VPCPeeringSpecPrivate{
+				Requester: requester,
+			},
+			Status: &pb.VPCPeeringStatusPrivate{
+				Phase:   pb.VPCPeeringPhase_VPCPeeringPhase_PendingAcceptance,
+				Message: "VPC peering is pending acceptance",
+			},
+		}
+
+		if accepterCloudAccountId == cloudAccountId {
+			// A peering between VPCs of the same cloud account is accepted when it is created.
+			accepter, err := s.newAttachment(ctx, accepterVpc, req.Spec.AccepterSubnetId)
+			if err != nil {
+				return nil, err
+			}
+			vpcPeering.Spec.Accepter = accepter
+			vpcPeering.Status.Phase = pb.VPCPeeringPhase_VPCPeeringPhase_Provisioning
+			vpcPeering.Status.Message = "VPC peering is provisioning"
+		} else {
+			if req.Spec.AccepterSubnetId != "" {
+				return nil, status.Error(codes.InvalidArgument, "accepterSubnetId must be provided by the accepter cloud account")
+			}
+			vpcPeering.Spec.Accepter = &pb.VPCPeeringAttachmentPrivate{
+				CloudAccountId: accepterCloudAccountId,
+				VpcId:          accepterVpc.Metadata.ResourceId,
+				VpcCidrBlock:   accepterVpc.Spec.CidrBlock,
+			}
+		}
+
+		if err := s.create(ctx, vpcPeering); err != nil {
+			return nil, err
+		}
+
+		return toVPCPeering(vpcPeering), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *VPCPeeringService) Get(ctx context.Context, req *pb.VPCPeeringGetRequest) (*pb.VPCPeering, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.Get").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.VPCPeering, error) {
+		vpcPeering, err := s.getVisible(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		return toVPCPeering(vpcPeering), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API.
+func (s *VPCPeeringService) Search(ctx context.Context, req *pb.VPCPeeringSearchRequest) (*pb.VPCPeeringSearchResponse, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.Search").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.VPCPeeringSearchResponse, error) {
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		query := fmt.Sprintf(`
+			select %s
+			from   vpc_peering
+			where  (cloud_account_id = $1 or value->'spec'->'accepter'->>'cloudAccountId' = $1)
+			  and  deleted_timestamp = $2
+		`, transformer.ColumnsForFromRow())
+		args := []any{cloudAccountId, common.TimestampInfinityStr}
+
+		if req.VpcId != "" {
+			if _, err := uuid.Parse(req.VpcId); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid vpc id")
+			}
+			query += ` and (value->'spec'->'requester'->>'vpcId' = $3 or value->'spec'->'accepter'->>'vpcId' = $3)`
+			args = append(args, req.VpcId)
+		}
+		query += ` order by name, resource_id`
+
+		rows, err := s.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		resp := &pb.VPCPeeringSearchResponse{}
+		for rows.Next() {
+			vpcPeering, err := s.sqlTransformer.FromRow(ctx, rows)
+			if err != nil {
+				return nil, err
+			}
+			resp.Items = append(resp.Items, toVPCPeering(vpcPeering))
+		}
+		return resp, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Accept a VPC peering requested by another cloud account.
+// The caller must be the accepter cloud account.
+func (s *VPCPeeringService) Accept(ctx context.Context, req *pb.VPCPeeringAcceptRequest) (*pb.VPCPeering, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.Accept").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*pb.VPCPeering, error) {
+		vpcPeering, err := s.getAsAccepter(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+
+		accepterVpc, err := s.vpcService.ValidateVPC(ctx, req.Metadata.CloudAccountId, vpcPeering.Spec.Accepter.VpcId)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, "the accepter vpc no longer exists")
+		}
+		accepter, err := s.newAttachment(ctx, accepterVpc, req.AccepterSubnetId)
+		if err != nil {
+			return nil, err
+		}
+
+		updateFunc := func(vpcPeering *pb.VPCPeeringPrivate) error {
+			if vpcPeering.Status.Phase != pb.VPCPeeringPhase_VPCPeeringPhase_PendingAcceptance {
+				return status.Errorf(codes.FailedPrecondition, "vpc peering cannot be accepted in phase %s", vpcPeering.Status.Phase)
+			}
+			vpcPeering.Spec.Accepter = accepter
+			vpcPeering.Status = &pb.VPCPeeringStatusPrivate{
+				Phase:   pb.VPCPeeringPhase_VPCPeeringPhase_Provisioning,
+				Message: "VPC peering is provisioning",
+			}
+			return nil
+		}
+
+		requesterCloudAccountId := vpcPeering.Metadata.CloudAccountId
+		if err := s.update(ctx, requesterCloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+
+		vpcPeering, err = s.getPrivate(ctx, requesterCloudAccountId, vpcPeeringIdKey, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		return toVPCPeering(vpcPeering), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Reject a VPC peering requested by another cloud account.
+// The caller must be the accepter cloud account.
+func (s *VPCPeeringService) Reject(ctx context.Context, req *pb.VPCPeeringRejectRequest) (*pb.VPCPeering, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.Reject").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*pb.VPCPeering, error) {
+		vpcPeering, err := s.getAsAccepter(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+
+		updateFunc := func(vpcPeering *pb.VPCPeeringPrivate) error {
+			if vpcPeering.Status.Phase != pb.VPCPeeringPhase_VPCPeeringPhase_PendingAcceptance {
+				return status.Errorf(codes.FailedPrecondition, "vpc peering cannot be rejected in phase %s", vpcPeering.Status.Phase)
+			}
+			vpcPeering.Status = &pb.VPCPeeringStatusPrivate{
+				Phase:   pb.VPCPeeringPhase_VPCPeeringPhase_Rejected,
+				Message: "VPC peering was rejected by the accepter cloud account",
+			}
+			return nil
+		}
+
+		requesterCloudAccountId := vpcPeering.Metadata.CloudAccountId
+		if err := s.update(ctx, requesterCloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+
+		vpcPeering, err = s.getPrivate(ctx, requesterCloudAccountId, vpcPeeringIdKey, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		return toVPCPeering(vpcPeering), nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Public API: Delete a VPC peering.
+// The VPC peering must not be the target of a route.
+func (s *VPCPeeringService) Delete(ctx context.Context, req *pb.VPCPeeringDeleteRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.Delete").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("Request", logkeys.Request, req)
+
+	resp, err := func() (*emptypb.Empty, error) {
+		vpcPeering, err := s.getVisible(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+
+		routeCount, err := s.countRoutes(ctx, req.Metadata.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		if routeCount > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "vpc peering is the target of routes in %d route tables", routeCount)
+		}
+
+		updateFunc := func(vpcPeering *pb.VPCPeeringPrivate) error {
+			if vpcPeering.Metadata.DeletionTimestamp == nil {
+				vpcPeering.Metadata.DeletionTimestamp = timestamppb.Now()
+				vpcPeering.Status.Phase = pb.VPCPeeringPhase_VPCPeeringPhase_Deleting
+				vpcPeering.Status.Message = "VPC peering is deleting"
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, vpcPeering.Metadata.CloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, utils.SanitizeError(err)
+}
+
+// Private API.
+// The VPC peering is returned if the cloud account is the requester or the accepter.
+func (s *VPCPeeringService) GetPrivate(ctx context.Context, req *pb.VPCPeeringGetPrivateRequest) (*pb.VPCPeeringPrivate, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.GetPrivate").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*pb.VPCPeeringPrivate, error) {
+		return s.getVisible(ctx, req.Metadata.CloudAccountId, req.Metadata.ResourceId)
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, err
+}
+
+// Allow update of:
+//   - Status
+//
+// If a resource version is provided and the VPC peering has changed since, FailedPrecondition is returned.
+// Private API.
+func (s *VPCPeeringService) UpdateStatus(ctx context.Context, req *pb.VPCPeeringUpdateStatusRequest) (*emptypb.Empty, error) {
+	if req.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.UpdateStatus").WithValues(logkeys.CloudAccountId, req.Metadata.CloudAccountId,
+		logkeys.ResourceId, req.Metadata.ResourceId).Start()
+	defer span.End()
+
+	logger.Info("Request", logkeys.Request, req)
+	resp, err := func() (*emptypb.Empty, error) {
+		if req.Status == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing status")
+		}
+
+		cloudAccountId := req.Metadata.CloudAccountId
+		if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+			return nil, err
+		}
+
+		updateFunc := func(vpcPeering *pb.VPCPeeringPrivate) error {
+			vpcPeering.Status = req.Status
+			if req.Metadata.DeletedTimestamp != nil {
+				vpcPeering.Metadata.DeletedTimestamp = req.Metadata.DeletedTimestamp
+			}
+			return nil
+		}
+
+		if err := s.update(ctx, cloudAccountId, req.Metadata.ResourceId, req.Metadata.ResourceVersion, updateFunc); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}()
+	log.LogResponseOrError(logger, req, resp, err)
+	return resp, err
+}
+
+// Return the VPC of the cloud account to peer.
+// A VPC that does not exist and a VPC of another cloud account return the same error, so that the VPCs of other cloud
+// accounts cannot be discovered.
+func (s *VPCPeeringService) validatePeeredVPC(ctx context.Context, cloudAccountId string, vpcId string, field string) (*pb.VPCPrivate, error) {
+	vpc, err := s.vpcService.ValidateVPC(ctx, cloudAccountId, vpcId)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+		}
+		return nil, err
+	}
+	return vpc, nil
+}
+
+// Build one side of a VPC peering.
+// The subnet must belong to the VPC and must not attach another VPC peering.
+func (s *VPCPeeringService) newAttachment(ctx context.Context, vpc *pb.VPCPrivate, subnetId string) (*pb.VPCPeeringAttachmentPrivate, error) {
+	if _, err := uuid.Parse(subnetId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subnet id")
+	}
+	subnet, err := s.subnetService.GetPrivate(ctx, &pb.SubnetGetPrivateRequest{
+		Metadata: &pb.SubnetMetadataReference{
+			CloudAccountId: vpc.Metadata.CloudAccountId,
+			NameOrId: &pb.SubnetMetadataReference_ResourceId{
+				ResourceId: subnetId,
+			},
+		},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.InvalidArgument, "subnet %s not found", subnetId)
+		}
+		return nil, err
+	}
+	if subnet.Spec.VpcId != vpc.Metadata.ResourceId {
+		return nil, status.Errorf(codes.InvalidArgument, "subnet %s is not in vpc %s", subnetId, vpc.Metadata.ResourceId)
+	}
+
+	var count int
+	query := `
+		select count(*)
+		from   vpc_peering
+		where  deleted_timestamp = $1
+		  and  (value->'spec'->'requester'->>'subnetId' = $2 or value->'spec'->'accepter'->>'subnetId' = $2)
+		  and  value->'status'->>'phase' != $3
+	`
+	if err := s.db.QueryRowContext(ctx, query, common.TimestampInfinityStr, subnetId, pb.VPCPeeringPhase_VPCPeeringPhase_Rejected.String()).Scan(&count); err != nil {
+		return nil, fmt.Errorf("newAttachment: %w", err)
+	}
+	if count > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "subnet %s already attaches a vpc peering", subnetId)
+	}
+
+	// The peering router uses the last usable address of the subnet.
+	// It sends traffic for the VPC to the subnet gateway.
+	interfaceIP, err := networkutils.LastUsableIP(subnet.Spec.CidrBlock)
+	if err != nil {
+		return nil, err
+	}
+	interfaceAddress, err := networkutils.AddressWithPrefixLength(interfaceIP, subnet.Spec.CidrBlock)
+	if err != nil {
+		return nil, err
+	}
+	gatewayIP, err := networkutils.FirstUsableIP(subnet.Spec.CidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VPCPeeringAttachmentPrivate{
+		CloudAccountId:   vpc.Metadata.CloudAccountId,
+		VpcId:            vpc.Metadata.ResourceId,
+		VpcCidrBlock:     vpc.Spec.CidrBlock,
+		SubnetId:         subnetId,
+		SubnetCidrBlock:  subnet.Spec.CidrBlock,
+		InterfaceAddress: interfaceAddress,
+		GatewayAddress:   gatewayIP.String(),
+	}, nil
+}
+
+// Return the number of route tables that have a route to the VPC peering.
+func (s *VPCPeeringService) countRoutes(ctx context.Context, vpcPeeringId string) (int, error) {
+	var count int
+	query := `
+		select count(*)
+		from   route_table
+		where  deleted_timestamp = $1
+		  and  value->'spec'->'routes' @> jsonb_build_array(jsonb_build_object('vpcPeeringId', $2::text))
+	`
+	if err := s.db.QueryRowContext(ctx, query, common.TimestampInfinityStr, vpcPeeringId).Scan(&count); err != nil {
+		return 0, fmt.Errorf("countRoutes: %w", err)
+	}
+	return count, nil
+}
+
+// Return a VPC peering that the cloud account can access, as the requester or the accepter.
+func (s *VPCPeeringService) getVisible(ctx context.Context, cloudAccountId string, resourceId string) (*pb.VPCPeeringPrivate, error) {
+	if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(resourceId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid resource id")
+	}
+
+	query := fmt.Sprintf(`
+		select %s
+		from   vpc_peering
+		where  (cloud_account_id = $1 or value->'spec'->'accepter'->>'cloudAccountId' = $1)
+		  and  resource_id = $2
+		  and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow())
+
+	rows, err := s.db.QueryContext(ctx, query, cloudAccountId, resourceId, common.TimestampInfinityStr)
+	if err != nil {
+		return nil, fmt.Errorf("getVisible: %w", err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, status.Error(codes.NotFound, "resource not found")
+	}
+	return s.sqlTransformer.FromRow(ctx, rows)
+}
+
+// Return a VPC peering that the cloud account can accept or reject.
+func (s *VPCPeeringService) getAsAccepter(ctx context.Context, cloudAccountId string, resourceId string) (*pb.VPCPeeringPrivate, error) {
+	vpcPeering, err := s.getVisible(ctx, cloudAccountId, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	if vpcPeering.Spec.Accepter.CloudAccountId != cloudAccountId || vpcPeering.Metadata.CloudAccountId == cloudAccountId {
+		return nil, status.Error(codes.FailedPrecondition, "only the accepter cloud account can accept or reject the vpc peering")
+	}
+	if vpcPeering.Metadata.DeletionTimestamp != nil {
+		return nil, status.Error(codes.FailedPrecondition, "vpc peering is being deleted")
+	}
+	return vpcPeering, nil
+}
+
+func (s *VPCPeeringService) getPrivate(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*pb.VPCPeeringPrivate, error) {
+	rows, err := s.selectVPCPeering(ctx, cloudAccountId, argName, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return s.sqlTransformer.FromRow(ctx, rows)
+}
+
+// Update a VPC peering record using the user-provided updateFunc to update the VPC peering.
+// The cloud account must be the requester cloud account.
+// This uses optimistic concurrency control to ensure that the record has not been updated between the select and update.
+// Additionally, if the caller provides a resource version, optimistic concurrency control can be extended to
+// previous get or search calls.
+func (s *VPCPeeringService) update(
+	ctx context.Context,
+	cloudAccountId string,
+	resourceId string,
+	resourceVersion string,
+	updateFunc func(*pb.VPCPeeringPrivate) error) error {
+
+	query := fmt.Sprintf(`
+		select %s
+		from   vpc_peering
+		where  cloud_account_id = $1
+			and  %s = $2
+			and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow(), vpcPeeringIdKey)
+
+	// Retry on conflict if caller did not provide resourceVersion.
+	isRetryable := func(err error) bool {
+		return resourceVersion == "" && status.Code(err) == codes.FailedPrecondition
+	}
+
+	err := retry.OnError(retry.DefaultRetry, isRetryable, func() error {
+		rows, err := s.db.QueryContext(ctx, query, cloudAccountId, resourceId, common.TimestampInfinityStr)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		if !rows.Next() {
+			return status.Error(codes.NotFound, "resource not found")
+		}
+		vpcPeering, err := s.sqlTransformer.FromRow(ctx, rows)
+		if err != nil {
+			return err
+		}
+		metadata := vpcPeering.Metadata
+
+		// If resource version was provided, ensure that stored version matches.
+		if resourceVersion != "" && resourceVersion != metadata.ResourceVersion {
+			return status.Error(codes.FailedPrecondition, "stored resource version does not match requested resource version")
+		}
+
+		// Update VPCPeering object.
+		if err := updateFunc(vpcPeering); err != nil {
+			return err
+		}
+
+		// Flatten VPC peering into columns.
+		flattened, err := s.sqlTransformer.Flatten(ctx, vpcPeering)
+		if err != nil {
+			return err
+		}
+
+		args := append([]any{metadata.CloudAccountId, metadata.ResourceId, metadata.ResourceVersion}, flattened.Values...)
+
+		deletedTimestamp := ""
+		if metadata.DeletedTimestamp != nil {
+			deletedTimestamp = "deleted_timestamp = '" + metadata.DeletedTimestamp.AsTime().Format(time.RFC3339) + "',"
+		}
+
+		// Update database.
+		updateQuery := fmt.Sprintf(`
+		update vpc_peering
+		set    resource_version = nextval('vpc_peering_resource_version_seq'),
+			   %s
+			   %s
+		where  cloud_account_id = $1
+		and    resource_id = $2
+		and    resource_version = $3
+		`, deletedTimestamp, flattened.GetUpdateSetString(4))
+		sqlResult, err := s.db.ExecContext(ctx, updateQuery, args...)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected < 1 {
+			return status.Error(codes.FailedPrecondition, "no records updated; possible update conflict")
+		}
+
+		return nil
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return status.Error(st.Code(), "update: "+st.Message())
+	}
+	return nil
+}
+
+// Validates and sets defaults in the provided VPCPeering object and stores it in the database.
+func (s *VPCPeeringService) create(ctx context.Context, vpcPeering *pb.VPCPeeringPrivate) error {
+	ctx, _, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringService.create").WithValues(logkeys.CloudAccountId, vpcPeering.Metadata.CloudAccountId).Start()
+	defer span.End()
+
+	// Validate
+	if err := utils.ValidateLabels(vpcPeering.Metadata.Labels); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := networkutils.ValidateSubnetName(vpcPeering.Metadata.Name); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Calculate resourceId
+	resourceId, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	vpcPeering.Metadata.ResourceId = resourceId.String()
+
+	// Calculate name if not provided.
+	if vpcPeering.Metadata.Name == "" {
+		vpcPeering.Metadata.Name = vpcPeering.Metadata.ResourceId
+	}
+	name := vpcPeering.Metadata.Name
+	vpcPeering.Metadata.CreationTimestamp = timestamppb.Now()
+
+	// Flatten VPC peering into columns.
+	flattened, err := s.sqlTransformer.Flatten(ctx, vpcPeering)
+	if err != nil {
+		return err
+	}
+
+	// Insert into database.
+	query := fmt.Sprintf(`insert into vpc_peering (resource_id, cloud_account_id, name, %s) values ($1, $2, $3, %s) returning resource_version`,
+		flattened.GetColumnsString(), flattened.GetInsertValuesString(4))
+	args := append([]any{vpcPeering.Metadata.ResourceId, vpcPeering.Metadata.CloudAccountId, name}, flattened.Values...)
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&vpcPeering.Metadata.ResourceVersion); err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == common.KErrUniqueViolation {
+			// Only one VPC peering can exist between two VPCs.
+			if pgErr.ConstraintName == vpcPairIndexName {
+				return status.Error(codes.AlreadyExists, "a vpc peering between the vpcs already exists")
+			}
+			return status.Error(codes.AlreadyExists, "insert: vpc peering "+name+" already exists")
+		}
+		return fmt.Errorf("insert: %w", err)
+	}
+
+	return nil
+}
+
+// Caller must close the returned sql.Rows.
+func (s *VPCPeeringService) selectVPCPeering(ctx context.Context, cloudAccountId string, argName string, arg interface{}) (*sql.Rows, error) {
+	query := fmt.Sprintf(`
+		select %s
+		from   vpc_peering
+		where  cloud_account_id = $1
+		  and  %s = $2
+		  and  deleted_timestamp = $3
+	`, transformer.ColumnsForFromRow(), argName)
+
+	rows, err := s.db.QueryContext(ctx, query, cloudAccountId, arg, common.TimestampInfinityStr)
+	if err != nil {
+		return nil, fmt.Errorf("selectVPCPeering: %w", err)
+	}
+	if !rows.Next() {
+		defer rows.Close()
+		return nil, status.Error(codes.NotFound, "resource not found")
+	}
+	return rows, nil
+}
+
+// Convert a VPCPeeringPrivate to a public VPCPeering.
+func toVPCPeering(vpcPeering *pb.VPCPeeringPrivate) *pb.VPCPeering {
+	return &pb.VPCPeering{
+		Metadata: &pb.VPCPeeringMetadata{
+			CloudAccountId:    vpcPeering.Metadata.CloudAccountId,
+			Name:              vpcPeering.Metadata.Name,
+			ResourceId:        vpcPeering.Metadata.ResourceId,
+			ResourceVersion:   vpcPeering.Metadata.ResourceVersion,
+			Labels:            vpcPeering.Metadata.Labels,
+			CreationTimestamp: vpcPeering.Metadata.CreationTimestamp,
+			DeletionTimestamp: vpcPeering.Metadata.DeletionTimestamp,
+		},
+		Spec: &pb.VPCPeeringSpec{
+			RequesterVpcId:         vpcPeering.Spec.GetRequester().GetVpcId(),
+			RequesterSubnetId:      vpcPeering.Spec.GetRequester().GetSubnetId(),
+			AccepterCloudAccountId: vpcPeering.Spec.GetAccepter().GetCloudAccountId(),
+			AccepterVpcId:          vpcPeering.Spec.GetAccepter().GetVpcId(),
+			AccepterSubnetId:       vpcPeering.Spec.GetAccepter().GetSubnetId(),
+		},
+		Status: &pb.VPCPeeringStatus{
+			Phase:   vpcPeering.Status.GetPhase(),
+			Message: vpcPeering.Status.GetMessage(),
+		},
+	}
+}
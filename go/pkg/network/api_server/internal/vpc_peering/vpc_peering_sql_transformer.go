@@ -0,0 +1,137 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package vpc_peering
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/compute_api_server/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Transforms a VPCPeering to a form that can be written to a SQL database.
+// Also performs the inverse, reading from sql.Rows and creating a VPCPeering.
+// This uses the JSON serializer from the GRPC Gateway.
+type VPCPeeringSQLTransformer struct {
+	marshaler *runtime.JSONPb
+}
+
+func NewVPCPeeringSQLTransformer() *VPCPeeringSQLTransformer {
+	return &VPCPeeringSQLTransformer{
+		marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				// When writing JSON, emit fields that have default values, including for enums.
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				// When reading JSON, ignore fields with unknown names.
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+// Returns a Flattened object that can be used to construct a SQL INSERT or UPDATE statement.
+// The Flattened object omits columns that are never updated, such as the primary key columns.
+func (s *VPCPeeringSQLTransformer) Flatten(ctx context.Context, vpcPeering *pb.VPCPeeringPrivate) (*protodb.Flattened, error) {
+	flattened := &protodb.Flattened{}
+	jsonVPCPeering, err := s.marshaler.Marshal(vpcPeering)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to json: %w", err)
+	}
+	flattened.Add("value", jsonVPCPeering)
+	return flattened, nil
+}
+
+// Read a database row into a VPCPeering.
+func (s *VPCPeeringSQLTransformer) FromRow(ctx context.Context, rows *sql.Rows) (*pb.VPCPeeringPrivate, error) {
+	log := log.FromContext(ctx).WithName("VPCPeeringSQLTransformer.FromRow")
+	metadata := &pb.VPCPeeringMetadataPrivate{}
+	var deletedTimestamp string
+	var resourceJson []byte
+	if err := rows.Scan(&metadata.CloudAccountId, &metadata.ResourceId, &metadata.Name, &deletedTimestamp, &metadata.ResourceVersion, &resourceJson); err != nil {
+		return nil, fmt.Errorf("RowToVPCPeeringPrivate: Scan: %w", err)
+	}
+	log.V(9).Info("scanned", logkeys.ResourceId, metadata.ResourceId, logkeys.ResourceJson, string(resourceJson))
+	vpcPeering := &pb.VPCPeeringPrivate{}
+	if err := s.marshaler.Unmarshal(resourceJson, &vpcPeering); err != nil {
+		return nil, err
+	}
+	log.V(9).Info("decoded", logkeys.VPC_PEERING, vpcPeering)
+	// Copy fields directly in the row to the VPC peering.
+	vpcPeering.Metadata.CloudAccountId = metadata.CloudAccountId
+	vpcPeering.Metadata.ResourceId = metadata.ResourceId
+	vpcPeering.Metadata.ResourceVersion = metadata.ResourceVersion
+	return vpcPeering, nil
+}
+
+// Read a database row into a VPCPeeringPrivateWatchResponse.
+// This encodes the Spec & Status as json blobs to allow informer to handle the proto style resources.
+func (s *VPCPeeringSQLTransformer) FromRowWatchResponse(ctx context.Context, rows *sql.Rows) (*pb.VPCPeeringPrivateWatchResponse, error) {
+	log := log.FromContext(ctx).WithName("VPCPeeringSQLTransformer.FromRowWatchResponse")
+	metadata := &pb.VPCPeeringMetadataPrivate{}
+	var deletedTimestamp string
+	var resourceJson []byte
+	if err := rows.Scan(&metadata.CloudAccountId, &metadata.ResourceId, &metadata.Name, &deletedTimestamp, &metadata.ResourceVersion, &resourceJson); err != nil {
+		return nil, fmt.Errorf("FromRowWatchResponse: Scan: %w", err)
+	}
+	log.V(9).Info("scanned", logkeys.ResourceId, metadata.ResourceId, logkeys.ResourceJson, string(resourceJson))
+
+	// Unmarshal into a VPCPeeringPrivate
+	vpcPeeringPrivate := &pb.VPCPeeringPrivate{}
+	if err := s.marshaler.Unmarshal(resourceJson, &vpcPeeringPrivate); err != nil {
+		return nil, err
+	}
+
+	// Convert the VPCPeeringPrivate into a VPCPeeringPrivateWatchResponse
+	spec, err := s.marshaler.Marshal(vpcPeeringPrivate.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.marshaler.Marshal(vpcPeeringPrivate.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcPeering := &pb.VPCPeeringPrivateWatchResponse{
+		Metadata: vpcPeeringPrivate.Metadata,
+		Spec:     string(spec),
+		Status:   string(status),
+	}
+
+	log.V(9).Info("decoded", logkeys.VPC_PEERING, vpcPeering)
+	// Copy fields directly in the row to the VPC peering.
+	vpcPeering.Metadata.CloudAccountId = metadata.CloudAccountId
+	vpcPeering.Metadata.ResourceId = metadata.ResourceId
+	vpcPeering.Metadata.Name = metadata.Name
+	vpcPeering.Metadata.ResourceVersion = metadata.ResourceVersion
+	vpcPeering.Metadata.DeletionTimestamp = vpcPeeringPrivate.Metadata.DeletionTimestamp
+	vpcPeering.Metadata.DeletedTimestamp, err = timestampStrToPbTimestamp(deletedTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return vpcPeering, nil
+}
+
+// Convert a timestamp from Postgres format to Protobuf.
+// The special time "infinity" is returned as (nil, nil).
+func timestampStrToPbTimestamp(ts string) (*timestamppb.Timestamp, error) {
+	if ts == common.TimestampInfinityStr {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
@@ -18,10 +18,14 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/elastic_ip"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/global_operations"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/iprm"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/route_table"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/security_group"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/subnet"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/vpc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/vpc_peering"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/db"
 	sdnv1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
@@ -38,14 +42,21 @@ type GrpcService struct {
 	VPCService                *vpc.VPCService
 	SubnetService             *subnet.SubnetService
 	IPRMService               *iprm.IPRMService
+	SecurityGroupService      *security_group.SecurityGroupService
+	SecurityRuleService       *security_group.SecurityRuleService
+	VPCPeeringService         *vpc_peering.VPCPeeringService
+	RouteTableService         *route_table.RouteTableService
 	GlobalOperationsService   *global_operations.GlobalOperationsService
 	AddressTranslationService *address_translation.AddressTranslationPrivateService
+	ElasticIPService          *elastic_ip.ElasticIPService
+	ElasticIPMeteringReporter *elastic_ip.ElasticIPMeteringReporter
 	listener                  net.Listener
 	grpcServer                *grpc.Server
 	db                        *sql.DB
 	errc                      chan error
 	cfg                       config.Config
 	cloudAccountServiceClient pb.CloudAccountServiceClient
+	meteringServiceClient     pb.MeteringServiceClient
 	sdnClient                 sdnv1.OvnnetClient
 	availabilityZones         []string
 	prometheusPort            int
@@ -57,6 +68,7 @@ func New(
 	managedDb *manageddb.ManagedDb,
 	listener net.Listener,
 	cloudAccountServiceClient pb.CloudAccountServiceClient,
+	meteringServiceClient pb.MeteringServiceClient,
 	availabilityZones []string,
 ) (*GrpcService, error) {
 	return &GrpcService{
@@ -64,6 +76,7 @@ func New(
 		listener:                  listener,
 		cfg:                       *cfg,
 		cloudAccountServiceClient: cloudAccountServiceClient,
+		meteringServiceClient:     meteringServiceClient,
 		availabilityZones:         availabilityZones,
 	}, nil
 }
@@ -127,7 +140,31 @@ func (s *GrpcService) Start(ctx context.Context) error {
 	}
 	s.SubnetService = subnetService
 
-	iprmService, err := iprm.NewIPRMService(db, s.cfg, s.cloudAccountServiceClient, subnetService)
+	securityGroupService, err := security_group.NewSecurityGroupService(db, s.cfg, s.cloudAccountServiceClient, vpcService)
+	if err != nil {
+		return err
+	}
+	s.SecurityGroupService = securityGroupService
+
+	securityRuleService, err := security_group.NewSecurityRuleService(securityGroupService)
+	if err != nil {
+		return err
+	}
+	s.SecurityRuleService = securityRuleService
+
+	vpcPeeringService, err := vpc_peering.NewVPCPeeringService(db, s.cfg, s.cloudAccountServiceClient, vpcService, subnetService)
+	if err != nil {
+		return err
+	}
+	s.VPCPeeringService = vpcPeeringService
+
+	routeTableService, err := route_table.NewRouteTableService(db, s.cfg, s.cloudAccountServiceClient, vpcService, subnetService, vpcPeeringService)
+	if err != nil {
+		return err
+	}
+	s.RouteTableService = routeTableService
+
+	iprmService, err := iprm.NewIPRMService(db, s.cfg, s.cloudAccountServiceClient, subnetService, securityGroupService)
 	if err != nil {
 		return err
 	}
@@ -145,11 +182,43 @@ func (s *GrpcService) Start(ctx context.Context) error {
 	}
 	s.AddressTranslationService = addressTranslationService
 
+	elasticIPService, err := elastic_ip.NewElasticIPService(db, s.cfg, s.cloudAccountServiceClient, iprmService, addressTranslationService)
+	if err != nil {
+		return err
+	}
+	s.ElasticIPService = elasticIPService
+
+	// Elastic IPs are not metered if the metering service is not configured.
+	if s.meteringServiceClient != nil {
+		elasticIPMeteringReporter, err := elastic_ip.NewElasticIPMeteringReporter(db, s.cfg, s.meteringServiceClient)
+		if err != nil {
+			return err
+		}
+		s.ElasticIPMeteringReporter = elasticIPMeteringReporter
+		// Usage must be reported by a single replica.
+		if s.cfg.LeaderElection.Enabled {
+			if err := runWithLeaderElection(ctx, s.cfg.LeaderElection, elasticIPMeteringReporter.Start); err != nil {
+				return err
+			}
+		} else {
+			go elasticIPMeteringReporter.Start(ctx)
+		}
+	}
+
 	pb.RegisterVPCServiceServer(s.grpcServer, vpcService)
 	pb.RegisterVPCPrivateServiceServer(s.grpcServer, vpcService)
 	pb.RegisterSubnetServiceServer(s.grpcServer, subnetService)
 	pb.RegisterSubnetPrivateServiceServer(s.grpcServer, subnetService)
 	pb.RegisterIPRMPrivateServiceServer(s.grpcServer, iprmService)
+	pb.RegisterSecurityGroupServiceServer(s.grpcServer, securityGroupService)
+	pb.RegisterSecurityGroupPrivateServiceServer(s.grpcServer, securityGroupService)
+	pb.RegisterSecurityRuleServiceServer(s.grpcServer, securityRuleService)
+	pb.RegisterVPCPeeringServiceServer(s.grpcServer, vpcPeeringService)
+	pb.RegisterVPCPeeringPrivateServiceServer(s.grpcServer, vpcPeeringService)
+	pb.RegisterRouteTableServiceServer(s.grpcServer, routeTableService)
+	pb.RegisterRouteTablePrivateServiceServer(s.grpcServer, routeTableService)
+	pb.RegisterElasticIPServiceServer(s.grpcServer, elasticIPService)
+	pb.RegisterElasticIPPrivateServiceServer(s.grpcServer, elasticIPService)
 
 	pb.RegisterGlobalOperationsServiceServer(s.grpcServer, GlobalOperationsService)
 	pb.RegisterAddressTranslationPrivateServiceServer(s.grpcServer, addressTranslationService)
@@ -0,0 +1,82 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// Run task while this replica holds the lease, until ctx is cancelled.
+// The context of task is cancelled when the lease is lost, and task is started again when the lease is acquired again.
+func runWithLeaderElection(ctx context.Context, cfg config.LeaderElectionConfig, task func(ctx context.Context)) error {
+	log := log.FromContext(ctx).WithName("runWithLeaderElection")
+
+	if cfg.LeaseName == "" || cfg.LeaseNamespace == "" {
+		return fmt.Errorf("leaseName and leaseNamespace are required for leader election")
+	}
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return fmt.Errorf("leader election: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("leader election: %w", err)
+	}
+	// The pod name identifies the replica.
+	identity, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("leader election: %w", err)
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      cfg.LeaseName,
+				Namespace: cfg.LeaseNamespace,
+			},
+			Client:     clientset.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Info("started leading", "lease", cfg.LeaseName, "identity", identity)
+				task(ctx)
+			},
+			OnStoppedLeading: func() {
+				log.Info("stopped leading", "lease", cfg.LeaseName, "identity", identity)
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("leader election: %w", err)
+	}
+
+	go func() {
+		// Run returns when the lease is lost. Try to acquire it again until ctx is cancelled.
+		for ctx.Err() == nil {
+			elector.Run(ctx)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,14 @@
+#!/usr/bin/env bash
+# INTEL CONFIDENTIAL
+# Copyright (C) 2023 Intel Corporation
+set -ex
+SCRIPT_DIR=$(cd "$(dirname "$0")" && pwd)
+source "${SCRIPT_DIR}/defaults.sh"
+
+curl -vk \
+-H 'Content-type: application/json' \
+-H "Origin: http://localhost:3001/" \
+-H "Authorization: Bearer ${TOKEN}" \
+-X DELETE \
+${IDC_REGIONAL_URL_PREFIX}/v1/cloudaccounts/${CLOUDACCOUNT}/network/elasticips/id/${ELASTICIPID} \
+| jq .
@@ -0,0 +1,312 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/api_server/internal/elastic_ip"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newCreateElasticIPRequest(cloudAccountId string, name string) *pb.ElasticIPCreateRequest {
+	return &pb.ElasticIPCreateRequest{
+		Metadata: &pb.ElasticIPMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           name,
+		},
+	}
+}
+
+func newElasticIPReference(cloudAccountId, id string) *pb.ElasticIPMetadataReference {
+	return &pb.ElasticIPMetadataReference{
+		CloudAccountId: cloudAccountId,
+		ResourceId:     id,
+	}
+}
+
+func newPort(ctx context.Context, cloudAccountId string, subnet *pb.VPCSubnet, ipAddress string) *pb.PortPrivate {
+	port, err := iprmPrivateServiceClient.ReservePort(ctx, &pb.ReservePortRequest{
+		Metadata: &pb.PortMetadataCreatePrivate{
+			CloudAccountId: cloudAccountId,
+		},
+		Spec: &pb.PortSpecPrivate{
+			SubnetId:        subnet.Metadata.ResourceId,
+			IpuSerialNumber: "ipuSerialNumber",
+			ChassisId:       uuid.NewString(),
+			IpAddress:       ipAddress,
+			MacAddress:      "macAddress",
+		},
+	})
+	Expect(err).Should(Succeed())
+	return port
+}
+
+func getAddressTranslation(ctx context.Context, cloudAccountId, id string) *pb.AddressTranslationPrivate {
+	addressTranslation, err := addressTranslationPrivateServiceClient.GetPrivate(ctx, &pb.AddressTranslationGetPrivateRequest{
+		Metadata: &pb.AddressTranslationIdReference{
+			CloudAccountId: cloudAccountId,
+			ResourceId:     id,
+		},
+	})
+	Expect(err).Should(Succeed())
+	return addressTranslation
+}
+
+var _ = Describe("Elastic IP API Integration Tests", Serial, func() {
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		clearDatabase(ctx)
+	})
+
+	It("Create, Get, Search and Delete should succeed", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+
+		By("Creating an elastic IP")
+		elasticIP, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip1"))
+		Expect(err).Should(Succeed())
+		Expect(elasticIP.Metadata.Name).Should(Equal("eip1"))
+		Expect(elasticIP.Status.Phase).Should(Equal(pb.ElasticIPPhase_ElasticIPPhase_Allocated))
+		Expect(elasticIP.Status.IpAddress).Should(BeElementOf("198.51.100.1", "198.51.100.2"))
+		Expect(elasticIP.Status.PortId).Should(BeEmpty())
+
+		By("Getting the elastic IP")
+		getResp, err := elasticIPServiceClient.Get(ctx, &pb.ElasticIPGetRequest{
+			Metadata: newElasticIPReference(cloudAccountId, elasticIP.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+		Expect(getResp.Status.IpAddress).Should(Equal(elasticIP.Status.IpAddress))
+
+		By("Getting the elastic IP from another cloud account")
+		_, err = elasticIPServiceClient.Get(ctx, &pb.ElasticIPGetRequest{
+			Metadata: newElasticIPReference(cloudaccount.MustNewId(), elasticIP.Metadata.ResourceId),
+		})
+		Expect(status.Code(err)).Should(Equal(codes.NotFound))
+
+		By("Creating an elastic IP with the same name")
+		_, err = elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip1"))
+		Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+
+		By("Searching elastic IPs")
+		searchResp, err := elasticIPServiceClient.Search(ctx, &pb.ElasticIPSearchRequest{
+			Metadata: &pb.ElasticIPMetadataSearch{CloudAccountId: cloudAccountId},
+		})
+		Expect(err).Should(Succeed())
+		Expect(searchResp.Items).Should(HaveLen(1))
+
+		By("Deleting the elastic IP")
+		_, err = elasticIPServiceClient.Delete(ctx, &pb.ElasticIPDeleteRequest{
+			Metadata: newElasticIPReference(cloudAccountId, elasticIP.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+
+		_, err = elasticIPServiceClient.Get(ctx, &pb.ElasticIPGetRequest{
+			Metadata: newElasticIPReference(cloudAccountId, elasticIP.Metadata.ResourceId),
+		})
+		Expect(status.Code(err)).Should(Equal(codes.NotFound))
+	})
+
+	It("Create should fail when the public IP pool is exhausted", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+
+		By("Allocating all addresses of the pool")
+		eip1, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip1"))
+		Expect(err).Should(Succeed())
+		eip2, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip2"))
+		Expect(err).Should(Succeed())
+		Expect(eip2.Status.IpAddress).ShouldNot(Equal(eip1.Status.IpAddress))
+
+		_, err = elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip3"))
+		Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+
+		By("Releasing an elastic IP")
+		_, err = elasticIPServiceClient.Delete(ctx, &pb.ElasticIPDeleteRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip1.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+
+		By("Allocating the released address")
+		eip3, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip3"))
+		Expect(err).Should(Succeed())
+		Expect(eip3.Status.IpAddress).Should(Equal(eip1.Status.IpAddress))
+	})
+
+	It("Associate and Disassociate should manage the address translation of the port", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+		_, subnet, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+		Expect(err).Should(Succeed())
+		port := newPort(ctx, cloudAccountId, subnet, "10.0.0.10")
+
+		eip1, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip1"))
+		Expect(err).Should(Succeed())
+		eip2, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip2"))
+		Expect(err).Should(Succeed())
+
+		By("Associating with a port that does not exist")
+		_, err = elasticIPServiceClient.Associate(ctx, &pb.ElasticIPAssociateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip1.Metadata.ResourceId),
+			PortId:   uuid.NewString(),
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		By("Associating with a port of another cloud account")
+		otherCloudAccountId := cloudaccount.MustNewId()
+		eipOther, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(otherCloudAccountId, "eip1"))
+		Expect(err).Should(Succeed())
+		_, err = elasticIPServiceClient.Associate(ctx, &pb.ElasticIPAssociateRequest{
+			Metadata: newElasticIPReference(otherCloudAccountId, eipOther.Metadata.ResourceId),
+			PortId:   port.Metadata.ResourceId,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		_, err = elasticIPServiceClient.Delete(ctx, &pb.ElasticIPDeleteRequest{
+			Metadata: newElasticIPReference(otherCloudAccountId, eipOther.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+
+		By("Associating the elastic IP with the port")
+		associated, err := elasticIPServiceClient.Associate(ctx, &pb.ElasticIPAssociateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip1.Metadata.ResourceId),
+			PortId:   port.Metadata.ResourceId,
+		})
+		Expect(err).Should(Succeed())
+		Expect(associated.Status.Phase).Should(Equal(pb.ElasticIPPhase_ElasticIPPhase_Associated))
+		Expect(associated.Status.PortId).Should(Equal(port.Metadata.ResourceId))
+		Expect(associated.Status.PrivateIpAddress).Should(Equal("10.0.0.10"))
+
+		eip1Private, err := elasticIPPrivateServiceClient.GetPrivate(ctx, &pb.ElasticIPGetPrivateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip1.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+		addressTranslation := getAddressTranslation(ctx, cloudAccountId, eip1Private.Spec.AddressTranslationId)
+		Expect(addressTranslation.Spec.TranslationType).Should(Equal("elastic"))
+		Expect(addressTranslation.Spec.PortId).Should(Equal(port.Metadata.ResourceId))
+		Expect(addressTranslation.Spec.IpAddress).Should(Equal(eip1.Status.IpAddress))
+
+		By("Associating the elastic IP again")
+		_, err = elasticIPServiceClient.Associate(ctx, &pb.ElasticIPAssociateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip1.Metadata.ResourceId),
+			PortId:   port.Metadata.ResourceId,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+		By("Associating another elastic IP with the same port")
+		_, err = elasticIPServiceClient.Associate(ctx, &pb.ElasticIPAssociateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip2.Metadata.ResourceId),
+			PortId:   port.Metadata.ResourceId,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+		By("Disassociating the elastic IP")
+		disassociated, err := elasticIPServiceClient.Disassociate(ctx, &pb.ElasticIPDisassociateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip1.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+		Expect(disassociated.Status.Phase).Should(Equal(pb.ElasticIPPhase_ElasticIPPhase_Allocated))
+		Expect(disassociated.Status.PortId).Should(BeEmpty())
+		Expect(disassociated.Status.IpAddress).Should(Equal(eip1.Status.IpAddress))
+		addressTranslation = getAddressTranslation(ctx, cloudAccountId, eip1Private.Spec.AddressTranslationId)
+		Expect(addressTranslation.Metadata.DeletionTimestamp).ShouldNot(BeNil())
+
+		By("Disassociating the elastic IP again")
+		_, err = elasticIPServiceClient.Disassociate(ctx, &pb.ElasticIPDisassociateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip1.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+
+		By("Associating another elastic IP with the port")
+		associated, err = elasticIPServiceClient.Associate(ctx, &pb.ElasticIPAssociateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip2.Metadata.ResourceId),
+			PortId:   port.Metadata.ResourceId,
+		})
+		Expect(err).Should(Succeed())
+		Expect(associated.Status.PortId).Should(Equal(port.Metadata.ResourceId))
+
+		By("Deleting the associated elastic IP")
+		eip2Private, err := elasticIPPrivateServiceClient.GetPrivate(ctx, &pb.ElasticIPGetPrivateRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip2.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+		_, err = elasticIPServiceClient.Delete(ctx, &pb.ElasticIPDeleteRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip2.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+		addressTranslation = getAddressTranslation(ctx, cloudAccountId, eip2Private.Spec.AddressTranslationId)
+		Expect(addressTranslation.Metadata.DeletionTimestamp).ShouldNot(BeNil())
+	})
+
+	It("Usage should be reported for allocated and released elastic IPs", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+		eip1, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip1"))
+		Expect(err).Should(Succeed())
+		eip2, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip2"))
+		Expect(err).Should(Succeed())
+		_, err = elasticIPServiceClient.Delete(ctx, &pb.ElasticIPDeleteRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip2.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+
+		usageRecordsMutex.Lock()
+		usageRecords = nil
+		usageRecordsMutex.Unlock()
+
+		Expect(grpcService.ElasticIPMeteringReporter.Report(ctx)).Should(Succeed())
+
+		usageRecordsMutex.Lock()
+		defer usageRecordsMutex.Unlock()
+		recordsByResourceId := map[string]*pb.UsageCreate{}
+		for _, record := range usageRecords {
+			recordsByResourceId[record.ResourceId] = record
+		}
+		Expect(recordsByResourceId).Should(HaveKey(eip1.Metadata.ResourceId))
+		Expect(recordsByResourceId).Should(HaveKey(eip2.Metadata.ResourceId))
+
+		record := recordsByResourceId[eip1.Metadata.ResourceId]
+		Expect(record.CloudAccountId).Should(Equal(cloudAccountId))
+		Expect(record.Properties).Should(HaveKeyWithValue("serviceType", "ElasticIPAsAService"))
+		Expect(record.Properties).Should(HaveKeyWithValue("region", "us-dev-1"))
+		Expect(record.Properties).Should(HaveKeyWithValue("ipAddress", eip1.Status.IpAddress))
+		Expect(record.Properties).Should(HaveKeyWithValue("hour", "1"))
+		Expect(record.Properties).Should(HaveKeyWithValue("deleted", "false"))
+
+		Expect(recordsByResourceId[eip2.Metadata.ResourceId].Properties).Should(HaveKeyWithValue("deleted", "true"))
+	})
+
+	It("Released elastic IPs should not be reported again after a restart", func() {
+		cloudAccountId := cloudaccount.MustNewId()
+		eip1, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip1"))
+		Expect(err).Should(Succeed())
+		eip2, err := elasticIPServiceClient.Create(ctx, newCreateElasticIPRequest(cloudAccountId, "eip2"))
+		Expect(err).Should(Succeed())
+		_, err = elasticIPServiceClient.Delete(ctx, &pb.ElasticIPDeleteRequest{
+			Metadata: newElasticIPReference(cloudAccountId, eip2.Metadata.ResourceId),
+		})
+		Expect(err).Should(Succeed())
+		Expect(grpcService.ElasticIPMeteringReporter.Report(ctx)).Should(Succeed())
+
+		usageRecordsMutex.Lock()
+		usageRecords = nil
+		usageRecordsMutex.Unlock()
+
+		By("Reporting with a new reporter")
+		reporter, err := elastic_ip.NewElasticIPMeteringReporter(sqlDb, config.Config{Region: "us-dev-1"}, NewMockMeteringServiceClient())
+		Expect(err).Should(Succeed())
+		Expect(reporter.Report(ctx)).Should(Succeed())
+
+		usageRecordsMutex.Lock()
+		defer usageRecordsMutex.Unlock()
+		var resourceIds []string
+		for _, record := range usageRecords {
+			resourceIds = append(resourceIds, record.ResourceId)
+		}
+		Expect(resourceIds).Should(ContainElement(eip1.Metadata.ResourceId))
+		Expect(resourceIds).ShouldNot(ContainElement(eip2.Metadata.ResourceId))
+	})
+})
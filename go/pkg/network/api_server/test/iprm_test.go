@@ -67,6 +67,61 @@ var _ = Describe("IPRM API Integration Tests", Serial, func() {
 
 		})
 
+		It("Reserve Port in dual-stack subnet should allocate IPv6 addresses", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+
+			createVPCReq := NewCreateVPCRequest(cloudAccountId, "default", "10.0.0.0/16")
+			createVPCReq.Spec.Ipv6CidrBlock = "fd00:1::/48"
+			vpc, err := vpcServiceClient.Create(ctx, createVPCReq)
+			Expect(err).Should(Succeed())
+			subnet, err := subnetServiceClient.Create(ctx, &pb.SubnetCreateRequest{
+				Metadata: &pb.SubnetMetadataCreate{
+					CloudAccountId: cloudAccountId,
+				},
+				Spec: &pb.SubnetSpec{
+					CidrBlock:        "10.0.1.0/24",
+					AvailabilityZone: "us-dev-1a",
+					VpcId:            vpc.Metadata.ResourceId,
+					Ipv6CidrBlock:    "fd00:1:0:1::/64",
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			reservePort := func(macAddress, ipv6Address string) (*pb.PortPrivate, error) {
+				return iprmPrivateServiceClient.ReservePort(ctx, &pb.ReservePortRequest{
+					Metadata: &pb.PortMetadataCreatePrivate{
+						CloudAccountId: cloudAccountId,
+					},
+					Spec: &pb.PortSpecPrivate{
+						SubnetId:        subnet.Metadata.ResourceId,
+						IpuSerialNumber: "ipuSerialNumber",
+						ChassisId:       "30",
+						MacAddress:      macAddress,
+						Ipv6Address:     ipv6Address,
+					},
+				})
+			}
+
+			By("The first port should get the first address after the gateway")
+			port1, err := reservePort("macAddress1", "")
+			Expect(err).Should(Succeed())
+			Expect(port1.Spec.Ipv6Address).Should(Equal("fd00:1:0:1::2"))
+
+			By("The second port should get the next address")
+			port2, err := reservePort("macAddress2", "")
+			Expect(err).Should(Succeed())
+			Expect(port2.Spec.Ipv6Address).Should(Equal("fd00:1:0:1::3"))
+
+			By("A requested address should be used")
+			port3, err := reservePort("macAddress3", "fd00:1:0:1:0::10")
+			Expect(err).Should(Succeed())
+			Expect(port3.Spec.Ipv6Address).Should(Equal("fd00:1:0:1::10"))
+
+			By("A requested address outside of the subnet should fail")
+			_, err = reservePort("macAddress4", "fd00:1:0:2::10")
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
 		It("Reserve Port should succeed and return the same port on duplicate call", func() {
 			cloudAccountId := cloudaccount.MustNewId()
 
@@ -0,0 +1,207 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func NewCreateRouteTableRequest(cloudAccountId, name, vpcId string, subnetIds []string, routes []*pb.Route) *pb.RouteTableCreateRequest {
+	return &pb.RouteTableCreateRequest{
+		Metadata: &pb.RouteTableMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           name,
+			Labels:         defaultLabels,
+		},
+		Spec: &pb.RouteTableSpec{
+			VpcId:     vpcId,
+			Routes:    routes,
+			SubnetIds: subnetIds,
+		},
+	}
+}
+
+func newVPCPeeringRoute(destinationCidrBlock, vpcPeeringId string) *pb.Route {
+	return &pb.Route{
+		DestinationCidrBlock: destinationCidrBlock,
+		Target:               &pb.Route_VpcPeeringId{VpcPeeringId: vpcPeeringId},
+	}
+}
+
+func newGetRouteTablePrivateRequest(cloudAccountId, id string) *pb.RouteTableGetPrivateRequest {
+	return &pb.RouteTableGetPrivateRequest{
+		Metadata: &pb.RouteTableMetadataReference{
+			CloudAccountId: cloudAccountId,
+			NameOrId:       &pb.RouteTableMetadataReference_ResourceId{ResourceId: id},
+		},
+	}
+}
+
+var _ = Describe("Route Table API Integration Tests", Serial, func() {
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		clearDatabase(ctx)
+	})
+
+	Context("Route Table", func() {
+		var cloudAccountId string
+		var vpcA, vpcB *pb.VPC
+		var subnetA, subnetB *pb.VPCSubnet
+		var vpcPeering *pb.VPCPeering
+
+		BeforeEach(func() {
+			cloudAccountId = cloudaccount.MustNewId()
+			vpcA, subnetA = newVpcAndSubnet(ctx, cloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			vpcB, subnetB = newVpcAndSubnet(ctx, cloudAccountId, "b", "10.1.0.0/16", "10.1.0.0/24")
+			var err error
+			vpcPeering, err = vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcA, subnetA, "", vpcB, subnetB.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+		})
+
+		It("Create, Get, Search, Update and Delete should succeed", func() {
+			created, err := routeTableServiceClient.Create(ctx, NewCreateRouteTableRequest(cloudAccountId, "rt", vpcA.Metadata.ResourceId,
+				[]string{subnetA.Metadata.ResourceId}, []*pb.Route{newVPCPeeringRoute("10.1.0.0/16", vpcPeering.Metadata.ResourceId)}))
+			Expect(err).Should(Succeed())
+			Expect(created.Metadata.Name).Should(Equal("rt"))
+			Expect(created.Spec.Routes).Should(HaveLen(1))
+			Expect(created.Spec.Routes[0].GetVpcPeeringId()).Should(Equal(vpcPeering.Metadata.ResourceId))
+			Expect(created.Status.Phase).Should(Equal(pb.RouteTablePhase_RouteTablePhase_Provisioning))
+
+			gotPrivate, err := routeTablePrivateServiceClient.GetPrivate(ctx, newGetRouteTablePrivateRequest(cloudAccountId, created.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Spec.Routes[0].NextHopAddress).Should(Equal("10.0.0.254"))
+			Expect(gotPrivate.Spec.Subnets[0].InterfaceAddress).Should(Equal("10.0.0.1/24"))
+
+			got, err := routeTableServiceClient.Get(ctx, &pb.RouteTableGetRequest{
+				Metadata: &pb.RouteTableMetadataReference{
+					CloudAccountId: cloudAccountId,
+					NameOrId:       &pb.RouteTableMetadataReference_Name{Name: "rt"},
+				},
+			})
+			Expect(err).Should(Succeed())
+			Expect(got.Metadata.ResourceId).Should(Equal(created.Metadata.ResourceId))
+
+			searchResp, err := routeTableServiceClient.Search(ctx, &pb.RouteTableSearchRequest{
+				Metadata: &pb.RouteTableMetadataSearch{CloudAccountId: cloudAccountId},
+				VpcId:    vpcA.Metadata.ResourceId,
+			})
+			Expect(err).Should(Succeed())
+			Expect(searchResp.Items).Should(HaveLen(1))
+
+			By("Deleting a peering that is the target of a route should fail")
+			_, err = vpcPeeringServiceClient.Delete(ctx, &pb.VPCPeeringDeleteRequest{Metadata: newVPCPeeringReference(cloudAccountId, vpcPeering.Metadata.ResourceId)})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			By("Removing the routes")
+			_, err = routeTableServiceClient.Update(ctx, &pb.RouteTableUpdateRequest{
+				Metadata: &pb.RouteTableMetadataUpdate{
+					CloudAccountId: cloudAccountId,
+					ResourceId:     created.Metadata.ResourceId,
+					Labels:         updatedLabels,
+				},
+				Spec: &pb.RouteTableSpecUpdate{
+					SubnetIds: []string{subnetA.Metadata.ResourceId},
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			gotPrivate, err = routeTablePrivateServiceClient.GetPrivate(ctx, newGetRouteTablePrivateRequest(cloudAccountId, created.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Spec.Routes).Should(BeEmpty())
+			Expect(gotPrivate.Metadata.Labels).Should(Equal(updatedLabels))
+
+			_, err = vpcPeeringServiceClient.Delete(ctx, &pb.VPCPeeringDeleteRequest{Metadata: newVPCPeeringReference(cloudAccountId, vpcPeering.Metadata.ResourceId)})
+			Expect(err).Should(Succeed())
+
+			_, err = routeTableServiceClient.Delete(ctx, &pb.RouteTableDeleteRequest{
+				Metadata: &pb.RouteTableMetadataReference{
+					CloudAccountId: cloudAccountId,
+					NameOrId:       &pb.RouteTableMetadataReference_Name{Name: "rt"},
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			gotPrivate, err = routeTablePrivateServiceClient.GetPrivate(ctx, newGetRouteTablePrivateRequest(cloudAccountId, created.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Metadata.DeletionTimestamp).ShouldNot(BeNil())
+			Expect(gotPrivate.Status.Phase).Should(Equal(pb.RouteTablePhase_RouteTablePhase_Deleting))
+		})
+
+		It("A subnet should be associated with at most one route table", func() {
+			_, err := routeTableServiceClient.Create(ctx, NewCreateRouteTableRequest(cloudAccountId, "rt1", vpcA.Metadata.ResourceId,
+				[]string{subnetA.Metadata.ResourceId}, nil))
+			Expect(err).Should(Succeed())
+
+			_, err = routeTableServiceClient.Create(ctx, NewCreateRouteTableRequest(cloudAccountId, "rt2", vpcA.Metadata.ResourceId,
+				[]string{subnetA.Metadata.ResourceId}, nil))
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("Create with a subnet of another vpc should fail", func() {
+			_, err := routeTableServiceClient.Create(ctx, NewCreateRouteTableRequest(cloudAccountId, "rt", vpcA.Metadata.ResourceId,
+				[]string{subnetB.Metadata.ResourceId}, nil))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("Create with an invalid route should fail", func() {
+			subnetIds := []string{subnetA.Metadata.ResourceId}
+			for _, route := range []*pb.Route{
+				// Not in canonical form.
+				newVPCPeeringRoute("10.1.0.1/16", vpcPeering.Metadata.ResourceId),
+				// Overlaps the vpc.
+				newVPCPeeringRoute("10.0.1.0/24", vpcPeering.Metadata.ResourceId),
+				// Not within the peer vpc.
+				newVPCPeeringRoute("10.2.0.0/16", vpcPeering.Metadata.ResourceId),
+				// Missing target.
+				{DestinationCidrBlock: "10.1.0.0/16"},
+			} {
+				_, err := routeTableServiceClient.Create(ctx, NewCreateRouteTableRequest(cloudAccountId, "rt", vpcA.Metadata.ResourceId, subnetIds, []*pb.Route{route}))
+				Expect(status.Code(err)).Should(Equal(codes.InvalidArgument), "route %v", route)
+			}
+
+			By("Using a peering without the attachment subnet")
+			_, err := routeTableServiceClient.Create(ctx, NewCreateRouteTableRequest(cloudAccountId, "rt", vpcA.Metadata.ResourceId, nil,
+				[]*pb.Route{newVPCPeeringRoute("10.1.0.0/16", vpcPeering.Metadata.ResourceId)}))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("Route to a peering that has not been accepted should fail", func() {
+			accepterCloudAccountId := cloudaccount.MustNewId()
+			vpcC, _ := newVpcAndSubnet(ctx, accepterCloudAccountId, "c", "10.2.0.0/16", "10.2.0.0/24")
+			subnetA2, err := subnetServiceClient.Create(ctx, &pb.SubnetCreateRequest{
+				Metadata: &pb.SubnetMetadataCreate{CloudAccountId: cloudAccountId, Name: "a2"},
+				Spec: &pb.SubnetSpec{
+					CidrBlock:        "10.0.1.0/24",
+					AvailabilityZone: "us-dev-1a",
+					VpcId:            vpcA.Metadata.ResourceId,
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			By("Requesting a peering with the other cloud account")
+			created, err := vpcPeeringServiceClient.Create(ctx, &pb.VPCPeeringCreateRequest{
+				Metadata: &pb.VPCPeeringMetadataCreate{CloudAccountId: cloudAccountId},
+				Spec: &pb.VPCPeeringSpec{
+					RequesterVpcId:         vpcA.Metadata.ResourceId,
+					RequesterSubnetId:      subnetA2.Metadata.ResourceId,
+					AccepterCloudAccountId: accepterCloudAccountId,
+					AccepterVpcId:          vpcC.Metadata.ResourceId,
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			By("Routing from the accepter vpc before the peering is accepted")
+			_, err = routeTableServiceClient.Create(ctx, NewCreateRouteTableRequest(accepterCloudAccountId, "rt", vpcC.Metadata.ResourceId, nil,
+				[]*pb.Route{newVPCPeeringRoute("10.0.0.0/16", created.Metadata.ResourceId)}))
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
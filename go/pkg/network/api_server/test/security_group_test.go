@@ -0,0 +1,327 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func NewCreateSecurityGroupRequest(cloudAccountId, name, vpcId string) *pb.SecurityGroupCreateRequest {
+	return &pb.SecurityGroupCreateRequest{
+		Metadata: &pb.SecurityGroupMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           name,
+			Labels:         defaultLabels,
+		},
+		Spec: &pb.SecurityGroupSpec{
+			VpcId:       vpcId,
+			Description: "web servers",
+		},
+	}
+}
+
+func NewCreateSecurityRuleRequest(cloudAccountId, securityGroupId string, priority uint32) *pb.SecurityRuleCreateRequest {
+	return &pb.SecurityRuleCreateRequest{
+		Metadata: &pb.SecurityRuleMetadataCreate{
+			CloudAccountId: cloudAccountId,
+		},
+		Spec: &pb.SecurityRuleSpec{
+			SecurityGroupId: securityGroupId,
+			Direction:       pb.SecurityRuleDirection_SecurityRuleDirection_Ingress,
+			Protocol:        pb.SecurityRuleProtocol_SecurityRuleProtocol_TCP,
+			RemoteCidrs:     []string{"10.0.0.0/8"},
+			PortRangeMin:    443,
+			Priority:        priority,
+		},
+	}
+}
+
+func newGetSecurityGroupPrivateRequest(cloudAccountId, id string) *pb.SecurityGroupGetPrivateRequest {
+	return &pb.SecurityGroupGetPrivateRequest{
+		Metadata: &pb.SecurityGroupMetadataReference{
+			CloudAccountId: cloudAccountId,
+			NameOrId:       &pb.SecurityGroupMetadataReference_ResourceId{ResourceId: id},
+		},
+	}
+}
+
+func newReservePortRequest(cloudAccountId, subnetId, chassisId string, securityGroupIds []string) *pb.ReservePortRequest {
+	return &pb.ReservePortRequest{
+		Metadata: &pb.PortMetadataCreatePrivate{
+			CloudAccountId: cloudAccountId,
+		},
+		Spec: &pb.PortSpecPrivate{
+			SubnetId:         subnetId,
+			IpuSerialNumber:  "ipuSerialNumber",
+			ChassisId:        chassisId,
+			IpAddress:        "ipAddress",
+			MacAddress:       "macAddress",
+			SecurityGroupIds: securityGroupIds,
+		},
+	}
+}
+
+var _ = Describe("Security Group API Integration Tests", Serial, func() {
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		clearDatabase(ctx)
+	})
+
+	Context("Security Group", func() {
+		It("Create, Get, Search, Update and Delete should succeed", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpc, _, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+			Expect(err).Should(Succeed())
+
+			created, err := securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "web", vpc.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(created.Metadata.ResourceId).ShouldNot(BeEmpty())
+			Expect(created.Metadata.Name).Should(Equal("web"))
+			Expect(created.Spec.VpcId).Should(Equal(vpc.Metadata.ResourceId))
+			Expect(created.Status.Phase).Should(Equal(pb.SecurityGroupPhase_SecurityGroupPhase_Provisioning))
+
+			got, err := securityGroupServiceClient.Get(ctx, &pb.SecurityGroupGetRequest{
+				Metadata: &pb.SecurityGroupMetadataReference{
+					CloudAccountId: cloudAccountId,
+					NameOrId:       &pb.SecurityGroupMetadataReference_Name{Name: "web"},
+				},
+			})
+			Expect(err).Should(Succeed())
+			Expect(got.Metadata.ResourceId).Should(Equal(created.Metadata.ResourceId))
+
+			searchResp, err := securityGroupServiceClient.Search(ctx, &pb.SecurityGroupSearchRequest{
+				Metadata: &pb.SecurityGroupMetadataSearch{CloudAccountId: cloudAccountId},
+				VpcId:    vpc.Metadata.ResourceId,
+			})
+			Expect(err).Should(Succeed())
+			Expect(searchResp.Items).Should(HaveLen(1))
+
+			_, err = securityGroupServiceClient.Update(ctx, &pb.SecurityGroupUpdateRequest{
+				Metadata: &pb.SecurityGroupMetadataUpdate{
+					CloudAccountId: cloudAccountId,
+					ResourceId:     created.Metadata.ResourceId,
+					Name:           "web2",
+					Labels:         updatedLabels,
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			_, err = securityGroupServiceClient.Delete(ctx, &pb.SecurityGroupDeleteRequest{
+				Metadata: &pb.SecurityGroupMetadataReference{
+					CloudAccountId: cloudAccountId,
+					NameOrId:       &pb.SecurityGroupMetadataReference_Name{Name: "web2"},
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			gotPrivate, err := securityGroupPrivateServiceClient.GetPrivate(ctx, newGetSecurityGroupPrivateRequest(cloudAccountId, created.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Metadata.DeletionTimestamp).ShouldNot(BeNil())
+			Expect(gotPrivate.Status.Phase).Should(Equal(pb.SecurityGroupPhase_SecurityGroupPhase_Deleting))
+		})
+
+		It("Flow logging should be enabled and disabled", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpc, _, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+			Expect(err).Should(Succeed())
+
+			req := NewCreateSecurityGroupRequest(cloudAccountId, "web", vpc.Metadata.ResourceId)
+			req.Spec.FlowLogging = true
+			created, err := securityGroupServiceClient.Create(ctx, req)
+			Expect(err).Should(Succeed())
+			Expect(created.Spec.FlowLogging).Should(BeTrue())
+
+			gotPrivate, err := securityGroupPrivateServiceClient.GetPrivate(ctx, newGetSecurityGroupPrivateRequest(cloudAccountId, created.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Spec.FlowLogging).Should(BeTrue())
+
+			_, err = securityGroupServiceClient.Update(ctx, &pb.SecurityGroupUpdateRequest{
+				Metadata: &pb.SecurityGroupMetadataUpdate{
+					CloudAccountId: cloudAccountId,
+					ResourceId:     created.Metadata.ResourceId,
+				},
+				Spec: &pb.SecurityGroupSpecUpdate{FlowLogging: false},
+			})
+			Expect(err).Should(Succeed())
+
+			gotPrivate, err = securityGroupPrivateServiceClient.GetPrivate(ctx, newGetSecurityGroupPrivateRequest(cloudAccountId, created.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Spec.FlowLogging).Should(BeFalse())
+		})
+
+		It("Create with an invalid vpc should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			_, err := securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "web", uuid.NewString()))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("Create with an existing name should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpc, _, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+			Expect(err).Should(Succeed())
+			_, err = securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "web", vpc.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			_, err = securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "web", vpc.Metadata.ResourceId))
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+	})
+
+	Context("Security Rule", func() {
+		It("Create, Get, Search and Delete should succeed", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpc, _, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+			Expect(err).Should(Succeed())
+			securityGroup, err := securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "web", vpc.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			securityGroupId := securityGroup.Metadata.ResourceId
+
+			rule2, err := securityRuleServiceClient.Create(ctx, NewCreateSecurityRuleRequest(cloudAccountId, securityGroupId, 200))
+			Expect(err).Should(Succeed())
+			Expect(rule2.Spec.PortRangeMax).Should(Equal(uint32(443)))
+			rule1, err := securityRuleServiceClient.Create(ctx, NewCreateSecurityRuleRequest(cloudAccountId, securityGroupId, 100))
+			Expect(err).Should(Succeed())
+
+			got, err := securityRuleServiceClient.Get(ctx, &pb.SecurityRuleGetRequest{
+				Metadata:        &pb.SecurityRuleMetadataReference{CloudAccountId: cloudAccountId, ResourceId: rule1.Metadata.ResourceId},
+				SecurityGroupId: securityGroupId,
+			})
+			Expect(err).Should(Succeed())
+			Expect(got.Spec.Priority).Should(Equal(uint32(100)))
+
+			// Rules are returned in priority order.
+			searchResp, err := securityRuleServiceClient.Search(ctx, &pb.SecurityRuleSearchRequest{
+				Metadata:        &pb.SecurityRuleMetadataSearch{CloudAccountId: cloudAccountId},
+				SecurityGroupId: securityGroupId,
+			})
+			Expect(err).Should(Succeed())
+			Expect(searchResp.Items).Should(HaveLen(2))
+			Expect(searchResp.Items[0].Metadata.ResourceId).Should(Equal(rule1.Metadata.ResourceId))
+			Expect(searchResp.Items[1].Metadata.ResourceId).Should(Equal(rule2.Metadata.ResourceId))
+
+			_, err = securityRuleServiceClient.Delete(ctx, &pb.SecurityRuleDeleteRequest{
+				Metadata:        &pb.SecurityRuleMetadataReference{CloudAccountId: cloudAccountId, ResourceId: rule1.Metadata.ResourceId},
+				SecurityGroupId: securityGroupId,
+			})
+			Expect(err).Should(Succeed())
+
+			gotPrivate, err := securityGroupPrivateServiceClient.GetPrivate(ctx, newGetSecurityGroupPrivateRequest(cloudAccountId, securityGroupId))
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Spec.Rules).Should(HaveLen(1))
+			Expect(gotPrivate.Spec.Rules[0].Metadata.ResourceId).Should(Equal(rule2.Metadata.ResourceId))
+		})
+
+		It("Create with a duplicate priority should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpc, _, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+			Expect(err).Should(Succeed())
+			securityGroup, err := securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "web", vpc.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+
+			_, err = securityRuleServiceClient.Create(ctx, NewCreateSecurityRuleRequest(cloudAccountId, securityGroup.Metadata.ResourceId, 100))
+			Expect(err).Should(Succeed())
+			_, err = securityRuleServiceClient.Create(ctx, NewCreateSecurityRuleRequest(cloudAccountId, securityGroup.Metadata.ResourceId, 100))
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+
+		It("Create with an invalid spec should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpc, _, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+			Expect(err).Should(Succeed())
+			securityGroup, err := securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "web", vpc.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			securityGroupId := securityGroup.Metadata.ResourceId
+
+			invalidSpecs := []func(spec *pb.SecurityRuleSpec){
+				func(spec *pb.SecurityRuleSpec) {
+					spec.Direction = pb.SecurityRuleDirection_SecurityRuleDirection_Unspecified
+				},
+				func(spec *pb.SecurityRuleSpec) { spec.RemoteCidrs = []string{"10.0.0.1/8"} },
+				func(spec *pb.SecurityRuleSpec) { spec.RemoteCidrs = []string{"fd00::/8"} },
+				func(spec *pb.SecurityRuleSpec) { spec.PortRangeMin, spec.PortRangeMax = 443, 80 },
+				func(spec *pb.SecurityRuleSpec) { spec.Protocol = pb.SecurityRuleProtocol_SecurityRuleProtocol_ICMP },
+				func(spec *pb.SecurityRuleSpec) { spec.Priority = 0 },
+				func(spec *pb.SecurityRuleSpec) { spec.Priority = 1001 },
+			}
+			for _, invalidate := range invalidSpecs {
+				req := NewCreateSecurityRuleRequest(cloudAccountId, securityGroupId, 100)
+				invalidate(req.Spec)
+				_, err := securityRuleServiceClient.Create(ctx, req)
+				Expect(status.Code(err)).Should(Equal(codes.InvalidArgument), "spec: %v", req.Spec)
+			}
+		})
+	})
+
+	Context("Port security groups", func() {
+		It("Ports should be attached to and detached from security groups", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpc, subnet, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+			Expect(err).Should(Succeed())
+			sg1, err := securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "sg1", vpc.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			sg2, err := securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "sg2", vpc.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+
+			port, err := iprmPrivateServiceClient.ReservePort(ctx, newReservePortRequest(cloudAccountId, subnet.Metadata.ResourceId, "40", []string{sg1.Metadata.ResourceId}))
+			Expect(err).Should(Succeed())
+			Expect(port.Spec.SecurityGroupIds).Should(ConsistOf(sg1.Metadata.ResourceId))
+
+			gotSg1, err := securityGroupPrivateServiceClient.GetPrivate(ctx, newGetSecurityGroupPrivateRequest(cloudAccountId, sg1.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotSg1.Spec.PortIds).Should(ConsistOf(port.Metadata.ResourceId))
+
+			// A security group with attached ports cannot be deleted.
+			_, err = securityGroupServiceClient.Delete(ctx, &pb.SecurityGroupDeleteRequest{
+				Metadata: &pb.SecurityGroupMetadataReference{
+					CloudAccountId: cloudAccountId,
+					NameOrId:       &pb.SecurityGroupMetadataReference_ResourceId{ResourceId: sg1.Metadata.ResourceId},
+				},
+			})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			port, err = iprmPrivateServiceClient.UpdateSecurityGroups(ctx, &pb.PortUpdateSecurityGroupsRequest{
+				Metadata:         &pb.PortMetadataReference{CloudAccountId: cloudAccountId, ResourceId: port.Metadata.ResourceId},
+				SecurityGroupIds: []string{sg2.Metadata.ResourceId},
+			})
+			Expect(err).Should(Succeed())
+			Expect(port.Spec.SecurityGroupIds).Should(ConsistOf(sg2.Metadata.ResourceId))
+
+			gotSg1, err = securityGroupPrivateServiceClient.GetPrivate(ctx, newGetSecurityGroupPrivateRequest(cloudAccountId, sg1.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotSg1.Spec.PortIds).Should(BeEmpty())
+			gotSg2, err := securityGroupPrivateServiceClient.GetPrivate(ctx, newGetSecurityGroupPrivateRequest(cloudAccountId, sg2.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotSg2.Spec.PortIds).Should(ConsistOf(port.Metadata.ResourceId))
+
+			_, err = iprmPrivateServiceClient.ReleasePort(ctx, &pb.ReleasePortRequest{
+				Metadata: &pb.PortMetadataReference{CloudAccountId: cloudAccountId, ResourceId: port.Metadata.ResourceId},
+			})
+			Expect(err).Should(Succeed())
+
+			gotSg2, err = securityGroupPrivateServiceClient.GetPrivate(ctx, newGetSecurityGroupPrivateRequest(cloudAccountId, sg2.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(gotSg2.Spec.PortIds).Should(BeEmpty())
+		})
+
+		It("Reserve Port should fail with a security group of another vpc", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			_, subnet, err := NewCreateVpcAndSubnet(ctx, cloudAccountId, vpcServiceClient, subnetServiceClient)
+			Expect(err).Should(Succeed())
+			otherVpc, err := vpcServiceClient.Create(ctx, NewCreateVPCRequest(cloudAccountId, "other", "10.1.0.0/16"))
+			Expect(err).Should(Succeed())
+			sg, err := securityGroupServiceClient.Create(ctx, NewCreateSecurityGroupRequest(cloudAccountId, "sg", otherVpc.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+
+			_, err = iprmPrivateServiceClient.ReservePort(ctx, newReservePortRequest(cloudAccountId, subnet.Metadata.ResourceId, "41", []string{sg.Metadata.ResourceId}))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+})
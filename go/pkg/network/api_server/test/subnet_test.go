@@ -1297,4 +1297,63 @@ var _ = Describe("Subnet API Integration Tests", Serial, func() {
 			}
 		})
 	})
+
+	Context("Dual-stack subnet", func() {
+		newCreateSubnetRequest := func(cloudAccountId, vpcId, cidrBlock, ipv6CidrBlock string) *pb.SubnetCreateRequest {
+			return &pb.SubnetCreateRequest{
+				Metadata: &pb.SubnetMetadataCreate{
+					CloudAccountId: cloudAccountId,
+				},
+				Spec: &pb.SubnetSpec{
+					CidrBlock:        cidrBlock,
+					AvailabilityZone: "us-dev-1a",
+					VpcId:            vpcId,
+					Ipv6CidrBlock:    ipv6CidrBlock,
+				},
+			}
+		}
+
+		It("Create dual-stack subnet in dual-stack VPC should succeed", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			createVPCReq := NewCreateVPCRequest(cloudAccountId, "default", "10.0.0.0/16")
+			createVPCReq.Spec.Ipv6CidrBlock = "fd00:1::/48"
+			gotVPC, err := vpcServiceClient.Create(ctx, createVPCReq)
+			Expect(err).Should(Succeed())
+			Expect(gotVPC.Spec.Ipv6CidrBlock).Should(Equal("fd00:1::/48"))
+
+			gotSubnet, err := subnetServiceClient.Create(ctx, newCreateSubnetRequest(cloudAccountId, gotVPC.Metadata.ResourceId, "10.0.1.0/24", "fd00:1:0:1::/64"))
+			Expect(err).Should(Succeed())
+			Expect(gotSubnet.Spec.Ipv6CidrBlock).Should(Equal("fd00:1:0:1::/64"))
+
+			By("Creating a subnet with an overlapping IPv6 CIDR should fail")
+			_, err = subnetServiceClient.Create(ctx, newCreateSubnetRequest(cloudAccountId, gotVPC.Metadata.ResourceId, "10.0.2.0/24", "fd00:1:0:1::/64"))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+			By("Creating a subnet with an IPv6 CIDR outside of the VPC should fail")
+			_, err = subnetServiceClient.Create(ctx, newCreateSubnetRequest(cloudAccountId, gotVPC.Metadata.ResourceId, "10.0.3.0/24", "fd00:2:0:1::/64"))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+			By("Creating an IPv4-only subnet should succeed")
+			_, err = subnetServiceClient.Create(ctx, newCreateSubnetRequest(cloudAccountId, gotVPC.Metadata.ResourceId, "10.0.4.0/24", ""))
+			Expect(err).Should(Succeed())
+		})
+
+		It("Create dual-stack subnet in IPv4-only VPC should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			gotVPC, err := vpcServiceClient.Create(ctx, NewCreateVPCRequest(cloudAccountId, "default", "10.0.0.0/16"))
+			Expect(err).Should(Succeed())
+
+			_, err = subnetServiceClient.Create(ctx, newCreateSubnetRequest(cloudAccountId, gotVPC.Metadata.ResourceId, "10.0.1.0/24", "fd00:1:0:1::/64"))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("Create VPC with invalid IPv6 CIDR should fail", func() {
+			for _, ipv6CidrBlock := range []string{"10.1.0.0/16", "fd00:1::1/48", "fd00::/32", "fe80::/48"} {
+				createVPCReq := NewCreateVPCRequest(cloudaccount.MustNewId(), "default", "10.0.0.0/16")
+				createVPCReq.Spec.Ipv6CidrBlock = ipv6CidrBlock
+				_, err := vpcServiceClient.Create(ctx, createVPCReq)
+				Expect(status.Code(err)).Should(Equal(codes.InvalidArgument), ipv6CidrBlock)
+			}
+		})
+	})
 })
@@ -9,7 +9,9 @@ import (
 	"database/sql"
 	"fmt"
 	"net"
+	"sync"
 	"testing"
+	"time"
 
 	_ "github.com/amacneil/dbmate/pkg/driver/postgres"
 	"github.com/golang/mock/gomock"
@@ -44,6 +46,18 @@ var (
 	iprmPrivateServiceClient               pb.IPRMPrivateServiceClient
 	globalOperationsServiceClient          pb.GlobalOperationsServiceClient
 	addressTranslationPrivateServiceClient pb.AddressTranslationPrivateServiceClient
+	securityGroupServiceClient             pb.SecurityGroupServiceClient
+	securityGroupPrivateServiceClient      pb.SecurityGroupPrivateServiceClient
+	securityRuleServiceClient              pb.SecurityRuleServiceClient
+	vpcPeeringServiceClient                pb.VPCPeeringServiceClient
+	vpcPeeringPrivateServiceClient         pb.VPCPeeringPrivateServiceClient
+	routeTableServiceClient                pb.RouteTableServiceClient
+	routeTablePrivateServiceClient         pb.RouteTablePrivateServiceClient
+	elasticIPServiceClient                 pb.ElasticIPServiceClient
+	elasticIPPrivateServiceClient          pb.ElasticIPPrivateServiceClient
+	// Usage records received by the mock Metering Service.
+	usageRecords      []*pb.UsageCreate
+	usageRecordsMutex sync.Mutex
 )
 
 func TestNetworkApiServer(t *testing.T) {
@@ -80,6 +94,9 @@ var _ = BeforeSuite(func() {
 	By("Creating mock CloudAccount Service")
 	cloudAccountService := NewMockCloudAccountServiceClient()
 
+	By("Creating mock Metering Service")
+	meteringService := NewMockMeteringServiceClient()
+
 	By("Starting GRPC server")
 	grpcServerListener, err := net.Listen("tcp", "localhost:")
 	Expect(err).Should(Succeed())
@@ -87,7 +104,12 @@ var _ = BeforeSuite(func() {
 
 	grpcService, err = server.New(ctx, &config.Config{
 		Region: region,
-	}, managedDb, grpcServerListener, cloudAccountService, availabilityZones)
+		ElasticIP: config.ElasticIPConfig{
+			PublicIPPools: []string{"198.51.100.0/30"},
+			// Tests report usage explicitly.
+			MeteringInterval: time.Hour,
+		},
+	}, managedDb, grpcServerListener, cloudAccountService, meteringService, availabilityZones)
 	Expect(err).Should(Succeed())
 	Expect(grpcService.Start(ctx)).Should(Succeed())
 
@@ -101,6 +123,15 @@ var _ = BeforeSuite(func() {
 	iprmPrivateServiceClient = pb.NewIPRMPrivateServiceClient(clientConn)
 	globalOperationsServiceClient = pb.NewGlobalOperationsServiceClient(clientConn)
 	addressTranslationPrivateServiceClient = pb.NewAddressTranslationPrivateServiceClient(clientConn)
+	securityGroupServiceClient = pb.NewSecurityGroupServiceClient(clientConn)
+	securityGroupPrivateServiceClient = pb.NewSecurityGroupPrivateServiceClient(clientConn)
+	securityRuleServiceClient = pb.NewSecurityRuleServiceClient(clientConn)
+	vpcPeeringServiceClient = pb.NewVPCPeeringServiceClient(clientConn)
+	vpcPeeringPrivateServiceClient = pb.NewVPCPeeringPrivateServiceClient(clientConn)
+	routeTableServiceClient = pb.NewRouteTableServiceClient(clientConn)
+	routeTablePrivateServiceClient = pb.NewRouteTablePrivateServiceClient(clientConn)
+	elasticIPServiceClient = pb.NewElasticIPServiceClient(clientConn)
+	elasticIPPrivateServiceClient = pb.NewElasticIPPrivateServiceClient(clientConn)
 
 	By("Pinging VPC service until it comes up")
 	Eventually(func(g Gomega) {
@@ -136,6 +167,34 @@ var _ = BeforeSuite(func() {
 		g.Expect(err).Should(Succeed())
 	}, "10s", "1s").Should(Succeed())
 	By("Subnet Service is ready")
+
+	By("Pinging Security Group service until it comes up")
+	Eventually(func(g Gomega) {
+		_, err := securityGroupServiceClient.Ping(ctx, &emptypb.Empty{})
+		g.Expect(err).Should(Succeed())
+	}, "10s", "1s").Should(Succeed())
+	By("Security Group Service is ready")
+
+	By("Pinging VPC Peering service until it comes up")
+	Eventually(func(g Gomega) {
+		_, err := vpcPeeringServiceClient.Ping(ctx, &emptypb.Empty{})
+		g.Expect(err).Should(Succeed())
+	}, "10s", "1s").Should(Succeed())
+	By("VPC Peering Service is ready")
+
+	By("Pinging Route Table service until it comes up")
+	Eventually(func(g Gomega) {
+		_, err := routeTableServiceClient.Ping(ctx, &emptypb.Empty{})
+		g.Expect(err).Should(Succeed())
+	}, "10s", "1s").Should(Succeed())
+	By("Route Table Service is ready")
+
+	By("Pinging Elastic IP service until it comes up")
+	Eventually(func(g Gomega) {
+		_, err := elasticIPServiceClient.Ping(ctx, &emptypb.Empty{})
+		g.Expect(err).Should(Succeed())
+	}, "10s", "1s").Should(Succeed())
+	By("Elastic IP Service is ready")
 })
 
 var _ = AfterSuite(func() {
@@ -159,6 +218,14 @@ func clearDatabase(ctx context.Context) {
 	Expect(err).Should(Succeed())
 	_, err = db.ExecContext(ctx, "delete from address_translation")
 	Expect(err).Should(Succeed())
+	_, err = db.ExecContext(ctx, "delete from security_group")
+	Expect(err).Should(Succeed())
+	_, err = db.ExecContext(ctx, "delete from route_table")
+	Expect(err).Should(Succeed())
+	_, err = db.ExecContext(ctx, "delete from vpc_peering")
+	Expect(err).Should(Succeed())
+	_, err = db.ExecContext(ctx, "delete from elastic_ip")
+	Expect(err).Should(Succeed())
 }
 
 func runNetworkDBQuery(ctx context.Context, query string) error {
@@ -179,3 +246,18 @@ func NewMockCloudAccountServiceClient() pb.CloudAccountServiceClient {
 	cloudAccountClient.EXPECT().GetById(gomock.Any(), gomock.Any()).Return(cloudAccount, nil).AnyTimes()
 	return cloudAccountClient
 }
+
+// Returns a mock Metering Service client that stores usage records in usageRecords.
+func NewMockMeteringServiceClient() pb.MeteringServiceClient {
+	mockController := gomock.NewController(GinkgoT())
+	meteringClient := pb.NewMockMeteringServiceClient(mockController)
+
+	meteringClient.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, record *pb.UsageCreate, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			usageRecordsMutex.Lock()
+			defer usageRecordsMutex.Unlock()
+			usageRecords = append(usageRecords, record)
+			return &emptypb.Empty{}, nil
+		}).AnyTimes()
+	return meteringClient
+}
@@ -0,0 +1,269 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newVpcAndSubnet(ctx context.Context, cloudAccountId, name, vpcCidr, subnetCidr string) (*pb.VPC, *pb.VPCSubnet) {
+	vpc, err := vpcServiceClient.Create(ctx, NewCreateVPCRequest(cloudAccountId, name, vpcCidr))
+	Expect(err).Should(Succeed())
+	subnet, err := subnetServiceClient.Create(ctx, &pb.SubnetCreateRequest{
+		Metadata: &pb.SubnetMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           name,
+		},
+		Spec: &pb.SubnetSpec{
+			CidrBlock:        subnetCidr,
+			AvailabilityZone: "us-dev-1a",
+			VpcId:            vpc.Metadata.ResourceId,
+		},
+	})
+	Expect(err).Should(Succeed())
+	return vpc, subnet
+}
+
+func NewCreateVPCPeeringRequest(cloudAccountId string, requesterVpc *pb.VPC, requesterSubnet *pb.VPCSubnet, accepterCloudAccountId string, accepterVpc *pb.VPC, accepterSubnetId string) *pb.VPCPeeringCreateRequest {
+	return &pb.VPCPeeringCreateRequest{
+		Metadata: &pb.VPCPeeringMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           "peering",
+		},
+		Spec: &pb.VPCPeeringSpec{
+			RequesterVpcId:         requesterVpc.Metadata.ResourceId,
+			RequesterSubnetId:      requesterSubnet.Metadata.ResourceId,
+			AccepterCloudAccountId: accepterCloudAccountId,
+			AccepterVpcId:          accepterVpc.Metadata.ResourceId,
+			AccepterSubnetId:       accepterSubnetId,
+		},
+	}
+}
+
+func newVPCPeeringReference(cloudAccountId, id string) *pb.VPCPeeringMetadataReference {
+	return &pb.VPCPeeringMetadataReference{
+		CloudAccountId: cloudAccountId,
+		ResourceId:     id,
+	}
+}
+
+var _ = Describe("VPC Peering API Integration Tests", Serial, func() {
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		clearDatabase(ctx)
+	})
+
+	Context("VPC Peering", func() {
+		It("Create, Get, Search and Delete in the same cloud account should succeed", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpcA, subnetA := newVpcAndSubnet(ctx, cloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			vpcB, subnetB := newVpcAndSubnet(ctx, cloudAccountId, "b", "10.1.0.0/16", "10.1.0.0/24")
+
+			created, err := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcA, subnetA, "", vpcB, subnetB.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+			Expect(created.Metadata.ResourceId).ShouldNot(BeEmpty())
+			Expect(created.Spec.AccepterCloudAccountId).Should(Equal(cloudAccountId))
+			Expect(created.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_Provisioning))
+
+			got, err := vpcPeeringServiceClient.Get(ctx, &pb.VPCPeeringGetRequest{Metadata: newVPCPeeringReference(cloudAccountId, created.Metadata.ResourceId)})
+			Expect(err).Should(Succeed())
+			Expect(got.Spec.AccepterSubnetId).Should(Equal(subnetB.Metadata.ResourceId))
+
+			gotPrivate, err := vpcPeeringPrivateServiceClient.GetPrivate(ctx, &pb.VPCPeeringGetPrivateRequest{Metadata: newVPCPeeringReference(cloudAccountId, created.Metadata.ResourceId)})
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Spec.Requester.InterfaceAddress).Should(Equal("10.0.0.254/24"))
+			Expect(gotPrivate.Spec.Requester.GatewayAddress).Should(Equal("10.0.0.1"))
+			Expect(gotPrivate.Spec.Accepter.VpcCidrBlock).Should(Equal("10.1.0.0/16"))
+			Expect(gotPrivate.Spec.Accepter.InterfaceAddress).Should(Equal("10.1.0.254/24"))
+
+			searchResp, err := vpcPeeringServiceClient.Search(ctx, &pb.VPCPeeringSearchRequest{
+				Metadata: &pb.VPCPeeringMetadataSearch{CloudAccountId: cloudAccountId},
+				VpcId:    vpcB.Metadata.ResourceId,
+			})
+			Expect(err).Should(Succeed())
+			Expect(searchResp.Items).Should(HaveLen(1))
+
+			_, err = vpcPeeringServiceClient.Delete(ctx, &pb.VPCPeeringDeleteRequest{Metadata: newVPCPeeringReference(cloudAccountId, created.Metadata.ResourceId)})
+			Expect(err).Should(Succeed())
+
+			gotPrivate, err = vpcPeeringPrivateServiceClient.GetPrivate(ctx, &pb.VPCPeeringGetPrivateRequest{Metadata: newVPCPeeringReference(cloudAccountId, created.Metadata.ResourceId)})
+			Expect(err).Should(Succeed())
+			Expect(gotPrivate.Metadata.DeletionTimestamp).ShouldNot(BeNil())
+			Expect(gotPrivate.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_Deleting))
+		})
+
+		It("Peering with another cloud account should be accepted by the accepter cloud account", func() {
+			requesterCloudAccountId := cloudaccount.MustNewId()
+			accepterCloudAccountId := cloudaccount.MustNewId()
+			vpcA, subnetA := newVpcAndSubnet(ctx, requesterCloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			vpcB, subnetB := newVpcAndSubnet(ctx, accepterCloudAccountId, "b", "10.1.0.0/16", "10.1.0.0/24")
+
+			By("Providing the accepter subnet with Create should fail")
+			_, err := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(requesterCloudAccountId, vpcA, subnetA, accepterCloudAccountId, vpcB, subnetB.Metadata.ResourceId))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+			created, err := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(requesterCloudAccountId, vpcA, subnetA, accepterCloudAccountId, vpcB, ""))
+			Expect(err).Should(Succeed())
+			Expect(created.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_PendingAcceptance))
+
+			By("Searching as the accepter cloud account")
+			searchResp, err := vpcPeeringServiceClient.Search(ctx, &pb.VPCPeeringSearchRequest{
+				Metadata: &pb.VPCPeeringMetadataSearch{CloudAccountId: accepterCloudAccountId},
+			})
+			Expect(err).Should(Succeed())
+			Expect(searchResp.Items).Should(HaveLen(1))
+
+			By("Accepting as the requester cloud account should fail")
+			_, err = vpcPeeringServiceClient.Accept(ctx, &pb.VPCPeeringAcceptRequest{
+				Metadata:         newVPCPeeringReference(requesterCloudAccountId, created.Metadata.ResourceId),
+				AccepterSubnetId: subnetB.Metadata.ResourceId,
+			})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			By("Accepting as the accepter cloud account")
+			accepted, err := vpcPeeringServiceClient.Accept(ctx, &pb.VPCPeeringAcceptRequest{
+				Metadata:         newVPCPeeringReference(accepterCloudAccountId, created.Metadata.ResourceId),
+				AccepterSubnetId: subnetB.Metadata.ResourceId,
+			})
+			Expect(err).Should(Succeed())
+			Expect(accepted.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_Provisioning))
+			Expect(accepted.Spec.AccepterSubnetId).Should(Equal(subnetB.Metadata.ResourceId))
+
+			By("Rejecting an accepted peering should fail")
+			_, err = vpcPeeringServiceClient.Reject(ctx, &pb.VPCPeeringRejectRequest{
+				Metadata: newVPCPeeringReference(accepterCloudAccountId, created.Metadata.ResourceId),
+			})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			By("Deleting as the accepter cloud account")
+			_, err = vpcPeeringServiceClient.Delete(ctx, &pb.VPCPeeringDeleteRequest{Metadata: newVPCPeeringReference(accepterCloudAccountId, created.Metadata.ResourceId)})
+			Expect(err).Should(Succeed())
+		})
+
+		It("Rejected peering should not be accepted", func() {
+			requesterCloudAccountId := cloudaccount.MustNewId()
+			accepterCloudAccountId := cloudaccount.MustNewId()
+			vpcA, subnetA := newVpcAndSubnet(ctx, requesterCloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			vpcB, subnetB := newVpcAndSubnet(ctx, accepterCloudAccountId, "b", "10.1.0.0/16", "10.1.0.0/24")
+
+			created, err := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(requesterCloudAccountId, vpcA, subnetA, accepterCloudAccountId, vpcB, ""))
+			Expect(err).Should(Succeed())
+
+			rejected, err := vpcPeeringServiceClient.Reject(ctx, &pb.VPCPeeringRejectRequest{
+				Metadata: newVPCPeeringReference(accepterCloudAccountId, created.Metadata.ResourceId),
+			})
+			Expect(err).Should(Succeed())
+			Expect(rejected.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_Rejected))
+
+			_, err = vpcPeeringServiceClient.Accept(ctx, &pb.VPCPeeringAcceptRequest{
+				Metadata:         newVPCPeeringReference(accepterCloudAccountId, created.Metadata.ResourceId),
+				AccepterSubnetId: subnetB.Metadata.ResourceId,
+			})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			By("Requesting the peering again after it was rejected")
+			_, err = vpcPeeringServiceClient.Create(ctx, &pb.VPCPeeringCreateRequest{
+				Metadata: &pb.VPCPeeringMetadataCreate{CloudAccountId: requesterCloudAccountId},
+				Spec: &pb.VPCPeeringSpec{
+					RequesterVpcId:         vpcA.Metadata.ResourceId,
+					RequesterSubnetId:      subnetA.Metadata.ResourceId,
+					AccepterCloudAccountId: accepterCloudAccountId,
+					AccepterVpcId:          vpcB.Metadata.ResourceId,
+				},
+			})
+			Expect(err).Should(Succeed())
+		})
+
+		It("Get by an unrelated cloud account should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpcA, subnetA := newVpcAndSubnet(ctx, cloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			vpcB, subnetB := newVpcAndSubnet(ctx, cloudAccountId, "b", "10.1.0.0/16", "10.1.0.0/24")
+
+			created, err := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcA, subnetA, "", vpcB, subnetB.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+
+			_, err = vpcPeeringServiceClient.Get(ctx, &pb.VPCPeeringGetRequest{Metadata: newVPCPeeringReference(cloudaccount.MustNewId(), created.Metadata.ResourceId)})
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+
+		It("Create with a vpc of another cloud account should fail like a missing vpc", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpcA, subnetA := newVpcAndSubnet(ctx, cloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			otherVpc, otherSubnet := newVpcAndSubnet(ctx, cloudaccount.MustNewId(), "b", "10.1.0.0/16", "10.1.0.0/24")
+			missingVpc := &pb.VPC{Metadata: &pb.VPCMetadata{ResourceId: uuid.NewString()}}
+
+			By("Requesting with the vpc")
+			_, otherErr := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, otherVpc, otherSubnet, "", vpcA, subnetA.Metadata.ResourceId))
+			_, missingErr := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, missingVpc, otherSubnet, "", vpcA, subnetA.Metadata.ResourceId))
+			Expect(status.Code(otherErr)).Should(Equal(codes.InvalidArgument))
+			Expect(status.Convert(otherErr).Message()).Should(Equal(status.Convert(missingErr).Message()))
+
+			By("Accepting with the vpc")
+			_, otherErr = vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcA, subnetA, "", otherVpc, otherSubnet.Metadata.ResourceId))
+			_, missingErr = vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcA, subnetA, "", missingVpc, otherSubnet.Metadata.ResourceId))
+			Expect(status.Code(otherErr)).Should(Equal(codes.InvalidArgument))
+			Expect(status.Convert(otherErr).Message()).Should(Equal(status.Convert(missingErr).Message()))
+		})
+
+		It("Create with overlapping vpcs should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpcA, subnetA := newVpcAndSubnet(ctx, cloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			vpcB, subnetB := newVpcAndSubnet(ctx, cloudAccountId, "b", "10.0.0.0/20", "10.0.1.0/24")
+
+			_, err := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcA, subnetA, "", vpcB, subnetB.Metadata.ResourceId))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("Create with a subnet of another vpc should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpcA, subnetA := newVpcAndSubnet(ctx, cloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			vpcB, _ := newVpcAndSubnet(ctx, cloudAccountId, "b", "10.1.0.0/16", "10.1.0.0/24")
+
+			_, err := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcA, subnetA, "", vpcB, subnetA.Metadata.ResourceId))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("Create a second peering between the same vpcs should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			vpcA, subnetA := newVpcAndSubnet(ctx, cloudAccountId, "a", "10.0.0.0/16", "10.0.0.0/24")
+			vpcB, subnetB := newVpcAndSubnet(ctx, cloudAccountId, "b", "10.1.0.0/16", "10.1.0.0/24")
+
+			_, err := vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcA, subnetA, "", vpcB, subnetB.Metadata.ResourceId))
+			Expect(err).Should(Succeed())
+
+			By("Attaching the same subnet again")
+			_, err = vpcPeeringServiceClient.Create(ctx, NewCreateVPCPeeringRequest(cloudAccountId, vpcB, subnetB, "", vpcA, subnetA.Metadata.ResourceId))
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			By("Attaching other subnets of the same vpcs")
+			var otherSubnetIds []string
+			for _, vpc := range []*pb.VPC{vpcA, vpcB} {
+				subnet, err := subnetServiceClient.Create(ctx, &pb.SubnetCreateRequest{
+					Metadata: &pb.SubnetMetadataCreate{CloudAccountId: cloudAccountId},
+					Spec: &pb.SubnetSpec{
+						CidrBlock:        strings.Replace(vpc.Spec.CidrBlock, ".0.0/16", ".1.0/24", 1),
+						AvailabilityZone: "us-dev-1a",
+						VpcId:            vpc.Metadata.ResourceId,
+					},
+				})
+				Expect(err).Should(Succeed())
+				otherSubnetIds = append(otherSubnetIds, subnet.Metadata.ResourceId)
+			}
+			req := NewCreateVPCPeeringRequest(cloudAccountId, vpcB, subnetB, "", vpcA, otherSubnetIds[0])
+			req.Metadata.Name = "peering2"
+			req.Spec.RequesterSubnetId = otherSubnetIds[1]
+			_, err = vpcPeeringServiceClient.Create(ctx, req)
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+	})
+})
@@ -278,4 +278,49 @@ var _ = Describe("VPC API Integration Tests", Serial, func() {
 			Expect(getResp.Metadata.Labels).Should(Equal(defaultLabels))
 		})
 	})
+
+	Context("Search API", func() {
+		It("Search with pageSize should return all VPCs in pages", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			for i := 0; i < 5; i++ {
+				createReq := NewCreateVPCRequest(cloudAccountId, fmt.Sprintf("vpc%d", i), fmt.Sprintf("10.%d.0.0/16", i))
+				_, err := vpcServiceClient.Create(ctx, createReq)
+				Expect(err).Should(Succeed())
+			}
+
+			var names []string
+			pageToken := ""
+			for page := 0; ; page++ {
+				Expect(page).Should(BeNumerically("<", 3))
+				searchResp, err := vpcServiceClient.Search(ctx, &pb.VPCSearchRequest{
+					Metadata: &pb.VPCMetadataSearch{
+						CloudAccountId: cloudAccountId,
+					},
+					PageSize:  2,
+					PageToken: pageToken,
+				})
+				Expect(err).Should(Succeed())
+				Expect(len(searchResp.Items)).Should(BeNumerically("<=", 2))
+				for _, item := range searchResp.Items {
+					names = append(names, item.Metadata.Name)
+				}
+				pageToken = searchResp.NextPageToken
+				if pageToken == "" {
+					break
+				}
+			}
+			Expect(names).Should(Equal([]string{"vpc0", "vpc1", "vpc2", "vpc3", "vpc4"}))
+		})
+
+		It("Search with an invalid pageToken should fail", func() {
+			cloudAccountId := cloudaccount.MustNewId()
+			_, err := vpcServiceClient.Search(ctx, &pb.VPCSearchRequest{
+				Metadata: &pb.VPCMetadataSearch{
+					CloudAccountId: cloudAccountId,
+				},
+				PageToken: "invalid",
+			})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
 })
@@ -0,0 +1,51 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+
+-- VPC Peering --
+CREATE SEQUENCE vpc_peering_resource_version_seq minvalue 1;
+
+CREATE TABLE IF NOT EXISTS vpc_peering (
+    resource_id uuid primary key,
+    -- the requester cloud account
+    cloud_account_id varchar(12) not null,
+    -- will have same value as resource_id if not specified by user
+    name varchar(63) not null,
+    created_timestamp timestamp DEFAULT NOW(),
+    updated_timestamp timestamp DEFAULT NOW(),
+    -- infinity means not deleted; set to 'now' when logically deleted
+    deleted_timestamp timestamp not null default ('infinity'),
+    -- provides the ordering of inserts and updates for reliable watching
+    resource_version bigint not null default nextval('vpc_peering_resource_version_seq'),
+    -- Protobuf VPCPeeringPrivate message serialized as JSON.
+    value jsonb not null
+);
+
+-- Unique index prevents a record with the same name in the same cloud_account_id.
+CREATE UNIQUE INDEX resource_idx_vpc_peering on vpc_peering (cloud_account_id, name, deleted_timestamp);
+
+-- Used to find the peerings of the accepter cloud account.
+CREATE INDEX accepter_idx_vpc_peering on vpc_peering ((value->'spec'->'accepter'->>'cloudAccountId'), deleted_timestamp);
+
+
+-- Route Table --
+CREATE SEQUENCE route_table_resource_version_seq minvalue 1;
+
+CREATE TABLE IF NOT EXISTS route_table (
+    resource_id uuid primary key,
+    cloud_account_id varchar(12) not null,
+    -- will have same value as resource_id if not specified by user
+    name varchar(63) not null,
+    created_timestamp timestamp DEFAULT NOW(),
+    updated_timestamp timestamp DEFAULT NOW(),
+    -- infinity means not deleted; set to 'now' when logically deleted
+    deleted_timestamp timestamp not null default ('infinity'),
+    -- provides the ordering of inserts and updates for reliable watching
+    resource_version bigint not null default nextval('route_table_resource_version_seq'),
+    -- Protobuf RouteTablePrivate message serialized as JSON.
+    -- Routes and associated subnets are stored in the spec.
+    value jsonb not null
+);
+
+-- Unique index prevents a record with the same name in the same cloud_account_id.
+CREATE UNIQUE INDEX resource_idx_route_table on route_table (cloud_account_id, name, deleted_timestamp);
@@ -0,0 +1,42 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+
+-- Elastic IP --
+CREATE SEQUENCE elastic_ip_resource_version_seq minvalue 1;
+
+CREATE TABLE IF NOT EXISTS elastic_ip (
+    resource_id uuid primary key,
+    cloud_account_id varchar(12) not null,
+    -- will have same value as resource_id if not specified by user
+    name varchar(63) not null,
+    created_timestamp timestamp DEFAULT NOW(),
+    updated_timestamp timestamp DEFAULT NOW(),
+    -- infinity means not deleted; set to 'now' when the elastic IP is released
+    deleted_timestamp timestamp not null default ('infinity'),
+    -- provides the ordering of inserts and updates for reliable watching
+    resource_version bigint not null default nextval('elastic_ip_resource_version_seq'),
+    -- Protobuf ElasticIPPrivate message serialized as JSON.
+    value jsonb not null
+);
+
+-- Unique index prevents a record with the same name in the same cloud_account_id.
+CREATE UNIQUE INDEX resource_idx_elastic_ip on elastic_ip (cloud_account_id, name, deleted_timestamp);
+
+-- Unique index prevents the allocation of the same public IP address to more than one elastic IP.
+CREATE UNIQUE INDEX ip_address_idx_elastic_ip on elastic_ip ((value->'spec'->>'ipAddress')) where deleted_timestamp = 'infinity';
+
+-- Used to find the elastic IP of a port.
+CREATE INDEX port_idx_elastic_ip on elastic_ip ((value->'spec'->>'portId'), deleted_timestamp);
+
+
+-- Address Translation --
+-- An address translation that is being deleted must not prevent a new address translation of the same port,
+-- such as when an elastic IP is disassociated from a port and another elastic IP is associated with it.
+DROP INDEX IF EXISTS unique_address_translation_idx;
+CREATE UNIQUE INDEX unique_address_translation_idx ON address_translation (
+                                                                           cloud_account_id,
+                                                                           (value->'spec'->>'portId'),
+                                                                           (value->'spec'->>'translationType')
+                                                                          )
+    where deleted_timestamp = 'infinity' and value->'metadata'->>'deletionTimestamp' is null;
@@ -0,0 +1,8 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+CREATE INDEX idx_subnet_ipv6_cidrblock ON subnet ((value->'spec'->>'ipv6CidrBlock'));
+
+-- Prevents the same IPv6 address from being allocated to two ports of a dual-stack subnet.
+CREATE UNIQUE INDEX idx_port_ipv6_address ON port ((value->'spec'->>'subnetId'), (value->'spec'->>'ipv6Address'))
+    WHERE coalesce(value->'spec'->>'ipv6Address', '') <> '' AND deleted_timestamp = 'infinity';
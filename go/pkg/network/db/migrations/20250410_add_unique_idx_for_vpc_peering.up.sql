@@ -0,0 +1,9 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+-- Prevents more than one VPC peering between the same two VPCs, in either direction.
+-- Rejected peerings do not block a new peering between the VPCs.
+CREATE UNIQUE INDEX idx_vpc_peering_vpc_pair ON vpc_peering (
+    least(value->'spec'->'requester'->>'vpcId', value->'spec'->'accepter'->>'vpcId'),
+    greatest(value->'spec'->'requester'->>'vpcId', value->'spec'->'accepter'->>'vpcId'))
+    WHERE deleted_timestamp = 'infinity' AND value->'status'->>'phase' <> 'VPCPeeringPhase_Rejected';
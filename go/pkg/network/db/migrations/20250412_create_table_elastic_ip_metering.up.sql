@@ -0,0 +1,11 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+-- A single row with the time of the last usage report of elastic IPs.
+-- Elastic IPs released after this time are reported one last time by the next report.
+CREATE TABLE IF NOT EXISTS elastic_ip_metering (
+    id int primary key default 1 check (id = 1),
+    last_usage_record_timestamp timestamp not null
+);
+
+INSERT INTO elastic_ip_metering (last_usage_record_timestamp) VALUES (now()) ON CONFLICT DO NOTHING;
@@ -0,0 +1,64 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+
+// The flow log collector follows the ovn-controller log file and writes the ACL log records
+// of security rules with flow logging enabled to OpenSearch.
+// It runs on each node running ovn-controller. If logFile is not configured, the log is read from stdin.
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/conf"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/flowlog"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Parse command line.
+	var configFile string
+	flag.StringVar(&configFile, "config", "", "The application will load its configuration from this file.")
+	log.BindFlags()
+	flag.Parse()
+
+	// Initialize logger.
+	log.SetDefaultLogger()
+	log := log.FromContext(ctx)
+
+	err := func() error {
+		// Load configuration from file.
+		var cfg flowlog.Config
+		if err := conf.LoadConfigFile(ctx, configFile, &cfg); err != nil {
+			return err
+		}
+		log.Info("main", logkeys.Configuration, cfg)
+
+		collector, err := flowlog.NewCollector(cfg)
+		if err != nil {
+			return err
+		}
+		var reader io.Reader = os.Stdin
+		if cfg.LogFile != "" {
+			follower, err := flowlog.NewFollower(ctx, cfg.LogFile, cfg.LogFilePollInterval)
+			if err != nil {
+				return err
+			}
+			defer follower.Close()
+			reader = follower
+		}
+		return collector.Run(ctx, reader)
+	}()
+	if err != nil && ctx.Err() == nil {
+		log.Error(err, "fatal error")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,220 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package flowlog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+)
+
+type Config struct {
+	// The ovn-controller log file, which is followed like tail -F. Records are read from stdin if it is empty.
+	LogFile string `koanf:"logFile"`
+	// How often the log file is checked for new records and rotation.
+	LogFilePollInterval time.Duration `koanf:"logFilePollInterval"`
+	OpenSearchEndpoint  string        `koanf:"openSearchEndpoint"`
+	UsernameFile        string        `koanf:"usernameFile"`
+	PasswordFile        string        `koanf:"passwordFile"`
+	InsecureSkipVerify  bool          `koanf:"insecureSkipVerify"`
+	Region              string        `koanf:"region"`
+	// Records are sent to OpenSearch when the batch is full or the flush interval has elapsed.
+	BatchSize     int           `koanf:"batchSize"`
+	FlushInterval time.Duration `koanf:"flushInterval"`
+}
+
+// Collector reads ACL log records written by ovn-controller and writes them to the
+// OpenSearch index of the cloud account of each record.
+type Collector struct {
+	cfg        Config
+	host       string
+	httpClient *http.Client
+	username   string
+	password   string
+}
+
+func NewCollector(cfg Config) (*Collector, error) {
+	if cfg.OpenSearchEndpoint == "" {
+		return nil, fmt.Errorf("openSearchEndpoint is required")
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = 5 * time.Second
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	c := &Collector{
+		cfg:        cfg,
+		host:       host,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+	if cfg.InsecureSkipVerify {
+		c.httpClient.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	if cfg.UsernameFile != "" {
+		username, err := os.ReadFile(cfg.UsernameFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read OpenSearch username: %w", err)
+		}
+		c.username = strings.TrimSpace(string(username))
+	}
+	if cfg.PasswordFile != "" {
+		password, err := os.ReadFile(cfg.PasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read OpenSearch password: %w", err)
+		}
+		c.password = strings.TrimSpace(string(password))
+	}
+	return c, nil
+}
+
+// Read lines from reader until it is closed or the context is cancelled.
+func (c *Collector) Run(ctx context.Context, reader io.Reader) error {
+	log := log.FromContext(ctx).WithName("Collector.Run")
+	lines := make(chan string)
+	scanErr := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			select {
+			case lines <- scanner.Text():
+			case <-ctx.Done():
+				return
+			}
+		}
+		scanErr <- scanner.Err()
+	}()
+
+	ticker := time.NewTicker(c.cfg.FlushInterval)
+	defer ticker.Stop()
+	var batch []*Record
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := c.Write(ctx, batch); err != nil {
+			// Flow logs are best effort. The records are dropped rather than blocking the collector.
+			log.Error(err, "unable to write flow log records", logkeys.RecordCount, len(batch))
+		}
+		batch = nil
+	}
+	defer flush()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			flush()
+		case line, ok := <-lines:
+			if !ok {
+				select {
+				case err := <-scanErr:
+					return err
+				default:
+					return nil
+				}
+			}
+			record, err := ParseACLLog(line)
+			if errors.Is(err, ErrNotACLLog) {
+				continue
+			}
+			if err != nil {
+				log.V(1).Info("ignoring ACL log record", logkeys.Error, err)
+				continue
+			}
+			record.Region = c.cfg.Region
+			record.Host = c.host
+			batch = append(batch, record)
+			if len(batch) >= c.cfg.BatchSize {
+				flush()
+			}
+		}
+	}
+}
+
+// Write records to OpenSearch using the bulk API.
+// Records are written to the write alias of the cloud account, which is created by CloudMonitorLogsService.UserRegistration.
+// Records of cloud accounts that are not registered are rejected by OpenSearch.
+func (c *Collector) Write(ctx context.Context, records []*Record) error {
+	body, err := BulkRequestBody(records)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.OpenSearchEndpoint+"/_bulk", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending bulk request to OpenSearch: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading bulk response from OpenSearch: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received status %d for bulk request: %s", resp.StatusCode, respBody)
+	}
+	var bulkResp struct {
+		Errors bool `json:"errors"`
+	}
+	if err := json.Unmarshal(respBody, &bulkResp); err != nil {
+		return fmt.Errorf("unable to parse bulk response: %w", err)
+	}
+	if bulkResp.Errors {
+		return fmt.Errorf("some records were rejected by OpenSearch")
+	}
+	return nil
+}
+
+// Returns the body of an OpenSearch bulk request that indexes the records.
+func BulkRequestBody(records []*Record) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range records {
+		action := map[string]any{
+			"index": map[string]any{
+				"_index": WriteAlias(record.CloudAccountId),
+				// Do not create an index if the cloud account is not registered.
+				"require_alias": true,
+			},
+		}
+		if err := encoder.Encode(action); err != nil {
+			return nil, err
+		}
+		if err := encoder.Encode(record); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// The OpenSearch write alias of the logs of a cloud account.
+func WriteAlias(cloudAccountId string) string {
+	return fmt.Sprintf("cm_logs_%s_wrt_als", cloudAccountId)
+}
@@ -0,0 +1,125 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package flowlog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// Follower reads the lines appended to a log file, like tail -F.
+// The file is read from its end when the follower starts, so that records are not written again when the collector
+// restarts. When the file is rotated or truncated, the new file is read from its start.
+// Read blocks until more data is written, and returns io.EOF once the context is cancelled.
+type Follower struct {
+	ctx          context.Context
+	path         string
+	pollInterval time.Duration
+	file         *os.File
+	offset       int64
+}
+
+// NewFollower opens the file if it exists, otherwise it is opened by Read once it is created.
+func NewFollower(ctx context.Context, path string, pollInterval time.Duration) (*Follower, error) {
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	f := &Follower{
+		ctx:          ctx,
+		path:         path,
+		pollInterval: pollInterval,
+	}
+	if err := f.open(true); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *Follower) Read(p []byte) (int, error) {
+	for {
+		if f.file == nil {
+			if err := f.open(false); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return 0, err
+			}
+		}
+		if f.file != nil {
+			n, err := f.file.Read(p)
+			f.offset += int64(n)
+			if n > 0 {
+				return n, nil
+			}
+			if err != nil && err != io.EOF {
+				return 0, err
+			}
+			// At the end of the file, read the new file if it was rotated.
+			if err := f.checkRotation(); err != nil {
+				return 0, err
+			}
+			if f.file == nil {
+				continue
+			}
+		}
+		select {
+		case <-f.ctx.Done():
+			return 0, io.EOF
+		case <-time.After(f.pollInterval):
+		}
+	}
+}
+
+func (f *Follower) Close() error {
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func (f *Follower) open(fromEnd bool) error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+	offset := int64(0)
+	if fromEnd {
+		offset, err = file.Seek(0, io.SeekEnd)
+		if err != nil {
+			file.Close()
+			return err
+		}
+	}
+	f.file = file
+	f.offset = offset
+	return nil
+}
+
+// Close the file if it was replaced by a new file at the path, or read it again from its start if it was truncated.
+func (f *Follower) checkRotation() error {
+	pathInfo, err := os.Stat(f.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		// The file was moved and the new file is not created yet.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fileInfo, err := f.file.Stat()
+	if err != nil {
+		return err
+	}
+	if !os.SameFile(pathInfo, fileInfo) {
+		return f.Close()
+	}
+	if fileInfo.Size() < f.offset {
+		if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		f.offset = 0
+	}
+	return nil
+}
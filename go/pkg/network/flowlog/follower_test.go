@@ -0,0 +1,83 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package flowlog
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFollower(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	path := filepath.Join(t.TempDir(), "ovn-controller.log")
+
+	appendLine := func(line string) {
+		t.Helper()
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+		if _, err := file.WriteString(line + "\n"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	appendLine("written before the follower started")
+	follower, err := NewFollower(ctx, path, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer follower.Close()
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(follower)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	expectLine := func(expected string) {
+		t.Helper()
+		select {
+		case line := <-lines:
+			if line != expected {
+				t.Fatalf("expected %q, got %q", expected, line)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %q", expected)
+		}
+	}
+
+	appendLine("appended")
+	expectLine("appended")
+
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatal(err)
+	}
+	appendLine("after rotation")
+	expectLine("after rotation")
+
+	if err := os.Truncate(path, 0); err != nil {
+		t.Fatal(err)
+	}
+	// The file is smaller than the read offset.
+	appendLine("truncated")
+	expectLine("truncated")
+
+	cancel()
+	select {
+	case _, ok := <-lines:
+		if ok {
+			t.Fatal("unexpected line after cancellation")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("follower did not stop after cancellation")
+	}
+}
@@ -0,0 +1,72 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package flowlog
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// OVN writes the name of the ACL in each log record. The name is limited to 63 characters.
+// To map a log record to its cloud account, security group and security rule without a lookup,
+// the ACL of a rule with flow logging enabled is named fl.<cloudAccountId>.<securityGroupId>.<securityRuleId>,
+// where the ids of the security group and security rule are base64url encoded (22 characters each).
+const loggingNamePrefix = "fl"
+
+var (
+	loggingNameEncoding  = base64.RawURLEncoding
+	cloudAccountIdRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+)
+
+// Returns the name of the ACL of a security rule with flow logging enabled.
+func LoggingName(cloudAccountId string, securityGroupId string, securityRuleId string) (string, error) {
+	if !cloudAccountIdRegexp.MatchString(cloudAccountId) {
+		return "", fmt.Errorf("invalid cloud account id %s", cloudAccountId)
+	}
+	securityGroupUuid, err := uuid.Parse(securityGroupId)
+	if err != nil {
+		return "", fmt.Errorf("invalid security group id %s: %w", securityGroupId, err)
+	}
+	securityRuleUuid, err := uuid.Parse(securityRuleId)
+	if err != nil {
+		return "", fmt.Errorf("invalid security rule id %s: %w", securityRuleId, err)
+	}
+	return strings.Join([]string{
+		loggingNamePrefix,
+		cloudAccountId,
+		loggingNameEncoding.EncodeToString(securityGroupUuid[:]),
+		loggingNameEncoding.EncodeToString(securityRuleUuid[:]),
+	}, "."), nil
+}
+
+// Returns the cloud account, security group and security rule of an ACL name returned by LoggingName.
+func ParseLoggingName(name string) (cloudAccountId string, securityGroupId string, securityRuleId string, err error) {
+	parts := strings.Split(name, ".")
+	if len(parts) != 4 || parts[0] != loggingNamePrefix {
+		return "", "", "", fmt.Errorf("invalid logging name %q", name)
+	}
+	if !cloudAccountIdRegexp.MatchString(parts[1]) {
+		return "", "", "", fmt.Errorf("invalid logging name %q: invalid cloud account id", name)
+	}
+	securityGroupUuid, err := decodeUuid(parts[2])
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid logging name %q: %w", name, err)
+	}
+	securityRuleUuid, err := decodeUuid(parts[3])
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid logging name %q: %w", name, err)
+	}
+	return parts[1], securityGroupUuid.String(), securityRuleUuid.String(), nil
+}
+
+func decodeUuid(s string) (uuid.UUID, error) {
+	b, err := loggingNameEncoding.DecodeString(s)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.FromBytes(b)
+}
@@ -0,0 +1,54 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package flowlog
+
+import (
+	"testing"
+)
+
+func TestLoggingName(t *testing.T) {
+	cloudAccountId := "123456789012"
+	securityGroupId := "1b4e28ba-2fa1-4d3b-a3f5-ef19b5a7633b"
+	securityRuleId := "6ba7b810-9dad-41d1-80b4-00c04fd430c8"
+
+	name, err := LoggingName(cloudAccountId, securityGroupId, securityRuleId)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if len(name) > 63 {
+		t.Fatalf("want at most 63 characters, got %d (%s)", len(name), name)
+	}
+
+	gotCloudAccountId, gotSecurityGroupId, gotSecurityRuleId, err := ParseLoggingName(name)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if gotCloudAccountId != cloudAccountId || gotSecurityGroupId != securityGroupId || gotSecurityRuleId != securityRuleId {
+		t.Fatalf("want %s %s %s, got %s %s %s", cloudAccountId, securityGroupId, securityRuleId,
+			gotCloudAccountId, gotSecurityGroupId, gotSecurityRuleId)
+	}
+}
+
+func TestLoggingNameInvalid(t *testing.T) {
+	if _, err := LoggingName("1234", "1b4e28ba-2fa1-4d3b-a3f5-ef19b5a7633b", "6ba7b810-9dad-41d1-80b4-00c04fd430c8"); err == nil {
+		t.Fatalf("want error for invalid cloud account id")
+	}
+	if _, err := LoggingName("123456789012", "sg", "6ba7b810-9dad-41d1-80b4-00c04fd430c8"); err == nil {
+		t.Fatalf("want error for invalid security group id")
+	}
+}
+
+func TestParseLoggingNameInvalid(t *testing.T) {
+	for _, name := range []string{
+		"",
+		"my-rule",
+		"fl.123456789012.G04ouy-hTTuj9e8ZtadjOw",
+		"xx.123456789012.G04ouy-hTTuj9e8ZtadjOw.a6e4EJ2tEdGAtADAT9QwyA",
+		"fl.12345678901x.G04ouy-hTTuj9e8ZtadjOw.a6e4EJ2tEdGAtADAT9QwyA",
+		"fl.123456789012.G04ouy-hTTuj9e8Ztadj.a6e4EJ2tEdGAtADAT9QwyA",
+	} {
+		if _, _, _, err := ParseLoggingName(name); err == nil {
+			t.Fatalf("want error for %q", name)
+		}
+	}
+}
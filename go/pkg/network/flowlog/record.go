@@ -0,0 +1,137 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package flowlog
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	VerdictAllow = "allow"
+	VerdictDrop  = "drop"
+
+	DirectionIngress = "ingress"
+	DirectionEgress  = "egress"
+)
+
+// Returned by ParseACLLog for lines that are not ACL log records.
+var ErrNotACLLog = errors.New("not an ACL log record")
+
+// A flow log record as stored in OpenSearch.
+// The field names match the fields of the IKS log records where they have the same meaning,
+// so that both can be searched with CloudMonitorLogsService.
+type Record struct {
+	Timestamp       string `json:"@timestamp"`
+	CloudAccountId  string `json:"cloudaccount_id"`
+	Region          string `json:"cluster_region"`
+	Host            string `json:"host,omitempty"`
+	SecurityGroupId string `json:"security_group_id"`
+	SecurityRuleId  string `json:"security_rule_id"`
+	Verdict         string `json:"verdict"`
+	Direction       string `json:"direction"`
+	Protocol        string `json:"protocol"`
+	SrcIp           string `json:"src_ip,omitempty"`
+	DstIp           string `json:"dst_ip,omitempty"`
+	SrcPort         uint32 `json:"src_port,omitempty"`
+	DstPort         uint32 `json:"dst_port,omitempty"`
+	Log             string `json:"log"`
+}
+
+// Parse a line of the ovn-controller log into a flow log record.
+// ACL log records have the following format:
+//
+//	2024-05-01T10:00:00.000Z|00005|acl_log(ovn_pinctrl0)|INFO|name="fl.123456789012.<sg>.<rule>", verdict=drop, severity=info, direction=from-lport: tcp,vlan_tci=0x0000,dl_src=...,nw_src=10.0.0.2,nw_dst=10.0.0.3,...,tp_src=35000,tp_dst=22,tcp_flags=syn
+//
+// Records of ACLs that were not named by LoggingName are not flow log records and return an error.
+func ParseACLLog(line string) (*Record, error) {
+	line = strings.TrimSpace(line)
+	fields := strings.SplitN(line, "|", 5)
+	if len(fields) != 5 || !strings.HasPrefix(fields[2], "acl_log") {
+		return nil, ErrNotACLLog
+	}
+	timestamp := fields[0]
+	header, flow, found := strings.Cut(fields[4], ": ")
+	if !found {
+		return nil, fmt.Errorf("invalid ACL log record %q", line)
+	}
+
+	attributes := make(map[string]string)
+	for _, attribute := range strings.Split(header, ", ") {
+		key, value, found := strings.Cut(attribute, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid ACL log attribute %q", attribute)
+		}
+		attributes[key] = strings.Trim(value, `"`)
+	}
+
+	cloudAccountId, securityGroupId, securityRuleId, err := ParseLoggingName(attributes["name"])
+	if err != nil {
+		return nil, err
+	}
+
+	record := &Record{
+		Timestamp:       timestamp,
+		CloudAccountId:  cloudAccountId,
+		SecurityGroupId: securityGroupId,
+		SecurityRuleId:  securityRuleId,
+		Log:             line,
+	}
+
+	switch attributes["verdict"] {
+	case "allow", "allow-related", "allow-stateless":
+		record.Verdict = VerdictAllow
+	case "drop", "reject":
+		record.Verdict = VerdictDrop
+	default:
+		return nil, fmt.Errorf("invalid ACL log verdict %q", attributes["verdict"])
+	}
+
+	// The SDN controller applies ingress rules to traffic from the logical port
+	// and egress rules to traffic to the logical port.
+	switch attributes["direction"] {
+	case "from-lport":
+		record.Direction = DirectionIngress
+	case "to-lport":
+		record.Direction = DirectionEgress
+	default:
+		return nil, fmt.Errorf("invalid ACL log direction %q", attributes["direction"])
+	}
+
+	// The flow is the protocol followed by the fields of the packet.
+	for i, field := range strings.Split(flow, ",") {
+		if i == 0 {
+			record.Protocol = field
+			continue
+		}
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "nw_src", "ipv6_src":
+			record.SrcIp = value
+		case "nw_dst", "ipv6_dst":
+			record.DstIp = value
+		case "tp_src":
+			record.SrcPort, err = parsePort(value)
+		case "tp_dst":
+			record.DstPort, err = parsePort(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid ACL log field %q: %w", field, err)
+		}
+	}
+
+	return record, nil
+}
+
+func parsePort(value string) (uint32, error) {
+	port, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(port), nil
+}
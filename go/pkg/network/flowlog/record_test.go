@@ -0,0 +1,120 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package flowlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+const (
+	testCloudAccountId  = "123456789012"
+	testSecurityGroupId = "1b4e28ba-2fa1-4d3b-a3f5-ef19b5a7633b"
+	testSecurityRuleId  = "6ba7b810-9dad-41d1-80b4-00c04fd430c8"
+)
+
+func testACLLog(t *testing.T, verdict string, direction string, flow string) string {
+	name, err := LoggingName(testCloudAccountId, testSecurityGroupId, testSecurityRuleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(`2024-05-01T10:00:00.000Z|00005|acl_log(ovn_pinctrl0)|INFO|name="%s", verdict=%s, severity=info, direction=%s: %s`,
+		name, verdict, direction, flow)
+}
+
+func TestParseACLLogTCP(t *testing.T) {
+	line := testACLLog(t, "drop", "from-lport",
+		"tcp,vlan_tci=0x0000,dl_src=02:00:00:00:00:01,dl_dst=02:00:00:00:00:02,nw_src=10.0.0.2,nw_dst=10.0.0.3,nw_tos=0,nw_ecn=0,nw_ttl=64,nw_frag=no,tp_src=35000,tp_dst=22,tcp_flags=syn")
+	record, err := ParseACLLog(line)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	want := Record{
+		Timestamp:       "2024-05-01T10:00:00.000Z",
+		CloudAccountId:  testCloudAccountId,
+		SecurityGroupId: testSecurityGroupId,
+		SecurityRuleId:  testSecurityRuleId,
+		Verdict:         VerdictDrop,
+		Direction:       DirectionIngress,
+		Protocol:        "tcp",
+		SrcIp:           "10.0.0.2",
+		DstIp:           "10.0.0.3",
+		SrcPort:         35000,
+		DstPort:         22,
+		Log:             line,
+	}
+	if *record != want {
+		t.Fatalf("want %+v, got %+v", want, *record)
+	}
+}
+
+func TestParseACLLogICMP(t *testing.T) {
+	line := testACLLog(t, "allow-related", "to-lport",
+		"icmp,vlan_tci=0x0000,dl_src=02:00:00:00:00:01,dl_dst=02:00:00:00:00:02,nw_src=10.0.0.3,nw_dst=10.0.0.2,nw_tos=0,nw_ecn=0,nw_ttl=64,nw_frag=no,icmp_type=8,icmp_code=0")
+	record, err := ParseACLLog(line)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if record.Verdict != VerdictAllow || record.Direction != DirectionEgress || record.Protocol != "icmp" {
+		t.Fatalf("unexpected record %+v", *record)
+	}
+	if record.SrcPort != 0 || record.DstPort != 0 {
+		t.Fatalf("want no ports, got %d %d", record.SrcPort, record.DstPort)
+	}
+}
+
+func TestParseACLLogNotACLLog(t *testing.T) {
+	for _, line := range []string{
+		"",
+		"2024-05-01T10:00:00.000Z|00001|vlog|INFO|opened log file /var/log/ovn/ovn-controller.log",
+	} {
+		if _, err := ParseACLLog(line); !errors.Is(err, ErrNotACLLog) {
+			t.Fatalf("want ErrNotACLLog for %q, got %v", line, err)
+		}
+	}
+}
+
+func TestParseACLLogOtherACL(t *testing.T) {
+	line := `2024-05-01T10:00:00.000Z|00005|acl_log(ovn_pinctrl0)|INFO|name="my-acl", verdict=drop, severity=info, direction=from-lport: tcp,nw_src=10.0.0.2,nw_dst=10.0.0.3`
+	record, err := ParseACLLog(line)
+	if err == nil || errors.Is(err, ErrNotACLLog) {
+		t.Fatalf("want error for ACL without a logging name, got %v %v", record, err)
+	}
+}
+
+func TestBulkRequestBody(t *testing.T) {
+	record, err := ParseACLLog(testACLLog(t, "drop", "from-lport", "udp,nw_src=10.0.0.2,nw_dst=10.0.0.3,tp_src=5000,tp_dst=53"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := BulkRequestBody([]*Record{record})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.Split(bytes.TrimSpace(body), []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("want 2 lines, got %d", len(lines))
+	}
+	var action struct {
+		Index struct {
+			Index        string `json:"_index"`
+			RequireAlias bool   `json:"require_alias"`
+		} `json:"index"`
+	}
+	if err := json.Unmarshal(lines[0], &action); err != nil {
+		t.Fatal(err)
+	}
+	if action.Index.Index != "cm_logs_123456789012_wrt_als" || !action.Index.RequireAlias {
+		t.Fatalf("unexpected action %s", lines[0])
+	}
+	var document map[string]any
+	if err := json.Unmarshal(lines[1], &document); err != nil {
+		t.Fatal(err)
+	}
+	if document["cloudaccount_id"] != testCloudAccountId || document["security_rule_id"] != testSecurityRuleId || document["dst_port"] != float64(53) {
+		t.Fatalf("unexpected document %s", lines[1])
+	}
+}
@@ -0,0 +1,41 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+/*
+Copyright 2023.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RouteTableStatus defines the observed state of RouteTable
+type RouteTableStatus struct {
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rt;
+
+// RouteTable is the Schema for the RouteTable API
+type RouteTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   string `json:"spec"`
+	Status string `json:"status"`
+}
+
+//+kubebuilder:object:root=true
+
+// RouteTableList contains a list of RouteTable
+type RouteTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouteTable `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
+}
@@ -0,0 +1,41 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+/*
+Copyright 2023.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecurityGroupStatus defines the observed state of SecurityGroup
+type SecurityGroupStatus struct {
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=sg;
+
+// SecurityGroup is the Schema for the SecurityGroup API
+type SecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   string `json:"spec"`
+	Status string `json:"status"`
+}
+
+//+kubebuilder:object:root=true
+
+// SecurityGroupList contains a list of SecurityGroup
+type SecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SecurityGroup{}, &SecurityGroupList{})
+}
@@ -0,0 +1,41 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+/*
+Copyright 2023.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VPCPeeringStatus defines the observed state of VPCPeering
+type VPCPeeringStatus struct {
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vpcp;
+
+// VPCPeering is the Schema for the VPCPeering API
+type VPCPeering struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   string `json:"spec"`
+	Status string `json:"status"`
+}
+
+//+kubebuilder:object:root=true
+
+// VPCPeeringList contains a list of VPCPeering
+type VPCPeeringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeering `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VPCPeering{}, &VPCPeeringList{})
+}
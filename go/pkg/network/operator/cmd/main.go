@@ -39,8 +39,11 @@ import (
 	vpcv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/api/v1alpha1"
 	inputconfig "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/config"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/iprm"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/routetable"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/securitygroup"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/subnet"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/vpc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/vpcpeering"
 	sdnv1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
@@ -152,6 +155,9 @@ func main() {
 		vpcServiceClient := pb.NewVPCPrivateServiceClient(networkClientConn)
 		subnetServiceClient := pb.NewSubnetPrivateServiceClient(networkClientConn)
 		iprmServiceClient := pb.NewIPRMPrivateServiceClient(networkClientConn)
+		securityGroupServiceClient := pb.NewSecurityGroupPrivateServiceClient(networkClientConn)
+		vpcPeeringServiceClient := pb.NewVPCPeeringPrivateServiceClient(networkClientConn)
+		routeTableServiceClient := pb.NewRouteTablePrivateServiceClient(networkClientConn)
 
 		// Ensure that we can ping the network service before starting the manager.
 		pingNetworkCtx, cancelNetwork := context.WithTimeout(ctx, time.Second*10)
@@ -168,6 +174,18 @@ func main() {
 			return fmt.Errorf("unable to ping iprm service: %w", err)
 		}
 
+		if _, err := securityGroupServiceClient.PingPrivate(pingNetworkCtx, &emptypb.Empty{}); err != nil {
+			return fmt.Errorf("unable to ping security group service: %w", err)
+		}
+
+		if _, err := vpcPeeringServiceClient.PingPrivate(pingNetworkCtx, &emptypb.Empty{}); err != nil {
+			return fmt.Errorf("unable to ping vpc peering service: %w", err)
+		}
+
+		if _, err := routeTableServiceClient.PingPrivate(pingNetworkCtx, &emptypb.Empty{}); err != nil {
+			return fmt.Errorf("unable to ping route table service: %w", err)
+		}
+
 		log.Info("sdnserver", "address", inputConfig.SDNServerAddr)
 
 		// Create connection to SDN Controller
@@ -197,6 +215,24 @@ func main() {
 			os.Exit(1)
 		}
 
+		_, err = securitygroup.NewReconciler(ctx, mgr, securityGroupServiceClient, sdnClient)
+		if err != nil {
+			log.Error(err, "could not init security group reconciler")
+			os.Exit(1)
+		}
+
+		_, err = vpcpeering.NewReconciler(ctx, mgr, vpcPeeringServiceClient, sdnClient)
+		if err != nil {
+			log.Error(err, "could not init vpc peering reconciler")
+			os.Exit(1)
+		}
+
+		_, err = routetable.NewReconciler(ctx, mgr, routeTableServiceClient, sdnClient)
+		if err != nil {
+			log.Error(err, "could not init route table reconciler")
+			os.Exit(1)
+		}
+
 		setupLog.Info("initializing controller", "MaxConcurrentReconciles", inputConfig.MaxConcurrentReconciles)
 
 		if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
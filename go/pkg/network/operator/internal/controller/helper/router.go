@@ -0,0 +1,129 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	sdnv1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Router interfaces and static routes are owned by a router and have deterministic ids,
+// so that they can be found again without storing the ids.
+
+func RouterInterfaceId(routerId string, subnetId string) string {
+	return uuid.NewSHA1(uuid.MustParse(routerId), []byte(subnetId)).String()
+}
+
+func StaticRouteId(routerId string, prefix string) string {
+	return uuid.NewSHA1(uuid.MustParse(routerId), []byte(prefix)).String()
+}
+
+// Returns a locally administered unicast MAC address derived from the router interface id.
+func RouterInterfaceMAC(routerInterfaceId string) string {
+	id := uuid.MustParse(routerInterfaceId)
+	return fmt.Sprintf("02:%02x:%02x:%02x:%02x:%02x", id[0], id[1], id[2], id[3], id[4])
+}
+
+// Create the router in the SDN controller if it does not exist.
+func EnsureRouter(ctx context.Context, sdnClient sdnv1.OvnnetClient, routerId string, name string, vpcId string) error {
+	_, err := sdnClient.GetRouter(ctx, &sdnv1.GetRouterRequest{RouterId: &sdnv1.RouterId{Uuid: routerId}})
+	if status.Code(err) == codes.NotFound {
+		_, err := sdnClient.CreateRouter(ctx, &sdnv1.CreateRouterRequest{
+			RouterId: &sdnv1.RouterId{Uuid: routerId},
+			Name:     name,
+			VpcId:    &sdnv1.VPCId{Uuid: vpcId},
+		})
+		if err != nil {
+			return fmt.Errorf("could not create router: %v", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("could not status router: %v", err)
+	}
+	return nil
+}
+
+// Create the router interface in the subnet if it does not exist.
+// interfaceAddress includes the prefix length (e.g. 10.0.0.1/24).
+func EnsureRouterInterface(ctx context.Context, sdnClient sdnv1.OvnnetClient, routerId string, subnetId string, interfaceAddress string) error {
+	routerInterfaceId := RouterInterfaceId(routerId, subnetId)
+	_, err := sdnClient.GetRouterInterface(ctx, &sdnv1.GetRouterInterfaceRequest{RouterInterfaceId: &sdnv1.RouterInterfaceId{Uuid: routerInterfaceId}})
+	if status.Code(err) == codes.NotFound {
+		_, err := sdnClient.CreateRouterInterface(ctx, &sdnv1.CreateRouterInterfaceRequest{
+			RouterInterfaceId: &sdnv1.RouterInterfaceId{Uuid: routerInterfaceId},
+			RouterId:          &sdnv1.RouterId{Uuid: routerId},
+			SubnetId:          &sdnv1.SubnetId{Uuid: subnetId},
+			Interface_IP:      interfaceAddress,
+			Interface_MAC:     RouterInterfaceMAC(routerInterfaceId),
+		})
+		if err != nil {
+			return fmt.Errorf("could not create router interface: %v", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("could not status router interface: %v", err)
+	}
+	return nil
+}
+
+// Create the static route if it does not exist.
+// A static route with a different next hop is replaced.
+func EnsureStaticRoute(ctx context.Context, sdnClient sdnv1.OvnnetClient, routerId string, prefix string, nexthop string) error {
+	staticRouteId := StaticRouteId(routerId, prefix)
+	resp, err := sdnClient.GetStaticRoute(ctx, &sdnv1.GetStaticRouteRequest{StaticRouteId: &sdnv1.StaticRouteId{Uuid: staticRouteId}})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("could not status static route: %v", err)
+	}
+	if err == nil {
+		if resp.GetStaticRoute().GetNexthop() == nexthop {
+			return nil
+		}
+		if err := DeleteStaticRoute(ctx, sdnClient, routerId, prefix); err != nil {
+			return err
+		}
+	}
+	_, err = sdnClient.CreateStaticRoute(ctx, &sdnv1.CreateStaticRouteRequest{
+		StaticRouteId: &sdnv1.StaticRouteId{Uuid: staticRouteId},
+		RouterId:      &sdnv1.RouterId{Uuid: routerId},
+		Prefix:        prefix,
+		Nexthop:       nexthop,
+	})
+	if err != nil {
+		return fmt.Errorf("could not create static route: %v", err)
+	}
+	return nil
+}
+
+// Delete a router from the SDN controller. A router that does not exist is ignored.
+func DeleteRouter(ctx context.Context, sdnClient sdnv1.OvnnetClient, routerId string) error {
+	_, err := sdnClient.DeleteRouter(ctx, &sdnv1.DeleteRouterRequest{RouterId: &sdnv1.RouterId{Uuid: routerId}})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("could not delete router: %v", err)
+	}
+	return nil
+}
+
+// Delete a router interface from the SDN controller. A router interface that does not exist is ignored.
+func DeleteRouterInterface(ctx context.Context, sdnClient sdnv1.OvnnetClient, routerId string, subnetId string) error {
+	_, err := sdnClient.DeleteRouterInterface(ctx, &sdnv1.DeleteRouterInterfaceRequest{
+		RouterInterfaceId: &sdnv1.RouterInterfaceId{Uuid: RouterInterfaceId(routerId, subnetId)},
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("could not delete router interface: %v", err)
+	}
+	return nil
+}
+
+// Delete a static route from the SDN controller. A static route that does not exist is ignored.
+func DeleteStaticRoute(ctx context.Context, sdnClient sdnv1.OvnnetClient, routerId string, prefix string) error {
+	_, err := sdnClient.DeleteStaticRoute(ctx, &sdnv1.DeleteStaticRouteRequest{
+		StaticRouteId: &sdnv1.StaticRouteId{Uuid: StaticRouteId(routerId, prefix)},
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("could not delete static route: %v", err)
+	}
+	return nil
+}
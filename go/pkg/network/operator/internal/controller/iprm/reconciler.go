@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
-	"strconv"
 	"time"
 
 	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -217,10 +216,6 @@ func (r *Reconciler) processPort(ctx context.Context, port *pb.PortPrivate) (rec
 	if status.Code(err) == codes.NotFound {
 		log.Info("port does not exist, create", logkeys.PORT, port.Metadata.ResourceId)
 
-		// Convert chassisId from string to int.
-		// TODO: remove conversion when sdn will change chassisId to string
-		chassisId, _ := strconv.Atoi(port.Spec.ChassisId)
-
 		// Send request to SDN controller
 		portRequest := &sdnv1.CreatePortRequest{
 			PortId: &sdnv1.PortId{
@@ -230,7 +225,7 @@ func (r *Reconciler) processPort(ctx context.Context, port *pb.PortPrivate) (rec
 				Uuid: port.Spec.SubnetId,
 			},
 			// TODO: add the other params
-			ChassisId: uint32(chassisId),
+			ChassisId: port.Spec.ChassisId,
 			//DeviceId:   port.Spec.DeviceId,
 			MACAddress: port.Spec.MacAddress,
 		}
@@ -0,0 +1,372 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package routetable
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/core/cache"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	routetablev1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/api/v1alpha1"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/helper"
+	sdnv1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/tools/atomicduration"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Reconciler reconciles RouteTable objects from the Network API Server to the SDN controller.
+// A route table is implemented by a router with an interface in each associated subnet and a static route for each route.
+// See https://docs.bitnami.com/tutorials/kubewatch-an-example-of-kubernetes-custom-controller/
+// See https://komodor.com/learn/controller-manager/
+type Reconciler struct {
+	informer                 toolscache.SharedIndexInformer
+	cache                    *cache.Cache
+	k8sClient                k8sclient.Client
+	routeTableClient         pb.RouteTablePrivateServiceClient
+	sdnClient                sdnv1.OvnnetClient
+	durationSinceLastSuccess *atomicduration.AtomicDuration
+	marshaler                *grpcruntime.JSONPb
+}
+
+func NewReconciler(ctx context.Context, mgr ctrl.Manager, grpcClient pb.RouteTablePrivateServiceClient,
+	sdnClient sdnv1.OvnnetClient) (*Reconciler, error) {
+
+	durationSinceLastSuccess := atomicduration.New()
+	// Create source that reads from GRPC RouteTablePrivateServiceClient.
+	lw := NewListerWatcher(grpcClient, 60*time.Second)
+	// Whenever the ListerWatcher receives a Watch response, reset durationSinceLastSuccess so that
+	// the health check can detect idleness.
+	lw.OnWatchSuccess = durationSinceLastSuccess.Reset
+	informer := toolscache.NewSharedIndexInformer(lw, &routetablev1alpha1.RouteTable{}, 0, toolscache.Indexers{})
+	cache := &cache.Cache{
+		Informer: informer,
+	}
+	// Create replicator.
+	r := &Reconciler{
+		informer:                 informer,
+		cache:                    cache,
+		k8sClient:                mgr.GetClient(),
+		routeTableClient:         grpcClient,
+		sdnClient:                sdnClient,
+		durationSinceLastSuccess: durationSinceLastSuccess,
+		marshaler: &grpcruntime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				// Force fields with default values, including for enums.
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+	// Create controller.
+	controllerOptions := controller.Options{
+		Reconciler: r,
+	}
+	c, err := controller.New("routetable_reconciler", mgr, controllerOptions)
+	if err != nil {
+		return nil, err
+	}
+	// Connect sources to manager.
+	src := source.Kind(cache, &routetablev1alpha1.RouteTable{})
+	if err := c.Watch(src, &handler.EnqueueRequestForObject{},
+		predicate.Or(predicate.ResourceVersionChangedPredicate{}, predicate.AnnotationChangedPredicate{})); err != nil {
+		return nil, err
+	}
+	// Ensure manager runs informer.
+	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return r.Run(ctx)
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.AddHealthzCheck("healthz", r.Healthz); err != nil {
+		return r, fmt.Errorf("unable to set up health check: %w", err)
+	}
+	return r, nil
+}
+
+func (r *Reconciler) Run(ctx context.Context) error {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("RouteTableReconciler.Run").Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+	defer utilruntime.HandleCrash()
+	log.Info("Service running")
+	return r.cache.Start(ctx)
+}
+
+// Liveness check. Returns success (nil) if the service recently received a Watch response.
+func (r *Reconciler) Healthz(req *http.Request) error {
+	ctx := req.Context()
+	log := log.FromContext(ctx).WithName("RouteTableReconciler.Healthz")
+	lastSuccessAge := r.durationSinceLastSuccess.SinceReset()
+	log.Info("Checking health", "lastSuccessAge", lastSuccessAge)
+	if lastSuccessAge > 10*time.Second {
+		return fmt.Errorf("last success was %s ago", lastSuccessAge)
+	}
+	return nil
+}
+
+// Reconcile is called by the controller runtime when an create/update/delete event occurs
+// in the Network API Server or K8s.
+// req contains only the namespace (CloudAccountId) and name (ResourceId).
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("RouteTableReconciler.Reconcile").WithValues(logkeys.ResourceId, req.Name).Start()
+	defer span.End()
+	log.Info("Reconciling route table")
+	result, reconcileErr := func() (ctrl.Result, error) {
+		// Fetch the route table from the Network API Server (Postgres).
+		routeTable, err := r.getSourceRouteTable(ctx, req)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if routeTable == nil {
+			log.Info("Ignoring reconcile request because source route table was not found in cache")
+			return ctrl.Result{}, nil
+		}
+
+		result, processErr := func() (ctrl.Result, error) {
+			if routeTable.Metadata.DeletionTimestamp == nil {
+				return r.processRouteTable(ctx, routeTable)
+			} else {
+				return r.processDeleteRouteTable(ctx, routeTable)
+			}
+		}()
+
+		// Update the status of the resource
+		if err := r.updateStatus(ctx, routeTable); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return result, processErr
+	}()
+	if reconcileErr != nil {
+		log.Error(reconcileErr, "error reconciling route table")
+	}
+	log.Info("RouteTableReconciler.Reconcile: Completed", logkeys.Result, result, logkeys.Error, reconcileErr)
+	// If an error occurs, the controller runtime will schedule a retry.
+	return result, reconcileErr
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, routeTable *pb.RouteTablePrivate) error {
+	log := log.FromContext(ctx).WithName("RouteTableReconciler.updateStatus")
+
+	routeTableOrig, err := r.routeTableClient.GetPrivate(ctx, &pb.RouteTableGetPrivateRequest{
+		Metadata: &pb.RouteTableMetadataReference{
+			NameOrId: &pb.RouteTableMetadataReference_ResourceId{
+				ResourceId: routeTable.Metadata.ResourceId,
+			},
+			CloudAccountId: routeTable.Metadata.CloudAccountId,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Check if the current status is different than the one in the DB, if so, then update the status.
+	if routeTable.Status.Message != routeTableOrig.Status.Message ||
+		routeTable.Status.Phase != routeTableOrig.Status.Phase ||
+		!slices.Equal(routeTable.Status.AppliedSubnetIds, routeTableOrig.Status.AppliedSubnetIds) ||
+		!slices.Equal(routeTable.Status.AppliedDestinationCidrBlocks, routeTableOrig.Status.AppliedDestinationCidrBlocks) {
+
+		// Update the status of the resource.
+		// The resource version ensures that a status computed from an older spec does not overwrite a newer one.
+		_, err = r.routeTableClient.UpdateStatus(ctx, &pb.RouteTableUpdateStatusRequest{
+			Metadata: &pb.RouteTableIdReference{
+				CloudAccountId:   routeTable.Metadata.CloudAccountId,
+				ResourceId:       routeTable.Metadata.ResourceId,
+				ResourceVersion:  routeTable.Metadata.ResourceVersion,
+				DeletedTimestamp: routeTable.Metadata.DeletedTimestamp,
+			},
+			Status: routeTable.Status,
+		})
+		if status.Code(err) == codes.FailedPrecondition {
+			// The route table was changed since this reconcile started. It will be reconciled again.
+			log.Info("Ignoring status of outdated route table", logkeys.ResourceVersion, routeTable.Metadata.ResourceVersion)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) processRouteTable(ctx context.Context, routeTable *pb.RouteTablePrivate) (reconcile.Result, error) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("RouteTableReconciler.processRouteTable").WithValues(logkeys.ResourceId, routeTable.Metadata.ResourceId).Start()
+	defer span.End()
+
+	// Set status to Provisioning since it's an update.
+	routeTable.Status.Message = "Provisioning"
+	routeTable.Status.Phase = pb.RouteTablePhase_RouteTablePhase_Provisioning
+
+	// The router of the route table has the same id as the route table.
+	routerId := routeTable.Metadata.ResourceId
+	if err := helper.EnsureRouter(ctx, r.sdnClient, routerId, routeTable.Metadata.Name, routeTable.Spec.VpcId); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var subnetIds []string
+	for _, subnet := range routeTable.Spec.Subnets {
+		subnetIds = append(subnetIds, subnet.SubnetId)
+	}
+	var destinationCidrBlocks []string
+	for _, route := range routeTable.Spec.Routes {
+		destinationCidrBlocks = append(destinationCidrBlocks, route.DestinationCidrBlock)
+	}
+
+	// Remove static routes and router interfaces that were applied for a previous spec.
+	// Static routes are removed first because the next hop may only be reachable through a removed interface.
+	for _, destinationCidrBlock := range routeTable.Status.AppliedDestinationCidrBlocks {
+		if slices.Contains(destinationCidrBlocks, destinationCidrBlock) {
+			continue
+		}
+		log.Info("removing static route", "destinationCidrBlock", destinationCidrBlock)
+		if err := helper.DeleteStaticRoute(ctx, r.sdnClient, routerId, destinationCidrBlock); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	for _, subnetId := range routeTable.Status.AppliedSubnetIds {
+		if slices.Contains(subnetIds, subnetId) {
+			continue
+		}
+		log.Info("removing router interface", logkeys.SUBNET, subnetId)
+		if err := helper.DeleteRouterInterface(ctx, r.sdnClient, routerId, subnetId); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The status is stored even if the reconcile fails, so record everything that may be applied below.
+	routeTable.Status.AppliedSubnetIds = subnetIds
+	routeTable.Status.AppliedDestinationCidrBlocks = destinationCidrBlocks
+
+	for _, subnet := range routeTable.Spec.Subnets {
+		if err := helper.EnsureRouterInterface(ctx, r.sdnClient, routerId, subnet.SubnetId, subnet.InterfaceAddress); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	for _, route := range routeTable.Spec.Routes {
+		if err := helper.EnsureStaticRoute(ctx, r.sdnClient, routerId, route.DestinationCidrBlock, route.NextHopAddress); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Set status to ready
+	routeTable.Status.Message = "Route table ready"
+	routeTable.Status.Phase = pb.RouteTablePhase_RouteTablePhase_Ready
+
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) processDeleteRouteTable(ctx context.Context, routeTable *pb.RouteTablePrivate) (reconcile.Result, error) {
+	routerId := routeTable.Metadata.ResourceId
+
+	// Static routes and router interfaces must be removed before the router.
+	destinationCidrBlocks := slices.Clone(routeTable.Status.AppliedDestinationCidrBlocks)
+	for _, route := range routeTable.Spec.Routes {
+		destinationCidrBlocks = append(destinationCidrBlocks, route.DestinationCidrBlock)
+	}
+	slices.Sort(destinationCidrBlocks)
+	for _, destinationCidrBlock := range slices.Compact(destinationCidrBlocks) {
+		if err := helper.DeleteStaticRoute(ctx, r.sdnClient, routerId, destinationCidrBlock); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	subnetIds := slices.Clone(routeTable.Status.AppliedSubnetIds)
+	for _, subnet := range routeTable.Spec.Subnets {
+		subnetIds = append(subnetIds, subnet.SubnetId)
+	}
+	slices.Sort(subnetIds)
+	for _, subnetId := range slices.Compact(subnetIds) {
+		if err := helper.DeleteRouterInterface(ctx, r.sdnClient, routerId, subnetId); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if err := helper.DeleteRouter(ctx, r.sdnClient, routerId); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Update the route table in the db too
+	routeTable.Metadata.DeletedTimestamp = timestamppb.Now()
+	routeTable.Status.Message = "Deleted"
+	routeTable.Status.AppliedSubnetIds = nil
+	routeTable.Status.AppliedDestinationCidrBlocks = nil
+	routeTable.Status.Phase = pb.RouteTablePhase_RouteTablePhase_Deleted
+
+	return ctrl.Result{}, nil
+}
+
+// Get RouteTable from Network API Server.
+// Returns (nil, nil) if not found.
+func (r *Reconciler) getSourceRouteTable(ctx context.Context, req ctrl.Request) (*pb.RouteTablePrivate, error) {
+
+	cachedObject, exists, err := r.informer.GetStore().GetByKey(req.NamespacedName.String())
+	if err != nil {
+		return nil, fmt.Errorf("getSourceRouteTable error: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	routeTable, ok := cachedObject.(*routetablev1alpha1.RouteTable)
+	if !ok {
+		return nil, fmt.Errorf("getSourceRouteTable error: unexpected type of cached object")
+	}
+
+	spec := &pb.RouteTableSpecPrivate{}
+	if err := r.marshaler.Unmarshal([]byte(routeTable.Spec), spec); err != nil {
+		return nil, fmt.Errorf("RouteTableReconciler.getSourceRouteTable: Spec: %w", err)
+	}
+
+	status := &pb.RouteTableStatusPrivate{}
+	if len(routeTable.Status) > 0 {
+		if err := r.marshaler.Unmarshal([]byte(routeTable.Status), status); err != nil {
+			return nil, fmt.Errorf("RouteTableReconciler.getSourceRouteTable: Status: %w", err)
+		}
+	}
+
+	routeTablePB := &pb.RouteTablePrivate{
+		Metadata: &pb.RouteTableMetadataPrivate{
+			CloudAccountId:    routeTable.ObjectMeta.Labels["cloud-account-id"],
+			ResourceId:        routeTable.ObjectMeta.Name,
+			Name:              routeTable.ObjectMeta.Name,
+			ResourceVersion:   routeTable.ObjectMeta.ResourceVersion,
+			Labels:            routeTable.ObjectMeta.Labels,
+			CreationTimestamp: fromK8sTimestamp(&routeTable.ObjectMeta.CreationTimestamp),
+			DeletionTimestamp: fromK8sTimestamp(routeTable.ObjectMeta.DeletionTimestamp),
+		},
+		Spec:   spec,
+		Status: status,
+	}
+
+	return routeTablePB, nil
+}
+
+func fromK8sTimestamp(t *metav1.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
@@ -0,0 +1,492 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/core/cache"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/flowlog"
+	securitygroupv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/api/v1alpha1"
+	sdnv1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/tools/atomicduration"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Reconciler reconciles SecurityGroup objects from the Network API Server to the SDN controller.
+// Security rules are part of the security group spec and are applied to the SDN controller together with the attached ports.
+// See https://docs.bitnami.com/tutorials/kubewatch-an-example-of-kubernetes-custom-controller/
+// See https://komodor.com/learn/controller-manager/
+type Reconciler struct {
+	informer                 toolscache.SharedIndexInformer
+	cache                    *cache.Cache
+	k8sClient                k8sclient.Client
+	securityGroupClient      pb.SecurityGroupPrivateServiceClient
+	sdnClient                sdnv1.OvnnetClient
+	durationSinceLastSuccess *atomicduration.AtomicDuration
+	marshaler                *grpcruntime.JSONPb
+}
+
+func NewReconciler(ctx context.Context, mgr ctrl.Manager, grpcClient pb.SecurityGroupPrivateServiceClient,
+	sdnClient sdnv1.OvnnetClient) (*Reconciler, error) {
+
+	durationSinceLastSuccess := atomicduration.New()
+	// Create source that reads from GRPC SecurityGroupPrivateServiceClient.
+	lw := NewListerWatcher(grpcClient, 60*time.Second)
+	// Whenever the ListerWatcher receives a Watch response, reset durationSinceLastSuccess so that
+	// the health check can detect idleness.
+	lw.OnWatchSuccess = durationSinceLastSuccess.Reset
+	informer := toolscache.NewSharedIndexInformer(lw, &securitygroupv1alpha1.SecurityGroup{}, 0, toolscache.Indexers{})
+	cache := &cache.Cache{
+		Informer: informer,
+	}
+	// Create replicator.
+	r := &Reconciler{
+		informer:                 informer,
+		cache:                    cache,
+		k8sClient:                mgr.GetClient(),
+		securityGroupClient:      grpcClient,
+		sdnClient:                sdnClient,
+		durationSinceLastSuccess: durationSinceLastSuccess,
+		marshaler: &grpcruntime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				// Force fields with default values, including for enums.
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+	// Create controller.
+	controllerOptions := controller.Options{
+		Reconciler: r,
+	}
+	c, err := controller.New("securitygroup_reconciler", mgr, controllerOptions)
+	if err != nil {
+		return nil, err
+	}
+	// Connect sources to manager.
+	src := source.Kind(cache, &securitygroupv1alpha1.SecurityGroup{})
+	if err := c.Watch(src, &handler.EnqueueRequestForObject{},
+		predicate.Or(predicate.ResourceVersionChangedPredicate{}, predicate.AnnotationChangedPredicate{})); err != nil {
+		return nil, err
+	}
+	// Ensure manager runs informer.
+	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return r.Run(ctx)
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.AddHealthzCheck("healthz", r.Healthz); err != nil {
+		return r, fmt.Errorf("unable to set up health check: %w", err)
+	}
+	return r, nil
+}
+
+func (r *Reconciler) Run(ctx context.Context) error {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("SecurityGroupReconciler.Run").Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+	defer utilruntime.HandleCrash()
+	log.Info("Service running")
+	return r.cache.Start(ctx)
+}
+
+// Liveness check. Returns success (nil) if the service recently received a Watch response.
+func (r *Reconciler) Healthz(req *http.Request) error {
+	ctx := req.Context()
+	log := log.FromContext(ctx).WithName("SecurityGroupReconciler.Healthz")
+	lastSuccessAge := r.durationSinceLastSuccess.SinceReset()
+	log.Info("Checking health", "lastSuccessAge", lastSuccessAge)
+	if lastSuccessAge > 10*time.Second {
+		return fmt.Errorf("last success was %s ago", lastSuccessAge)
+	}
+	return nil
+}
+
+// Reconcile is called by the controller runtime when an create/update/delete event occurs
+// in the Network API Server or K8s.
+// req contains only the namespace (CloudAccountId) and name (ResourceId).
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SecurityGroupReconciler.Reconcile").WithValues(logkeys.ResourceId, req.Name).Start()
+	defer span.End()
+	log.Info("Reconciling security group")
+	result, reconcileErr := func() (ctrl.Result, error) {
+		// Fetch the security group from the Network API Server (Postgres).
+		securityGroup, err := r.getSourceSecurityGroup(ctx, req)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if securityGroup == nil {
+			log.Info("Ignoring reconcile request because source security group was not found in cache")
+			return ctrl.Result{}, nil
+		}
+
+		result, processErr := func() (ctrl.Result, error) {
+			if securityGroup.Metadata.DeletionTimestamp == nil {
+				return r.processSecurityGroup(ctx, securityGroup)
+			} else {
+				return r.processDeleteSecurityGroup(ctx, securityGroup)
+			}
+		}()
+
+		// Update the status of the resource
+		if err := r.updateStatus(ctx, securityGroup); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return result, processErr
+	}()
+	if reconcileErr != nil {
+		log.Error(reconcileErr, "error reconciling security group")
+	}
+	log.Info("SecurityGroupReconciler.Reconcile: Completed", logkeys.Result, result, logkeys.Error, reconcileErr)
+	// If an error occurs, the controller runtime will schedule a retry.
+	return result, reconcileErr
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, securityGroup *pb.SecurityGroupPrivate) error {
+	log := log.FromContext(ctx).WithName("SecurityGroupReconciler.updateStatus")
+
+	securityGroupOrig, err := r.securityGroupClient.GetPrivate(ctx, &pb.SecurityGroupGetPrivateRequest{
+		Metadata: &pb.SecurityGroupMetadataReference{
+			NameOrId: &pb.SecurityGroupMetadataReference_ResourceId{
+				ResourceId: securityGroup.Metadata.ResourceId,
+			},
+			CloudAccountId: securityGroup.Metadata.CloudAccountId,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Check if the current status is different than the one in the DB, if so, then update the status.
+	if securityGroup.Status.Message != securityGroupOrig.Status.Message ||
+		securityGroup.Status.Phase != securityGroupOrig.Status.Phase {
+
+		// Update the status of the resource.
+		// The resource version ensures that a status computed from an older spec does not overwrite a newer one.
+		_, err = r.securityGroupClient.UpdateStatus(ctx, &pb.SecurityGroupUpdateStatusRequest{
+			Metadata: &pb.SecurityGroupIdReference{
+				CloudAccountId:   securityGroup.Metadata.CloudAccountId,
+				ResourceId:       securityGroup.Metadata.ResourceId,
+				ResourceVersion:  securityGroup.Metadata.ResourceVersion,
+				DeletedTimestamp: securityGroup.Metadata.DeletedTimestamp,
+			},
+			Status: securityGroup.Status,
+		})
+		if status.Code(err) == codes.FailedPrecondition {
+			// The security group was changed since this reconcile started. It will be reconciled again.
+			log.Info("Ignoring status of outdated security group", logkeys.ResourceVersion, securityGroup.Metadata.ResourceVersion)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) processSecurityGroup(ctx context.Context, securityGroup *pb.SecurityGroupPrivate) (reconcile.Result, error) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SecurityGroupReconciler.processSecurityGroup").WithValues(logkeys.ResourceId, securityGroup.Metadata.ResourceId).Start()
+	defer span.End()
+
+	// Set status to Provisioning since it's an update.
+	securityGroup.Status.Message = "Provisioning"
+	securityGroup.Status.Phase = pb.SecurityGroupPhase_SecurityGroupPhase_Provisioning
+
+	var portIds []*sdnv1.PortId
+	for _, portId := range securityGroup.Spec.PortIds {
+		portIds = append(portIds, &sdnv1.PortId{Uuid: portId})
+	}
+
+	// Determine current security group status
+	securityGroupId := &sdnv1.SecurityGroupId{Uuid: securityGroup.Metadata.ResourceId}
+	upstreamSecurityGroup, err := r.sdnClient.GetSecurityGroup(ctx, &sdnv1.GetSecurityGroupRequest{SecurityGroupId: securityGroupId})
+	// Check if the security group exists
+	if status.Code(err) == codes.NotFound {
+		log.Info("security group does not exist, create", logkeys.SECURITY_GROUP, securityGroup.Metadata.ResourceId)
+
+		// Send request to SDN controller
+		_, err := r.sdnClient.CreateSecurityGroup(ctx, &sdnv1.CreateSecurityGroupRequest{
+			SecurityGroupId: securityGroupId,
+			Name:            securityGroup.Metadata.Name,
+			PortIds:         portIds,
+			VpcId:           &sdnv1.VPCId{Uuid: securityGroup.Spec.VpcId},
+		})
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not create security group: %v", err)
+		}
+	} else if err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not status security group: %v", err)
+	} else {
+		log.Info("security group exists, updating", logkeys.SECURITY_GROUP, securityGroup.Metadata.ResourceId)
+
+		// The update replaces the complete list of ports.
+		_, err := r.sdnClient.UpdateSecurityGroup(ctx, &sdnv1.UpdateSecurityGroupRequest{
+			SecurityGroupId: securityGroupId,
+			PortIds:         portIds,
+		})
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not update security group: %v", err)
+		}
+	}
+
+	// Rules reference the security group, so they are created once the security group exists.
+	var ruleIds []*sdnv1.SecurityRuleId
+	for _, rule := range securityGroup.Spec.Rules {
+		ruleId := &sdnv1.SecurityRuleId{Uuid: rule.Metadata.ResourceId}
+		createReq, err := toSdnSecurityRule(securityGroup, rule)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		upstreamRule, err := r.sdnClient.GetSecurityRule(ctx, &sdnv1.GetSecurityRuleRequest{SecurityRuleId: ruleId})
+		if status.Code(err) == codes.NotFound {
+			log.Info("security rule does not exist, create", logkeys.SECURITY_RULE, rule.Metadata.ResourceId)
+			if _, err := r.sdnClient.CreateSecurityRule(ctx, createReq); err != nil {
+				return ctrl.Result{}, fmt.Errorf("could not create security rule: %v", err)
+			}
+		} else if err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not status security rule: %v", err)
+		} else if !equalLogging(upstreamRule.GetSecurityRule().GetLogging(), createReq.Logging) {
+			// Flow logging can be enabled and disabled after the rule is created.
+			log.Info("security rule logging changed, updating", logkeys.SECURITY_RULE, rule.Metadata.ResourceId)
+			if _, err := r.sdnClient.UpdateSecurityRule(ctx, toSdnSecurityRuleUpdate(createReq)); err != nil {
+				return ctrl.Result{}, fmt.Errorf("could not update security rule: %v", err)
+			}
+		}
+		ruleIds = append(ruleIds, ruleId)
+	}
+
+	// Remove rules that are no longer referenced by the security group.
+	wanted := make(map[string]bool)
+	for _, ruleId := range ruleIds {
+		wanted[ruleId.Uuid] = true
+	}
+	for _, ruleId := range upstreamSecurityGroup.GetSecurityGroup().GetSecurityRuleIds() {
+		if wanted[ruleId.Uuid] {
+			continue
+		}
+		log.Info("removing security rule", logkeys.SECURITY_RULE, ruleId.Uuid)
+		if err := r.deleteSecurityRule(ctx, ruleId); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Set status to ready
+	securityGroup.Status.Message = "Security group ready"
+	securityGroup.Status.Phase = pb.SecurityGroupPhase_SecurityGroupPhase_Ready
+
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) processDeleteSecurityGroup(ctx context.Context, securityGroup *pb.SecurityGroupPrivate) (reconcile.Result, error) {
+	securityGroupId := &sdnv1.SecurityGroupId{Uuid: securityGroup.Metadata.ResourceId}
+
+	// Rules can only be deleted once they are no longer referenced by the security group.
+	var ruleIds []*sdnv1.SecurityRuleId
+	upstreamSecurityGroup, err := r.sdnClient.GetSecurityGroup(ctx, &sdnv1.GetSecurityGroupRequest{SecurityGroupId: securityGroupId})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return ctrl.Result{}, fmt.Errorf("could not status security group: %v", err)
+	}
+	ruleIds = append(ruleIds, upstreamSecurityGroup.GetSecurityGroup().GetSecurityRuleIds()...)
+
+	// remove security group from sdn.
+	_, err = r.sdnClient.DeleteSecurityGroup(ctx, &sdnv1.DeleteSecurityGroupRequest{
+		SecurityGroupId: securityGroupId,
+	})
+	if err != nil {
+		// If the security group is not found, it is already deleted in sdn.
+		// therefor, ignore the error and continue.
+		if status.Code(err) != codes.NotFound {
+			return ctrl.Result{}, fmt.Errorf("could not delete security group: %v", err)
+		}
+	}
+
+	for _, rule := range securityGroup.Spec.Rules {
+		ruleIds = append(ruleIds, &sdnv1.SecurityRuleId{Uuid: rule.Metadata.ResourceId})
+	}
+	for _, ruleId := range ruleIds {
+		if err := r.deleteSecurityRule(ctx, ruleId); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Update the security group in the db too
+	securityGroup.Metadata.DeletedTimestamp = timestamppb.Now()
+	securityGroup.Status.Message = "Deleted"
+	securityGroup.Status.Phase = pb.SecurityGroupPhase_SecurityGroupPhase_Deleted
+
+	return ctrl.Result{}, nil
+}
+
+// Delete a security rule from the SDN controller. A rule that does not exist is ignored.
+func (r *Reconciler) deleteSecurityRule(ctx context.Context, ruleId *sdnv1.SecurityRuleId) error {
+	_, err := r.sdnClient.DeleteSecurityRule(ctx, &sdnv1.DeleteSecurityRuleRequest{
+		SecurityRuleId: ruleId,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("could not delete security rule: %v", err)
+	}
+	return nil
+}
+
+// Convert a security rule to an SDN controller request.
+// The remote CIDRs are the source of ingress rules and the destination of egress rules.
+// The port range always applies to the destination port.
+// A rule is logged if flow logging is enabled for the rule or for its security group.
+func toSdnSecurityRule(securityGroup *pb.SecurityGroupPrivate, rule *pb.SecurityRulePrivate) (*sdnv1.CreateSecurityRuleRequest, error) {
+	spec := rule.Spec
+	req := &sdnv1.CreateSecurityRuleRequest{
+		SecurityRuleId:  &sdnv1.SecurityRuleId{Uuid: rule.Metadata.ResourceId},
+		Name:            rule.Metadata.Name,
+		SecurityGroupId: &sdnv1.SecurityGroupId{Uuid: securityGroup.Metadata.ResourceId},
+		Priority:        spec.Priority,
+		VpcId:           &sdnv1.VPCId{Uuid: securityGroup.Spec.VpcId},
+	}
+
+	if spec.FlowLogging || securityGroup.Spec.FlowLogging {
+		// The logging name allows the flow log collector to map log records to the rule.
+		loggingName, err := flowlog.LoggingName(securityGroup.Metadata.CloudAccountId, securityGroup.Metadata.ResourceId, rule.Metadata.ResourceId)
+		if err != nil {
+			return nil, fmt.Errorf("could not enable flow logging for security rule %s: %v", rule.Metadata.ResourceId, err)
+		}
+		req.Logging = &sdnv1.SecurityRuleLogging{
+			Enabled: true,
+			Name:    loggingName,
+		}
+	}
+
+	switch spec.Direction {
+	case pb.SecurityRuleDirection_SecurityRuleDirection_Ingress:
+		req.Direction = sdnv1.Direction_INGRESS
+		req.Source_IPAddresses = spec.RemoteCidrs
+	case pb.SecurityRuleDirection_SecurityRuleDirection_Egress:
+		req.Direction = sdnv1.Direction_EGRESS
+		req.Destination_IPAddresses = spec.RemoteCidrs
+	}
+
+	switch spec.Action {
+	case pb.SecurityRuleAction_SecurityRuleAction_Allow:
+		req.Action = sdnv1.SecurityAction_ALLOW
+	case pb.SecurityRuleAction_SecurityRuleAction_Deny:
+		req.Action = sdnv1.SecurityAction_DENY
+	}
+
+	// Protocol is omitted to match any protocol.
+	switch spec.Protocol {
+	case pb.SecurityRuleProtocol_SecurityRuleProtocol_TCP:
+		req.Protocol = sdnv1.Protocol_TCP.Enum()
+	case pb.SecurityRuleProtocol_SecurityRuleProtocol_UDP:
+		req.Protocol = sdnv1.Protocol_UDP.Enum()
+	case pb.SecurityRuleProtocol_SecurityRuleProtocol_ICMP:
+		req.Protocol = sdnv1.Protocol_ICMP.Enum()
+	}
+
+	if spec.PortRangeMin != 0 {
+		req.DestinationPortRange = &sdnv1.PortRange{
+			Min: spec.PortRangeMin,
+			Max: spec.PortRangeMax,
+		}
+	}
+
+	return req, nil
+}
+
+// Convert a create request to an update request of the same security rule.
+func toSdnSecurityRuleUpdate(req *sdnv1.CreateSecurityRuleRequest) *sdnv1.UpdateSecurityRuleRequest {
+	return &sdnv1.UpdateSecurityRuleRequest{
+		SecurityRuleId:          req.SecurityRuleId,
+		Priority:                req.Priority,
+		Direction:               req.Direction,
+		Source_IPAddresses:      req.Source_IPAddresses,
+		Destination_IPAddresses: req.Destination_IPAddresses,
+		Protocol:                req.Protocol,
+		SourcePortRange:         req.SourcePortRange,
+		DestinationPortRange:    req.DestinationPortRange,
+		Action:                  req.Action,
+		Logging:                 req.Logging,
+	}
+}
+
+// Returns true if both logging parameters are equal. Nil is equal to disabled logging.
+func equalLogging(a *sdnv1.SecurityRuleLogging, b *sdnv1.SecurityRuleLogging) bool {
+	return a.GetEnabled() == b.GetEnabled() && (!a.GetEnabled() || a.GetName() == b.GetName())
+}
+
+// Get SecurityGroup from Network API Server.
+// Returns (nil, nil) if not found.
+func (r *Reconciler) getSourceSecurityGroup(ctx context.Context, req ctrl.Request) (*pb.SecurityGroupPrivate, error) {
+
+	cachedObject, exists, err := r.informer.GetStore().GetByKey(req.NamespacedName.String())
+	if err != nil {
+		return nil, fmt.Errorf("getSourceSecurityGroup error: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	securityGroup, ok := cachedObject.(*securitygroupv1alpha1.SecurityGroup)
+	if !ok {
+		return nil, fmt.Errorf("getSourceSecurityGroup error: unexpected type of cached object")
+	}
+
+	spec := &pb.SecurityGroupSpecPrivate{}
+	if err := r.marshaler.Unmarshal([]byte(securityGroup.Spec), spec); err != nil {
+		return nil, fmt.Errorf("SecurityGroupReconciler.getSourceSecurityGroup: Spec: %w", err)
+	}
+
+	status := &pb.SecurityGroupStatusPrivate{}
+	if len(securityGroup.Status) > 0 {
+		if err := r.marshaler.Unmarshal([]byte(securityGroup.Status), status); err != nil {
+			return nil, fmt.Errorf("SecurityGroupReconciler.getSourceSecurityGroup: Status: %w", err)
+		}
+	}
+
+	securityGroupPB := &pb.SecurityGroupPrivate{
+		Metadata: &pb.SecurityGroupMetadataPrivate{
+			CloudAccountId:    securityGroup.ObjectMeta.Labels["cloud-account-id"],
+			ResourceId:        securityGroup.ObjectMeta.Name,
+			Name:              securityGroup.ObjectMeta.Name,
+			ResourceVersion:   securityGroup.ObjectMeta.ResourceVersion,
+			Labels:            securityGroup.ObjectMeta.Labels,
+			CreationTimestamp: fromK8sTimestamp(&securityGroup.ObjectMeta.CreationTimestamp),
+			DeletionTimestamp: fromK8sTimestamp(securityGroup.ObjectMeta.DeletionTimestamp),
+		},
+		Spec:   spec,
+		Status: status,
+	}
+
+	return securityGroupPB, nil
+}
+
+func fromK8sTimestamp(t *metav1.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
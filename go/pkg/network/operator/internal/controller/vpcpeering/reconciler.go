@@ -0,0 +1,331 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package vpcpeering
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/core/cache"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	vpcpeeringv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/api/v1alpha1"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/helper"
+	sdnv1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/tools/atomicduration"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Reconciler reconciles VPCPeering objects from the Network API Server to the SDN controller.
+// A VPC peering is implemented by a router with an interface in the attachment subnet of each VPC.
+// See https://docs.bitnami.com/tutorials/kubewatch-an-example-of-kubernetes-custom-controller/
+// See https://komodor.com/learn/controller-manager/
+type Reconciler struct {
+	informer                 toolscache.SharedIndexInformer
+	cache                    *cache.Cache
+	k8sClient                k8sclient.Client
+	vpcPeeringClient         pb.VPCPeeringPrivateServiceClient
+	sdnClient                sdnv1.OvnnetClient
+	durationSinceLastSuccess *atomicduration.AtomicDuration
+	marshaler                *grpcruntime.JSONPb
+}
+
+func NewReconciler(ctx context.Context, mgr ctrl.Manager, grpcClient pb.VPCPeeringPrivateServiceClient,
+	sdnClient sdnv1.OvnnetClient) (*Reconciler, error) {
+
+	durationSinceLastSuccess := atomicduration.New()
+	// Create source that reads from GRPC VPCPeeringPrivateServiceClient.
+	lw := NewListerWatcher(grpcClient, 60*time.Second)
+	// Whenever the ListerWatcher receives a Watch response, reset durationSinceLastSuccess so that
+	// the health check can detect idleness.
+	lw.OnWatchSuccess = durationSinceLastSuccess.Reset
+	informer := toolscache.NewSharedIndexInformer(lw, &vpcpeeringv1alpha1.VPCPeering{}, 0, toolscache.Indexers{})
+	cache := &cache.Cache{
+		Informer: informer,
+	}
+	// Create replicator.
+	r := &Reconciler{
+		informer:                 informer,
+		cache:                    cache,
+		k8sClient:                mgr.GetClient(),
+		vpcPeeringClient:         grpcClient,
+		sdnClient:                sdnClient,
+		durationSinceLastSuccess: durationSinceLastSuccess,
+		marshaler: &grpcruntime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				// Force fields with default values, including for enums.
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+	// Create controller.
+	controllerOptions := controller.Options{
+		Reconciler: r,
+	}
+	c, err := controller.New("vpcpeering_reconciler", mgr, controllerOptions)
+	if err != nil {
+		return nil, err
+	}
+	// Connect sources to manager.
+	src := source.Kind(cache, &vpcpeeringv1alpha1.VPCPeering{})
+	if err := c.Watch(src, &handler.EnqueueRequestForObject{},
+		predicate.Or(predicate.ResourceVersionChangedPredicate{}, predicate.AnnotationChangedPredicate{})); err != nil {
+		return nil, err
+	}
+	// Ensure manager runs informer.
+	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return r.Run(ctx)
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.AddHealthzCheck("healthz", r.Healthz); err != nil {
+		return r, fmt.Errorf("unable to set up health check: %w", err)
+	}
+	return r, nil
+}
+
+func (r *Reconciler) Run(ctx context.Context) error {
+	ctx, log, span := obs.LogAndSpanFromContext(ctx).WithName("VPCPeeringReconciler.Run").Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+	defer utilruntime.HandleCrash()
+	log.Info("Service running")
+	return r.cache.Start(ctx)
+}
+
+// Liveness check. Returns success (nil) if the service recently received a Watch response.
+func (r *Reconciler) Healthz(req *http.Request) error {
+	ctx := req.Context()
+	log := log.FromContext(ctx).WithName("VPCPeeringReconciler.Healthz")
+	lastSuccessAge := r.durationSinceLastSuccess.SinceReset()
+	log.Info("Checking health", "lastSuccessAge", lastSuccessAge)
+	if lastSuccessAge > 10*time.Second {
+		return fmt.Errorf("last success was %s ago", lastSuccessAge)
+	}
+	return nil
+}
+
+// Reconcile is called by the controller runtime when an create/update/delete event occurs
+// in the Network API Server or K8s.
+// req contains only the namespace (CloudAccountId) and name (ResourceId).
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("VPCPeeringReconciler.Reconcile").WithValues(logkeys.ResourceId, req.Name).Start()
+	defer span.End()
+	log.Info("Reconciling VPC peering")
+	result, reconcileErr := func() (ctrl.Result, error) {
+		// Fetch the VPC peering from the Network API Server (Postgres).
+		vpcPeering, err := r.getSourceVPCPeering(ctx, req)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if vpcPeering == nil {
+			log.Info("Ignoring reconcile request because source VPC peering was not found in cache")
+			return ctrl.Result{}, nil
+		}
+
+		result, processErr := func() (ctrl.Result, error) {
+			if vpcPeering.Metadata.DeletionTimestamp == nil {
+				return r.processVPCPeering(ctx, vpcPeering)
+			} else {
+				return r.processDeleteVPCPeering(ctx, vpcPeering)
+			}
+		}()
+
+		// Update the status of the resource
+		if err := r.updateStatus(ctx, vpcPeering); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return result, processErr
+	}()
+	if reconcileErr != nil {
+		log.Error(reconcileErr, "error reconciling VPC peering")
+	}
+	log.Info("VPCPeeringReconciler.Reconcile: Completed", logkeys.Result, result, logkeys.Error, reconcileErr)
+	// If an error occurs, the controller runtime will schedule a retry.
+	return result, reconcileErr
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, vpcPeering *pb.VPCPeeringPrivate) error {
+	log := log.FromContext(ctx).WithName("VPCPeeringReconciler.updateStatus")
+
+	vpcPeeringOrig, err := r.vpcPeeringClient.GetPrivate(ctx, &pb.VPCPeeringGetPrivateRequest{
+		Metadata: &pb.VPCPeeringMetadataReference{
+			CloudAccountId: vpcPeering.Metadata.CloudAccountId,
+			ResourceId:     vpcPeering.Metadata.ResourceId,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Check if the current status is different than the one in the DB, if so, then update the status.
+	if vpcPeering.Status.Message != vpcPeeringOrig.Status.Message ||
+		vpcPeering.Status.Phase != vpcPeeringOrig.Status.Phase {
+
+		// Update the status of the resource.
+		// The resource version ensures that a status computed from an older spec does not overwrite a newer one.
+		_, err = r.vpcPeeringClient.UpdateStatus(ctx, &pb.VPCPeeringUpdateStatusRequest{
+			Metadata: &pb.VPCPeeringIdReference{
+				CloudAccountId:   vpcPeering.Metadata.CloudAccountId,
+				ResourceId:       vpcPeering.Metadata.ResourceId,
+				ResourceVersion:  vpcPeering.Metadata.ResourceVersion,
+				DeletedTimestamp: vpcPeering.Metadata.DeletedTimestamp,
+			},
+			Status: vpcPeering.Status,
+		})
+		if status.Code(err) == codes.FailedPrecondition {
+			// The VPC peering was changed since this reconcile started. It will be reconciled again.
+			log.Info("Ignoring status of outdated VPC peering", logkeys.ResourceVersion, vpcPeering.Metadata.ResourceVersion)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) processVPCPeering(ctx context.Context, vpcPeering *pb.VPCPeeringPrivate) (reconcile.Result, error) {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("VPCPeeringReconciler.processVPCPeering").WithValues(logkeys.ResourceId, vpcPeering.Metadata.ResourceId).Start()
+	defer span.End()
+
+	// Nothing is applied to the SDN controller until the VPC peering has been accepted.
+	if vpcPeering.Status.Phase == pb.VPCPeeringPhase_VPCPeeringPhase_PendingAcceptance ||
+		vpcPeering.Status.Phase == pb.VPCPeeringPhase_VPCPeeringPhase_Rejected {
+		log.Info("Ignoring VPC peering that has not been accepted", logkeys.StatusPhase, vpcPeering.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+
+	// Set status to Provisioning since it's an update.
+	vpcPeering.Status.Message = "Provisioning"
+	vpcPeering.Status.Phase = pb.VPCPeeringPhase_VPCPeeringPhase_Provisioning
+
+	// The router of the VPC peering has the same id as the VPC peering.
+	routerId := vpcPeering.Metadata.ResourceId
+	if err := helper.EnsureRouter(ctx, r.sdnClient, routerId, vpcPeering.Metadata.Name, vpcPeering.Spec.Requester.VpcId); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The router forwards traffic for each VPC to the gateway of the attachment subnet in that VPC.
+	for _, attachment := range []*pb.VPCPeeringAttachmentPrivate{vpcPeering.Spec.Requester, vpcPeering.Spec.Accepter} {
+		log.Info("applying attachment", logkeys.VPC, attachment.VpcId, logkeys.SUBNET, attachment.SubnetId)
+		if err := helper.EnsureRouterInterface(ctx, r.sdnClient, routerId, attachment.SubnetId, attachment.InterfaceAddress); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := helper.EnsureStaticRoute(ctx, r.sdnClient, routerId, attachment.VpcCidrBlock, attachment.GatewayAddress); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Set status to active
+	vpcPeering.Status.Message = "VPC peering active"
+	vpcPeering.Status.Phase = pb.VPCPeeringPhase_VPCPeeringPhase_Active
+
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) processDeleteVPCPeering(ctx context.Context, vpcPeering *pb.VPCPeeringPrivate) (reconcile.Result, error) {
+	routerId := vpcPeering.Metadata.ResourceId
+
+	// Static routes and router interfaces must be removed before the router.
+	// A VPC peering that was never accepted has no accepter subnet and nothing in the SDN controller.
+	for _, attachment := range []*pb.VPCPeeringAttachmentPrivate{vpcPeering.Spec.Requester, vpcPeering.Spec.Accepter} {
+		if attachment.GetVpcCidrBlock() != "" {
+			if err := helper.DeleteStaticRoute(ctx, r.sdnClient, routerId, attachment.VpcCidrBlock); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if attachment.GetSubnetId() != "" {
+			if err := helper.DeleteRouterInterface(ctx, r.sdnClient, routerId, attachment.SubnetId); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+	if err := helper.DeleteRouter(ctx, r.sdnClient, routerId); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Update the VPC peering in the db too
+	vpcPeering.Metadata.DeletedTimestamp = timestamppb.Now()
+	vpcPeering.Status.Message = "Deleted"
+	vpcPeering.Status.Phase = pb.VPCPeeringPhase_VPCPeeringPhase_Deleted
+
+	return ctrl.Result{}, nil
+}
+
+// Get VPCPeering from Network API Server.
+// Returns (nil, nil) if not found.
+func (r *Reconciler) getSourceVPCPeering(ctx context.Context, req ctrl.Request) (*pb.VPCPeeringPrivate, error) {
+
+	cachedObject, exists, err := r.informer.GetStore().GetByKey(req.NamespacedName.String())
+	if err != nil {
+		return nil, fmt.Errorf("getSourceVPCPeering error: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	vpcPeering, ok := cachedObject.(*vpcpeeringv1alpha1.VPCPeering)
+	if !ok {
+		return nil, fmt.Errorf("getSourceVPCPeering error: unexpected type of cached object")
+	}
+
+	spec := &pb.VPCPeeringSpecPrivate{}
+	if err := r.marshaler.Unmarshal([]byte(vpcPeering.Spec), spec); err != nil {
+		return nil, fmt.Errorf("VPCPeeringReconciler.getSourceVPCPeering: Spec: %w", err)
+	}
+
+	status := &pb.VPCPeeringStatusPrivate{}
+	if len(vpcPeering.Status) > 0 {
+		if err := r.marshaler.Unmarshal([]byte(vpcPeering.Status), status); err != nil {
+			return nil, fmt.Errorf("VPCPeeringReconciler.getSourceVPCPeering: Status: %w", err)
+		}
+	}
+
+	vpcPeeringPB := &pb.VPCPeeringPrivate{
+		Metadata: &pb.VPCPeeringMetadataPrivate{
+			CloudAccountId:    vpcPeering.ObjectMeta.Labels["cloud-account-id"],
+			ResourceId:        vpcPeering.ObjectMeta.Name,
+			Name:              vpcPeering.ObjectMeta.Name,
+			ResourceVersion:   vpcPeering.ObjectMeta.ResourceVersion,
+			Labels:            vpcPeering.ObjectMeta.Labels,
+			CreationTimestamp: fromK8sTimestamp(&vpcPeering.ObjectMeta.CreationTimestamp),
+			DeletionTimestamp: fromK8sTimestamp(vpcPeering.ObjectMeta.DeletionTimestamp),
+		},
+		Spec:   spec,
+		Status: status,
+	}
+
+	return vpcPeeringPB, nil
+}
+
+func fromK8sTimestamp(t *metav1.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
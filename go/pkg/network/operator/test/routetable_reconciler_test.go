@@ -0,0 +1,103 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Route table reconciler", func() {
+	ctx := context.Background()
+	defer GinkgoRecover()
+
+	BeforeEach(func() {
+		clearDatabase(ctx)
+	})
+
+	getRouteTablePrivate := func(cloudAccountId string, resourceId string) (*pb.RouteTablePrivate, error) {
+		return routeTablePrivateClient.GetPrivate(ctx, &pb.RouteTableGetPrivateRequest{
+			Metadata: &pb.RouteTableMetadataReference{
+				CloudAccountId: cloudAccountId,
+				NameOrId:       &pb.RouteTableMetadataReference_ResourceId{ResourceId: resourceId},
+			},
+		})
+	}
+
+	Context("Route table integration tests", func() {
+		It("Reconciles a route table update and delete", func() {
+			cloudaccountId, err := NewCloudAcctId()
+			Expect(err).Should(Succeed())
+
+			vpcA, subnetA := createVPCAndSubnetWithCidrBlock(ctx, cloudaccountId, "10.0.0.0/24")
+			vpcB, subnetB := createVPCAndSubnetWithCidrBlock(ctx, cloudaccountId, "10.1.0.0/24")
+			vpcPeering := createVPCPeering(ctx, cloudaccountId, vpcA, subnetA, vpcB, subnetB)
+
+			routeTable, err := routeTableServiceClient.Create(ctx, &pb.RouteTableCreateRequest{
+				Metadata: &pb.RouteTableMetadataCreate{
+					CloudAccountId: cloudaccountId,
+					Name:           uuid.NewString(),
+				},
+				Spec: &pb.RouteTableSpec{
+					VpcId:     vpcA.Metadata.ResourceId,
+					SubnetIds: []string{subnetA.Metadata.ResourceId},
+					Routes: []*pb.Route{{
+						DestinationCidrBlock: "10.1.0.0/24",
+						Target:               &pb.Route_VpcPeeringId{VpcPeeringId: vpcPeering.Metadata.ResourceId},
+					}},
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			By("Waiting for route table to be applied in SDN")
+			Eventually(func(g Gomega) {
+				got, err := getRouteTablePrivate(cloudaccountId, routeTable.Metadata.ResourceId)
+				g.Expect(err).Should(Succeed())
+				g.Expect(got.Status.Phase).Should(Equal(pb.RouteTablePhase_RouteTablePhase_Ready))
+				g.Expect(got.Status.Message).Should(Equal("Route table ready"))
+				g.Expect(got.Status.AppliedSubnetIds).Should(Equal([]string{subnetA.Metadata.ResourceId}))
+				g.Expect(got.Status.AppliedDestinationCidrBlocks).Should(Equal([]string{"10.1.0.0/24"}))
+			}, timeout).Should(Succeed())
+
+			By("Removing the routes")
+			_, err = routeTableServiceClient.Update(ctx, &pb.RouteTableUpdateRequest{
+				Metadata: &pb.RouteTableMetadataUpdate{
+					CloudAccountId: cloudaccountId,
+					ResourceId:     routeTable.Metadata.ResourceId,
+				},
+				Spec: &pb.RouteTableSpecUpdate{
+					SubnetIds: []string{subnetA.Metadata.ResourceId},
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				got, err := getRouteTablePrivate(cloudaccountId, routeTable.Metadata.ResourceId)
+				g.Expect(err).Should(Succeed())
+				g.Expect(got.Status.Phase).Should(Equal(pb.RouteTablePhase_RouteTablePhase_Ready))
+				g.Expect(got.Status.AppliedDestinationCidrBlocks).Should(BeEmpty())
+			}, timeout).Should(Succeed())
+
+			By("Deleting route table")
+			_, err = routeTableServiceClient.Delete(ctx, &pb.RouteTableDeleteRequest{
+				Metadata: &pb.RouteTableMetadataReference{
+					CloudAccountId: cloudaccountId,
+					NameOrId:       &pb.RouteTableMetadataReference_ResourceId{ResourceId: routeTable.Metadata.ResourceId},
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			By("Waiting for route table to be deleted in SDN")
+			Eventually(func(g Gomega) {
+				_, err := getRouteTablePrivate(cloudaccountId, routeTable.Metadata.ResourceId)
+				g.Expect(status.Code(err)).Should(Equal(codes.NotFound))
+			}, timeout).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,127 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Security group reconciler", func() {
+	ctx := context.Background()
+	defer GinkgoRecover()
+
+	BeforeEach(func() {
+		clearDatabase(ctx)
+	})
+
+	getSecurityGroupPrivate := func(cloudAccountId string, resourceId string) (*pb.SecurityGroupPrivate, error) {
+		return securityGroupPrivateClient.GetPrivate(ctx, &pb.SecurityGroupGetPrivateRequest{
+			Metadata: &pb.SecurityGroupMetadataReference{
+				CloudAccountId: cloudAccountId,
+				NameOrId:       &pb.SecurityGroupMetadataReference_ResourceId{ResourceId: resourceId},
+			},
+		})
+	}
+
+	Context("Security group integration tests", func() {
+		It("Reconciles a new security group and its rules", func() {
+			cloudaccountId, err := NewCloudAcctId()
+			Expect(err).Should(Succeed())
+
+			vpc, _, err := createVPCAndSubnet(ctx, cloudaccountId, uuid.NewString())
+			Expect(err).Should(Succeed())
+
+			securityGroup, err := securityGroupServiceClient.Create(ctx, &pb.SecurityGroupCreateRequest{
+				Metadata: &pb.SecurityGroupMetadataCreate{
+					CloudAccountId: cloudaccountId,
+					Name:           uuid.NewString(),
+				},
+				Spec: &pb.SecurityGroupSpec{
+					VpcId: vpc.Metadata.ResourceId,
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			By("Waiting for security group to be created in SDN")
+			Eventually(func(g Gomega) {
+				got, err := getSecurityGroupPrivate(cloudaccountId, securityGroup.Metadata.ResourceId)
+				g.Expect(err).Should(Succeed())
+				g.Expect(got.Status.Phase).Should(Equal(pb.SecurityGroupPhase_SecurityGroupPhase_Ready))
+				g.Expect(got.Status.Message).Should(Equal("Security group ready"))
+			}, timeout).Should(Succeed())
+
+			By("Adding a security rule")
+			rule, err := securityRuleServiceClient.Create(ctx, &pb.SecurityRuleCreateRequest{
+				Metadata: &pb.SecurityRuleMetadataCreate{
+					CloudAccountId: cloudaccountId,
+				},
+				Spec: &pb.SecurityRuleSpec{
+					SecurityGroupId: securityGroup.Metadata.ResourceId,
+					Direction:       pb.SecurityRuleDirection_SecurityRuleDirection_Ingress,
+					Protocol:        pb.SecurityRuleProtocol_SecurityRuleProtocol_TCP,
+					RemoteCidrs:     []string{"10.0.0.0/8"},
+					PortRangeMin:    22,
+					Priority:        100,
+				},
+			})
+			Expect(err).Should(Succeed())
+			Expect(rule.Status.Phase).Should(Equal(pb.SecurityGroupPhase_SecurityGroupPhase_Provisioning))
+
+			By("Waiting for security rule to be applied in SDN")
+			Eventually(func(g Gomega) {
+				got, err := getSecurityGroupPrivate(cloudaccountId, securityGroup.Metadata.ResourceId)
+				g.Expect(err).Should(Succeed())
+				g.Expect(got.Spec.Rules).Should(HaveLen(1))
+				g.Expect(got.Status.Phase).Should(Equal(pb.SecurityGroupPhase_SecurityGroupPhase_Ready))
+			}, timeout).Should(Succeed())
+		})
+
+		It("Reconciles a security group delete", func() {
+			cloudaccountId, err := NewCloudAcctId()
+			Expect(err).Should(Succeed())
+
+			vpc, _, err := createVPCAndSubnet(ctx, cloudaccountId, uuid.NewString())
+			Expect(err).Should(Succeed())
+
+			securityGroup, err := securityGroupServiceClient.Create(ctx, &pb.SecurityGroupCreateRequest{
+				Metadata: &pb.SecurityGroupMetadataCreate{
+					CloudAccountId: cloudaccountId,
+					Name:           uuid.NewString(),
+				},
+				Spec: &pb.SecurityGroupSpec{
+					VpcId: vpc.Metadata.ResourceId,
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			By("Waiting for security group to be created in SDN")
+			Eventually(func(g Gomega) {
+				got, err := getSecurityGroupPrivate(cloudaccountId, securityGroup.Metadata.ResourceId)
+				g.Expect(err).Should(Succeed())
+				g.Expect(got.Status.Phase).Should(Equal(pb.SecurityGroupPhase_SecurityGroupPhase_Ready))
+			}, timeout).Should(Succeed())
+
+			By("Deleting security group")
+			_, err = securityGroupServiceClient.Delete(ctx, &pb.SecurityGroupDeleteRequest{
+				Metadata: &pb.SecurityGroupMetadataReference{
+					CloudAccountId: cloudaccountId,
+					NameOrId:       &pb.SecurityGroupMetadataReference_ResourceId{ResourceId: securityGroup.Metadata.ResourceId},
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			By("Waiting for security group to be deleted in SDN")
+			Eventually(func(g Gomega) {
+				_, err := getSecurityGroupPrivate(cloudaccountId, securityGroup.Metadata.ResourceId)
+				g.Expect(status.Code(err)).Should(Equal(codes.NotFound))
+			}, timeout).Should(Succeed())
+		})
+	})
+})
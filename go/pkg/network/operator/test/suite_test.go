@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/routetable"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/securitygroup"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/subnet"
 
 	"github.com/golang/mock/gomock"
@@ -34,6 +36,7 @@ import (
 	vpcv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/api/v1alpha1"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/iprm"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/vpc"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/operator/internal/controller/vpcpeering"
 	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn/mock"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
@@ -71,6 +74,13 @@ var (
 	subnetServiceClient        pb.SubnetServiceClient
 	subnetPrivateServiceClient pb.SubnetPrivateServiceClient
 	iprmPrivateServiceClient   pb.IPRMPrivateServiceClient
+	securityGroupServiceClient pb.SecurityGroupServiceClient
+	securityGroupPrivateClient pb.SecurityGroupPrivateServiceClient
+	securityRuleServiceClient  pb.SecurityRuleServiceClient
+	vpcPeeringServiceClient    pb.VPCPeeringServiceClient
+	vpcPeeringPrivateClient    pb.VPCPeeringPrivateServiceClient
+	routeTableServiceClient    pb.RouteTableServiceClient
+	routeTablePrivateClient    pb.RouteTablePrivateServiceClient
 	sdnServiceClient           *v1.MockOvnnetClient
 	managerStoppable           *stoppable.Stoppable
 	poll                       time.Duration = 10 * time.Millisecond
@@ -117,7 +127,7 @@ var _ = BeforeSuite(func() {
 
 	grpcService, err = server.New(ctx, &config.Config{
 		Region: region,
-	}, managedDb, grpcServerListener, cloudAccountService, availabilityZones)
+	}, managedDb, grpcServerListener, cloudAccountService, nil, availabilityZones)
 	Expect(err).Should(Succeed())
 	Expect(grpcService.Start(ctx)).Should(Succeed())
 
@@ -130,6 +140,13 @@ var _ = BeforeSuite(func() {
 	subnetServiceClient = pb.NewSubnetServiceClient(clientConn)
 	subnetPrivateServiceClient = pb.NewSubnetPrivateServiceClient(clientConn)
 	iprmPrivateServiceClient = pb.NewIPRMPrivateServiceClient(clientConn)
+	securityGroupServiceClient = pb.NewSecurityGroupServiceClient(clientConn)
+	securityGroupPrivateClient = pb.NewSecurityGroupPrivateServiceClient(clientConn)
+	securityRuleServiceClient = pb.NewSecurityRuleServiceClient(clientConn)
+	vpcPeeringServiceClient = pb.NewVPCPeeringServiceClient(clientConn)
+	vpcPeeringPrivateClient = pb.NewVPCPeeringPrivateServiceClient(clientConn)
+	routeTableServiceClient = pb.NewRouteTableServiceClient(clientConn)
+	routeTablePrivateClient = pb.NewRouteTablePrivateServiceClient(clientConn)
 
 	By("Pinging VPC service until it comes up")
 	Eventually(func(g Gomega) {
@@ -152,6 +169,27 @@ var _ = BeforeSuite(func() {
 	}, "10s", "1s").Should(Succeed())
 	By("IPRM Private Service is ready")
 
+	By("Pinging Security Group service until it comes up")
+	Eventually(func(g Gomega) {
+		_, err := securityGroupPrivateClient.PingPrivate(ctx, &emptypb.Empty{})
+		g.Expect(err).Should(Succeed())
+	}, "10s", "1s").Should(Succeed())
+	By("Security Group Private Service is ready")
+
+	By("Pinging VPC Peering service until it comes up")
+	Eventually(func(g Gomega) {
+		_, err := vpcPeeringPrivateClient.PingPrivate(ctx, &emptypb.Empty{})
+		g.Expect(err).Should(Succeed())
+	}, "10s", "1s").Should(Succeed())
+	By("VPC Peering Private Service is ready")
+
+	By("Pinging Route Table service until it comes up")
+	Eventually(func(g Gomega) {
+		_, err := routeTablePrivateClient.PingPrivate(ctx, &emptypb.Empty{})
+		g.Expect(err).Should(Succeed())
+	}, "10s", "1s").Should(Succeed())
+	By("Route Table Private Service is ready")
+
 	By("Starting Kubernetes API Server")
 	testEnv = &envtest.Environment{
 		// When adding CRDS, be sure to add them to the data list in BUILD.bazel.
@@ -189,6 +227,15 @@ var _ = BeforeSuite(func() {
 	_, err = iprm.NewReconciler(ctx, k8sManager, iprmPrivateServiceClient, sdnServiceClient)
 	Expect(err).NotTo(HaveOccurred())
 
+	_, err = securitygroup.NewReconciler(ctx, k8sManager, securityGroupPrivateClient, sdnServiceClient)
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = vpcpeering.NewReconciler(ctx, k8sManager, vpcPeeringPrivateClient, sdnServiceClient)
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = routetable.NewReconciler(ctx, k8sManager, routeTablePrivateClient, sdnServiceClient)
+	Expect(err).NotTo(HaveOccurred())
+
 	By("Starting manager")
 	managerStoppable = stoppable.New(k8sManager.Start)
 	managerStoppable.Start(ctx)
@@ -216,6 +263,12 @@ func clearDatabase(ctx context.Context) {
 	Expect(err).Should(Succeed())
 	_, err = db.ExecContext(ctx, "delete from port")
 	Expect(err).Should(Succeed())
+	_, err = db.ExecContext(ctx, "delete from route_table")
+	Expect(err).Should(Succeed())
+	_, err = db.ExecContext(ctx, "delete from vpc_peering")
+	Expect(err).Should(Succeed())
+	_, err = db.ExecContext(ctx, "delete from security_group")
+	Expect(err).Should(Succeed())
 	_, err = db.ExecContext(ctx, "delete from subnet")
 	Expect(err).Should(Succeed())
 	_, err = db.ExecContext(ctx, "delete from vpc")
@@ -245,6 +298,24 @@ func NewMockOvnnetClient() *v1.MockOvnnetClient {
 	mockOvnnetClient.EXPECT().DeleteSubnet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	mockOvnnetClient.EXPECT().GetSubnet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
+	mockOvnnetClient.EXPECT().CreateSecurityGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().UpdateSecurityGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().DeleteSecurityGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().GetSecurityGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().CreateSecurityRule(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().DeleteSecurityRule(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().GetSecurityRule(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockOvnnetClient.EXPECT().CreateRouter(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().DeleteRouter(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().GetRouter(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().CreateRouterInterface(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().DeleteRouterInterface(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().GetRouterInterface(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().CreateStaticRoute(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().DeleteStaticRoute(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockOvnnetClient.EXPECT().GetStaticRoute(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
 	return mockOvnnetClient
 }
 
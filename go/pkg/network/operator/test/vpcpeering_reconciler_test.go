@@ -0,0 +1,158 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Create a VPC with a single subnet that covers the VPC.
+func createVPCAndSubnetWithCidrBlock(ctx context.Context, cloudAccountId string, cidrBlock string) (*pb.VPC, *pb.VPCSubnet) {
+	vpc, err := vpcServiceClient.Create(ctx, &pb.VPCCreateRequest{
+		Metadata: &pb.VPCMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           uuid.NewString(),
+		},
+		Spec: &pb.VPCSpec{
+			CidrBlock: cidrBlock,
+		},
+	})
+	Expect(err).Should(Succeed())
+
+	subnet, err := subnetServiceClient.Create(ctx, &pb.SubnetCreateRequest{
+		Metadata: &pb.SubnetMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           uuid.NewString(),
+		},
+		Spec: &pb.SubnetSpec{
+			CidrBlock:        cidrBlock,
+			AvailabilityZone: "us-dev-1a",
+			VpcId:            vpc.Metadata.ResourceId,
+		},
+	})
+	Expect(err).Should(Succeed())
+	return vpc, subnet
+}
+
+func createVPCPeering(ctx context.Context, cloudAccountId string, vpcA *pb.VPC, subnetA *pb.VPCSubnet, vpcB *pb.VPC, subnetB *pb.VPCSubnet) *pb.VPCPeering {
+	vpcPeering, err := vpcPeeringServiceClient.Create(ctx, &pb.VPCPeeringCreateRequest{
+		Metadata: &pb.VPCPeeringMetadataCreate{
+			CloudAccountId: cloudAccountId,
+			Name:           uuid.NewString(),
+		},
+		Spec: &pb.VPCPeeringSpec{
+			RequesterVpcId:    vpcA.Metadata.ResourceId,
+			RequesterSubnetId: subnetA.Metadata.ResourceId,
+			AccepterVpcId:     vpcB.Metadata.ResourceId,
+			AccepterSubnetId:  subnetB.Metadata.ResourceId,
+		},
+	})
+	Expect(err).Should(Succeed())
+	return vpcPeering
+}
+
+var _ = Describe("VPC peering reconciler", func() {
+	ctx := context.Background()
+	defer GinkgoRecover()
+
+	BeforeEach(func() {
+		clearDatabase(ctx)
+	})
+
+	getVPCPeeringPrivate := func(cloudAccountId string, resourceId string) (*pb.VPCPeeringPrivate, error) {
+		return vpcPeeringPrivateClient.GetPrivate(ctx, &pb.VPCPeeringGetPrivateRequest{
+			Metadata: &pb.VPCPeeringMetadataReference{
+				CloudAccountId: cloudAccountId,
+				ResourceId:     resourceId,
+			},
+		})
+	}
+
+	Context("VPC peering integration tests", func() {
+		It("Reconciles a new VPC peering and its delete", func() {
+			cloudaccountId, err := NewCloudAcctId()
+			Expect(err).Should(Succeed())
+
+			vpcA, subnetA := createVPCAndSubnetWithCidrBlock(ctx, cloudaccountId, "10.0.0.0/24")
+			vpcB, subnetB := createVPCAndSubnetWithCidrBlock(ctx, cloudaccountId, "10.1.0.0/24")
+			vpcPeering := createVPCPeering(ctx, cloudaccountId, vpcA, subnetA, vpcB, subnetB)
+			Expect(vpcPeering.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_Provisioning))
+
+			By("Waiting for VPC peering to be created in SDN")
+			Eventually(func(g Gomega) {
+				got, err := getVPCPeeringPrivate(cloudaccountId, vpcPeering.Metadata.ResourceId)
+				g.Expect(err).Should(Succeed())
+				g.Expect(got.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_Active))
+				g.Expect(got.Status.Message).Should(Equal("VPC peering active"))
+			}, timeout).Should(Succeed())
+
+			By("Deleting VPC peering")
+			_, err = vpcPeeringServiceClient.Delete(ctx, &pb.VPCPeeringDeleteRequest{
+				Metadata: &pb.VPCPeeringMetadataReference{
+					CloudAccountId: cloudaccountId,
+					ResourceId:     vpcPeering.Metadata.ResourceId,
+				},
+			})
+			Expect(err).Should(Succeed())
+
+			By("Waiting for VPC peering to be deleted in SDN")
+			Eventually(func(g Gomega) {
+				_, err := getVPCPeeringPrivate(cloudaccountId, vpcPeering.Metadata.ResourceId)
+				g.Expect(status.Code(err)).Should(Equal(codes.NotFound))
+			}, timeout).Should(Succeed())
+		})
+
+		It("Does not apply a VPC peering that has not been accepted", func() {
+			cloudaccountId, err := NewCloudAcctId()
+			Expect(err).Should(Succeed())
+			accepterCloudAccountId, err := NewCloudAcctId()
+			Expect(err).Should(Succeed())
+
+			vpcA, subnetA := createVPCAndSubnetWithCidrBlock(ctx, cloudaccountId, "10.0.0.0/24")
+			vpcB, subnetB := createVPCAndSubnetWithCidrBlock(ctx, accepterCloudAccountId, "10.1.0.0/24")
+			vpcPeering, err := vpcPeeringServiceClient.Create(ctx, &pb.VPCPeeringCreateRequest{
+				Metadata: &pb.VPCPeeringMetadataCreate{
+					CloudAccountId: cloudaccountId,
+					Name:           uuid.NewString(),
+				},
+				Spec: &pb.VPCPeeringSpec{
+					RequesterVpcId:         vpcA.Metadata.ResourceId,
+					RequesterSubnetId:      subnetA.Metadata.ResourceId,
+					AccepterCloudAccountId: accepterCloudAccountId,
+					AccepterVpcId:          vpcB.Metadata.ResourceId,
+				},
+			})
+			Expect(err).Should(Succeed())
+			Expect(vpcPeering.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_PendingAcceptance))
+
+			Consistently(func(g Gomega) {
+				got, err := getVPCPeeringPrivate(cloudaccountId, vpcPeering.Metadata.ResourceId)
+				g.Expect(err).Should(Succeed())
+				g.Expect(got.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_PendingAcceptance))
+			}, "5s", poll).Should(Succeed())
+
+			By("Accepting the VPC peering")
+			_, err = vpcPeeringServiceClient.Accept(ctx, &pb.VPCPeeringAcceptRequest{
+				Metadata: &pb.VPCPeeringMetadataReference{
+					CloudAccountId: accepterCloudAccountId,
+					ResourceId:     vpcPeering.Metadata.ResourceId,
+				},
+				AccepterSubnetId: subnetB.Metadata.ResourceId,
+			})
+			Expect(err).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				got, err := getVPCPeeringPrivate(cloudaccountId, vpcPeering.Metadata.ResourceId)
+				g.Expect(err).Should(Succeed())
+				g.Expect(got.Status.Phase).Should(Equal(pb.VPCPeeringPhase_VPCPeeringPhase_Active))
+			}, timeout).Should(Succeed())
+		})
+	})
+})
@@ -9,7 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn"
+	sdn "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/network/sdn"
 	grpc "google.golang.org/grpc"
 )
 
@@ -36,15 +36,35 @@ func (m *MockOvnnetClient) EXPECT() *MockOvnnetClientMockRecorder {
 	return m.recorder
 }
 
+// CreateAddressTranslation mocks base method.
+func (m *MockOvnnetClient) CreateAddressTranslation(ctx context.Context, in *sdn.CreateAddressTranslationRequest, opts ...grpc.CallOption) (*sdn.CreateAddressTranslationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAddressTranslation", varargs...)
+	ret0, _ := ret[0].(*sdn.CreateAddressTranslationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAddressTranslation indicates an expected call of CreateAddressTranslation.
+func (mr *MockOvnnetClientMockRecorder) CreateAddressTranslation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAddressTranslation", reflect.TypeOf((*MockOvnnetClient)(nil).CreateAddressTranslation), varargs...)
+}
+
 // CreatePort mocks base method.
-func (m *MockOvnnetClient) CreatePort(ctx context.Context, in *v1.CreatePortRequest, opts ...grpc.CallOption) (*v1.CreatePortResponse, error) {
+func (m *MockOvnnetClient) CreatePort(ctx context.Context, in *sdn.CreatePortRequest, opts ...grpc.CallOption) (*sdn.CreatePortResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreatePort", varargs...)
-	ret0, _ := ret[0].(*v1.CreatePortResponse)
+	ret0, _ := ret[0].(*sdn.CreatePortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -57,14 +77,14 @@ func (mr *MockOvnnetClientMockRecorder) CreatePort(ctx, in interface{}, opts ...
 }
 
 // CreateRouter mocks base method.
-func (m *MockOvnnetClient) CreateRouter(ctx context.Context, in *v1.CreateRouterRequest, opts ...grpc.CallOption) (*v1.CreateRouterResponse, error) {
+func (m *MockOvnnetClient) CreateRouter(ctx context.Context, in *sdn.CreateRouterRequest, opts ...grpc.CallOption) (*sdn.CreateRouterResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateRouter", varargs...)
-	ret0, _ := ret[0].(*v1.CreateRouterResponse)
+	ret0, _ := ret[0].(*sdn.CreateRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -77,14 +97,14 @@ func (mr *MockOvnnetClientMockRecorder) CreateRouter(ctx, in interface{}, opts .
 }
 
 // CreateRouterInterface mocks base method.
-func (m *MockOvnnetClient) CreateRouterInterface(ctx context.Context, in *v1.CreateRouterInterfaceRequest, opts ...grpc.CallOption) (*v1.CreateRouterInterfaceResponse, error) {
+func (m *MockOvnnetClient) CreateRouterInterface(ctx context.Context, in *sdn.CreateRouterInterfaceRequest, opts ...grpc.CallOption) (*sdn.CreateRouterInterfaceResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateRouterInterface", varargs...)
-	ret0, _ := ret[0].(*v1.CreateRouterInterfaceResponse)
+	ret0, _ := ret[0].(*sdn.CreateRouterInterfaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -97,14 +117,14 @@ func (mr *MockOvnnetClientMockRecorder) CreateRouterInterface(ctx, in interface{
 }
 
 // CreateSecurityGroup mocks base method.
-func (m *MockOvnnetClient) CreateSecurityGroup(ctx context.Context, in *v1.CreateSecurityGroupRequest, opts ...grpc.CallOption) (*v1.CreateSecurityGroupResponse, error) {
+func (m *MockOvnnetClient) CreateSecurityGroup(ctx context.Context, in *sdn.CreateSecurityGroupRequest, opts ...grpc.CallOption) (*sdn.CreateSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateSecurityGroup", varargs...)
-	ret0, _ := ret[0].(*v1.CreateSecurityGroupResponse)
+	ret0, _ := ret[0].(*sdn.CreateSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -117,14 +137,14 @@ func (mr *MockOvnnetClientMockRecorder) CreateSecurityGroup(ctx, in interface{},
 }
 
 // CreateSecurityRule mocks base method.
-func (m *MockOvnnetClient) CreateSecurityRule(ctx context.Context, in *v1.CreateSecurityRuleRequest, opts ...grpc.CallOption) (*v1.CreateSecurityRuleResponse, error) {
+func (m *MockOvnnetClient) CreateSecurityRule(ctx context.Context, in *sdn.CreateSecurityRuleRequest, opts ...grpc.CallOption) (*sdn.CreateSecurityRuleResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateSecurityRule", varargs...)
-	ret0, _ := ret[0].(*v1.CreateSecurityRuleResponse)
+	ret0, _ := ret[0].(*sdn.CreateSecurityRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -137,14 +157,14 @@ func (mr *MockOvnnetClientMockRecorder) CreateSecurityRule(ctx, in interface{},
 }
 
 // CreateStaticRoute mocks base method.
-func (m *MockOvnnetClient) CreateStaticRoute(ctx context.Context, in *v1.CreateStaticRouteRequest, opts ...grpc.CallOption) (*v1.CreateStaticRouteResponse, error) {
+func (m *MockOvnnetClient) CreateStaticRoute(ctx context.Context, in *sdn.CreateStaticRouteRequest, opts ...grpc.CallOption) (*sdn.CreateStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateStaticRoute", varargs...)
-	ret0, _ := ret[0].(*v1.CreateStaticRouteResponse)
+	ret0, _ := ret[0].(*sdn.CreateStaticRouteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -157,14 +177,14 @@ func (mr *MockOvnnetClientMockRecorder) CreateStaticRoute(ctx, in interface{}, o
 }
 
 // CreateSubnet mocks base method.
-func (m *MockOvnnetClient) CreateSubnet(ctx context.Context, in *v1.CreateSubnetRequest, opts ...grpc.CallOption) (*v1.CreateSubnetResponse, error) {
+func (m *MockOvnnetClient) CreateSubnet(ctx context.Context, in *sdn.CreateSubnetRequest, opts ...grpc.CallOption) (*sdn.CreateSubnetResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateSubnet", varargs...)
-	ret0, _ := ret[0].(*v1.CreateSubnetResponse)
+	ret0, _ := ret[0].(*sdn.CreateSubnetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -177,14 +197,14 @@ func (mr *MockOvnnetClientMockRecorder) CreateSubnet(ctx, in interface{}, opts .
 }
 
 // CreateVPC mocks base method.
-func (m *MockOvnnetClient) CreateVPC(ctx context.Context, in *v1.CreateVPCRequest, opts ...grpc.CallOption) (*v1.CreateVPCResponse, error) {
+func (m *MockOvnnetClient) CreateVPC(ctx context.Context, in *sdn.CreateVPCRequest, opts ...grpc.CallOption) (*sdn.CreateVPCResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateVPC", varargs...)
-	ret0, _ := ret[0].(*v1.CreateVPCResponse)
+	ret0, _ := ret[0].(*sdn.CreateVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -196,15 +216,35 @@ func (mr *MockOvnnetClientMockRecorder) CreateVPC(ctx, in interface{}, opts ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPC", reflect.TypeOf((*MockOvnnetClient)(nil).CreateVPC), varargs...)
 }
 
+// DeleteAddressTranslation mocks base method.
+func (m *MockOvnnetClient) DeleteAddressTranslation(ctx context.Context, in *sdn.DeleteAddressTranslationRequest, opts ...grpc.CallOption) (*sdn.DeleteAddressTranslationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAddressTranslation", varargs...)
+	ret0, _ := ret[0].(*sdn.DeleteAddressTranslationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAddressTranslation indicates an expected call of DeleteAddressTranslation.
+func (mr *MockOvnnetClientMockRecorder) DeleteAddressTranslation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddressTranslation", reflect.TypeOf((*MockOvnnetClient)(nil).DeleteAddressTranslation), varargs...)
+}
+
 // DeletePort mocks base method.
-func (m *MockOvnnetClient) DeletePort(ctx context.Context, in *v1.DeletePortRequest, opts ...grpc.CallOption) (*v1.DeletePortResponse, error) {
+func (m *MockOvnnetClient) DeletePort(ctx context.Context, in *sdn.DeletePortRequest, opts ...grpc.CallOption) (*sdn.DeletePortResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeletePort", varargs...)
-	ret0, _ := ret[0].(*v1.DeletePortResponse)
+	ret0, _ := ret[0].(*sdn.DeletePortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -217,14 +257,14 @@ func (mr *MockOvnnetClientMockRecorder) DeletePort(ctx, in interface{}, opts ...
 }
 
 // DeleteRouter mocks base method.
-func (m *MockOvnnetClient) DeleteRouter(ctx context.Context, in *v1.DeleteRouterRequest, opts ...grpc.CallOption) (*v1.DeleteRouterResponse, error) {
+func (m *MockOvnnetClient) DeleteRouter(ctx context.Context, in *sdn.DeleteRouterRequest, opts ...grpc.CallOption) (*sdn.DeleteRouterResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteRouter", varargs...)
-	ret0, _ := ret[0].(*v1.DeleteRouterResponse)
+	ret0, _ := ret[0].(*sdn.DeleteRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -237,14 +277,14 @@ func (mr *MockOvnnetClientMockRecorder) DeleteRouter(ctx, in interface{}, opts .
 }
 
 // DeleteRouterInterface mocks base method.
-func (m *MockOvnnetClient) DeleteRouterInterface(ctx context.Context, in *v1.DeleteRouterInterfaceRequest, opts ...grpc.CallOption) (*v1.DeleteRouterInterfaceResponse, error) {
+func (m *MockOvnnetClient) DeleteRouterInterface(ctx context.Context, in *sdn.DeleteRouterInterfaceRequest, opts ...grpc.CallOption) (*sdn.DeleteRouterInterfaceResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteRouterInterface", varargs...)
-	ret0, _ := ret[0].(*v1.DeleteRouterInterfaceResponse)
+	ret0, _ := ret[0].(*sdn.DeleteRouterInterfaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -257,14 +297,14 @@ func (mr *MockOvnnetClientMockRecorder) DeleteRouterInterface(ctx, in interface{
 }
 
 // DeleteSecurityGroup mocks base method.
-func (m *MockOvnnetClient) DeleteSecurityGroup(ctx context.Context, in *v1.DeleteSecurityGroupRequest, opts ...grpc.CallOption) (*v1.DeleteSecurityGroupResponse, error) {
+func (m *MockOvnnetClient) DeleteSecurityGroup(ctx context.Context, in *sdn.DeleteSecurityGroupRequest, opts ...grpc.CallOption) (*sdn.DeleteSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteSecurityGroup", varargs...)
-	ret0, _ := ret[0].(*v1.DeleteSecurityGroupResponse)
+	ret0, _ := ret[0].(*sdn.DeleteSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -277,14 +317,14 @@ func (mr *MockOvnnetClientMockRecorder) DeleteSecurityGroup(ctx, in interface{},
 }
 
 // DeleteSecurityRule mocks base method.
-func (m *MockOvnnetClient) DeleteSecurityRule(ctx context.Context, in *v1.DeleteSecurityRuleRequest, opts ...grpc.CallOption) (*v1.DeleteSecurityRuleResponse, error) {
+func (m *MockOvnnetClient) DeleteSecurityRule(ctx context.Context, in *sdn.DeleteSecurityRuleRequest, opts ...grpc.CallOption) (*sdn.DeleteSecurityRuleResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteSecurityRule", varargs...)
-	ret0, _ := ret[0].(*v1.DeleteSecurityRuleResponse)
+	ret0, _ := ret[0].(*sdn.DeleteSecurityRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -297,14 +337,14 @@ func (mr *MockOvnnetClientMockRecorder) DeleteSecurityRule(ctx, in interface{},
 }
 
 // DeleteStaticRoute mocks base method.
-func (m *MockOvnnetClient) DeleteStaticRoute(ctx context.Context, in *v1.DeleteStaticRouteRequest, opts ...grpc.CallOption) (*v1.DeleteStaticRouteResponse, error) {
+func (m *MockOvnnetClient) DeleteStaticRoute(ctx context.Context, in *sdn.DeleteStaticRouteRequest, opts ...grpc.CallOption) (*sdn.DeleteStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteStaticRoute", varargs...)
-	ret0, _ := ret[0].(*v1.DeleteStaticRouteResponse)
+	ret0, _ := ret[0].(*sdn.DeleteStaticRouteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -317,14 +357,14 @@ func (mr *MockOvnnetClientMockRecorder) DeleteStaticRoute(ctx, in interface{}, o
 }
 
 // DeleteSubnet mocks base method.
-func (m *MockOvnnetClient) DeleteSubnet(ctx context.Context, in *v1.DeleteSubnetRequest, opts ...grpc.CallOption) (*v1.DeleteSubnetResponse, error) {
+func (m *MockOvnnetClient) DeleteSubnet(ctx context.Context, in *sdn.DeleteSubnetRequest, opts ...grpc.CallOption) (*sdn.DeleteSubnetResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteSubnet", varargs...)
-	ret0, _ := ret[0].(*v1.DeleteSubnetResponse)
+	ret0, _ := ret[0].(*sdn.DeleteSubnetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -337,14 +377,14 @@ func (mr *MockOvnnetClientMockRecorder) DeleteSubnet(ctx, in interface{}, opts .
 }
 
 // DeleteVPC mocks base method.
-func (m *MockOvnnetClient) DeleteVPC(ctx context.Context, in *v1.DeleteVPCRequest, opts ...grpc.CallOption) (*v1.DeleteVPCResponse, error) {
+func (m *MockOvnnetClient) DeleteVPC(ctx context.Context, in *sdn.DeleteVPCRequest, opts ...grpc.CallOption) (*sdn.DeleteVPCResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteVPC", varargs...)
-	ret0, _ := ret[0].(*v1.DeleteVPCResponse)
+	ret0, _ := ret[0].(*sdn.DeleteVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -356,15 +396,35 @@ func (mr *MockOvnnetClientMockRecorder) DeleteVPC(ctx, in interface{}, opts ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockOvnnetClient)(nil).DeleteVPC), varargs...)
 }
 
+// GetAddressTranslation mocks base method.
+func (m *MockOvnnetClient) GetAddressTranslation(ctx context.Context, in *sdn.GetAddressTranslationRequest, opts ...grpc.CallOption) (*sdn.GetAddressTranslationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAddressTranslation", varargs...)
+	ret0, _ := ret[0].(*sdn.GetAddressTranslationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressTranslation indicates an expected call of GetAddressTranslation.
+func (mr *MockOvnnetClientMockRecorder) GetAddressTranslation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressTranslation", reflect.TypeOf((*MockOvnnetClient)(nil).GetAddressTranslation), varargs...)
+}
+
 // GetPort mocks base method.
-func (m *MockOvnnetClient) GetPort(ctx context.Context, in *v1.GetPortRequest, opts ...grpc.CallOption) (*v1.GetPortResponse, error) {
+func (m *MockOvnnetClient) GetPort(ctx context.Context, in *sdn.GetPortRequest, opts ...grpc.CallOption) (*sdn.GetPortResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetPort", varargs...)
-	ret0, _ := ret[0].(*v1.GetPortResponse)
+	ret0, _ := ret[0].(*sdn.GetPortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -377,14 +437,14 @@ func (mr *MockOvnnetClientMockRecorder) GetPort(ctx, in interface{}, opts ...int
 }
 
 // GetRouter mocks base method.
-func (m *MockOvnnetClient) GetRouter(ctx context.Context, in *v1.GetRouterRequest, opts ...grpc.CallOption) (*v1.GetRouterResponse, error) {
+func (m *MockOvnnetClient) GetRouter(ctx context.Context, in *sdn.GetRouterRequest, opts ...grpc.CallOption) (*sdn.GetRouterResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetRouter", varargs...)
-	ret0, _ := ret[0].(*v1.GetRouterResponse)
+	ret0, _ := ret[0].(*sdn.GetRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -397,14 +457,14 @@ func (mr *MockOvnnetClientMockRecorder) GetRouter(ctx, in interface{}, opts ...i
 }
 
 // GetRouterInterface mocks base method.
-func (m *MockOvnnetClient) GetRouterInterface(ctx context.Context, in *v1.GetRouterInterfaceRequest, opts ...grpc.CallOption) (*v1.GetRouterInterfaceResponse, error) {
+func (m *MockOvnnetClient) GetRouterInterface(ctx context.Context, in *sdn.GetRouterInterfaceRequest, opts ...grpc.CallOption) (*sdn.GetRouterInterfaceResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetRouterInterface", varargs...)
-	ret0, _ := ret[0].(*v1.GetRouterInterfaceResponse)
+	ret0, _ := ret[0].(*sdn.GetRouterInterfaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -417,14 +477,14 @@ func (mr *MockOvnnetClientMockRecorder) GetRouterInterface(ctx, in interface{},
 }
 
 // GetSecurityGroup mocks base method.
-func (m *MockOvnnetClient) GetSecurityGroup(ctx context.Context, in *v1.GetSecurityGroupRequest, opts ...grpc.CallOption) (*v1.GetSecurityGroupResponse, error) {
+func (m *MockOvnnetClient) GetSecurityGroup(ctx context.Context, in *sdn.GetSecurityGroupRequest, opts ...grpc.CallOption) (*sdn.GetSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetSecurityGroup", varargs...)
-	ret0, _ := ret[0].(*v1.GetSecurityGroupResponse)
+	ret0, _ := ret[0].(*sdn.GetSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -437,14 +497,14 @@ func (mr *MockOvnnetClientMockRecorder) GetSecurityGroup(ctx, in interface{}, op
 }
 
 // GetSecurityRule mocks base method.
-func (m *MockOvnnetClient) GetSecurityRule(ctx context.Context, in *v1.GetSecurityRuleRequest, opts ...grpc.CallOption) (*v1.GetSecurityRuleResponse, error) {
+func (m *MockOvnnetClient) GetSecurityRule(ctx context.Context, in *sdn.GetSecurityRuleRequest, opts ...grpc.CallOption) (*sdn.GetSecurityRuleResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetSecurityRule", varargs...)
-	ret0, _ := ret[0].(*v1.GetSecurityRuleResponse)
+	ret0, _ := ret[0].(*sdn.GetSecurityRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -457,14 +517,14 @@ func (mr *MockOvnnetClientMockRecorder) GetSecurityRule(ctx, in interface{}, opt
 }
 
 // GetStaticRoute mocks base method.
-func (m *MockOvnnetClient) GetStaticRoute(ctx context.Context, in *v1.GetStaticRouteRequest, opts ...grpc.CallOption) (*v1.GetStaticRouteResponse, error) {
+func (m *MockOvnnetClient) GetStaticRoute(ctx context.Context, in *sdn.GetStaticRouteRequest, opts ...grpc.CallOption) (*sdn.GetStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetStaticRoute", varargs...)
-	ret0, _ := ret[0].(*v1.GetStaticRouteResponse)
+	ret0, _ := ret[0].(*sdn.GetStaticRouteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -477,14 +537,14 @@ func (mr *MockOvnnetClientMockRecorder) GetStaticRoute(ctx, in interface{}, opts
 }
 
 // GetSubnet mocks base method.
-func (m *MockOvnnetClient) GetSubnet(ctx context.Context, in *v1.GetSubnetRequest, opts ...grpc.CallOption) (*v1.GetSubnetResponse, error) {
+func (m *MockOvnnetClient) GetSubnet(ctx context.Context, in *sdn.GetSubnetRequest, opts ...grpc.CallOption) (*sdn.GetSubnetResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetSubnet", varargs...)
-	ret0, _ := ret[0].(*v1.GetSubnetResponse)
+	ret0, _ := ret[0].(*sdn.GetSubnetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -497,14 +557,14 @@ func (mr *MockOvnnetClientMockRecorder) GetSubnet(ctx, in interface{}, opts ...i
 }
 
 // GetVPC mocks base method.
-func (m *MockOvnnetClient) GetVPC(ctx context.Context, in *v1.GetVPCRequest, opts ...grpc.CallOption) (*v1.GetVPCResponse, error) {
+func (m *MockOvnnetClient) GetVPC(ctx context.Context, in *sdn.GetVPCRequest, opts ...grpc.CallOption) (*sdn.GetVPCResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetVPC", varargs...)
-	ret0, _ := ret[0].(*v1.GetVPCResponse)
+	ret0, _ := ret[0].(*sdn.GetVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -516,15 +576,35 @@ func (mr *MockOvnnetClientMockRecorder) GetVPC(ctx, in interface{}, opts ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPC", reflect.TypeOf((*MockOvnnetClient)(nil).GetVPC), varargs...)
 }
 
+// ListAddressTranslations mocks base method.
+func (m *MockOvnnetClient) ListAddressTranslations(ctx context.Context, in *sdn.ListAddressTranslationsRequest, opts ...grpc.CallOption) (*sdn.ListAddressTranslationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAddressTranslations", varargs...)
+	ret0, _ := ret[0].(*sdn.ListAddressTranslationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddressTranslations indicates an expected call of ListAddressTranslations.
+func (mr *MockOvnnetClientMockRecorder) ListAddressTranslations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddressTranslations", reflect.TypeOf((*MockOvnnetClient)(nil).ListAddressTranslations), varargs...)
+}
+
 // ListPorts mocks base method.
-func (m *MockOvnnetClient) ListPorts(ctx context.Context, in *v1.ListPortsRequest, opts ...grpc.CallOption) (*v1.ListPortsResponse, error) {
+func (m *MockOvnnetClient) ListPorts(ctx context.Context, in *sdn.ListPortsRequest, opts ...grpc.CallOption) (*sdn.ListPortsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListPorts", varargs...)
-	ret0, _ := ret[0].(*v1.ListPortsResponse)
+	ret0, _ := ret[0].(*sdn.ListPortsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -537,14 +617,14 @@ func (mr *MockOvnnetClientMockRecorder) ListPorts(ctx, in interface{}, opts ...i
 }
 
 // ListRouterInterfaces mocks base method.
-func (m *MockOvnnetClient) ListRouterInterfaces(ctx context.Context, in *v1.ListRouterInterfacesRequest, opts ...grpc.CallOption) (*v1.ListRouterInterfacesResponse, error) {
+func (m *MockOvnnetClient) ListRouterInterfaces(ctx context.Context, in *sdn.ListRouterInterfacesRequest, opts ...grpc.CallOption) (*sdn.ListRouterInterfacesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListRouterInterfaces", varargs...)
-	ret0, _ := ret[0].(*v1.ListRouterInterfacesResponse)
+	ret0, _ := ret[0].(*sdn.ListRouterInterfacesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -557,14 +637,14 @@ func (mr *MockOvnnetClientMockRecorder) ListRouterInterfaces(ctx, in interface{}
 }
 
 // ListRouters mocks base method.
-func (m *MockOvnnetClient) ListRouters(ctx context.Context, in *v1.ListRoutersRequest, opts ...grpc.CallOption) (*v1.ListRoutersResponse, error) {
+func (m *MockOvnnetClient) ListRouters(ctx context.Context, in *sdn.ListRoutersRequest, opts ...grpc.CallOption) (*sdn.ListRoutersResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListRouters", varargs...)
-	ret0, _ := ret[0].(*v1.ListRoutersResponse)
+	ret0, _ := ret[0].(*sdn.ListRoutersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -577,14 +657,14 @@ func (mr *MockOvnnetClientMockRecorder) ListRouters(ctx, in interface{}, opts ..
 }
 
 // ListSecurityGroups mocks base method.
-func (m *MockOvnnetClient) ListSecurityGroups(ctx context.Context, in *v1.ListSecurityGroupsRequest, opts ...grpc.CallOption) (*v1.ListSecurityGroupsResponse, error) {
+func (m *MockOvnnetClient) ListSecurityGroups(ctx context.Context, in *sdn.ListSecurityGroupsRequest, opts ...grpc.CallOption) (*sdn.ListSecurityGroupsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListSecurityGroups", varargs...)
-	ret0, _ := ret[0].(*v1.ListSecurityGroupsResponse)
+	ret0, _ := ret[0].(*sdn.ListSecurityGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -597,14 +677,14 @@ func (mr *MockOvnnetClientMockRecorder) ListSecurityGroups(ctx, in interface{},
 }
 
 // ListSecurityRules mocks base method.
-func (m *MockOvnnetClient) ListSecurityRules(ctx context.Context, in *v1.ListSecurityRulesRequest, opts ...grpc.CallOption) (*v1.ListSecurityRulesResponse, error) {
+func (m *MockOvnnetClient) ListSecurityRules(ctx context.Context, in *sdn.ListSecurityRulesRequest, opts ...grpc.CallOption) (*sdn.ListSecurityRulesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListSecurityRules", varargs...)
-	ret0, _ := ret[0].(*v1.ListSecurityRulesResponse)
+	ret0, _ := ret[0].(*sdn.ListSecurityRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -617,14 +697,14 @@ func (mr *MockOvnnetClientMockRecorder) ListSecurityRules(ctx, in interface{}, o
 }
 
 // ListStaticRoutes mocks base method.
-func (m *MockOvnnetClient) ListStaticRoutes(ctx context.Context, in *v1.ListStaticRoutesRequest, opts ...grpc.CallOption) (*v1.ListStaticRoutesResponse, error) {
+func (m *MockOvnnetClient) ListStaticRoutes(ctx context.Context, in *sdn.ListStaticRoutesRequest, opts ...grpc.CallOption) (*sdn.ListStaticRoutesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListStaticRoutes", varargs...)
-	ret0, _ := ret[0].(*v1.ListStaticRoutesResponse)
+	ret0, _ := ret[0].(*sdn.ListStaticRoutesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -637,14 +717,14 @@ func (mr *MockOvnnetClientMockRecorder) ListStaticRoutes(ctx, in interface{}, op
 }
 
 // ListSubnets mocks base method.
-func (m *MockOvnnetClient) ListSubnets(ctx context.Context, in *v1.ListSubnetsRequest, opts ...grpc.CallOption) (*v1.ListSubnetsResponse, error) {
+func (m *MockOvnnetClient) ListSubnets(ctx context.Context, in *sdn.ListSubnetsRequest, opts ...grpc.CallOption) (*sdn.ListSubnetsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListSubnets", varargs...)
-	ret0, _ := ret[0].(*v1.ListSubnetsResponse)
+	ret0, _ := ret[0].(*sdn.ListSubnetsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -657,14 +737,14 @@ func (mr *MockOvnnetClientMockRecorder) ListSubnets(ctx, in interface{}, opts ..
 }
 
 // ListVPCs mocks base method.
-func (m *MockOvnnetClient) ListVPCs(ctx context.Context, in *v1.ListVPCsRequest, opts ...grpc.CallOption) (*v1.ListVPCsResponse, error) {
+func (m *MockOvnnetClient) ListVPCs(ctx context.Context, in *sdn.ListVPCsRequest, opts ...grpc.CallOption) (*sdn.ListVPCsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListVPCs", varargs...)
-	ret0, _ := ret[0].(*v1.ListVPCsResponse)
+	ret0, _ := ret[0].(*sdn.ListVPCsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -677,14 +757,14 @@ func (mr *MockOvnnetClientMockRecorder) ListVPCs(ctx, in interface{}, opts ...in
 }
 
 // UpdatePort mocks base method.
-func (m *MockOvnnetClient) UpdatePort(ctx context.Context, in *v1.UpdatePortRequest, opts ...grpc.CallOption) (*v1.UpdatePortResponse, error) {
+func (m *MockOvnnetClient) UpdatePort(ctx context.Context, in *sdn.UpdatePortRequest, opts ...grpc.CallOption) (*sdn.UpdatePortResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "UpdatePort", varargs...)
-	ret0, _ := ret[0].(*v1.UpdatePortResponse)
+	ret0, _ := ret[0].(*sdn.UpdatePortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -697,14 +777,14 @@ func (mr *MockOvnnetClientMockRecorder) UpdatePort(ctx, in interface{}, opts ...
 }
 
 // UpdateSecurityGroup mocks base method.
-func (m *MockOvnnetClient) UpdateSecurityGroup(ctx context.Context, in *v1.UpdateSecurityGroupRequest, opts ...grpc.CallOption) (*v1.UpdateSecurityGroupResponse, error) {
+func (m *MockOvnnetClient) UpdateSecurityGroup(ctx context.Context, in *sdn.UpdateSecurityGroupRequest, opts ...grpc.CallOption) (*sdn.UpdateSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "UpdateSecurityGroup", varargs...)
-	ret0, _ := ret[0].(*v1.UpdateSecurityGroupResponse)
+	ret0, _ := ret[0].(*sdn.UpdateSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -716,6 +796,26 @@ func (mr *MockOvnnetClientMockRecorder) UpdateSecurityGroup(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurityGroup", reflect.TypeOf((*MockOvnnetClient)(nil).UpdateSecurityGroup), varargs...)
 }
 
+// UpdateSecurityRule mocks base method.
+func (m *MockOvnnetClient) UpdateSecurityRule(ctx context.Context, in *sdn.UpdateSecurityRuleRequest, opts ...grpc.CallOption) (*sdn.UpdateSecurityRuleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSecurityRule", varargs...)
+	ret0, _ := ret[0].(*sdn.UpdateSecurityRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecurityRule indicates an expected call of UpdateSecurityRule.
+func (mr *MockOvnnetClientMockRecorder) UpdateSecurityRule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurityRule", reflect.TypeOf((*MockOvnnetClient)(nil).UpdateSecurityRule), varargs...)
+}
+
 // MockOvnnetServer is a mock of OvnnetServer interface.
 type MockOvnnetServer struct {
 	ctrl     *gomock.Controller
@@ -739,11 +839,26 @@ func (m *MockOvnnetServer) EXPECT() *MockOvnnetServerMockRecorder {
 	return m.recorder
 }
 
+// CreateAddressTranslation mocks base method.
+func (m *MockOvnnetServer) CreateAddressTranslation(arg0 context.Context, arg1 *sdn.CreateAddressTranslationRequest) (*sdn.CreateAddressTranslationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAddressTranslation", arg0, arg1)
+	ret0, _ := ret[0].(*sdn.CreateAddressTranslationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAddressTranslation indicates an expected call of CreateAddressTranslation.
+func (mr *MockOvnnetServerMockRecorder) CreateAddressTranslation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAddressTranslation", reflect.TypeOf((*MockOvnnetServer)(nil).CreateAddressTranslation), arg0, arg1)
+}
+
 // CreatePort mocks base method.
-func (m *MockOvnnetServer) CreatePort(arg0 context.Context, arg1 *v1.CreatePortRequest) (*v1.CreatePortResponse, error) {
+func (m *MockOvnnetServer) CreatePort(arg0 context.Context, arg1 *sdn.CreatePortRequest) (*sdn.CreatePortResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePort", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CreatePortResponse)
+	ret0, _ := ret[0].(*sdn.CreatePortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -755,10 +870,10 @@ func (mr *MockOvnnetServerMockRecorder) CreatePort(arg0, arg1 interface{}) *gomo
 }
 
 // CreateRouter mocks base method.
-func (m *MockOvnnetServer) CreateRouter(arg0 context.Context, arg1 *v1.CreateRouterRequest) (*v1.CreateRouterResponse, error) {
+func (m *MockOvnnetServer) CreateRouter(arg0 context.Context, arg1 *sdn.CreateRouterRequest) (*sdn.CreateRouterResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateRouter", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CreateRouterResponse)
+	ret0, _ := ret[0].(*sdn.CreateRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -770,10 +885,10 @@ func (mr *MockOvnnetServerMockRecorder) CreateRouter(arg0, arg1 interface{}) *go
 }
 
 // CreateRouterInterface mocks base method.
-func (m *MockOvnnetServer) CreateRouterInterface(arg0 context.Context, arg1 *v1.CreateRouterInterfaceRequest) (*v1.CreateRouterInterfaceResponse, error) {
+func (m *MockOvnnetServer) CreateRouterInterface(arg0 context.Context, arg1 *sdn.CreateRouterInterfaceRequest) (*sdn.CreateRouterInterfaceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateRouterInterface", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CreateRouterInterfaceResponse)
+	ret0, _ := ret[0].(*sdn.CreateRouterInterfaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -785,10 +900,10 @@ func (mr *MockOvnnetServerMockRecorder) CreateRouterInterface(arg0, arg1 interfa
 }
 
 // CreateSecurityGroup mocks base method.
-func (m *MockOvnnetServer) CreateSecurityGroup(arg0 context.Context, arg1 *v1.CreateSecurityGroupRequest) (*v1.CreateSecurityGroupResponse, error) {
+func (m *MockOvnnetServer) CreateSecurityGroup(arg0 context.Context, arg1 *sdn.CreateSecurityGroupRequest) (*sdn.CreateSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSecurityGroup", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CreateSecurityGroupResponse)
+	ret0, _ := ret[0].(*sdn.CreateSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -800,10 +915,10 @@ func (mr *MockOvnnetServerMockRecorder) CreateSecurityGroup(arg0, arg1 interface
 }
 
 // CreateSecurityRule mocks base method.
-func (m *MockOvnnetServer) CreateSecurityRule(arg0 context.Context, arg1 *v1.CreateSecurityRuleRequest) (*v1.CreateSecurityRuleResponse, error) {
+func (m *MockOvnnetServer) CreateSecurityRule(arg0 context.Context, arg1 *sdn.CreateSecurityRuleRequest) (*sdn.CreateSecurityRuleResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSecurityRule", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CreateSecurityRuleResponse)
+	ret0, _ := ret[0].(*sdn.CreateSecurityRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -815,10 +930,10 @@ func (mr *MockOvnnetServerMockRecorder) CreateSecurityRule(arg0, arg1 interface{
 }
 
 // CreateStaticRoute mocks base method.
-func (m *MockOvnnetServer) CreateStaticRoute(arg0 context.Context, arg1 *v1.CreateStaticRouteRequest) (*v1.CreateStaticRouteResponse, error) {
+func (m *MockOvnnetServer) CreateStaticRoute(arg0 context.Context, arg1 *sdn.CreateStaticRouteRequest) (*sdn.CreateStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateStaticRoute", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CreateStaticRouteResponse)
+	ret0, _ := ret[0].(*sdn.CreateStaticRouteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -830,10 +945,10 @@ func (mr *MockOvnnetServerMockRecorder) CreateStaticRoute(arg0, arg1 interface{}
 }
 
 // CreateSubnet mocks base method.
-func (m *MockOvnnetServer) CreateSubnet(arg0 context.Context, arg1 *v1.CreateSubnetRequest) (*v1.CreateSubnetResponse, error) {
+func (m *MockOvnnetServer) CreateSubnet(arg0 context.Context, arg1 *sdn.CreateSubnetRequest) (*sdn.CreateSubnetResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSubnet", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CreateSubnetResponse)
+	ret0, _ := ret[0].(*sdn.CreateSubnetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -845,10 +960,10 @@ func (mr *MockOvnnetServerMockRecorder) CreateSubnet(arg0, arg1 interface{}) *go
 }
 
 // CreateVPC mocks base method.
-func (m *MockOvnnetServer) CreateVPC(arg0 context.Context, arg1 *v1.CreateVPCRequest) (*v1.CreateVPCResponse, error) {
+func (m *MockOvnnetServer) CreateVPC(arg0 context.Context, arg1 *sdn.CreateVPCRequest) (*sdn.CreateVPCResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateVPC", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CreateVPCResponse)
+	ret0, _ := ret[0].(*sdn.CreateVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -859,11 +974,26 @@ func (mr *MockOvnnetServerMockRecorder) CreateVPC(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPC", reflect.TypeOf((*MockOvnnetServer)(nil).CreateVPC), arg0, arg1)
 }
 
+// DeleteAddressTranslation mocks base method.
+func (m *MockOvnnetServer) DeleteAddressTranslation(arg0 context.Context, arg1 *sdn.DeleteAddressTranslationRequest) (*sdn.DeleteAddressTranslationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddressTranslation", arg0, arg1)
+	ret0, _ := ret[0].(*sdn.DeleteAddressTranslationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAddressTranslation indicates an expected call of DeleteAddressTranslation.
+func (mr *MockOvnnetServerMockRecorder) DeleteAddressTranslation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddressTranslation", reflect.TypeOf((*MockOvnnetServer)(nil).DeleteAddressTranslation), arg0, arg1)
+}
+
 // DeletePort mocks base method.
-func (m *MockOvnnetServer) DeletePort(arg0 context.Context, arg1 *v1.DeletePortRequest) (*v1.DeletePortResponse, error) {
+func (m *MockOvnnetServer) DeletePort(arg0 context.Context, arg1 *sdn.DeletePortRequest) (*sdn.DeletePortResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeletePort", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeletePortResponse)
+	ret0, _ := ret[0].(*sdn.DeletePortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -875,10 +1005,10 @@ func (mr *MockOvnnetServerMockRecorder) DeletePort(arg0, arg1 interface{}) *gomo
 }
 
 // DeleteRouter mocks base method.
-func (m *MockOvnnetServer) DeleteRouter(arg0 context.Context, arg1 *v1.DeleteRouterRequest) (*v1.DeleteRouterResponse, error) {
+func (m *MockOvnnetServer) DeleteRouter(arg0 context.Context, arg1 *sdn.DeleteRouterRequest) (*sdn.DeleteRouterResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteRouter", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteRouterResponse)
+	ret0, _ := ret[0].(*sdn.DeleteRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -890,10 +1020,10 @@ func (mr *MockOvnnetServerMockRecorder) DeleteRouter(arg0, arg1 interface{}) *go
 }
 
 // DeleteRouterInterface mocks base method.
-func (m *MockOvnnetServer) DeleteRouterInterface(arg0 context.Context, arg1 *v1.DeleteRouterInterfaceRequest) (*v1.DeleteRouterInterfaceResponse, error) {
+func (m *MockOvnnetServer) DeleteRouterInterface(arg0 context.Context, arg1 *sdn.DeleteRouterInterfaceRequest) (*sdn.DeleteRouterInterfaceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteRouterInterface", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteRouterInterfaceResponse)
+	ret0, _ := ret[0].(*sdn.DeleteRouterInterfaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -905,10 +1035,10 @@ func (mr *MockOvnnetServerMockRecorder) DeleteRouterInterface(arg0, arg1 interfa
 }
 
 // DeleteSecurityGroup mocks base method.
-func (m *MockOvnnetServer) DeleteSecurityGroup(arg0 context.Context, arg1 *v1.DeleteSecurityGroupRequest) (*v1.DeleteSecurityGroupResponse, error) {
+func (m *MockOvnnetServer) DeleteSecurityGroup(arg0 context.Context, arg1 *sdn.DeleteSecurityGroupRequest) (*sdn.DeleteSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSecurityGroup", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteSecurityGroupResponse)
+	ret0, _ := ret[0].(*sdn.DeleteSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -920,10 +1050,10 @@ func (mr *MockOvnnetServerMockRecorder) DeleteSecurityGroup(arg0, arg1 interface
 }
 
 // DeleteSecurityRule mocks base method.
-func (m *MockOvnnetServer) DeleteSecurityRule(arg0 context.Context, arg1 *v1.DeleteSecurityRuleRequest) (*v1.DeleteSecurityRuleResponse, error) {
+func (m *MockOvnnetServer) DeleteSecurityRule(arg0 context.Context, arg1 *sdn.DeleteSecurityRuleRequest) (*sdn.DeleteSecurityRuleResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSecurityRule", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteSecurityRuleResponse)
+	ret0, _ := ret[0].(*sdn.DeleteSecurityRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -935,10 +1065,10 @@ func (mr *MockOvnnetServerMockRecorder) DeleteSecurityRule(arg0, arg1 interface{
 }
 
 // DeleteStaticRoute mocks base method.
-func (m *MockOvnnetServer) DeleteStaticRoute(arg0 context.Context, arg1 *v1.DeleteStaticRouteRequest) (*v1.DeleteStaticRouteResponse, error) {
+func (m *MockOvnnetServer) DeleteStaticRoute(arg0 context.Context, arg1 *sdn.DeleteStaticRouteRequest) (*sdn.DeleteStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteStaticRoute", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteStaticRouteResponse)
+	ret0, _ := ret[0].(*sdn.DeleteStaticRouteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -950,10 +1080,10 @@ func (mr *MockOvnnetServerMockRecorder) DeleteStaticRoute(arg0, arg1 interface{}
 }
 
 // DeleteSubnet mocks base method.
-func (m *MockOvnnetServer) DeleteSubnet(arg0 context.Context, arg1 *v1.DeleteSubnetRequest) (*v1.DeleteSubnetResponse, error) {
+func (m *MockOvnnetServer) DeleteSubnet(arg0 context.Context, arg1 *sdn.DeleteSubnetRequest) (*sdn.DeleteSubnetResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSubnet", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteSubnetResponse)
+	ret0, _ := ret[0].(*sdn.DeleteSubnetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -965,10 +1095,10 @@ func (mr *MockOvnnetServerMockRecorder) DeleteSubnet(arg0, arg1 interface{}) *go
 }
 
 // DeleteVPC mocks base method.
-func (m *MockOvnnetServer) DeleteVPC(arg0 context.Context, arg1 *v1.DeleteVPCRequest) (*v1.DeleteVPCResponse, error) {
+func (m *MockOvnnetServer) DeleteVPC(arg0 context.Context, arg1 *sdn.DeleteVPCRequest) (*sdn.DeleteVPCResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteVPC", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteVPCResponse)
+	ret0, _ := ret[0].(*sdn.DeleteVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -979,11 +1109,26 @@ func (mr *MockOvnnetServerMockRecorder) DeleteVPC(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockOvnnetServer)(nil).DeleteVPC), arg0, arg1)
 }
 
+// GetAddressTranslation mocks base method.
+func (m *MockOvnnetServer) GetAddressTranslation(arg0 context.Context, arg1 *sdn.GetAddressTranslationRequest) (*sdn.GetAddressTranslationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressTranslation", arg0, arg1)
+	ret0, _ := ret[0].(*sdn.GetAddressTranslationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressTranslation indicates an expected call of GetAddressTranslation.
+func (mr *MockOvnnetServerMockRecorder) GetAddressTranslation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressTranslation", reflect.TypeOf((*MockOvnnetServer)(nil).GetAddressTranslation), arg0, arg1)
+}
+
 // GetPort mocks base method.
-func (m *MockOvnnetServer) GetPort(arg0 context.Context, arg1 *v1.GetPortRequest) (*v1.GetPortResponse, error) {
+func (m *MockOvnnetServer) GetPort(arg0 context.Context, arg1 *sdn.GetPortRequest) (*sdn.GetPortResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPort", arg0, arg1)
-	ret0, _ := ret[0].(*v1.GetPortResponse)
+	ret0, _ := ret[0].(*sdn.GetPortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -995,10 +1140,10 @@ func (mr *MockOvnnetServerMockRecorder) GetPort(arg0, arg1 interface{}) *gomock.
 }
 
 // GetRouter mocks base method.
-func (m *MockOvnnetServer) GetRouter(arg0 context.Context, arg1 *v1.GetRouterRequest) (*v1.GetRouterResponse, error) {
+func (m *MockOvnnetServer) GetRouter(arg0 context.Context, arg1 *sdn.GetRouterRequest) (*sdn.GetRouterResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRouter", arg0, arg1)
-	ret0, _ := ret[0].(*v1.GetRouterResponse)
+	ret0, _ := ret[0].(*sdn.GetRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1010,10 +1155,10 @@ func (mr *MockOvnnetServerMockRecorder) GetRouter(arg0, arg1 interface{}) *gomoc
 }
 
 // GetRouterInterface mocks base method.
-func (m *MockOvnnetServer) GetRouterInterface(arg0 context.Context, arg1 *v1.GetRouterInterfaceRequest) (*v1.GetRouterInterfaceResponse, error) {
+func (m *MockOvnnetServer) GetRouterInterface(arg0 context.Context, arg1 *sdn.GetRouterInterfaceRequest) (*sdn.GetRouterInterfaceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRouterInterface", arg0, arg1)
-	ret0, _ := ret[0].(*v1.GetRouterInterfaceResponse)
+	ret0, _ := ret[0].(*sdn.GetRouterInterfaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1025,10 +1170,10 @@ func (mr *MockOvnnetServerMockRecorder) GetRouterInterface(arg0, arg1 interface{
 }
 
 // GetSecurityGroup mocks base method.
-func (m *MockOvnnetServer) GetSecurityGroup(arg0 context.Context, arg1 *v1.GetSecurityGroupRequest) (*v1.GetSecurityGroupResponse, error) {
+func (m *MockOvnnetServer) GetSecurityGroup(arg0 context.Context, arg1 *sdn.GetSecurityGroupRequest) (*sdn.GetSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSecurityGroup", arg0, arg1)
-	ret0, _ := ret[0].(*v1.GetSecurityGroupResponse)
+	ret0, _ := ret[0].(*sdn.GetSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1040,10 +1185,10 @@ func (mr *MockOvnnetServerMockRecorder) GetSecurityGroup(arg0, arg1 interface{})
 }
 
 // GetSecurityRule mocks base method.
-func (m *MockOvnnetServer) GetSecurityRule(arg0 context.Context, arg1 *v1.GetSecurityRuleRequest) (*v1.GetSecurityRuleResponse, error) {
+func (m *MockOvnnetServer) GetSecurityRule(arg0 context.Context, arg1 *sdn.GetSecurityRuleRequest) (*sdn.GetSecurityRuleResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSecurityRule", arg0, arg1)
-	ret0, _ := ret[0].(*v1.GetSecurityRuleResponse)
+	ret0, _ := ret[0].(*sdn.GetSecurityRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1055,10 +1200,10 @@ func (mr *MockOvnnetServerMockRecorder) GetSecurityRule(arg0, arg1 interface{})
 }
 
 // GetStaticRoute mocks base method.
-func (m *MockOvnnetServer) GetStaticRoute(arg0 context.Context, arg1 *v1.GetStaticRouteRequest) (*v1.GetStaticRouteResponse, error) {
+func (m *MockOvnnetServer) GetStaticRoute(arg0 context.Context, arg1 *sdn.GetStaticRouteRequest) (*sdn.GetStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStaticRoute", arg0, arg1)
-	ret0, _ := ret[0].(*v1.GetStaticRouteResponse)
+	ret0, _ := ret[0].(*sdn.GetStaticRouteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1070,10 +1215,10 @@ func (mr *MockOvnnetServerMockRecorder) GetStaticRoute(arg0, arg1 interface{}) *
 }
 
 // GetSubnet mocks base method.
-func (m *MockOvnnetServer) GetSubnet(arg0 context.Context, arg1 *v1.GetSubnetRequest) (*v1.GetSubnetResponse, error) {
+func (m *MockOvnnetServer) GetSubnet(arg0 context.Context, arg1 *sdn.GetSubnetRequest) (*sdn.GetSubnetResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSubnet", arg0, arg1)
-	ret0, _ := ret[0].(*v1.GetSubnetResponse)
+	ret0, _ := ret[0].(*sdn.GetSubnetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1085,10 +1230,10 @@ func (mr *MockOvnnetServerMockRecorder) GetSubnet(arg0, arg1 interface{}) *gomoc
 }
 
 // GetVPC mocks base method.
-func (m *MockOvnnetServer) GetVPC(arg0 context.Context, arg1 *v1.GetVPCRequest) (*v1.GetVPCResponse, error) {
+func (m *MockOvnnetServer) GetVPC(arg0 context.Context, arg1 *sdn.GetVPCRequest) (*sdn.GetVPCResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetVPC", arg0, arg1)
-	ret0, _ := ret[0].(*v1.GetVPCResponse)
+	ret0, _ := ret[0].(*sdn.GetVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1099,11 +1244,26 @@ func (mr *MockOvnnetServerMockRecorder) GetVPC(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPC", reflect.TypeOf((*MockOvnnetServer)(nil).GetVPC), arg0, arg1)
 }
 
+// ListAddressTranslations mocks base method.
+func (m *MockOvnnetServer) ListAddressTranslations(arg0 context.Context, arg1 *sdn.ListAddressTranslationsRequest) (*sdn.ListAddressTranslationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAddressTranslations", arg0, arg1)
+	ret0, _ := ret[0].(*sdn.ListAddressTranslationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddressTranslations indicates an expected call of ListAddressTranslations.
+func (mr *MockOvnnetServerMockRecorder) ListAddressTranslations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddressTranslations", reflect.TypeOf((*MockOvnnetServer)(nil).ListAddressTranslations), arg0, arg1)
+}
+
 // ListPorts mocks base method.
-func (m *MockOvnnetServer) ListPorts(arg0 context.Context, arg1 *v1.ListPortsRequest) (*v1.ListPortsResponse, error) {
+func (m *MockOvnnetServer) ListPorts(arg0 context.Context, arg1 *sdn.ListPortsRequest) (*sdn.ListPortsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListPorts", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListPortsResponse)
+	ret0, _ := ret[0].(*sdn.ListPortsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1115,10 +1275,10 @@ func (mr *MockOvnnetServerMockRecorder) ListPorts(arg0, arg1 interface{}) *gomoc
 }
 
 // ListRouterInterfaces mocks base method.
-func (m *MockOvnnetServer) ListRouterInterfaces(arg0 context.Context, arg1 *v1.ListRouterInterfacesRequest) (*v1.ListRouterInterfacesResponse, error) {
+func (m *MockOvnnetServer) ListRouterInterfaces(arg0 context.Context, arg1 *sdn.ListRouterInterfacesRequest) (*sdn.ListRouterInterfacesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListRouterInterfaces", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListRouterInterfacesResponse)
+	ret0, _ := ret[0].(*sdn.ListRouterInterfacesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1130,10 +1290,10 @@ func (mr *MockOvnnetServerMockRecorder) ListRouterInterfaces(arg0, arg1 interfac
 }
 
 // ListRouters mocks base method.
-func (m *MockOvnnetServer) ListRouters(arg0 context.Context, arg1 *v1.ListRoutersRequest) (*v1.ListRoutersResponse, error) {
+func (m *MockOvnnetServer) ListRouters(arg0 context.Context, arg1 *sdn.ListRoutersRequest) (*sdn.ListRoutersResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListRouters", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListRoutersResponse)
+	ret0, _ := ret[0].(*sdn.ListRoutersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1145,10 +1305,10 @@ func (mr *MockOvnnetServerMockRecorder) ListRouters(arg0, arg1 interface{}) *gom
 }
 
 // ListSecurityGroups mocks base method.
-func (m *MockOvnnetServer) ListSecurityGroups(arg0 context.Context, arg1 *v1.ListSecurityGroupsRequest) (*v1.ListSecurityGroupsResponse, error) {
+func (m *MockOvnnetServer) ListSecurityGroups(arg0 context.Context, arg1 *sdn.ListSecurityGroupsRequest) (*sdn.ListSecurityGroupsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSecurityGroups", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListSecurityGroupsResponse)
+	ret0, _ := ret[0].(*sdn.ListSecurityGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1160,10 +1320,10 @@ func (mr *MockOvnnetServerMockRecorder) ListSecurityGroups(arg0, arg1 interface{
 }
 
 // ListSecurityRules mocks base method.
-func (m *MockOvnnetServer) ListSecurityRules(arg0 context.Context, arg1 *v1.ListSecurityRulesRequest) (*v1.ListSecurityRulesResponse, error) {
+func (m *MockOvnnetServer) ListSecurityRules(arg0 context.Context, arg1 *sdn.ListSecurityRulesRequest) (*sdn.ListSecurityRulesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSecurityRules", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListSecurityRulesResponse)
+	ret0, _ := ret[0].(*sdn.ListSecurityRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1175,10 +1335,10 @@ func (mr *MockOvnnetServerMockRecorder) ListSecurityRules(arg0, arg1 interface{}
 }
 
 // ListStaticRoutes mocks base method.
-func (m *MockOvnnetServer) ListStaticRoutes(arg0 context.Context, arg1 *v1.ListStaticRoutesRequest) (*v1.ListStaticRoutesResponse, error) {
+func (m *MockOvnnetServer) ListStaticRoutes(arg0 context.Context, arg1 *sdn.ListStaticRoutesRequest) (*sdn.ListStaticRoutesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStaticRoutes", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListStaticRoutesResponse)
+	ret0, _ := ret[0].(*sdn.ListStaticRoutesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1190,10 +1350,10 @@ func (mr *MockOvnnetServerMockRecorder) ListStaticRoutes(arg0, arg1 interface{})
 }
 
 // ListSubnets mocks base method.
-func (m *MockOvnnetServer) ListSubnets(arg0 context.Context, arg1 *v1.ListSubnetsRequest) (*v1.ListSubnetsResponse, error) {
+func (m *MockOvnnetServer) ListSubnets(arg0 context.Context, arg1 *sdn.ListSubnetsRequest) (*sdn.ListSubnetsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSubnets", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListSubnetsResponse)
+	ret0, _ := ret[0].(*sdn.ListSubnetsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1205,10 +1365,10 @@ func (mr *MockOvnnetServerMockRecorder) ListSubnets(arg0, arg1 interface{}) *gom
 }
 
 // ListVPCs mocks base method.
-func (m *MockOvnnetServer) ListVPCs(arg0 context.Context, arg1 *v1.ListVPCsRequest) (*v1.ListVPCsResponse, error) {
+func (m *MockOvnnetServer) ListVPCs(arg0 context.Context, arg1 *sdn.ListVPCsRequest) (*sdn.ListVPCsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListVPCs", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListVPCsResponse)
+	ret0, _ := ret[0].(*sdn.ListVPCsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1220,10 +1380,10 @@ func (mr *MockOvnnetServerMockRecorder) ListVPCs(arg0, arg1 interface{}) *gomock
 }
 
 // UpdatePort mocks base method.
-func (m *MockOvnnetServer) UpdatePort(arg0 context.Context, arg1 *v1.UpdatePortRequest) (*v1.UpdatePortResponse, error) {
+func (m *MockOvnnetServer) UpdatePort(arg0 context.Context, arg1 *sdn.UpdatePortRequest) (*sdn.UpdatePortResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdatePort", arg0, arg1)
-	ret0, _ := ret[0].(*v1.UpdatePortResponse)
+	ret0, _ := ret[0].(*sdn.UpdatePortResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1235,10 +1395,10 @@ func (mr *MockOvnnetServerMockRecorder) UpdatePort(arg0, arg1 interface{}) *gomo
 }
 
 // UpdateSecurityGroup mocks base method.
-func (m *MockOvnnetServer) UpdateSecurityGroup(arg0 context.Context, arg1 *v1.UpdateSecurityGroupRequest) (*v1.UpdateSecurityGroupResponse, error) {
+func (m *MockOvnnetServer) UpdateSecurityGroup(arg0 context.Context, arg1 *sdn.UpdateSecurityGroupRequest) (*sdn.UpdateSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateSecurityGroup", arg0, arg1)
-	ret0, _ := ret[0].(*v1.UpdateSecurityGroupResponse)
+	ret0, _ := ret[0].(*sdn.UpdateSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1249,6 +1409,21 @@ func (mr *MockOvnnetServerMockRecorder) UpdateSecurityGroup(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurityGroup", reflect.TypeOf((*MockOvnnetServer)(nil).UpdateSecurityGroup), arg0, arg1)
 }
 
+// UpdateSecurityRule mocks base method.
+func (m *MockOvnnetServer) UpdateSecurityRule(arg0 context.Context, arg1 *sdn.UpdateSecurityRuleRequest) (*sdn.UpdateSecurityRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecurityRule", arg0, arg1)
+	ret0, _ := ret[0].(*sdn.UpdateSecurityRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecurityRule indicates an expected call of UpdateSecurityRule.
+func (mr *MockOvnnetServerMockRecorder) UpdateSecurityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurityRule", reflect.TypeOf((*MockOvnnetServer)(nil).UpdateSecurityRule), arg0, arg1)
+}
+
 // mustEmbedUnimplementedOvnnetServer mocks base method.
 func (m *MockOvnnetServer) mustEmbedUnimplementedOvnnetServer() {
 	m.ctrl.T.Helper()
@@ -1,7 +1,10 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2024 Intel Corporation
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
-// 	protoc        v3.21.9
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.21.12
 // source: api/sdn/v1/ovnnet.proto
 
 package v1
@@ -20,6 +23,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SecurityGroupType int32
+
+const (
+	SecurityGroupType_PORT   SecurityGroupType = 0
+	SecurityGroupType_SUBNET SecurityGroupType = 1
+)
+
+// Enum value maps for SecurityGroupType.
+var (
+	SecurityGroupType_name = map[int32]string{
+		0: "PORT",
+		1: "SUBNET",
+	}
+	SecurityGroupType_value = map[string]int32{
+		"PORT":   0,
+		"SUBNET": 1,
+	}
+)
+
+func (x SecurityGroupType) Enum() *SecurityGroupType {
+	p := new(SecurityGroupType)
+	*p = x
+	return p
+}
+
+func (x SecurityGroupType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecurityGroupType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sdn_v1_ovnnet_proto_enumTypes[0].Descriptor()
+}
+
+func (SecurityGroupType) Type() protoreflect.EnumType {
+	return &file_api_sdn_v1_ovnnet_proto_enumTypes[0]
+}
+
+func (x SecurityGroupType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecurityGroupType.Descriptor instead.
+func (SecurityGroupType) EnumDescriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{0}
+}
+
 type Protocol int32
 
 const (
@@ -53,11 +102,11 @@ func (x Protocol) String() string {
 }
 
 func (Protocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_sdn_v1_ovnnet_proto_enumTypes[0].Descriptor()
+	return file_api_sdn_v1_ovnnet_proto_enumTypes[1].Descriptor()
 }
 
 func (Protocol) Type() protoreflect.EnumType {
-	return &file_api_sdn_v1_ovnnet_proto_enumTypes[0]
+	return &file_api_sdn_v1_ovnnet_proto_enumTypes[1]
 }
 
 func (x Protocol) Number() protoreflect.EnumNumber {
@@ -66,7 +115,7 @@ func (x Protocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Protocol.Descriptor instead.
 func (Protocol) EnumDescriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{0}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{1}
 }
 
 type Direction int32
@@ -102,11 +151,11 @@ func (x Direction) String() string {
 }
 
 func (Direction) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_sdn_v1_ovnnet_proto_enumTypes[1].Descriptor()
+	return file_api_sdn_v1_ovnnet_proto_enumTypes[2].Descriptor()
 }
 
 func (Direction) Type() protoreflect.EnumType {
-	return &file_api_sdn_v1_ovnnet_proto_enumTypes[1]
+	return &file_api_sdn_v1_ovnnet_proto_enumTypes[2]
 }
 
 func (x Direction) Number() protoreflect.EnumNumber {
@@ -115,7 +164,7 @@ func (x Direction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Direction.Descriptor instead.
 func (Direction) EnumDescriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{1}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{2}
 }
 
 type SecurityAction int32
@@ -151,11 +200,11 @@ func (x SecurityAction) String() string {
 }
 
 func (SecurityAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_sdn_v1_ovnnet_proto_enumTypes[2].Descriptor()
+	return file_api_sdn_v1_ovnnet_proto_enumTypes[3].Descriptor()
 }
 
 func (SecurityAction) Type() protoreflect.EnumType {
-	return &file_api_sdn_v1_ovnnet_proto_enumTypes[2]
+	return &file_api_sdn_v1_ovnnet_proto_enumTypes[3]
 }
 
 func (x SecurityAction) Number() protoreflect.EnumNumber {
@@ -164,7 +213,56 @@ func (x SecurityAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SecurityAction.Descriptor instead.
 func (SecurityAction) EnumDescriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{2}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{3}
+}
+
+type AddressTranslationType int32
+
+const (
+	AddressTranslationType_SHARED                 AddressTranslationType = 0 // This will result in a SNAT
+	AddressTranslationType_INDIVIDUAL_CENTRALIZED AddressTranslationType = 1 // This will result in a SDNAT through a centralized gateway
+	AddressTranslationType_INDIVIDUAL_DISTRIBUTED AddressTranslationType = 2 // This will result in a SDNAT through a distributed gateway
+)
+
+// Enum value maps for AddressTranslationType.
+var (
+	AddressTranslationType_name = map[int32]string{
+		0: "SHARED",
+		1: "INDIVIDUAL_CENTRALIZED",
+		2: "INDIVIDUAL_DISTRIBUTED",
+	}
+	AddressTranslationType_value = map[string]int32{
+		"SHARED":                 0,
+		"INDIVIDUAL_CENTRALIZED": 1,
+		"INDIVIDUAL_DISTRIBUTED": 2,
+	}
+)
+
+func (x AddressTranslationType) Enum() *AddressTranslationType {
+	p := new(AddressTranslationType)
+	*p = x
+	return p
+}
+
+func (x AddressTranslationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressTranslationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sdn_v1_ovnnet_proto_enumTypes[4].Descriptor()
+}
+
+func (AddressTranslationType) Type() protoreflect.EnumType {
+	return &file_api_sdn_v1_ovnnet_proto_enumTypes[4]
+}
+
+func (x AddressTranslationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressTranslationType.Descriptor instead.
+func (AddressTranslationType) EnumDescriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{4}
 }
 
 type ListVPCsRequest struct {
@@ -2230,7 +2328,7 @@ type CreatePortRequest struct {
 
 	PortId             *PortId   `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	SubnetId           *SubnetId `protobuf:"bytes,2,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
-	ChassisId          uint32    `protobuf:"varint,3,opt,name=chassis_id,json=chassisId,proto3" json:"chassis_id,omitempty"`
+	ChassisId          string    `protobuf:"bytes,3,opt,name=chassis_id,json=chassisId,proto3" json:"chassis_id,omitempty"`
 	DeviceId           uint32    `protobuf:"varint,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	MACAddress         string    `protobuf:"bytes,5,opt,name=MAC_address,json=MACAddress,proto3" json:"MAC_address,omitempty"`
 	Internal_IPAddress string    `protobuf:"bytes,6,opt,name=internal_IP_address,json=internalIPAddress,proto3" json:"internal_IP_address,omitempty"`
@@ -2286,11 +2384,11 @@ func (x *CreatePortRequest) GetSubnetId() *SubnetId {
 	return nil
 }
 
-func (x *CreatePortRequest) GetChassisId() uint32 {
+func (x *CreatePortRequest) GetChassisId() string {
 	if x != nil {
 		return x.ChassisId
 	}
-	return 0
+	return ""
 }
 
 func (x *CreatePortRequest) GetDeviceId() uint32 {
@@ -2803,7 +2901,7 @@ type CreateSecurityGroupRequest struct {
 
 	SecurityGroupId *SecurityGroupId  `protobuf:"bytes,1,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
 	Name            string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	SecurityRules   []*SecurityRuleId `protobuf:"bytes,3,rep,name=security_rules,json=securityRules,proto3" json:"security_rules,omitempty"`
+	Type            SecurityGroupType `protobuf:"varint,3,opt,name=type,proto3,enum=sdn.v1.SecurityGroupType" json:"type,omitempty"`
 	PortIds         []*PortId         `protobuf:"bytes,4,rep,name=port_ids,json=portIds,proto3" json:"port_ids,omitempty"`
 	SubnetIds       []*SubnetId       `protobuf:"bytes,5,rep,name=subnet_ids,json=subnetIds,proto3" json:"subnet_ids,omitempty"`
 	VpcId           *VPCId            `protobuf:"bytes,6,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
@@ -2855,11 +2953,11 @@ func (x *CreateSecurityGroupRequest) GetName() string {
 	return ""
 }
 
-func (x *CreateSecurityGroupRequest) GetSecurityRules() []*SecurityRuleId {
+func (x *CreateSecurityGroupRequest) GetType() SecurityGroupType {
 	if x != nil {
-		return x.SecurityRules
+		return x.Type
 	}
-	return nil
+	return SecurityGroupType_PORT
 }
 
 func (x *CreateSecurityGroupRequest) GetPortIds() []*PortId {
@@ -3029,10 +3127,10 @@ type UpdateSecurityGroupRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SecurityGroupId *SecurityGroupId  `protobuf:"bytes,1,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
-	SecurityRules   []*SecurityRuleId `protobuf:"bytes,2,rep,name=security_rules,json=securityRules,proto3" json:"security_rules,omitempty"` // This list should contain all the rules expected to be part of the Security Group once the update has taken effect.
-	PortIds         []*PortId         `protobuf:"bytes,3,rep,name=port_ids,json=portIds,proto3" json:"port_ids,omitempty"`                   // This list should contain all the Ports expected to be part of the Security Group once the update has taken effect.
-	SubnetIds       []*SubnetId       `protobuf:"bytes,4,rep,name=subnet_ids,json=subnetIds,proto3" json:"subnet_ids,omitempty"`             // This list should contain all the Subnets expected to be part of the Security Group once the update has taken effect.
+	SecurityGroupId *SecurityGroupId `protobuf:"bytes,1,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
+	// Depending on the type of Security Group either Ports or Subnets can be listed.
+	PortIds   []*PortId   `protobuf:"bytes,2,rep,name=port_ids,json=portIds,proto3" json:"port_ids,omitempty"`       // This list should contain all the Ports expected to be part of the Security Group once the update has taken effect.
+	SubnetIds []*SubnetId `protobuf:"bytes,3,rep,name=subnet_ids,json=subnetIds,proto3" json:"subnet_ids,omitempty"` // This list should contain all the Subnets expected to be part of the Security Group once the update has taken effect.
 }
 
 func (x *UpdateSecurityGroupRequest) Reset() {
@@ -3074,13 +3172,6 @@ func (x *UpdateSecurityGroupRequest) GetSecurityGroupId() *SecurityGroupId {
 	return nil
 }
 
-func (x *UpdateSecurityGroupRequest) GetSecurityRules() []*SecurityRuleId {
-	if x != nil {
-		return x.SecurityRules
-	}
-	return nil
-}
-
 func (x *UpdateSecurityGroupRequest) GetPortIds() []*PortId {
 	if x != nil {
 		return x.PortIds
@@ -3326,17 +3417,19 @@ type CreateSecurityRuleRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SecurityRuleId          *SecurityRuleId `protobuf:"bytes,1,opt,name=security_rule_id,json=securityRuleId,proto3" json:"security_rule_id,omitempty"`
-	Name                    string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Priority                uint32          `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
-	Direction               Direction       `protobuf:"varint,4,opt,name=direction,proto3,enum=sdn.v1.Direction" json:"direction,omitempty"`
-	Source_IPAddresses      []string        `protobuf:"bytes,5,rep,name=source_IP_addresses,json=sourceIPAddresses,proto3" json:"source_IP_addresses,omitempty"`
-	Destination_IPAddresses []string        `protobuf:"bytes,6,rep,name=destination_IP_addresses,json=destinationIPAddresses,proto3" json:"destination_IP_addresses,omitempty"`
-	Protocol                *Protocol       `protobuf:"varint,7,opt,name=protocol,proto3,enum=sdn.v1.Protocol,oneof" json:"protocol,omitempty"`
-	SourcePortRange         *PortRange      `protobuf:"bytes,8,opt,name=source_port_range,json=sourcePortRange,proto3,oneof" json:"source_port_range,omitempty"`
-	DestinationPortRange    *PortRange      `protobuf:"bytes,9,opt,name=destination_port_range,json=destinationPortRange,proto3,oneof" json:"destination_port_range,omitempty"`
-	Action                  SecurityAction  `protobuf:"varint,10,opt,name=action,proto3,enum=sdn.v1.SecurityAction" json:"action,omitempty"`
-	VpcId                   *VPCId          `protobuf:"bytes,11,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	SecurityRuleId          *SecurityRuleId      `protobuf:"bytes,1,opt,name=security_rule_id,json=securityRuleId,proto3" json:"security_rule_id,omitempty"`
+	Name                    string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SecurityGroupId         *SecurityGroupId     `protobuf:"bytes,3,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
+	Priority                uint32               `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Direction               Direction            `protobuf:"varint,5,opt,name=direction,proto3,enum=sdn.v1.Direction" json:"direction,omitempty"`
+	Source_IPAddresses      []string             `protobuf:"bytes,6,rep,name=source_IP_addresses,json=sourceIPAddresses,proto3" json:"source_IP_addresses,omitempty"`
+	Destination_IPAddresses []string             `protobuf:"bytes,7,rep,name=destination_IP_addresses,json=destinationIPAddresses,proto3" json:"destination_IP_addresses,omitempty"`
+	Protocol                *Protocol            `protobuf:"varint,8,opt,name=protocol,proto3,enum=sdn.v1.Protocol,oneof" json:"protocol,omitempty"`
+	SourcePortRange         *PortRange           `protobuf:"bytes,9,opt,name=source_port_range,json=sourcePortRange,proto3,oneof" json:"source_port_range,omitempty"`
+	DestinationPortRange    *PortRange           `protobuf:"bytes,10,opt,name=destination_port_range,json=destinationPortRange,proto3,oneof" json:"destination_port_range,omitempty"`
+	Action                  SecurityAction       `protobuf:"varint,11,opt,name=action,proto3,enum=sdn.v1.SecurityAction" json:"action,omitempty"`
+	VpcId                   *VPCId               `protobuf:"bytes,12,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	Logging                 *SecurityRuleLogging `protobuf:"bytes,13,opt,name=logging,proto3" json:"logging,omitempty"`
 }
 
 func (x *CreateSecurityRuleRequest) Reset() {
@@ -3385,6 +3478,13 @@ func (x *CreateSecurityRuleRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateSecurityRuleRequest) GetSecurityGroupId() *SecurityGroupId {
+	if x != nil {
+		return x.SecurityGroupId
+	}
+	return nil
+}
+
 func (x *CreateSecurityRuleRequest) GetPriority() uint32 {
 	if x != nil {
 		return x.Priority
@@ -3448,6 +3548,13 @@ func (x *CreateSecurityRuleRequest) GetVpcId() *VPCId {
 	return nil
 }
 
+func (x *CreateSecurityRuleRequest) GetLogging() *SecurityRuleLogging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
 type CreateSecurityRuleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3589,16 +3696,25 @@ func (x *GetSecurityRuleResponse) GetSecurityRule() *SecurityRule {
 	return nil
 }
 
-type DeleteSecurityRuleRequest struct {
+type UpdateSecurityRuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SecurityRuleId *SecurityRuleId `protobuf:"bytes,1,opt,name=security_rule_id,json=securityRuleId,proto3" json:"security_rule_id,omitempty"`
+	SecurityRuleId          *SecurityRuleId      `protobuf:"bytes,1,opt,name=security_rule_id,json=securityRuleId,proto3" json:"security_rule_id,omitempty"`
+	Priority                uint32               `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Direction               Direction            `protobuf:"varint,3,opt,name=direction,proto3,enum=sdn.v1.Direction" json:"direction,omitempty"`
+	Source_IPAddresses      []string             `protobuf:"bytes,4,rep,name=source_IP_addresses,json=sourceIPAddresses,proto3" json:"source_IP_addresses,omitempty"`
+	Destination_IPAddresses []string             `protobuf:"bytes,5,rep,name=destination_IP_addresses,json=destinationIPAddresses,proto3" json:"destination_IP_addresses,omitempty"`
+	Protocol                *Protocol            `protobuf:"varint,6,opt,name=protocol,proto3,enum=sdn.v1.Protocol,oneof" json:"protocol,omitempty"`
+	SourcePortRange         *PortRange           `protobuf:"bytes,7,opt,name=source_port_range,json=sourcePortRange,proto3,oneof" json:"source_port_range,omitempty"`
+	DestinationPortRange    *PortRange           `protobuf:"bytes,8,opt,name=destination_port_range,json=destinationPortRange,proto3,oneof" json:"destination_port_range,omitempty"`
+	Action                  SecurityAction       `protobuf:"varint,9,opt,name=action,proto3,enum=sdn.v1.SecurityAction" json:"action,omitempty"`
+	Logging                 *SecurityRuleLogging `protobuf:"bytes,10,opt,name=logging,proto3" json:"logging,omitempty"`
 }
 
-func (x *DeleteSecurityRuleRequest) Reset() {
-	*x = DeleteSecurityRuleRequest{}
+func (x *UpdateSecurityRuleRequest) Reset() {
+	*x = UpdateSecurityRuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3606,13 +3722,13 @@ func (x *DeleteSecurityRuleRequest) Reset() {
 	}
 }
 
-func (x *DeleteSecurityRuleRequest) String() string {
+func (x *UpdateSecurityRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSecurityRuleRequest) ProtoMessage() {}
+func (*UpdateSecurityRuleRequest) ProtoMessage() {}
 
-func (x *DeleteSecurityRuleRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateSecurityRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3624,19 +3740,82 @@ func (x *DeleteSecurityRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSecurityRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSecurityRuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSecurityRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecurityRuleRequest) Descriptor() ([]byte, []int) {
 	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DeleteSecurityRuleRequest) GetSecurityRuleId() *SecurityRuleId {
+func (x *UpdateSecurityRuleRequest) GetSecurityRuleId() *SecurityRuleId {
 	if x != nil {
 		return x.SecurityRuleId
 	}
 	return nil
 }
 
-type DeleteSecurityRuleResponse struct {
+func (x *UpdateSecurityRuleRequest) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateSecurityRuleRequest) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_DIR_UNSPECIFIED
+}
+
+func (x *UpdateSecurityRuleRequest) GetSource_IPAddresses() []string {
+	if x != nil {
+		return x.Source_IPAddresses
+	}
+	return nil
+}
+
+func (x *UpdateSecurityRuleRequest) GetDestination_IPAddresses() []string {
+	if x != nil {
+		return x.Destination_IPAddresses
+	}
+	return nil
+}
+
+func (x *UpdateSecurityRuleRequest) GetProtocol() Protocol {
+	if x != nil && x.Protocol != nil {
+		return *x.Protocol
+	}
+	return Protocol_TCP
+}
+
+func (x *UpdateSecurityRuleRequest) GetSourcePortRange() *PortRange {
+	if x != nil {
+		return x.SourcePortRange
+	}
+	return nil
+}
+
+func (x *UpdateSecurityRuleRequest) GetDestinationPortRange() *PortRange {
+	if x != nil {
+		return x.DestinationPortRange
+	}
+	return nil
+}
+
+func (x *UpdateSecurityRuleRequest) GetAction() SecurityAction {
+	if x != nil {
+		return x.Action
+	}
+	return SecurityAction_ACTION_UNSPECIFIED
+}
+
+func (x *UpdateSecurityRuleRequest) GetLogging() *SecurityRuleLogging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+type UpdateSecurityRuleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3644,8 +3823,8 @@ type DeleteSecurityRuleResponse struct {
 	SecurityRuleId *SecurityRuleId `protobuf:"bytes,1,opt,name=security_rule_id,json=securityRuleId,proto3" json:"security_rule_id,omitempty"`
 }
 
-func (x *DeleteSecurityRuleResponse) Reset() {
-	*x = DeleteSecurityRuleResponse{}
+func (x *UpdateSecurityRuleResponse) Reset() {
+	*x = UpdateSecurityRuleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3653,13 +3832,13 @@ func (x *DeleteSecurityRuleResponse) Reset() {
 	}
 }
 
-func (x *DeleteSecurityRuleResponse) String() string {
+func (x *UpdateSecurityRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSecurityRuleResponse) ProtoMessage() {}
+func (*UpdateSecurityRuleResponse) ProtoMessage() {}
 
-func (x *DeleteSecurityRuleResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateSecurityRuleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3671,28 +3850,28 @@ func (x *DeleteSecurityRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSecurityRuleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSecurityRuleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSecurityRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecurityRuleResponse) Descriptor() ([]byte, []int) {
 	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *DeleteSecurityRuleResponse) GetSecurityRuleId() *SecurityRuleId {
+func (x *UpdateSecurityRuleResponse) GetSecurityRuleId() *SecurityRuleId {
 	if x != nil {
 		return x.SecurityRuleId
 	}
 	return nil
 }
 
-type ObjStatus struct {
+type DeleteSecurityRuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SecurityRuleId *SecurityRuleId `protobuf:"bytes,1,opt,name=security_rule_id,json=securityRuleId,proto3" json:"security_rule_id,omitempty"`
 }
 
-func (x *ObjStatus) Reset() {
-	*x = ObjStatus{}
+func (x *DeleteSecurityRuleRequest) Reset() {
+	*x = DeleteSecurityRuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3700,13 +3879,13 @@ func (x *ObjStatus) Reset() {
 	}
 }
 
-func (x *ObjStatus) String() string {
+func (x *DeleteSecurityRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObjStatus) ProtoMessage() {}
+func (*DeleteSecurityRuleRequest) ProtoMessage() {}
 
-func (x *ObjStatus) ProtoReflect() protoreflect.Message {
+func (x *DeleteSecurityRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3718,28 +3897,28 @@ func (x *ObjStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObjStatus.ProtoReflect.Descriptor instead.
-func (*ObjStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSecurityRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecurityRuleRequest) Descriptor() ([]byte, []int) {
 	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ObjStatus) GetStatus() string {
+func (x *DeleteSecurityRuleRequest) GetSecurityRuleId() *SecurityRuleId {
 	if x != nil {
-		return x.Status
+		return x.SecurityRuleId
 	}
-	return ""
+	return nil
 }
 
-type VPCId struct {
+type DeleteSecurityRuleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SecurityRuleId *SecurityRuleId `protobuf:"bytes,1,opt,name=security_rule_id,json=securityRuleId,proto3" json:"security_rule_id,omitempty"`
 }
 
-func (x *VPCId) Reset() {
-	*x = VPCId{}
+func (x *DeleteSecurityRuleResponse) Reset() {
+	*x = DeleteSecurityRuleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3747,13 +3926,13 @@ func (x *VPCId) Reset() {
 	}
 }
 
-func (x *VPCId) String() string {
+func (x *DeleteSecurityRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VPCId) ProtoMessage() {}
+func (*DeleteSecurityRuleResponse) ProtoMessage() {}
 
-func (x *VPCId) ProtoReflect() protoreflect.Message {
+func (x *DeleteSecurityRuleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3765,34 +3944,26 @@ func (x *VPCId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VPCId.ProtoReflect.Descriptor instead.
-func (*VPCId) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSecurityRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecurityRuleResponse) Descriptor() ([]byte, []int) {
 	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *VPCId) GetUuid() string {
+func (x *DeleteSecurityRuleResponse) GetSecurityRuleId() *SecurityRuleId {
 	if x != nil {
-		return x.Uuid
+		return x.SecurityRuleId
 	}
-	return ""
+	return nil
 }
 
-type VPC struct {
+type ListAddressTranslationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id       *VPCId      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TenantId string      `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // Uniquely identifes a consumer of VPC
-	RegionId string      `protobuf:"bytes,4,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"` // Identifies the region. Must be same as the region of controller instance.
-	Routers  []*RouterId `protobuf:"bytes,5,rep,name=routers,proto3" json:"routers,omitempty"`
-	Subnets  []*SubnetId `protobuf:"bytes,6,rep,name=subnets,proto3" json:"subnets,omitempty"`
-	Status   *ObjStatus  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *VPC) Reset() {
-	*x = VPC{}
+func (x *ListAddressTranslationsRequest) Reset() {
+	*x = ListAddressTranslationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3800,13 +3971,13 @@ func (x *VPC) Reset() {
 	}
 }
 
-func (x *VPC) String() string {
+func (x *ListAddressTranslationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VPC) ProtoMessage() {}
+func (*ListAddressTranslationsRequest) ProtoMessage() {}
 
-func (x *VPC) ProtoReflect() protoreflect.Message {
+func (x *ListAddressTranslationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3818,37 +3989,554 @@ func (x *VPC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VPC.ProtoReflect.Descriptor instead.
-func (*VPC) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAddressTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressTranslationsRequest) Descriptor() ([]byte, []int) {
 	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *VPC) GetId() *VPCId {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
+type ListAddressTranslationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *VPC) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+	AddressTranslationIds []*AddressTranslationId `protobuf:"bytes,1,rep,name=address_translation_ids,json=addressTranslationIds,proto3" json:"address_translation_ids,omitempty"`
 }
 
-func (x *VPC) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
+func (x *ListAddressTranslationsResponse) Reset() {
+	*x = ListAddressTranslationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *VPC) GetRegionId() string {
-	if x != nil {
-		return x.RegionId
-	}
-	return ""
+func (x *ListAddressTranslationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressTranslationsResponse) ProtoMessage() {}
+
+func (x *ListAddressTranslationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressTranslationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressTranslationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListAddressTranslationsResponse) GetAddressTranslationIds() []*AddressTranslationId {
+	if x != nil {
+		return x.AddressTranslationIds
+	}
+	return nil
+}
+
+type CreateAddressTranslationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddressTranslationId *AddressTranslationId  `protobuf:"bytes,1,opt,name=address_translation_id,json=addressTranslationId,proto3" json:"address_translation_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PortId               *PortId                `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`                                  // Port to create translation for
+	ServiceNetworkId     uint32                 `protobuf:"varint,4,opt,name=service_network_id,json=serviceNetworkId,proto3" json:"service_network_id,omitempty"` // The service network the transalted traffic is to be forwarded to
+	TranslationType      AddressTranslationType `protobuf:"varint,5,opt,name=translation_type,json=translationType,proto3,enum=sdn.v1.AddressTranslationType" json:"translation_type,omitempty"`
+	ServiceSpecific_IP   *string                `protobuf:"bytes,6,opt,name=service_specific_IP,json=serviceSpecificIP,proto3,oneof" json:"service_specific_IP,omitempty"`    // IP address to translate to for the service network
+	ServiceSpecific_MAC  *string                `protobuf:"bytes,7,opt,name=service_specific_MAC,json=serviceSpecificMAC,proto3,oneof" json:"service_specific_MAC,omitempty"` // MAC address on the service network
+}
+
+func (x *CreateAddressTranslationRequest) Reset() {
+	*x = CreateAddressTranslationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAddressTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressTranslationRequest) ProtoMessage() {}
+
+func (x *CreateAddressTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressTranslationRequest.ProtoReflect.Descriptor instead.
+func (*CreateAddressTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateAddressTranslationRequest) GetAddressTranslationId() *AddressTranslationId {
+	if x != nil {
+		return x.AddressTranslationId
+	}
+	return nil
+}
+
+func (x *CreateAddressTranslationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAddressTranslationRequest) GetPortId() *PortId {
+	if x != nil {
+		return x.PortId
+	}
+	return nil
+}
+
+func (x *CreateAddressTranslationRequest) GetServiceNetworkId() uint32 {
+	if x != nil {
+		return x.ServiceNetworkId
+	}
+	return 0
+}
+
+func (x *CreateAddressTranslationRequest) GetTranslationType() AddressTranslationType {
+	if x != nil {
+		return x.TranslationType
+	}
+	return AddressTranslationType_SHARED
+}
+
+func (x *CreateAddressTranslationRequest) GetServiceSpecific_IP() string {
+	if x != nil && x.ServiceSpecific_IP != nil {
+		return *x.ServiceSpecific_IP
+	}
+	return ""
+}
+
+func (x *CreateAddressTranslationRequest) GetServiceSpecific_MAC() string {
+	if x != nil && x.ServiceSpecific_MAC != nil {
+		return *x.ServiceSpecific_MAC
+	}
+	return ""
+}
+
+type CreateAddressTranslationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddressTranslationId *AddressTranslationId `protobuf:"bytes,1,opt,name=address_translation_id,json=addressTranslationId,proto3" json:"address_translation_id,omitempty"`
+}
+
+func (x *CreateAddressTranslationResponse) Reset() {
+	*x = CreateAddressTranslationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAddressTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressTranslationResponse) ProtoMessage() {}
+
+func (x *CreateAddressTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressTranslationResponse.ProtoReflect.Descriptor instead.
+func (*CreateAddressTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateAddressTranslationResponse) GetAddressTranslationId() *AddressTranslationId {
+	if x != nil {
+		return x.AddressTranslationId
+	}
+	return nil
+}
+
+type GetAddressTranslationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddressTranslationId *AddressTranslationId `protobuf:"bytes,1,opt,name=address_translation_id,json=addressTranslationId,proto3" json:"address_translation_id,omitempty"`
+}
+
+func (x *GetAddressTranslationRequest) Reset() {
+	*x = GetAddressTranslationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAddressTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressTranslationRequest) ProtoMessage() {}
+
+func (x *GetAddressTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressTranslationRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetAddressTranslationRequest) GetAddressTranslationId() *AddressTranslationId {
+	if x != nil {
+		return x.AddressTranslationId
+	}
+	return nil
+}
+
+type GetAddressTranslationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddressTranslation *AddressTranslation `protobuf:"bytes,1,opt,name=address_translation,json=addressTranslation,proto3" json:"address_translation,omitempty"`
+}
+
+func (x *GetAddressTranslationResponse) Reset() {
+	*x = GetAddressTranslationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAddressTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressTranslationResponse) ProtoMessage() {}
+
+func (x *GetAddressTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressTranslationResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAddressTranslationResponse) GetAddressTranslation() *AddressTranslation {
+	if x != nil {
+		return x.AddressTranslation
+	}
+	return nil
+}
+
+type DeleteAddressTranslationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddressTranslationId *AddressTranslationId `protobuf:"bytes,1,opt,name=address_translation_id,json=addressTranslationId,proto3" json:"address_translation_id,omitempty"`
+}
+
+func (x *DeleteAddressTranslationRequest) Reset() {
+	*x = DeleteAddressTranslationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAddressTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteAddressTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteAddressTranslationRequest) GetAddressTranslationId() *AddressTranslationId {
+	if x != nil {
+		return x.AddressTranslationId
+	}
+	return nil
+}
+
+type DeleteAddressTranslationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddressTranslationId *AddressTranslationId `protobuf:"bytes,1,opt,name=address_translation_id,json=addressTranslationId,proto3" json:"address_translation_id,omitempty"`
+}
+
+func (x *DeleteAddressTranslationResponse) Reset() {
+	*x = DeleteAddressTranslationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAddressTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressTranslationResponse) ProtoMessage() {}
+
+func (x *DeleteAddressTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressTranslationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAddressTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteAddressTranslationResponse) GetAddressTranslationId() *AddressTranslationId {
+	if x != nil {
+		return x.AddressTranslationId
+	}
+	return nil
+}
+
+type ObjStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ObjStatus) Reset() {
+	*x = ObjStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjStatus) ProtoMessage() {}
+
+func (x *ObjStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjStatus.ProtoReflect.Descriptor instead.
+func (*ObjStatus) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ObjStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type VPCId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *VPCId) Reset() {
+	*x = VPCId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VPCId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPCId) ProtoMessage() {}
+
+func (x *VPCId) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPCId.ProtoReflect.Descriptor instead.
+func (*VPCId) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *VPCId) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type VPC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       *VPCId      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TenantId string      `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // Uniquely identifes a consumer of VPC
+	RegionId string      `protobuf:"bytes,4,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"` // Identifies the region. Must be same as the region of controller instance.
+	Routers  []*RouterId `protobuf:"bytes,5,rep,name=routers,proto3" json:"routers,omitempty"`
+	Subnets  []*SubnetId `protobuf:"bytes,6,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	Status   *ObjStatus  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *VPC) Reset() {
+	*x = VPC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VPC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPC) ProtoMessage() {}
+
+func (x *VPC) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPC.ProtoReflect.Descriptor instead.
+func (*VPC) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *VPC) GetId() *VPCId {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *VPC) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VPC) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *VPC) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
 }
 
 func (x *VPC) GetRouters() []*RouterId {
@@ -3883,7 +4571,7 @@ type SubnetId struct {
 func (x *SubnetId) Reset() {
 	*x = SubnetId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[71]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3896,7 +4584,7 @@ func (x *SubnetId) String() string {
 func (*SubnetId) ProtoMessage() {}
 
 func (x *SubnetId) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[71]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3909,7 +4597,7 @@ func (x *SubnetId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubnetId.ProtoReflect.Descriptor instead.
 func (*SubnetId) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{71}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *SubnetId) GetUuid() string {
@@ -3935,7 +4623,7 @@ type Subnet struct {
 func (x *Subnet) Reset() {
 	*x = Subnet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[72]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3948,7 +4636,7 @@ func (x *Subnet) String() string {
 func (*Subnet) ProtoMessage() {}
 
 func (x *Subnet) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[72]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3961,7 +4649,7 @@ func (x *Subnet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subnet.ProtoReflect.Descriptor instead.
 func (*Subnet) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{72}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *Subnet) GetId() *SubnetId {
@@ -4017,7 +4705,7 @@ type RouterId struct {
 func (x *RouterId) Reset() {
 	*x = RouterId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[73]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4030,7 +4718,7 @@ func (x *RouterId) String() string {
 func (*RouterId) ProtoMessage() {}
 
 func (x *RouterId) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[73]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4043,7 +4731,7 @@ func (x *RouterId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouterId.ProtoReflect.Descriptor instead.
 func (*RouterId) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{73}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *RouterId) GetUuid() string {
@@ -4068,7 +4756,7 @@ type Router struct {
 func (x *Router) Reset() {
 	*x = Router{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[74]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4081,7 +4769,7 @@ func (x *Router) String() string {
 func (*Router) ProtoMessage() {}
 
 func (x *Router) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[74]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4094,7 +4782,7 @@ func (x *Router) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Router.ProtoReflect.Descriptor instead.
 func (*Router) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{74}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *Router) GetId() *RouterId {
@@ -4143,7 +4831,7 @@ type RouterInterfaceId struct {
 func (x *RouterInterfaceId) Reset() {
 	*x = RouterInterfaceId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[75]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4156,7 +4844,7 @@ func (x *RouterInterfaceId) String() string {
 func (*RouterInterfaceId) ProtoMessage() {}
 
 func (x *RouterInterfaceId) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[75]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4169,7 +4857,7 @@ func (x *RouterInterfaceId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouterInterfaceId.ProtoReflect.Descriptor instead.
 func (*RouterInterfaceId) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{75}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *RouterInterfaceId) GetUuid() string {
@@ -4195,7 +4883,7 @@ type RouterInterface struct {
 func (x *RouterInterface) Reset() {
 	*x = RouterInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[76]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4208,7 +4896,7 @@ func (x *RouterInterface) String() string {
 func (*RouterInterface) ProtoMessage() {}
 
 func (x *RouterInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[76]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4221,7 +4909,7 @@ func (x *RouterInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouterInterface.ProtoReflect.Descriptor instead.
 func (*RouterInterface) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{76}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *RouterInterface) GetId() *RouterInterfaceId {
@@ -4277,7 +4965,7 @@ type StaticRouteId struct {
 func (x *StaticRouteId) Reset() {
 	*x = StaticRouteId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[77]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4290,7 +4978,7 @@ func (x *StaticRouteId) String() string {
 func (*StaticRouteId) ProtoMessage() {}
 
 func (x *StaticRouteId) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[77]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4303,7 +4991,7 @@ func (x *StaticRouteId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticRouteId.ProtoReflect.Descriptor instead.
 func (*StaticRouteId) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{77}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *StaticRouteId) GetUuid() string {
@@ -4328,7 +5016,7 @@ type StaticRoute struct {
 func (x *StaticRoute) Reset() {
 	*x = StaticRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[78]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4341,7 +5029,7 @@ func (x *StaticRoute) String() string {
 func (*StaticRoute) ProtoMessage() {}
 
 func (x *StaticRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[78]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4354,7 +5042,7 @@ func (x *StaticRoute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticRoute.ProtoReflect.Descriptor instead.
 func (*StaticRoute) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{78}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *StaticRoute) GetId() *StaticRouteId {
@@ -4403,7 +5091,7 @@ type PortId struct {
 func (x *PortId) Reset() {
 	*x = PortId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[79]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4416,7 +5104,7 @@ func (x *PortId) String() string {
 func (*PortId) ProtoMessage() {}
 
 func (x *PortId) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[79]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4429,7 +5117,7 @@ func (x *PortId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortId.ProtoReflect.Descriptor instead.
 func (*PortId) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{79}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *PortId) GetUuid() string {
@@ -4449,7 +5137,7 @@ type Port struct {
 	SubnetId           *SubnetId  `protobuf:"bytes,3,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
 	IPAddress          string     `protobuf:"bytes,4,opt,name=IP_address,json=IPAddress,proto3" json:"IP_address,omitempty"`
 	MACAddress         *string    `protobuf:"bytes,5,opt,name=MAC_address,json=MACAddress,proto3,oneof" json:"MAC_address,omitempty"` // The preferred approach is for the caller to provide the MAC address. It is TBD whether the system can internally generate one.
-	ChassisId          uint32     `protobuf:"varint,6,opt,name=chassis_id,json=chassisId,proto3" json:"chassis_id,omitempty"`
+	ChassisId          string     `protobuf:"bytes,6,opt,name=chassis_id,json=chassisId,proto3" json:"chassis_id,omitempty"`
 	DeviceId           uint32     `protobuf:"varint,7,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	IsEnabled          bool       `protobuf:"varint,8,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
 	IsNAT              *string    `protobuf:"bytes,9,opt,name=isNAT,proto3,oneof" json:"isNAT,omitempty"`
@@ -4461,7 +5149,7 @@ type Port struct {
 func (x *Port) Reset() {
 	*x = Port{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[80]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4474,7 +5162,7 @@ func (x *Port) String() string {
 func (*Port) ProtoMessage() {}
 
 func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[80]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4487,7 +5175,7 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Port.ProtoReflect.Descriptor instead.
 func (*Port) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{80}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *Port) GetId() *PortId {
@@ -4525,11 +5213,11 @@ func (x *Port) GetMACAddress() string {
 	return ""
 }
 
-func (x *Port) GetChassisId() uint32 {
+func (x *Port) GetChassisId() string {
 	if x != nil {
 		return x.ChassisId
 	}
-	return 0
+	return ""
 }
 
 func (x *Port) GetDeviceId() uint32 {
@@ -4585,7 +5273,7 @@ type SecurityGroupId struct {
 func (x *SecurityGroupId) Reset() {
 	*x = SecurityGroupId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[81]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4598,7 +5286,7 @@ func (x *SecurityGroupId) String() string {
 func (*SecurityGroupId) ProtoMessage() {}
 
 func (x *SecurityGroupId) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[81]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4611,7 +5299,7 @@ func (x *SecurityGroupId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityGroupId.ProtoReflect.Descriptor instead.
 func (*SecurityGroupId) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{81}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *SecurityGroupId) GetUuid() string {
@@ -4628,17 +5316,18 @@ type SecurityGroup struct {
 
 	Id              *SecurityGroupId  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name            string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	SecurityRuleIds []*SecurityRuleId `protobuf:"bytes,3,rep,name=security_rule_ids,json=securityRuleIds,proto3" json:"security_rule_ids,omitempty"`
-	PortIds         []*PortId         `protobuf:"bytes,4,rep,name=port_ids,json=portIds,proto3" json:"port_ids,omitempty"`
-	SubnetIds       []*SubnetId       `protobuf:"bytes,5,rep,name=subnet_ids,json=subnetIds,proto3" json:"subnet_ids,omitempty"`
-	VpcId           *VPCId            `protobuf:"bytes,6,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
-	Status          *ObjStatus        `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Type            SecurityGroupType `protobuf:"varint,3,opt,name=type,proto3,enum=sdn.v1.SecurityGroupType" json:"type,omitempty"`
+	SecurityRuleIds []*SecurityRuleId `protobuf:"bytes,4,rep,name=security_rule_ids,json=securityRuleIds,proto3" json:"security_rule_ids,omitempty"`
+	PortIds         []*PortId         `protobuf:"bytes,5,rep,name=port_ids,json=portIds,proto3" json:"port_ids,omitempty"`
+	SubnetIds       []*SubnetId       `protobuf:"bytes,6,rep,name=subnet_ids,json=subnetIds,proto3" json:"subnet_ids,omitempty"`
+	VpcId           *VPCId            `protobuf:"bytes,7,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	Status          *ObjStatus        `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 }
 
 func (x *SecurityGroup) Reset() {
 	*x = SecurityGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[82]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4651,7 +5340,7 @@ func (x *SecurityGroup) String() string {
 func (*SecurityGroup) ProtoMessage() {}
 
 func (x *SecurityGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[82]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4664,7 +5353,7 @@ func (x *SecurityGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityGroup.ProtoReflect.Descriptor instead.
 func (*SecurityGroup) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{82}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *SecurityGroup) GetId() *SecurityGroupId {
@@ -4681,6 +5370,13 @@ func (x *SecurityGroup) GetName() string {
 	return ""
 }
 
+func (x *SecurityGroup) GetType() SecurityGroupType {
+	if x != nil {
+		return x.Type
+	}
+	return SecurityGroupType_PORT
+}
+
 func (x *SecurityGroup) GetSecurityRuleIds() []*SecurityRuleId {
 	if x != nil {
 		return x.SecurityRuleIds
@@ -4727,7 +5423,7 @@ type SecurityRuleId struct {
 func (x *SecurityRuleId) Reset() {
 	*x = SecurityRuleId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[83]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4740,7 +5436,7 @@ func (x *SecurityRuleId) String() string {
 func (*SecurityRuleId) ProtoMessage() {}
 
 func (x *SecurityRuleId) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[83]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4753,7 +5449,7 @@ func (x *SecurityRuleId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityRuleId.ProtoReflect.Descriptor instead.
 func (*SecurityRuleId) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{83}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *SecurityRuleId) GetUuid() string {
@@ -4768,25 +5464,26 @@ type SecurityRule struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                   *SecurityRuleId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Priority             uint32          `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"` // The priority should be unique within the SG the SR is included in otherwise behavior is unknown
-	Direction            Direction       `protobuf:"varint,4,opt,name=direction,proto3,enum=sdn.v1.Direction" json:"direction,omitempty"`
-	Source_IPs           []string        `protobuf:"bytes,7,rep,name=source_IPs,json=sourceIPs,proto3" json:"source_IPs,omitempty"`
-	Destination_IPs      []string        `protobuf:"bytes,8,rep,name=destination_IPs,json=destinationIPs,proto3" json:"destination_IPs,omitempty"`
-	Protocol             *Protocol       `protobuf:"varint,9,opt,name=protocol,proto3,enum=sdn.v1.Protocol,oneof" json:"protocol,omitempty"`
-	SourcePortRange      *PortRange      `protobuf:"bytes,10,opt,name=source_port_range,json=sourcePortRange,proto3,oneof" json:"source_port_range,omitempty"`
-	DestinationPortRange *PortRange      `protobuf:"bytes,11,opt,name=destination_port_range,json=destinationPortRange,proto3,oneof" json:"destination_port_range,omitempty"`
-	Action               SecurityAction  `protobuf:"varint,12,opt,name=action,proto3,enum=sdn.v1.SecurityAction" json:"action,omitempty"`
-	VpcId                *VPCId          `protobuf:"bytes,13,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
-	LoggingParametersTbd string          `protobuf:"bytes,14,opt,name=logging_parameters_tbd,json=loggingParametersTbd,proto3" json:"logging_parameters_tbd,omitempty"` // When implemented, add an Update API too.
-	Status               *ObjStatus      `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty"`
+	Id                   *SecurityRuleId      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SecurityGroupId      *SecurityGroupId     `protobuf:"bytes,3,opt,name=security_group_id,json=securityGroupId,proto3" json:"security_group_id,omitempty"`
+	Priority             uint32               `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"` // The priority should be unique within the SG the SR is included in otherwise behavior is unknown
+	Direction            Direction            `protobuf:"varint,5,opt,name=direction,proto3,enum=sdn.v1.Direction" json:"direction,omitempty"`
+	Source_IPs           []string             `protobuf:"bytes,6,rep,name=source_IPs,json=sourceIPs,proto3" json:"source_IPs,omitempty"`
+	Destination_IPs      []string             `protobuf:"bytes,7,rep,name=destination_IPs,json=destinationIPs,proto3" json:"destination_IPs,omitempty"`
+	Protocol             *Protocol            `protobuf:"varint,8,opt,name=protocol,proto3,enum=sdn.v1.Protocol,oneof" json:"protocol,omitempty"`
+	SourcePortRange      *PortRange           `protobuf:"bytes,9,opt,name=source_port_range,json=sourcePortRange,proto3,oneof" json:"source_port_range,omitempty"`
+	DestinationPortRange *PortRange           `protobuf:"bytes,10,opt,name=destination_port_range,json=destinationPortRange,proto3,oneof" json:"destination_port_range,omitempty"`
+	Action               SecurityAction       `protobuf:"varint,11,opt,name=action,proto3,enum=sdn.v1.SecurityAction" json:"action,omitempty"`
+	VpcId                *VPCId               `protobuf:"bytes,12,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	Status               *ObjStatus           `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	Logging              *SecurityRuleLogging `protobuf:"bytes,15,opt,name=logging,proto3" json:"logging,omitempty"`
 }
 
 func (x *SecurityRule) Reset() {
 	*x = SecurityRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[84]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4799,7 +5496,7 @@ func (x *SecurityRule) String() string {
 func (*SecurityRule) ProtoMessage() {}
 
 func (x *SecurityRule) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[84]
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4812,7 +5509,7 @@ func (x *SecurityRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityRule.ProtoReflect.Descriptor instead.
 func (*SecurityRule) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{84}
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *SecurityRule) GetId() *SecurityRuleId {
@@ -4829,6 +5526,13 @@ func (x *SecurityRule) GetName() string {
 	return ""
 }
 
+func (x *SecurityRule) GetSecurityGroupId() *SecurityGroupId {
+	if x != nil {
+		return x.SecurityGroupId
+	}
+	return nil
+}
+
 func (x *SecurityRule) GetPriority() uint32 {
 	if x != nil {
 		return x.Priority
@@ -4892,46 +5596,211 @@ func (x *SecurityRule) GetVpcId() *VPCId {
 	return nil
 }
 
-func (x *SecurityRule) GetLoggingParametersTbd() string {
+func (x *SecurityRule) GetStatus() *ObjStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SecurityRule) GetLogging() *SecurityRuleLogging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+// Flow logging of the connections matched by a security rule.
+// The name is included in every log record of the rule and is used to correlate
+// the record with the cloud account and the rule.
+type SecurityRuleLogging struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Up to 63 characters.
+}
+
+func (x *SecurityRuleLogging) Reset() {
+	*x = SecurityRuleLogging{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityRuleLogging) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityRuleLogging) ProtoMessage() {}
+
+func (x *SecurityRuleLogging) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityRuleLogging.ProtoReflect.Descriptor instead.
+func (*SecurityRuleLogging) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SecurityRuleLogging) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SecurityRuleLogging) GetName() string {
 	if x != nil {
-		return x.LoggingParametersTbd
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SecurityRule) GetStatus() *ObjStatus {
+type PortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *PortRange) Reset() {
+	*x = PortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortRange) ProtoMessage() {}
+
+func (x *PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
+func (*PortRange) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PortRange) GetMin() uint32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PortRange) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type AddressTranslationId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *AddressTranslationId) Reset() {
+	*x = AddressTranslationId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressTranslationId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressTranslationId) ProtoMessage() {}
+
+func (x *AddressTranslationId) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressTranslationId.ProtoReflect.Descriptor instead.
+func (*AddressTranslationId) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AddressTranslationId) GetUuid() string {
 	if x != nil {
-		return x.Status
+		return x.Uuid
 	}
-	return nil
+	return ""
 }
 
-type PortRange struct {
+type AddressTranslation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	AddressTranslationId *AddressTranslationId  `protobuf:"bytes,1,opt,name=address_translation_id,json=addressTranslationId,proto3" json:"address_translation_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PortId               *PortId                `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ServiceNetworkId     uint32                 `protobuf:"varint,4,opt,name=service_network_id,json=serviceNetworkId,proto3" json:"service_network_id,omitempty"`
+	TranslationType      AddressTranslationType `protobuf:"varint,5,opt,name=translation_type,json=translationType,proto3,enum=sdn.v1.AddressTranslationType" json:"translation_type,omitempty"`
+	ServiceSpecificIp    *string                `protobuf:"bytes,6,opt,name=service_specific_ip,json=serviceSpecificIp,proto3,oneof" json:"service_specific_ip,omitempty"`    // needed for types INDIVIDUAL_CENTRALIZED and INDIVIDUAL_DISTRIBUTED
+	ServiceSpecific_MAC  *string                `protobuf:"bytes,7,opt,name=service_specific_MAC,json=serviceSpecificMAC,proto3,oneof" json:"service_specific_MAC,omitempty"` // needed only for type INDIVIDUAL_DISTRIBUTED
 }
 
-func (x *PortRange) Reset() {
-	*x = PortRange{}
+func (x *AddressTranslation) Reset() {
+	*x = AddressTranslation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[85]
+		mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PortRange) String() string {
+func (x *AddressTranslation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortRange) ProtoMessage() {}
+func (*AddressTranslation) ProtoMessage() {}
 
-func (x *PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[85]
+func (x *AddressTranslation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sdn_v1_ovnnet_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4942,25 +5811,60 @@ func (x *PortRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
-func (*PortRange) Descriptor() ([]byte, []int) {
-	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use AddressTranslation.ProtoReflect.Descriptor instead.
+func (*AddressTranslation) Descriptor() ([]byte, []int) {
+	return file_api_sdn_v1_ovnnet_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *PortRange) GetMin() uint32 {
+func (x *AddressTranslation) GetAddressTranslationId() *AddressTranslationId {
 	if x != nil {
-		return x.Min
+		return x.AddressTranslationId
 	}
-	return 0
+	return nil
 }
 
-func (x *PortRange) GetMax() uint32 {
+func (x *AddressTranslation) GetName() string {
 	if x != nil {
-		return x.Max
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddressTranslation) GetPortId() *PortId {
+	if x != nil {
+		return x.PortId
+	}
+	return nil
+}
+
+func (x *AddressTranslation) GetServiceNetworkId() uint32 {
+	if x != nil {
+		return x.ServiceNetworkId
 	}
 	return 0
 }
 
+func (x *AddressTranslation) GetTranslationType() AddressTranslationType {
+	if x != nil {
+		return x.TranslationType
+	}
+	return AddressTranslationType_SHARED
+}
+
+func (x *AddressTranslation) GetServiceSpecificIp() string {
+	if x != nil && x.ServiceSpecificIp != nil {
+		return *x.ServiceSpecificIp
+	}
+	return ""
+}
+
+func (x *AddressTranslation) GetServiceSpecific_MAC() string {
+	if x != nil && x.ServiceSpecific_MAC != nil {
+		return *x.ServiceSpecific_MAC
+	}
+	return ""
+}
+
 var File_api_sdn_v1_ovnnet_proto protoreflect.FileDescriptor
 
 var file_api_sdn_v1_ovnnet_proto_rawDesc = []byte{
@@ -5193,7 +6097,7 @@ var file_api_sdn_v1_ovnnet_proto_rawDesc = []byte{
 	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
 	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e,
 	0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x73, 0x73, 0x69, 0x73, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x73, 0x73, 0x69,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x73, 0x73, 0x69,
 	0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
 	0x12, 0x1f, 0x0a, 0x0b, 0x4d, 0x41, 0x43, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
@@ -5265,18 +6169,17 @@ var file_api_sdn_v1_ovnnet_proto_rawDesc = []byte{
 	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e,
 	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75,
 	0x70, 0x49, 0x64, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x73, 0x22, 0xb6, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x75, 0x70, 0x49, 0x64, 0x73, 0x22, 0xa6, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
 	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
 	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
 	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a,
-	0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0d, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x08,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x08,
 	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
 	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x52, 0x07,
 	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65,
@@ -5302,492 +6205,708 @@ var file_api_sdn_v1_ovnnet_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
 	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xfc, 0x01, 0x0a,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xbd, 0x01, 0x0a,
 	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47,
 	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x11, 0x73,
 	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
 	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52,
 	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
-	0x12, 0x3d, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64,
-	0x52, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
-	0x29, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x49,
-	0x64, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x75,
-	0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64,
-	0x52, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x1b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x73, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52, 0x0f,
-	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22,
-	0x61, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a,
-	0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x64, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x64, 0x22, 0x62, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x43, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
-	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x64, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x5f, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x42, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65,
-	0x49, 0x64, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65,
-	0x49, 0x64, 0x73, 0x22, 0x81, 0x05, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64,
+	0x12, 0x29, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49,
+	0x64, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x1b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x22, 0x61, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64,
 	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
-	0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
-	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x49,
-	0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x31,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x88, 0x01,
-	0x01, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48,
-	0x01, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x02, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x43,
-	0x49, 0x64, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72,
+	0x65, 0x49, 0x64, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0xfd, 0x05, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x01, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x16, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x02,
+	0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x70, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x50, 0x43, 0x49, 0x64, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x22, 0xfe, 0x04, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x49, 0x50,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x31, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x42, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x01,
+	0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x02, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x19, 0x0a, 0x17,
 	0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
 	0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
 	0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
-	0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
-	0x65, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
-	0x0a, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x5d, 0x0a, 0x19, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x09, 0x4f, 0x62, 0x6a, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1b, 0x0a,
-	0x05, 0x56, 0x50, 0x43, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x03, 0x56,
-	0x50, 0x43, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x43, 0x49, 0x64, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x2a, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x73,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
-	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x52, 0x07,
-	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x17, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x15, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x22, 0xc8, 0x03, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x52, 0x14, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x52, 0x06,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x33, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x5f, 0x49, 0x50, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x49,
+	0x50, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x4d, 0x41, 0x43, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x4d, 0x41, 0x43, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x5f, 0x49, 0x50, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x4d, 0x41, 0x43, 0x22, 0x76, 0x0a, 0x20,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x14,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x52, 0x14, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x16, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x14, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x76, 0x0a,
+	0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52,
+	0x14, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x09, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1b, 0x0a, 0x05, 0x56, 0x50,
+	0x43, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x03, 0x56, 0x50, 0x43, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x43, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x07,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52,
+	0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62,
+	0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x1e, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
+	0xd0, 0x01, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x69, 0x64, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e,
+	0x65, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x43, 0x49, 0x64,
+	0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
 	0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x1e, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x75, 0x73, 0x22, 0x1e, 0x0a, 0x08, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
 	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x20, 0x0a,
+	0x69, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50,
-	0x43, 0x49, 0x64, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1e, 0x0a, 0x08, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x12, 0x20, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
-	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a,
-	0x6f, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x43,
-	0x49, 0x64, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x8d, 0x02,
-	0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x12, 0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x09,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x73,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64,
-	0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x49, 0x50, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x50, 0x12, 0x23, 0x0a,
-	0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x4d, 0x41, 0x43, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4d,
-	0x41, 0x43, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x23, 0x0a,
-	0x0d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x2d, 0x0a, 0x09, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1c, 0x0a, 0x06, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x22, 0xef, 0x03, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x2d, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
-	0x6e, 0x65, 0x74, 0x49, 0x64, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24,
-	0x0a, 0x0b, 0x4d, 0x41, 0x43, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x4d, 0x41, 0x43, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x73, 0x73, 0x69, 0x73, 0x5f,
-	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x73, 0x73, 0x69,
-	0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
-	0x19, 0x0a, 0x05, 0x69, 0x73, 0x4e, 0x41, 0x54, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x05, 0x69, 0x73, 0x4e, 0x41, 0x54, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12,
-	0x26, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x4d, 0x41, 0x43, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x4d, 0x41, 0x43, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65,
+	0x12, 0x24, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x43, 0x49, 0x64, 0x52,
+	0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x8d, 0x02, 0x0a, 0x0f, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x29,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x08,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x52, 0x08, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x5f, 0x49, 0x50, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x50, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x4d, 0x41, 0x43, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4d, 0x41, 0x43, 0x12,
+	0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
+	0xc0, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x52, 0x08, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
 	0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x73, 0x4e, 0x41, 0x54, 0x42, 0x16, 0x0a, 0x14,
-	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x5f, 0x4d, 0x41, 0x43, 0x22, 0x25, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xbd, 0x02, 0x0a,
-	0x0d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x11, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0f,
-	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12,
-	0x29, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x49,
-	0x64, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x75,
-	0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64,
-	0x52, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x76,
-	0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x43, 0x49, 0x64, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49,
-	0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x24, 0x0a, 0x0e,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x99, 0x05, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x75, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x75, 0x73, 0x22, 0x1c, 0x0a, 0x06, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0xef, 0x03, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x49, 0x64, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x49, 0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x4d,
+	0x41, 0x43, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x4d, 0x41, 0x43, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x73, 0x73, 0x69, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x73, 0x73, 0x69, 0x73, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x05,
+	0x69, 0x73, 0x4e, 0x41, 0x54, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x69,
+	0x73, 0x4e, 0x41, 0x54, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x4d, 0x41, 0x43, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x41,
+	0x43, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62,
+	0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x69, 0x73, 0x4e, 0x41, 0x54, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x49, 0x50, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x4d,
+	0x41, 0x43, 0x22, 0x25, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xec, 0x02, 0x0a, 0x0d, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x52, 0x07, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x52, 0x09, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x50, 0x43, 0x49, 0x64, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x24, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xfd,
+	0x05, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x49, 0x50, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x50, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x50, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x50, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x01, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x16,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48,
+	0x02, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x70,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x43, 0x49, 0x64, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69,
+	0x6e, 0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x4a, 0x04, 0x08, 0x0d, 0x10, 0x0e, 0x52, 0x16, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x62, 0x64, 0x22, 0x43,
+	0x0a, 0x13, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d,
+	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0xbb, 0x03, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x14, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x49, 0x50, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x50, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x50, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x50, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x01, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
-	0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x16, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
-	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x02,
-	0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72,
-	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x70, 0x63,
-	0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x50, 0x43, 0x49, 0x64, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12,
-	0x34, 0x0a, 0x16, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x62, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x14, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x54, 0x62, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
-	0x62, 0x6a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x14, 0x0a,
-	0x12, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2f,
-	0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x2a,
-	0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x54,
-	0x43, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x49, 0x43, 0x4d, 0x50, 0x10, 0x02, 0x2a, 0x39, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x47,
-	0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x47, 0x52, 0x45, 0x53, 0x53,
-	0x10, 0x02, 0x2a, 0x3d, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10,
-	0x02, 0x32, 0xf9, 0x15, 0x0a, 0x06, 0x4f, 0x76, 0x6e, 0x6e, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x08,
-	0x4c, 0x69, 0x73, 0x74, 0x56, 0x50, 0x43, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x50, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56,
-	0x50, 0x43, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a,
-	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x50, 0x43, 0x12, 0x18, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x50, 0x43, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x50, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x39, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x56, 0x50, 0x43, 0x12, 0x15, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x50, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56,
-	0x50, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x50, 0x43, 0x12, 0x18, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x50, 0x43, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x56, 0x50, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12,
-	0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
-	0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x6e, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65,
+	0x27, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64,
+	0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x33, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x49, 0x70, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x4d, 0x41, 0x43, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x4d, 0x41, 0x43, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a,
+	0x14, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x63, 0x5f, 0x69, 0x70, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x5f, 0x4d, 0x41, 0x43, 0x2a, 0x29,
+	0x0a, 0x11, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x55, 0x42, 0x4e, 0x45, 0x54, 0x10, 0x01, 0x2a, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x43, 0x4d, 0x50, 0x10,
+	0x02, 0x2a, 0x39, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13,
+	0x0a, 0x0f, 0x44, 0x49, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x45, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x2a, 0x3d, 0x0a, 0x0e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x02, 0x2a, 0x5c, 0x0a, 0x16, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x5f,
+	0x43, 0x45, 0x4e, 0x54, 0x52, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x5f, 0x44, 0x49, 0x53, 0x54,
+	0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x44, 0x10, 0x02, 0x32, 0x90, 0x1a, 0x0a, 0x06, 0x4f, 0x76,
+	0x6e, 0x6e, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x50, 0x43, 0x73,
+	0x12, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x50,
+	0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x50, 0x43, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x50, 0x43, 0x12, 0x18, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x50, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x50, 0x43, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x47, 0x65, 0x74,
+	0x56, 0x50, 0x43, 0x12, 0x15, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x50, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x50, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x50,
+	0x43, 0x12, 0x18, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x56, 0x50, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x50, 0x43, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x73,
-	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52,
+	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x63, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x24,
-	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x64,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x64,
 	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x23, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
 	0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x12, 0x20, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x1f, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x18, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
 	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x45, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x64, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12,
-	0x21, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x64,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x14, 0x5a,
-	0x12, 0x6f, 0x76, 0x6e, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x64, 0x6e,
-	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x64, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x60, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x64, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6f, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73,
+	0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x18, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x73, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x69, 0x5a, 0x67,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x6c,
+	0x2d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x64, 0x65,
+	0x76, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x69, 0x64, 0x63, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x64, 0x6e, 0x2d, 0x76,
+	0x6e, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x73, 0x64, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5802,286 +6921,335 @@ func file_api_sdn_v1_ovnnet_proto_rawDescGZIP() []byte {
 	return file_api_sdn_v1_ovnnet_proto_rawDescData
 }
 
-var file_api_sdn_v1_ovnnet_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_sdn_v1_ovnnet_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
-var file_api_sdn_v1_ovnnet_proto_goTypes = []interface{}{
-	(Protocol)(0),                         // 0: sdn.v1.Protocol
-	(Direction)(0),                        // 1: sdn.v1.Direction
-	(SecurityAction)(0),                   // 2: sdn.v1.SecurityAction
-	(*ListVPCsRequest)(nil),               // 3: sdn.v1.ListVPCsRequest
-	(*ListVPCsResponse)(nil),              // 4: sdn.v1.ListVPCsResponse
-	(*CreateVPCRequest)(nil),              // 5: sdn.v1.CreateVPCRequest
-	(*CreateVPCResponse)(nil),             // 6: sdn.v1.CreateVPCResponse
-	(*GetVPCRequest)(nil),                 // 7: sdn.v1.GetVPCRequest
-	(*GetVPCResponse)(nil),                // 8: sdn.v1.GetVPCResponse
-	(*DeleteVPCRequest)(nil),              // 9: sdn.v1.DeleteVPCRequest
-	(*DeleteVPCResponse)(nil),             // 10: sdn.v1.DeleteVPCResponse
-	(*ListSubnetsRequest)(nil),            // 11: sdn.v1.ListSubnetsRequest
-	(*ListSubnetsResponse)(nil),           // 12: sdn.v1.ListSubnetsResponse
-	(*CreateSubnetRequest)(nil),           // 13: sdn.v1.CreateSubnetRequest
-	(*CreateSubnetResponse)(nil),          // 14: sdn.v1.CreateSubnetResponse
-	(*GetSubnetRequest)(nil),              // 15: sdn.v1.GetSubnetRequest
-	(*GetSubnetResponse)(nil),             // 16: sdn.v1.GetSubnetResponse
-	(*DeleteSubnetRequest)(nil),           // 17: sdn.v1.DeleteSubnetRequest
-	(*DeleteSubnetResponse)(nil),          // 18: sdn.v1.DeleteSubnetResponse
-	(*ListRoutersRequest)(nil),            // 19: sdn.v1.ListRoutersRequest
-	(*ListRoutersResponse)(nil),           // 20: sdn.v1.ListRoutersResponse
-	(*CreateRouterRequest)(nil),           // 21: sdn.v1.CreateRouterRequest
-	(*CreateRouterResponse)(nil),          // 22: sdn.v1.CreateRouterResponse
-	(*GetRouterRequest)(nil),              // 23: sdn.v1.GetRouterRequest
-	(*GetRouterResponse)(nil),             // 24: sdn.v1.GetRouterResponse
-	(*DeleteRouterRequest)(nil),           // 25: sdn.v1.DeleteRouterRequest
-	(*DeleteRouterResponse)(nil),          // 26: sdn.v1.DeleteRouterResponse
-	(*ListRouterInterfacesRequest)(nil),   // 27: sdn.v1.ListRouterInterfacesRequest
-	(*ListRouterInterfacesResponse)(nil),  // 28: sdn.v1.ListRouterInterfacesResponse
-	(*CreateRouterInterfaceRequest)(nil),  // 29: sdn.v1.CreateRouterInterfaceRequest
-	(*GetRouterInterfaceRequest)(nil),     // 30: sdn.v1.GetRouterInterfaceRequest
-	(*GetRouterInterfaceResponse)(nil),    // 31: sdn.v1.GetRouterInterfaceResponse
-	(*CreateRouterInterfaceResponse)(nil), // 32: sdn.v1.CreateRouterInterfaceResponse
-	(*DeleteRouterInterfaceRequest)(nil),  // 33: sdn.v1.DeleteRouterInterfaceRequest
-	(*DeleteRouterInterfaceResponse)(nil), // 34: sdn.v1.DeleteRouterInterfaceResponse
-	(*ListStaticRoutesRequest)(nil),       // 35: sdn.v1.ListStaticRoutesRequest
-	(*ListStaticRoutesResponse)(nil),      // 36: sdn.v1.ListStaticRoutesResponse
-	(*CreateStaticRouteRequest)(nil),      // 37: sdn.v1.CreateStaticRouteRequest
-	(*CreateStaticRouteResponse)(nil),     // 38: sdn.v1.CreateStaticRouteResponse
-	(*GetStaticRouteRequest)(nil),         // 39: sdn.v1.GetStaticRouteRequest
-	(*GetStaticRouteResponse)(nil),        // 40: sdn.v1.GetStaticRouteResponse
-	(*DeleteStaticRouteRequest)(nil),      // 41: sdn.v1.DeleteStaticRouteRequest
-	(*DeleteStaticRouteResponse)(nil),     // 42: sdn.v1.DeleteStaticRouteResponse
-	(*ListPortsRequest)(nil),              // 43: sdn.v1.ListPortsRequest
-	(*ListPortsResponse)(nil),             // 44: sdn.v1.ListPortsResponse
-	(*CreatePortRequest)(nil),             // 45: sdn.v1.CreatePortRequest
-	(*CreatePortResponse)(nil),            // 46: sdn.v1.CreatePortResponse
-	(*GetPortRequest)(nil),                // 47: sdn.v1.GetPortRequest
-	(*GetPortResponse)(nil),               // 48: sdn.v1.GetPortResponse
-	(*UpdatePortRequest)(nil),             // 49: sdn.v1.UpdatePortRequest
-	(*UpdatePortResponse)(nil),            // 50: sdn.v1.UpdatePortResponse
-	(*DeletePortRequest)(nil),             // 51: sdn.v1.DeletePortRequest
-	(*DeletePortResponse)(nil),            // 52: sdn.v1.DeletePortResponse
-	(*ListSecurityGroupsRequest)(nil),     // 53: sdn.v1.ListSecurityGroupsRequest
-	(*ListSecurityGroupsResponse)(nil),    // 54: sdn.v1.ListSecurityGroupsResponse
-	(*CreateSecurityGroupRequest)(nil),    // 55: sdn.v1.CreateSecurityGroupRequest
-	(*CreateSecurityGroupResponse)(nil),   // 56: sdn.v1.CreateSecurityGroupResponse
-	(*GetSecurityGroupRequest)(nil),       // 57: sdn.v1.GetSecurityGroupRequest
-	(*GetSecurityGroupResponse)(nil),      // 58: sdn.v1.GetSecurityGroupResponse
-	(*UpdateSecurityGroupRequest)(nil),    // 59: sdn.v1.UpdateSecurityGroupRequest
-	(*UpdateSecurityGroupResponse)(nil),   // 60: sdn.v1.UpdateSecurityGroupResponse
-	(*DeleteSecurityGroupRequest)(nil),    // 61: sdn.v1.DeleteSecurityGroupRequest
-	(*DeleteSecurityGroupResponse)(nil),   // 62: sdn.v1.DeleteSecurityGroupResponse
-	(*ListSecurityRulesRequest)(nil),      // 63: sdn.v1.ListSecurityRulesRequest
-	(*ListSecurityRulesResponse)(nil),     // 64: sdn.v1.ListSecurityRulesResponse
-	(*CreateSecurityRuleRequest)(nil),     // 65: sdn.v1.CreateSecurityRuleRequest
-	(*CreateSecurityRuleResponse)(nil),    // 66: sdn.v1.CreateSecurityRuleResponse
-	(*GetSecurityRuleRequest)(nil),        // 67: sdn.v1.GetSecurityRuleRequest
-	(*GetSecurityRuleResponse)(nil),       // 68: sdn.v1.GetSecurityRuleResponse
-	(*DeleteSecurityRuleRequest)(nil),     // 69: sdn.v1.DeleteSecurityRuleRequest
-	(*DeleteSecurityRuleResponse)(nil),    // 70: sdn.v1.DeleteSecurityRuleResponse
-	(*ObjStatus)(nil),                     // 71: sdn.v1.ObjStatus
-	(*VPCId)(nil),                         // 72: sdn.v1.VPCId
-	(*VPC)(nil),                           // 73: sdn.v1.VPC
-	(*SubnetId)(nil),                      // 74: sdn.v1.SubnetId
-	(*Subnet)(nil),                        // 75: sdn.v1.Subnet
-	(*RouterId)(nil),                      // 76: sdn.v1.RouterId
-	(*Router)(nil),                        // 77: sdn.v1.Router
-	(*RouterInterfaceId)(nil),             // 78: sdn.v1.RouterInterfaceId
-	(*RouterInterface)(nil),               // 79: sdn.v1.RouterInterface
-	(*StaticRouteId)(nil),                 // 80: sdn.v1.StaticRouteId
-	(*StaticRoute)(nil),                   // 81: sdn.v1.StaticRoute
-	(*PortId)(nil),                        // 82: sdn.v1.PortId
-	(*Port)(nil),                          // 83: sdn.v1.Port
-	(*SecurityGroupId)(nil),               // 84: sdn.v1.SecurityGroupId
-	(*SecurityGroup)(nil),                 // 85: sdn.v1.SecurityGroup
-	(*SecurityRuleId)(nil),                // 86: sdn.v1.SecurityRuleId
-	(*SecurityRule)(nil),                  // 87: sdn.v1.SecurityRule
-	(*PortRange)(nil),                     // 88: sdn.v1.PortRange
+var file_api_sdn_v1_ovnnet_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_api_sdn_v1_ovnnet_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_api_sdn_v1_ovnnet_proto_goTypes = []any{
+	(SecurityGroupType)(0),                   // 0: sdn.v1.SecurityGroupType
+	(Protocol)(0),                            // 1: sdn.v1.Protocol
+	(Direction)(0),                           // 2: sdn.v1.Direction
+	(SecurityAction)(0),                      // 3: sdn.v1.SecurityAction
+	(AddressTranslationType)(0),              // 4: sdn.v1.AddressTranslationType
+	(*ListVPCsRequest)(nil),                  // 5: sdn.v1.ListVPCsRequest
+	(*ListVPCsResponse)(nil),                 // 6: sdn.v1.ListVPCsResponse
+	(*CreateVPCRequest)(nil),                 // 7: sdn.v1.CreateVPCRequest
+	(*CreateVPCResponse)(nil),                // 8: sdn.v1.CreateVPCResponse
+	(*GetVPCRequest)(nil),                    // 9: sdn.v1.GetVPCRequest
+	(*GetVPCResponse)(nil),                   // 10: sdn.v1.GetVPCResponse
+	(*DeleteVPCRequest)(nil),                 // 11: sdn.v1.DeleteVPCRequest
+	(*DeleteVPCResponse)(nil),                // 12: sdn.v1.DeleteVPCResponse
+	(*ListSubnetsRequest)(nil),               // 13: sdn.v1.ListSubnetsRequest
+	(*ListSubnetsResponse)(nil),              // 14: sdn.v1.ListSubnetsResponse
+	(*CreateSubnetRequest)(nil),              // 15: sdn.v1.CreateSubnetRequest
+	(*CreateSubnetResponse)(nil),             // 16: sdn.v1.CreateSubnetResponse
+	(*GetSubnetRequest)(nil),                 // 17: sdn.v1.GetSubnetRequest
+	(*GetSubnetResponse)(nil),                // 18: sdn.v1.GetSubnetResponse
+	(*DeleteSubnetRequest)(nil),              // 19: sdn.v1.DeleteSubnetRequest
+	(*DeleteSubnetResponse)(nil),             // 20: sdn.v1.DeleteSubnetResponse
+	(*ListRoutersRequest)(nil),               // 21: sdn.v1.ListRoutersRequest
+	(*ListRoutersResponse)(nil),              // 22: sdn.v1.ListRoutersResponse
+	(*CreateRouterRequest)(nil),              // 23: sdn.v1.CreateRouterRequest
+	(*CreateRouterResponse)(nil),             // 24: sdn.v1.CreateRouterResponse
+	(*GetRouterRequest)(nil),                 // 25: sdn.v1.GetRouterRequest
+	(*GetRouterResponse)(nil),                // 26: sdn.v1.GetRouterResponse
+	(*DeleteRouterRequest)(nil),              // 27: sdn.v1.DeleteRouterRequest
+	(*DeleteRouterResponse)(nil),             // 28: sdn.v1.DeleteRouterResponse
+	(*ListRouterInterfacesRequest)(nil),      // 29: sdn.v1.ListRouterInterfacesRequest
+	(*ListRouterInterfacesResponse)(nil),     // 30: sdn.v1.ListRouterInterfacesResponse
+	(*CreateRouterInterfaceRequest)(nil),     // 31: sdn.v1.CreateRouterInterfaceRequest
+	(*GetRouterInterfaceRequest)(nil),        // 32: sdn.v1.GetRouterInterfaceRequest
+	(*GetRouterInterfaceResponse)(nil),       // 33: sdn.v1.GetRouterInterfaceResponse
+	(*CreateRouterInterfaceResponse)(nil),    // 34: sdn.v1.CreateRouterInterfaceResponse
+	(*DeleteRouterInterfaceRequest)(nil),     // 35: sdn.v1.DeleteRouterInterfaceRequest
+	(*DeleteRouterInterfaceResponse)(nil),    // 36: sdn.v1.DeleteRouterInterfaceResponse
+	(*ListStaticRoutesRequest)(nil),          // 37: sdn.v1.ListStaticRoutesRequest
+	(*ListStaticRoutesResponse)(nil),         // 38: sdn.v1.ListStaticRoutesResponse
+	(*CreateStaticRouteRequest)(nil),         // 39: sdn.v1.CreateStaticRouteRequest
+	(*CreateStaticRouteResponse)(nil),        // 40: sdn.v1.CreateStaticRouteResponse
+	(*GetStaticRouteRequest)(nil),            // 41: sdn.v1.GetStaticRouteRequest
+	(*GetStaticRouteResponse)(nil),           // 42: sdn.v1.GetStaticRouteResponse
+	(*DeleteStaticRouteRequest)(nil),         // 43: sdn.v1.DeleteStaticRouteRequest
+	(*DeleteStaticRouteResponse)(nil),        // 44: sdn.v1.DeleteStaticRouteResponse
+	(*ListPortsRequest)(nil),                 // 45: sdn.v1.ListPortsRequest
+	(*ListPortsResponse)(nil),                // 46: sdn.v1.ListPortsResponse
+	(*CreatePortRequest)(nil),                // 47: sdn.v1.CreatePortRequest
+	(*CreatePortResponse)(nil),               // 48: sdn.v1.CreatePortResponse
+	(*GetPortRequest)(nil),                   // 49: sdn.v1.GetPortRequest
+	(*GetPortResponse)(nil),                  // 50: sdn.v1.GetPortResponse
+	(*UpdatePortRequest)(nil),                // 51: sdn.v1.UpdatePortRequest
+	(*UpdatePortResponse)(nil),               // 52: sdn.v1.UpdatePortResponse
+	(*DeletePortRequest)(nil),                // 53: sdn.v1.DeletePortRequest
+	(*DeletePortResponse)(nil),               // 54: sdn.v1.DeletePortResponse
+	(*ListSecurityGroupsRequest)(nil),        // 55: sdn.v1.ListSecurityGroupsRequest
+	(*ListSecurityGroupsResponse)(nil),       // 56: sdn.v1.ListSecurityGroupsResponse
+	(*CreateSecurityGroupRequest)(nil),       // 57: sdn.v1.CreateSecurityGroupRequest
+	(*CreateSecurityGroupResponse)(nil),      // 58: sdn.v1.CreateSecurityGroupResponse
+	(*GetSecurityGroupRequest)(nil),          // 59: sdn.v1.GetSecurityGroupRequest
+	(*GetSecurityGroupResponse)(nil),         // 60: sdn.v1.GetSecurityGroupResponse
+	(*UpdateSecurityGroupRequest)(nil),       // 61: sdn.v1.UpdateSecurityGroupRequest
+	(*UpdateSecurityGroupResponse)(nil),      // 62: sdn.v1.UpdateSecurityGroupResponse
+	(*DeleteSecurityGroupRequest)(nil),       // 63: sdn.v1.DeleteSecurityGroupRequest
+	(*DeleteSecurityGroupResponse)(nil),      // 64: sdn.v1.DeleteSecurityGroupResponse
+	(*ListSecurityRulesRequest)(nil),         // 65: sdn.v1.ListSecurityRulesRequest
+	(*ListSecurityRulesResponse)(nil),        // 66: sdn.v1.ListSecurityRulesResponse
+	(*CreateSecurityRuleRequest)(nil),        // 67: sdn.v1.CreateSecurityRuleRequest
+	(*CreateSecurityRuleResponse)(nil),       // 68: sdn.v1.CreateSecurityRuleResponse
+	(*GetSecurityRuleRequest)(nil),           // 69: sdn.v1.GetSecurityRuleRequest
+	(*GetSecurityRuleResponse)(nil),          // 70: sdn.v1.GetSecurityRuleResponse
+	(*UpdateSecurityRuleRequest)(nil),        // 71: sdn.v1.UpdateSecurityRuleRequest
+	(*UpdateSecurityRuleResponse)(nil),       // 72: sdn.v1.UpdateSecurityRuleResponse
+	(*DeleteSecurityRuleRequest)(nil),        // 73: sdn.v1.DeleteSecurityRuleRequest
+	(*DeleteSecurityRuleResponse)(nil),       // 74: sdn.v1.DeleteSecurityRuleResponse
+	(*ListAddressTranslationsRequest)(nil),   // 75: sdn.v1.ListAddressTranslationsRequest
+	(*ListAddressTranslationsResponse)(nil),  // 76: sdn.v1.ListAddressTranslationsResponse
+	(*CreateAddressTranslationRequest)(nil),  // 77: sdn.v1.CreateAddressTranslationRequest
+	(*CreateAddressTranslationResponse)(nil), // 78: sdn.v1.CreateAddressTranslationResponse
+	(*GetAddressTranslationRequest)(nil),     // 79: sdn.v1.GetAddressTranslationRequest
+	(*GetAddressTranslationResponse)(nil),    // 80: sdn.v1.GetAddressTranslationResponse
+	(*DeleteAddressTranslationRequest)(nil),  // 81: sdn.v1.DeleteAddressTranslationRequest
+	(*DeleteAddressTranslationResponse)(nil), // 82: sdn.v1.DeleteAddressTranslationResponse
+	(*ObjStatus)(nil),                        // 83: sdn.v1.ObjStatus
+	(*VPCId)(nil),                            // 84: sdn.v1.VPCId
+	(*VPC)(nil),                              // 85: sdn.v1.VPC
+	(*SubnetId)(nil),                         // 86: sdn.v1.SubnetId
+	(*Subnet)(nil),                           // 87: sdn.v1.Subnet
+	(*RouterId)(nil),                         // 88: sdn.v1.RouterId
+	(*Router)(nil),                           // 89: sdn.v1.Router
+	(*RouterInterfaceId)(nil),                // 90: sdn.v1.RouterInterfaceId
+	(*RouterInterface)(nil),                  // 91: sdn.v1.RouterInterface
+	(*StaticRouteId)(nil),                    // 92: sdn.v1.StaticRouteId
+	(*StaticRoute)(nil),                      // 93: sdn.v1.StaticRoute
+	(*PortId)(nil),                           // 94: sdn.v1.PortId
+	(*Port)(nil),                             // 95: sdn.v1.Port
+	(*SecurityGroupId)(nil),                  // 96: sdn.v1.SecurityGroupId
+	(*SecurityGroup)(nil),                    // 97: sdn.v1.SecurityGroup
+	(*SecurityRuleId)(nil),                   // 98: sdn.v1.SecurityRuleId
+	(*SecurityRule)(nil),                     // 99: sdn.v1.SecurityRule
+	(*SecurityRuleLogging)(nil),              // 100: sdn.v1.SecurityRuleLogging
+	(*PortRange)(nil),                        // 101: sdn.v1.PortRange
+	(*AddressTranslationId)(nil),             // 102: sdn.v1.AddressTranslationId
+	(*AddressTranslation)(nil),               // 103: sdn.v1.AddressTranslation
 }
 var file_api_sdn_v1_ovnnet_proto_depIdxs = []int32{
-	72,  // 0: sdn.v1.ListVPCsResponse.vpc_ids:type_name -> sdn.v1.VPCId
-	72,  // 1: sdn.v1.CreateVPCRequest.vpc_id:type_name -> sdn.v1.VPCId
-	72,  // 2: sdn.v1.CreateVPCResponse.vpc_id:type_name -> sdn.v1.VPCId
-	72,  // 3: sdn.v1.GetVPCRequest.vpc_id:type_name -> sdn.v1.VPCId
-	73,  // 4: sdn.v1.GetVPCResponse.vpc:type_name -> sdn.v1.VPC
-	72,  // 5: sdn.v1.DeleteVPCRequest.vpc_id:type_name -> sdn.v1.VPCId
-	72,  // 6: sdn.v1.DeleteVPCResponse.vpc_id:type_name -> sdn.v1.VPCId
-	74,  // 7: sdn.v1.ListSubnetsResponse.subnet_ids:type_name -> sdn.v1.SubnetId
-	74,  // 8: sdn.v1.CreateSubnetRequest.subnet_id:type_name -> sdn.v1.SubnetId
-	72,  // 9: sdn.v1.CreateSubnetRequest.vpc_id:type_name -> sdn.v1.VPCId
-	74,  // 10: sdn.v1.CreateSubnetResponse.subnet_id:type_name -> sdn.v1.SubnetId
-	74,  // 11: sdn.v1.GetSubnetRequest.subnet_id:type_name -> sdn.v1.SubnetId
-	75,  // 12: sdn.v1.GetSubnetResponse.subnet:type_name -> sdn.v1.Subnet
-	74,  // 13: sdn.v1.DeleteSubnetRequest.subnet_id:type_name -> sdn.v1.SubnetId
-	74,  // 14: sdn.v1.DeleteSubnetResponse.subnet_id:type_name -> sdn.v1.SubnetId
-	76,  // 15: sdn.v1.ListRoutersResponse.router_ids:type_name -> sdn.v1.RouterId
-	76,  // 16: sdn.v1.CreateRouterRequest.router_id:type_name -> sdn.v1.RouterId
-	72,  // 17: sdn.v1.CreateRouterRequest.vpc_id:type_name -> sdn.v1.VPCId
-	76,  // 18: sdn.v1.CreateRouterResponse.router_id:type_name -> sdn.v1.RouterId
-	76,  // 19: sdn.v1.GetRouterRequest.router_id:type_name -> sdn.v1.RouterId
-	77,  // 20: sdn.v1.GetRouterResponse.router:type_name -> sdn.v1.Router
-	76,  // 21: sdn.v1.DeleteRouterRequest.router_id:type_name -> sdn.v1.RouterId
-	76,  // 22: sdn.v1.DeleteRouterResponse.router_id:type_name -> sdn.v1.RouterId
-	78,  // 23: sdn.v1.ListRouterInterfacesResponse.router_interface_ids:type_name -> sdn.v1.RouterInterfaceId
-	78,  // 24: sdn.v1.CreateRouterInterfaceRequest.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
-	76,  // 25: sdn.v1.CreateRouterInterfaceRequest.router_id:type_name -> sdn.v1.RouterId
-	74,  // 26: sdn.v1.CreateRouterInterfaceRequest.subnet_id:type_name -> sdn.v1.SubnetId
-	78,  // 27: sdn.v1.GetRouterInterfaceRequest.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
-	79,  // 28: sdn.v1.GetRouterInterfaceResponse.router_interface:type_name -> sdn.v1.RouterInterface
-	78,  // 29: sdn.v1.CreateRouterInterfaceResponse.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
-	78,  // 30: sdn.v1.DeleteRouterInterfaceRequest.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
-	78,  // 31: sdn.v1.DeleteRouterInterfaceResponse.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
-	80,  // 32: sdn.v1.ListStaticRoutesResponse.static_route_ids:type_name -> sdn.v1.StaticRouteId
-	80,  // 33: sdn.v1.CreateStaticRouteRequest.static_route_id:type_name -> sdn.v1.StaticRouteId
-	76,  // 34: sdn.v1.CreateStaticRouteRequest.router_id:type_name -> sdn.v1.RouterId
-	80,  // 35: sdn.v1.CreateStaticRouteResponse.static_route_id:type_name -> sdn.v1.StaticRouteId
-	80,  // 36: sdn.v1.GetStaticRouteRequest.static_route_id:type_name -> sdn.v1.StaticRouteId
-	81,  // 37: sdn.v1.GetStaticRouteResponse.static_route:type_name -> sdn.v1.StaticRoute
-	80,  // 38: sdn.v1.DeleteStaticRouteRequest.static_route_id:type_name -> sdn.v1.StaticRouteId
-	80,  // 39: sdn.v1.DeleteStaticRouteResponse.static_route_id:type_name -> sdn.v1.StaticRouteId
-	82,  // 40: sdn.v1.ListPortsResponse.port_ids:type_name -> sdn.v1.PortId
-	82,  // 41: sdn.v1.CreatePortRequest.port_id:type_name -> sdn.v1.PortId
-	74,  // 42: sdn.v1.CreatePortRequest.subnet_id:type_name -> sdn.v1.SubnetId
-	82,  // 43: sdn.v1.CreatePortResponse.port_id:type_name -> sdn.v1.PortId
-	82,  // 44: sdn.v1.GetPortRequest.port_id:type_name -> sdn.v1.PortId
-	83,  // 45: sdn.v1.GetPortResponse.port:type_name -> sdn.v1.Port
-	82,  // 46: sdn.v1.UpdatePortRequest.port_id:type_name -> sdn.v1.PortId
-	82,  // 47: sdn.v1.UpdatePortResponse.port_id:type_name -> sdn.v1.PortId
-	82,  // 48: sdn.v1.DeletePortRequest.port_id:type_name -> sdn.v1.PortId
-	82,  // 49: sdn.v1.DeletePortResponse.port_id:type_name -> sdn.v1.PortId
-	84,  // 50: sdn.v1.ListSecurityGroupsResponse.security_group_ids:type_name -> sdn.v1.SecurityGroupId
-	84,  // 51: sdn.v1.CreateSecurityGroupRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
-	86,  // 52: sdn.v1.CreateSecurityGroupRequest.security_rules:type_name -> sdn.v1.SecurityRuleId
-	82,  // 53: sdn.v1.CreateSecurityGroupRequest.port_ids:type_name -> sdn.v1.PortId
-	74,  // 54: sdn.v1.CreateSecurityGroupRequest.subnet_ids:type_name -> sdn.v1.SubnetId
-	72,  // 55: sdn.v1.CreateSecurityGroupRequest.vpc_id:type_name -> sdn.v1.VPCId
-	84,  // 56: sdn.v1.CreateSecurityGroupResponse.security_group_id:type_name -> sdn.v1.SecurityGroupId
-	84,  // 57: sdn.v1.GetSecurityGroupRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
-	85,  // 58: sdn.v1.GetSecurityGroupResponse.security_group:type_name -> sdn.v1.SecurityGroup
-	84,  // 59: sdn.v1.UpdateSecurityGroupRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
-	86,  // 60: sdn.v1.UpdateSecurityGroupRequest.security_rules:type_name -> sdn.v1.SecurityRuleId
-	82,  // 61: sdn.v1.UpdateSecurityGroupRequest.port_ids:type_name -> sdn.v1.PortId
-	74,  // 62: sdn.v1.UpdateSecurityGroupRequest.subnet_ids:type_name -> sdn.v1.SubnetId
-	84,  // 63: sdn.v1.UpdateSecurityGroupResponse.security_group_id:type_name -> sdn.v1.SecurityGroupId
-	84,  // 64: sdn.v1.DeleteSecurityGroupRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
-	84,  // 65: sdn.v1.DeleteSecurityGroupResponse.security_group_id:type_name -> sdn.v1.SecurityGroupId
-	86,  // 66: sdn.v1.ListSecurityRulesResponse.security_rule_ids:type_name -> sdn.v1.SecurityRuleId
-	86,  // 67: sdn.v1.CreateSecurityRuleRequest.security_rule_id:type_name -> sdn.v1.SecurityRuleId
-	1,   // 68: sdn.v1.CreateSecurityRuleRequest.direction:type_name -> sdn.v1.Direction
-	0,   // 69: sdn.v1.CreateSecurityRuleRequest.protocol:type_name -> sdn.v1.Protocol
-	88,  // 70: sdn.v1.CreateSecurityRuleRequest.source_port_range:type_name -> sdn.v1.PortRange
-	88,  // 71: sdn.v1.CreateSecurityRuleRequest.destination_port_range:type_name -> sdn.v1.PortRange
-	2,   // 72: sdn.v1.CreateSecurityRuleRequest.action:type_name -> sdn.v1.SecurityAction
-	72,  // 73: sdn.v1.CreateSecurityRuleRequest.vpc_id:type_name -> sdn.v1.VPCId
-	86,  // 74: sdn.v1.CreateSecurityRuleResponse.security_rule_id:type_name -> sdn.v1.SecurityRuleId
-	86,  // 75: sdn.v1.GetSecurityRuleRequest.security_rule_id:type_name -> sdn.v1.SecurityRuleId
-	87,  // 76: sdn.v1.GetSecurityRuleResponse.security_rule:type_name -> sdn.v1.SecurityRule
-	86,  // 77: sdn.v1.DeleteSecurityRuleRequest.security_rule_id:type_name -> sdn.v1.SecurityRuleId
-	86,  // 78: sdn.v1.DeleteSecurityRuleResponse.security_rule_id:type_name -> sdn.v1.SecurityRuleId
-	72,  // 79: sdn.v1.VPC.id:type_name -> sdn.v1.VPCId
-	76,  // 80: sdn.v1.VPC.routers:type_name -> sdn.v1.RouterId
-	74,  // 81: sdn.v1.VPC.subnets:type_name -> sdn.v1.SubnetId
-	71,  // 82: sdn.v1.VPC.status:type_name -> sdn.v1.ObjStatus
-	74,  // 83: sdn.v1.Subnet.id:type_name -> sdn.v1.SubnetId
-	72,  // 84: sdn.v1.Subnet.vpc_id:type_name -> sdn.v1.VPCId
-	71,  // 85: sdn.v1.Subnet.status:type_name -> sdn.v1.ObjStatus
-	76,  // 86: sdn.v1.Router.id:type_name -> sdn.v1.RouterId
-	72,  // 87: sdn.v1.Router.vpc_id:type_name -> sdn.v1.VPCId
-	71,  // 88: sdn.v1.Router.status:type_name -> sdn.v1.ObjStatus
-	78,  // 89: sdn.v1.RouterInterface.id:type_name -> sdn.v1.RouterInterfaceId
-	76,  // 90: sdn.v1.RouterInterface.router_id:type_name -> sdn.v1.RouterId
-	74,  // 91: sdn.v1.RouterInterface.subnet_id:type_name -> sdn.v1.SubnetId
-	71,  // 92: sdn.v1.RouterInterface.status:type_name -> sdn.v1.ObjStatus
-	80,  // 93: sdn.v1.StaticRoute.id:type_name -> sdn.v1.StaticRouteId
-	76,  // 94: sdn.v1.StaticRoute.router_id:type_name -> sdn.v1.RouterId
-	71,  // 95: sdn.v1.StaticRoute.status:type_name -> sdn.v1.ObjStatus
-	82,  // 96: sdn.v1.Port.id:type_name -> sdn.v1.PortId
-	74,  // 97: sdn.v1.Port.subnet_id:type_name -> sdn.v1.SubnetId
-	71,  // 98: sdn.v1.Port.status:type_name -> sdn.v1.ObjStatus
-	84,  // 99: sdn.v1.SecurityGroup.id:type_name -> sdn.v1.SecurityGroupId
-	86,  // 100: sdn.v1.SecurityGroup.security_rule_ids:type_name -> sdn.v1.SecurityRuleId
-	82,  // 101: sdn.v1.SecurityGroup.port_ids:type_name -> sdn.v1.PortId
-	74,  // 102: sdn.v1.SecurityGroup.subnet_ids:type_name -> sdn.v1.SubnetId
-	72,  // 103: sdn.v1.SecurityGroup.vpc_id:type_name -> sdn.v1.VPCId
-	71,  // 104: sdn.v1.SecurityGroup.status:type_name -> sdn.v1.ObjStatus
-	86,  // 105: sdn.v1.SecurityRule.id:type_name -> sdn.v1.SecurityRuleId
-	1,   // 106: sdn.v1.SecurityRule.direction:type_name -> sdn.v1.Direction
-	0,   // 107: sdn.v1.SecurityRule.protocol:type_name -> sdn.v1.Protocol
-	88,  // 108: sdn.v1.SecurityRule.source_port_range:type_name -> sdn.v1.PortRange
-	88,  // 109: sdn.v1.SecurityRule.destination_port_range:type_name -> sdn.v1.PortRange
-	2,   // 110: sdn.v1.SecurityRule.action:type_name -> sdn.v1.SecurityAction
-	72,  // 111: sdn.v1.SecurityRule.vpc_id:type_name -> sdn.v1.VPCId
-	71,  // 112: sdn.v1.SecurityRule.status:type_name -> sdn.v1.ObjStatus
-	3,   // 113: sdn.v1.Ovnnet.ListVPCs:input_type -> sdn.v1.ListVPCsRequest
-	5,   // 114: sdn.v1.Ovnnet.CreateVPC:input_type -> sdn.v1.CreateVPCRequest
-	7,   // 115: sdn.v1.Ovnnet.GetVPC:input_type -> sdn.v1.GetVPCRequest
-	9,   // 116: sdn.v1.Ovnnet.DeleteVPC:input_type -> sdn.v1.DeleteVPCRequest
-	11,  // 117: sdn.v1.Ovnnet.ListSubnets:input_type -> sdn.v1.ListSubnetsRequest
-	13,  // 118: sdn.v1.Ovnnet.CreateSubnet:input_type -> sdn.v1.CreateSubnetRequest
-	15,  // 119: sdn.v1.Ovnnet.GetSubnet:input_type -> sdn.v1.GetSubnetRequest
-	17,  // 120: sdn.v1.Ovnnet.DeleteSubnet:input_type -> sdn.v1.DeleteSubnetRequest
-	19,  // 121: sdn.v1.Ovnnet.ListRouters:input_type -> sdn.v1.ListRoutersRequest
-	21,  // 122: sdn.v1.Ovnnet.CreateRouter:input_type -> sdn.v1.CreateRouterRequest
-	23,  // 123: sdn.v1.Ovnnet.GetRouter:input_type -> sdn.v1.GetRouterRequest
-	25,  // 124: sdn.v1.Ovnnet.DeleteRouter:input_type -> sdn.v1.DeleteRouterRequest
-	27,  // 125: sdn.v1.Ovnnet.ListRouterInterfaces:input_type -> sdn.v1.ListRouterInterfacesRequest
-	29,  // 126: sdn.v1.Ovnnet.CreateRouterInterface:input_type -> sdn.v1.CreateRouterInterfaceRequest
-	30,  // 127: sdn.v1.Ovnnet.GetRouterInterface:input_type -> sdn.v1.GetRouterInterfaceRequest
-	33,  // 128: sdn.v1.Ovnnet.DeleteRouterInterface:input_type -> sdn.v1.DeleteRouterInterfaceRequest
-	35,  // 129: sdn.v1.Ovnnet.ListStaticRoutes:input_type -> sdn.v1.ListStaticRoutesRequest
-	37,  // 130: sdn.v1.Ovnnet.CreateStaticRoute:input_type -> sdn.v1.CreateStaticRouteRequest
-	39,  // 131: sdn.v1.Ovnnet.GetStaticRoute:input_type -> sdn.v1.GetStaticRouteRequest
-	41,  // 132: sdn.v1.Ovnnet.DeleteStaticRoute:input_type -> sdn.v1.DeleteStaticRouteRequest
-	43,  // 133: sdn.v1.Ovnnet.ListPorts:input_type -> sdn.v1.ListPortsRequest
-	45,  // 134: sdn.v1.Ovnnet.CreatePort:input_type -> sdn.v1.CreatePortRequest
-	47,  // 135: sdn.v1.Ovnnet.GetPort:input_type -> sdn.v1.GetPortRequest
-	49,  // 136: sdn.v1.Ovnnet.UpdatePort:input_type -> sdn.v1.UpdatePortRequest
-	51,  // 137: sdn.v1.Ovnnet.DeletePort:input_type -> sdn.v1.DeletePortRequest
-	53,  // 138: sdn.v1.Ovnnet.ListSecurityGroups:input_type -> sdn.v1.ListSecurityGroupsRequest
-	55,  // 139: sdn.v1.Ovnnet.CreateSecurityGroup:input_type -> sdn.v1.CreateSecurityGroupRequest
-	57,  // 140: sdn.v1.Ovnnet.GetSecurityGroup:input_type -> sdn.v1.GetSecurityGroupRequest
-	59,  // 141: sdn.v1.Ovnnet.UpdateSecurityGroup:input_type -> sdn.v1.UpdateSecurityGroupRequest
-	61,  // 142: sdn.v1.Ovnnet.DeleteSecurityGroup:input_type -> sdn.v1.DeleteSecurityGroupRequest
-	63,  // 143: sdn.v1.Ovnnet.ListSecurityRules:input_type -> sdn.v1.ListSecurityRulesRequest
-	65,  // 144: sdn.v1.Ovnnet.CreateSecurityRule:input_type -> sdn.v1.CreateSecurityRuleRequest
-	67,  // 145: sdn.v1.Ovnnet.GetSecurityRule:input_type -> sdn.v1.GetSecurityRuleRequest
-	69,  // 146: sdn.v1.Ovnnet.DeleteSecurityRule:input_type -> sdn.v1.DeleteSecurityRuleRequest
-	4,   // 147: sdn.v1.Ovnnet.ListVPCs:output_type -> sdn.v1.ListVPCsResponse
-	6,   // 148: sdn.v1.Ovnnet.CreateVPC:output_type -> sdn.v1.CreateVPCResponse
-	8,   // 149: sdn.v1.Ovnnet.GetVPC:output_type -> sdn.v1.GetVPCResponse
-	10,  // 150: sdn.v1.Ovnnet.DeleteVPC:output_type -> sdn.v1.DeleteVPCResponse
-	12,  // 151: sdn.v1.Ovnnet.ListSubnets:output_type -> sdn.v1.ListSubnetsResponse
-	14,  // 152: sdn.v1.Ovnnet.CreateSubnet:output_type -> sdn.v1.CreateSubnetResponse
-	16,  // 153: sdn.v1.Ovnnet.GetSubnet:output_type -> sdn.v1.GetSubnetResponse
-	18,  // 154: sdn.v1.Ovnnet.DeleteSubnet:output_type -> sdn.v1.DeleteSubnetResponse
-	20,  // 155: sdn.v1.Ovnnet.ListRouters:output_type -> sdn.v1.ListRoutersResponse
-	22,  // 156: sdn.v1.Ovnnet.CreateRouter:output_type -> sdn.v1.CreateRouterResponse
-	24,  // 157: sdn.v1.Ovnnet.GetRouter:output_type -> sdn.v1.GetRouterResponse
-	26,  // 158: sdn.v1.Ovnnet.DeleteRouter:output_type -> sdn.v1.DeleteRouterResponse
-	28,  // 159: sdn.v1.Ovnnet.ListRouterInterfaces:output_type -> sdn.v1.ListRouterInterfacesResponse
-	32,  // 160: sdn.v1.Ovnnet.CreateRouterInterface:output_type -> sdn.v1.CreateRouterInterfaceResponse
-	31,  // 161: sdn.v1.Ovnnet.GetRouterInterface:output_type -> sdn.v1.GetRouterInterfaceResponse
-	34,  // 162: sdn.v1.Ovnnet.DeleteRouterInterface:output_type -> sdn.v1.DeleteRouterInterfaceResponse
-	36,  // 163: sdn.v1.Ovnnet.ListStaticRoutes:output_type -> sdn.v1.ListStaticRoutesResponse
-	38,  // 164: sdn.v1.Ovnnet.CreateStaticRoute:output_type -> sdn.v1.CreateStaticRouteResponse
-	40,  // 165: sdn.v1.Ovnnet.GetStaticRoute:output_type -> sdn.v1.GetStaticRouteResponse
-	42,  // 166: sdn.v1.Ovnnet.DeleteStaticRoute:output_type -> sdn.v1.DeleteStaticRouteResponse
-	44,  // 167: sdn.v1.Ovnnet.ListPorts:output_type -> sdn.v1.ListPortsResponse
-	46,  // 168: sdn.v1.Ovnnet.CreatePort:output_type -> sdn.v1.CreatePortResponse
-	48,  // 169: sdn.v1.Ovnnet.GetPort:output_type -> sdn.v1.GetPortResponse
-	50,  // 170: sdn.v1.Ovnnet.UpdatePort:output_type -> sdn.v1.UpdatePortResponse
-	52,  // 171: sdn.v1.Ovnnet.DeletePort:output_type -> sdn.v1.DeletePortResponse
-	54,  // 172: sdn.v1.Ovnnet.ListSecurityGroups:output_type -> sdn.v1.ListSecurityGroupsResponse
-	56,  // 173: sdn.v1.Ovnnet.CreateSecurityGroup:output_type -> sdn.v1.CreateSecurityGroupResponse
-	58,  // 174: sdn.v1.Ovnnet.GetSecurityGroup:output_type -> sdn.v1.GetSecurityGroupResponse
-	60,  // 175: sdn.v1.Ovnnet.UpdateSecurityGroup:output_type -> sdn.v1.UpdateSecurityGroupResponse
-	62,  // 176: sdn.v1.Ovnnet.DeleteSecurityGroup:output_type -> sdn.v1.DeleteSecurityGroupResponse
-	64,  // 177: sdn.v1.Ovnnet.ListSecurityRules:output_type -> sdn.v1.ListSecurityRulesResponse
-	66,  // 178: sdn.v1.Ovnnet.CreateSecurityRule:output_type -> sdn.v1.CreateSecurityRuleResponse
-	68,  // 179: sdn.v1.Ovnnet.GetSecurityRule:output_type -> sdn.v1.GetSecurityRuleResponse
-	70,  // 180: sdn.v1.Ovnnet.DeleteSecurityRule:output_type -> sdn.v1.DeleteSecurityRuleResponse
-	147, // [147:181] is the sub-list for method output_type
-	113, // [113:147] is the sub-list for method input_type
-	113, // [113:113] is the sub-list for extension type_name
-	113, // [113:113] is the sub-list for extension extendee
-	0,   // [0:113] is the sub-list for field type_name
+	84,  // 0: sdn.v1.ListVPCsResponse.vpc_ids:type_name -> sdn.v1.VPCId
+	84,  // 1: sdn.v1.CreateVPCRequest.vpc_id:type_name -> sdn.v1.VPCId
+	84,  // 2: sdn.v1.CreateVPCResponse.vpc_id:type_name -> sdn.v1.VPCId
+	84,  // 3: sdn.v1.GetVPCRequest.vpc_id:type_name -> sdn.v1.VPCId
+	85,  // 4: sdn.v1.GetVPCResponse.vpc:type_name -> sdn.v1.VPC
+	84,  // 5: sdn.v1.DeleteVPCRequest.vpc_id:type_name -> sdn.v1.VPCId
+	84,  // 6: sdn.v1.DeleteVPCResponse.vpc_id:type_name -> sdn.v1.VPCId
+	86,  // 7: sdn.v1.ListSubnetsResponse.subnet_ids:type_name -> sdn.v1.SubnetId
+	86,  // 8: sdn.v1.CreateSubnetRequest.subnet_id:type_name -> sdn.v1.SubnetId
+	84,  // 9: sdn.v1.CreateSubnetRequest.vpc_id:type_name -> sdn.v1.VPCId
+	86,  // 10: sdn.v1.CreateSubnetResponse.subnet_id:type_name -> sdn.v1.SubnetId
+	86,  // 11: sdn.v1.GetSubnetRequest.subnet_id:type_name -> sdn.v1.SubnetId
+	87,  // 12: sdn.v1.GetSubnetResponse.subnet:type_name -> sdn.v1.Subnet
+	86,  // 13: sdn.v1.DeleteSubnetRequest.subnet_id:type_name -> sdn.v1.SubnetId
+	86,  // 14: sdn.v1.DeleteSubnetResponse.subnet_id:type_name -> sdn.v1.SubnetId
+	88,  // 15: sdn.v1.ListRoutersResponse.router_ids:type_name -> sdn.v1.RouterId
+	88,  // 16: sdn.v1.CreateRouterRequest.router_id:type_name -> sdn.v1.RouterId
+	84,  // 17: sdn.v1.CreateRouterRequest.vpc_id:type_name -> sdn.v1.VPCId
+	88,  // 18: sdn.v1.CreateRouterResponse.router_id:type_name -> sdn.v1.RouterId
+	88,  // 19: sdn.v1.GetRouterRequest.router_id:type_name -> sdn.v1.RouterId
+	89,  // 20: sdn.v1.GetRouterResponse.router:type_name -> sdn.v1.Router
+	88,  // 21: sdn.v1.DeleteRouterRequest.router_id:type_name -> sdn.v1.RouterId
+	88,  // 22: sdn.v1.DeleteRouterResponse.router_id:type_name -> sdn.v1.RouterId
+	90,  // 23: sdn.v1.ListRouterInterfacesResponse.router_interface_ids:type_name -> sdn.v1.RouterInterfaceId
+	90,  // 24: sdn.v1.CreateRouterInterfaceRequest.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
+	88,  // 25: sdn.v1.CreateRouterInterfaceRequest.router_id:type_name -> sdn.v1.RouterId
+	86,  // 26: sdn.v1.CreateRouterInterfaceRequest.subnet_id:type_name -> sdn.v1.SubnetId
+	90,  // 27: sdn.v1.GetRouterInterfaceRequest.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
+	91,  // 28: sdn.v1.GetRouterInterfaceResponse.router_interface:type_name -> sdn.v1.RouterInterface
+	90,  // 29: sdn.v1.CreateRouterInterfaceResponse.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
+	90,  // 30: sdn.v1.DeleteRouterInterfaceRequest.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
+	90,  // 31: sdn.v1.DeleteRouterInterfaceResponse.router_interface_id:type_name -> sdn.v1.RouterInterfaceId
+	92,  // 32: sdn.v1.ListStaticRoutesResponse.static_route_ids:type_name -> sdn.v1.StaticRouteId
+	92,  // 33: sdn.v1.CreateStaticRouteRequest.static_route_id:type_name -> sdn.v1.StaticRouteId
+	88,  // 34: sdn.v1.CreateStaticRouteRequest.router_id:type_name -> sdn.v1.RouterId
+	92,  // 35: sdn.v1.CreateStaticRouteResponse.static_route_id:type_name -> sdn.v1.StaticRouteId
+	92,  // 36: sdn.v1.GetStaticRouteRequest.static_route_id:type_name -> sdn.v1.StaticRouteId
+	93,  // 37: sdn.v1.GetStaticRouteResponse.static_route:type_name -> sdn.v1.StaticRoute
+	92,  // 38: sdn.v1.DeleteStaticRouteRequest.static_route_id:type_name -> sdn.v1.StaticRouteId
+	92,  // 39: sdn.v1.DeleteStaticRouteResponse.static_route_id:type_name -> sdn.v1.StaticRouteId
+	94,  // 40: sdn.v1.ListPortsResponse.port_ids:type_name -> sdn.v1.PortId
+	94,  // 41: sdn.v1.CreatePortRequest.port_id:type_name -> sdn.v1.PortId
+	86,  // 42: sdn.v1.CreatePortRequest.subnet_id:type_name -> sdn.v1.SubnetId
+	94,  // 43: sdn.v1.CreatePortResponse.port_id:type_name -> sdn.v1.PortId
+	94,  // 44: sdn.v1.GetPortRequest.port_id:type_name -> sdn.v1.PortId
+	95,  // 45: sdn.v1.GetPortResponse.port:type_name -> sdn.v1.Port
+	94,  // 46: sdn.v1.UpdatePortRequest.port_id:type_name -> sdn.v1.PortId
+	94,  // 47: sdn.v1.UpdatePortResponse.port_id:type_name -> sdn.v1.PortId
+	94,  // 48: sdn.v1.DeletePortRequest.port_id:type_name -> sdn.v1.PortId
+	94,  // 49: sdn.v1.DeletePortResponse.port_id:type_name -> sdn.v1.PortId
+	96,  // 50: sdn.v1.ListSecurityGroupsResponse.security_group_ids:type_name -> sdn.v1.SecurityGroupId
+	96,  // 51: sdn.v1.CreateSecurityGroupRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	0,   // 52: sdn.v1.CreateSecurityGroupRequest.type:type_name -> sdn.v1.SecurityGroupType
+	94,  // 53: sdn.v1.CreateSecurityGroupRequest.port_ids:type_name -> sdn.v1.PortId
+	86,  // 54: sdn.v1.CreateSecurityGroupRequest.subnet_ids:type_name -> sdn.v1.SubnetId
+	84,  // 55: sdn.v1.CreateSecurityGroupRequest.vpc_id:type_name -> sdn.v1.VPCId
+	96,  // 56: sdn.v1.CreateSecurityGroupResponse.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	96,  // 57: sdn.v1.GetSecurityGroupRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	97,  // 58: sdn.v1.GetSecurityGroupResponse.security_group:type_name -> sdn.v1.SecurityGroup
+	96,  // 59: sdn.v1.UpdateSecurityGroupRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	94,  // 60: sdn.v1.UpdateSecurityGroupRequest.port_ids:type_name -> sdn.v1.PortId
+	86,  // 61: sdn.v1.UpdateSecurityGroupRequest.subnet_ids:type_name -> sdn.v1.SubnetId
+	96,  // 62: sdn.v1.UpdateSecurityGroupResponse.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	96,  // 63: sdn.v1.DeleteSecurityGroupRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	96,  // 64: sdn.v1.DeleteSecurityGroupResponse.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	98,  // 65: sdn.v1.ListSecurityRulesResponse.security_rule_ids:type_name -> sdn.v1.SecurityRuleId
+	98,  // 66: sdn.v1.CreateSecurityRuleRequest.security_rule_id:type_name -> sdn.v1.SecurityRuleId
+	96,  // 67: sdn.v1.CreateSecurityRuleRequest.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	2,   // 68: sdn.v1.CreateSecurityRuleRequest.direction:type_name -> sdn.v1.Direction
+	1,   // 69: sdn.v1.CreateSecurityRuleRequest.protocol:type_name -> sdn.v1.Protocol
+	101, // 70: sdn.v1.CreateSecurityRuleRequest.source_port_range:type_name -> sdn.v1.PortRange
+	101, // 71: sdn.v1.CreateSecurityRuleRequest.destination_port_range:type_name -> sdn.v1.PortRange
+	3,   // 72: sdn.v1.CreateSecurityRuleRequest.action:type_name -> sdn.v1.SecurityAction
+	84,  // 73: sdn.v1.CreateSecurityRuleRequest.vpc_id:type_name -> sdn.v1.VPCId
+	100, // 74: sdn.v1.CreateSecurityRuleRequest.logging:type_name -> sdn.v1.SecurityRuleLogging
+	98,  // 75: sdn.v1.CreateSecurityRuleResponse.security_rule_id:type_name -> sdn.v1.SecurityRuleId
+	98,  // 76: sdn.v1.GetSecurityRuleRequest.security_rule_id:type_name -> sdn.v1.SecurityRuleId
+	99,  // 77: sdn.v1.GetSecurityRuleResponse.security_rule:type_name -> sdn.v1.SecurityRule
+	98,  // 78: sdn.v1.UpdateSecurityRuleRequest.security_rule_id:type_name -> sdn.v1.SecurityRuleId
+	2,   // 79: sdn.v1.UpdateSecurityRuleRequest.direction:type_name -> sdn.v1.Direction
+	1,   // 80: sdn.v1.UpdateSecurityRuleRequest.protocol:type_name -> sdn.v1.Protocol
+	101, // 81: sdn.v1.UpdateSecurityRuleRequest.source_port_range:type_name -> sdn.v1.PortRange
+	101, // 82: sdn.v1.UpdateSecurityRuleRequest.destination_port_range:type_name -> sdn.v1.PortRange
+	3,   // 83: sdn.v1.UpdateSecurityRuleRequest.action:type_name -> sdn.v1.SecurityAction
+	100, // 84: sdn.v1.UpdateSecurityRuleRequest.logging:type_name -> sdn.v1.SecurityRuleLogging
+	98,  // 85: sdn.v1.UpdateSecurityRuleResponse.security_rule_id:type_name -> sdn.v1.SecurityRuleId
+	98,  // 86: sdn.v1.DeleteSecurityRuleRequest.security_rule_id:type_name -> sdn.v1.SecurityRuleId
+	98,  // 87: sdn.v1.DeleteSecurityRuleResponse.security_rule_id:type_name -> sdn.v1.SecurityRuleId
+	102, // 88: sdn.v1.ListAddressTranslationsResponse.address_translation_ids:type_name -> sdn.v1.AddressTranslationId
+	102, // 89: sdn.v1.CreateAddressTranslationRequest.address_translation_id:type_name -> sdn.v1.AddressTranslationId
+	94,  // 90: sdn.v1.CreateAddressTranslationRequest.port_id:type_name -> sdn.v1.PortId
+	4,   // 91: sdn.v1.CreateAddressTranslationRequest.translation_type:type_name -> sdn.v1.AddressTranslationType
+	102, // 92: sdn.v1.CreateAddressTranslationResponse.address_translation_id:type_name -> sdn.v1.AddressTranslationId
+	102, // 93: sdn.v1.GetAddressTranslationRequest.address_translation_id:type_name -> sdn.v1.AddressTranslationId
+	103, // 94: sdn.v1.GetAddressTranslationResponse.address_translation:type_name -> sdn.v1.AddressTranslation
+	102, // 95: sdn.v1.DeleteAddressTranslationRequest.address_translation_id:type_name -> sdn.v1.AddressTranslationId
+	102, // 96: sdn.v1.DeleteAddressTranslationResponse.address_translation_id:type_name -> sdn.v1.AddressTranslationId
+	84,  // 97: sdn.v1.VPC.id:type_name -> sdn.v1.VPCId
+	88,  // 98: sdn.v1.VPC.routers:type_name -> sdn.v1.RouterId
+	86,  // 99: sdn.v1.VPC.subnets:type_name -> sdn.v1.SubnetId
+	83,  // 100: sdn.v1.VPC.status:type_name -> sdn.v1.ObjStatus
+	86,  // 101: sdn.v1.Subnet.id:type_name -> sdn.v1.SubnetId
+	84,  // 102: sdn.v1.Subnet.vpc_id:type_name -> sdn.v1.VPCId
+	83,  // 103: sdn.v1.Subnet.status:type_name -> sdn.v1.ObjStatus
+	88,  // 104: sdn.v1.Router.id:type_name -> sdn.v1.RouterId
+	84,  // 105: sdn.v1.Router.vpc_id:type_name -> sdn.v1.VPCId
+	83,  // 106: sdn.v1.Router.status:type_name -> sdn.v1.ObjStatus
+	90,  // 107: sdn.v1.RouterInterface.id:type_name -> sdn.v1.RouterInterfaceId
+	88,  // 108: sdn.v1.RouterInterface.router_id:type_name -> sdn.v1.RouterId
+	86,  // 109: sdn.v1.RouterInterface.subnet_id:type_name -> sdn.v1.SubnetId
+	83,  // 110: sdn.v1.RouterInterface.status:type_name -> sdn.v1.ObjStatus
+	92,  // 111: sdn.v1.StaticRoute.id:type_name -> sdn.v1.StaticRouteId
+	88,  // 112: sdn.v1.StaticRoute.router_id:type_name -> sdn.v1.RouterId
+	83,  // 113: sdn.v1.StaticRoute.status:type_name -> sdn.v1.ObjStatus
+	94,  // 114: sdn.v1.Port.id:type_name -> sdn.v1.PortId
+	86,  // 115: sdn.v1.Port.subnet_id:type_name -> sdn.v1.SubnetId
+	83,  // 116: sdn.v1.Port.status:type_name -> sdn.v1.ObjStatus
+	96,  // 117: sdn.v1.SecurityGroup.id:type_name -> sdn.v1.SecurityGroupId
+	0,   // 118: sdn.v1.SecurityGroup.type:type_name -> sdn.v1.SecurityGroupType
+	98,  // 119: sdn.v1.SecurityGroup.security_rule_ids:type_name -> sdn.v1.SecurityRuleId
+	94,  // 120: sdn.v1.SecurityGroup.port_ids:type_name -> sdn.v1.PortId
+	86,  // 121: sdn.v1.SecurityGroup.subnet_ids:type_name -> sdn.v1.SubnetId
+	84,  // 122: sdn.v1.SecurityGroup.vpc_id:type_name -> sdn.v1.VPCId
+	83,  // 123: sdn.v1.SecurityGroup.status:type_name -> sdn.v1.ObjStatus
+	98,  // 124: sdn.v1.SecurityRule.id:type_name -> sdn.v1.SecurityRuleId
+	96,  // 125: sdn.v1.SecurityRule.security_group_id:type_name -> sdn.v1.SecurityGroupId
+	2,   // 126: sdn.v1.SecurityRule.direction:type_name -> sdn.v1.Direction
+	1,   // 127: sdn.v1.SecurityRule.protocol:type_name -> sdn.v1.Protocol
+	101, // 128: sdn.v1.SecurityRule.source_port_range:type_name -> sdn.v1.PortRange
+	101, // 129: sdn.v1.SecurityRule.destination_port_range:type_name -> sdn.v1.PortRange
+	3,   // 130: sdn.v1.SecurityRule.action:type_name -> sdn.v1.SecurityAction
+	84,  // 131: sdn.v1.SecurityRule.vpc_id:type_name -> sdn.v1.VPCId
+	83,  // 132: sdn.v1.SecurityRule.status:type_name -> sdn.v1.ObjStatus
+	100, // 133: sdn.v1.SecurityRule.logging:type_name -> sdn.v1.SecurityRuleLogging
+	102, // 134: sdn.v1.AddressTranslation.address_translation_id:type_name -> sdn.v1.AddressTranslationId
+	94,  // 135: sdn.v1.AddressTranslation.port_id:type_name -> sdn.v1.PortId
+	4,   // 136: sdn.v1.AddressTranslation.translation_type:type_name -> sdn.v1.AddressTranslationType
+	5,   // 137: sdn.v1.Ovnnet.ListVPCs:input_type -> sdn.v1.ListVPCsRequest
+	7,   // 138: sdn.v1.Ovnnet.CreateVPC:input_type -> sdn.v1.CreateVPCRequest
+	9,   // 139: sdn.v1.Ovnnet.GetVPC:input_type -> sdn.v1.GetVPCRequest
+	11,  // 140: sdn.v1.Ovnnet.DeleteVPC:input_type -> sdn.v1.DeleteVPCRequest
+	13,  // 141: sdn.v1.Ovnnet.ListSubnets:input_type -> sdn.v1.ListSubnetsRequest
+	15,  // 142: sdn.v1.Ovnnet.CreateSubnet:input_type -> sdn.v1.CreateSubnetRequest
+	17,  // 143: sdn.v1.Ovnnet.GetSubnet:input_type -> sdn.v1.GetSubnetRequest
+	19,  // 144: sdn.v1.Ovnnet.DeleteSubnet:input_type -> sdn.v1.DeleteSubnetRequest
+	21,  // 145: sdn.v1.Ovnnet.ListRouters:input_type -> sdn.v1.ListRoutersRequest
+	23,  // 146: sdn.v1.Ovnnet.CreateRouter:input_type -> sdn.v1.CreateRouterRequest
+	25,  // 147: sdn.v1.Ovnnet.GetRouter:input_type -> sdn.v1.GetRouterRequest
+	27,  // 148: sdn.v1.Ovnnet.DeleteRouter:input_type -> sdn.v1.DeleteRouterRequest
+	29,  // 149: sdn.v1.Ovnnet.ListRouterInterfaces:input_type -> sdn.v1.ListRouterInterfacesRequest
+	31,  // 150: sdn.v1.Ovnnet.CreateRouterInterface:input_type -> sdn.v1.CreateRouterInterfaceRequest
+	32,  // 151: sdn.v1.Ovnnet.GetRouterInterface:input_type -> sdn.v1.GetRouterInterfaceRequest
+	35,  // 152: sdn.v1.Ovnnet.DeleteRouterInterface:input_type -> sdn.v1.DeleteRouterInterfaceRequest
+	37,  // 153: sdn.v1.Ovnnet.ListStaticRoutes:input_type -> sdn.v1.ListStaticRoutesRequest
+	39,  // 154: sdn.v1.Ovnnet.CreateStaticRoute:input_type -> sdn.v1.CreateStaticRouteRequest
+	41,  // 155: sdn.v1.Ovnnet.GetStaticRoute:input_type -> sdn.v1.GetStaticRouteRequest
+	43,  // 156: sdn.v1.Ovnnet.DeleteStaticRoute:input_type -> sdn.v1.DeleteStaticRouteRequest
+	45,  // 157: sdn.v1.Ovnnet.ListPorts:input_type -> sdn.v1.ListPortsRequest
+	47,  // 158: sdn.v1.Ovnnet.CreatePort:input_type -> sdn.v1.CreatePortRequest
+	49,  // 159: sdn.v1.Ovnnet.GetPort:input_type -> sdn.v1.GetPortRequest
+	51,  // 160: sdn.v1.Ovnnet.UpdatePort:input_type -> sdn.v1.UpdatePortRequest
+	53,  // 161: sdn.v1.Ovnnet.DeletePort:input_type -> sdn.v1.DeletePortRequest
+	55,  // 162: sdn.v1.Ovnnet.ListSecurityGroups:input_type -> sdn.v1.ListSecurityGroupsRequest
+	57,  // 163: sdn.v1.Ovnnet.CreateSecurityGroup:input_type -> sdn.v1.CreateSecurityGroupRequest
+	59,  // 164: sdn.v1.Ovnnet.GetSecurityGroup:input_type -> sdn.v1.GetSecurityGroupRequest
+	61,  // 165: sdn.v1.Ovnnet.UpdateSecurityGroup:input_type -> sdn.v1.UpdateSecurityGroupRequest
+	63,  // 166: sdn.v1.Ovnnet.DeleteSecurityGroup:input_type -> sdn.v1.DeleteSecurityGroupRequest
+	65,  // 167: sdn.v1.Ovnnet.ListSecurityRules:input_type -> sdn.v1.ListSecurityRulesRequest
+	67,  // 168: sdn.v1.Ovnnet.CreateSecurityRule:input_type -> sdn.v1.CreateSecurityRuleRequest
+	69,  // 169: sdn.v1.Ovnnet.GetSecurityRule:input_type -> sdn.v1.GetSecurityRuleRequest
+	73,  // 170: sdn.v1.Ovnnet.DeleteSecurityRule:input_type -> sdn.v1.DeleteSecurityRuleRequest
+	71,  // 171: sdn.v1.Ovnnet.UpdateSecurityRule:input_type -> sdn.v1.UpdateSecurityRuleRequest
+	75,  // 172: sdn.v1.Ovnnet.ListAddressTranslations:input_type -> sdn.v1.ListAddressTranslationsRequest
+	77,  // 173: sdn.v1.Ovnnet.CreateAddressTranslation:input_type -> sdn.v1.CreateAddressTranslationRequest
+	79,  // 174: sdn.v1.Ovnnet.GetAddressTranslation:input_type -> sdn.v1.GetAddressTranslationRequest
+	81,  // 175: sdn.v1.Ovnnet.DeleteAddressTranslation:input_type -> sdn.v1.DeleteAddressTranslationRequest
+	6,   // 176: sdn.v1.Ovnnet.ListVPCs:output_type -> sdn.v1.ListVPCsResponse
+	8,   // 177: sdn.v1.Ovnnet.CreateVPC:output_type -> sdn.v1.CreateVPCResponse
+	10,  // 178: sdn.v1.Ovnnet.GetVPC:output_type -> sdn.v1.GetVPCResponse
+	12,  // 179: sdn.v1.Ovnnet.DeleteVPC:output_type -> sdn.v1.DeleteVPCResponse
+	14,  // 180: sdn.v1.Ovnnet.ListSubnets:output_type -> sdn.v1.ListSubnetsResponse
+	16,  // 181: sdn.v1.Ovnnet.CreateSubnet:output_type -> sdn.v1.CreateSubnetResponse
+	18,  // 182: sdn.v1.Ovnnet.GetSubnet:output_type -> sdn.v1.GetSubnetResponse
+	20,  // 183: sdn.v1.Ovnnet.DeleteSubnet:output_type -> sdn.v1.DeleteSubnetResponse
+	22,  // 184: sdn.v1.Ovnnet.ListRouters:output_type -> sdn.v1.ListRoutersResponse
+	24,  // 185: sdn.v1.Ovnnet.CreateRouter:output_type -> sdn.v1.CreateRouterResponse
+	26,  // 186: sdn.v1.Ovnnet.GetRouter:output_type -> sdn.v1.GetRouterResponse
+	28,  // 187: sdn.v1.Ovnnet.DeleteRouter:output_type -> sdn.v1.DeleteRouterResponse
+	30,  // 188: sdn.v1.Ovnnet.ListRouterInterfaces:output_type -> sdn.v1.ListRouterInterfacesResponse
+	34,  // 189: sdn.v1.Ovnnet.CreateRouterInterface:output_type -> sdn.v1.CreateRouterInterfaceResponse
+	33,  // 190: sdn.v1.Ovnnet.GetRouterInterface:output_type -> sdn.v1.GetRouterInterfaceResponse
+	36,  // 191: sdn.v1.Ovnnet.DeleteRouterInterface:output_type -> sdn.v1.DeleteRouterInterfaceResponse
+	38,  // 192: sdn.v1.Ovnnet.ListStaticRoutes:output_type -> sdn.v1.ListStaticRoutesResponse
+	40,  // 193: sdn.v1.Ovnnet.CreateStaticRoute:output_type -> sdn.v1.CreateStaticRouteResponse
+	42,  // 194: sdn.v1.Ovnnet.GetStaticRoute:output_type -> sdn.v1.GetStaticRouteResponse
+	44,  // 195: sdn.v1.Ovnnet.DeleteStaticRoute:output_type -> sdn.v1.DeleteStaticRouteResponse
+	46,  // 196: sdn.v1.Ovnnet.ListPorts:output_type -> sdn.v1.ListPortsResponse
+	48,  // 197: sdn.v1.Ovnnet.CreatePort:output_type -> sdn.v1.CreatePortResponse
+	50,  // 198: sdn.v1.Ovnnet.GetPort:output_type -> sdn.v1.GetPortResponse
+	52,  // 199: sdn.v1.Ovnnet.UpdatePort:output_type -> sdn.v1.UpdatePortResponse
+	54,  // 200: sdn.v1.Ovnnet.DeletePort:output_type -> sdn.v1.DeletePortResponse
+	56,  // 201: sdn.v1.Ovnnet.ListSecurityGroups:output_type -> sdn.v1.ListSecurityGroupsResponse
+	58,  // 202: sdn.v1.Ovnnet.CreateSecurityGroup:output_type -> sdn.v1.CreateSecurityGroupResponse
+	60,  // 203: sdn.v1.Ovnnet.GetSecurityGroup:output_type -> sdn.v1.GetSecurityGroupResponse
+	62,  // 204: sdn.v1.Ovnnet.UpdateSecurityGroup:output_type -> sdn.v1.UpdateSecurityGroupResponse
+	64,  // 205: sdn.v1.Ovnnet.DeleteSecurityGroup:output_type -> sdn.v1.DeleteSecurityGroupResponse
+	66,  // 206: sdn.v1.Ovnnet.ListSecurityRules:output_type -> sdn.v1.ListSecurityRulesResponse
+	68,  // 207: sdn.v1.Ovnnet.CreateSecurityRule:output_type -> sdn.v1.CreateSecurityRuleResponse
+	70,  // 208: sdn.v1.Ovnnet.GetSecurityRule:output_type -> sdn.v1.GetSecurityRuleResponse
+	74,  // 209: sdn.v1.Ovnnet.DeleteSecurityRule:output_type -> sdn.v1.DeleteSecurityRuleResponse
+	72,  // 210: sdn.v1.Ovnnet.UpdateSecurityRule:output_type -> sdn.v1.UpdateSecurityRuleResponse
+	76,  // 211: sdn.v1.Ovnnet.ListAddressTranslations:output_type -> sdn.v1.ListAddressTranslationsResponse
+	78,  // 212: sdn.v1.Ovnnet.CreateAddressTranslation:output_type -> sdn.v1.CreateAddressTranslationResponse
+	80,  // 213: sdn.v1.Ovnnet.GetAddressTranslation:output_type -> sdn.v1.GetAddressTranslationResponse
+	82,  // 214: sdn.v1.Ovnnet.DeleteAddressTranslation:output_type -> sdn.v1.DeleteAddressTranslationResponse
+	176, // [176:215] is the sub-list for method output_type
+	137, // [137:176] is the sub-list for method input_type
+	137, // [137:137] is the sub-list for extension type_name
+	137, // [137:137] is the sub-list for extension extendee
+	0,   // [0:137] is the sub-list for field type_name
 }
 
 func init() { file_api_sdn_v1_ovnnet_proto_init() }
@@ -6090,7 +7258,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_api_sdn_v1_ovnnet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*ListVPCsRequest); i {
 			case 0:
 				return &v.state
@@ -6102,7 +7270,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*ListVPCsResponse); i {
 			case 0:
 				return &v.state
@@ -6114,7 +7282,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateVPCRequest); i {
 			case 0:
 				return &v.state
@@ -6126,7 +7294,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateVPCResponse); i {
 			case 0:
 				return &v.state
@@ -6138,7 +7306,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*GetVPCRequest); i {
 			case 0:
 				return &v.state
@@ -6150,7 +7318,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[5].Exporter = func(v any, i int) any {
 			switch v := v.(*GetVPCResponse); i {
 			case 0:
 				return &v.state
@@ -6162,7 +7330,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteVPCRequest); i {
 			case 0:
 				return &v.state
@@ -6174,7 +7342,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteVPCResponse); i {
 			case 0:
 				return &v.state
@@ -6186,7 +7354,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSubnetsRequest); i {
 			case 0:
 				return &v.state
@@ -6198,7 +7366,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[9].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSubnetsResponse); i {
 			case 0:
 				return &v.state
@@ -6210,7 +7378,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[10].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateSubnetRequest); i {
 			case 0:
 				return &v.state
@@ -6222,7 +7390,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[11].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateSubnetResponse); i {
 			case 0:
 				return &v.state
@@ -6234,7 +7402,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSubnetRequest); i {
 			case 0:
 				return &v.state
@@ -6246,7 +7414,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSubnetResponse); i {
 			case 0:
 				return &v.state
@@ -6258,7 +7426,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteSubnetRequest); i {
 			case 0:
 				return &v.state
@@ -6270,7 +7438,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[15].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteSubnetResponse); i {
 			case 0:
 				return &v.state
@@ -6282,7 +7450,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*ListRoutersRequest); i {
 			case 0:
 				return &v.state
@@ -6294,7 +7462,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[17].Exporter = func(v any, i int) any {
 			switch v := v.(*ListRoutersResponse); i {
 			case 0:
 				return &v.state
@@ -6306,7 +7474,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[18].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateRouterRequest); i {
 			case 0:
 				return &v.state
@@ -6318,7 +7486,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[19].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateRouterResponse); i {
 			case 0:
 				return &v.state
@@ -6330,7 +7498,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRouterRequest); i {
 			case 0:
 				return &v.state
@@ -6342,7 +7510,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[21].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRouterResponse); i {
 			case 0:
 				return &v.state
@@ -6354,7 +7522,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[22].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteRouterRequest); i {
 			case 0:
 				return &v.state
@@ -6366,7 +7534,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteRouterResponse); i {
 			case 0:
 				return &v.state
@@ -6378,7 +7546,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[24].Exporter = func(v any, i int) any {
 			switch v := v.(*ListRouterInterfacesRequest); i {
 			case 0:
 				return &v.state
@@ -6390,7 +7558,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[25].Exporter = func(v any, i int) any {
 			switch v := v.(*ListRouterInterfacesResponse); i {
 			case 0:
 				return &v.state
@@ -6402,7 +7570,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[26].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateRouterInterfaceRequest); i {
 			case 0:
 				return &v.state
@@ -6414,7 +7582,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[27].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRouterInterfaceRequest); i {
 			case 0:
 				return &v.state
@@ -6426,7 +7594,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[28].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRouterInterfaceResponse); i {
 			case 0:
 				return &v.state
@@ -6438,7 +7606,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateRouterInterfaceResponse); i {
 			case 0:
 				return &v.state
@@ -6450,7 +7618,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[30].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteRouterInterfaceRequest); i {
 			case 0:
 				return &v.state
@@ -6462,7 +7630,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteRouterInterfaceResponse); i {
 			case 0:
 				return &v.state
@@ -6474,7 +7642,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[32].Exporter = func(v any, i int) any {
 			switch v := v.(*ListStaticRoutesRequest); i {
 			case 0:
 				return &v.state
@@ -6486,7 +7654,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*ListStaticRoutesResponse); i {
 			case 0:
 				return &v.state
@@ -6498,7 +7666,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[34].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateStaticRouteRequest); i {
 			case 0:
 				return &v.state
@@ -6510,7 +7678,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[35].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateStaticRouteResponse); i {
 			case 0:
 				return &v.state
@@ -6522,7 +7690,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[36].Exporter = func(v any, i int) any {
 			switch v := v.(*GetStaticRouteRequest); i {
 			case 0:
 				return &v.state
@@ -6534,7 +7702,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*GetStaticRouteResponse); i {
 			case 0:
 				return &v.state
@@ -6546,7 +7714,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteStaticRouteRequest); i {
 			case 0:
 				return &v.state
@@ -6558,7 +7726,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[39].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteStaticRouteResponse); i {
 			case 0:
 				return &v.state
@@ -6570,7 +7738,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*ListPortsRequest); i {
 			case 0:
 				return &v.state
@@ -6582,7 +7750,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[41].Exporter = func(v any, i int) any {
 			switch v := v.(*ListPortsResponse); i {
 			case 0:
 				return &v.state
@@ -6594,7 +7762,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[42].Exporter = func(v any, i int) any {
 			switch v := v.(*CreatePortRequest); i {
 			case 0:
 				return &v.state
@@ -6606,7 +7774,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*CreatePortResponse); i {
 			case 0:
 				return &v.state
@@ -6618,7 +7786,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[44].Exporter = func(v any, i int) any {
 			switch v := v.(*GetPortRequest); i {
 			case 0:
 				return &v.state
@@ -6630,7 +7798,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[45].Exporter = func(v any, i int) any {
 			switch v := v.(*GetPortResponse); i {
 			case 0:
 				return &v.state
@@ -6642,7 +7810,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[46].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdatePortRequest); i {
 			case 0:
 				return &v.state
@@ -6654,7 +7822,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[47].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdatePortResponse); i {
 			case 0:
 				return &v.state
@@ -6666,7 +7834,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[48].Exporter = func(v any, i int) any {
 			switch v := v.(*DeletePortRequest); i {
 			case 0:
 				return &v.state
@@ -6678,7 +7846,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[49].Exporter = func(v any, i int) any {
 			switch v := v.(*DeletePortResponse); i {
 			case 0:
 				return &v.state
@@ -6690,7 +7858,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSecurityGroupsRequest); i {
 			case 0:
 				return &v.state
@@ -6702,7 +7870,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSecurityGroupsResponse); i {
 			case 0:
 				return &v.state
@@ -6714,7 +7882,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateSecurityGroupRequest); i {
 			case 0:
 				return &v.state
@@ -6726,7 +7894,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateSecurityGroupResponse); i {
 			case 0:
 				return &v.state
@@ -6738,7 +7906,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSecurityGroupRequest); i {
 			case 0:
 				return &v.state
@@ -6750,7 +7918,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSecurityGroupResponse); i {
 			case 0:
 				return &v.state
@@ -6762,7 +7930,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[56].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateSecurityGroupRequest); i {
 			case 0:
 				return &v.state
@@ -6774,7 +7942,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[57].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateSecurityGroupResponse); i {
 			case 0:
 				return &v.state
@@ -6786,7 +7954,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteSecurityGroupRequest); i {
 			case 0:
 				return &v.state
@@ -6798,7 +7966,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteSecurityGroupResponse); i {
 			case 0:
 				return &v.state
@@ -6810,7 +7978,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSecurityRulesRequest); i {
 			case 0:
 				return &v.state
@@ -6822,7 +7990,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[61].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSecurityRulesResponse); i {
 			case 0:
 				return &v.state
@@ -6834,7 +8002,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[62].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateSecurityRuleRequest); i {
 			case 0:
 				return &v.state
@@ -6846,7 +8014,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateSecurityRuleResponse); i {
 			case 0:
 				return &v.state
@@ -6858,7 +8026,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[64].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSecurityRuleRequest); i {
 			case 0:
 				return &v.state
@@ -6870,7 +8038,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[65].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSecurityRuleResponse); i {
 			case 0:
 				return &v.state
@@ -6882,7 +8050,31 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateSecurityRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateSecurityRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[68].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteSecurityRuleRequest); i {
 			case 0:
 				return &v.state
@@ -6894,7 +8086,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[69].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteSecurityRuleResponse); i {
 			case 0:
 				return &v.state
@@ -6906,7 +8098,103 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAddressTranslationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAddressTranslationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAddressTranslationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAddressTranslationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAddressTranslationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAddressTranslationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAddressTranslationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAddressTranslationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[78].Exporter = func(v any, i int) any {
 			switch v := v.(*ObjStatus); i {
 			case 0:
 				return &v.state
@@ -6918,7 +8206,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[79].Exporter = func(v any, i int) any {
 			switch v := v.(*VPCId); i {
 			case 0:
 				return &v.state
@@ -6930,7 +8218,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[80].Exporter = func(v any, i int) any {
 			switch v := v.(*VPC); i {
 			case 0:
 				return &v.state
@@ -6942,7 +8230,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[81].Exporter = func(v any, i int) any {
 			switch v := v.(*SubnetId); i {
 			case 0:
 				return &v.state
@@ -6954,7 +8242,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[82].Exporter = func(v any, i int) any {
 			switch v := v.(*Subnet); i {
 			case 0:
 				return &v.state
@@ -6966,7 +8254,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[83].Exporter = func(v any, i int) any {
 			switch v := v.(*RouterId); i {
 			case 0:
 				return &v.state
@@ -6978,7 +8266,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[84].Exporter = func(v any, i int) any {
 			switch v := v.(*Router); i {
 			case 0:
 				return &v.state
@@ -6990,7 +8278,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[85].Exporter = func(v any, i int) any {
 			switch v := v.(*RouterInterfaceId); i {
 			case 0:
 				return &v.state
@@ -7002,7 +8290,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[86].Exporter = func(v any, i int) any {
 			switch v := v.(*RouterInterface); i {
 			case 0:
 				return &v.state
@@ -7014,7 +8302,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[87].Exporter = func(v any, i int) any {
 			switch v := v.(*StaticRouteId); i {
 			case 0:
 				return &v.state
@@ -7026,7 +8314,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[88].Exporter = func(v any, i int) any {
 			switch v := v.(*StaticRoute); i {
 			case 0:
 				return &v.state
@@ -7038,7 +8326,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[89].Exporter = func(v any, i int) any {
 			switch v := v.(*PortId); i {
 			case 0:
 				return &v.state
@@ -7050,7 +8338,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[90].Exporter = func(v any, i int) any {
 			switch v := v.(*Port); i {
 			case 0:
 				return &v.state
@@ -7062,7 +8350,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[91].Exporter = func(v any, i int) any {
 			switch v := v.(*SecurityGroupId); i {
 			case 0:
 				return &v.state
@@ -7074,7 +8362,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[92].Exporter = func(v any, i int) any {
 			switch v := v.(*SecurityGroup); i {
 			case 0:
 				return &v.state
@@ -7086,7 +8374,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[93].Exporter = func(v any, i int) any {
 			switch v := v.(*SecurityRuleId); i {
 			case 0:
 				return &v.state
@@ -7098,7 +8386,7 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[94].Exporter = func(v any, i int) any {
 			switch v := v.(*SecurityRule); i {
 			case 0:
 				return &v.state
@@ -7110,7 +8398,19 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
-		file_api_sdn_v1_ovnnet_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_api_sdn_v1_ovnnet_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*SecurityRuleLogging); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[96].Exporter = func(v any, i int) any {
 			switch v := v.(*PortRange); i {
 			case 0:
 				return &v.state
@@ -7122,20 +8422,47 @@ func file_api_sdn_v1_ovnnet_proto_init() {
 				return nil
 			}
 		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*AddressTranslationId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sdn_v1_ovnnet_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*AddressTranslation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_sdn_v1_ovnnet_proto_msgTypes[42].OneofWrappers = []interface{}{}
-	file_api_sdn_v1_ovnnet_proto_msgTypes[46].OneofWrappers = []interface{}{}
-	file_api_sdn_v1_ovnnet_proto_msgTypes[47].OneofWrappers = []interface{}{}
-	file_api_sdn_v1_ovnnet_proto_msgTypes[62].OneofWrappers = []interface{}{}
-	file_api_sdn_v1_ovnnet_proto_msgTypes[80].OneofWrappers = []interface{}{}
-	file_api_sdn_v1_ovnnet_proto_msgTypes[84].OneofWrappers = []interface{}{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[42].OneofWrappers = []any{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[46].OneofWrappers = []any{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[47].OneofWrappers = []any{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[62].OneofWrappers = []any{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[66].OneofWrappers = []any{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[72].OneofWrappers = []any{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[90].OneofWrappers = []any{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[94].OneofWrappers = []any{}
+	file_api_sdn_v1_ovnnet_proto_msgTypes[98].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_sdn_v1_ovnnet_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   86,
+			NumEnums:      5,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
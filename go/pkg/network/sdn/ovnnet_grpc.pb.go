@@ -1,7 +1,10 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2024 Intel Corporation
+
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
-// - protoc             v3.21.9
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
 // source: api/sdn/v1/ovnnet.proto
 
 package v1
@@ -15,8 +18,50 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Ovnnet_ListVPCs_FullMethodName                 = "/sdn.v1.Ovnnet/ListVPCs"
+	Ovnnet_CreateVPC_FullMethodName                = "/sdn.v1.Ovnnet/CreateVPC"
+	Ovnnet_GetVPC_FullMethodName                   = "/sdn.v1.Ovnnet/GetVPC"
+	Ovnnet_DeleteVPC_FullMethodName                = "/sdn.v1.Ovnnet/DeleteVPC"
+	Ovnnet_ListSubnets_FullMethodName              = "/sdn.v1.Ovnnet/ListSubnets"
+	Ovnnet_CreateSubnet_FullMethodName             = "/sdn.v1.Ovnnet/CreateSubnet"
+	Ovnnet_GetSubnet_FullMethodName                = "/sdn.v1.Ovnnet/GetSubnet"
+	Ovnnet_DeleteSubnet_FullMethodName             = "/sdn.v1.Ovnnet/DeleteSubnet"
+	Ovnnet_ListRouters_FullMethodName              = "/sdn.v1.Ovnnet/ListRouters"
+	Ovnnet_CreateRouter_FullMethodName             = "/sdn.v1.Ovnnet/CreateRouter"
+	Ovnnet_GetRouter_FullMethodName                = "/sdn.v1.Ovnnet/GetRouter"
+	Ovnnet_DeleteRouter_FullMethodName             = "/sdn.v1.Ovnnet/DeleteRouter"
+	Ovnnet_ListRouterInterfaces_FullMethodName     = "/sdn.v1.Ovnnet/ListRouterInterfaces"
+	Ovnnet_CreateRouterInterface_FullMethodName    = "/sdn.v1.Ovnnet/CreateRouterInterface"
+	Ovnnet_GetRouterInterface_FullMethodName       = "/sdn.v1.Ovnnet/GetRouterInterface"
+	Ovnnet_DeleteRouterInterface_FullMethodName    = "/sdn.v1.Ovnnet/DeleteRouterInterface"
+	Ovnnet_ListStaticRoutes_FullMethodName         = "/sdn.v1.Ovnnet/ListStaticRoutes"
+	Ovnnet_CreateStaticRoute_FullMethodName        = "/sdn.v1.Ovnnet/CreateStaticRoute"
+	Ovnnet_GetStaticRoute_FullMethodName           = "/sdn.v1.Ovnnet/GetStaticRoute"
+	Ovnnet_DeleteStaticRoute_FullMethodName        = "/sdn.v1.Ovnnet/DeleteStaticRoute"
+	Ovnnet_ListPorts_FullMethodName                = "/sdn.v1.Ovnnet/ListPorts"
+	Ovnnet_CreatePort_FullMethodName               = "/sdn.v1.Ovnnet/CreatePort"
+	Ovnnet_GetPort_FullMethodName                  = "/sdn.v1.Ovnnet/GetPort"
+	Ovnnet_UpdatePort_FullMethodName               = "/sdn.v1.Ovnnet/UpdatePort"
+	Ovnnet_DeletePort_FullMethodName               = "/sdn.v1.Ovnnet/DeletePort"
+	Ovnnet_ListSecurityGroups_FullMethodName       = "/sdn.v1.Ovnnet/ListSecurityGroups"
+	Ovnnet_CreateSecurityGroup_FullMethodName      = "/sdn.v1.Ovnnet/CreateSecurityGroup"
+	Ovnnet_GetSecurityGroup_FullMethodName         = "/sdn.v1.Ovnnet/GetSecurityGroup"
+	Ovnnet_UpdateSecurityGroup_FullMethodName      = "/sdn.v1.Ovnnet/UpdateSecurityGroup"
+	Ovnnet_DeleteSecurityGroup_FullMethodName      = "/sdn.v1.Ovnnet/DeleteSecurityGroup"
+	Ovnnet_ListSecurityRules_FullMethodName        = "/sdn.v1.Ovnnet/ListSecurityRules"
+	Ovnnet_CreateSecurityRule_FullMethodName       = "/sdn.v1.Ovnnet/CreateSecurityRule"
+	Ovnnet_GetSecurityRule_FullMethodName          = "/sdn.v1.Ovnnet/GetSecurityRule"
+	Ovnnet_DeleteSecurityRule_FullMethodName       = "/sdn.v1.Ovnnet/DeleteSecurityRule"
+	Ovnnet_UpdateSecurityRule_FullMethodName       = "/sdn.v1.Ovnnet/UpdateSecurityRule"
+	Ovnnet_ListAddressTranslations_FullMethodName  = "/sdn.v1.Ovnnet/ListAddressTranslations"
+	Ovnnet_CreateAddressTranslation_FullMethodName = "/sdn.v1.Ovnnet/CreateAddressTranslation"
+	Ovnnet_GetAddressTranslation_FullMethodName    = "/sdn.v1.Ovnnet/GetAddressTranslation"
+	Ovnnet_DeleteAddressTranslation_FullMethodName = "/sdn.v1.Ovnnet/DeleteAddressTranslation"
+)
 
 // OvnnetClient is the client API for Ovnnet service.
 //
@@ -72,11 +117,11 @@ type OvnnetClient interface {
 	//
 	// Usage :
 	//
-	// * Enable/Disable SNAT
+	// * Enable/Disable SNAT // Deprecated -- Use AddressTranslation API
 	//
 	// This can be done by setting the IsNAT to True or False.
 	//
-	// * Associate/Disassociate ElasticIP
+	// * Associate/Disassociate ElasticIP // Deprecated -- Use AddressTranslation API
 	//
 	// In order to associate or remove Elastic IP set (or clear) the external_IP_address and External_MAC attributes for the Port.
 	// Caller must ensure the external IP address is available for assignment
@@ -87,23 +132,23 @@ type OvnnetClient interface {
 	// Get the Ids of all the SecurityGroups
 	ListSecurityGroups(ctx context.Context, in *ListSecurityGroupsRequest, opts ...grpc.CallOption) (*ListSecurityGroupsResponse, error)
 	// Create a new SecurityGroup and return the ID
+	// A Security Group can be of type PORT or SUBNET
+	// A Security Group of type PORT can only be applied to ports whereas, Security Group of type SUBNET
+	// can be applied to subnets only
 	CreateSecurityGroup(ctx context.Context, in *CreateSecurityGroupRequest, opts ...grpc.CallOption) (*CreateSecurityGroupResponse, error)
 	// Get details of a SecurityGroup
 	GetSecurityGroup(ctx context.Context, in *GetSecurityGroupRequest, opts ...grpc.CallOption) (*GetSecurityGroupResponse, error)
 	// Update the SecurityGroup  with the given ID to the given attributes
 	//
-	// This can be used to:
-	//
-	// * Add or remove Security Rules from the SecurityGroup
-	//
-	// The list of Security Rules specified in this call should have all the Security Rules
-	// that would be part of the Security Group once the update completes.
+	// For Security Group of type PORT this can be used to:
 	//
 	// * Add or remove Ports the SecurityGroup needs to be applied to
 	//
 	// The list of Ports specified in this call should have all the Ports
 	// that would be part of the Security Group once the update completes.
 	//
+	// For Security Group of type SUBNET this can be used to:
+	//
 	// * Add or remove Subnets the SecurityGroup needs to be applied to
 	//
 	// The list of Subnets specified in this call should have all the Subnets
@@ -116,6 +161,8 @@ type OvnnetClient interface {
 	// It is caller’s responsibility to assess and take appropriate measures such as quiesce the port. The API will not implicitly take any such measures.
 	UpdateSecurityGroup(ctx context.Context, in *UpdateSecurityGroupRequest, opts ...grpc.CallOption) (*UpdateSecurityGroupResponse, error)
 	// Delete the SecurityGroup  with the given ID
+	// All the Security Rules belonging to the Security Group should be deleted prior to
+	// deleting the Security Group
 	DeleteSecurityGroup(ctx context.Context, in *DeleteSecurityGroupRequest, opts ...grpc.CallOption) (*DeleteSecurityGroupResponse, error)
 	// Get the Ids of all the SecurityRules
 	ListSecurityRules(ctx context.Context, in *ListSecurityRulesRequest, opts ...grpc.CallOption) (*ListSecurityRulesResponse, error)
@@ -124,7 +171,22 @@ type OvnnetClient interface {
 	// Get details of a SecurityRule
 	GetSecurityRule(ctx context.Context, in *GetSecurityRuleRequest, opts ...grpc.CallOption) (*GetSecurityRuleResponse, error)
 	// Delete the SecurityRule  with the given ID
+	// Deleting a Security Rule will automatically remove the rule from the Security Group
+	// it belonged to.
 	DeleteSecurityRule(ctx context.Context, in *DeleteSecurityRuleRequest, opts ...grpc.CallOption) (*DeleteSecurityRuleResponse, error)
+	// Update the SecurityRule
+	UpdateSecurityRule(ctx context.Context, in *UpdateSecurityRuleRequest, opts ...grpc.CallOption) (*UpdateSecurityRuleResponse, error)
+	// Address Translation APIs can be used to apply NATs and direct NATed traffic to a set of
+	// pre-defined gateways so that services such as internet access can be provided.
+	//
+	// Get the Ids of all the Address Translations
+	ListAddressTranslations(ctx context.Context, in *ListAddressTranslationsRequest, opts ...grpc.CallOption) (*ListAddressTranslationsResponse, error)
+	// Create a new Address Translation and return the ID
+	CreateAddressTranslation(ctx context.Context, in *CreateAddressTranslationRequest, opts ...grpc.CallOption) (*CreateAddressTranslationResponse, error)
+	// Get details of an Address Translation
+	GetAddressTranslation(ctx context.Context, in *GetAddressTranslationRequest, opts ...grpc.CallOption) (*GetAddressTranslationResponse, error)
+	// Delete the Address Translation  with the given ID
+	DeleteAddressTranslation(ctx context.Context, in *DeleteAddressTranslationRequest, opts ...grpc.CallOption) (*DeleteAddressTranslationResponse, error)
 }
 
 type ovnnetClient struct {
@@ -136,8 +198,9 @@ func NewOvnnetClient(cc grpc.ClientConnInterface) OvnnetClient {
 }
 
 func (c *ovnnetClient) ListVPCs(ctx context.Context, in *ListVPCsRequest, opts ...grpc.CallOption) (*ListVPCsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListVPCsResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/ListVPCs", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_ListVPCs_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -145,8 +208,9 @@ func (c *ovnnetClient) ListVPCs(ctx context.Context, in *ListVPCsRequest, opts .
 }
 
 func (c *ovnnetClient) CreateVPC(ctx context.Context, in *CreateVPCRequest, opts ...grpc.CallOption) (*CreateVPCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateVPCResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/CreateVPC", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_CreateVPC_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,8 +218,9 @@ func (c *ovnnetClient) CreateVPC(ctx context.Context, in *CreateVPCRequest, opts
 }
 
 func (c *ovnnetClient) GetVPC(ctx context.Context, in *GetVPCRequest, opts ...grpc.CallOption) (*GetVPCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVPCResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/GetVPC", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_GetVPC_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -163,8 +228,9 @@ func (c *ovnnetClient) GetVPC(ctx context.Context, in *GetVPCRequest, opts ...gr
 }
 
 func (c *ovnnetClient) DeleteVPC(ctx context.Context, in *DeleteVPCRequest, opts ...grpc.CallOption) (*DeleteVPCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteVPCResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/DeleteVPC", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_DeleteVPC_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,8 +238,9 @@ func (c *ovnnetClient) DeleteVPC(ctx context.Context, in *DeleteVPCRequest, opts
 }
 
 func (c *ovnnetClient) ListSubnets(ctx context.Context, in *ListSubnetsRequest, opts ...grpc.CallOption) (*ListSubnetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSubnetsResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/ListSubnets", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_ListSubnets_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -181,8 +248,9 @@ func (c *ovnnetClient) ListSubnets(ctx context.Context, in *ListSubnetsRequest,
 }
 
 func (c *ovnnetClient) CreateSubnet(ctx context.Context, in *CreateSubnetRequest, opts ...grpc.CallOption) (*CreateSubnetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSubnetResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/CreateSubnet", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_CreateSubnet_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -190,8 +258,9 @@ func (c *ovnnetClient) CreateSubnet(ctx context.Context, in *CreateSubnetRequest
 }
 
 func (c *ovnnetClient) GetSubnet(ctx context.Context, in *GetSubnetRequest, opts ...grpc.CallOption) (*GetSubnetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSubnetResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/GetSubnet", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_GetSubnet_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -199,8 +268,9 @@ func (c *ovnnetClient) GetSubnet(ctx context.Context, in *GetSubnetRequest, opts
 }
 
 func (c *ovnnetClient) DeleteSubnet(ctx context.Context, in *DeleteSubnetRequest, opts ...grpc.CallOption) (*DeleteSubnetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteSubnetResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/DeleteSubnet", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_DeleteSubnet_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -208,8 +278,9 @@ func (c *ovnnetClient) DeleteSubnet(ctx context.Context, in *DeleteSubnetRequest
 }
 
 func (c *ovnnetClient) ListRouters(ctx context.Context, in *ListRoutersRequest, opts ...grpc.CallOption) (*ListRoutersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListRoutersResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/ListRouters", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_ListRouters_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -217,8 +288,9 @@ func (c *ovnnetClient) ListRouters(ctx context.Context, in *ListRoutersRequest,
 }
 
 func (c *ovnnetClient) CreateRouter(ctx context.Context, in *CreateRouterRequest, opts ...grpc.CallOption) (*CreateRouterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateRouterResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/CreateRouter", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_CreateRouter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -226,8 +298,9 @@ func (c *ovnnetClient) CreateRouter(ctx context.Context, in *CreateRouterRequest
 }
 
 func (c *ovnnetClient) GetRouter(ctx context.Context, in *GetRouterRequest, opts ...grpc.CallOption) (*GetRouterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetRouterResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/GetRouter", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_GetRouter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,8 +308,9 @@ func (c *ovnnetClient) GetRouter(ctx context.Context, in *GetRouterRequest, opts
 }
 
 func (c *ovnnetClient) DeleteRouter(ctx context.Context, in *DeleteRouterRequest, opts ...grpc.CallOption) (*DeleteRouterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteRouterResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/DeleteRouter", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_DeleteRouter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -244,8 +318,9 @@ func (c *ovnnetClient) DeleteRouter(ctx context.Context, in *DeleteRouterRequest
 }
 
 func (c *ovnnetClient) ListRouterInterfaces(ctx context.Context, in *ListRouterInterfacesRequest, opts ...grpc.CallOption) (*ListRouterInterfacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListRouterInterfacesResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/ListRouterInterfaces", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_ListRouterInterfaces_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -253,8 +328,9 @@ func (c *ovnnetClient) ListRouterInterfaces(ctx context.Context, in *ListRouterI
 }
 
 func (c *ovnnetClient) CreateRouterInterface(ctx context.Context, in *CreateRouterInterfaceRequest, opts ...grpc.CallOption) (*CreateRouterInterfaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateRouterInterfaceResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/CreateRouterInterface", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_CreateRouterInterface_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -262,8 +338,9 @@ func (c *ovnnetClient) CreateRouterInterface(ctx context.Context, in *CreateRout
 }
 
 func (c *ovnnetClient) GetRouterInterface(ctx context.Context, in *GetRouterInterfaceRequest, opts ...grpc.CallOption) (*GetRouterInterfaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetRouterInterfaceResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/GetRouterInterface", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_GetRouterInterface_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -271,8 +348,9 @@ func (c *ovnnetClient) GetRouterInterface(ctx context.Context, in *GetRouterInte
 }
 
 func (c *ovnnetClient) DeleteRouterInterface(ctx context.Context, in *DeleteRouterInterfaceRequest, opts ...grpc.CallOption) (*DeleteRouterInterfaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteRouterInterfaceResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/DeleteRouterInterface", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_DeleteRouterInterface_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -280,8 +358,9 @@ func (c *ovnnetClient) DeleteRouterInterface(ctx context.Context, in *DeleteRout
 }
 
 func (c *ovnnetClient) ListStaticRoutes(ctx context.Context, in *ListStaticRoutesRequest, opts ...grpc.CallOption) (*ListStaticRoutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListStaticRoutesResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/ListStaticRoutes", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_ListStaticRoutes_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -289,8 +368,9 @@ func (c *ovnnetClient) ListStaticRoutes(ctx context.Context, in *ListStaticRoute
 }
 
 func (c *ovnnetClient) CreateStaticRoute(ctx context.Context, in *CreateStaticRouteRequest, opts ...grpc.CallOption) (*CreateStaticRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateStaticRouteResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/CreateStaticRoute", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_CreateStaticRoute_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -298,8 +378,9 @@ func (c *ovnnetClient) CreateStaticRoute(ctx context.Context, in *CreateStaticRo
 }
 
 func (c *ovnnetClient) GetStaticRoute(ctx context.Context, in *GetStaticRouteRequest, opts ...grpc.CallOption) (*GetStaticRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStaticRouteResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/GetStaticRoute", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_GetStaticRoute_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -307,8 +388,9 @@ func (c *ovnnetClient) GetStaticRoute(ctx context.Context, in *GetStaticRouteReq
 }
 
 func (c *ovnnetClient) DeleteStaticRoute(ctx context.Context, in *DeleteStaticRouteRequest, opts ...grpc.CallOption) (*DeleteStaticRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteStaticRouteResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/DeleteStaticRoute", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_DeleteStaticRoute_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -316,8 +398,9 @@ func (c *ovnnetClient) DeleteStaticRoute(ctx context.Context, in *DeleteStaticRo
 }
 
 func (c *ovnnetClient) ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPortsResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/ListPorts", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_ListPorts_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -325,8 +408,9 @@ func (c *ovnnetClient) ListPorts(ctx context.Context, in *ListPortsRequest, opts
 }
 
 func (c *ovnnetClient) CreatePort(ctx context.Context, in *CreatePortRequest, opts ...grpc.CallOption) (*CreatePortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePortResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/CreatePort", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_CreatePort_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -334,8 +418,9 @@ func (c *ovnnetClient) CreatePort(ctx context.Context, in *CreatePortRequest, op
 }
 
 func (c *ovnnetClient) GetPort(ctx context.Context, in *GetPortRequest, opts ...grpc.CallOption) (*GetPortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPortResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/GetPort", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_GetPort_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -343,8 +428,9 @@ func (c *ovnnetClient) GetPort(ctx context.Context, in *GetPortRequest, opts ...
 }
 
 func (c *ovnnetClient) UpdatePort(ctx context.Context, in *UpdatePortRequest, opts ...grpc.CallOption) (*UpdatePortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdatePortResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/UpdatePort", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_UpdatePort_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -352,8 +438,9 @@ func (c *ovnnetClient) UpdatePort(ctx context.Context, in *UpdatePortRequest, op
 }
 
 func (c *ovnnetClient) DeletePort(ctx context.Context, in *DeletePortRequest, opts ...grpc.CallOption) (*DeletePortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeletePortResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/DeletePort", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_DeletePort_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -361,8 +448,9 @@ func (c *ovnnetClient) DeletePort(ctx context.Context, in *DeletePortRequest, op
 }
 
 func (c *ovnnetClient) ListSecurityGroups(ctx context.Context, in *ListSecurityGroupsRequest, opts ...grpc.CallOption) (*ListSecurityGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSecurityGroupsResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/ListSecurityGroups", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_ListSecurityGroups_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -370,8 +458,9 @@ func (c *ovnnetClient) ListSecurityGroups(ctx context.Context, in *ListSecurityG
 }
 
 func (c *ovnnetClient) CreateSecurityGroup(ctx context.Context, in *CreateSecurityGroupRequest, opts ...grpc.CallOption) (*CreateSecurityGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSecurityGroupResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/CreateSecurityGroup", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_CreateSecurityGroup_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -379,8 +468,9 @@ func (c *ovnnetClient) CreateSecurityGroup(ctx context.Context, in *CreateSecuri
 }
 
 func (c *ovnnetClient) GetSecurityGroup(ctx context.Context, in *GetSecurityGroupRequest, opts ...grpc.CallOption) (*GetSecurityGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSecurityGroupResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/GetSecurityGroup", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_GetSecurityGroup_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -388,8 +478,9 @@ func (c *ovnnetClient) GetSecurityGroup(ctx context.Context, in *GetSecurityGrou
 }
 
 func (c *ovnnetClient) UpdateSecurityGroup(ctx context.Context, in *UpdateSecurityGroupRequest, opts ...grpc.CallOption) (*UpdateSecurityGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateSecurityGroupResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/UpdateSecurityGroup", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_UpdateSecurityGroup_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -397,8 +488,9 @@ func (c *ovnnetClient) UpdateSecurityGroup(ctx context.Context, in *UpdateSecuri
 }
 
 func (c *ovnnetClient) DeleteSecurityGroup(ctx context.Context, in *DeleteSecurityGroupRequest, opts ...grpc.CallOption) (*DeleteSecurityGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteSecurityGroupResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/DeleteSecurityGroup", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_DeleteSecurityGroup_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -406,8 +498,9 @@ func (c *ovnnetClient) DeleteSecurityGroup(ctx context.Context, in *DeleteSecuri
 }
 
 func (c *ovnnetClient) ListSecurityRules(ctx context.Context, in *ListSecurityRulesRequest, opts ...grpc.CallOption) (*ListSecurityRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSecurityRulesResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/ListSecurityRules", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_ListSecurityRules_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -415,8 +508,9 @@ func (c *ovnnetClient) ListSecurityRules(ctx context.Context, in *ListSecurityRu
 }
 
 func (c *ovnnetClient) CreateSecurityRule(ctx context.Context, in *CreateSecurityRuleRequest, opts ...grpc.CallOption) (*CreateSecurityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSecurityRuleResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/CreateSecurityRule", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_CreateSecurityRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -424,8 +518,9 @@ func (c *ovnnetClient) CreateSecurityRule(ctx context.Context, in *CreateSecurit
 }
 
 func (c *ovnnetClient) GetSecurityRule(ctx context.Context, in *GetSecurityRuleRequest, opts ...grpc.CallOption) (*GetSecurityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSecurityRuleResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/GetSecurityRule", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_GetSecurityRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -433,8 +528,59 @@ func (c *ovnnetClient) GetSecurityRule(ctx context.Context, in *GetSecurityRuleR
 }
 
 func (c *ovnnetClient) DeleteSecurityRule(ctx context.Context, in *DeleteSecurityRuleRequest, opts ...grpc.CallOption) (*DeleteSecurityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteSecurityRuleResponse)
-	err := c.cc.Invoke(ctx, "/sdn.v1.Ovnnet/DeleteSecurityRule", in, out, opts...)
+	err := c.cc.Invoke(ctx, Ovnnet_DeleteSecurityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ovnnetClient) UpdateSecurityRule(ctx context.Context, in *UpdateSecurityRuleRequest, opts ...grpc.CallOption) (*UpdateSecurityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSecurityRuleResponse)
+	err := c.cc.Invoke(ctx, Ovnnet_UpdateSecurityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ovnnetClient) ListAddressTranslations(ctx context.Context, in *ListAddressTranslationsRequest, opts ...grpc.CallOption) (*ListAddressTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAddressTranslationsResponse)
+	err := c.cc.Invoke(ctx, Ovnnet_ListAddressTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ovnnetClient) CreateAddressTranslation(ctx context.Context, in *CreateAddressTranslationRequest, opts ...grpc.CallOption) (*CreateAddressTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAddressTranslationResponse)
+	err := c.cc.Invoke(ctx, Ovnnet_CreateAddressTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ovnnetClient) GetAddressTranslation(ctx context.Context, in *GetAddressTranslationRequest, opts ...grpc.CallOption) (*GetAddressTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAddressTranslationResponse)
+	err := c.cc.Invoke(ctx, Ovnnet_GetAddressTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ovnnetClient) DeleteAddressTranslation(ctx context.Context, in *DeleteAddressTranslationRequest, opts ...grpc.CallOption) (*DeleteAddressTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAddressTranslationResponse)
+	err := c.cc.Invoke(ctx, Ovnnet_DeleteAddressTranslation_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -443,7 +589,7 @@ func (c *ovnnetClient) DeleteSecurityRule(ctx context.Context, in *DeleteSecurit
 
 // OvnnetServer is the server API for Ovnnet service.
 // All implementations must embed UnimplementedOvnnetServer
-// for forward compatibility
+// for forward compatibility.
 type OvnnetServer interface {
 	// Get the Ids of all the VPCs
 	ListVPCs(context.Context, *ListVPCsRequest) (*ListVPCsResponse, error)
@@ -495,11 +641,11 @@ type OvnnetServer interface {
 	//
 	// Usage :
 	//
-	// * Enable/Disable SNAT
+	// * Enable/Disable SNAT // Deprecated -- Use AddressTranslation API
 	//
 	// This can be done by setting the IsNAT to True or False.
 	//
-	// * Associate/Disassociate ElasticIP
+	// * Associate/Disassociate ElasticIP // Deprecated -- Use AddressTranslation API
 	//
 	// In order to associate or remove Elastic IP set (or clear) the external_IP_address and External_MAC attributes for the Port.
 	// Caller must ensure the external IP address is available for assignment
@@ -510,23 +656,23 @@ type OvnnetServer interface {
 	// Get the Ids of all the SecurityGroups
 	ListSecurityGroups(context.Context, *ListSecurityGroupsRequest) (*ListSecurityGroupsResponse, error)
 	// Create a new SecurityGroup and return the ID
+	// A Security Group can be of type PORT or SUBNET
+	// A Security Group of type PORT can only be applied to ports whereas, Security Group of type SUBNET
+	// can be applied to subnets only
 	CreateSecurityGroup(context.Context, *CreateSecurityGroupRequest) (*CreateSecurityGroupResponse, error)
 	// Get details of a SecurityGroup
 	GetSecurityGroup(context.Context, *GetSecurityGroupRequest) (*GetSecurityGroupResponse, error)
 	// Update the SecurityGroup  with the given ID to the given attributes
 	//
-	// This can be used to:
-	//
-	// * Add or remove Security Rules from the SecurityGroup
-	//
-	// The list of Security Rules specified in this call should have all the Security Rules
-	// that would be part of the Security Group once the update completes.
+	// For Security Group of type PORT this can be used to:
 	//
 	// * Add or remove Ports the SecurityGroup needs to be applied to
 	//
 	// The list of Ports specified in this call should have all the Ports
 	// that would be part of the Security Group once the update completes.
 	//
+	// For Security Group of type SUBNET this can be used to:
+	//
 	// * Add or remove Subnets the SecurityGroup needs to be applied to
 	//
 	// The list of Subnets specified in this call should have all the Subnets
@@ -539,6 +685,8 @@ type OvnnetServer interface {
 	// It is caller’s responsibility to assess and take appropriate measures such as quiesce the port. The API will not implicitly take any such measures.
 	UpdateSecurityGroup(context.Context, *UpdateSecurityGroupRequest) (*UpdateSecurityGroupResponse, error)
 	// Delete the SecurityGroup  with the given ID
+	// All the Security Rules belonging to the Security Group should be deleted prior to
+	// deleting the Security Group
 	DeleteSecurityGroup(context.Context, *DeleteSecurityGroupRequest) (*DeleteSecurityGroupResponse, error)
 	// Get the Ids of all the SecurityRules
 	ListSecurityRules(context.Context, *ListSecurityRulesRequest) (*ListSecurityRulesResponse, error)
@@ -547,13 +695,31 @@ type OvnnetServer interface {
 	// Get details of a SecurityRule
 	GetSecurityRule(context.Context, *GetSecurityRuleRequest) (*GetSecurityRuleResponse, error)
 	// Delete the SecurityRule  with the given ID
+	// Deleting a Security Rule will automatically remove the rule from the Security Group
+	// it belonged to.
 	DeleteSecurityRule(context.Context, *DeleteSecurityRuleRequest) (*DeleteSecurityRuleResponse, error)
+	// Update the SecurityRule
+	UpdateSecurityRule(context.Context, *UpdateSecurityRuleRequest) (*UpdateSecurityRuleResponse, error)
+	// Address Translation APIs can be used to apply NATs and direct NATed traffic to a set of
+	// pre-defined gateways so that services such as internet access can be provided.
+	//
+	// Get the Ids of all the Address Translations
+	ListAddressTranslations(context.Context, *ListAddressTranslationsRequest) (*ListAddressTranslationsResponse, error)
+	// Create a new Address Translation and return the ID
+	CreateAddressTranslation(context.Context, *CreateAddressTranslationRequest) (*CreateAddressTranslationResponse, error)
+	// Get details of an Address Translation
+	GetAddressTranslation(context.Context, *GetAddressTranslationRequest) (*GetAddressTranslationResponse, error)
+	// Delete the Address Translation  with the given ID
+	DeleteAddressTranslation(context.Context, *DeleteAddressTranslationRequest) (*DeleteAddressTranslationResponse, error)
 	mustEmbedUnimplementedOvnnetServer()
 }
 
-// UnimplementedOvnnetServer must be embedded to have forward compatible implementations.
-type UnimplementedOvnnetServer struct {
-}
+// UnimplementedOvnnetServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOvnnetServer struct{}
 
 func (UnimplementedOvnnetServer) ListVPCs(context.Context, *ListVPCsRequest) (*ListVPCsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVPCs not implemented")
@@ -657,7 +823,23 @@ func (UnimplementedOvnnetServer) GetSecurityRule(context.Context, *GetSecurityRu
 func (UnimplementedOvnnetServer) DeleteSecurityRule(context.Context, *DeleteSecurityRuleRequest) (*DeleteSecurityRuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecurityRule not implemented")
 }
+func (UnimplementedOvnnetServer) UpdateSecurityRule(context.Context, *UpdateSecurityRuleRequest) (*UpdateSecurityRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecurityRule not implemented")
+}
+func (UnimplementedOvnnetServer) ListAddressTranslations(context.Context, *ListAddressTranslationsRequest) (*ListAddressTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddressTranslations not implemented")
+}
+func (UnimplementedOvnnetServer) CreateAddressTranslation(context.Context, *CreateAddressTranslationRequest) (*CreateAddressTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAddressTranslation not implemented")
+}
+func (UnimplementedOvnnetServer) GetAddressTranslation(context.Context, *GetAddressTranslationRequest) (*GetAddressTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressTranslation not implemented")
+}
+func (UnimplementedOvnnetServer) DeleteAddressTranslation(context.Context, *DeleteAddressTranslationRequest) (*DeleteAddressTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddressTranslation not implemented")
+}
 func (UnimplementedOvnnetServer) mustEmbedUnimplementedOvnnetServer() {}
+func (UnimplementedOvnnetServer) testEmbeddedByValue()                {}
 
 // UnsafeOvnnetServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OvnnetServer will
@@ -667,6 +849,13 @@ type UnsafeOvnnetServer interface {
 }
 
 func RegisterOvnnetServer(s grpc.ServiceRegistrar, srv OvnnetServer) {
+	// If the following call pancis, it indicates UnimplementedOvnnetServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&Ovnnet_ServiceDesc, srv)
 }
 
@@ -680,7 +869,7 @@ func _Ovnnet_ListVPCs_Handler(srv interface{}, ctx context.Context, dec func(int
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/ListVPCs",
+		FullMethod: Ovnnet_ListVPCs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).ListVPCs(ctx, req.(*ListVPCsRequest))
@@ -698,7 +887,7 @@ func _Ovnnet_CreateVPC_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/CreateVPC",
+		FullMethod: Ovnnet_CreateVPC_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).CreateVPC(ctx, req.(*CreateVPCRequest))
@@ -716,7 +905,7 @@ func _Ovnnet_GetVPC_Handler(srv interface{}, ctx context.Context, dec func(inter
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/GetVPC",
+		FullMethod: Ovnnet_GetVPC_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).GetVPC(ctx, req.(*GetVPCRequest))
@@ -734,7 +923,7 @@ func _Ovnnet_DeleteVPC_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/DeleteVPC",
+		FullMethod: Ovnnet_DeleteVPC_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).DeleteVPC(ctx, req.(*DeleteVPCRequest))
@@ -752,7 +941,7 @@ func _Ovnnet_ListSubnets_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/ListSubnets",
+		FullMethod: Ovnnet_ListSubnets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).ListSubnets(ctx, req.(*ListSubnetsRequest))
@@ -770,7 +959,7 @@ func _Ovnnet_CreateSubnet_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/CreateSubnet",
+		FullMethod: Ovnnet_CreateSubnet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).CreateSubnet(ctx, req.(*CreateSubnetRequest))
@@ -788,7 +977,7 @@ func _Ovnnet_GetSubnet_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/GetSubnet",
+		FullMethod: Ovnnet_GetSubnet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).GetSubnet(ctx, req.(*GetSubnetRequest))
@@ -806,7 +995,7 @@ func _Ovnnet_DeleteSubnet_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/DeleteSubnet",
+		FullMethod: Ovnnet_DeleteSubnet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).DeleteSubnet(ctx, req.(*DeleteSubnetRequest))
@@ -824,7 +1013,7 @@ func _Ovnnet_ListRouters_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/ListRouters",
+		FullMethod: Ovnnet_ListRouters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).ListRouters(ctx, req.(*ListRoutersRequest))
@@ -842,7 +1031,7 @@ func _Ovnnet_CreateRouter_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/CreateRouter",
+		FullMethod: Ovnnet_CreateRouter_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).CreateRouter(ctx, req.(*CreateRouterRequest))
@@ -860,7 +1049,7 @@ func _Ovnnet_GetRouter_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/GetRouter",
+		FullMethod: Ovnnet_GetRouter_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).GetRouter(ctx, req.(*GetRouterRequest))
@@ -878,7 +1067,7 @@ func _Ovnnet_DeleteRouter_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/DeleteRouter",
+		FullMethod: Ovnnet_DeleteRouter_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).DeleteRouter(ctx, req.(*DeleteRouterRequest))
@@ -896,7 +1085,7 @@ func _Ovnnet_ListRouterInterfaces_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/ListRouterInterfaces",
+		FullMethod: Ovnnet_ListRouterInterfaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).ListRouterInterfaces(ctx, req.(*ListRouterInterfacesRequest))
@@ -914,7 +1103,7 @@ func _Ovnnet_CreateRouterInterface_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/CreateRouterInterface",
+		FullMethod: Ovnnet_CreateRouterInterface_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).CreateRouterInterface(ctx, req.(*CreateRouterInterfaceRequest))
@@ -932,7 +1121,7 @@ func _Ovnnet_GetRouterInterface_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/GetRouterInterface",
+		FullMethod: Ovnnet_GetRouterInterface_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).GetRouterInterface(ctx, req.(*GetRouterInterfaceRequest))
@@ -950,7 +1139,7 @@ func _Ovnnet_DeleteRouterInterface_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/DeleteRouterInterface",
+		FullMethod: Ovnnet_DeleteRouterInterface_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).DeleteRouterInterface(ctx, req.(*DeleteRouterInterfaceRequest))
@@ -968,7 +1157,7 @@ func _Ovnnet_ListStaticRoutes_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/ListStaticRoutes",
+		FullMethod: Ovnnet_ListStaticRoutes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).ListStaticRoutes(ctx, req.(*ListStaticRoutesRequest))
@@ -986,7 +1175,7 @@ func _Ovnnet_CreateStaticRoute_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/CreateStaticRoute",
+		FullMethod: Ovnnet_CreateStaticRoute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).CreateStaticRoute(ctx, req.(*CreateStaticRouteRequest))
@@ -1004,7 +1193,7 @@ func _Ovnnet_GetStaticRoute_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/GetStaticRoute",
+		FullMethod: Ovnnet_GetStaticRoute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).GetStaticRoute(ctx, req.(*GetStaticRouteRequest))
@@ -1022,7 +1211,7 @@ func _Ovnnet_DeleteStaticRoute_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/DeleteStaticRoute",
+		FullMethod: Ovnnet_DeleteStaticRoute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).DeleteStaticRoute(ctx, req.(*DeleteStaticRouteRequest))
@@ -1040,7 +1229,7 @@ func _Ovnnet_ListPorts_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/ListPorts",
+		FullMethod: Ovnnet_ListPorts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).ListPorts(ctx, req.(*ListPortsRequest))
@@ -1058,7 +1247,7 @@ func _Ovnnet_CreatePort_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/CreatePort",
+		FullMethod: Ovnnet_CreatePort_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).CreatePort(ctx, req.(*CreatePortRequest))
@@ -1076,7 +1265,7 @@ func _Ovnnet_GetPort_Handler(srv interface{}, ctx context.Context, dec func(inte
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/GetPort",
+		FullMethod: Ovnnet_GetPort_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).GetPort(ctx, req.(*GetPortRequest))
@@ -1094,7 +1283,7 @@ func _Ovnnet_UpdatePort_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/UpdatePort",
+		FullMethod: Ovnnet_UpdatePort_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).UpdatePort(ctx, req.(*UpdatePortRequest))
@@ -1112,7 +1301,7 @@ func _Ovnnet_DeletePort_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/DeletePort",
+		FullMethod: Ovnnet_DeletePort_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).DeletePort(ctx, req.(*DeletePortRequest))
@@ -1130,7 +1319,7 @@ func _Ovnnet_ListSecurityGroups_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/ListSecurityGroups",
+		FullMethod: Ovnnet_ListSecurityGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).ListSecurityGroups(ctx, req.(*ListSecurityGroupsRequest))
@@ -1148,7 +1337,7 @@ func _Ovnnet_CreateSecurityGroup_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/CreateSecurityGroup",
+		FullMethod: Ovnnet_CreateSecurityGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).CreateSecurityGroup(ctx, req.(*CreateSecurityGroupRequest))
@@ -1166,7 +1355,7 @@ func _Ovnnet_GetSecurityGroup_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/GetSecurityGroup",
+		FullMethod: Ovnnet_GetSecurityGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).GetSecurityGroup(ctx, req.(*GetSecurityGroupRequest))
@@ -1184,7 +1373,7 @@ func _Ovnnet_UpdateSecurityGroup_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/UpdateSecurityGroup",
+		FullMethod: Ovnnet_UpdateSecurityGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).UpdateSecurityGroup(ctx, req.(*UpdateSecurityGroupRequest))
@@ -1202,7 +1391,7 @@ func _Ovnnet_DeleteSecurityGroup_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/DeleteSecurityGroup",
+		FullMethod: Ovnnet_DeleteSecurityGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).DeleteSecurityGroup(ctx, req.(*DeleteSecurityGroupRequest))
@@ -1220,7 +1409,7 @@ func _Ovnnet_ListSecurityRules_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/ListSecurityRules",
+		FullMethod: Ovnnet_ListSecurityRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).ListSecurityRules(ctx, req.(*ListSecurityRulesRequest))
@@ -1238,7 +1427,7 @@ func _Ovnnet_CreateSecurityRule_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/CreateSecurityRule",
+		FullMethod: Ovnnet_CreateSecurityRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).CreateSecurityRule(ctx, req.(*CreateSecurityRuleRequest))
@@ -1256,7 +1445,7 @@ func _Ovnnet_GetSecurityRule_Handler(srv interface{}, ctx context.Context, dec f
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/GetSecurityRule",
+		FullMethod: Ovnnet_GetSecurityRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).GetSecurityRule(ctx, req.(*GetSecurityRuleRequest))
@@ -1274,7 +1463,7 @@ func _Ovnnet_DeleteSecurityRule_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sdn.v1.Ovnnet/DeleteSecurityRule",
+		FullMethod: Ovnnet_DeleteSecurityRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(OvnnetServer).DeleteSecurityRule(ctx, req.(*DeleteSecurityRuleRequest))
@@ -1282,6 +1471,96 @@ func _Ovnnet_DeleteSecurityRule_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ovnnet_UpdateSecurityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecurityRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvnnetServer).UpdateSecurityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ovnnet_UpdateSecurityRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvnnetServer).UpdateSecurityRule(ctx, req.(*UpdateSecurityRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ovnnet_ListAddressTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvnnetServer).ListAddressTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ovnnet_ListAddressTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvnnetServer).ListAddressTranslations(ctx, req.(*ListAddressTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ovnnet_CreateAddressTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAddressTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvnnetServer).CreateAddressTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ovnnet_CreateAddressTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvnnetServer).CreateAddressTranslation(ctx, req.(*CreateAddressTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ovnnet_GetAddressTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvnnetServer).GetAddressTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ovnnet_GetAddressTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvnnetServer).GetAddressTranslation(ctx, req.(*GetAddressTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ovnnet_DeleteAddressTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddressTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvnnetServer).DeleteAddressTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ovnnet_DeleteAddressTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvnnetServer).DeleteAddressTranslation(ctx, req.(*DeleteAddressTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Ovnnet_ServiceDesc is the grpc.ServiceDesc for Ovnnet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1425,6 +1704,26 @@ var Ovnnet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSecurityRule",
 			Handler:    _Ovnnet_DeleteSecurityRule_Handler,
 		},
+		{
+			MethodName: "UpdateSecurityRule",
+			Handler:    _Ovnnet_UpdateSecurityRule_Handler,
+		},
+		{
+			MethodName: "ListAddressTranslations",
+			Handler:    _Ovnnet_ListAddressTranslations_Handler,
+		},
+		{
+			MethodName: "CreateAddressTranslation",
+			Handler:    _Ovnnet_CreateAddressTranslation_Handler,
+		},
+		{
+			MethodName: "GetAddressTranslation",
+			Handler:    _Ovnnet_GetAddressTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteAddressTranslation",
+			Handler:    _Ovnnet_DeleteAddressTranslation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/sdn/v1/ovnnet.proto",
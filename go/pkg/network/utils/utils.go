@@ -20,9 +20,14 @@ const (
 	maxNetworkPrefixLen  = 28
 	IPv4LinkLocalAddress = "169.254.0.0/16"
 	subnetNameMaxLength  = 63
+
+	minIPv6NetworkPrefixLen = 44
+	maxIPv6NetworkPrefixLen = 64
+	IPv6LinkLocalAddress    = "fe80::/10"
 )
 
 var _, IPv4LinkLocal, _ = net.ParseCIDR(IPv4LinkLocalAddress)
+var _, IPv6LinkLocal, _ = net.ParseCIDR(IPv6LinkLocalAddress)
 
 var subnetNamePattern = regexp.MustCompile("^[a-zA-Z0-9_\\-.]+$")
 
@@ -61,6 +66,39 @@ func ValidateCIDR(cidrBlock string) error {
 	return nil
 }
 
+// The IPv6 CIDR field is optional for VPCs and subnets. When provided, the VPC or subnet is dual-stack.
+// It specifies an IPv6 network in the format: "x:x:x:x::/mask".
+// Restrictions:
+// - Must be a valid IPv6 address without host bits.
+// - Mask length must be between 44 and 64 (inclusive).
+// - Must not overlap with the link-local range (fe80::/10)
+// See: https://www.rfc-editor.org/rfc/rfc4291
+func ValidateIPv6CIDR(cidrBlock string) error {
+	ip, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return fmt.Errorf("Invalid IPv6 CIDR: %v", err)
+	}
+
+	if ip.To4() != nil {
+		return fmt.Errorf("Invalid IPv6 CIDR: should be in IPv6 format")
+	}
+
+	if !ip.Equal(ipNet.IP) {
+		return fmt.Errorf("Invalid IPv6 CIDR: has non-zero host bits: %v", cidrBlock)
+	}
+
+	numOfOnes, _ := ipNet.Mask.Size()
+	if numOfOnes < minIPv6NetworkPrefixLen || numOfOnes > maxIPv6NetworkPrefixLen {
+		return fmt.Errorf("Invalid IPv6 CIDR: netmask must be between %d and %d, got: %v", minIPv6NetworkPrefixLen, maxIPv6NetworkPrefixLen, cidrBlock)
+	}
+
+	if IPv6LinkLocal.Contains(ipNet.IP) {
+		return fmt.Errorf("Invalid IPv6 CIDR: overlap with local link address: %v", cidrBlock)
+	}
+
+	return nil
+}
+
 // Subnet name is not mandatory.
 // It cant be just spaces.
 // It can't contain leading or trailing spaces.
@@ -123,6 +161,10 @@ func IsCIDRWithinCIDR(parentCIDR, childCIDR string) (bool, error) {
 		return false, fmt.Errorf("failed to parse childCIDR: %v", err)
 	}
 
+	if parentIPNet.IP.To4() == nil || childIPNet.IP.To4() == nil {
+		return isIPv6CIDRWithinCIDR(parentIPNet, childIPNet), nil
+	}
+
 	parentFirstIP, err := ipToInt(parentIPNet.IP)
 	if err != nil {
 		return false, fmt.Errorf("failed to transform ip to int. %v ip: %v", err, parentIPNet.IP)
@@ -146,6 +188,70 @@ func IsCIDRWithinCIDR(parentCIDR, childCIDR string) (bool, error) {
 	return parentFirstIP <= childFirstIP && parentLastIP >= childLastIP, nil
 }
 
+// Returns true if the two CIDR blocks have at least one address in common. false otherwise.
+func IsCIDROverlap(cidr1, cidr2 string) (bool, error) {
+	_, ipNet1, err := net.ParseCIDR(cidr1)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse CIDR: %v", err)
+	}
+
+	_, ipNet2, err := net.ParseCIDR(cidr2)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse CIDR: %v", err)
+	}
+
+	if ipNet1.IP.To4() == nil || ipNet2.IP.To4() == nil {
+		return ipNet1.Contains(ipNet2.IP) || ipNet2.Contains(ipNet1.IP), nil
+	}
+
+	return isCIDROverlap(ipNet1, ipNet2)
+}
+
+// CIDR blocks are either nested or disjoint, so the child is within the parent if the parent contains its first address
+// and has a shorter or equal prefix. Addresses of different families are never within each other.
+func isIPv6CIDRWithinCIDR(parentIPNet, childIPNet *net.IPNet) bool {
+	parentOnes, parentBits := parentIPNet.Mask.Size()
+	childOnes, childBits := childIPNet.Mask.Size()
+	return parentBits == childBits && parentOnes <= childOnes && parentIPNet.Contains(childIPNet.IP)
+}
+
+// Returns the first usable address of an IPv4 CIDR block (e.g. 10.0.0.1 for 10.0.0.0/24).
+// This address is used as the gateway of a subnet.
+func FirstUsableIP(cidrBlock string) (net.IP, error) {
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CIDR: %v", err)
+	}
+	first, err := ipToInt(ipNet.IP)
+	if err != nil {
+		return nil, err
+	}
+	return intToIP(first + 1), nil
+}
+
+// Returns the last usable address of an IPv4 CIDR block (e.g. 10.0.0.254 for 10.0.0.0/24).
+func LastUsableIP(cidrBlock string) (net.IP, error) {
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CIDR: %v", err)
+	}
+	last, err := ipToInt(lastIP(ipNet))
+	if err != nil {
+		return nil, err
+	}
+	return intToIP(last - 1), nil
+}
+
+// Returns the address with the prefix length of the CIDR block (e.g. 10.0.0.1/24).
+func AddressWithPrefixLength(ip net.IP, cidrBlock string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse CIDR: %v", err)
+	}
+	prefixLength, _ := ipNet.Mask.Size()
+	return fmt.Sprintf("%s/%d", ip, prefixLength), nil
+}
+
 func ipToInt(ip net.IP) (uint32, error) {
 	ip4 := ip.To4()
 	if ip4 == nil {
@@ -154,6 +260,12 @@ func ipToInt(ip net.IP) (uint32, error) {
 	return binary.BigEndian.Uint32(ip4), nil
 }
 
+func intToIP(i uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, i)
+	return ip
+}
+
 func lastIP(network *net.IPNet) net.IP {
 	ip := network.IP
 	mask := network.Mask
@@ -189,3 +301,23 @@ func OverlapsExistingCIDRs(ctx context.Context, db *sql.Tx, subnet *pb.SubnetPri
 
 	return count > 0, nil
 }
+
+func OverlapsExistingIPv6CIDRs(ctx context.Context, db *sql.Tx, subnet *pb.SubnetPrivate) (bool, error) {
+	query := `
+		select count(*)
+		from   subnet
+		where  cloud_account_id = $1
+		  and  value->'spec'->>'vpcId' = $2
+		  and  nullif(value->'spec'->>'ipv6CidrBlock', '')::inet && $3::inet
+		  and  deleted_timestamp = $4
+	`
+
+	var count int
+
+	err := db.QueryRowContext(ctx, query, subnet.Metadata.CloudAccountId, subnet.Spec.VpcId, subnet.Spec.Ipv6CidrBlock, common.TimestampInfinityStr).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %v", err)
+	}
+
+	return count > 0, nil
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
@@ -21,6 +22,16 @@ const (
 	AWS_ACCOUNTID_EXPRESSION = `(?m)^aws_account_id\s*=\s*(.*)$`
 )
 
+const (
+	WEBHOOK_TIMEOUT_DEFAULT                   = 10 * time.Second
+	WEBHOOK_MAX_ATTEMPTS_DEFAULT              = 5
+	WEBHOOK_INITIAL_BACKOFF_DEFAULT           = 10 * time.Second
+	WEBHOOK_MAX_BACKOFF_DEFAULT               = 10 * time.Minute
+	WEBHOOK_DISABLE_AFTER_FAILURES_DEFAULT    = 10
+	WEBHOOK_MAX_SUBSCRIPTIONS_DEFAULT         = 10
+	WEBHOOK_DELIVERY_RETENTION_PERIOD_DEFAULT = 30 * 24 * time.Hour
+)
+
 type Config struct {
 	ListenPort    uint16           `koanf:"listenPort"`
 	Database      manageddb.Config `koanf:"database"`
@@ -40,6 +51,25 @@ type Config struct {
 			} `koanf:"sns"`
 		} `koanf:"aws"`
 	} `koanf:"notifications"`
+	Webhooks WebhookConfig `koanf:"webhooks"`
+}
+
+type WebhookConfig struct {
+	Enabled bool `koanf:"enabled"`
+	// timeout of a single delivery attempt
+	Timeout time.Duration `koanf:"timeout"`
+	// number of attempts to deliver an event before it is dropped
+	MaxAttempts int `koanf:"maxAttempts"`
+	// the backoff between attempts doubles after each attempt, up to MaxBackoff
+	InitialBackoff time.Duration `koanf:"initialBackoff"`
+	MaxBackoff     time.Duration `koanf:"maxBackoff"`
+	// a webhook is disabled when this many events in a row could not be delivered
+	DisableAfterFailures            int `koanf:"disableAfterFailures"`
+	MaxSubscriptionsPerCloudAccount int `koanf:"maxSubscriptionsPerCloudAccount"`
+	// delivery attempts older than this are deleted
+	DeliveryRetentionPeriod time.Duration `koanf:"deliveryRetentionPeriod"`
+	// allow http urls and private addresses, only for development
+	AllowInsecureUrls bool `koanf:"allowInsecureUrls"`
 }
 
 var Cfg *Config
@@ -168,3 +198,60 @@ func (config *Config) GetAWSAccountIdFile() string {
 func (config *Config) SetAWSAccountId(accountId string) {
 	config.Notifications.AWS.AccountId = accountId
 }
+
+func (config *Config) GetWebhooksEnabled() bool {
+	return config.Webhooks.Enabled
+}
+
+func (config *Config) GetWebhookTimeout() time.Duration {
+	if config.Webhooks.Timeout <= 0 {
+		return WEBHOOK_TIMEOUT_DEFAULT
+	}
+	return config.Webhooks.Timeout
+}
+
+func (config *Config) GetWebhookMaxAttempts() int {
+	if config.Webhooks.MaxAttempts <= 0 {
+		return WEBHOOK_MAX_ATTEMPTS_DEFAULT
+	}
+	return config.Webhooks.MaxAttempts
+}
+
+func (config *Config) GetWebhookInitialBackoff() time.Duration {
+	if config.Webhooks.InitialBackoff <= 0 {
+		return WEBHOOK_INITIAL_BACKOFF_DEFAULT
+	}
+	return config.Webhooks.InitialBackoff
+}
+
+func (config *Config) GetWebhookMaxBackoff() time.Duration {
+	if config.Webhooks.MaxBackoff <= 0 {
+		return WEBHOOK_MAX_BACKOFF_DEFAULT
+	}
+	return config.Webhooks.MaxBackoff
+}
+
+func (config *Config) GetWebhookDisableAfterFailures() int {
+	if config.Webhooks.DisableAfterFailures <= 0 {
+		return WEBHOOK_DISABLE_AFTER_FAILURES_DEFAULT
+	}
+	return config.Webhooks.DisableAfterFailures
+}
+
+func (config *Config) GetWebhookMaxSubscriptions() int {
+	if config.Webhooks.MaxSubscriptionsPerCloudAccount <= 0 {
+		return WEBHOOK_MAX_SUBSCRIPTIONS_DEFAULT
+	}
+	return config.Webhooks.MaxSubscriptionsPerCloudAccount
+}
+
+func (config *Config) GetWebhookDeliveryRetentionPeriod() time.Duration {
+	if config.Webhooks.DeliveryRetentionPeriod <= 0 {
+		return WEBHOOK_DELIVERY_RETENTION_PERIOD_DEFAULT
+	}
+	return config.Webhooks.DeliveryRetentionPeriod
+}
+
+func (config *Config) GetWebhookAllowInsecureUrls() bool {
+	return config.Webhooks.AllowInsecureUrls
+}
@@ -4,6 +4,7 @@ package event
 
 import (
 	"context"
+	"time"
 
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
@@ -12,11 +13,12 @@ import (
 
 type EmailNotificationService struct {
 	pb.UnimplementedEmailNotificationServiceServer
-	sesUtil *sesutil.SESUtil
+	sesUtil         *sesutil.SESUtil
+	eventDispatcher *EventDispatcher
 }
 
-func NewEmailNotificationService(sesUtil *sesutil.SESUtil) *EmailNotificationService {
-	return &EmailNotificationService{sesUtil: sesUtil}
+func NewEmailNotificationService(sesUtil *sesutil.SESUtil, eventDispatcher *EventDispatcher) *EmailNotificationService {
+	return &EmailNotificationService{sesUtil: sesUtil, eventDispatcher: eventDispatcher}
 }
 
 func (ens *EmailNotificationService) SendUserEmail(ctx context.Context, emailRequest *pb.EmailRequest) (*pb.EmailResponse, error) {
@@ -24,6 +26,9 @@ func (ens *EmailNotificationService) SendUserEmail(ctx context.Context, emailReq
 	defer span.End()
 	logger.Info("send email api invoked", "templateName", emailRequest.TemplateName, "templateData", emailRequest.TemplateData)
 	messageId, err := ens.sesUtil.SendEmail(ctx, emailRequest.Recipient, emailRequest.Sender, emailRequest.TemplateName, emailRequest.TemplateData)
+	if emailRequest.CloudAccountId != nil {
+		ens.dispatchWebhooks(ctx, emailRequest)
+	}
 	if err != nil {
 		logger.Error(err, "failed to send email")
 		return &pb.EmailResponse{MessageId: messageId, Success: false}, err
@@ -42,3 +47,26 @@ func (ens *EmailNotificationService) SendEmail(ctx context.Context, emailRequest
 	}
 	return &pb.EmailResponse{MessageId: messageId, Success: true}, nil
 }
+
+// dispatchWebhooks delivers the message as an event to the webhooks of the cloud account.
+// Only the event properties are delivered, as template data may contain secrets such as invitation codes.
+func (ens *EmailNotificationService) dispatchWebhooks(ctx context.Context, emailRequest *pb.EmailRequest) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("EmailNotificationService.dispatchWebhooks").WithValues("cloudAccountId", emailRequest.GetCloudAccountId()).Start()
+	defer span.End()
+	eventId, err := NewId()
+	if err != nil {
+		logger.Error(err, "failed to generate id")
+		return
+	}
+	if err := ens.eventDispatcher.dispatchWebhooks(ctx, WebhookEvent{
+		Id:             eventId,
+		Type:           EventType_EMAIL,
+		Name:           emailRequest.MessageType,
+		CloudAccountId: emailRequest.GetCloudAccountId(),
+		ServiceName:    emailRequest.ServiceName,
+		Properties:     emailRequest.EventProperties,
+		Creation:       time.Now(),
+	}); err != nil {
+		logger.Error(err, "failed to dispatch webhooks")
+	}
+}
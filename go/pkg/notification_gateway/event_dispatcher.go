@@ -4,12 +4,26 @@ package event
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
+	"time"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/notification_gateway/config"
 )
 
-const fixNumberOfRetrials = 1
+const (
+	fixNumberOfRetrials = 1
+	// Maximum length of the error stored with a webhook delivery attempt.
+	maxWebhookDeliveryErrorLength = 1024
+	webhookDisabledReason         = "disabled after repeated delivery failures"
+	// Interval between checks for webhook deliveries which are due.
+	webhookDeliveryPollInterval = 5 * time.Second
+	// Maximum number of webhook deliveries claimed at once.
+	webhookDeliveryBatchSize = 100
+	// Time added to the webhook timeout before a claimed delivery may be attempted by another replica.
+	webhookDeliveryClaimMargin = time.Minute
+)
 
 type ServiceEventDispatcher struct {
 	serviceName string
@@ -20,12 +34,89 @@ type ServiceEventDispatcher struct {
 
 var serviceEventTypeMap = sync.Map{}
 
+// webhookStore is the part of WebhookData used to deliver events.
+type webhookStore interface {
+	getEnabledSubscriptions(ctx context.Context, cloudAccountId string) ([]*WebhookSubscription, error)
+	storeDelivery(ctx context.Context, delivery WebhookDelivery) error
+	recordDeliveryResult(ctx context.Context, subscriptionId string, success bool, disableAfterFailures int, disabledReason string) (bool, error)
+	enqueueDeliveries(ctx context.Context, deliveries []*pendingWebhookDelivery, nextAttempt time.Time) error
+	claimPendingDeliveries(ctx context.Context, now time.Time, claimedUntil time.Time, limit int) ([]*pendingWebhookDelivery, error)
+	reschedulePendingDelivery(ctx context.Context, id int64, attempts int, nextAttempt time.Time) error
+	deletePendingDelivery(ctx context.Context, id int64) error
+}
+
+type webhookPolicy struct {
+	maxAttempts          int
+	initialBackoff       time.Duration
+	maxBackoff           time.Duration
+	disableAfterFailures int
+	// how long a delivery attempt may take before another replica attempts it again
+	claimDuration time.Duration
+}
+
+// pendingWebhookDelivery is an event which is not delivered to a webhook yet.
+type pendingWebhookDelivery struct {
+	id           int64
+	subscription *WebhookSubscription
+	eventId      string
+	eventType    string
+	payload      []byte
+	// number of failed attempts so far
+	attempts int
+}
+
 type EventDispatcher struct {
 	//eventApiSubscriber *EventApiSubscriber
+	webhookStore  webhookStore
+	webhookSender *WebhookSender
+	webhookPolicy webhookPolicy
+	now           func() time.Time
+	// wakes up the webhook delivery loop when events were dispatched
+	webhookWake chan struct{}
 }
 
 func NewEventDispatcher( /**eventPoll *EventApiSubscriber**/ ) *EventDispatcher {
-	return &EventDispatcher{}
+	return &EventDispatcher{now: time.Now, webhookWake: make(chan struct{}, 1)}
+}
+
+// EnableWebhooks delivers the events of a cloud account to its webhook subscriptions.
+// Events are not delivered to webhooks unless this is called.
+func (ed *EventDispatcher) EnableWebhooks(cfg *config.Config, webhookData *WebhookData, webhookSender *WebhookSender) {
+	ed.webhookStore = webhookData
+	ed.webhookSender = webhookSender
+	ed.webhookPolicy = webhookPolicy{
+		maxAttempts:          cfg.GetWebhookMaxAttempts(),
+		initialBackoff:       cfg.GetWebhookInitialBackoff(),
+		maxBackoff:           cfg.GetWebhookMaxBackoff(),
+		disableAfterFailures: cfg.GetWebhookDisableAfterFailures(),
+		claimDuration:        cfg.GetWebhookTimeout() + webhookDeliveryClaimMargin,
+	}
+}
+
+// StartWebhookDeliveries delivers the pending webhook deliveries in the background. Deliveries are stored in the
+// database, so they are resumed after a restart, and each one is attempted by a single replica at a time.
+func (ed *EventDispatcher) StartWebhookDeliveries(ctx context.Context) {
+	go ed.webhookDeliveryLoop(context.WithoutCancel(ctx))
+}
+
+func (ed *EventDispatcher) webhookDeliveryLoop(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("EventDispatcher.webhookDeliveryLoop")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	ticker := time.NewTicker(webhookDeliveryPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := ed.deliverPendingWebhooks(ctx); err != nil {
+			logger.Error(err, "failed to deliver pending webhooks")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ed.webhookWake:
+		case <-ticker.C:
+		}
+	}
 }
 
 func (ed *EventDispatcher) dispatchNotification(ctx context.Context, notification Notification) error {
@@ -33,7 +124,18 @@ func (ed *EventDispatcher) dispatchNotification(ctx context.Context, notificatio
 	logger.V(9).Info("BEGIN")
 	defer logger.V(9).Info("END")
 	//ed.eventApiSubscriber.sendNotification(ctx, notification)
-	return nil
+	return ed.dispatchWebhooks(ctx, WebhookEvent{
+		Id:             notification.Id,
+		Type:           EventType_NOTIFICATION,
+		SubType:        notification.NotificationType,
+		CloudAccountId: notification.CloudAccountId,
+		ServiceName:    notification.ServiceName,
+		Status:         notification.Status,
+		Severity:       notification.Severity,
+		Message:        notification.Message,
+		Properties:     notification.Properties,
+		Creation:       notification.Creation,
+	})
 }
 
 func (ed *EventDispatcher) retryDispatchNotification(ctx context.Context, notification Notification) error {
@@ -54,7 +156,18 @@ func (ed *EventDispatcher) dispatchAlert(ctx context.Context, alert Alert) error
 	logger.V(9).Info("BEGIN")
 	defer logger.V(9).Info("END")
 	//ed.eventApiSubscriber.sendAlert(ctx, alert)
-	return nil
+	return ed.dispatchWebhooks(ctx, WebhookEvent{
+		Id:             alert.Id,
+		Type:           EventType_ALERT,
+		SubType:        alert.AlertType,
+		CloudAccountId: alert.CloudAccountId,
+		ServiceName:    alert.ServiceName,
+		Status:         alert.Status,
+		Severity:       alert.Severity,
+		Message:        alert.Message,
+		Properties:     alert.Properties,
+		Creation:       alert.Creation,
+	})
 }
 
 func (ed *EventDispatcher) dispatchError(ctx context.Context, error Error) error {
@@ -62,7 +175,19 @@ func (ed *EventDispatcher) dispatchError(ctx context.Context, error Error) error
 	logger.V(9).Info("BEGIN")
 	defer logger.V(9).Info("END")
 	//ed.eventApiSubscriber.sendAlert(ctx, error)
-	return nil
+	return ed.dispatchWebhooks(ctx, WebhookEvent{
+		Id:             error.Id,
+		Type:           EventType_ERROR,
+		SubType:        error.ErrorType,
+		CloudAccountId: error.CloudAccountId,
+		ServiceName:    error.ServiceName,
+		Status:         error.Status,
+		Severity:       error.Severity,
+		Message:        error.Message,
+		Region:         error.Region,
+		Properties:     error.Properties,
+		Creation:       error.Creation,
+	})
 }
 
 func (ed *EventDispatcher) dispatchEmail(ctx context.Context, email Email) error {
@@ -89,3 +214,147 @@ func (ed *EventDispatcher) retryDispatchAlert(ctx context.Context, alert Alert)
 func (ed *EventDispatcher) addDispatcher(ctx context.Context, serviceName string, eventSubTypeReceiverRegistration []*RegisterEventSubTypeReceiver) {
 
 }
+
+// dispatchWebhooks stores a delivery of the event for each enabled webhook of its cloud account that subscribed to
+// it. The deliveries are attempted in the background, so that failing webhooks do not delay or fail the creation of
+// events.
+func (ed *EventDispatcher) dispatchWebhooks(ctx context.Context, event WebhookEvent) error {
+	logger := log.FromContext(ctx).WithName("EventDispatcher.dispatchWebhooks").WithValues("cloudAccountId", event.CloudAccountId, "eventId", event.Id)
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+	if ed.webhookStore == nil || event.CloudAccountId == "" {
+		return nil
+	}
+	subscriptions, err := ed.webhookStore.getEnabledSubscriptions(ctx, event.CloudAccountId)
+	if err != nil {
+		// The event was stored already, so it is not failed because of webhooks.
+		logger.Error(err, "failed to get webhook subscriptions")
+		return nil
+	}
+	var payload []byte
+	var deliveries []*pendingWebhookDelivery
+	for _, subscription := range subscriptions {
+		if !subscription.matches(event) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				logger.Error(err, "failed to marshal webhook event")
+				return nil
+			}
+		}
+		deliveries = append(deliveries, &pendingWebhookDelivery{
+			subscription: subscription,
+			eventId:      event.Id,
+			eventType:    event.EventType(),
+			payload:      payload,
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := ed.webhookStore.enqueueDeliveries(ctx, deliveries, ed.now()); err != nil {
+		logger.Error(err, "failed to store webhook deliveries")
+		return nil
+	}
+	select {
+	case ed.webhookWake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// deliverPendingWebhooks attempts the webhook deliveries which are due.
+func (ed *EventDispatcher) deliverPendingWebhooks(ctx context.Context) error {
+	for {
+		now := ed.now()
+		deliveries, err := ed.webhookStore.claimPendingDeliveries(ctx, now, now.Add(ed.webhookPolicy.claimDuration), webhookDeliveryBatchSize)
+		if err != nil {
+			return err
+		}
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func(delivery *pendingWebhookDelivery) {
+				defer wg.Done()
+				ed.deliverWebhook(ctx, delivery)
+			}(delivery)
+		}
+		wg.Wait()
+		if len(deliveries) < webhookDeliveryBatchSize {
+			return nil
+		}
+	}
+}
+
+// deliverWebhook attempts to deliver an event to a webhook. Failed deliveries are attempted again with an exponential
+// backoff. Each attempt is logged. The webhook is disabled if too many events in a row could not be delivered.
+func (ed *EventDispatcher) deliverWebhook(ctx context.Context, pending *pendingWebhookDelivery) {
+	subscription := pending.subscription
+	logger := log.FromContext(ctx).WithName("EventDispatcher.deliverWebhook").WithValues("subscriptionId", subscription.Id, "eventId", pending.eventId)
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	if !subscription.Enabled {
+		// The webhook was disabled after the event was dispatched.
+		if err := ed.webhookStore.deletePendingDelivery(ctx, pending.id); err != nil {
+			logger.Error(err, "failed to delete webhook delivery")
+		}
+		return
+	}
+
+	attempt := pending.attempts + 1
+	statusCode, err := ed.webhookSender.send(ctx, subscription, pending.eventId, pending.payload)
+	delivery := WebhookDelivery{
+		SubscriptionId: subscription.Id,
+		EventId:        pending.eventId,
+		EventType:      pending.eventType,
+		Attempt:        int32(attempt),
+		Success:        err == nil,
+		StatusCode:     int32(statusCode),
+		Creation:       ed.now(),
+	}
+	if err != nil {
+		delivery.Error = err.Error()
+		if len(delivery.Error) > maxWebhookDeliveryErrorLength {
+			delivery.Error = delivery.Error[:maxWebhookDeliveryErrorLength]
+		}
+		logger.Info("webhook delivery failed", "attempt", attempt, "statusCode", statusCode, "error", delivery.Error)
+	}
+	if err := ed.webhookStore.storeDelivery(ctx, delivery); err != nil {
+		logger.Error(err, "failed to store webhook delivery")
+	}
+
+	if !delivery.Success && attempt < ed.webhookPolicy.maxAttempts {
+		nextAttempt := ed.now().Add(webhookBackoff(attempt, ed.webhookPolicy.initialBackoff, ed.webhookPolicy.maxBackoff))
+		if err := ed.webhookStore.reschedulePendingDelivery(ctx, pending.id, attempt, nextAttempt); err != nil {
+			logger.Error(err, "failed to reschedule webhook delivery")
+		}
+		return
+	}
+	if err := ed.webhookStore.deletePendingDelivery(ctx, pending.id); err != nil {
+		// The delivery is attempted again once its claim expired, its result is recorded then.
+		logger.Error(err, "failed to delete webhook delivery")
+		return
+	}
+	enabled, err := ed.webhookStore.recordDeliveryResult(ctx, subscription.Id, delivery.Success, ed.webhookPolicy.disableAfterFailures, webhookDisabledReason)
+	if err != nil {
+		logger.Error(err, "failed to record webhook delivery result")
+		return
+	}
+	if !delivery.Success && !enabled {
+		logger.Info("webhook disabled after repeated delivery failures")
+	}
+}
+
+// webhookBackoff returns the time to wait before the given retry. It doubles with each retry, up to maxBackoff.
+func webhookBackoff(retry int, initialBackoff time.Duration, maxBackoff time.Duration) time.Duration {
+	backoff := initialBackoff
+	for i := 1; i < retry; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff || backoff <= 0 {
+			return maxBackoff
+		}
+	}
+	return min(backoff, maxBackoff)
+}
@@ -82,3 +82,26 @@ func (eventExpiryScheduler *EventExpiryScheduler) handleEventExpiry(ctx context.
 	}
 	return nil
 }
+
+// Interval between removals of expired webhook delivery attempts.
+const webhookDeliveryExpiryInterval = time.Hour
+
+func StartWebhookDeliveryExpiryScheduler(ctx context.Context, webhookData *WebhookData, retentionPeriod time.Duration) {
+	go webhookDeliveryExpiryLoop(context.WithoutCancel(ctx), webhookData, retentionPeriod)
+}
+
+func webhookDeliveryExpiryLoop(ctx context.Context, webhookData *WebhookData, retentionPeriod time.Duration) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("webhookDeliveryExpiryLoop").Start()
+	defer span.End()
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	ticker := time.NewTicker(webhookDeliveryExpiryInterval)
+	defer ticker.Stop()
+	for {
+		if err := webhookData.deleteDeliveries(ctx, time.Now().Add(-retentionPeriod)); err != nil {
+			logger.Error(err, "failed to delete expired webhook deliveries")
+		}
+		<-ticker.C
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/notification_gateway/config"
@@ -15,9 +16,10 @@ import (
 )
 
 type EventHandler struct {
-	eventData      *EventData
-	eventPublisher *EventPublisher
-	eventReceiver  *EventReceiver
+	eventData       *EventData
+	eventPublisher  *EventPublisher
+	eventReceiver   *EventReceiver
+	eventDispatcher *EventDispatcher
 }
 
 type Message struct {
@@ -29,11 +31,12 @@ type DeleteMessageError struct {
 	MessageError string `json:"messageError"`
 }
 
-func NewEventHandler(eventData *EventData, eventPublisher *EventPublisher, eventReceiver *EventReceiver) *EventHandler {
+func NewEventHandler(eventData *EventData, eventPublisher *EventPublisher, eventReceiver *EventReceiver, eventDispatcher *EventDispatcher) *EventHandler {
 	return &EventHandler{
-		eventData:      eventData,
-		eventPublisher: eventPublisher,
-		eventReceiver:  eventReceiver,
+		eventData:       eventData,
+		eventPublisher:  eventPublisher,
+		eventReceiver:   eventReceiver,
+		eventDispatcher: eventDispatcher,
 	}
 }
 
@@ -129,7 +132,20 @@ func (eh *EventHandler) Create(ctx context.Context, cloudAccountId string, creat
 		logger.Error(err, "failed to store cloud credits event")
 		return err
 	}
-	return nil
+	return eh.eventDispatcher.dispatchWebhooks(ctx, WebhookEvent{
+		Id:             eventBase.Id,
+		Type:           strings.ToLower(createEvent.GetType().String()),
+		SubType:        createEvent.GetEventSubType(),
+		Name:           createEvent.GetEventName(),
+		CloudAccountId: cloudAccountId,
+		ServiceName:    strings.ToLower(cloudCreditsEvent.ServiceName),
+		Status:         strings.ToLower(eventBase.Status),
+		Severity:       strings.ToLower(eventBase.Severity),
+		Message:        cloudCreditsEvent.Message,
+		Region:         createEvent.GetRegion(),
+		Properties:     eventBase.Properties,
+		Creation:       eventBase.Creation,
+	})
 
 }
 
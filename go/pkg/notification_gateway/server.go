@@ -55,19 +55,27 @@ func (svc *Service) Init(ctx context.Context, cfg *config.Config, resolver grpcu
 		logger.Error(err, "couldn't init ses client")
 	}
 	eventDispatcher := NewEventDispatcher()
+	webhookData := NewWebhookData(svc.Sql)
+	if cfg.GetWebhooksEnabled() {
+		eventDispatcher.EnableWebhooks(cfg, webhookData, NewWebhookSender(cfg.GetWebhookTimeout(), cfg.GetWebhookAllowInsecureUrls()))
+		eventDispatcher.StartWebhookDeliveries(ctx)
+		StartWebhookDeliveryExpiryScheduler(ctx, webhookData, cfg.GetWebhookDeliveryRetentionPeriod())
+	}
 	eventData := NewEventData(svc.Sql)
 	eventManager := NewEventManager(eventData, eventDispatcher)
 	eventPublisher := NewEventPublisher(&snsUtil)
 	eventReceiver := NewEventReceiver(&snsUtil, &sqsUtil)
-	eventHandler := NewEventHandler(eventData, eventPublisher, eventReceiver)
+	eventHandler := NewEventHandler(eventData, eventPublisher, eventReceiver, eventDispatcher)
 	notificationGwService := NewNotificationGatewayService(eventManager, eventHandler)
 	pb.RegisterNotificationGatewayServiceServer(grpcServer, notificationGwService)
+	webhookService := NewWebhookSubscriptionService(webhookData, cfg)
+	pb.RegisterWebhookSubscriptionServiceServer(grpcServer, webhookService)
 	sesUtil := sesutil.SESUtil{}
 	//TODO: IAM changes
 	if err := sesUtil.Init(ctx, awsSESRegion, awsCredentialsFile); err != nil {
 		logger.Error(err, "couldn't init ses client")
 	}
-	emailService := NewEmailNotificationService(&sesUtil)
+	emailService := NewEmailNotificationService(&sesUtil, eventDispatcher)
 	pb.RegisterEmailNotificationServiceServer(grpcServer, emailService)
 	reflection.Register(grpcServer)
 	return nil
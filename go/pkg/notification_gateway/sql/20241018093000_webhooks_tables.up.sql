@@ -0,0 +1,39 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+--------------------------------------------------------------------------------
+-- for webhook subscriptions
+--------------------------------------------------------------------------------
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id VARCHAR(12) NOT NULL PRIMARY KEY,
+    cloud_account_id VARCHAR(12) NOT NULL,
+    url TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    event_types jsonb NOT NULL DEFAULT '[]',
+    secret VARCHAR(128) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    disabled_reason TEXT NOT NULL DEFAULT '',
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    creation TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS webhook_subscriptions_cloud_account_id_idx ON webhook_subscriptions (cloud_account_id);
+
+--------------------------------------------------------------------------------
+-- for webhook delivery attempts
+--------------------------------------------------------------------------------
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id VARCHAR(12) NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    attempt INTEGER NOT NULL,
+    success BOOLEAN NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    creation TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_subscription_id_idx ON webhook_deliveries (subscription_id, creation);
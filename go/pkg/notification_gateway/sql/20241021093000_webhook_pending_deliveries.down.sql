@@ -0,0 +1,3 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+DROP TABLE IF EXISTS webhook_pending_deliveries;
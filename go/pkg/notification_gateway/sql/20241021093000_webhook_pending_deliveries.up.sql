@@ -0,0 +1,20 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+--------------------------------------------------------------------------------
+-- for webhook deliveries which are not done yet, they are retried until they
+-- succeed or run out of attempts.
+--------------------------------------------------------------------------------
+
+CREATE TABLE IF NOT EXISTS webhook_pending_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id VARCHAR(12) NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMP NOT NULL,
+    claimed_until TIMESTAMP,
+    creation TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS webhook_pending_deliveries_next_attempt_idx ON webhook_pending_deliveries (next_attempt);
@@ -0,0 +1,219 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// Headers sent with each webhook delivery.
+	WebhookEventIdHeader   = "X-IDC-Event-Id"
+	WebhookTimestampHeader = "X-IDC-Timestamp"
+	WebhookSignatureHeader = "X-IDC-Signature"
+
+	webhookSignaturePrefix = "sha256="
+	webhookSecretPrefix    = "whsec_"
+	webhookUserAgent       = "IDC-Webhooks/1.0"
+	// Maximum number of bytes read from a webhook response.
+	maxWebhookResponseBytes = 64 * 1024
+)
+
+type WebhookSubscription struct {
+	Id                  string
+	CloudAccountId      string
+	Url                 string
+	Description         string
+	EventTypes          []string
+	Secret              string
+	Enabled             bool
+	DisabledReason      string
+	ConsecutiveFailures int32
+	Creation            time.Time
+	Updated             time.Time
+}
+
+type WebhookDelivery struct {
+	Id             int64
+	SubscriptionId string
+	EventId        string
+	EventType      string
+	Attempt        int32
+	Success        bool
+	StatusCode     int32
+	Error          string
+	Creation       time.Time
+}
+
+// WebhookEvent is the JSON payload delivered to webhooks.
+type WebhookEvent struct {
+	Id             string            `json:"id"`
+	Type           string            `json:"type"`
+	SubType        string            `json:"subType,omitempty"`
+	Name           string            `json:"name,omitempty"`
+	CloudAccountId string            `json:"cloudAccountId"`
+	ServiceName    string            `json:"serviceName,omitempty"`
+	Status         string            `json:"status,omitempty"`
+	Severity       string            `json:"severity,omitempty"`
+	Message        string            `json:"message,omitempty"`
+	Region         string            `json:"region,omitempty"`
+	Properties     map[string]string `json:"properties,omitempty"`
+	Creation       time.Time         `json:"creation"`
+}
+
+// EventType returns the most specific type of the event.
+func (event WebhookEvent) EventType() string {
+	if event.Name != "" {
+		return event.Name
+	}
+	if event.SubType != "" {
+		return event.SubType
+	}
+	return event.Type
+}
+
+// matches returns true if the event should be delivered to the subscription.
+func (subscription *WebhookSubscription) matches(event WebhookEvent) bool {
+	if len(subscription.EventTypes) == 0 {
+		return true
+	}
+	for _, eventType := range subscription.EventTypes {
+		for _, value := range []string{event.Name, event.SubType, event.Type} {
+			if value != "" && strings.EqualFold(eventType, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SignWebhookPayload returns the value of the signature header of a payload sent at the given unix time.
+// The signature is the hex encoded HMAC-SHA256 of "<timestamp>.<payload>" keyed with the subscription secret.
+// Including the timestamp lets receivers reject replayed deliveries.
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + hex.EncodeToString(secret), nil
+}
+
+// validateWebhookUrl checks that events can be delivered to the url.
+func validateWebhookUrl(rawUrl string, allowInsecure bool) error {
+	webhookUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if webhookUrl.Scheme != "https" && !(allowInsecure && webhookUrl.Scheme == "http") {
+		return errors.New("url must use https")
+	}
+	if webhookUrl.User != nil {
+		return errors.New("url must not contain credentials")
+	}
+	host := webhookUrl.Hostname()
+	if host == "" {
+		return errors.New("url must contain a host")
+	}
+	if allowInsecure {
+		return nil
+	}
+	if strings.EqualFold(host, "localhost") {
+		return errors.New("url must not refer to a private address")
+	}
+	if ip := net.ParseIP(host); ip != nil && isPrivateAddress(ip) {
+		return errors.New("url must not refer to a private address")
+	}
+	return nil
+}
+
+func isPrivateAddress(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast()
+}
+
+// WebhookSender posts signed events to webhooks.
+type WebhookSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewWebhookSender returns a sender that refuses to connect to private addresses unless allowInsecureUrls is set.
+// The check is done when connecting, so that host names resolving to private addresses are rejected too.
+func NewWebhookSender(timeout time.Duration, allowInsecureUrls bool) *WebhookSender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowInsecureUrls {
+		dialer.Control = func(network string, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateAddress(ip) {
+				return fmt.Errorf("connection to private address %s is not allowed", host)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &WebhookSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			// Redirects are not followed, as they could point to a private address.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// send posts the payload to the webhook and returns the status code of the response.
+// Any status code other than 2xx is an error.
+func (ws *WebhookSender) send(ctx context.Context, subscription *WebhookSubscription, eventId string, payload []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := ws.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(WebhookEventIdHeader, eventId)
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(subscription.Secret, timestamp, payload))
+
+	resp, err := ws.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxWebhookResponseBytes))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,448 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package event
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+)
+
+const (
+	webhookSubscriptionColumns = `id, cloud_account_id, url, description, event_types, secret, enabled, disabled_reason, consecutive_failures, creation, updated`
+
+	insertWebhookSubscriptionQuery = `
+		INSERT INTO webhook_subscriptions (id, cloud_account_id, url, description, event_types, secret, enabled, disabled_reason, consecutive_failures, creation, updated)
+		VALUES ($1, $2, $3, $4, $5, $6, TRUE, '', 0, $7, $7)
+	`
+
+	getWebhookSubscriptionQuery = `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions WHERE cloud_account_id = $1 AND id = $2
+	`
+
+	searchWebhookSubscriptionsQuery = `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions WHERE cloud_account_id = $1 ORDER BY creation
+	`
+
+	searchEnabledWebhookSubscriptionsQuery = `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions WHERE cloud_account_id = $1 AND enabled ORDER BY creation
+	`
+
+	countWebhookSubscriptionsQuery = `
+		SELECT COUNT(*) FROM webhook_subscriptions WHERE cloud_account_id = $1
+	`
+
+	updateWebhookSubscriptionQuery = `
+		UPDATE webhook_subscriptions
+		SET url = $3, description = $4, event_types = $5, secret = $6, enabled = $7, disabled_reason = $8, consecutive_failures = $9, updated = $10
+		WHERE cloud_account_id = $1 AND id = $2
+	`
+
+	deleteWebhookSubscriptionQuery = `
+		DELETE FROM webhook_subscriptions WHERE cloud_account_id = $1 AND id = $2
+	`
+
+	resetWebhookFailuresQuery = `
+		UPDATE webhook_subscriptions SET consecutive_failures = 0 WHERE id = $1 AND consecutive_failures > 0
+	`
+
+	// disables the subscription when the number of consecutive failures reaches $2.
+	recordWebhookFailureQuery = `
+		UPDATE webhook_subscriptions
+		SET consecutive_failures = consecutive_failures + 1,
+			disabled_reason = CASE WHEN enabled AND consecutive_failures + 1 >= $2 THEN $3 ELSE disabled_reason END,
+			enabled = enabled AND consecutive_failures + 1 < $2,
+			updated = CASE WHEN enabled AND consecutive_failures + 1 >= $2 THEN $4 ELSE updated END
+		WHERE id = $1
+		RETURNING enabled
+	`
+
+	insertWebhookDeliveryQuery = `
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, attempt, success, status_code, error, creation)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	searchWebhookDeliveriesQuery = `
+		SELECT id, subscription_id, event_id, event_type, attempt, success, status_code, error, creation
+		FROM webhook_deliveries WHERE subscription_id = $1 ORDER BY creation DESC, id DESC LIMIT $2
+	`
+
+	deleteWebhookDeliveriesQuery = `
+		DELETE FROM webhook_deliveries WHERE creation < $1
+	`
+
+	insertWebhookPendingDeliveryQuery = `
+		INSERT INTO webhook_pending_deliveries (subscription_id, event_id, event_type, payload, attempts, next_attempt, creation)
+		VALUES ($1, $2, $3, $4, 0, $5, $5)
+	`
+
+	// claims the deliveries due at $1 until $2, deliveries claimed by another replica are skipped until their claim expired.
+	claimWebhookPendingDeliveriesQuery = `
+		WITH claimed AS (
+			UPDATE webhook_pending_deliveries SET claimed_until = $2
+			WHERE id IN (
+				SELECT id FROM webhook_pending_deliveries
+				WHERE next_attempt <= $1 AND (claimed_until IS NULL OR claimed_until < $1)
+				ORDER BY next_attempt
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, subscription_id, event_id, event_type, payload, attempts
+		)
+		SELECT claimed.id, claimed.event_id, claimed.event_type, claimed.payload, claimed.attempts,
+			s.id, s.cloud_account_id, s.url, s.description, s.event_types, s.secret, s.enabled, s.disabled_reason, s.consecutive_failures, s.creation, s.updated
+		FROM claimed JOIN webhook_subscriptions s ON s.id = claimed.subscription_id
+	`
+
+	rescheduleWebhookPendingDeliveryQuery = `
+		UPDATE webhook_pending_deliveries SET attempts = $2, next_attempt = $3, claimed_until = NULL WHERE id = $1
+	`
+
+	deleteWebhookPendingDeliveryQuery = `
+		DELETE FROM webhook_pending_deliveries WHERE id = $1
+	`
+)
+
+type WebhookData struct {
+	db *sql.DB
+}
+
+func NewWebhookData(db *sql.DB) *WebhookData {
+	return &WebhookData{db: db}
+}
+
+func (wd *WebhookData) createSubscription(ctx context.Context, subscription *WebhookSubscription) error {
+	logger := log.FromContext(ctx).WithName("WebhookData.createSubscription")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	eventTypes, err := json.Marshal(subscription.EventTypes)
+	if err != nil {
+		return err
+	}
+	if _, err := wd.db.ExecContext(ctx,
+		insertWebhookSubscriptionQuery,
+		subscription.Id,
+		subscription.CloudAccountId,
+		subscription.Url,
+		subscription.Description,
+		eventTypes,
+		subscription.Secret,
+		subscription.Creation,
+	); err != nil {
+		logger.Error(err, "failed to store webhook subscription")
+		return err
+	}
+	return nil
+}
+
+// getSubscription returns nil if the subscription does not exist.
+func (wd *WebhookData) getSubscription(ctx context.Context, cloudAccountId string, id string) (*WebhookSubscription, error) {
+	logger := log.FromContext(ctx).WithName("WebhookData.getSubscription")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	subscription, err := scanWebhookSubscription(wd.db.QueryRowContext(ctx, getWebhookSubscriptionQuery, cloudAccountId, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		logger.Error(err, "failed to read webhook subscription", "id", id)
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (wd *WebhookData) searchSubscriptions(ctx context.Context, cloudAccountId string) ([]*WebhookSubscription, error) {
+	return wd.querySubscriptions(ctx, searchWebhookSubscriptionsQuery, cloudAccountId)
+}
+
+func (wd *WebhookData) getEnabledSubscriptions(ctx context.Context, cloudAccountId string) ([]*WebhookSubscription, error) {
+	return wd.querySubscriptions(ctx, searchEnabledWebhookSubscriptionsQuery, cloudAccountId)
+}
+
+func (wd *WebhookData) querySubscriptions(ctx context.Context, query string, cloudAccountId string) ([]*WebhookSubscription, error) {
+	logger := log.FromContext(ctx).WithName("WebhookData.querySubscriptions")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	rows, err := wd.db.QueryContext(ctx, query, cloudAccountId)
+	if err != nil {
+		logger.Error(err, "failed to find webhook subscriptions")
+		return nil, err
+	}
+	defer rows.Close()
+	subscriptions := []*WebhookSubscription{}
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			logger.Error(err, "failed to read webhook subscription")
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (wd *WebhookData) countSubscriptions(ctx context.Context, cloudAccountId string) (int, error) {
+	var count int
+	if err := wd.db.QueryRowContext(ctx, countWebhookSubscriptionsQuery, cloudAccountId).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// updateSubscription returns false if the subscription does not exist.
+func (wd *WebhookData) updateSubscription(ctx context.Context, subscription *WebhookSubscription) (bool, error) {
+	logger := log.FromContext(ctx).WithName("WebhookData.updateSubscription")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	eventTypes, err := json.Marshal(subscription.EventTypes)
+	if err != nil {
+		return false, err
+	}
+	result, err := wd.db.ExecContext(ctx,
+		updateWebhookSubscriptionQuery,
+		subscription.CloudAccountId,
+		subscription.Id,
+		subscription.Url,
+		subscription.Description,
+		eventTypes,
+		subscription.Secret,
+		subscription.Enabled,
+		subscription.DisabledReason,
+		subscription.ConsecutiveFailures,
+		subscription.Updated,
+	)
+	if err != nil {
+		logger.Error(err, "failed to update webhook subscription", "id", subscription.Id)
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
+
+// deleteSubscription returns false if the subscription does not exist. Its delivery attempts are deleted too.
+func (wd *WebhookData) deleteSubscription(ctx context.Context, cloudAccountId string, id string) (bool, error) {
+	logger := log.FromContext(ctx).WithName("WebhookData.deleteSubscription")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	result, err := wd.db.ExecContext(ctx, deleteWebhookSubscriptionQuery, cloudAccountId, id)
+	if err != nil {
+		logger.Error(err, "failed to delete webhook subscription", "id", id)
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
+
+// recordDeliveryResult updates the failure count of a subscription after an event was delivered or given up on.
+// The subscription is disabled when disableAfterFailures events in a row failed. It returns true if the subscription
+// is still enabled.
+func (wd *WebhookData) recordDeliveryResult(ctx context.Context, subscriptionId string, success bool, disableAfterFailures int, disabledReason string) (bool, error) {
+	logger := log.FromContext(ctx).WithName("WebhookData.recordDeliveryResult")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	if success {
+		_, err := wd.db.ExecContext(ctx, resetWebhookFailuresQuery, subscriptionId)
+		return true, err
+	}
+	var enabled bool
+	err := wd.db.QueryRowContext(ctx, recordWebhookFailureQuery, subscriptionId, disableAfterFailures, disabledReason, time.Now()).Scan(&enabled)
+	if err == sql.ErrNoRows {
+		// The subscription was deleted while the event was delivered.
+		return false, nil
+	}
+	if err != nil {
+		logger.Error(err, "failed to record webhook failure", "subscriptionId", subscriptionId)
+		return false, err
+	}
+	return enabled, nil
+}
+
+func (wd *WebhookData) storeDelivery(ctx context.Context, delivery WebhookDelivery) error {
+	logger := log.FromContext(ctx).WithName("WebhookData.storeDelivery")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	if _, err := wd.db.ExecContext(ctx,
+		insertWebhookDeliveryQuery,
+		delivery.SubscriptionId,
+		delivery.EventId,
+		delivery.EventType,
+		delivery.Attempt,
+		delivery.Success,
+		delivery.StatusCode,
+		delivery.Error,
+		delivery.Creation,
+	); err != nil {
+		logger.Error(err, "failed to store webhook delivery", "subscriptionId", delivery.SubscriptionId)
+		return err
+	}
+	return nil
+}
+
+func (wd *WebhookData) searchDeliveries(ctx context.Context, subscriptionId string, limit int32) ([]*WebhookDelivery, error) {
+	logger := log.FromContext(ctx).WithName("WebhookData.searchDeliveries")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	rows, err := wd.db.QueryContext(ctx, searchWebhookDeliveriesQuery, subscriptionId, limit)
+	if err != nil {
+		logger.Error(err, "failed to find webhook deliveries", "subscriptionId", subscriptionId)
+		return nil, err
+	}
+	defer rows.Close()
+	deliveries := []*WebhookDelivery{}
+	for rows.Next() {
+		delivery := WebhookDelivery{}
+		if err := rows.Scan(&delivery.Id, &delivery.SubscriptionId, &delivery.EventId, &delivery.EventType, &delivery.Attempt,
+			&delivery.Success, &delivery.StatusCode, &delivery.Error, &delivery.Creation); err != nil {
+			logger.Error(err, "failed to read webhook delivery", "subscriptionId", subscriptionId)
+			return nil, err
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// deleteDeliveries deletes the delivery attempts made before the given time.
+func (wd *WebhookData) deleteDeliveries(ctx context.Context, before time.Time) error {
+	logger := log.FromContext(ctx).WithName("WebhookData.deleteDeliveries")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	if _, err := wd.db.ExecContext(ctx, deleteWebhookDeliveriesQuery, before); err != nil {
+		logger.Error(err, "failed to delete webhook deliveries")
+		return err
+	}
+	return nil
+}
+
+// enqueueDeliveries stores the deliveries of an event, they are first attempted at nextAttempt.
+func (wd *WebhookData) enqueueDeliveries(ctx context.Context, deliveries []*pendingWebhookDelivery, nextAttempt time.Time) error {
+	logger := log.FromContext(ctx).WithName("WebhookData.enqueueDeliveries")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	tx, err := wd.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, delivery := range deliveries {
+		if _, err := tx.ExecContext(ctx,
+			insertWebhookPendingDeliveryQuery,
+			delivery.subscription.Id,
+			delivery.eventId,
+			delivery.eventType,
+			string(delivery.payload),
+			nextAttempt,
+		); err != nil {
+			logger.Error(err, "failed to store pending webhook delivery", "subscriptionId", delivery.subscription.Id)
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// claimPendingDeliveries returns up to limit deliveries which are due at now. They are not returned again until
+// claimedUntil, unless they are rescheduled.
+func (wd *WebhookData) claimPendingDeliveries(ctx context.Context, now time.Time, claimedUntil time.Time, limit int) ([]*pendingWebhookDelivery, error) {
+	logger := log.FromContext(ctx).WithName("WebhookData.claimPendingDeliveries")
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	rows, err := wd.db.QueryContext(ctx, claimWebhookPendingDeliveriesQuery, now, claimedUntil, limit)
+	if err != nil {
+		logger.Error(err, "failed to claim pending webhook deliveries")
+		return nil, err
+	}
+	defer rows.Close()
+	deliveries := []*pendingWebhookDelivery{}
+	for rows.Next() {
+		delivery := pendingWebhookDelivery{}
+		payload := ""
+		subscription, err := scanWebhookSubscription(prefixScanner{row: rows, dest: []any{&delivery.id, &delivery.eventId,
+			&delivery.eventType, &payload, &delivery.attempts}})
+		if err != nil {
+			logger.Error(err, "failed to read pending webhook delivery")
+			return nil, err
+		}
+		delivery.subscription = subscription
+		delivery.payload = []byte(payload)
+		deliveries = append(deliveries, &delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// reschedulePendingDelivery releases the claim of a delivery, it is attempted again at nextAttempt.
+func (wd *WebhookData) reschedulePendingDelivery(ctx context.Context, id int64, attempts int, nextAttempt time.Time) error {
+	if _, err := wd.db.ExecContext(ctx, rescheduleWebhookPendingDeliveryQuery, id, attempts, nextAttempt); err != nil {
+		log.FromContext(ctx).WithName("WebhookData.reschedulePendingDelivery").Error(err, "failed to reschedule pending webhook delivery", "id", id)
+		return err
+	}
+	return nil
+}
+
+func (wd *WebhookData) deletePendingDelivery(ctx context.Context, id int64) error {
+	if _, err := wd.db.ExecContext(ctx, deleteWebhookPendingDeliveryQuery, id); err != nil {
+		log.FromContext(ctx).WithName("WebhookData.deletePendingDelivery").Error(err, "failed to delete pending webhook delivery", "id", id)
+		return err
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// prefixScanner scans the columns selected before those of a webhook subscription into dest.
+type prefixScanner struct {
+	row  rowScanner
+	dest []any
+}
+
+func (s prefixScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(s.dest, dest...)...)
+}
+
+func scanWebhookSubscription(row rowScanner) (*WebhookSubscription, error) {
+	subscription := WebhookSubscription{}
+	eventTypes := []byte{}
+	if err := row.Scan(&subscription.Id, &subscription.CloudAccountId, &subscription.Url, &subscription.Description,
+		&eventTypes, &subscription.Secret, &subscription.Enabled, &subscription.DisabledReason,
+		&subscription.ConsecutiveFailures, &subscription.Creation, &subscription.Updated); err != nil {
+		return nil, err
+	}
+	if len(eventTypes) > 0 {
+		if err := json.Unmarshal(eventTypes, &subscription.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+	return &subscription, nil
+}
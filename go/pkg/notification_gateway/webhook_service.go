@@ -0,0 +1,275 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package event
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/notification_gateway/config"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxWebhookUrlLength         = 2048
+	maxWebhookDescriptionLength = 256
+	maxWebhookEventTypes        = 32
+	maxWebhookEventTypeLength   = 64
+	defaultWebhookDeliveryLimit = 100
+	maxWebhookDeliveryLimit     = 1000
+)
+
+type WebhookSubscriptionService struct {
+	webhookData *WebhookData
+	cfg         *config.Config
+
+	pb.UnimplementedWebhookSubscriptionServiceServer
+}
+
+func NewWebhookSubscriptionService(webhookData *WebhookData, cfg *config.Config) *WebhookSubscriptionService {
+	return &WebhookSubscriptionService{webhookData: webhookData, cfg: cfg}
+}
+
+func (svc *WebhookSubscriptionService) Create(ctx context.Context, in *pb.WebhookSubscriptionCreateRequest) (*pb.WebhookSubscription, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("WebhookSubscriptionService.Create").WithValues("cloudAccountId", in.GetCloudAccountId()).Start()
+	defer span.End()
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	if in.GetCloudAccountId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing cloudAccountId")
+	}
+	if err := svc.validate(in.GetUrl(), in.GetDescription(), in.GetEventTypes()); err != nil {
+		return nil, err
+	}
+	count, err := svc.webhookData.countSubscriptions(ctx, in.CloudAccountId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+	if count >= svc.cfg.GetWebhookMaxSubscriptions() {
+		return nil, status.Errorf(codes.ResourceExhausted, "a cloud account can have at most %d webhooks", svc.cfg.GetWebhookMaxSubscriptions())
+	}
+
+	id, err := NewId()
+	if err != nil {
+		logger.Error(err, "failed to generate id")
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		logger.Error(err, "failed to generate secret")
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+	now := time.Now()
+	subscription := &WebhookSubscription{
+		Id:             id,
+		CloudAccountId: in.CloudAccountId,
+		Url:            in.Url,
+		Description:    in.Description,
+		EventTypes:     in.EventTypes,
+		Secret:         secret,
+		Enabled:        true,
+		Creation:       now,
+		Updated:        now,
+	}
+	if err := svc.webhookData.createSubscription(ctx, subscription); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+	return webhookSubscriptionToPb(subscription, true), nil
+}
+
+func (svc *WebhookSubscriptionService) Get(ctx context.Context, in *pb.WebhookSubscriptionReference) (*pb.WebhookSubscription, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("WebhookSubscriptionService.Get").WithValues("cloudAccountId", in.GetCloudAccountId(), "id", in.GetId()).Start()
+	defer span.End()
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	subscription, err := svc.getSubscription(ctx, in.GetCloudAccountId(), in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return webhookSubscriptionToPb(subscription, false), nil
+}
+
+func (svc *WebhookSubscriptionService) Search(ctx context.Context, in *pb.WebhookSubscriptionSearchRequest) (*pb.WebhookSubscriptionSearchResponse, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("WebhookSubscriptionService.Search").WithValues("cloudAccountId", in.GetCloudAccountId()).Start()
+	defer span.End()
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	if in.GetCloudAccountId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing cloudAccountId")
+	}
+	subscriptions, err := svc.webhookData.searchSubscriptions(ctx, in.CloudAccountId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search webhooks")
+	}
+	resp := &pb.WebhookSubscriptionSearchResponse{}
+	for _, subscription := range subscriptions {
+		resp.Items = append(resp.Items, webhookSubscriptionToPb(subscription, false))
+	}
+	return resp, nil
+}
+
+func (svc *WebhookSubscriptionService) Update(ctx context.Context, in *pb.WebhookSubscriptionUpdateRequest) (*pb.WebhookSubscription, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("WebhookSubscriptionService.Update").WithValues("cloudAccountId", in.GetCloudAccountId(), "id", in.GetId()).Start()
+	defer span.End()
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	subscription, err := svc.getSubscription(ctx, in.GetCloudAccountId(), in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if in.Url != nil {
+		subscription.Url = in.GetUrl()
+	}
+	if in.Description != nil {
+		subscription.Description = in.GetDescription()
+	}
+	if in.EventTypes != nil {
+		subscription.EventTypes = in.EventTypes.GetEventTypes()
+	}
+	if err := svc.validate(subscription.Url, subscription.Description, subscription.EventTypes); err != nil {
+		return nil, err
+	}
+	if in.Enabled != nil {
+		if in.GetEnabled() && !subscription.Enabled {
+			subscription.ConsecutiveFailures = 0
+			subscription.DisabledReason = ""
+		}
+		subscription.Enabled = in.GetEnabled()
+	}
+	if in.RotateSecret {
+		if subscription.Secret, err = newWebhookSecret(); err != nil {
+			logger.Error(err, "failed to generate secret")
+			return nil, status.Error(codes.Internal, "failed to update webhook")
+		}
+	}
+	subscription.Updated = time.Now()
+	found, err := svc.webhookData.updateSubscription(ctx, subscription)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update webhook")
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "webhook %s not found", in.Id)
+	}
+	return webhookSubscriptionToPb(subscription, in.RotateSecret), nil
+}
+
+func (svc *WebhookSubscriptionService) Delete(ctx context.Context, in *pb.WebhookSubscriptionReference) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("WebhookSubscriptionService.Delete").WithValues("cloudAccountId", in.GetCloudAccountId(), "id", in.GetId()).Start()
+	defer span.End()
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	if in.GetCloudAccountId() == "" || in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing cloudAccountId or id")
+	}
+	found, err := svc.webhookData.deleteSubscription(ctx, in.CloudAccountId, in.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete webhook")
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "webhook %s not found", in.Id)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (svc *WebhookSubscriptionService) SearchDeliveries(ctx context.Context, in *pb.WebhookDeliverySearchRequest) (*pb.WebhookDeliverySearchResponse, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("WebhookSubscriptionService.SearchDeliveries").WithValues("cloudAccountId", in.GetCloudAccountId(), "id", in.GetId()).Start()
+	defer span.End()
+	logger.V(9).Info("BEGIN")
+	defer logger.V(9).Info("END")
+
+	// Checks that the webhook belongs to the cloud account.
+	if _, err := svc.getSubscription(ctx, in.GetCloudAccountId(), in.GetId()); err != nil {
+		return nil, err
+	}
+	limit := in.GetLimit()
+	if limit <= 0 {
+		limit = defaultWebhookDeliveryLimit
+	}
+	if limit > maxWebhookDeliveryLimit {
+		limit = maxWebhookDeliveryLimit
+	}
+	deliveries, err := svc.webhookData.searchDeliveries(ctx, in.Id, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search webhook deliveries")
+	}
+	resp := &pb.WebhookDeliverySearchResponse{}
+	for _, delivery := range deliveries {
+		resp.Items = append(resp.Items, &pb.WebhookDelivery{
+			Id:             strconv.FormatInt(delivery.Id, 10),
+			SubscriptionId: delivery.SubscriptionId,
+			EventId:        delivery.EventId,
+			EventType:      delivery.EventType,
+			Attempt:        delivery.Attempt,
+			Success:        delivery.Success,
+			StatusCode:     delivery.StatusCode,
+			Error:          delivery.Error,
+			Timestamp:      timestamppb.New(delivery.Creation),
+		})
+	}
+	return resp, nil
+}
+
+func (svc *WebhookSubscriptionService) getSubscription(ctx context.Context, cloudAccountId string, id string) (*WebhookSubscription, error) {
+	if cloudAccountId == "" || id == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing cloudAccountId or id")
+	}
+	subscription, err := svc.webhookData.getSubscription(ctx, cloudAccountId, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get webhook")
+	}
+	if subscription == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook %s not found", id)
+	}
+	return subscription, nil
+}
+
+func (svc *WebhookSubscriptionService) validate(url string, description string, eventTypes []string) error {
+	if len(url) > maxWebhookUrlLength {
+		return status.Errorf(codes.InvalidArgument, "url must not be longer than %d characters", maxWebhookUrlLength)
+	}
+	if err := validateWebhookUrl(url, svc.cfg.GetWebhookAllowInsecureUrls()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(description) > maxWebhookDescriptionLength {
+		return status.Errorf(codes.InvalidArgument, "description must not be longer than %d characters", maxWebhookDescriptionLength)
+	}
+	if len(eventTypes) > maxWebhookEventTypes {
+		return status.Errorf(codes.InvalidArgument, "at most %d event types are allowed", maxWebhookEventTypes)
+	}
+	for _, eventType := range eventTypes {
+		if eventType == "" || len(eventType) > maxWebhookEventTypeLength {
+			return status.Errorf(codes.InvalidArgument, "event types must have between 1 and %d characters", maxWebhookEventTypeLength)
+		}
+	}
+	return nil
+}
+
+func webhookSubscriptionToPb(subscription *WebhookSubscription, includeSecret bool) *pb.WebhookSubscription {
+	resp := &pb.WebhookSubscription{
+		Id:                  subscription.Id,
+		CloudAccountId:      subscription.CloudAccountId,
+		Url:                 subscription.Url,
+		Description:         subscription.Description,
+		EventTypes:          subscription.EventTypes,
+		Enabled:             subscription.Enabled,
+		DisabledReason:      subscription.DisabledReason,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreationTimestamp:   timestamppb.New(subscription.Creation),
+		UpdateTimestamp:     timestamppb.New(subscription.Updated),
+	}
+	if includeSecret {
+		resp.Secret = subscription.Secret
+	}
+	return resp
+}
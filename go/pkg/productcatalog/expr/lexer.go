@@ -0,0 +1,194 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package expr
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenEq
+	tokenNe
+	tokenLt
+	tokenLe
+	tokenGt
+	tokenGe
+	tokenPlus
+	tokenMinus
+	tokenMul
+	tokenDiv
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+)
+
+var tokenNames = map[tokenKind]string{
+	tokenEOF:      "end of expression",
+	tokenIdent:    "identifier",
+	tokenString:   "string",
+	tokenNumber:   "number",
+	tokenAnd:      "&&",
+	tokenOr:       "||",
+	tokenNot:      "!",
+	tokenEq:       "==",
+	tokenNe:       "!=",
+	tokenLt:       "<",
+	tokenLe:       "<=",
+	tokenGt:       ">",
+	tokenGe:       ">=",
+	tokenPlus:     "+",
+	tokenMinus:    "-",
+	tokenMul:      "*",
+	tokenDiv:      "/",
+	tokenLParen:   "(",
+	tokenRParen:   ")",
+	tokenLBracket: "[",
+	tokenRBracket: "]",
+	tokenComma:    ",",
+}
+
+func (kind tokenKind) String() string {
+	return tokenNames[kind]
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// Match expressions compare properties with unquoted values such as vm-spr-sml, hence '-' is part of a
+// word there. Usage expressions are arithmetic and '-' is always the minus operator.
+func tokenize(src string, hyphenInWords bool) ([]token, error) {
+	var tokens []token
+	runes := []rune(src)
+	for pos := 0; pos < len(runes); {
+		r := runes[pos]
+		switch {
+		case unicode.IsSpace(r):
+			pos++
+		case r == '"' || r == '\'':
+			text, next, err := scanString(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: pos})
+			pos = next
+		case isWordRune(r, false):
+			start := pos
+			for pos < len(runes) && isWordRune(runes[pos], hyphenInWords) {
+				pos++
+			}
+			text := string(runes[start:pos])
+			kind := tokenIdent
+			if isNumber(text) {
+				kind = tokenNumber
+			} else if unicode.IsDigit(r) && !hyphenInWords {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start)
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: start})
+		default:
+			kind, width, err := scanOperator(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: kind, text: string(runes[pos : pos+width]), pos: pos})
+			pos += width
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(runes)})
+	return tokens, nil
+}
+
+func scanString(runes []rune, pos int) (string, int, error) {
+	quote := runes[pos]
+	var sb strings.Builder
+	for i := pos + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 == len(runes) {
+				return "", 0, fmt.Errorf("unterminated string at position %d", pos)
+			}
+			i++
+			sb.WriteRune(runes[i])
+		case quote:
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string at position %d", pos)
+}
+
+func scanOperator(runes []rune, pos int) (tokenKind, int, error) {
+	next := rune(0)
+	if pos+1 < len(runes) {
+		next = runes[pos+1]
+	}
+	switch runes[pos] {
+	case '&':
+		if next == '&' {
+			return tokenAnd, 2, nil
+		}
+	case '|':
+		if next == '|' {
+			return tokenOr, 2, nil
+		}
+	case '=':
+		if next == '=' {
+			return tokenEq, 2, nil
+		}
+	case '!':
+		if next == '=' {
+			return tokenNe, 2, nil
+		}
+		return tokenNot, 1, nil
+	case '<':
+		if next == '=' {
+			return tokenLe, 2, nil
+		}
+		return tokenLt, 1, nil
+	case '>':
+		if next == '=' {
+			return tokenGe, 2, nil
+		}
+		return tokenGt, 1, nil
+	case '+':
+		return tokenPlus, 1, nil
+	// catalog entries are often written with an en dash
+	case '-', '–':
+		return tokenMinus, 1, nil
+	case '*':
+		return tokenMul, 1, nil
+	case '/':
+		return tokenDiv, 1, nil
+	case '(':
+		return tokenLParen, 1, nil
+	case ')':
+		return tokenRParen, 1, nil
+	case '[':
+		return tokenLBracket, 1, nil
+	case ']':
+		return tokenRBracket, 1, nil
+	case ',':
+		return tokenComma, 1, nil
+	}
+	return 0, 0, fmt.Errorf("unexpected character %q at position %d", runes[pos], pos)
+}
+
+func isWordRune(r rune, hyphen bool) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || (hyphen && r == '-')
+}
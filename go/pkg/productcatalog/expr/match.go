@@ -0,0 +1,363 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package expr
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// MatchExpr is a parsed product matchExpr, it selects the metering records billed with a product.
+//
+// The grammar is
+//
+//	expr       := and ('||' and)*
+//	and        := unary ('&&' unary)*
+//	unary      := '!' unary | '(' expr ')' | comparison | property
+//	comparison := property ('==' | '!=' | '<' | '<=' | '>' | '>=') value
+//	            | property ['not'] 'in' ('(' | '[') value (',' value)* (')' | ']')
+//	value      := "quoted" | 'quoted' | number | word
+//
+// Quoted values and words compare as strings, only numbers compare numerically so that version == "1.10"
+// does not match 1.1. The ordering comparisons require a number.
+// A property which is missing in the metering record equals "" and 0, it never satisfies the other
+// comparisons. A bare property must hold a boolean, a missing one is neither true nor false.
+type MatchExpr struct {
+	src  string
+	root matchNode
+}
+
+// ParseMatch parses and type checks a match expression.
+func ParseMatch(src string) (*MatchExpr, error) {
+	tokens, err := tokenize(src, true)
+	if err != nil {
+		return nil, fmt.Errorf("invalid match expression: %w", err)
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = p.unexpected()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid match expression: %w", err)
+	}
+	return &MatchExpr{src: src, root: root}, nil
+}
+
+// ValidateMatchExpr returns an error if src is not a valid match expression.
+func ValidateMatchExpr(src string) error {
+	_, err := ParseMatch(src)
+	return err
+}
+
+// Matches evaluates the expression with the properties of a metering record.
+func (m *MatchExpr) Matches(properties map[string]string) (bool, error) {
+	return m.root.eval(properties)
+}
+
+// Properties returns the sorted names of the properties referenced by the expression.
+func (m *MatchExpr) Properties() []string {
+	seen := map[string]bool{}
+	m.root.properties(seen)
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *MatchExpr) String() string {
+	return m.src
+}
+
+type matchNode interface {
+	eval(properties map[string]string) (bool, error)
+	properties(seen map[string]bool)
+}
+
+type orNode struct{ left, right matchNode }
+
+func (n *orNode) eval(properties map[string]string) (bool, error) {
+	left, err := n.left.eval(properties)
+	if err != nil || left {
+		return left, err
+	}
+	return n.right.eval(properties)
+}
+
+func (n *orNode) properties(seen map[string]bool) {
+	n.left.properties(seen)
+	n.right.properties(seen)
+}
+
+type andNode struct{ left, right matchNode }
+
+func (n *andNode) eval(properties map[string]string) (bool, error) {
+	left, err := n.left.eval(properties)
+	if err != nil || !left {
+		return false, err
+	}
+	return n.right.eval(properties)
+}
+
+func (n *andNode) properties(seen map[string]bool) {
+	n.left.properties(seen)
+	n.right.properties(seen)
+}
+
+type notNode struct{ operand matchNode }
+
+func (n *notNode) eval(properties map[string]string) (bool, error) {
+	// a missing boolean property is neither true nor false
+	if property, ok := n.operand.(*propertyNode); ok {
+		if _, found := properties[property.name]; !found {
+			return false, nil
+		}
+	}
+	result, err := n.operand.eval(properties)
+	return !result, err
+}
+
+func (n *notNode) properties(seen map[string]bool) {
+	n.operand.properties(seen)
+}
+
+type propertyNode struct{ name string }
+
+func (n *propertyNode) eval(properties map[string]string) (bool, error) {
+	value, found := properties[n.name]
+	if !found {
+		return false, nil
+	}
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("property %s is not a boolean: %q", n.name, value)
+	}
+	return result, nil
+}
+
+func (n *propertyNode) properties(seen map[string]bool) {
+	seen[n.name] = true
+}
+
+type literal struct {
+	text     string
+	number   float64
+	isNumber bool
+}
+
+// Unquoted numeric tokens compare as numbers.
+func newLiteral(text string) literal {
+	number, err := strconv.ParseFloat(text, 64)
+	return literal{text: text, number: number, isNumber: err == nil && isNumber(text)}
+}
+
+// Quoted strings and identifiers compare as strings, "1.10" does not equal 1.1.
+func newStringLiteral(text string) literal {
+	return literal{text: text}
+}
+
+func (l literal) isZero() bool {
+	return l.text == "" || (l.isNumber && l.number == 0)
+}
+
+// Two values are equal if they are the same string, or the same number if the literal is a number. "" also equals 0.
+func (l literal) equals(value string) bool {
+	if value == l.text {
+		return true
+	}
+	if numberValue, err := strconv.ParseFloat(value, 64); err == nil && isNumber(value) {
+		if l.text == "" {
+			return numberValue == 0
+		}
+		return l.isNumber && numberValue == l.number
+	}
+	return false
+}
+
+type comparisonNode struct {
+	name  string
+	op    tokenKind
+	value literal
+}
+
+func (n *comparisonNode) eval(properties map[string]string) (bool, error) {
+	value, found := properties[n.name]
+	switch n.op {
+	case tokenEq:
+		if !found {
+			return n.value.isZero(), nil
+		}
+		return n.value.equals(value), nil
+	case tokenNe:
+		return found && !n.value.equals(value), nil
+	}
+
+	if !found {
+		return false, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false, fmt.Errorf("property %s is not a number: %q", n.name, value)
+	}
+	switch n.op {
+	case tokenLt:
+		return number < n.value.number, nil
+	case tokenLe:
+		return number <= n.value.number, nil
+	case tokenGt:
+		return number > n.value.number, nil
+	default:
+		return number >= n.value.number, nil
+	}
+}
+
+func (n *comparisonNode) properties(seen map[string]bool) {
+	seen[n.name] = true
+}
+
+type inNode struct {
+	name   string
+	values []literal
+	negate bool
+}
+
+func (n *inNode) eval(properties map[string]string) (bool, error) {
+	value, found := properties[n.name]
+	for _, l := range n.values {
+		if (!found && l.isZero()) || (found && l.equals(value)) {
+			return !n.negate, nil
+		}
+	}
+	// like != a missing property is not part of a negated list
+	return found && n.negate, nil
+}
+
+func (n *inNode) properties(seen map[string]bool) {
+	seen[n.name] = true
+}
+
+var propertyNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.\-]*$`)
+
+func (p *parser) parseOr() (matchNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept(tokenOr) {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (matchNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept(tokenAnd) {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (matchNode, error) {
+	if p.accept(tokenNot) {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+	if p.accept(tokenLParen) {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(tokenRParen) {
+			return nil, p.unexpected()
+		}
+		return node, nil
+	}
+
+	name := p.peek()
+	if name.kind != tokenIdent || !propertyNamePattern.MatchString(name.text) {
+		return nil, p.unexpected()
+	}
+	p.next()
+
+	op := p.peek()
+	switch {
+	case op.kind == tokenEq || op.kind == tokenNe || op.kind == tokenLt || op.kind == tokenLe || op.kind == tokenGt || op.kind == tokenGe:
+		p.next()
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if op.kind != tokenEq && op.kind != tokenNe && !value.isNumber {
+			return nil, fmt.Errorf("%s requires a number at position %d", op.kind, op.pos)
+		}
+		return &comparisonNode{name: name.text, op: op.kind, value: value}, nil
+	case p.acceptKeyword("in"):
+		return p.parseIn(name.text, false)
+	case p.acceptKeyword("not"):
+		if !p.acceptKeyword("in") {
+			return nil, p.unexpected()
+		}
+		return p.parseIn(name.text, true)
+	}
+	return &propertyNode{name: name.text}, nil
+}
+
+func (p *parser) parseIn(name string, negate bool) (matchNode, error) {
+	closing := tokenRParen
+	if p.accept(tokenLBracket) {
+		closing = tokenRBracket
+	} else if !p.accept(tokenLParen) {
+		return nil, p.unexpected()
+	}
+	node := &inNode{name: name, negate: negate}
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		node.values = append(node.values, value)
+		if p.accept(closing) {
+			return node, nil
+		}
+		if !p.accept(tokenComma) {
+			return nil, p.unexpected()
+		}
+	}
+}
+
+func (p *parser) parseValue() (literal, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenString, tokenIdent:
+		p.next()
+		return newStringLiteral(tok.text), nil
+	case tokenNumber:
+		p.next()
+		return newLiteral(tok.text), nil
+	case tokenMinus:
+		p.next()
+		if number := p.peek(); number.kind == tokenNumber {
+			p.next()
+			return newLiteral("-" + number.text), nil
+		}
+	}
+	return literal{}, p.unexpected()
+}
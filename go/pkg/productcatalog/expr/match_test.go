@@ -0,0 +1,121 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package expr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatches(t *testing.T) {
+	properties := map[string]string{
+		"serviceType":       "ComputeAsAService",
+		"instanceType":      "vm-spr-sml",
+		"instanceGroupSize": "1",
+		"cpuCount":          "8",
+		"memoryGB":          "31.5",
+		"running":           "true",
+		"deleted":           "false",
+		"region":            "us-region-1",
+		"version":           "1.1",
+	}
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{`(serviceType == "ComputeAsAService" || serviceType == "KubernetesAsAService") && instanceType == "vm-spr-sml" && instanceGroupSize == "1"`, true},
+		{`serviceType == ComputeAsAService && instanceType == vm-spr-sml`, true},
+		{`serviceType == 'ComputeAsAService'`, true},
+		{`serviceType != "ComputeAsAService"`, false},
+		{`instanceType != "vm-spr-lrg"`, true},
+		{`serviceType in ("ComputeAsAService", "KubernetesAsAService")`, true},
+		{`instanceType in [vm-spr-lrg, vm-spr-med]`, false},
+		{`instanceType not in [vm-spr-lrg, vm-spr-med]`, true},
+		{`cpuCount >= 8 && cpuCount < 16`, true},
+		{`memoryGB > 32`, false},
+		{`cpuCount == 8.0`, true},
+		{`cpuCount == "8.0"`, false},
+		{`version == "1.10"`, false},
+		{`version == 1.10`, true},
+		{`version in ("1.10", "1.2")`, false},
+		{`version != "1.10"`, true},
+		{`running && !deleted`, true},
+		{`!(running || deleted)`, false},
+		{`missing`, false},
+		{`!missing`, false},
+		{`missing == ""`, true},
+		{`missing == 0`, true},
+		{`missing == "x"`, false},
+		{`missing != "x"`, false},
+		{`missing > -1`, false},
+		{`missing not in ("x")`, false},
+		{`missing in ("", "x")`, true},
+		{`serviceType == "ComputeAsAService" || missing`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			m, err := ParseMatch(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			matches, err := m.Matches(properties)
+			if err != nil {
+				t.Fatalf("unexpected evaluation error: %v", err)
+			}
+			if matches != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, matches)
+			}
+		})
+	}
+}
+
+func TestMatchesErrors(t *testing.T) {
+	properties := map[string]string{"instanceType": "vm-spr-sml", "cpuCount": "many"}
+	for _, src := range []string{`instanceType`, `!instanceType`, `cpuCount > 4`} {
+		t.Run(src, func(t *testing.T) {
+			m, err := ParseMatch(src)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			if _, err := m.Matches(properties); err == nil {
+				t.Fatalf("expected an evaluation error")
+			}
+		})
+	}
+}
+
+func TestParseMatchErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`instanceType ==`,
+		`instanceType = "x"`,
+		`(instanceType == "x"`,
+		`instanceType == "x")`,
+		`instanceType == "x" &&`,
+		`instanceType == "x" & running`,
+		`instanceType == "x`,
+		`cpuCount > "many"`,
+		`cpuCount > "4"`,
+		`instanceType in ()`,
+		`instanceType in ("x",`,
+		`instanceType not ("x")`,
+		`"instanceType" == "x"`,
+	} {
+		t.Run(src, func(t *testing.T) {
+			if err := ValidateMatchExpr(src); err == nil {
+				t.Fatalf("expected a parse error")
+			}
+		})
+	}
+}
+
+func TestMatchProperties(t *testing.T) {
+	m, err := ParseMatch(`(serviceType == "x" || !running) && cpuCount in (1, 2) && serviceType != "y"`)
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	expected := []string{"cpuCount", "running", "serviceType"}
+	if properties := m.Properties(); !reflect.DeepEqual(properties, expected) {
+		t.Fatalf("expected %v, got %v", expected, properties)
+	}
+}
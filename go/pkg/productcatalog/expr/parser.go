@@ -0,0 +1,56 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package expr
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) accept(kind tokenKind) bool {
+	if p.peek().kind != kind {
+		return false
+	}
+	p.next()
+	return true
+}
+
+func (p *parser) acceptKeyword(keyword string) bool {
+	tok := p.peek()
+	if tok.kind != tokenIdent || tok.text != keyword {
+		return false
+	}
+	p.next()
+	return true
+}
+
+func (p *parser) unexpected() error {
+	tok := p.peek()
+	if tok.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of expression")
+	}
+	return fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+var numberPattern = regexp.MustCompile(`^-?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
+func isNumber(text string) bool {
+	return numberPattern.MatchString(text)
+}
@@ -0,0 +1,332 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package expr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const previousPrefix = "previous."
+
+// UsageExpr is a parsed usageExpr of a product rate, it computes the cumulative quantity of a resource
+// from its latest metering record, e.g. "time - previous.time" or "storageGB * (time - previous.time) / 60".
+//
+// The grammar is
+//
+//	expr    := term (('+' | '-') term)*
+//	term    := unary (('*' | '/') unary)*
+//	unary   := '-' unary | primary
+//	primary := number | property | 'previous.'property | function '(' expr (',' expr)* ')' | '(' expr ')'
+//
+// The functions are min, max, ceil and floor. Properties are numbers read from the latest metering
+// record, previous properties are read from the metering record before it and are 0 for the first record.
+type UsageExpr struct {
+	src  string
+	root usageNode
+}
+
+// ParseUsage parses a usage expression.
+func ParseUsage(src string) (*UsageExpr, error) {
+	tokens, err := tokenize(src, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid usage expression: %w", err)
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseSum()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = p.unexpected()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid usage expression: %w", err)
+	}
+	return &UsageExpr{src: src, root: root}, nil
+}
+
+// ValidateUsageExpr returns an error if src is not a valid usage expression.
+func ValidateUsageExpr(src string) error {
+	_, err := ParseUsage(src)
+	return err
+}
+
+// Evaluate computes the quantity with the properties of the latest and the previous metering record,
+// previous is nil for the first record of a resource.
+func (u *UsageExpr) Evaluate(current, previous map[string]string) (float64, error) {
+	result, err := u.root.eval(current, previous)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return 0, fmt.Errorf("usage expression %q evaluated to %v", u.src, result)
+	}
+	return result, nil
+}
+
+// Properties returns the sorted names of the properties referenced by the expression, previous
+// properties keep their prefix.
+func (u *UsageExpr) Properties() []string {
+	seen := map[string]bool{}
+	u.root.properties(seen)
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (u *UsageExpr) String() string {
+	return u.src
+}
+
+type usageNode interface {
+	eval(current, previous map[string]string) (float64, error)
+	properties(seen map[string]bool)
+}
+
+type numberNode struct{ value float64 }
+
+func (n *numberNode) eval(current, previous map[string]string) (float64, error) {
+	return n.value, nil
+}
+
+func (n *numberNode) properties(seen map[string]bool) {}
+
+type variableNode struct {
+	name     string
+	previous bool
+}
+
+func (n *variableNode) eval(current, previous map[string]string) (float64, error) {
+	properties := current
+	if n.previous {
+		if previous == nil {
+			return 0, nil
+		}
+		properties = previous
+	}
+	value, found := properties[n.name]
+	if !found {
+		if n.previous {
+			return 0, fmt.Errorf("property %s%s not found in the previous metering record", previousPrefix, n.name)
+		}
+		return 0, fmt.Errorf("property %s not found in the metering record", n.name)
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || !isNumber(value) {
+		return 0, fmt.Errorf("property %s is not a number: %q", n.name, value)
+	}
+	return number, nil
+}
+
+func (n *variableNode) properties(seen map[string]bool) {
+	if n.previous {
+		seen[previousPrefix+n.name] = true
+	} else {
+		seen[n.name] = true
+	}
+}
+
+type negateNode struct{ operand usageNode }
+
+func (n *negateNode) eval(current, previous map[string]string) (float64, error) {
+	value, err := n.operand.eval(current, previous)
+	return -value, err
+}
+
+func (n *negateNode) properties(seen map[string]bool) {
+	n.operand.properties(seen)
+}
+
+type binaryNode struct {
+	op          tokenKind
+	left, right usageNode
+}
+
+func (n *binaryNode) eval(current, previous map[string]string) (float64, error) {
+	left, err := n.left.eval(current, previous)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.eval(current, previous)
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case tokenPlus:
+		return left + right, nil
+	case tokenMinus:
+		return left - right, nil
+	case tokenMul:
+		return left * right, nil
+	default:
+		if right == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return left / right, nil
+	}
+}
+
+func (n *binaryNode) properties(seen map[string]bool) {
+	n.left.properties(seen)
+	n.right.properties(seen)
+}
+
+type usageFunction struct {
+	minArgs, maxArgs int
+	apply            func(args []float64) float64
+}
+
+var usageFunctions = map[string]usageFunction{
+	"min": {minArgs: 2, maxArgs: -1, apply: func(args []float64) float64 {
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = math.Min(result, arg)
+		}
+		return result
+	}},
+	"max": {minArgs: 2, maxArgs: -1, apply: func(args []float64) float64 {
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = math.Max(result, arg)
+		}
+		return result
+	}},
+	"ceil":  {minArgs: 1, maxArgs: 1, apply: func(args []float64) float64 { return math.Ceil(args[0]) }},
+	"floor": {minArgs: 1, maxArgs: 1, apply: func(args []float64) float64 { return math.Floor(args[0]) }},
+}
+
+type callNode struct {
+	function usageFunction
+	args     []usageNode
+}
+
+func (n *callNode) eval(current, previous map[string]string) (float64, error) {
+	args := make([]float64, 0, len(n.args))
+	for _, arg := range n.args {
+		value, err := arg.eval(current, previous)
+		if err != nil {
+			return 0, err
+		}
+		args = append(args, value)
+	}
+	return n.function.apply(args), nil
+}
+
+func (n *callNode) properties(seen map[string]bool) {
+	for _, arg := range n.args {
+		arg.properties(seen)
+	}
+}
+
+func (p *parser) parseSum() (usageNode, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek().kind
+		if op != tokenPlus && op != tokenMinus {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseProduct() (usageNode, error) {
+	left, err := p.parseNegation()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek().kind
+		if op != tokenMul && op != tokenDiv {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseNegation()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseNegation() (usageNode, error) {
+	if p.accept(tokenMinus) {
+		operand, err := p.parseNegation()
+		if err != nil {
+			return nil, err
+		}
+		return &negateNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (usageNode, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenNumber:
+		p.next()
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", tok.text, tok.pos)
+		}
+		return &numberNode{value: value}, nil
+	case tokenLParen:
+		p.next()
+		node, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(tokenRParen) {
+			return nil, p.unexpected()
+		}
+		return node, nil
+	case tokenIdent:
+		p.next()
+		if p.peek().kind == tokenLParen {
+			return p.parseCall(tok)
+		}
+		name, previous := strings.CutPrefix(tok.text, previousPrefix)
+		if !propertyNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid property %q at position %d", tok.text, tok.pos)
+		}
+		return &variableNode{name: name, previous: previous}, nil
+	}
+	return nil, p.unexpected()
+}
+
+func (p *parser) parseCall(name token) (usageNode, error) {
+	function, ok := usageFunctions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos)
+	}
+	p.next()
+	node := &callNode{function: function}
+	for {
+		arg, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		node.args = append(node.args, arg)
+		if p.accept(tokenRParen) {
+			break
+		}
+		if !p.accept(tokenComma) {
+			return nil, p.unexpected()
+		}
+	}
+	if len(node.args) < function.minArgs || (function.maxArgs >= 0 && len(node.args) > function.maxArgs) {
+		return nil, fmt.Errorf("wrong number of arguments for %s at position %d", name.text, name.pos)
+	}
+	return node, nil
+}
@@ -0,0 +1,105 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package expr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	current := map[string]string{
+		"time":         "120",
+		"storageGB":    "50",
+		"inputTokens":  "1500",
+		"outputTokens": "500",
+	}
+	previous := map[string]string{
+		"time":         "60",
+		"storageGB":    "20",
+		"inputTokens":  "1000",
+		"outputTokens": "200",
+	}
+	tests := []struct {
+		expr     string
+		previous map[string]string
+		expected float64
+	}{
+		{"time – previous.time", previous, 60},
+		{"time - previous.time", previous, 60},
+		{"time - previous.time", nil, 120},
+		{"storageGB * (time - previous.time) / 60", previous, 50},
+		{"(inputTokens + outputTokens) / 1000", previous, 2},
+		{"inputTokens + 3 * outputTokens", nil, 3000},
+		{"ceil(time / 50)", previous, 3},
+		{"floor(time / 50)", previous, 2},
+		{"max(storageGB, 100) * time", previous, 12000},
+		{"min(storageGB, previous.storageGB, 30)", previous, 20},
+		{"-previous.time + time", previous, 60},
+		{"2 - -1", nil, 3},
+		{"1.5 * .5", nil, 0.75},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			u, err := ParseUsage(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			quantity, err := u.Evaluate(current, tt.previous)
+			if err != nil {
+				t.Fatalf("unexpected evaluation error: %v", err)
+			}
+			if quantity != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, quantity)
+			}
+		})
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	current := map[string]string{"time": "120", "zero": "0", "name": "vm-spr-sml"}
+	previous := map[string]string{"other": "1"}
+	for _, src := range []string{"missing", "name * 2", "time / zero", "time - previous.time"} {
+		t.Run(src, func(t *testing.T) {
+			u, err := ParseUsage(src)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			if _, err := u.Evaluate(current, previous); err == nil {
+				t.Fatalf("expected an evaluation error")
+			}
+		})
+	}
+}
+
+func TestParseUsageErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`time -`,
+		`time previous.time`,
+		`(time - previous.time`,
+		`sqrt(time)`,
+		`ceil(time, 2)`,
+		`min(time)`,
+		`time == 1`,
+		`"time"`,
+		`5GB * time`,
+	} {
+		t.Run(src, func(t *testing.T) {
+			if err := ValidateUsageExpr(src); err == nil {
+				t.Fatalf("expected a parse error")
+			}
+		})
+	}
+}
+
+func TestUsageProperties(t *testing.T) {
+	u, err := ParseUsage("storageGB * (time - previous.time)")
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	expected := []string{"previous.time", "storageGB", "time"}
+	if properties := u.Properties(); !reflect.DeepEqual(properties, expected) {
+		t.Fatalf("expected %v, got %v", expected, properties)
+	}
+}
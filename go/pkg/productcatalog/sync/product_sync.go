@@ -10,6 +10,7 @@ import (
 
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/productcatalog/expr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -46,6 +47,11 @@ func (srv *ProductSyncService) Put(ctx context.Context, product *pb.DefaultProdu
 		return nil, status.Error(codes.InvalidArgument, "validation failed for product")
 	}
 
+	if err := validateProductExpressions(product.GetSpec()); err != nil {
+		logger.Error(err, "validation failed for product expressions")
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed for product: %v", err)
+	}
+
 	// Begin a transaction
 	tx, err := srv.dbClient.BeginTx(ctx, nil)
 	if err != nil {
@@ -70,6 +76,19 @@ func (srv *ProductSyncService) Put(ctx context.Context, product *pb.DefaultProdu
 	return &emptypb.Empty{}, nil
 }
 
+// Match and usage expressions are only evaluated by billing, reject invalid ones before they are synchronized.
+func validateProductExpressions(spec *pb.ProductSpec) error {
+	if err := expr.ValidateMatchExpr(spec.GetMatchExpr()); err != nil {
+		return fmt.Errorf("matchExpr: %w", err)
+	}
+	for _, rate := range spec.GetRates() {
+		if err := expr.ValidateUsageExpr(rate.GetUsageExpr()); err != nil {
+			return fmt.Errorf("usageExpr of %s rate: %w", rate.GetAccountType(), err)
+		}
+	}
+	return nil
+}
+
 func insertRegions(ctx context.Context, tx *sql.Tx, defaultRegions []pb.DefaultRegionSpec) error {
 	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("ProductSyncService.insertRegion").Start()
 	defer span.End()
@@ -10,6 +10,7 @@ import (
 
 	"unicode/utf8"
 
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/productcatalog/expr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gopkg.in/yaml.v2"
@@ -228,13 +229,14 @@ func checkProductValues(item Item) {
 		Expect(file.Spec.Description).To(MatchRegexp(descriptionPattern), validationMessage)
 		Expect(utf8.RuneCountInString(file.Spec.Description)).To(BeNumerically("<=", 99), validationMessage)
 		Expect(len(file.Spec.MatchExpr)).To(BeNumerically(">=", 1), validationMessage)
+		Expect(expr.ValidateMatchExpr(file.Spec.MatchExpr)).To(Succeed(), validationMessage)
 		Expect(len(file.Spec.Rates)).To(BeNumerically("==", 4), validationMessage)
 		// Validate rates values.
 		for _, rate := range file.Spec.Rates {
 			Expect(rate.AccountType).To(MatchRegexp("^(standard|premium|enterprise|intel)$"), validationMessage)
 			Expect(rate.Unit).To(MatchRegexp("^(dollarsPerMinute|dollarsPerTBPerHour|dollarsPerInference|dollarsPerMillionTokens)$"), validationMessage)
 			Expect(rate.Rate).To(MatchRegexp("^[0-9]+(\\.[0-9]+)?$"), validationMessage)
-			Expect(rate.UsageExpr).To(MatchRegexp("^(time – previous\\.time)$"), validationMessage)
+			Expect(expr.ValidateUsageExpr(rate.UsageExpr)).To(Succeed(), validationMessage)
 		}
 	}
 }
@@ -0,0 +1,243 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package protodb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultPageSize is used when a page token is provided without a page size.
+	DefaultPageSize = 100
+	// MaxPageSize is the largest page that a Search RPC will return.
+	// Larger page sizes requested by the client are reduced to this value.
+	MaxPageSize = 1000
+
+	pageTokenVersion = 1
+)
+
+// Pagination provides keyset (seek) pagination for Search queries.
+//
+// Rows are ordered by Columns and each page starts immediately after the last row
+// of the previous page. Unlike offset pagination, this is stable when rows are
+// inserted or deleted between calls and it does not require Postgres to scan the
+// skipped rows. The combination of Columns must be unique, so the last column
+// is usually resource_id.
+//
+// The page token returned to the client is opaque. It holds the values of Columns
+// for the last row of the previous page.
+//
+// For example:
+//
+//	pagination, err := protodb.NewPagination(req.PageSize, req.PageToken, "name", "resource_id")
+//	if err != nil {
+//		return nil, err
+//	}
+//	query := fmt.Sprintf("select %s from instance where %s and %s %s %s",
+//		columns, filter.GetWhereString(1), pagination.GetWhereString(len(filter.Values)+1),
+//		pagination.GetOrderByString(), pagination.GetLimitString())
+//	args := append(filter.Values, pagination.GetValues()...)
+//	...
+//	items, nextPageToken, err := protodb.NextPage(pagination, items, func(item *pb.Instance) []string {
+//		return []string{item.Metadata.Name, item.Metadata.ResourceId}
+//	})
+//
+// If the client does not provide a page size or page token, pagination is disabled
+// and all rows are returned. This preserves the behavior of existing clients.
+// A nil *Pagination is also disabled. This is useful for private APIs that share
+// a query with a public Search but never paginate.
+// Internal callers that page through a public Search can use SearchAllPages.
+type Pagination struct {
+	// Columns that define the order of rows.
+	Columns []string
+	// Number of rows in a page. Zero means pagination is disabled.
+	pageSize int
+	// Values of Columns for the last row of the previous page. Nil for the first page.
+	after []string
+}
+
+type pageToken struct {
+	Version int      `json:"v"`
+	After   []string `json:"a"`
+}
+
+// NewPagination returns a Pagination for the page size and page token provided by the client.
+// Returns an InvalidArgument error if the page size is negative or the page token cannot be decoded.
+func NewPagination(pageSize int32, token string, columns ...string) (*Pagination, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("NewPagination: at least one column is required")
+	}
+	if pageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "pageSize must not be negative")
+	}
+	p := &Pagination{
+		Columns:  columns,
+		pageSize: int(pageSize),
+	}
+	if p.pageSize > MaxPageSize {
+		p.pageSize = MaxPageSize
+	}
+	if token != "" {
+		if p.pageSize == 0 {
+			p.pageSize = DefaultPageSize
+		}
+		after, err := decodePageToken(token, len(columns))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid pageToken")
+		}
+		p.after = after
+	}
+	return p, nil
+}
+
+// Enabled returns true if the client requested a page size or provided a page token.
+func (p *Pagination) Enabled() bool {
+	return p != nil && p.pageSize > 0
+}
+
+// PageSize returns the number of rows in a page. Zero means pagination is disabled.
+func (p *Pagination) PageSize() int {
+	if p == nil {
+		return 0
+	}
+	return p.pageSize
+}
+
+// Returns an expression that can be added to a SQL WHERE expression to skip the rows
+// of previous pages. If this is the first page, returns "1 = 1".
+// For example: (name, resource_id) > ($3, $4)
+func (p *Pagination) GetWhereString(firstPlaceholderNumber int) string {
+	if p == nil || p.after == nil {
+		return "1 = 1"
+	}
+	var params []string
+	for i := range p.Columns {
+		params = append(params, fmt.Sprintf("$%d", i+firstPlaceholderNumber))
+	}
+	return fmt.Sprintf("(%s) > (%s)", strings.Join(p.Columns, ", "), strings.Join(params, ", "))
+}
+
+// Returns the values for the place holders in the expression returned by GetWhereString.
+func (p *Pagination) GetValues() []any {
+	if p == nil {
+		return nil
+	}
+	var values []any
+	for _, v := range p.after {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Returns a SQL ORDER BY clause or the empty string if the Pagination is nil.
+// For example: order by name, resource_id
+func (p *Pagination) GetOrderByString() string {
+	if p == nil {
+		return ""
+	}
+	return "order by " + strings.Join(p.Columns, ", ")
+}
+
+// Returns a SQL LIMIT clause or the empty string if pagination is disabled.
+// One more row than the page size is requested so that NextPage can determine
+// if there is another page.
+func (p *Pagination) GetLimitString() string {
+	if !p.Enabled() {
+		return ""
+	}
+	return fmt.Sprintf("limit %d", p.pageSize+1)
+}
+
+// NextPage removes the extra row requested by GetLimitString and returns the page token
+// for the next page. The page token is empty if this is the last page.
+// getKeyValues must return the values of Columns for an item, in the same order as Columns.
+func NextPage[T any](p *Pagination, items []T, getKeyValues func(T) []string) ([]T, string, error) {
+	if !p.Enabled() || len(items) <= p.pageSize {
+		return items, "", nil
+	}
+	items = items[:p.pageSize]
+	token, err := encodePageToken(getKeyValues(items[len(items)-1]))
+	if err != nil {
+		return nil, "", err
+	}
+	return items, token, nil
+}
+
+// Page returns a page of items that have been read into memory, along with the page token
+// for the next page. This is used when a Search cannot apply the pagination in SQL.
+// Items must be sorted in ascending order of the values returned by getKeyValues,
+// which must be the values of Columns for an item.
+func Page[T any](p *Pagination, items []T, getKeyValues func(T) []string) ([]T, string, error) {
+	if p.Enabled() && p.after != nil {
+		start := sort.Search(len(items), func(i int) bool {
+			return compareKeyValues(getKeyValues(items[i]), p.after) > 0
+		})
+		items = items[start:]
+	}
+	if p.Enabled() && len(items) > p.pageSize+1 {
+		items = items[:p.pageSize+1]
+	}
+	return NextPage(p, items, getKeyValues)
+}
+
+// Compares key values in the same way as the row comparison in GetWhereString.
+func compareKeyValues(a []string, b []string) int {
+	for i := range a {
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// SearchAllPages calls searchPage for each page, starting with the first page, and returns the items of all pages.
+// searchPage must call a paginated Search with the page token and return the items and the next page token.
+// This is used by internal callers of public Search methods that need all items.
+func SearchAllPages[T any](searchPage func(pageToken string) ([]T, string, error)) ([]T, error) {
+	var allItems []T
+	pageToken := ""
+	for {
+		items, nextPageToken, err := searchPage(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		allItems = append(allItems, items...)
+		if nextPageToken == "" {
+			return allItems, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func encodePageToken(after []string) (string, error) {
+	jsonBytes, err := json.Marshal(pageToken{Version: pageTokenVersion, After: after})
+	if err != nil {
+		return "", fmt.Errorf("encodePageToken: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(jsonBytes), nil
+}
+
+func decodePageToken(token string, numColumns int) ([]string, error) {
+	jsonBytes, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	var t pageToken
+	if err := json.Unmarshal(jsonBytes, &t); err != nil {
+		return nil, err
+	}
+	if t.Version != pageTokenVersion {
+		return nil, fmt.Errorf("unsupported page token version %d", t.Version)
+	}
+	if len(t.After) != numColumns {
+		return nil, fmt.Errorf("page token has %d values, expected %d", len(t.After), numColumns)
+	}
+	return t.After, nil
+}
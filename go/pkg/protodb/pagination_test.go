@@ -0,0 +1,158 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package protodb
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testItem struct {
+	name       string
+	resourceId string
+}
+
+func testItemKeyValues(item testItem) []string {
+	return []string{item.name, item.resourceId}
+}
+
+func createTestItems(count int) []testItem {
+	var items []testItem
+	for i := 0; i < count; i++ {
+		items = append(items, testItem{name: fmt.Sprintf("name%03d", i), resourceId: fmt.Sprintf("id%03d", i)})
+	}
+	return items
+}
+
+var _ = Describe("Pagination", func() {
+	It("Should be disabled when page size and page token are empty", func() {
+		p, err := NewPagination(0, "", "name", "resource_id")
+		Expect(err).Should(Succeed())
+		Expect(p.Enabled()).Should(BeFalse())
+		Expect(p.GetWhereString(3)).Should(Equal("1 = 1"))
+		Expect(p.GetValues()).Should(BeEmpty())
+		Expect(p.GetLimitString()).Should(Equal(""))
+		Expect(p.GetOrderByString()).Should(Equal("order by name, resource_id"))
+
+		items, token, err := NextPage(p, createTestItems(DefaultPageSize+1), testItemKeyValues)
+		Expect(err).Should(Succeed())
+		Expect(items).Should(HaveLen(DefaultPageSize + 1))
+		Expect(token).Should(Equal(""))
+	})
+
+	It("Should be disabled when nil", func() {
+		var p *Pagination
+		Expect(p.Enabled()).Should(BeFalse())
+		Expect(p.GetWhereString(1)).Should(Equal("1 = 1"))
+		Expect(p.GetValues()).Should(BeEmpty())
+		Expect(p.GetLimitString()).Should(Equal(""))
+		Expect(p.GetOrderByString()).Should(Equal(""))
+
+		items, token, err := NextPage(p, createTestItems(5), testItemKeyValues)
+		Expect(err).Should(Succeed())
+		Expect(items).Should(HaveLen(5))
+		Expect(token).Should(Equal(""))
+	})
+
+	It("Should request one more row than the page size", func() {
+		p, err := NewPagination(10, "", "name", "resource_id")
+		Expect(err).Should(Succeed())
+		Expect(p.Enabled()).Should(BeTrue())
+		Expect(p.GetLimitString()).Should(Equal("limit 11"))
+	})
+
+	It("Should reduce page size to MaxPageSize", func() {
+		p, err := NewPagination(MaxPageSize+1, "", "name", "resource_id")
+		Expect(err).Should(Succeed())
+		Expect(p.PageSize()).Should(Equal(MaxPageSize))
+	})
+
+	It("Should reject a negative page size", func() {
+		_, err := NewPagination(-1, "", "name", "resource_id")
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("Should reject an invalid page token", func() {
+		_, err := NewPagination(10, "not-a-token", "name", "resource_id")
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("Should reject a page token with the wrong number of columns", func() {
+		token, err := encodePageToken([]string{"name001"})
+		Expect(err).Should(Succeed())
+		_, err = NewPagination(10, token, "name", "resource_id")
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("Should return a page token that continues after the last item", func() {
+		p, err := NewPagination(2, "", "name", "resource_id")
+		Expect(err).Should(Succeed())
+		// The query returns page size + 1 rows.
+		items, token, err := NextPage(p, createTestItems(3), testItemKeyValues)
+		Expect(err).Should(Succeed())
+		Expect(items).Should(Equal(createTestItems(2)))
+		Expect(token).ShouldNot(BeEmpty())
+
+		next, err := NewPagination(2, token, "name", "resource_id")
+		Expect(err).Should(Succeed())
+		Expect(next.GetWhereString(3)).Should(Equal("(name, resource_id) > ($3, $4)"))
+		Expect(next.GetValues()).Should(Equal([]any{"name001", "id001"}))
+	})
+
+	It("Should return an empty page token for the last page", func() {
+		p, err := NewPagination(2, "", "name", "resource_id")
+		Expect(err).Should(Succeed())
+		items, token, err := NextPage(p, createTestItems(2), testItemKeyValues)
+		Expect(err).Should(Succeed())
+		Expect(items).Should(HaveLen(2))
+		Expect(token).Should(Equal(""))
+	})
+
+	It("Should use DefaultPageSize when a page token is provided without a page size", func() {
+		token, err := encodePageToken([]string{"name001", "id001"})
+		Expect(err).Should(Succeed())
+		p, err := NewPagination(0, token, "name", "resource_id")
+		Expect(err).Should(Succeed())
+		Expect(p.PageSize()).Should(Equal(DefaultPageSize))
+	})
+
+	It("Page should return pages of items in memory", func() {
+		allItems := createTestItems(5)
+		p, err := NewPagination(2, "", "name", "resource_id")
+		Expect(err).Should(Succeed())
+		items, token, err := Page(p, allItems, testItemKeyValues)
+		Expect(err).Should(Succeed())
+		Expect(items).Should(Equal(allItems[0:2]))
+
+		p, err = NewPagination(2, token, "name", "resource_id")
+		Expect(err).Should(Succeed())
+		items, token, err = Page(p, allItems, testItemKeyValues)
+		Expect(err).Should(Succeed())
+		Expect(items).Should(Equal(allItems[2:4]))
+
+		p, err = NewPagination(2, token, "name", "resource_id")
+		Expect(err).Should(Succeed())
+		items, token, err = Page(p, allItems, testItemKeyValues)
+		Expect(err).Should(Succeed())
+		Expect(items).Should(Equal(allItems[4:5]))
+		Expect(token).Should(Equal(""))
+	})
+
+	It("SearchAllPages should return the items of all pages", func() {
+		allItems := createTestItems(5)
+		searchPage := func(pageToken string) ([]testItem, string, error) {
+			p, err := NewPagination(2, pageToken, "name", "resource_id")
+			if err != nil {
+				return nil, "", err
+			}
+			return Page(p, allItems, testItemKeyValues)
+		}
+		items, err := SearchAllPages(searchPage)
+		Expect(err).Should(Succeed())
+		Expect(items).Should(Equal(allItems))
+	})
+})
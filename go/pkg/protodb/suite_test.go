@@ -0,0 +1,15 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package protodb
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ProtoDB Test Suite")
+}
@@ -0,0 +1,9 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+--------------------------------------------------------------------------------
+--  quota usage ledger
+--------------------------------------------------------------------------------
+
+drop table quota_reservations;
+drop table quota_usage;
@@ -0,0 +1,43 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+--------------------------------------------------------------------------------
+--  quota usage ledger
+--------------------------------------------------------------------------------
+
+-- Committed usage of a resource by a cloudaccount.
+-- The row is locked while reserving quota, which serializes the reservations
+-- of a cloudaccount and resource across replicas.
+create table quota_usage (
+    service_id varchar(64) not null,
+
+    resource_name varchar(64) not null,
+
+    cloud_account_id varchar(12) not null,
+
+    usage bigint not null,
+
+    updated_timestamp timestamp not null default now(),
+
+    primary key (service_id, resource_name, cloud_account_id)
+);
+
+-- Quota reserved for resources being created, until it is committed to quota_usage,
+-- released or expired.
+create table quota_reservations (
+    reservation_id varchar(64) primary key,
+
+    service_id varchar(64) not null,
+
+    resource_name varchar(64) not null,
+
+    cloud_account_id varchar(12) not null,
+
+    quantity bigint not null,
+
+    created_timestamp timestamp not null default now(),
+
+    expiration_timestamp timestamp not null
+);
+
+create index if not exists quota_reservations_resource_idx on quota_reservations (service_id, resource_name, cloud_account_id);
@@ -0,0 +1,9 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+--------------------------------------------------------------------------------
+--  quota increase requests
+--------------------------------------------------------------------------------
+
+drop table quota_increase_requests;
+drop type quota_increase_request_state;
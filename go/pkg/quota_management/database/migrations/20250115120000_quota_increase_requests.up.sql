@@ -0,0 +1,42 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+--------------------------------------------------------------------------------
+--  quota increase requests
+--------------------------------------------------------------------------------
+
+create type quota_increase_request_state AS ENUM (
+    'PENDING',
+    'APPROVED',
+    'REJECTED'
+);
+
+create table quota_increase_requests (
+    request_id varchar(64) primary key,
+
+    cloud_account_id varchar(12) not null,
+
+    service_id varchar(64) not null,
+
+    resource_name varchar(64) not null,
+
+    current_limit bigint not null,
+
+    requested_limit bigint not null,
+
+    justification text not null,
+
+    state quota_increase_request_state not null default 'PENDING',
+
+    review_comment text not null default '',
+
+    created_timestamp timestamp not null default now(),
+
+    updated_timestamp timestamp not null default now()
+);
+
+create index if not exists quota_increase_requests_cloud_account_idx on quota_increase_requests (cloud_account_id);
+
+-- A cloudaccount can have a single pending request per resource.
+create unique index if not exists quota_increase_requests_pending_idx on quota_increase_requests (cloud_account_id, service_id, resource_name)
+    where state = 'PENDING';
@@ -0,0 +1,8 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+--------------------------------------------------------------------------------
+--  quota usage limits scale
+--------------------------------------------------------------------------------
+
+alter table quota_usage drop column limits_scale;
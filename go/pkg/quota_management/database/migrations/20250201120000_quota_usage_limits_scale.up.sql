@@ -0,0 +1,10 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+
+--------------------------------------------------------------------------------
+--  quota usage limits scale
+--------------------------------------------------------------------------------
+
+-- Number of units of usage in a unit of the quota limit, e.g. 1000 when the
+-- usage of a quota in TB is counted in GB.
+alter table quota_usage add column limits_scale bigint not null default 1;
@@ -0,0 +1,221 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type QuotaIncreaseRequestState string
+
+const (
+	QuotaIncreaseRequestPending  QuotaIncreaseRequestState = "PENDING"
+	QuotaIncreaseRequestApproved QuotaIncreaseRequestState = "APPROVED"
+	QuotaIncreaseRequestRejected QuotaIncreaseRequestState = "REJECTED"
+)
+
+var quotaIncreaseRequestStates = map[QuotaIncreaseRequestState]pb.QuotaIncreaseRequestState{
+	QuotaIncreaseRequestPending:  pb.QuotaIncreaseRequestState_QUOTA_INCREASE_REQUEST_STATE_PENDING,
+	QuotaIncreaseRequestApproved: pb.QuotaIncreaseRequestState_QUOTA_INCREASE_REQUEST_STATE_APPROVED,
+	QuotaIncreaseRequestRejected: pb.QuotaIncreaseRequestState_QUOTA_INCREASE_REQUEST_STATE_REJECTED,
+}
+
+type QuotaIncreaseRequest struct {
+	RequestId        string
+	CloudAccountId   string
+	ServiceId        string
+	ServiceName      string
+	ResourceName     string
+	CurrentLimit     int64
+	RequestedLimit   int64
+	Justification    string
+	State            QuotaIncreaseRequestState
+	ReviewComment    string
+	CreatedTimestamp time.Time
+	UpdateTimestamp  time.Time
+}
+
+// QuotaIncreaseRequestStateFromPb returns the database state of a request state, or an empty state if it is unspecified.
+func QuotaIncreaseRequestStateFromPb(state pb.QuotaIncreaseRequestState) QuotaIncreaseRequestState {
+	for dbState, pbState := range quotaIncreaseRequestStates {
+		if pbState == state {
+			return dbState
+		}
+	}
+	return ""
+}
+
+func (r *QuotaIncreaseRequest) ToPb() *pb.QuotaIncreaseRequest {
+	return &pb.QuotaIncreaseRequest{
+		RequestId:      r.RequestId,
+		CloudAccountId: r.CloudAccountId,
+		ServiceName:    r.ServiceName,
+		ResourceType:   r.ResourceName,
+		CurrentLimit:   r.CurrentLimit,
+		RequestedLimit: r.RequestedLimit,
+		Justification:  r.Justification,
+		State:          quotaIncreaseRequestStates[r.State],
+		ReviewComment:  r.ReviewComment,
+		CreatedTime:    timestamppb.New(r.CreatedTimestamp),
+		UpdatedTime:    timestamppb.New(r.UpdateTimestamp),
+	}
+}
+
+const (
+	insertQuotaIncreaseRequest = `
+		INSERT INTO quota_increase_requests
+		(request_id, cloud_account_id, service_id, resource_name, current_limit, requested_limit, justification)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING state, review_comment, created_timestamp, updated_timestamp
+	`
+	getQuotaIncreaseRequests = `
+		SELECT r.request_id, r.cloud_account_id, r.service_id, s.service_name, r.resource_name, r.current_limit,
+			r.requested_limit, r.justification, r.state, r.review_comment, r.created_timestamp, r.updated_timestamp
+		FROM quota_increase_requests r
+		JOIN registered_services s ON s.service_id = r.service_id
+		WHERE 1=1
+	`
+	updateQuotaIncreaseRequestState = `
+		UPDATE quota_increase_requests
+		SET state = $2, review_comment = $3, updated_timestamp = now()
+		WHERE request_id = $1
+		RETURNING updated_timestamp
+	`
+)
+
+func InsertQuotaIncreaseRequest(ctx context.Context, tx *sql.Tx, request *QuotaIncreaseRequest) error {
+	logger := log.FromContext(ctx).WithName("InsertQuotaIncreaseRequest")
+	logger.Info("insert quota increase request", "requestId", request.RequestId)
+
+	err := tx.QueryRowContext(
+		ctx,
+		insertQuotaIncreaseRequest,
+		request.RequestId,
+		request.CloudAccountId,
+		request.ServiceId,
+		request.ResourceName,
+		request.CurrentLimit,
+		request.RequestedLimit,
+		request.Justification,
+	).Scan(
+		&request.State,
+		&request.ReviewComment,
+		&request.CreatedTimestamp,
+		&request.UpdateTimestamp,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return status.Errorf(codes.AlreadyExists, "a quota increase request for %s is already pending", request.ResourceName)
+		}
+		logger.Error(err, "failed to insert quota increase request")
+		return status.Error(codes.Internal, "database transaction failed")
+	}
+	return nil
+}
+
+// GetQuotaIncreaseRequest returns a request. The request is locked until the end of the transaction when forUpdate is set.
+func GetQuotaIncreaseRequest(ctx context.Context, tx *sql.Tx, requestId, cloudAccountId string, forUpdate bool) (*QuotaIncreaseRequest, error) {
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(getQuotaIncreaseRequests)
+	queryBuilder.WriteString(" AND r.request_id = $1")
+	params := []interface{}{requestId}
+	if cloudAccountId != "" {
+		queryBuilder.WriteString(" AND r.cloud_account_id = $2")
+		params = append(params, cloudAccountId)
+	}
+	if forUpdate {
+		queryBuilder.WriteString(" FOR UPDATE OF r")
+	}
+
+	requests, err := queryQuotaIncreaseRequests(ctx, tx, queryBuilder.String(), params)
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return nil, status.Error(codes.NotFound, "quota increase request not found")
+	}
+	return requests[0], nil
+}
+
+// ListQuotaIncreaseRequests returns the requests, newest first. Empty filters are ignored.
+func ListQuotaIncreaseRequests(ctx context.Context, tx *sql.Tx, cloudAccountId string, state QuotaIncreaseRequestState) ([]*QuotaIncreaseRequest, error) {
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(getQuotaIncreaseRequests)
+	params := []interface{}{}
+	if cloudAccountId != "" {
+		params = append(params, cloudAccountId)
+		queryBuilder.WriteString(fmt.Sprintf(" AND r.cloud_account_id = $%d", len(params)))
+	}
+	if state != "" {
+		params = append(params, state)
+		queryBuilder.WriteString(fmt.Sprintf(" AND r.state = $%d", len(params)))
+	}
+	queryBuilder.WriteString(" ORDER BY r.created_timestamp DESC")
+
+	return queryQuotaIncreaseRequests(ctx, tx, queryBuilder.String(), params)
+}
+
+func UpdateQuotaIncreaseRequestState(ctx context.Context, tx *sql.Tx, request *QuotaIncreaseRequest, state QuotaIncreaseRequestState, reviewComment string) error {
+	logger := log.FromContext(ctx).WithName("UpdateQuotaIncreaseRequestState")
+	logger.Info("update quota increase request state", "requestId", request.RequestId, "state", state)
+
+	if err := tx.QueryRowContext(ctx, updateQuotaIncreaseRequestState, request.RequestId, state, reviewComment).Scan(&request.UpdateTimestamp); err != nil {
+		logger.Error(err, "failed to update quota increase request state")
+		return status.Error(codes.Internal, "database transaction failed")
+	}
+	request.State = state
+	request.ReviewComment = reviewComment
+	return nil
+}
+
+func queryQuotaIncreaseRequests(ctx context.Context, tx *sql.Tx, query string, params []interface{}) ([]*QuotaIncreaseRequest, error) {
+	logger := log.FromContext(ctx).WithName("queryQuotaIncreaseRequests")
+
+	rows, err := tx.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error(err, "failed to query quota increase requests")
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer rows.Close()
+
+	requests := []*QuotaIncreaseRequest{}
+	for rows.Next() {
+		var request QuotaIncreaseRequest
+		if err := rows.Scan(
+			&request.RequestId,
+			&request.CloudAccountId,
+			&request.ServiceId,
+			&request.ServiceName,
+			&request.ResourceName,
+			&request.CurrentLimit,
+			&request.RequestedLimit,
+			&request.Justification,
+			&request.State,
+			&request.ReviewComment,
+			&request.CreatedTimestamp,
+			&request.UpdateTimestamp,
+		); err != nil {
+			logger.Error(err, "failed to scan quota increase request")
+			return nil, status.Error(codes.Internal, "database transaction failed")
+		}
+		requests = append(requests, &request)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error(err, "failed to read quota increase requests")
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	return requests, nil
+}
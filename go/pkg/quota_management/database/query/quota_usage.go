@@ -0,0 +1,296 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type QuotaReservation struct {
+	ReservationId       string
+	ServiceId           string
+	ResourceName        string
+	CloudAccountId      string
+	Quantity            int64
+	ExpirationTimestamp time.Time
+}
+
+// QuotaUsageCount is the usage of a resource by a cloudaccount counted by the service that owns the resource.
+type QuotaUsageCount struct {
+	ResourceName   string
+	CloudAccountId string
+	Usage          int64
+	LimitsScale    int64
+}
+
+const (
+	insertQuotaUsageIfMissing = `
+		INSERT INTO quota_usage (service_id, resource_name, cloud_account_id, usage, limits_scale)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (service_id, resource_name, cloud_account_id) DO NOTHING
+	`
+	lockQuotaUsage = `
+		SELECT usage
+		FROM quota_usage
+		WHERE service_id = $1 AND resource_name = $2 AND cloud_account_id = $3
+		FOR UPDATE
+	`
+	updateQuotaUsage = `
+		UPDATE quota_usage
+		SET usage = greatest(usage + $4, 0), updated_timestamp = now()
+		WHERE service_id = $1 AND resource_name = $2 AND cloud_account_id = $3
+		RETURNING usage
+	`
+	getQuotaUsages = `
+		SELECT service_id, resource_name, (usage + limits_scale - 1) / limits_scale
+		FROM quota_usage
+		WHERE cloud_account_id = $1
+	`
+	reconcileQuotaUsage = `
+		INSERT INTO quota_usage (service_id, resource_name, cloud_account_id, usage, limits_scale)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (service_id, resource_name, cloud_account_id) DO UPDATE
+		SET usage = excluded.usage, limits_scale = excluded.limits_scale, updated_timestamp = now()
+		WHERE quota_usage.updated_timestamp < $6
+		  AND (quota_usage.usage <> excluded.usage OR quota_usage.limits_scale <> excluded.limits_scale)
+	`
+	getReconcilableQuotaUsages = `
+		SELECT resource_name, cloud_account_id
+		FROM quota_usage
+		WHERE service_id = $1 AND usage <> 0 AND updated_timestamp < $2
+	`
+	resetQuotaUsage = `
+		UPDATE quota_usage
+		SET usage = 0, updated_timestamp = now()
+		WHERE service_id = $1 AND resource_name = $2 AND cloud_account_id = $3 AND updated_timestamp < $4
+	`
+	deleteExpiredQuotaReservations = `
+		DELETE FROM quota_reservations
+		WHERE service_id = $1 AND resource_name = $2 AND cloud_account_id = $3 AND expiration_timestamp < now()
+	`
+	getReservedQuota = `
+		SELECT coalesce(sum(quantity), 0)
+		FROM quota_reservations
+		WHERE service_id = $1 AND resource_name = $2 AND cloud_account_id = $3
+	`
+	insertQuotaReservation = `
+		INSERT INTO quota_reservations (reservation_id, service_id, resource_name, cloud_account_id, quantity, expiration_timestamp)
+		VALUES ($1, $2, $3, $4, $5, now() + make_interval(secs => $6))
+		RETURNING expiration_timestamp
+	`
+	getQuotaReservation = `
+		SELECT reservation_id, service_id, resource_name, cloud_account_id, quantity, expiration_timestamp
+		FROM quota_reservations
+		WHERE reservation_id = $1
+	`
+	deleteQuotaReservation = `
+		DELETE FROM quota_reservations
+		WHERE reservation_id = $1
+	`
+)
+
+// LockQuotaUsage returns the committed usage of a resource by a cloudaccount and locks it until the end of the
+// transaction. The usage is initialized with initialUsage, counted in limitsScale units per unit of the quota limit,
+// if the resource has never been reserved.
+func LockQuotaUsage(ctx context.Context, tx *sql.Tx, serviceId, resourceName, cloudAccountId string, initialUsage, limitsScale int64) (int64, error) {
+	logger := log.FromContext(ctx).WithName("LockQuotaUsage")
+	logger.Info("lock quota usage")
+
+	if _, err := tx.ExecContext(ctx, insertQuotaUsageIfMissing, serviceId, resourceName, cloudAccountId, initialUsage, limitsScale); err != nil {
+		logger.Error(err, "failed to initialize quota usage")
+		return 0, status.Error(codes.Internal, "database transaction failed")
+	}
+	var usage int64
+	if err := tx.QueryRowContext(ctx, lockQuotaUsage, serviceId, resourceName, cloudAccountId).Scan(&usage); err != nil {
+		logger.Error(err, "failed to lock quota usage")
+		return 0, status.Error(codes.Internal, "database transaction failed")
+	}
+	return usage, nil
+}
+
+// UpdateQuotaUsage adds delta to the committed usage of a resource by a cloudaccount, without going below 0.
+// Nothing is updated if the resource has never been reserved.
+func UpdateQuotaUsage(ctx context.Context, tx *sql.Tx, serviceId, resourceName, cloudAccountId string, delta int64) (int64, error) {
+	logger := log.FromContext(ctx).WithName("UpdateQuotaUsage")
+	logger.Info("update quota usage", "delta", delta)
+
+	var usage int64
+	err := tx.QueryRowContext(ctx, updateQuotaUsage, serviceId, resourceName, cloudAccountId, delta).Scan(&usage)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		logger.Error(err, "failed to update quota usage")
+		return 0, status.Error(codes.Internal, "database transaction failed")
+	}
+	return usage, nil
+}
+
+// GetQuotaUsages returns the committed usages of the resources by a cloudaccount in units of their quota limits, keyed
+// by service id and resource name.
+func GetQuotaUsages(ctx context.Context, tx *sql.Tx, cloudAccountId string) (map[string]map[string]int64, error) {
+	logger := log.FromContext(ctx).WithName("GetQuotaUsages")
+	logger.Info("get quota usages")
+
+	rows, err := tx.QueryContext(ctx, getQuotaUsages, cloudAccountId)
+	if err != nil {
+		logger.Error(err, "failed to get quota usages")
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer rows.Close()
+
+	usages := map[string]map[string]int64{}
+	for rows.Next() {
+		var serviceId, resourceName string
+		var usage int64
+		if err := rows.Scan(&serviceId, &resourceName, &usage); err != nil {
+			logger.Error(err, "failed to scan quota usage")
+			return nil, status.Error(codes.Internal, "database transaction failed")
+		}
+		if usages[serviceId] == nil {
+			usages[serviceId] = map[string]int64{}
+		}
+		usages[serviceId][resourceName] = usage
+	}
+	return usages, nil
+}
+
+// ReconcileQuotaUsage sets the usages of the resources of a service to the counted ones, and the usages that were not
+// counted to 0. Usages updated at or after updatedBefore are kept, since the counts may not include their changes.
+func ReconcileQuotaUsage(ctx context.Context, tx *sql.Tx, serviceId string, counts []QuotaUsageCount, updatedBefore time.Time) error {
+	logger := log.FromContext(ctx).WithName("ReconcileQuotaUsage")
+	logger.Info("reconcile quota usage", "counts", len(counts), "updatedBefore", updatedBefore)
+
+	counted := map[string]map[string]bool{}
+	for _, count := range counts {
+		if _, err := tx.ExecContext(ctx, reconcileQuotaUsage, serviceId, count.ResourceName, count.CloudAccountId, count.Usage, count.LimitsScale, updatedBefore); err != nil {
+			logger.Error(err, "failed to reconcile quota usage")
+			return status.Error(codes.Internal, "database transaction failed")
+		}
+		if counted[count.ResourceName] == nil {
+			counted[count.ResourceName] = map[string]bool{}
+		}
+		counted[count.ResourceName][count.CloudAccountId] = true
+	}
+
+	rows, err := tx.QueryContext(ctx, getReconcilableQuotaUsages, serviceId, updatedBefore)
+	if err != nil {
+		logger.Error(err, "failed to get quota usages")
+		return status.Error(codes.Internal, "database transaction failed")
+	}
+	uncounted := []QuotaUsageCount{}
+	for rows.Next() {
+		var usage QuotaUsageCount
+		if err := rows.Scan(&usage.ResourceName, &usage.CloudAccountId); err != nil {
+			rows.Close()
+			logger.Error(err, "failed to scan quota usage")
+			return status.Error(codes.Internal, "database transaction failed")
+		}
+		if !counted[usage.ResourceName][usage.CloudAccountId] {
+			uncounted = append(uncounted, usage)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		logger.Error(err, "failed to get quota usages")
+		return status.Error(codes.Internal, "database transaction failed")
+	}
+	for _, usage := range uncounted {
+		if _, err := tx.ExecContext(ctx, resetQuotaUsage, serviceId, usage.ResourceName, usage.CloudAccountId, updatedBefore); err != nil {
+			logger.Error(err, "failed to reset quota usage")
+			return status.Error(codes.Internal, "database transaction failed")
+		}
+	}
+	return nil
+}
+
+// GetReservedQuota deletes the expired reservations of a resource by a cloudaccount and returns the quantity held by
+// the remaining ones.
+func GetReservedQuota(ctx context.Context, tx *sql.Tx, serviceId, resourceName, cloudAccountId string) (int64, error) {
+	logger := log.FromContext(ctx).WithName("GetReservedQuota")
+	logger.Info("get reserved quota")
+
+	if _, err := tx.ExecContext(ctx, deleteExpiredQuotaReservations, serviceId, resourceName, cloudAccountId); err != nil {
+		logger.Error(err, "failed to delete expired quota reservations")
+		return 0, status.Error(codes.Internal, "database transaction failed")
+	}
+	var reserved int64
+	if err := tx.QueryRowContext(ctx, getReservedQuota, serviceId, resourceName, cloudAccountId).Scan(&reserved); err != nil {
+		logger.Error(err, "failed to get reserved quota")
+		return 0, status.Error(codes.Internal, "database transaction failed")
+	}
+	return reserved, nil
+}
+
+func InsertQuotaReservation(ctx context.Context, tx *sql.Tx, reservation *QuotaReservation, ttl time.Duration) error {
+	logger := log.FromContext(ctx).WithName("InsertQuotaReservation")
+	logger.Info("insert quota reservation", "reservationId", reservation.ReservationId)
+
+	err := tx.QueryRowContext(
+		ctx,
+		insertQuotaReservation,
+		reservation.ReservationId,
+		reservation.ServiceId,
+		reservation.ResourceName,
+		reservation.CloudAccountId,
+		reservation.Quantity,
+		ttl.Seconds(),
+	).Scan(
+		&reservation.ExpirationTimestamp,
+	)
+	if err != nil {
+		logger.Error(err, "failed to insert quota reservation")
+		return status.Error(codes.Internal, "database transaction failed")
+	}
+	return nil
+}
+
+func GetQuotaReservation(ctx context.Context, tx *sql.Tx, reservationId string) (*QuotaReservation, error) {
+	logger := log.FromContext(ctx).WithName("GetQuotaReservation")
+	logger.Info("get quota reservation", "reservationId", reservationId)
+
+	var reservation QuotaReservation
+	err := tx.QueryRowContext(
+		ctx,
+		getQuotaReservation,
+		reservationId,
+	).Scan(
+		&reservation.ReservationId,
+		&reservation.ServiceId,
+		&reservation.ResourceName,
+		&reservation.CloudAccountId,
+		&reservation.Quantity,
+		&reservation.ExpirationTimestamp,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "quota reservation not found")
+		}
+		logger.Error(err, "failed to get quota reservation")
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	return &reservation, nil
+}
+
+func DeleteQuotaReservation(ctx context.Context, tx *sql.Tx, reservationId string) error {
+	logger := log.FromContext(ctx).WithName("DeleteQuotaReservation")
+	logger.Info("delete quota reservation", "reservationId", reservationId)
+
+	result, err := tx.ExecContext(ctx, deleteQuotaReservation, reservationId)
+	if err != nil {
+		logger.Error(err, "failed to delete quota reservation")
+		return status.Error(codes.Internal, "database transaction failed")
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		// The reservation expired and was deleted by a concurrent reservation.
+		return status.Error(codes.NotFound, "quota reservation not found")
+	}
+	return nil
+}
@@ -31,17 +31,19 @@ var (
 	}
 )
 
-// QuotaManagementServiceClient is used to implement pb.UnimplementedQuotaManagementServiceServer & pb.UnimplementedQuotaManagementServiceServer
+// QuotaManagementServiceClient is used to implement pb.UnimplementedQuotaManagementServiceServer, pb.UnimplementedQuotaManagementPrivateServiceServer & pb.UnimplementedQuotaServiceServer
 type QuotaManagementServiceClient struct {
 	pb.UnimplementedQuotaManagementServiceServer
 	pb.UnimplementedQuotaManagementPrivateServiceServer
+	pb.UnimplementedQuotaServiceServer
 
 	session                   *sql.DB
 	cloudAccountServiceClient pb.CloudAccountServiceClient
+	notificationClient        pb.NotificationGatewayServiceClient
 	selectedRegion            string
 }
 
-func NewQuotaManagementServiceClient(ctx context.Context, session *sql.DB, cloudAccountSvc pb.CloudAccountServiceClient, selectedRegion string) (*QuotaManagementServiceClient, error) {
+func NewQuotaManagementServiceClient(ctx context.Context, session *sql.DB, cloudAccountSvc pb.CloudAccountServiceClient, notificationClient pb.NotificationGatewayServiceClient, selectedRegion string) (*QuotaManagementServiceClient, error) {
 	if session == nil {
 		return nil, fmt.Errorf("db session is required")
 	}
@@ -49,6 +51,7 @@ func NewQuotaManagementServiceClient(ctx context.Context, session *sql.DB, cloud
 	qmSrv := QuotaManagementServiceClient{
 		session:                   session,
 		cloudAccountServiceClient: cloudAccountSvc,
+		notificationClient:        notificationClient,
 		selectedRegion:            selectedRegion,
 	}
 
@@ -356,38 +359,7 @@ func (s *QuotaManagementServiceClient) CreateServiceQuota(ctx context.Context, r
 
 	defer tx.Rollback()
 
-	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
-		return nil, err
-	}
-
-	// check if the service resource already exists
-	referenceResource, err := query.GetServiceResource(ctx, tx, req.ServiceId, req.ServiceQuotaResource.ResourceType)
-	if err != nil {
-		logger.Error(err, "failed to get registered resource type", "resource name", req.ServiceQuotaResource.ResourceType, "service id", req.ServiceId)
-		return nil, status.Errorf(codes.Internal, "failed to create quota, add resource types that are not registered")
-	}
-
-	// check if requested quota limit exceeds max limit in service registration
-	if req.ServiceQuotaResource.QuotaConfig.Limits > referenceResource.MaxLimit {
-		return nil, status.Errorf(codes.InvalidArgument, "not allowed to create/update resource limit to more than that specified in service registration")
-	}
-
-	// fetch name and region for service
-	registeredService, err := query.GetRegisteredService(ctx, tx, req.ServiceId)
-	if err != nil {
-		logger.Error(err, "failed to get registered service")
-		return nil, err
-	}
-
-	logger.Info("registered service retrieved: ", "service in db : ", registeredService)
-
-	// generate unique rule id for this service
-	ruleId := s.generateUniqueServiceId()
-
-	serviceQuotaResourceInserted, err := query.InsertServiceResourceQuota(ctx, tx, registeredService.ServiceId, req.ServiceQuotaResource.ResourceType,
-		ruleId, req.ServiceQuotaResource.QuotaConfig.Limits, req.ServiceQuotaResource.QuotaConfig.QuotaUnit,
-		req.ServiceQuotaResource.Scope.ScopeType, req.ServiceQuotaResource.Scope.ScopeValue,
-		req.ServiceQuotaResource.Reason)
+	registeredService, serviceQuotaResourceInserted, err := s.createServiceQuota(ctx, tx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -421,6 +393,48 @@ func (s *QuotaManagementServiceClient) CreateServiceQuota(ctx context.Context, r
 
 }
 
+// createServiceQuota inserts the service quota of the request within tx, the caller commits it.
+func (s *QuotaManagementServiceClient) createServiceQuota(ctx context.Context, tx *sql.Tx, req *pb.CreateServiceQuotaRequest) (*query.RegisteredService, *query.ServiceQuotaResource, error) {
+	logger := log.FromContext(ctx).WithName("QuotaManagementService.createServiceQuota")
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, nil, err
+	}
+
+	// check if the service resource already exists
+	referenceResource, err := query.GetServiceResource(ctx, tx, req.ServiceId, req.ServiceQuotaResource.ResourceType)
+	if err != nil {
+		logger.Error(err, "failed to get registered resource type", "resource name", req.ServiceQuotaResource.ResourceType, "service id", req.ServiceId)
+		return nil, nil, status.Errorf(codes.Internal, "failed to create quota, add resource types that are not registered")
+	}
+
+	// check if requested quota limit exceeds max limit in service registration
+	if req.ServiceQuotaResource.QuotaConfig.Limits > referenceResource.MaxLimit {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "not allowed to create/update resource limit to more than that specified in service registration")
+	}
+
+	// fetch name and region for service
+	registeredService, err := query.GetRegisteredService(ctx, tx, req.ServiceId)
+	if err != nil {
+		logger.Error(err, "failed to get registered service")
+		return nil, nil, err
+	}
+
+	logger.Info("registered service retrieved: ", "service in db : ", registeredService)
+
+	// generate unique rule id for this service
+	ruleId := s.generateUniqueServiceId()
+
+	serviceQuotaResourceInserted, err := query.InsertServiceResourceQuota(ctx, tx, registeredService.ServiceId, req.ServiceQuotaResource.ResourceType,
+		ruleId, req.ServiceQuotaResource.QuotaConfig.Limits, req.ServiceQuotaResource.QuotaConfig.QuotaUnit,
+		req.ServiceQuotaResource.Scope.ScopeType, req.ServiceQuotaResource.Scope.ScopeValue,
+		req.ServiceQuotaResource.Reason)
+	if err != nil {
+		return nil, nil, err
+	}
+	return registeredService, serviceQuotaResourceInserted, nil
+}
+
 func (s *QuotaManagementServiceClient) GetServiceQuotaResource(ctx context.Context, req *pb.ServiceQuotaResourceRequest) (*pb.ServiceQuotaResourceResponse, error) {
 	_, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaManagementService.updateServiceRegistration").Start()
 	defer span.End()
@@ -526,6 +540,40 @@ func (s *QuotaManagementServiceClient) UpdateServiceQuotaResource(ctx context.Co
 
 	defer tx.Rollback()
 
+	updateServiceQuotaResource, err := s.updateServiceQuotaResource(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	updateQuotaServiceResponse := &pb.UpdateQuotaServiceResponse{
+		ServiceId: updateServiceQuotaResource.ServiceId,
+		ServiceQuotaResource: &pb.ServiceQuotaResource{
+			ResourceType: updateServiceQuotaResource.ResourceName,
+			QuotaConfig: &pb.QuotaConfig{
+				Limits:    updateServiceQuotaResource.Limits,
+				QuotaUnit: updateServiceQuotaResource.QuotaUnit,
+			},
+			Scope: &pb.QuotaScope{
+				ScopeType:  updateServiceQuotaResource.QuotaScope,
+				ScopeValue: updateServiceQuotaResource.QuotaScopeValue,
+			},
+			RuleId:      updateServiceQuotaResource.RuleId,
+			Reason:      updateServiceQuotaResource.Reason,
+			CreatedTime: timestamppb.New(updateServiceQuotaResource.CreatedTimestamp),
+			UpdatedTime: timestamppb.New(updateServiceQuotaResource.UpdateTimestamp),
+		},
+	}
+	return updateQuotaServiceResponse, nil
+
+}
+
+// updateServiceQuotaResource updates the limits of the service quota rule of the request within tx, the caller commits
+// it.
+func (s *QuotaManagementServiceClient) updateServiceQuotaResource(ctx context.Context, tx *sql.Tx, req *pb.UpdateQuotaServiceRequest) (*query.ServiceQuotaResource, error) {
+	logger := log.FromContext(ctx).WithName("QuotaManagementService.updateServiceQuotaResource")
+
 	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
 		return nil, err
 	}
@@ -565,30 +613,7 @@ func (s *QuotaManagementServiceClient) UpdateServiceQuotaResource(ctx context.Co
 		logger.Error(err, "update of service resource quota failed")
 		return nil, status.Error(codes.Internal, "database transaction failed")
 	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, status.Error(codes.Internal, "database transaction failed")
-	}
-	updateQuotaServiceResponse := &pb.UpdateQuotaServiceResponse{
-		ServiceId: updateServiceQuotaResource.ServiceId,
-		ServiceQuotaResource: &pb.ServiceQuotaResource{
-			ResourceType: updateServiceQuotaResource.ResourceName,
-			QuotaConfig: &pb.QuotaConfig{
-				Limits:    updateServiceQuotaResource.Limits,
-				QuotaUnit: updateServiceQuotaResource.QuotaUnit,
-			},
-			Scope: &pb.QuotaScope{
-				ScopeType:  updateServiceQuotaResource.QuotaScope,
-				ScopeValue: updateServiceQuotaResource.QuotaScopeValue,
-			},
-			RuleId:      updateServiceQuotaResource.RuleId,
-			Reason:      updateServiceQuotaResource.Reason,
-			CreatedTime: timestamppb.New(updateServiceQuotaResource.CreatedTimestamp),
-			UpdatedTime: timestamppb.New(updateServiceQuotaResource.UpdateTimestamp),
-		},
-	}
-	return updateQuotaServiceResponse, nil
-
+	return updateServiceQuotaResource, nil
 }
 
 func (s *QuotaManagementServiceClient) ListServiceQuota(ctx context.Context, req *pb.ListServiceQuotaRequest) (*pb.ListServiceQuotaResponse, error) {
@@ -821,6 +846,56 @@ func (s *QuotaManagementServiceClient) validateServiceRequest(ctx context.Contex
 			return status.Errorf(codes.InvalidArgument, "private service quota could not be fetched due to invalid request")
 		}
 	}
+	if req, ok := obj.(*pb.QuotaReservationRequestPrivate); ok {
+		if req.ServiceName == "" || req.ResourceType == "" || req.CloudAccountId == "" || req.Quantity <= 0 || req.CurrentUsage < 0 || req.TtlSeconds < 0 || req.LimitsScale < 0 {
+			return status.Errorf(codes.InvalidArgument, "quota could not be reserved due to invalid request")
+		}
+	}
+	if req, ok := obj.(*pb.QuotaReservationReferencePrivate); ok {
+		if req.ReservationId == "" {
+			return status.Errorf(codes.InvalidArgument, "quota reservation could not be committed due to invalid request")
+		}
+	}
+	if req, ok := obj.(*pb.QuotaReleaseRequestPrivate); ok {
+		if req.ReservationId == "" && (req.ServiceName == "" || req.ResourceType == "" || req.CloudAccountId == "" || req.Quantity <= 0) {
+			return status.Errorf(codes.InvalidArgument, "quota could not be released due to invalid request")
+		}
+	}
+	if req, ok := obj.(*pb.QuotaUsageReconcileRequestPrivate); ok {
+		if req.ServiceName == "" || req.UpdatedBefore == nil {
+			return status.Errorf(codes.InvalidArgument, "quota usage could not be reconciled due to invalid request")
+		}
+		for _, usage := range req.Usages {
+			if usage.ResourceType == "" || usage.CloudAccountId == "" || usage.Usage < 0 || usage.LimitsScale < 0 {
+				return status.Errorf(codes.InvalidArgument, "quota usage could not be reconciled due to invalid request")
+			}
+		}
+	}
+	if req, ok := obj.(*pb.GetMyQuotasRequest); ok {
+		if req.CloudAccountId == "" {
+			return status.Errorf(codes.InvalidArgument, "quotas could not be fetched due to invalid request")
+		}
+	}
+	if req, ok := obj.(*pb.QuotaIncreaseRequestSubmission); ok {
+		if req.CloudAccountId == "" || req.ServiceName == "" || req.ResourceType == "" || req.RequestedLimit <= 0 || req.Justification == "" {
+			return status.Errorf(codes.InvalidArgument, "quota increase request could not be submitted due to invalid request")
+		}
+	}
+	if req, ok := obj.(*pb.ListMyQuotaIncreaseRequestsRequest); ok {
+		if req.CloudAccountId == "" {
+			return status.Errorf(codes.InvalidArgument, "quota increase requests could not be listed due to invalid request")
+		}
+	}
+	if req, ok := obj.(*pb.GetMyQuotaIncreaseRequestRequest); ok {
+		if req.CloudAccountId == "" || req.RequestId == "" {
+			return status.Errorf(codes.InvalidArgument, "quota increase request could not be fetched due to invalid request")
+		}
+	}
+	if req, ok := obj.(*pb.QuotaIncreaseRequestReview); ok {
+		if req.RequestId == "" {
+			return status.Errorf(codes.InvalidArgument, "quota increase request could not be reviewed due to invalid request")
+		}
+	}
 	return nil
 }
 
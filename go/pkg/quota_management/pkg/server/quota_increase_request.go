@@ -0,0 +1,365 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/quota_management/database/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	quotaIncreaseRequestTopicName    = "idc-quota-increase-requests-topic"
+	quotaIncreaseRequestEventSubType = "QUOTA_INCREASE_REQUEST"
+)
+
+func (s *QuotaManagementServiceClient) GetMyQuotas(ctx context.Context, req *pb.GetMyQuotasRequest) (*pb.GetMyQuotasResponse, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaService.GetMyQuotas").Start()
+	defer span.End()
+	logger.Info("entering get my quotas")
+	defer logger.Info("returning from get my quotas")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	registeredServices, err := query.ListRegisteredServices(ctx, tx)
+	if err != nil {
+		logger.Error(err, "failed to list registered services")
+		return nil, err
+	}
+	usages, err := query.GetQuotaUsages(ctx, tx, req.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetMyQuotasResponse{Quotas: []*pb.QuotaUsage{}}
+	for _, registeredService := range registeredServices {
+		serviceResources, err := query.ListServiceResources(ctx, tx, registeredService.ServiceId)
+		if err != nil {
+			logger.Error(err, "failed to list service resources", "serviceName", registeredService.ServiceName)
+			return nil, status.Error(codes.Internal, "database transaction failed")
+		}
+		serviceQuotas, err := query.GetServiceResourceQuotasPrivate(ctx, tx, registeredService.ServiceId, registeredService.ServiceName, "", req.CloudAccountId, s.cloudAccountServiceClient)
+		if err != nil {
+			// The cloudaccount has no quota for the resources of this service.
+			logger.Info("no quotas found for service", "serviceName", registeredService.ServiceName, "error", err)
+			continue
+		}
+		limits := map[string]int64{}
+		for _, quota := range serviceQuotas.GetDefaultQuota().GetServiceResources() {
+			limits[quota.ResourceType] = quota.GetQuotaConfig().GetLimits()
+		}
+		// Custom quotas of the cloudaccount override the defaults of its account type.
+		for _, quota := range serviceQuotas.GetCustomQuota().GetServiceResources() {
+			limits[quota.ResourceType] = quota.GetQuotaConfig().GetLimits()
+		}
+		for _, serviceResource := range serviceResources {
+			limit, ok := limits[serviceResource.Name]
+			if !ok {
+				continue
+			}
+			resp.Quotas = append(resp.Quotas, &pb.QuotaUsage{
+				ServiceName:  registeredService.ServiceName,
+				ResourceType: serviceResource.Name,
+				QuotaUnit:    serviceResource.QuotaUnit,
+				Limits:       limit,
+				Usage:        usages[registeredService.ServiceId][serviceResource.Name],
+				MaxLimit:     serviceResource.MaxLimit,
+			})
+		}
+	}
+	return resp, nil
+}
+
+func (s *QuotaManagementServiceClient) SubmitQuotaIncreaseRequest(ctx context.Context, req *pb.QuotaIncreaseRequestSubmission) (*pb.QuotaIncreaseRequest, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaService.SubmitQuotaIncreaseRequest").Start()
+	defer span.End()
+	logger.Info("entering submit quota increase request")
+	defer logger.Info("returning from submit quota increase request")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	logger.Info("submit quota increase request params", "request details:", req)
+	registeredService, err := query.GetRegisteredServiceByName(ctx, tx, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to get registered service")
+		return nil, err
+	}
+	serviceResource, err := query.GetServiceResource(ctx, tx, registeredService.ServiceId, req.ResourceType)
+	if err != nil {
+		logger.Error(err, "failed to get registered resource type", "resource name", req.ResourceType)
+		return nil, err
+	}
+	if req.RequestedLimit > serviceResource.MaxLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "requested limit can not be more than %d", serviceResource.MaxLimit)
+	}
+
+	// A resource without a quota for the cloudaccount has a current limit of 0.
+	var currentLimit int64
+	serviceQuotas, err := query.GetServiceResourceQuotasPrivate(ctx, tx, registeredService.ServiceId, registeredService.ServiceName, req.ResourceType, req.CloudAccountId, s.cloudAccountServiceClient)
+	if err == nil {
+		currentLimit, _ = getQuotaLimits(serviceQuotas)
+	}
+	if req.RequestedLimit <= currentLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "requested limit must be more than the current limit of %d", currentLimit)
+	}
+
+	request := &query.QuotaIncreaseRequest{
+		RequestId:      uuid.NewString(),
+		CloudAccountId: req.CloudAccountId,
+		ServiceId:      registeredService.ServiceId,
+		ServiceName:    registeredService.ServiceName,
+		ResourceName:   req.ResourceType,
+		CurrentLimit:   currentLimit,
+		RequestedLimit: req.RequestedLimit,
+		Justification:  req.Justification,
+	}
+	if err := query.InsertQuotaIncreaseRequest(ctx, tx, request); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing db transaction")
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	return request.ToPb(), nil
+}
+
+func (s *QuotaManagementServiceClient) ListMyQuotaIncreaseRequests(ctx context.Context, req *pb.ListMyQuotaIncreaseRequestsRequest) (*pb.QuotaIncreaseRequestList, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaService.ListMyQuotaIncreaseRequests").Start()
+	defer span.End()
+	logger.Info("entering list my quota increase requests")
+	defer logger.Info("returning from list my quota increase requests")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	requests, err := query.ListQuotaIncreaseRequests(ctx, tx, req.CloudAccountId, "")
+	if err != nil {
+		return nil, err
+	}
+	return quotaIncreaseRequestsToPb(requests), nil
+}
+
+func (s *QuotaManagementServiceClient) GetMyQuotaIncreaseRequest(ctx context.Context, req *pb.GetMyQuotaIncreaseRequestRequest) (*pb.QuotaIncreaseRequest, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaService.GetMyQuotaIncreaseRequest").Start()
+	defer span.End()
+	logger.Info("entering get my quota increase request")
+	defer logger.Info("returning from get my quota increase request")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	request, err := query.GetQuotaIncreaseRequest(ctx, tx, req.RequestId, req.CloudAccountId, false)
+	if err != nil {
+		return nil, err
+	}
+	return request.ToPb(), nil
+}
+
+func (s *QuotaManagementServiceClient) ListQuotaIncreaseRequests(ctx context.Context, req *pb.ListQuotaIncreaseRequestsRequest) (*pb.QuotaIncreaseRequestList, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaManagementService.ListQuotaIncreaseRequests").Start()
+	defer span.End()
+	logger.Info("entering list quota increase requests")
+	defer logger.Info("returning from list quota increase requests")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	requests, err := query.ListQuotaIncreaseRequests(ctx, tx, req.CloudAccountId, query.QuotaIncreaseRequestStateFromPb(req.State))
+	if err != nil {
+		return nil, err
+	}
+	return quotaIncreaseRequestsToPb(requests), nil
+}
+
+func (s *QuotaManagementServiceClient) ApproveQuotaIncreaseRequest(ctx context.Context, req *pb.QuotaIncreaseRequestReview) (*pb.QuotaIncreaseRequest, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaManagementService.ApproveQuotaIncreaseRequest").Start()
+	defer span.End()
+	logger.Info("entering approve quota increase request")
+	defer logger.Info("returning from approve quota increase request")
+
+	return s.reviewQuotaIncreaseRequest(ctx, req, query.QuotaIncreaseRequestApproved)
+}
+
+func (s *QuotaManagementServiceClient) RejectQuotaIncreaseRequest(ctx context.Context, req *pb.QuotaIncreaseRequestReview) (*pb.QuotaIncreaseRequest, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaManagementService.RejectQuotaIncreaseRequest").Start()
+	defer span.End()
+	logger.Info("entering reject quota increase request")
+	defer logger.Info("returning from reject quota increase request")
+
+	return s.reviewQuotaIncreaseRequest(ctx, req, query.QuotaIncreaseRequestRejected)
+}
+
+// reviewQuotaIncreaseRequest moves a pending request to the approved or rejected state and notifies the cloudaccount.
+// The request stays locked while the quota of an approved request is applied, so that it is applied once.
+func (s *QuotaManagementServiceClient) reviewQuotaIncreaseRequest(ctx context.Context, req *pb.QuotaIncreaseRequestReview, state query.QuotaIncreaseRequestState) (*pb.QuotaIncreaseRequest, error) {
+	logger := log.FromContext(ctx).WithName("QuotaManagementService.reviewQuotaIncreaseRequest")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	request, err := query.GetQuotaIncreaseRequest(ctx, tx, req.RequestId, "", true)
+	if err != nil {
+		return nil, err
+	}
+	if request.State != query.QuotaIncreaseRequestPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "quota increase request is already %s", request.State)
+	}
+
+	if state == query.QuotaIncreaseRequestApproved {
+		if err := s.applyQuotaIncrease(ctx, tx, request); err != nil {
+			logger.Error(err, "failed to apply quota increase", "requestId", request.RequestId)
+			return nil, err
+		}
+	}
+	if err := query.UpdateQuotaIncreaseRequestState(ctx, tx, request, state, req.ReviewComment); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing db transaction")
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+
+	s.notifyQuotaIncreaseRequest(ctx, request)
+	return request.ToPb(), nil
+}
+
+// applyQuotaIncrease updates the custom quota of the cloudaccount for the resource to the requested limit, or creates
+// it if the cloudaccount only has the default quota of its account type. The quota is written within tx so that it is
+// committed together with the state of the request.
+func (s *QuotaManagementServiceClient) applyQuotaIncrease(ctx context.Context, tx *sql.Tx, request *query.QuotaIncreaseRequest) error {
+	serviceQuotas, err := query.GetServiceResourceQuotas(ctx, tx, request.ServiceId, request.ResourceName)
+	if err != nil {
+		return status.Error(codes.Internal, "database transaction failed")
+	}
+	serviceResource, err := query.GetServiceResource(ctx, tx, request.ServiceId, request.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	reason := fmt.Sprintf("quota increase request %s", request.RequestId)
+	for _, quota := range serviceQuotas {
+		if quota.QuotaScope == string(query.ScopeAccountId) && quota.QuotaScopeValue == request.CloudAccountId {
+			_, err := s.updateServiceQuotaResource(ctx, tx, &pb.UpdateQuotaServiceRequest{
+				ServiceId:    request.ServiceId,
+				ResourceType: request.ResourceName,
+				RuleId:       quota.RuleId,
+				QuotaConfig:  &pb.QuotaConfig{Limits: request.RequestedLimit, QuotaUnit: quota.QuotaUnit},
+				Reason:       reason,
+			})
+			return err
+		}
+	}
+	_, _, err = s.createServiceQuota(ctx, tx, &pb.CreateServiceQuotaRequest{
+		ServiceId: request.ServiceId,
+		ServiceQuotaResource: &pb.ServiceQuotaResource{
+			ResourceType: request.ResourceName,
+			QuotaConfig:  &pb.QuotaConfig{Limits: request.RequestedLimit, QuotaUnit: serviceResource.QuotaUnit},
+			Scope:        &pb.QuotaScope{ScopeType: string(query.ScopeAccountId), ScopeValue: request.CloudAccountId},
+			Reason:       reason,
+		},
+	})
+	return err
+}
+
+func (s *QuotaManagementServiceClient) notifyQuotaIncreaseRequest(ctx context.Context, request *query.QuotaIncreaseRequest) {
+	logger := log.FromContext(ctx).WithName("QuotaManagementService.notifyQuotaIncreaseRequest")
+
+	if s.notificationClient == nil {
+		logger.Info("notification gateway is not configured, quota increase request event not published")
+		return
+	}
+	eventName := "QuotaIncreaseRequestApproved"
+	message := fmt.Sprintf("Your request to increase the %s %s quota to %d has been approved",
+		request.ServiceName, request.ResourceName, request.RequestedLimit)
+	if request.State == query.QuotaIncreaseRequestRejected {
+		eventName = "QuotaIncreaseRequestRejected"
+		message = fmt.Sprintf("Your request to increase the %s %s quota to %d has been rejected",
+			request.ServiceName, request.ResourceName, request.RequestedLimit)
+	}
+	if request.ReviewComment != "" {
+		message = fmt.Sprintf("%s: %s", message, request.ReviewComment)
+	}
+	severity := pb.EventSeverity_LOW
+	_, err := s.notificationClient.PublishEvent(ctx, &pb.PublishEventRequest{
+		TopicName: quotaIncreaseRequestTopicName,
+		Subject:   eventName,
+		CreateEvent: &pb.CreateEvent{
+			EventName:      eventName,
+			Status:         pb.EventStatus_ACTIVE,
+			Type:           pb.EventType_NOTIFICATION,
+			Severity:       &severity,
+			Message:        &message,
+			EventSubType:   quotaIncreaseRequestEventSubType,
+			ClientRecordId: uuid.NewString(),
+			CloudAccountId: &request.CloudAccountId,
+			Region:         &s.selectedRegion,
+			Properties: map[string]string{
+				"requestId":      request.RequestId,
+				"serviceName":    request.ServiceName,
+				"resourceType":   request.ResourceName,
+				"requestedLimit": fmt.Sprint(request.RequestedLimit),
+				"state":          string(request.State),
+			},
+		},
+	})
+	if err != nil {
+		// The review is not undone when the notification fails.
+		logger.Error(err, "failed to publish quota increase request event", "requestId", request.RequestId)
+	}
+}
+
+func quotaIncreaseRequestsToPb(requests []*query.QuotaIncreaseRequest) *pb.QuotaIncreaseRequestList {
+	resp := &pb.QuotaIncreaseRequestList{Requests: []*pb.QuotaIncreaseRequest{}}
+	for _, request := range requests {
+		resp.Requests = append(resp.Requests, request.ToPb())
+	}
+	return resp
+}
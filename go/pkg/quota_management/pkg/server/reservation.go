@@ -0,0 +1,237 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/quota_management/database/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultQuotaReservationTtl = 5 * time.Minute
+
+func (s *QuotaManagementServiceClient) ReserveQuotaPrivate(ctx context.Context, req *pb.QuotaReservationRequestPrivate) (*pb.QuotaReservationPrivate, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaManagementService.ReserveQuotaPrivate").Start()
+	defer span.End()
+	logger.Info("entering reserve quota private")
+	defer logger.Info("returning reserve quota private")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	logger.Info("reserve quota private params", "request details:", req)
+	registeredService, err := query.GetRegisteredServiceByName(ctx, tx, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to get registered service")
+		return nil, err
+	}
+
+	serviceQuotas, err := query.GetServiceResourceQuotasPrivate(ctx, tx, registeredService.ServiceId, registeredService.ServiceName, req.ResourceType, req.CloudAccountId, s.cloudAccountServiceClient)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := getQuotaLimits(serviceQuotas)
+	if err != nil {
+		return nil, err
+	}
+	limitsScale := max(req.LimitsScale, 1)
+
+	// Locking the usage serializes the reservations of the resource for the cloudaccount across replicas.
+	usage, err := query.LockQuotaUsage(ctx, tx, registeredService.ServiceId, req.ResourceType, req.CloudAccountId, req.CurrentUsage, limitsScale)
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := query.GetReservedQuota(ctx, tx, registeredService.ServiceId, req.ResourceType, req.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("quota usage", "limits", limits, "limitsScale", limitsScale, "usage", usage, "reserved", reserved, "quantity", req.Quantity)
+	if usage+reserved+req.Quantity > limits*limitsScale {
+		return nil, status.Errorf(codes.ResourceExhausted, "quota limit of %d %s exceeded", limits, req.ResourceType)
+	}
+
+	ttl := defaultQuotaReservationTtl
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+	reservation := &query.QuotaReservation{
+		ReservationId:  uuid.NewString(),
+		ServiceId:      registeredService.ServiceId,
+		ResourceName:   req.ResourceType,
+		CloudAccountId: req.CloudAccountId,
+		Quantity:       req.Quantity,
+	}
+	if err := query.InsertQuotaReservation(ctx, tx, reservation, ttl); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing db transaction")
+		return nil, status.Errorf(codes.Internal, "quota reservation failed")
+	}
+
+	resp := reservationToPb(reservation, registeredService.ServiceName, usage)
+	resp.Limits = limits
+	return resp, nil
+}
+
+func (s *QuotaManagementServiceClient) CommitQuotaReservationPrivate(ctx context.Context, req *pb.QuotaReservationReferencePrivate) (*pb.QuotaReservationPrivate, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaManagementService.CommitQuotaReservationPrivate").Start()
+	defer span.End()
+	logger.Info("entering commit quota reservation private")
+	defer logger.Info("returning commit quota reservation private")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	reservation, err := query.GetQuotaReservation(ctx, tx, req.ReservationId)
+	if err != nil {
+		return nil, err
+	}
+	registeredService, err := query.GetRegisteredService(ctx, tx, reservation.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+	// The usage is locked before the reservation, in the same order as ReserveQuotaPrivate.
+	if _, err := query.LockQuotaUsage(ctx, tx, reservation.ServiceId, reservation.ResourceName, reservation.CloudAccountId, 0, 1); err != nil {
+		return nil, err
+	}
+	if err := query.DeleteQuotaReservation(ctx, tx, reservation.ReservationId); err != nil {
+		return nil, err
+	}
+	usage, err := query.UpdateQuotaUsage(ctx, tx, reservation.ServiceId, reservation.ResourceName, reservation.CloudAccountId, reservation.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing db transaction")
+		return nil, status.Errorf(codes.Internal, "quota reservation commit failed")
+	}
+	return reservationToPb(reservation, registeredService.ServiceName, usage), nil
+}
+
+func (s *QuotaManagementServiceClient) ReleaseQuotaPrivate(ctx context.Context, req *pb.QuotaReleaseRequestPrivate) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaManagementService.ReleaseQuotaPrivate").Start()
+	defer span.End()
+	logger.Info("entering release quota private")
+	defer logger.Info("returning release quota private")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	logger.Info("release quota private params", "request details:", req)
+	if req.ReservationId != "" {
+		// Releasing an expired reservation is not an error.
+		if err := query.DeleteQuotaReservation(ctx, tx, req.ReservationId); err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+	} else {
+		registeredService, err := query.GetRegisteredServiceByName(ctx, tx, req.ServiceName)
+		if err != nil {
+			logger.Error(err, "failed to get registered service")
+			return nil, err
+		}
+		if _, err := query.UpdateQuotaUsage(ctx, tx, registeredService.ServiceId, req.ResourceType, req.CloudAccountId, -req.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing db transaction")
+		return nil, status.Errorf(codes.Internal, "quota release failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *QuotaManagementServiceClient) ReconcileQuotaUsagePrivate(ctx context.Context, req *pb.QuotaUsageReconcileRequestPrivate) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("QuotaManagementService.ReconcileQuotaUsagePrivate").Start()
+	defer span.End()
+	logger.Info("entering reconcile quota usage private")
+	defer logger.Info("returning reconcile quota usage private")
+
+	tx, err := s.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "database transaction failed")
+	}
+	defer tx.Rollback()
+
+	if err := s.validateServiceRequest(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
+	registeredService, err := query.GetRegisteredServiceByName(ctx, tx, req.ServiceName)
+	if err != nil {
+		logger.Error(err, "failed to get registered service")
+		return nil, err
+	}
+	counts := make([]query.QuotaUsageCount, 0, len(req.Usages))
+	for _, usage := range req.Usages {
+		counts = append(counts, query.QuotaUsageCount{
+			ResourceName:   usage.ResourceType,
+			CloudAccountId: usage.CloudAccountId,
+			Usage:          usage.Usage,
+			LimitsScale:    max(usage.LimitsScale, 1),
+		})
+	}
+	if err := query.ReconcileQuotaUsage(ctx, tx, registeredService.ServiceId, counts, req.UpdatedBefore.AsTime()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing db transaction")
+		return nil, status.Errorf(codes.Internal, "quota usage reconciliation failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getQuotaLimits returns the limit of the custom quota of the resource if there is one, or else of the default quota.
+func getQuotaLimits(serviceQuotas *pb.ServiceQuotasPrivate) (int64, error) {
+	for _, quota := range []*pb.ServiceQuotaPrivate{serviceQuotas.GetCustomQuota(), serviceQuotas.GetDefaultQuota()} {
+		if resources := quota.GetServiceResources(); len(resources) > 0 && resources[0].GetQuotaConfig() != nil {
+			return resources[0].GetQuotaConfig().GetLimits(), nil
+		}
+	}
+	return 0, status.Errorf(codes.NotFound, "quota not found for resource")
+}
+
+func reservationToPb(reservation *query.QuotaReservation, serviceName string, usage int64) *pb.QuotaReservationPrivate {
+	return &pb.QuotaReservationPrivate{
+		ReservationId:  reservation.ReservationId,
+		ServiceName:    serviceName,
+		ResourceType:   reservation.ResourceName,
+		CloudAccountId: reservation.CloudAccountId,
+		Quantity:       reservation.Quantity,
+		ExpirationTime: timestamppb.New(reservation.ExpirationTimestamp),
+		Usage:          usage,
+	}
+}
@@ -75,12 +75,13 @@ type Service struct {
 }
 
 type Config struct {
-	ListenPort             uint16           `koanf:"listenPort"`
-	Database               manageddb.Config `koanf:"database"`
-	CloudaccountServerAddr string           `koanf:"cloudaccountServerAddr"`
-	TestMode               bool
-	SelectedRegion         string                 `koanf:"selectedRegion"`
-	BootstrappedServices   []*BootstrappedService `koanf:"bootstrappedServices"`
+	ListenPort              uint16           `koanf:"listenPort"`
+	Database                manageddb.Config `koanf:"database"`
+	CloudaccountServerAddr  string           `koanf:"cloudaccountServerAddr"`
+	NotificationGatewayAddr string           `koanf:"notificationGatewayAddr"`
+	TestMode                bool
+	SelectedRegion          string                 `koanf:"selectedRegion"`
+	BootstrappedServices    []*BootstrappedService `koanf:"bootstrappedServices"`
 }
 
 func (config *Config) GetListenPort() uint16 {
@@ -131,12 +132,20 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 	// defer cloudaccountClientConn.Close()
 	cloudAccountServiceClient := v1.NewCloudAccountServiceClient(cloudaccountClientConn)
 
-	quotaManagementSrv, err := NewQuotaManagementServiceClient(ctx, sqlDB, cloudAccountServiceClient, cfg.SelectedRegion)
+	// Connect to Notification Gateway Service
+	var notificationClient v1.NotificationGatewayServiceClient
+	if cfg.NotificationGatewayAddr != "" {
+		notificationClientConn := newClient(ctx, cfg.NotificationGatewayAddr, dialOptions...)
+		notificationClient = v1.NewNotificationGatewayServiceClient(notificationClientConn)
+	}
+
+	quotaManagementSrv, err := NewQuotaManagementServiceClient(ctx, sqlDB, cloudAccountServiceClient, notificationClient, cfg.SelectedRegion)
 	if err != nil {
 		return err
 	}
 	v1.RegisterQuotaManagementServiceServer(grpcServer, quotaManagementSrv)
 	v1.RegisterQuotaManagementPrivateServiceServer(grpcServer, quotaManagementSrv)
+	v1.RegisterQuotaServiceServer(grpcServer, quotaManagementSrv)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcServer)
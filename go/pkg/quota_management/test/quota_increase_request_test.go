@@ -0,0 +1,177 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("QuotaService", func() {
+	Context("QuotaIncreaseRequest", func() {
+		It("Should submit, approve and reject quota increase requests", func() {
+			ctx := context.Background()
+			svcName := "test-qir-" + time.Now().Format("02030405")
+			cloudAccountId := "123456789012"
+			resp, err := quotaManagementSvc.Register(ctx, &pb.ServiceQuotaRegistrationRequest{
+				ServiceName: svcName,
+				Region:      "us-region-1",
+				ServiceResources: []*pb.ServiceResource{
+					{
+						Name:      "instances",
+						QuotaUnit: "COUNT",
+						MaxLimit:  20,
+					},
+					{
+						Name:      "volumes",
+						QuotaUnit: "COUNT",
+						MaxLimit:  20,
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = quotaManagementSvc.CreateServiceQuota(ctx, &pb.CreateServiceQuotaRequest{
+				ServiceId: resp.ServiceId,
+				ServiceQuotaResource: &pb.ServiceQuotaResource{
+					ResourceType: "instances",
+					QuotaConfig:  &pb.QuotaConfig{QuotaUnit: "COUNT", Limits: 5},
+					Scope:        &pb.QuotaScope{ScopeType: "QUOTA_ACCOUNT_ID", ScopeValue: cloudAccountId},
+					Reason:       "testing",
+				},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, &pb.QuotaReservationRequestPrivate{
+				ServiceName:    svcName,
+				ResourceType:   "instances",
+				CloudAccountId: cloudAccountId,
+				Quantity:       1,
+				CurrentUsage:   2,
+			})
+			Expect(err).To(BeNil())
+
+			By("Getting the quotas and usage of the cloudaccount")
+			myQuotas, err := quotaManagementSvc.GetMyQuotas(ctx, &pb.GetMyQuotasRequest{CloudAccountId: cloudAccountId})
+			Expect(err).To(BeNil())
+			var instancesQuota *pb.QuotaUsage
+			for _, quota := range myQuotas.Quotas {
+				if quota.ServiceName == svcName && quota.ResourceType == "instances" {
+					instancesQuota = quota
+				}
+			}
+			Expect(instancesQuota).NotTo(BeNil())
+			Expect(instancesQuota.Limits).To(Equal(int64(5)))
+			Expect(instancesQuota.Usage).To(Equal(int64(2)))
+			Expect(instancesQuota.MaxLimit).To(Equal(int64(20)))
+
+			By("Submitting a quota increase request")
+			submission := &pb.QuotaIncreaseRequestSubmission{
+				CloudAccountId: cloudAccountId,
+				ServiceName:    svcName,
+				ResourceType:   "instances",
+				RequestedLimit: 10,
+				Justification:  "training a model",
+			}
+			request, err := quotaManagementSvc.SubmitQuotaIncreaseRequest(ctx, submission)
+			Expect(err).To(BeNil())
+			Expect(request.State).To(Equal(pb.QuotaIncreaseRequestState_QUOTA_INCREASE_REQUEST_STATE_PENDING))
+			Expect(request.CurrentLimit).To(Equal(int64(5)))
+			_, err = quotaManagementSvc.SubmitQuotaIncreaseRequest(ctx, submission)
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+
+			myRequests, err := quotaManagementSvc.ListMyQuotaIncreaseRequests(ctx, &pb.ListMyQuotaIncreaseRequestsRequest{CloudAccountId: cloudAccountId})
+			Expect(err).To(BeNil())
+			Expect(myRequests.Requests).NotTo(BeEmpty())
+			pendingRequests, err := quotaManagementSvc.ListQuotaIncreaseRequests(ctx, &pb.ListQuotaIncreaseRequestsRequest{
+				State: pb.QuotaIncreaseRequestState_QUOTA_INCREASE_REQUEST_STATE_PENDING,
+			})
+			Expect(err).To(BeNil())
+			Expect(pendingRequests.Requests).To(ContainElement(HaveField("RequestId", request.RequestId)))
+
+			By("Approving the request")
+			approved, err := quotaManagementSvc.ApproveQuotaIncreaseRequest(ctx, &pb.QuotaIncreaseRequestReview{RequestId: request.RequestId, ReviewComment: "ok"})
+			Expect(err).To(BeNil())
+			Expect(approved.State).To(Equal(pb.QuotaIncreaseRequestState_QUOTA_INCREASE_REQUEST_STATE_APPROVED))
+			quotas, err := quotaManagementSvc.GetResourceQuotaPrivate(ctx, &pb.ServiceQuotaResourceRequestPrivate{
+				ServiceName:    svcName,
+				ResourceType:   "instances",
+				CloudAccountId: cloudAccountId,
+			})
+			Expect(err).To(BeNil())
+			Expect(quotas.CustomQuota.ServiceResources).To(HaveLen(1))
+			Expect(quotas.CustomQuota.ServiceResources[0].QuotaConfig.Limits).To(Equal(int64(10)))
+			_, err = quotaManagementSvc.RejectQuotaIncreaseRequest(ctx, &pb.QuotaIncreaseRequestReview{RequestId: request.RequestId})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			By("Approving a request for a resource without a custom quota")
+			request, err = quotaManagementSvc.SubmitQuotaIncreaseRequest(ctx, &pb.QuotaIncreaseRequestSubmission{
+				CloudAccountId: cloudAccountId,
+				ServiceName:    svcName,
+				ResourceType:   "volumes",
+				RequestedLimit: 4,
+				Justification:  "storing a dataset",
+			})
+			Expect(err).To(BeNil())
+			Expect(request.CurrentLimit).To(Equal(int64(0)))
+			_, err = quotaManagementSvc.ApproveQuotaIncreaseRequest(ctx, &pb.QuotaIncreaseRequestReview{RequestId: request.RequestId})
+			Expect(err).To(BeNil())
+			quotas, err = quotaManagementSvc.GetResourceQuotaPrivate(ctx, &pb.ServiceQuotaResourceRequestPrivate{
+				ServiceName:    svcName,
+				ResourceType:   "volumes",
+				CloudAccountId: cloudAccountId,
+			})
+			Expect(err).To(BeNil())
+			Expect(quotas.CustomQuota.ServiceResources[0].QuotaConfig.Limits).To(Equal(int64(4)))
+
+			By("Getting the usage of resources created before their first reservation")
+			_, err = quotaManagementSvc.ReconcileQuotaUsagePrivate(ctx, &pb.QuotaUsageReconcileRequestPrivate{
+				ServiceName:   svcName,
+				Usages:        []*pb.QuotaUsageCountPrivate{{ResourceType: "volumes", CloudAccountId: cloudAccountId, Usage: 3}},
+				UpdatedBefore: timestamppb.Now(),
+			})
+			Expect(err).To(BeNil())
+			myQuotas, err = quotaManagementSvc.GetMyQuotas(ctx, &pb.GetMyQuotasRequest{CloudAccountId: cloudAccountId})
+			Expect(err).To(BeNil())
+			Expect(myQuotas.Quotas).To(ContainElement(And(
+				HaveField("ServiceName", svcName),
+				HaveField("ResourceType", "volumes"),
+				HaveField("Limits", int64(4)),
+				HaveField("Usage", int64(3)),
+			)))
+
+			By("Rejecting a request")
+			submission.RequestedLimit = 15
+			request, err = quotaManagementSvc.SubmitQuotaIncreaseRequest(ctx, submission)
+			Expect(err).To(BeNil())
+			Expect(request.CurrentLimit).To(Equal(int64(10)))
+			rejected, err := quotaManagementSvc.RejectQuotaIncreaseRequest(ctx, &pb.QuotaIncreaseRequestReview{RequestId: request.RequestId, ReviewComment: "not now"})
+			Expect(err).To(BeNil())
+			Expect(rejected.State).To(Equal(pb.QuotaIncreaseRequestState_QUOTA_INCREASE_REQUEST_STATE_REJECTED))
+			Expect(rejected.ReviewComment).To(Equal("not now"))
+			myRequest, err := quotaManagementSvc.GetMyQuotaIncreaseRequest(ctx, &pb.GetMyQuotaIncreaseRequestRequest{CloudAccountId: cloudAccountId, RequestId: request.RequestId})
+			Expect(err).To(BeNil())
+			Expect(myRequest.State).To(Equal(pb.QuotaIncreaseRequestState_QUOTA_INCREASE_REQUEST_STATE_REJECTED))
+
+			By("Rejecting invalid requests")
+			submission.RequestedLimit = 8
+			_, err = quotaManagementSvc.SubmitQuotaIncreaseRequest(ctx, submission)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			submission.RequestedLimit = 30
+			_, err = quotaManagementSvc.SubmitQuotaIncreaseRequest(ctx, submission)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			submission.Justification = ""
+			_, err = quotaManagementSvc.SubmitQuotaIncreaseRequest(ctx, submission)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			_, err = quotaManagementSvc.GetMyQuotaIncreaseRequest(ctx, &pb.GetMyQuotaIncreaseRequestRequest{CloudAccountId: "000000000000", RequestId: request.RequestId})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
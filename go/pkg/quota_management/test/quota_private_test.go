@@ -9,6 +9,8 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -198,4 +200,200 @@ var _ = Describe("QuotaManagementPrivate", func() {
 			Expect(respPvt).To(BeNil())
 		})
 	})
+
+	Context("ReserveQuota", func() {
+		It("Should reserve, commit and release quota", func() {
+			ctx := context.Background()
+			svcName := "test-rsv-" + time.Now().Format("02030405")
+			cloudAccountId := "123456789012"
+			resp, err := quotaManagementSvc.Register(ctx, &pb.ServiceQuotaRegistrationRequest{
+				ServiceName: svcName,
+				Region:      "us-region-1",
+				ServiceResources: []*pb.ServiceResource{
+					{
+						Name:      "instances",
+						QuotaUnit: "COUNT",
+						MaxLimit:  10,
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = quotaManagementSvc.CreateServiceQuota(ctx, &pb.CreateServiceQuotaRequest{
+				ServiceId: resp.ServiceId,
+				ServiceQuotaResource: &pb.ServiceQuotaResource{
+					ResourceType: "instances",
+					QuotaConfig:  &pb.QuotaConfig{QuotaUnit: "COUNT", Limits: 5},
+					Scope:        &pb.QuotaScope{ScopeType: "QUOTA_ACCOUNT_ID", ScopeValue: cloudAccountId},
+					RuleId:       "test-rule",
+					Reason:       "testing",
+				},
+			})
+			Expect(err).To(BeNil())
+
+			reserveReq := &pb.QuotaReservationRequestPrivate{
+				ServiceName:    svcName,
+				ResourceType:   "instances",
+				CloudAccountId: cloudAccountId,
+				Quantity:       2,
+				CurrentUsage:   1,
+			}
+
+			By("Reserving quota with the usage initialized by the service")
+			reservation, err := quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(err).To(BeNil())
+			Expect(reservation.ReservationId).NotTo(BeEmpty())
+			Expect(reservation.Limits).To(Equal(int64(5)))
+			Expect(reservation.Usage).To(Equal(int64(1)))
+
+			By("Counting the pending reservations")
+			pendingReservation, err := quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(err).To(BeNil())
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+
+			By("Committing a reservation")
+			committed, err := quotaManagementSvc.CommitQuotaReservationPrivate(ctx, &pb.QuotaReservationReferencePrivate{ReservationId: reservation.ReservationId})
+			Expect(err).To(BeNil())
+			Expect(committed.Usage).To(Equal(int64(3)))
+			_, err = quotaManagementSvc.CommitQuotaReservationPrivate(ctx, &pb.QuotaReservationReferencePrivate{ReservationId: reservation.ReservationId})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+			By("Ignoring the current usage once the usage is initialized")
+			reserveReq.CurrentUsage = 0
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+
+			By("Releasing a reservation")
+			_, err = quotaManagementSvc.ReleaseQuotaPrivate(ctx, &pb.QuotaReleaseRequestPrivate{ReservationId: pendingReservation.ReservationId})
+			Expect(err).To(BeNil())
+			reservation, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(err).To(BeNil())
+			Expect(reservation.Usage).To(Equal(int64(3)))
+
+			By("Releasing the usage of deleted resources")
+			_, err = quotaManagementSvc.ReleaseQuotaPrivate(ctx, &pb.QuotaReleaseRequestPrivate{
+				ServiceName:    svcName,
+				ResourceType:   "instances",
+				CloudAccountId: cloudAccountId,
+				Quantity:       3,
+			})
+			Expect(err).To(BeNil())
+			reservation, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(err).To(BeNil())
+			Expect(reservation.Usage).To(Equal(int64(0)))
+
+			By("Expiring reservations")
+			reserveReq.Quantity = 1
+			reserveReq.TtlSeconds = 1
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(err).To(BeNil())
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+			time.Sleep(2 * time.Second)
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, reserveReq)
+			Expect(err).To(BeNil())
+
+			By("Rejecting invalid requests")
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, &pb.QuotaReservationRequestPrivate{ServiceName: svcName, ResourceType: "instances", CloudAccountId: cloudAccountId})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			_, err = quotaManagementSvc.ReleaseQuotaPrivate(ctx, &pb.QuotaReleaseRequestPrivate{ServiceName: svcName})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("ReconcileQuotaUsage", func() {
+		It("Should reconcile the usage with the counts of the service", func() {
+			ctx := context.Background()
+			svcName := "test-rcl-" + time.Now().Format("02030405")
+			cloudAccountId := "123456789012"
+			resp, err := quotaManagementSvc.Register(ctx, &pb.ServiceQuotaRegistrationRequest{
+				ServiceName: svcName,
+				Region:      "us-region-1",
+				ServiceResources: []*pb.ServiceResource{
+					{
+						Name:      "instances",
+						QuotaUnit: "COUNT",
+						MaxLimit:  10,
+					},
+					{
+						Name:      "totalSizeTB",
+						QuotaUnit: "TB",
+						MaxLimit:  10,
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+			for _, resourceType := range []string{"instances", "totalSizeTB"} {
+				_, err = quotaManagementSvc.CreateServiceQuota(ctx, &pb.CreateServiceQuotaRequest{
+					ServiceId: resp.ServiceId,
+					ServiceQuotaResource: &pb.ServiceQuotaResource{
+						ResourceType: resourceType,
+						QuotaConfig:  &pb.QuotaConfig{QuotaUnit: "COUNT", Limits: 2},
+						Scope:        &pb.QuotaScope{ScopeType: "QUOTA_ACCOUNT_ID", ScopeValue: cloudAccountId},
+						RuleId:       "test-rule",
+						Reason:       "testing",
+					},
+				})
+				Expect(err).To(BeNil())
+			}
+
+			By("Reserving a quantity in units of the limit scale")
+			sizeReq := &pb.QuotaReservationRequestPrivate{
+				ServiceName:    svcName,
+				ResourceType:   "totalSizeTB",
+				CloudAccountId: cloudAccountId,
+				Quantity:       1500,
+				LimitsScale:    1000,
+			}
+			reservation, err := quotaManagementSvc.ReserveQuotaPrivate(ctx, sizeReq)
+			Expect(err).To(BeNil())
+			_, err = quotaManagementSvc.CommitQuotaReservationPrivate(ctx, &pb.QuotaReservationReferencePrivate{ReservationId: reservation.ReservationId})
+			Expect(err).To(BeNil())
+			sizeReq.Quantity = 600
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, sizeReq)
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+
+			By("Leaving the usage that changed after the count")
+			instancesReq := &pb.QuotaReservationRequestPrivate{
+				ServiceName:    svcName,
+				ResourceType:   "instances",
+				CloudAccountId: cloudAccountId,
+				Quantity:       2,
+			}
+			reservation, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, instancesReq)
+			Expect(err).To(BeNil())
+			_, err = quotaManagementSvc.CommitQuotaReservationPrivate(ctx, &pb.QuotaReservationReferencePrivate{ReservationId: reservation.ReservationId})
+			Expect(err).To(BeNil())
+			_, err = quotaManagementSvc.ReconcileQuotaUsagePrivate(ctx, &pb.QuotaUsageReconcileRequestPrivate{
+				ServiceName:   svcName,
+				UpdatedBefore: timestamppb.New(time.Now().Add(-time.Hour)),
+			})
+			Expect(err).To(BeNil())
+			instancesReq.Quantity = 1
+			_, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, instancesReq)
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+
+			By("Setting the usage to the counts, and the resources that were not counted to 0")
+			_, err = quotaManagementSvc.ReconcileQuotaUsagePrivate(ctx, &pb.QuotaUsageReconcileRequestPrivate{
+				ServiceName: svcName,
+				Usages: []*pb.QuotaUsageCountPrivate{
+					{ResourceType: "totalSizeTB", CloudAccountId: cloudAccountId, Usage: 100, LimitsScale: 1000},
+				},
+				UpdatedBefore: timestamppb.New(time.Now().Add(time.Minute)),
+			})
+			Expect(err).To(BeNil())
+			reservation, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, instancesReq)
+			Expect(err).To(BeNil())
+			Expect(reservation.Usage).To(Equal(int64(0)))
+			sizeReq.Quantity = 1900
+			reservation, err = quotaManagementSvc.ReserveQuotaPrivate(ctx, sizeReq)
+			Expect(err).To(BeNil())
+			Expect(reservation.Usage).To(Equal(int64(100)))
+
+			By("Rejecting invalid requests")
+			_, err = quotaManagementSvc.ReconcileQuotaUsagePrivate(ctx, &pb.QuotaUsageReconcileRequestPrivate{ServiceName: svcName})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
 })
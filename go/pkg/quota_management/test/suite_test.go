@@ -66,7 +66,7 @@ var _ = BeforeSuite(func() {
 
 	// Initialize QuotaManagementServiceClient
 	selectedRegion := "us-dev-1"
-	quotaManagementSvc, err = quotaManagementServer.NewQuotaManagementServiceClient(ctx, sqlDb, cloudAccountClient, selectedRegion)
+	quotaManagementSvc, err = quotaManagementServer.NewQuotaManagementServiceClient(ctx, sqlDb, cloudAccountClient, nil, selectedRegion)
 	Expect(err).Should(Succeed())
 	Expect(quotaManagementSvc).ShouldNot(BeNil())
 })
@@ -26,6 +26,13 @@ const (
 	SDNControllerNamespace = "idcs-system"
 )
 
+const (
+	// SwitchVendorArista switches are managed using eAPI.
+	SwitchVendorArista = "arista"
+	// SwitchVendorSonic switches are managed using gNMI / OpenConfig.
+	SwitchVendorSonic = "sonic"
+)
+
 // SwitchSpec defines the desired state of Switch
 type SwitchSpec struct {
 	FQDN        string     `json:"fqdn"`
@@ -34,6 +41,11 @@ type SwitchSpec struct {
 	BGP         *BGPConfig `json:"bgpConf,omitempty"`
 	IpOverride  string     `json:"ipOverride,omitempty"`
 	Maintenance string     `json:"maintenance,omitempty"`
+	// Vendor selects the switch client used to manage this switch. Defaults to "arista".
+	// +kubebuilder:validation:Enum=arista;sonic
+	// +optional
+	Vendor   string    `json:"vendor,omitempty"`
+	GNMIConf *GNMIConf `json:"gnmiConf,omitempty"`
 }
 
 type EAPIConf struct {
@@ -43,6 +55,13 @@ type EAPIConf struct {
 	Transport      string `json:"transport"`
 }
 
+type GNMIConf struct {
+	// Port is the gNMI port of the switch. Defaults to 8080.
+	Port int `json:"port,omitempty"`
+	// Transport is one of "tls", "tls-skip-verify" or "insecure". Defaults to "tls".
+	Transport string `json:"transport,omitempty"`
+}
+
 type EAPISecret struct {
 	Credentials struct {
 		Username string `json:"username"`
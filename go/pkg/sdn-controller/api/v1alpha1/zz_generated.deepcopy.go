@@ -276,6 +276,21 @@ func (in *FrontEndFabricStatus) DeepCopy() *FrontEndFabricStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GNMIConf) DeepCopyInto(out *GNMIConf) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GNMIConf.
+func (in *GNMIConf) DeepCopy() *GNMIConf {
+	if in == nil {
+		return nil
+	}
+	out := new(GNMIConf)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfigStrategy) DeepCopyInto(out *NetworkConfigStrategy) {
 	*out = *in
@@ -1243,6 +1258,11 @@ func (in *SwitchSpec) DeepCopyInto(out *SwitchSpec) {
 		*out = new(BGPConfig)
 		**out = **in
 	}
+	if in.GNMIConf != nil {
+		in, out := &in.GNMIConf, &out.GNMIConf
+		*out = new(GNMIConf)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchSpec.
@@ -170,7 +170,7 @@ func (d *IDCNetworkDeviceManager) AddOrUpdateSwitch(sw *idcnetworkv1alpha1.Switc
 
 	if !found || client == nil {
 		logger.V(1).Info("adding/updating switch client to DeviceManager")
-		client, err = d.createSwitchClient(ipToUse, switchBackendMode)
+		client, err = d.createSwitchClient(sw, ipToUse, switchBackendMode)
 		if err != nil {
 			if d.deviceManagerRecorder != nil {
 				// set the error flag metric for failure to create switch client.
@@ -194,12 +194,14 @@ func (d *IDCNetworkDeviceManager) AddOrUpdateSwitch(sw *idcnetworkv1alpha1.Switc
 		if err != nil {
 			return fmt.Errorf("could not get client host, %v", err)
 		}
-		if (existingHost != ipToUse) || forceRecreateSwitchClient {
+		// the mock client serves every vendor, so only the real clients are recreated when the vendor changes.
+		vendorChanged := switchBackendMode != idcnetworkv1alpha1.SwitchBackendModeMock && switchClientVendor(client) != switchVendor(sw)
+		if (existingHost != ipToUse) || forceRecreateSwitchClient || vendorChanged {
 			d.Lock()
 			delete(d.switchClientsMap, sw.Spec.FQDN)
 			d.Unlock()
-			logger.V(1).Info("IpToUse changed or forceRecreateSwitchClient, creating switch client to DeviceManager")
-			client, err = d.createSwitchClient(ipToUse, switchBackendMode)
+			logger.V(1).Info("IpToUse or vendor changed or forceRecreateSwitchClient, creating switch client to DeviceManager")
+			client, err = d.createSwitchClient(sw, ipToUse, switchBackendMode)
 			if err != nil {
 				if d.deviceManagerRecorder != nil {
 					// set the error flag metric for failure to create switch client.
@@ -235,7 +237,7 @@ func (d *IDCNetworkDeviceManager) AddOrUpdateSwitch(sw *idcnetworkv1alpha1.Switc
 	return nil
 }
 
-func (d *IDCNetworkDeviceManager) createSwitchClient(ipToUse string, switchBackendMode string) (sc.SwitchClient, error) {
+func (d *IDCNetworkDeviceManager) createSwitchClient(sw *idcnetworkv1alpha1.Switch, ipToUse string, switchBackendMode string) (sc.SwitchClient, error) {
 	//func (d *IDCNetworkDeviceManager) setBackendMode(sw *idcnetworkv1alpha1.Switch, client sc.SwitchClient, ipToUse string) (sc.SwitchClient, error) {
 	// here we determine what type of switchBackend will be used to talk to a network switch.
 	// The options are:
@@ -243,6 +245,7 @@ func (d *IDCNetworkDeviceManager) createSwitchClient(ipToUse string, switchBacke
 	// SwitchBackendModeEAPI:       This mode will call the Arista network switches' eAPI to make configuration changes. this should be used in production.
 	// SwitchBackendModeReadOnly: Read only is a special mode that used only for the phase that transitioning from Raven to SDN. It's basically the "SwitchBackendModeEAPI" mode
 	//                                                              but without any actual "write" operation.
+	// In the EAPI and ReadOnly modes, switches with Spec.Vendor "sonic" are managed over gNMI instead of eAPI.
 	// Note: when the flag "ControllerConfig.EnableReadOnlyMode" is set to enable, the network switch backend will be enforce to use the SwitchBackendModeReadOnly. For example, even
 	//	logger := log.FromContext(context.Background()).WithName("IDCNetworkDeviceManager.AddOrUpdateSwitch").
 	//		WithValues(utils.LogFieldSwitchFQDN, sw.Spec.FQDN).
@@ -257,13 +260,22 @@ func (d *IDCNetworkDeviceManager) createSwitchClient(ipToUse string, switchBacke
 		if err != nil {
 			return nil, err
 		}
-	} else if switchBackendMode == idcnetworkv1alpha1.SwitchBackendModeEAPI {
-		client, err = sc.NewAristaClient(ipToUse, d.cfg.ControllerConfig.SwitchSecretsPath, 443, "https", eapiConnTimeout, false, d.cfg.ControllerConfig.AllowedVlanIds, d.cfg.ControllerConfig.AllowedNativeVlanIds, d.cfg.ControllerConfig.AllowedModes, d.cfg.ControllerConfig.AllowedTrunkGroups, d.cfg.ControllerConfig.ProvisioningVlanIds)
-		if err != nil {
-			return nil, err
+	} else if switchBackendMode == idcnetworkv1alpha1.SwitchBackendModeEAPI || switchBackendMode == idcnetworkv1alpha1.SwitchBackendModeReadOnly {
+		readOnly := switchBackendMode == idcnetworkv1alpha1.SwitchBackendModeReadOnly
+		if switchVendor(sw) == idcnetworkv1alpha1.SwitchVendorSonic {
+			port, transport := sc.DefaultGNMIPort, sc.DefaultGNMITransport
+			if sw.Spec.GNMIConf != nil {
+				if sw.Spec.GNMIConf.Port != 0 {
+					port = sw.Spec.GNMIConf.Port
+				}
+				if sw.Spec.GNMIConf.Transport != "" {
+					transport = sw.Spec.GNMIConf.Transport
+				}
+			}
+			client, err = sc.NewSonicClient(ipToUse, d.cfg.ControllerConfig.SwitchSecretsPath, port, transport, eapiConnTimeout, readOnly, d.cfg.ControllerConfig.AllowedVlanIds, d.cfg.ControllerConfig.AllowedNativeVlanIds, d.cfg.ControllerConfig.AllowedModes, d.cfg.ControllerConfig.AllowedTrunkGroups, d.cfg.ControllerConfig.ProvisioningVlanIds)
+		} else {
+			client, err = sc.NewAristaClient(ipToUse, d.cfg.ControllerConfig.SwitchSecretsPath, 443, "https", eapiConnTimeout, readOnly, d.cfg.ControllerConfig.AllowedVlanIds, d.cfg.ControllerConfig.AllowedNativeVlanIds, d.cfg.ControllerConfig.AllowedModes, d.cfg.ControllerConfig.AllowedTrunkGroups, d.cfg.ControllerConfig.ProvisioningVlanIds)
 		}
-	} else if switchBackendMode == idcnetworkv1alpha1.SwitchBackendModeReadOnly {
-		client, err = sc.NewAristaClient(ipToUse, d.cfg.ControllerConfig.SwitchSecretsPath, 443, "https", eapiConnTimeout, true, d.cfg.ControllerConfig.AllowedVlanIds, d.cfg.ControllerConfig.AllowedNativeVlanIds, d.cfg.ControllerConfig.AllowedModes, d.cfg.ControllerConfig.AllowedTrunkGroups, d.cfg.ControllerConfig.ProvisioningVlanIds)
 		if err != nil {
 			return nil, err
 		}
@@ -274,6 +286,22 @@ func (d *IDCNetworkDeviceManager) createSwitchClient(ipToUse string, switchBacke
 	return client, nil
 }
 
+// switchVendor returns the vendor of the switch, defaulting to Arista.
+func switchVendor(sw *idcnetworkv1alpha1.Switch) string {
+	if sw.Spec.Vendor == "" {
+		return idcnetworkv1alpha1.SwitchVendorArista
+	}
+	return sw.Spec.Vendor
+}
+
+// switchClientVendor returns the vendor a switch client was created for.
+func switchClientVendor(client sc.SwitchClient) string {
+	if _, ok := client.(*sc.SonicClient); ok {
+		return idcnetworkv1alpha1.SwitchVendorSonic
+	}
+	return idcnetworkv1alpha1.SwitchVendorArista
+}
+
 func (d *IDCNetworkDeviceManager) WatchFileChanges(ctx context.Context, filePath string, interval time.Duration) error {
 	var lastModTime time.Time
 	var lastContents []byte
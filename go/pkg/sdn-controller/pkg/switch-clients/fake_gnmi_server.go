@@ -0,0 +1,411 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package switchclients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// FakeGNMIServer is an in-process gNMI target backed by an OpenConfig json tree. It's meant for testing the SonicClient
+// without a switch: lists are stored as json arrays and list entries are looked up by their key leaves, like SONiC does.
+// A Set on the "<module>:copy" RPC path saves the running tree as the startup tree.
+type FakeGNMIServer struct {
+	gpb.UnimplementedGNMIServer
+
+	mu      sync.Mutex
+	running map[string]interface{}
+	startup map[string]interface{}
+
+	// if set, requests must carry matching "username"/"password" metadata.
+	Username string
+	Password string
+
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// NewFakeGNMIServer creates a server whose running config is initialState (an OpenConfig json document).
+func NewFakeGNMIServer(initialState string) (*FakeGNMIServer, error) {
+	running := map[string]interface{}{}
+	if initialState != "" {
+		tree, err := unmarshalJSONTree([]byte(initialState))
+		if err != nil {
+			return nil, err
+		}
+		m, ok := stripModulePrefixes(tree).(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("initial state must be a json object")
+		}
+		running = m
+	}
+	return &FakeGNMIServer{running: running}, nil
+}
+
+// Start serves gNMI (plaintext) on a random local port, and returns its address.
+func (f *FakeGNMIServer) Start() (string, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	f.listener = lis
+	f.server = grpc.NewServer()
+	gpb.RegisterGNMIServer(f.server, f)
+	go func() {
+		_ = f.server.Serve(lis)
+	}()
+	return lis.Addr().String(), nil
+}
+
+func (f *FakeGNMIServer) Stop() {
+	if f.server != nil {
+		f.server.Stop()
+	}
+}
+
+// Node returns the (prefix-stripped) json node at path, or a NotFound error.
+func (f *FakeGNMIServer) Node(path string) (interface{}, error) {
+	p, err := parseGNMIPath(path)
+	if err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return getNode(f.running, p.GetElem())
+}
+
+// StartupConfig returns the tree saved by the last "copy" RPC, or nil if the config was never saved.
+func (f *FakeGNMIServer) StartupConfig() map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.startup
+}
+
+func (f *FakeGNMIServer) authorize(ctx context.Context) error {
+	if f.Username == "" && f.Password == "" {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("username")) != 1 || md.Get("username")[0] != f.Username ||
+		len(md.Get("password")) != 1 || md.Get("password")[0] != f.Password {
+		return status.Error(codes.Unauthenticated, "invalid username or password")
+	}
+	return nil
+}
+
+func (f *FakeGNMIServer) Capabilities(ctx context.Context, req *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+	if err := f.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return &gpb.CapabilityResponse{
+		SupportedModels: []*gpb.ModelData{
+			{Name: "openconfig-interfaces", Organization: "OpenConfig working group"},
+			{Name: "openconfig-network-instance", Organization: "OpenConfig working group"},
+			{Name: "openconfig-lldp", Organization: "OpenConfig working group"},
+			{Name: "openconfig-lacp", Organization: "OpenConfig working group"},
+			{Name: "openconfig-routing-policy", Organization: "OpenConfig working group"},
+		},
+		SupportedEncodings: []gpb.Encoding{gpb.Encoding_JSON, gpb.Encoding_JSON_IETF},
+		GNMIVersion:        "0.7.0",
+	}, nil
+}
+
+func (f *FakeGNMIServer) Get(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+	if err := f.authorize(ctx); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	resp := &gpb.GetResponse{}
+	for _, path := range req.GetPath() {
+		elems := joinPathElems(req.GetPrefix().GetElem(), path.GetElem())
+		node, err := getNode(f.running, elems)
+		if err != nil {
+			return nil, err
+		}
+		// like SONiC, wrap the node in a container named after it.
+		value := node
+		if len(elems) > 0 {
+			last := elems[len(elems)-1]
+			if len(last.GetKey()) > 0 {
+				value = map[string]interface{}{last.GetName(): []interface{}{node}}
+			} else {
+				value = map[string]interface{}{last.GetName(): node}
+			}
+		}
+		val, err := encodeGNMIValue(value)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Notification = append(resp.Notification, &gpb.Notification{
+			Update: []*gpb.Update{{Path: path, Val: val}},
+		})
+	}
+	return resp, nil
+}
+
+func (f *FakeGNMIServer) Set(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+	if err := f.authorize(ctx); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// apply to a copy so the Set is transactional.
+	running, err := deepCopyTree(f.running)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	prefix := req.GetPrefix().GetElem()
+	resp := &gpb.SetResponse{}
+	saveConfig := false
+
+	for _, path := range req.GetDelete() {
+		deleteNode(running, joinPathElems(prefix, path.GetElem()))
+		resp.Response = append(resp.Response, &gpb.UpdateResult{Path: path, Op: gpb.UpdateResult_DELETE})
+	}
+	apply := func(updates []*gpb.Update, op gpb.UpdateResult_Operation) error {
+		for _, update := range updates {
+			elems := joinPathElems(prefix, update.GetPath().GetElem())
+			if len(elems) == 1 && strings.HasSuffix(elems[0].GetName(), ":copy") {
+				saveConfig = true
+				resp.Response = append(resp.Response, &gpb.UpdateResult{Path: update.GetPath(), Op: op})
+				continue
+			}
+			var value interface{}
+			err := decodeGNMIValue(update.GetVal(), &gpb.Path{Elem: elems}, &value)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			value, err = normalizeNumbers(value)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			err = setNode(running, elems, value, op == gpb.UpdateResult_UPDATE)
+			if err != nil {
+				return err
+			}
+			resp.Response = append(resp.Response, &gpb.UpdateResult{Path: update.GetPath(), Op: op})
+		}
+		return nil
+	}
+	if err := apply(req.GetReplace(), gpb.UpdateResult_REPLACE); err != nil {
+		return nil, err
+	}
+	if err := apply(req.GetUpdate(), gpb.UpdateResult_UPDATE); err != nil {
+		return nil, err
+	}
+
+	if saveConfig {
+		f.startup, err = deepCopyTree(running)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	f.running = running
+	return resp, nil
+}
+
+func joinPathElems(prefix []*gpb.PathElem, elems []*gpb.PathElem) []*gpb.PathElem {
+	res := make([]*gpb.PathElem, 0, len(prefix)+len(elems))
+	res = append(res, prefix...)
+	return append(res, elems...)
+}
+
+func listEntryMatches(entry interface{}, keys map[string]string) bool {
+	m, ok := entry.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for k, v := range keys {
+		if fmt.Sprint(m[k]) != v {
+			return false
+		}
+	}
+	return true
+}
+
+func getNode(root map[string]interface{}, elems []*gpb.PathElem) (interface{}, error) {
+	var node interface{} = root
+	for _, elem := range elems {
+		container, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "%s not found", elem.GetName())
+		}
+		child, found := container[elem.GetName()]
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "%s not found", elem.GetName())
+		}
+		if len(elem.GetKey()) == 0 {
+			node = child
+			continue
+		}
+		list, _ := child.([]interface{})
+		found = false
+		for _, entry := range list {
+			if listEntryMatches(entry, elem.GetKey()) {
+				node = entry
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "%s%v not found", elem.GetName(), elem.GetKey())
+		}
+	}
+	return node, nil
+}
+
+// newListEntry creates a list entry holding its key leaves. Numeric keys (eg. vlan-id) are stored as numbers.
+func newListEntry(keys map[string]string) map[string]interface{} {
+	entry := make(map[string]interface{})
+	for k, v := range keys {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			entry[k] = json.Number(strconv.FormatInt(n, 10))
+		} else {
+			entry[k] = v
+		}
+	}
+	return entry
+}
+
+func setNode(container map[string]interface{}, elems []*gpb.PathElem, value interface{}, merge bool) error {
+	if len(elems) == 0 {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return status.Error(codes.InvalidArgument, "value for the root must be an object")
+		}
+		if !merge {
+			for k := range container {
+				delete(container, k)
+			}
+		}
+		mergeTrees(container, m)
+		return nil
+	}
+
+	elem := elems[0]
+	rest := elems[1:]
+	if len(elem.GetKey()) == 0 {
+		if len(rest) == 0 {
+			existing, isMap := container[elem.GetName()].(map[string]interface{})
+			update, updateIsMap := value.(map[string]interface{})
+			if merge && isMap && updateIsMap {
+				mergeTrees(existing, update)
+			} else {
+				container[elem.GetName()] = value
+			}
+			return nil
+		}
+		child, ok := container[elem.GetName()].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			container[elem.GetName()] = child
+		}
+		return setNode(child, rest, value, merge)
+	}
+
+	list, _ := container[elem.GetName()].([]interface{})
+	index := -1
+	for i, entry := range list {
+		if listEntryMatches(entry, elem.GetKey()) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		list = append(list, newListEntry(elem.GetKey()))
+		index = len(list) - 1
+		container[elem.GetName()] = list
+	}
+	entry := list[index].(map[string]interface{})
+	if len(rest) > 0 {
+		return setNode(entry, rest, value, merge)
+	}
+
+	update, ok := value.(map[string]interface{})
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "value for list entry %s must be an object", elem.GetName())
+	}
+	if !merge {
+		entry = newListEntry(elem.GetKey())
+		list[index] = entry
+	}
+	mergeTrees(entry, update)
+	return nil
+}
+
+func deleteNode(container map[string]interface{}, elems []*gpb.PathElem) {
+	if len(elems) == 0 {
+		for k := range container {
+			delete(container, k)
+		}
+		return
+	}
+	parent, err := getNode(container, elems[:len(elems)-1])
+	if err != nil {
+		return
+	}
+	parentMap, ok := parent.(map[string]interface{})
+	if !ok {
+		return
+	}
+	last := elems[len(elems)-1]
+	if len(last.GetKey()) == 0 {
+		delete(parentMap, last.GetName())
+		return
+	}
+	list, _ := parentMap[last.GetName()].([]interface{})
+	remaining := []interface{}{}
+	for _, entry := range list {
+		if !listEntryMatches(entry, last.GetKey()) {
+			remaining = append(remaining, entry)
+		}
+	}
+	parentMap[last.GetName()] = remaining
+}
+
+func mergeTrees(dst map[string]interface{}, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeTrees(dstMap, srcMap)
+		} else {
+			dst[k] = v
+		}
+	}
+}
+
+func deepCopyTree(tree map[string]interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(tree)
+	if err != nil {
+		return nil, err
+	}
+	copied, err := unmarshalJSONTree(b)
+	if err != nil {
+		return nil, err
+	}
+	return copied.(map[string]interface{}), nil
+}
+
+// normalizeNumbers round-trips value through json so numbers are stored as json.Number, like the initial state.
+func normalizeNumbers(value interface{}) (interface{}, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalJSONTree(b)
+}
@@ -0,0 +1,249 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package switchclients
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// identityValueRegex matches YANG identityref values in JSON_IETF encoding, eg. "openconfig-vlan-types:TRUNK".
+var identityValueRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*:([A-Z][A-Z0-9_]*)$`)
+
+// parseGNMIPath converts a path like "/interfaces/interface[name=Eth1/1]/config/description" into a gNMI path.
+// Key values may contain "/", so the path is only split on "/" outside of brackets.
+func parseGNMIPath(p string) (*gpb.Path, error) {
+	trimmed := strings.TrimPrefix(p, "/")
+	var elems []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(trimmed); i++ {
+		switch trimmed[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced ']' in path %s", p)
+			}
+		case '/':
+			if depth == 0 {
+				elems = append(elems, trimmed[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced '[' in path %s", p)
+	}
+	if start < len(trimmed) {
+		elems = append(elems, trimmed[start:])
+	}
+
+	path := &gpb.Path{}
+	for _, e := range elems {
+		if e == "" {
+			return nil, fmt.Errorf("empty element in path %s", p)
+		}
+		elem := &gpb.PathElem{Name: e}
+		if idx := strings.IndexByte(e, '['); idx >= 0 {
+			elem.Name = e[:idx]
+			elem.Key = make(map[string]string)
+			rest := e[idx:]
+			for len(rest) > 0 {
+				end := strings.IndexByte(rest, ']')
+				if rest[0] != '[' || end < 0 {
+					return nil, fmt.Errorf("invalid key in path element %s", e)
+				}
+				k, v, ok := strings.Cut(rest[1:end], "=")
+				if !ok || k == "" {
+					return nil, fmt.Errorf("invalid key in path element %s", e)
+				}
+				elem.Key[k] = v
+				rest = rest[end+1:]
+			}
+		}
+		path.Elem = append(path.Elem, elem)
+	}
+	return path, nil
+}
+
+// gnmiPathToString is the inverse of parseGNMIPath. Keys are sorted so the result is stable.
+func gnmiPathToString(path *gpb.Path) string {
+	var sb strings.Builder
+	for _, elem := range path.GetElem() {
+		sb.WriteString("/")
+		sb.WriteString(elem.GetName())
+		keys := make([]string, 0, len(elem.GetKey()))
+		for k := range elem.GetKey() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("[%s=%s]", k, elem.GetKey()[k]))
+		}
+	}
+	if sb.Len() == 0 {
+		return "/"
+	}
+	return sb.String()
+}
+
+// encodeGNMIValue encodes v as a JSON_IETF typed value.
+func encodeGNMIValue(v interface{}) (*gpb.TypedValue, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: b}}, nil
+}
+
+// decodeGNMIValue decodes a JSON / JSON_IETF typed value into out.
+// YANG module prefixes are stripped from member names and identity values, and if the target wrapped the
+// response in a container named after the requested node (as SONiC does) that container is unwrapped,
+// so that out always receives the content of the node at path.
+func decodeGNMIValue(val *gpb.TypedValue, path *gpb.Path, out interface{}) error {
+	var raw []byte
+	switch v := val.GetValue().(type) {
+	case *gpb.TypedValue_JsonIetfVal:
+		raw = v.JsonIetfVal
+	case *gpb.TypedValue_JsonVal:
+		raw = v.JsonVal
+	case *gpb.TypedValue_StringVal:
+		raw, _ = json.Marshal(v.StringVal)
+	case *gpb.TypedValue_UintVal:
+		raw = []byte(strconv.FormatUint(v.UintVal, 10))
+	case *gpb.TypedValue_IntVal:
+		raw = []byte(strconv.FormatInt(v.IntVal, 10))
+	case *gpb.TypedValue_BoolVal:
+		raw = []byte(strconv.FormatBool(v.BoolVal))
+	default:
+		return fmt.Errorf("unsupported gNMI value type %T", val.GetValue())
+	}
+
+	tree, err := unmarshalJSONTree(raw)
+	if err != nil {
+		return err
+	}
+	tree = stripModulePrefixes(tree)
+
+	elems := path.GetElem()
+	if len(elems) > 0 {
+		last := elems[len(elems)-1]
+		if m, ok := tree.(map[string]interface{}); ok && len(m) == 1 {
+			if inner, found := m[last.GetName()]; found {
+				tree = inner
+				// a keyed list element may come back as a single-entry list.
+				if list, ok := inner.([]interface{}); ok && len(last.GetKey()) > 0 && len(list) == 1 {
+					tree = list[0]
+				}
+			}
+		}
+	}
+
+	b, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+func unmarshalJSONTree(raw []byte) (interface{}, error) {
+	var tree interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&tree); err != nil {
+		return nil, fmt.Errorf("failed to decode gNMI json value, %v", err)
+	}
+	return tree, nil
+}
+
+func stripModulePrefixes(tree interface{}) interface{} {
+	switch t := tree.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			if idx := strings.IndexByte(k, ':'); idx >= 0 {
+				k = k[idx+1:]
+			}
+			res[k] = stripModulePrefixes(v)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(t))
+		for i, v := range t {
+			res[i] = stripModulePrefixes(v)
+		}
+		return res
+	case string:
+		if match := identityValueRegex.FindStringSubmatch(t); match != nil {
+			return match[1]
+		}
+		return t
+	default:
+		return t
+	}
+}
+
+// ocUint64 decodes an OpenConfig uint64 leaf, which RFC 7951 encodes as a JSON string, but some targets send as a number.
+type ocUint64 uint64
+
+func (u *ocUint64) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" {
+		*u = 0
+		return nil
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*u = ocUint64(v)
+	return nil
+}
+
+// parseTrunkVlans expands the OpenConfig trunk-vlans union (vlan ids or "start..end" ranges) into a sorted list of vlan ids.
+func parseTrunkVlans(values []interface{}) ([]int, error) {
+	var vlans []int
+	for _, value := range values {
+		var s string
+		switch v := value.(type) {
+		case float64:
+			s = strconv.Itoa(int(v))
+		case json.Number:
+			s = v.String()
+		case string:
+			s = v
+		default:
+			return nil, fmt.Errorf("unexpected trunk-vlans value %v", value)
+		}
+		if from, to, isRange := strings.Cut(s, ".."); isRange {
+			start, err := strconv.Atoi(from)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trunk-vlans range %s", s)
+			}
+			end, err := strconv.Atoi(to)
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid trunk-vlans range %s", s)
+			}
+			for vlan := start; vlan <= end; vlan++ {
+				vlans = append(vlans, vlan)
+			}
+			continue
+		}
+		vlan, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trunk-vlans value %s", s)
+		}
+		vlans = append(vlans, vlan)
+	}
+	sort.Ints(vlans)
+	return vlans, nil
+}
@@ -0,0 +1,1608 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package switchclients
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	idcnetworkv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/sdn-controller/api/v1alpha1"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/sdn-controller/pkg/utils"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+	"k8s.io/utils/strings/slices"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// GNMITransportTLS verifies the switch certificate against the system roots.
+	GNMITransportTLS = "tls"
+	// GNMITransportTLSSkipVerify uses TLS but does not verify the switch certificate (lab switches with self-signed certs).
+	GNMITransportTLSSkipVerify = "tls-skip-verify"
+	// GNMITransportInsecure uses plaintext gRPC. Only meant for tests and containerlab.
+	GNMITransportInsecure = "insecure"
+
+	DefaultGNMIPort      = 8080
+	DefaultGNMITransport = GNMITransportTLS
+
+	sonicDefaultNetworkInstance = "default"
+	// these mirror the route-map / community-list the AristaClient configures.
+	sonicAdvertiseCommunityPolicy    = "adv-set-comm"
+	sonicAdvertiseCommunityStatement = "10"
+)
+
+var gnmiGetSwitchPortsCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiGetSwitchPortscounter",
+		Help:        "Total attempts to get switch ports over gNMI.",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var gnmiUpdateVlanCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiUpdateVlancounter",
+		Help:        "Total attempts to update vlan over gNMI",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var gnmiUpdateModeCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiUpdateModecounter",
+		Help:        "Total attempts to update mode over gNMI",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var gnmiUpdateDescriptionCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiUpdateDescriptioncounter",
+		Help:        "Total attempts to update description over gNMI",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var gnmiUpdateNativeVlanCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiUpdateNativeVlancounter",
+		Help:        "Total attempts to update native vlan over gNMI",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var gnmiUpdateTrunkGroupsCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiUpdateTrunkGroupscounter",
+		Help:        "Total attempts to update trunk groups over gNMI",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var gnmiUpdateBGPCommunityCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiUpdateBGPCommunitycounter",
+		Help:        "Total attempts to update BGP community over gNMI",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var gnmiGetBGPCommunityCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiGetBGPCommunitycounter",
+		Help:        "Total attempts to get BGP community over gNMI",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var gnmiGetVlansCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name:        "gnmiGetVlanscounter",
+		Help:        "Total attempts to get vlans over gNMI.",
+		ConstLabels: map[string]string{"application": "sdn-controller"},
+	},
+)
+
+var (
+	sonicEthernetNameRegex    = regexp.MustCompile(`^Eth([1-9][0-9]{0,2}(/[1-9][0-9]{0,2})*)$`)
+	sonicPortChannelNameRegex = regexp.MustCompile(`^PortChannel(\d+)$`)
+	sonicPortSpeedRegex       = regexp.MustCompile(`^SPEED_(\d+)(MB|GB)$`)
+	sonicDefaultVlanNameRegex = regexp.MustCompile(`^Vlan\d+$`)
+)
+
+func init() {
+	metrics.Registry.MustRegister(gnmiGetSwitchPortsCounter)
+	metrics.Registry.MustRegister(gnmiGetVlansCounter)
+	metrics.Registry.MustRegister(gnmiUpdateVlanCounter)
+	metrics.Registry.MustRegister(gnmiUpdateModeCounter)
+	metrics.Registry.MustRegister(gnmiUpdateDescriptionCounter)
+	metrics.Registry.MustRegister(gnmiUpdateNativeVlanCounter)
+	metrics.Registry.MustRegister(gnmiUpdateBGPCommunityCounter)
+	metrics.Registry.MustRegister(gnmiGetBGPCommunityCounter)
+	metrics.Registry.MustRegister(gnmiUpdateTrunkGroupsCounter)
+}
+
+// SonicClient implements SwitchClient for SONiC (or any OpenConfig capable) switches using gNMI.
+//
+// SONiC has no concept of Arista-style trunk groups, so they are emulated with vlan names: a vlan belongs to the trunk
+// group with the same name as the vlan, and a port is a member of a trunk group when it trunks all the vlans of that group.
+// Interfaces are expected to use the SONiC "standard" naming mode (Eth1/1, PortChannel10), they are translated to and from
+// the Arista-style names (Ethernet1/1, Port-Channel10) used everywhere else in the sdn-controller.
+type SonicClient struct {
+	// config
+	host               string
+	switchSecretsPath  string
+	port               int
+	transport          string
+	connectionTimeout  time.Duration
+	allowedTrunkGroups []string
+
+	// gnmi
+	mu       sync.RWMutex
+	conn     *grpc.ClientConn
+	gnmi     gpb.GNMIClient
+	username string
+	password string
+
+	ReadOnly             bool // If set to true, will only do "read" type requests.
+	AllowedModes         []string
+	AllowedVlanIds       []int
+	AllowedNativeVlanIds []int
+	ProvisioningVlanIds  []int
+}
+
+// gnmiUpdate is a single path/value pair of a gNMI SetRequest.
+type gnmiUpdate struct {
+	path  string
+	value interface{}
+}
+
+// gnmiSetRequest collects the operations of a single (transactional) gNMI Set.
+type gnmiSetRequest struct {
+	deletes  []string
+	replaces []gnmiUpdate
+	updates  []gnmiUpdate
+}
+
+func NewSonicClient(host string, switchSecretsPath string, port int, transport string, connectionTimeout time.Duration, readOnly bool, allowedVlanIds []int, allowedNativeVlanIds []int, allowedModes []string, allowedTrunkGroups []string, provisioningVlanIds []int) (*SonicClient, error) {
+	_, _, span := obs.LogAndSpanFromContextOrGlobal(context.Background()).WithName("SonicClient.NewSonicClient").Start()
+	defer span.End()
+
+	client := &SonicClient{
+		host:               host,
+		switchSecretsPath:  switchSecretsPath,
+		port:               port,
+		transport:          transport,
+		connectionTimeout:  connectionTimeout,
+		allowedTrunkGroups: allowedTrunkGroups,
+
+		ReadOnly:             readOnly,
+		AllowedVlanIds:       allowedVlanIds,
+		AllowedNativeVlanIds: allowedNativeVlanIds,
+		AllowedModes:         allowedModes,
+		ProvisioningVlanIds:  provisioningVlanIds,
+	}
+
+	err := client.RefreshConnection()
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (s *SonicClient) GetHost() (string, error) {
+	return s.host, nil
+}
+
+func (s *SonicClient) RefreshConnection() error {
+	_, _, span := obs.LogAndSpanFromContextOrGlobal(context.Background()).WithName("SonicClient.RefreshConnection").WithValues(utils.LogFieldSwitchFQDN, s.host).Start()
+	defer span.End()
+
+	// the gNMI credentials use the same file format as the eAPI ones.
+	secretFile, err := os.ReadFile(s.switchSecretsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read file %s err: %v", s.switchSecretsPath, err)
+	}
+	secret := &idcnetworkv1alpha1.EAPISecret{}
+	err = yaml.Unmarshal(secretFile, &secret)
+	if err != nil {
+		return fmt.Errorf("failed unmarshal file %s err: %v", s.switchSecretsPath, err)
+	}
+
+	var transportCreds credentials.TransportCredentials
+	switch s.transport {
+	case GNMITransportTLS:
+		transportCreds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	case GNMITransportTLSSkipVerify:
+		transportCreds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: true})
+	case GNMITransportInsecure:
+		transportCreds = insecure.NewCredentials()
+	default:
+		return fmt.Errorf("unsupported gNMI transport %s", s.transport)
+	}
+
+	conn, err := grpc.NewClient(net.JoinHostPort(s.host, strconv.Itoa(s.port)), grpc.WithTransportCredentials(transportCreds))
+	if err != nil {
+		return fmt.Errorf("error connecting to switch: %v", err)
+	}
+	gnmiClient := gpb.NewGNMIClient(conn)
+
+	// grpc connects lazily, so perform a simple request to make sure the connection and credentials actually work.
+	ctx, cancel := context.WithTimeout(context.Background(), s.connectionTimeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "username", secret.Credentials.Username, "password", secret.Credentials.Password)
+	_, err = gnmiClient.Capabilities(ctx, &gpb.CapabilityRequest{})
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error connecting to switch: %v", err)
+	}
+
+	s.mu.Lock()
+	oldConn := s.conn
+	s.conn = conn
+	s.gnmi = gnmiClient
+	s.username = secret.Credentials.Username
+	s.password = secret.Credentials.Password
+	s.mu.Unlock()
+
+	if oldConn != nil {
+		oldConn.Close()
+	}
+	return nil
+}
+
+func (s *SonicClient) ValidateConnection() error {
+	client, ctx := s.gnmiClient(context.Background())
+	ctx, cancel := context.WithTimeout(ctx, s.connectionTimeout)
+	defer cancel()
+	_, err := client.Capabilities(ctx, &gpb.CapabilityRequest{})
+	return err
+}
+
+// gnmiClient returns the current gNMI client, and ctx with the switch credentials attached.
+func (s *SonicClient) gnmiClient(ctx context.Context) (gpb.GNMIClient, context.Context) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.gnmi, metadata.AppendToOutgoingContext(ctx, "username", s.username, "password", s.password)
+}
+
+// get reads the node at path into out. Returns a NotFound grpc error if the node does not exist.
+func (s *SonicClient) get(ctx context.Context, path string, out interface{}) error {
+	p, err := parseGNMIPath(path)
+	if err != nil {
+		return err
+	}
+	client, ctx := s.gnmiClient(ctx)
+	resp, err := client.Get(ctx, &gpb.GetRequest{
+		Path:     []*gpb.Path{p},
+		Type:     gpb.GetRequest_ALL,
+		Encoding: gpb.Encoding_JSON_IETF,
+	})
+	if err != nil {
+		return err
+	}
+	for _, notification := range resp.GetNotification() {
+		for _, update := range notification.GetUpdate() {
+			return decodeGNMIValue(update.GetVal(), p, out)
+		}
+	}
+	return status.Errorf(codes.NotFound, "no data returned for %s", path)
+}
+
+func (s *SonicClient) set(ctx context.Context, req gnmiSetRequest) error {
+	setReq := &gpb.SetRequest{}
+	for _, path := range req.deletes {
+		p, err := parseGNMIPath(path)
+		if err != nil {
+			return err
+		}
+		setReq.Delete = append(setReq.Delete, p)
+	}
+	toUpdates := func(updates []gnmiUpdate) ([]*gpb.Update, error) {
+		var res []*gpb.Update
+		for _, u := range updates {
+			p, err := parseGNMIPath(u.path)
+			if err != nil {
+				return nil, err
+			}
+			val, err := encodeGNMIValue(u.value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode value for %s, %v", u.path, err)
+			}
+			res = append(res, &gpb.Update{Path: p, Val: val})
+		}
+		return res, nil
+	}
+	var err error
+	setReq.Replace, err = toUpdates(req.replaces)
+	if err != nil {
+		return err
+	}
+	setReq.Update, err = toUpdates(req.updates)
+	if err != nil {
+		return err
+	}
+
+	client, ctx := s.gnmiClient(ctx)
+	_, err = client.Set(ctx, setReq)
+	if err != nil {
+		return fmt.Errorf("gnmi set failed, %v", err)
+	}
+	return nil
+}
+
+// OpenConfig data models. Only the leaves used by the sdn-controller are decoded.
+
+type ocInterfaces struct {
+	Interface []ocInterface `json:"interface"`
+}
+
+type ocInterface struct {
+	Name        string            `json:"name"`
+	Config      ocInterfaceConfig `json:"config"`
+	State       ocInterfaceState  `json:"state"`
+	Ethernet    *ocEthernet       `json:"ethernet,omitempty"`
+	Aggregation *ocAggregation    `json:"aggregation,omitempty"`
+	RoutedVlan  *ocRoutedVlan     `json:"routed-vlan,omitempty"`
+}
+
+type ocInterfaceConfig struct {
+	Name        string `json:"name,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+type ocInterfaceState struct {
+	Description string   `json:"description,omitempty"`
+	AdminStatus string   `json:"admin-status,omitempty"`
+	OperStatus  string   `json:"oper-status,omitempty"`
+	LastChange  ocUint64 `json:"last-change,omitempty"`
+}
+
+type ocEthernet struct {
+	Config       ocEthernetConfig `json:"config"`
+	State        ocEthernetConfig `json:"state"`
+	SwitchedVlan *ocSwitchedVlan  `json:"switched-vlan,omitempty"`
+}
+
+type ocEthernetConfig struct {
+	AggregateId string `json:"aggregate-id,omitempty"`
+	PortSpeed   string `json:"port-speed,omitempty"`
+	DuplexMode  string `json:"duplex-mode,omitempty"`
+}
+
+type ocAggregation struct {
+	Config       ocAggregationConfig `json:"config"`
+	State        ocAggregationState  `json:"state"`
+	SwitchedVlan *ocSwitchedVlan     `json:"switched-vlan,omitempty"`
+}
+
+type ocAggregationConfig struct {
+	LagType string `json:"lag-type,omitempty"`
+}
+
+type ocAggregationState struct {
+	LagType  string `json:"lag-type,omitempty"`
+	LagSpeed int64  `json:"lag-speed,omitempty"` // Mbps
+}
+
+type ocSwitchedVlan struct {
+	Config ocSwitchedVlanConfig `json:"config"`
+}
+
+type ocSwitchedVlanConfig struct {
+	InterfaceMode string        `json:"interface-mode,omitempty"`
+	AccessVlan    int           `json:"access-vlan,omitempty"`
+	NativeVlan    int           `json:"native-vlan,omitempty"`
+	TrunkVlans    []interface{} `json:"trunk-vlans,omitempty"`
+}
+
+type ocRoutedVlan struct {
+	Ipv4 struct {
+		Neighbors struct {
+			Neighbor []ocIpNeighbor `json:"neighbor"`
+		} `json:"neighbors"`
+	} `json:"ipv4"`
+}
+
+type ocIpNeighbor struct {
+	Ip    string `json:"ip"`
+	State struct {
+		Ip               string `json:"ip"`
+		LinkLayerAddress string `json:"link-layer-address"`
+	} `json:"state"`
+}
+
+type ocVlans struct {
+	Vlan []ocVlan `json:"vlan"`
+}
+
+type ocVlan struct {
+	VlanId  int          `json:"vlan-id"`
+	Config  ocVlanConfig `json:"config"`
+	Members struct {
+		Member []struct {
+			State struct {
+				Interface string `json:"interface"`
+			} `json:"state"`
+		} `json:"member"`
+	} `json:"members"`
+}
+
+type ocVlanConfig struct {
+	VlanId int    `json:"vlan-id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type ocMacTableEntries struct {
+	Entry []ocMacTableEntry `json:"entry"`
+}
+
+type ocMacTableEntry struct {
+	MacAddress string `json:"mac-address"`
+	Vlan       int    `json:"vlan"`
+	State      struct {
+		EntryType string `json:"entry-type"`
+	} `json:"state"`
+	Interface struct {
+		InterfaceRef struct {
+			State struct {
+				Interface string `json:"interface"`
+			} `json:"state"`
+		} `json:"interface-ref"`
+	} `json:"interface"`
+}
+
+type ocLldpInterfaces struct {
+	Interface []ocLldpInterface `json:"interface"`
+}
+
+type ocLldpInterface struct {
+	Name      string `json:"name"`
+	Neighbors struct {
+		Neighbor []ocLldpNeighbor `json:"neighbor"`
+	} `json:"neighbors"`
+}
+
+type ocLldpNeighbor struct {
+	Id    string `json:"id"`
+	State struct {
+		SystemName        string `json:"system-name"`
+		SystemDescription string `json:"system-description"`
+		ChassisId         string `json:"chassis-id"`
+		PortId            string `json:"port-id"`
+		PortIdType        string `json:"port-id-type"`
+		PortDescription   string `json:"port-description"`
+		ManagementAddress string `json:"management-address"`
+	} `json:"state"`
+}
+
+type ocLacpInterfaces struct {
+	Interface []struct {
+		Name   string `json:"name"`
+		Config struct {
+			LacpMode string `json:"lacp-mode"`
+		} `json:"config"`
+	} `json:"interface"`
+}
+
+type ocCommunitySet struct {
+	CommunitySetName string `json:"community-set-name"`
+	Config           struct {
+		CommunitySetName string   `json:"community-set-name"`
+		CommunityMember  []string `json:"community-member"`
+	} `json:"config"`
+}
+
+// sdnToSonicInterfaceName converts "Ethernet27/1" to "Eth27/1" and "Port-Channel10" to "PortChannel10".
+func sdnToSonicInterfaceName(name string) (string, error) {
+	if err := utils.ValidatePortValue(name); err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(name, "Ethernet") {
+		return "Eth" + strings.TrimPrefix(name, "Ethernet"), nil
+	}
+	portChannel, err := utils.PortChannelInterfaceNameToNumber(name)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("PortChannel%d", portChannel), nil
+}
+
+// sonicToSdnInterfaceName is the inverse of sdnToSonicInterfaceName. Returns false for interfaces the sdn-controller doesn't manage
+// (Vlan, Loopback and Management interfaces, or Ethernet ports using the SONiC "native" naming mode).
+func sonicToSdnInterfaceName(name string) (string, bool) {
+	if match := sonicEthernetNameRegex.FindStringSubmatch(name); match != nil {
+		return "Ethernet" + match[1], true
+	}
+	if match := sonicPortChannelNameRegex.FindStringSubmatch(name); match != nil {
+		portChannel, err := strconv.Atoi(match[1])
+		if err != nil {
+			return "", false
+		}
+		portChannelName, err := utils.PortChannelNumberToInterfaceName(portChannel)
+		if err != nil {
+			return "", false
+		}
+		return portChannelName, true
+	}
+	return "", false
+}
+
+func sonicInterfacePath(sonicName string) string {
+	return fmt.Sprintf("/interfaces/interface[name=%s]", sonicName)
+}
+
+// sonicSwitchedVlanConfigPath returns the switched-vlan config container, which lives under "aggregation" for port-channels.
+func sonicSwitchedVlanConfigPath(sonicName string) string {
+	if sonicPortChannelNameRegex.MatchString(sonicName) {
+		return sonicInterfacePath(sonicName) + "/aggregation/switched-vlan/config"
+	}
+	return sonicInterfacePath(sonicName) + "/ethernet/switched-vlan/config"
+}
+
+func sonicNetworkInstancePath() string {
+	return fmt.Sprintf("/network-instances/network-instance[name=%s]", sonicDefaultNetworkInstance)
+}
+
+func sonicCommunitySetPath(groupName string) string {
+	return fmt.Sprintf("/routing-policy/defined-sets/bgp-defined-sets/community-sets/community-set[community-set-name=%s]", groupName)
+}
+
+func sonicAdvertiseCommunityPath() string {
+	return fmt.Sprintf("/routing-policy/policy-definitions/policy-definition[name=%s]/statements/statement[name=%s]/actions/bgp-actions/set-community", sonicAdvertiseCommunityPolicy, sonicAdvertiseCommunityStatement)
+}
+
+func (s *SonicClient) UpdateMode(ctx context.Context, req UpdateModeRequest) error {
+	logger := log.FromContext(ctx).WithName("SonicClient.UpdateMode").WithValues(utils.LogFieldMode, req.Mode)
+	startTime := time.Now().UTC()
+
+	err := utils.ValidatePortValue(req.PortName)
+	if err != nil {
+		return fmt.Errorf("ValidatePortValue failed, error: %v", err)
+	}
+
+	err = utils.ValidateModeValue(req.Mode, s.AllowedModes)
+	if err != nil {
+		return fmt.Errorf("ValidateModeValue failed, error: %v", err)
+	}
+
+	sonicName, err := sdnToSonicInterfaceName(req.PortName)
+	if err != nil {
+		return fmt.Errorf("sdnToSonicInterfaceName failed, error: %v", err)
+	}
+	configPath := sonicSwitchedVlanConfigPath(sonicName)
+
+	setReq := gnmiSetRequest{}
+	if req.Mode == "access" {
+		setReq.updates = append(setReq.updates, gnmiUpdate{path: configPath + "/interface-mode", value: "ACCESS"})
+	} else if req.Mode == "trunk" {
+		// same as "no switchport access vlan" on Arista.
+		setReq.deletes = append(setReq.deletes, configPath+"/access-vlan")
+		setReq.updates = append(setReq.updates, gnmiUpdate{path: configPath + "/interface-mode", value: "TRUNK"})
+	}
+
+	if !s.ReadOnly {
+		gnmiUpdateModeCounter.Add(1)
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+		timeElapsed := time.Since(startTime)
+		logger.V(1).Info("SonicClient.UpdateMode success!", utils.LogFieldTimeElapsed, timeElapsed)
+	}
+	return nil
+}
+
+func (s *SonicClient) UpdateVlan(ctx context.Context, req UpdateVlanRequest) error {
+	_, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SonicClient.UpdateVlan").WithValues(utils.LogFieldSwitchFQDN, s.host, utils.LogFieldVlanID, req.Vlan).Start()
+	defer span.End()
+
+	startTime := time.Now().UTC()
+
+	err := utils.ValidatePortValue(req.PortName)
+	if err != nil {
+		return fmt.Errorf("ValidatePortValue failed, error: %v", err)
+	}
+
+	err = utils.ValidateVlanValue(int(req.Vlan), s.AllowedVlanIds)
+	if err != nil {
+		return fmt.Errorf("ValidateVlanValue failed, error: %v", err)
+	}
+
+	entries, err := s.ListVlans(ctx, ListVlansParamsRequest{SwitchFQDN: s.host})
+	if err != nil {
+		return fmt.Errorf("ListVlans failed, error: %v", err)
+	}
+
+	found := false
+	for _, vlan := range entries {
+		if vlan.VlanId == int(req.Vlan) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("Requested Vlan entry not found on the switch\n")
+	}
+
+	sonicName, err := sdnToSonicInterfaceName(req.PortName)
+	if err != nil {
+		return fmt.Errorf("sdnToSonicInterfaceName failed, error: %v", err)
+	}
+
+	setReq := gnmiSetRequest{
+		updates: []gnmiUpdate{
+			{path: sonicSwitchedVlanConfigPath(sonicName) + "/access-vlan", value: int(req.Vlan)},
+		},
+	}
+
+	if req.UpdateLLDP == true {
+		// LLDP is only enabled on the provisioning vlans, so tenants can't see the fabric.
+		provisioningVlan := false
+		for _, vlan := range s.ProvisioningVlanIds {
+			if int(req.Vlan) == vlan {
+				provisioningVlan = true
+				break
+			}
+		}
+		setReq.updates = append(setReq.updates, gnmiUpdate{
+			path:  fmt.Sprintf("/lldp/interfaces/interface[name=%s]/config/enabled", sonicName),
+			value: provisioningVlan,
+		})
+	}
+
+	if !s.ReadOnly {
+		gnmiUpdateVlanCounter.Add(1)
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+		timeElapsed := time.Since(startTime)
+		logger.Info("SonicClient.UpdateVlan success!", utils.LogFieldTimeElapsed, timeElapsed)
+	}
+	return nil
+}
+
+func (s *SonicClient) UpdateDescription(ctx context.Context, req UpdateDescriptionRequest) error {
+	logger := log.FromContext(ctx).WithName("SonicClient.UpdateDescription").WithValues(utils.LogFieldDescription, req.Description)
+	startTime := time.Now().UTC()
+
+	err := utils.ValidatePortValue(req.PortName)
+	if err != nil {
+		return fmt.Errorf("ValidatePortValue failed, error: %v", err)
+	}
+
+	sanitizedDescription, err := utils.ValidateAndSanitizeDescription(req.Description)
+	if err != nil {
+		return fmt.Errorf("ValidateAndSanitizeDescription failed, error: %v", err)
+	}
+
+	sonicName, err := sdnToSonicInterfaceName(req.PortName)
+	if err != nil {
+		return fmt.Errorf("sdnToSonicInterfaceName failed, error: %v", err)
+	}
+
+	setReq := gnmiSetRequest{
+		updates: []gnmiUpdate{
+			{path: sonicInterfacePath(sonicName) + "/config/description", value: sanitizedDescription},
+		},
+	}
+
+	if !s.ReadOnly {
+		gnmiUpdateDescriptionCounter.Add(1)
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+		timeElapsed := time.Since(startTime)
+		logger.Info("SonicClient.UpdateDescription success!", utils.LogFieldTimeElapsed, timeElapsed)
+	}
+	return nil
+}
+
+func (s *SonicClient) UpdateTrunkGroups(ctx context.Context, req UpdateTrunkGroupsRequest) error {
+	logger := log.FromContext(ctx).WithName("SonicClient.UpdateTrunkGroups").WithValues(utils.LogFieldTrunkGroups, req.TrunkGroups)
+	startTime := time.Now().UTC()
+
+	err := utils.ValidatePortValue(req.PortName)
+	if err != nil {
+		return fmt.Errorf("validate PortName failed, error: %v", err)
+	}
+	err = utils.ValidateTrunkGroups(req.TrunkGroups, s.allowedTrunkGroups)
+	if err != nil {
+		return fmt.Errorf("ValidateTrunkGroups failed, error: %v", err)
+	}
+
+	sonicName, err := sdnToSonicInterfaceName(req.PortName)
+	if err != nil {
+		return fmt.Errorf("sdnToSonicInterfaceName failed, error: %v", err)
+	}
+
+	vlans, err := s.ListVlans(ctx, ListVlansParamsRequest{SwitchFQDN: s.host})
+	if err != nil {
+		return fmt.Errorf("ListVlans failed, error: %v", err)
+	}
+
+	// The port trunks exactly the vlans that belong to the requested trunk groups.
+	trunkVlans := []int{}
+	for _, vlan := range vlans {
+		for _, trunkGroup := range vlan.TrunkGroups {
+			if slices.Contains(req.TrunkGroups, trunkGroup) {
+				trunkVlans = append(trunkVlans, vlan.VlanId)
+				break
+			}
+		}
+	}
+
+	configPath := sonicSwitchedVlanConfigPath(sonicName)
+	setReq := gnmiSetRequest{
+		deletes: []string{configPath + "/trunk-vlans"},
+	}
+	if len(trunkVlans) > 0 {
+		setReq.updates = append(setReq.updates, gnmiUpdate{path: configPath + "/trunk-vlans", value: trunkVlans})
+	}
+
+	if !s.ReadOnly {
+		gnmiUpdateTrunkGroupsCounter.Add(1)
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+		timeElapsed := time.Since(startTime)
+		logger.Info("SonicClient.UpdateTrunkGroups success!", utils.LogFieldTimeElapsed, timeElapsed)
+	}
+	return nil
+}
+
+func (s *SonicClient) UpdateNativeVlan(ctx context.Context, req UpdateNativeVlanRequest) error {
+	logger := log.FromContext(ctx).WithName("SonicClient.UpdateNativeVlan").WithValues(utils.LogFieldNativeVlan, req.NativeVlan)
+	startTime := time.Now().UTC()
+
+	err := utils.ValidatePortValue(req.PortName)
+	if err != nil {
+		return fmt.Errorf("validate PortName failed, error: %v", err)
+	}
+	err = utils.ValidateVlanValue(int(req.NativeVlan), s.AllowedNativeVlanIds)
+	if err != nil {
+		return fmt.Errorf("ValidateVlanValue NativeVlan failed, error: %v", err)
+	}
+
+	sonicName, err := sdnToSonicInterfaceName(req.PortName)
+	if err != nil {
+		return fmt.Errorf("sdnToSonicInterfaceName failed, error: %v", err)
+	}
+
+	setReq := gnmiSetRequest{
+		updates: []gnmiUpdate{
+			{path: sonicSwitchedVlanConfigPath(sonicName) + "/native-vlan", value: int(req.NativeVlan)},
+		},
+	}
+
+	if !s.ReadOnly {
+		gnmiUpdateNativeVlanCounter.Add(1)
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+		timeElapsed := time.Since(startTime)
+		logger.Info("SonicClient.UpdateNativeVlan success!", utils.LogFieldTimeElapsed, timeElapsed)
+	}
+	return nil
+}
+
+func (s *SonicClient) UpdateBGPCommunity(ctx context.Context, req UpdateBGPCommunityRequest) error {
+	_, logger, bgpSpan := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SonicClient.UpdateBGPCommunity").WithValues(utils.LogFieldSwitchFQDN, s.host, utils.LogFieldBGPCommunity, req.BGPCommunity).Start()
+	defer bgpSpan.End()
+
+	err := utils.ValidateBGPCommunityValue(req.BGPCommunity)
+	if err != nil {
+		return fmt.Errorf("ValidateBGPCommunityValue failed, error: %v", err)
+	}
+	err = utils.ValidateBGPCommunityGroupName(req.BGPCommunityIncomingGroupName)
+	if err != nil {
+		return fmt.Errorf("ValidateBGPCommunityGroupName failed, error: %v", err)
+	}
+	community, err := utils.BGPCommunityValueToString(int(req.BGPCommunity))
+	if err != nil {
+		return fmt.Errorf("BGPCommunityValueToString failed, error: %v", err)
+	}
+
+	groupName := req.BGPCommunityIncomingGroupName
+	setReq := gnmiSetRequest{
+		replaces: []gnmiUpdate{
+			{
+				// route-map adv-set-comm permit 10 / set community 101:N
+				path: sonicAdvertiseCommunityPath(),
+				value: map[string]interface{}{
+					"config": map[string]interface{}{"method": "INLINE", "options": "REPLACE"},
+					"inline": map[string]interface{}{
+						"config": map[string]interface{}{"communities": []string{community}},
+					},
+				},
+			},
+			{
+				// ip community-list <group> permit 101:N
+				path: sonicCommunitySetPath(groupName),
+				value: map[string]interface{}{
+					"community-set-name": groupName,
+					"config": map[string]interface{}{
+						"community-set-name": groupName,
+						"community-member":   []string{community},
+					},
+				},
+			},
+		},
+	}
+
+	if !s.ReadOnly {
+		gnmiUpdateBGPCommunityCounter.Add(1)
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+		logger.Info("SonicClient.UpdateBGPCommunity success!")
+	}
+
+	return nil
+}
+
+func (s *SonicClient) GetBGPCommunity(ctx context.Context, req GetBGPCommunityRequest) (int, error) {
+	_, _, bgpSpan := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SonicClient.GetBGPCommunity").WithValues(utils.LogFieldSwitchFQDN, s.host).Start()
+	defer bgpSpan.End()
+
+	incomingGroupName := req.BGPCommunityIncomingGroupName
+	err := utils.ValidateBGPCommunityGroupName(incomingGroupName)
+	if err != nil {
+		return 0, fmt.Errorf("ValidateBGPCommunityGroupName failed, error: %v", err)
+	}
+
+	gnmiGetBGPCommunityCounter.Add(1)
+	communitySet := &ocCommunitySet{}
+	err = s.get(ctx, sonicCommunitySetPath(incomingGroupName), communitySet)
+	if status.Code(err) == codes.NotFound {
+		return 0, fmt.Errorf("did not find incoming group %s in response from switch", incomingGroupName)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("gnmi get community-set failed, %v", err)
+	}
+
+	if len(communitySet.Config.CommunityMember) != 1 {
+		return 0, fmt.Errorf("incoming group %s did not have exactly one communityValue", incomingGroupName)
+	}
+
+	communityValue, err := utils.BGPCommunityStringToValue(communitySet.Config.CommunityMember[0])
+	if err != nil {
+		return 0, fmt.Errorf("could not parse communityValue: %v", err)
+	}
+
+	return communityValue, nil
+}
+
+func (s *SonicClient) getInterfaces(ctx context.Context) ([]ocInterface, error) {
+	interfaces := &ocInterfaces{}
+	err := s.get(ctx, "/interfaces", interfaces)
+	if err != nil {
+		return nil, fmt.Errorf("gnmi get interfaces failed, %v", err)
+	}
+	return interfaces.Interface, nil
+}
+
+func (s *SonicClient) GetSwitchPorts(ctx context.Context, req GetSwitchPortsRequest) (map[string]*idcnetworkv1alpha1.SwitchPortStatus, error) {
+	_, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SonicClient.GetSwitchPorts").WithValues(utils.LogFieldSwitchFQDN, s.host).Start()
+	defer span.End()
+
+	gnmiGetSwitchPortsCounter.Add(1)
+	interfaces, err := s.getInterfaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vlans, err := s.ListVlans(ctx, ListVlansParamsRequest{SwitchFQDN: s.host})
+	if err != nil {
+		return nil, fmt.Errorf("ListVlans failed, %v", err)
+	}
+	vlanTrunkGroups := make(map[int][]string)
+	for _, vlan := range vlans {
+		vlanTrunkGroups[vlan.VlanId] = vlan.TrunkGroups
+	}
+
+	res := make(map[string]*idcnetworkv1alpha1.SwitchPortStatus)
+	for _, interf := range interfaces {
+		interfaceName, ok := sonicToSdnInterfaceName(interf.Name)
+		if !ok {
+			if strings.HasPrefix(interf.Name, "Ethernet") {
+				logger.Info("port from switch not valid (is the switch using standard interface naming?). Ignoring!", utils.LogFieldSwitchPortName, interf.Name)
+			}
+			continue
+		}
+		switchPort, err := generateSonicSwitchPort(interfaceName, interf, vlanTrunkGroups)
+		if err != nil {
+			return nil, err
+		}
+		res[interfaceName] = switchPort
+	}
+	return res, nil
+}
+
+func generateSonicSwitchPort(interfaceName string, interf ocInterface, vlanTrunkGroups map[int][]string) (*idcnetworkv1alpha1.SwitchPortStatus, error) {
+	switchPort := &idcnetworkv1alpha1.SwitchPortStatus{
+		Name:                                interfaceName,
+		Description:                         interf.State.Description,
+		LineProtocolStatus:                  strings.ToLower(interf.State.OperStatus),
+		SwitchSideLastStatusChangeTimestamp: int64(interf.State.LastChange / ocUint64(time.Second)),
+	}
+	if switchPort.Description == "" {
+		switchPort.Description = interf.Config.Description
+	}
+
+	if interf.State.AdminStatus == "DOWN" {
+		switchPort.LinkStatus = "disabled"
+	} else if interf.State.OperStatus == "UP" {
+		switchPort.LinkStatus = "connected"
+	} else {
+		switchPort.LinkStatus = "notconnect"
+	}
+
+	var switchedVlan *ocSwitchedVlan
+	if interf.Ethernet != nil {
+		switchedVlan = interf.Ethernet.SwitchedVlan
+		speed := interf.Ethernet.State.PortSpeed
+		if speed == "" {
+			speed = interf.Ethernet.Config.PortSpeed
+		}
+		if match := sonicPortSpeedRegex.FindStringSubmatch(speed); match != nil {
+			bandwidth, _ := strconv.Atoi(match[1])
+			if match[2] == "GB" {
+				bandwidth *= 1000
+			}
+			switchPort.Bandwidth = bandwidth * 1000000
+		}
+		switch interf.Ethernet.Config.DuplexMode {
+		case "FULL":
+			switchPort.Duplex = "duplexFull"
+		case "HALF":
+			switchPort.Duplex = "duplexHalf"
+		}
+		if match := sonicPortChannelNameRegex.FindStringSubmatch(interf.Ethernet.Config.AggregateId); match != nil {
+			portChannel, err := strconv.Atoi(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse aggregate-id %s, %v", interf.Ethernet.Config.AggregateId, err)
+			}
+			switchPort.PortChannel = int64(portChannel)
+		}
+	}
+	if interf.Aggregation != nil {
+		switchedVlan = interf.Aggregation.SwitchedVlan
+		switchPort.Bandwidth = int(interf.Aggregation.State.LagSpeed) * 1000000
+		switchPort.Duplex = "duplexFull"
+	}
+
+	if switchedVlan == nil || switchedVlan.Config.InterfaceMode == "" {
+		switchPort.Mode = "routed"
+		return switchPort, nil
+	}
+
+	switchPort.Mode = strings.ToLower(switchedVlan.Config.InterfaceMode)
+	switchPort.NativeVlan = int64(switchedVlan.Config.NativeVlan)
+	if switchPort.Mode == "access" {
+		switchPort.VlanId = int64(switchedVlan.Config.AccessVlan)
+	}
+
+	trunkVlans, err := parseTrunkVlans(switchedVlan.Config.TrunkVlans)
+	if err != nil {
+		return nil, fmt.Errorf("interface %s: %v", interfaceName, err)
+	}
+	trunkGroups := []string{}
+	for _, vlan := range trunkVlans {
+		for _, trunkGroup := range vlanTrunkGroups[vlan] {
+			if !slices.Contains(trunkGroups, trunkGroup) {
+				trunkGroups = append(trunkGroups, trunkGroup)
+			}
+		}
+	}
+	if len(trunkGroups) >= 1 {
+		sort.Strings(trunkGroups)
+		switchPort.TrunkGroups = trunkGroups
+	}
+	return switchPort, nil
+}
+
+func (s *SonicClient) GetPortDetails(ctx context.Context, req PortParamsRequest) (ResPortInfo, error) {
+	entry := ResPortInfo{}
+
+	err := utils.ValidateSwitchFQDN(req.SwitchFQDN, "")
+	if err != nil {
+		return entry, fmt.Errorf("BadRequest: %v", err)
+	}
+
+	interfaceName := ""
+	if req.SwitchPort != "" {
+		// Port number should be <num>[/<num>] format (no "Ethernet" prefix)
+		err = utils.ValidatePortNumber(req.SwitchPort)
+		if err != nil {
+			return entry, fmt.Errorf("BadRequest: %v", err)
+		}
+		interfaceName = fmt.Sprintf("Ethernet%s", req.SwitchPort)
+	} else if req.PortChannel != 0 {
+		interfaceName, err = utils.PortChannelNumberToInterfaceName(req.PortChannel)
+		if err != nil {
+			return entry, fmt.Errorf("BadRequest: %v", err)
+		}
+	} else {
+		return entry, fmt.Errorf("BadRequest: SwitchPort or PortChannel must be specified")
+	}
+
+	allInterfacesStatus, err := s.GetSwitchPorts(ctx, GetSwitchPortsRequest{
+		SwitchFQDN: req.SwitchFQDN,
+	})
+	if err != nil {
+		return entry, err
+	}
+
+	interf, found := allInterfacesStatus[interfaceName]
+	if !found || interf == nil {
+		return entry, fmt.Errorf("BadRequest: Interface %s not found", interfaceName)
+	}
+	return convertSwitchPortStatusToResPortInfo(interf), nil
+}
+
+func (s *SonicClient) ListPortsDetails(ctx context.Context, req ListPortParamsRequest) ([]ResPortInfo, error) {
+	list := []ResPortInfo{}
+
+	err := utils.ValidateSwitchFQDN(req.SwitchFQDN, "")
+	if err != nil {
+		return list, fmt.Errorf("BadRequest: %v", err)
+	}
+
+	allInterfacesStatus, err := s.GetSwitchPorts(ctx, GetSwitchPortsRequest{
+		SwitchFQDN: req.SwitchFQDN,
+	})
+	if err != nil {
+		return list, err
+	}
+
+	for _, interf := range allInterfacesStatus {
+		list = append(list, convertSwitchPortStatusToResPortInfo(interf))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].InterfaceName < list[j].InterfaceName
+	})
+	return list, nil
+}
+
+func (s *SonicClient) ListVlans(ctx context.Context, req ListVlansParamsRequest) ([]VlanWithTrunkGroups, error) {
+	entries := []VlanWithTrunkGroups{}
+
+	gnmiGetVlansCounter.Add(1)
+	vlans := &ocVlans{}
+	err := s.get(ctx, sonicNetworkInstancePath()+"/vlans", vlans)
+	if status.Code(err) == codes.NotFound {
+		return entries, nil
+	}
+	if err != nil {
+		return entries, fmt.Errorf("gnmi get vlans failed, %v", err)
+	}
+
+	for _, vlan := range vlans.Vlan {
+		entry := VlanWithTrunkGroups{
+			VlanId:         vlan.VlanId,
+			Name:           vlan.Config.Name,
+			Status:         strings.ToLower(vlan.Config.Status),
+			InterfaceNames: []string{},
+		}
+		if entry.Status == "" {
+			entry.Status = "active"
+		}
+		// Vlans keeping their default name don't belong to any trunk group.
+		if vlan.Config.Name != "" && !sonicDefaultVlanNameRegex.MatchString(vlan.Config.Name) {
+			entry.TrunkGroups = []string{vlan.Config.Name}
+		}
+		for _, member := range vlan.Members.Member {
+			interfaceName, ok := sonicToSdnInterfaceName(member.State.Interface)
+			if !ok {
+				interfaceName = member.State.Interface
+			}
+			entry.InterfaceNames = append(entry.InterfaceNames, interfaceName)
+		}
+
+		sort.Strings(entry.InterfaceNames)
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].VlanId < entries[j].VlanId
+	})
+	return entries, nil
+}
+
+// portChannelMembers returns the member ports of each port-channel, keyed by port-channel name (eg. "Port-Channel10").
+func portChannelMembers(interfaces []ocInterface) map[string][]ocInterface {
+	members := make(map[string][]ocInterface)
+	for _, interf := range interfaces {
+		if interf.Ethernet == nil || interf.Ethernet.Config.AggregateId == "" {
+			continue
+		}
+		portChannelName, ok := sonicToSdnInterfaceName(interf.Ethernet.Config.AggregateId)
+		if !ok {
+			continue
+		}
+		members[portChannelName] = append(members[portChannelName], interf)
+	}
+	return members
+}
+
+func (s *SonicClient) GetMacAddressTable(ctx context.Context, req ListMacAddressTableRequest) ([]ResMacAddressTableEntry, error) {
+	toReturn := []ResMacAddressTableEntry{}
+
+	macEntries := &ocMacTableEntries{}
+	err := s.get(ctx, sonicNetworkInstancePath()+"/fdb/mac-table/entries", macEntries)
+	if status.Code(err) == codes.NotFound {
+		return toReturn, nil
+	}
+	if err != nil {
+		return toReturn, fmt.Errorf("gnmi get mac-table failed, %v", err)
+	}
+
+	interfaces, err := s.getInterfaces(ctx)
+	if err != nil {
+		return toReturn, err
+	}
+	members := portChannelMembers(interfaces)
+
+	for _, item := range macEntries.Entry {
+		if item.State.EntryType == "STATIC" {
+			continue
+		}
+		// only entries learnt on front-panel ports and port-channels are returned, which excludes the uplinks / vxlan tunnels.
+		intf, ok := sonicToSdnInterfaceName(item.Interface.InterfaceRef.State.Interface)
+		if !ok {
+			continue
+		}
+		// Consider port-channel as interface as well, same as the AristaClient.
+		if portChannelMembers, found := members[intf]; found {
+			names := []string{}
+			for _, member := range portChannelMembers {
+				name, _ := sonicToSdnInterfaceName(member.Name)
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			intf = strings.Join(names, "")
+		}
+		toReturn = append(toReturn, ResMacAddressTableEntry{Interface: intf, MacAddress: item.MacAddress, VlanTag: item.Vlan})
+	}
+
+	return toReturn, nil
+}
+
+func (s *SonicClient) getLLDPInterfaces(ctx context.Context) ([]ocLldpInterface, error) {
+	lldpInterfaces := &ocLldpInterfaces{}
+	err := s.get(ctx, "/lldp/interfaces", lldpInterfaces)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("gnmi get lldp interfaces failed, %v", err)
+	}
+	return lldpInterfaces.Interface, nil
+}
+
+func (s *SonicClient) GetLLDPNeighbors(ctx context.Context, req PortParamsRequest) ([]ResLLDPNeighbors, error) {
+	entries := []ResLLDPNeighbors{}
+
+	err := utils.ValidateSwitchFQDN(req.SwitchFQDN, "")
+	if err != nil {
+		return entries, fmt.Errorf("BadRequest: %v", err)
+	}
+
+	lldpInterfaces, err := s.getLLDPInterfaces(ctx)
+	if err != nil {
+		return entries, err
+	}
+
+	for _, lldpInterface := range lldpInterfaces {
+		if len(lldpInterface.Neighbors.Neighbor) == 0 {
+			continue
+		}
+		port, ok := sonicToSdnInterfaceName(lldpInterface.Name)
+		if !ok {
+			port = lldpInterface.Name
+		}
+		lldpNeighbors := []ResLDPIntNeighbors{}
+		for _, neighbor := range lldpInterface.Neighbors.Neighbor {
+			lldpNeighbors = append(lldpNeighbors, ResLDPIntNeighbors{
+				NeighborInterfaceId: neighbor.State.PortId,
+				NeighborName:        neighbor.State.SystemName,
+			})
+		}
+		entries = append(entries, ResLLDPNeighbors{
+			Interface:          port,
+			ResLDPIntNeighbors: lldpNeighbors,
+		})
+	}
+
+	return entries, nil
+}
+
+func (s *SonicClient) GetLLDPPortNeighbors(ctx context.Context, req PortParamsRequest) ([]ResLLDPPortNeighbors, error) {
+	entries := []ResLLDPPortNeighbors{}
+
+	err := utils.ValidateSwitchFQDN(req.SwitchFQDN, "")
+	if err != nil {
+		return entries, fmt.Errorf("BadRequest: %v", err)
+	}
+
+	if req.SwitchPort == "none" {
+		return entries, nil
+	}
+
+	// Port number should be <num>[/<num>] format (no "Ethernet" prefix)
+	err = utils.ValidatePortNumber(req.SwitchPort)
+	if err != nil {
+		return entries, fmt.Errorf("BadRequest: %v", err)
+	}
+	port := fmt.Sprintf("Ethernet%s", req.SwitchPort)
+	sonicName, err := sdnToSonicInterfaceName(port)
+	if err != nil {
+		return entries, fmt.Errorf("BadRequest: %v", err)
+	}
+
+	lldpInterface := &ocLldpInterface{}
+	err = s.get(ctx, fmt.Sprintf("/lldp/interfaces/interface[name=%s]", sonicName), lldpInterface)
+	if status.Code(err) == codes.NotFound {
+		return entries, nil
+	}
+	if err != nil {
+		return entries, fmt.Errorf("gnmi get lldp interface failed, %v", err)
+	}
+
+	lldpNeighbors := []ResLLDPPortNeighbor{}
+	for _, neighbor := range lldpInterface.Neighbors.Neighbor {
+		neighborIP := "no data found"
+		if neighbor.State.ManagementAddress != "" {
+			neighborIP = neighbor.State.ManagementAddress
+		}
+		neighborName := neighbor.State.SystemName
+		if neighborName == "" {
+			neighborName = neighborIP
+		}
+		lldpNeighbors = append(lldpNeighbors, ResLLDPPortNeighbor{
+			NeighborName:        neighborName,
+			NeighborSystemDescr: neighbor.State.SystemDescription,
+			NeighborMgmtIP:      neighborIP,
+			NeighborChassId:     neighbor.State.ChassisId,
+			NeighborIntfDescr:   neighbor.State.PortDescription,
+			NeighborIntfId:      neighbor.State.PortId,
+			NeighborIntfType:    neighbor.State.PortIdType,
+			NeighborVlanName:    map[string]string{},
+		})
+	}
+	entries = append(entries, ResLLDPPortNeighbors{
+		Interface:          port,
+		ResLDPIntNeighbors: lldpNeighbors,
+	})
+
+	return entries, nil
+}
+
+// SaveConfig copies the running configuration to the startup configuration using the sonic-config-mgmt "copy" RPC.
+func (s *SonicClient) SaveConfig(ctx context.Context, fqdn string) (string, error) {
+	err := utils.ValidateSwitchFQDN(fqdn, "")
+	if err != nil {
+		return "ValidateSwitchFQDN failed", err
+	}
+
+	setReq := gnmiSetRequest{
+		updates: []gnmiUpdate{
+			{
+				path: "/sonic-config-mgmt:copy",
+				value: map[string]interface{}{
+					"sonic-config-mgmt:input": map[string]interface{}{
+						"source":      "running-configuration",
+						"destination": "startup-configuration",
+					},
+				},
+			},
+		},
+	}
+
+	if !s.ReadOnly {
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+func (s *SonicClient) GetIpMacInfo(ctx context.Context, req ParamsRequest) ([]ResIpMacInfo, error) {
+	entries := []ResIpMacInfo{}
+
+	macEntries, err := s.GetMacAddressTable(ctx, ListMacAddressTableRequest{SwitchFQDN: req.SwitchFQDN})
+	if err != nil {
+		return entries, err
+	}
+
+	interfaces, err := s.getInterfaces(ctx)
+	if err != nil {
+		return entries, err
+	}
+	// ARP entries live on the vlan (SVI) interfaces.
+	macToIp := make(map[string]string)
+	for _, interf := range interfaces {
+		if interf.RoutedVlan == nil {
+			continue
+		}
+		for _, neighbor := range interf.RoutedVlan.Ipv4.Neighbors.Neighbor {
+			ip := neighbor.State.Ip
+			if ip == "" {
+				ip = neighbor.Ip
+			}
+			macToIp[strings.ToLower(neighbor.State.LinkLayerAddress)] = ip
+		}
+	}
+
+	for _, macEntry := range macEntries {
+		entries = append(entries, ResIpMacInfo{
+			Interface:  macEntry.Interface,
+			IpAddress:  macToIp[strings.ToLower(macEntry.MacAddress)],
+			MacAddress: macEntry.MacAddress,
+			VlanNum:    macEntry.VlanTag,
+		})
+	}
+	return entries, nil
+}
+
+// GetPortRunningConfig returns the (indented json) OpenConfig configuration of a port, one line per entry.
+func (s *SonicClient) GetPortRunningConfig(ctx context.Context, req PortParamsRequest) ([]string, error) {
+	entries := []string{}
+
+	var interfaceName string
+	var err error
+	if req.SwitchPort != "" {
+		err = utils.ValidatePortNumber(req.SwitchPort)
+		if err != nil {
+			return nil, err
+		}
+		interfaceName = fmt.Sprintf("Ethernet%s", req.SwitchPort)
+	} else if req.PortChannel != 0 {
+		interfaceName, err = utils.PortChannelNumberToInterfaceName(req.PortChannel)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return entries, fmt.Errorf("must specify switchport or portchannel")
+	}
+
+	sonicName, err := sdnToSonicInterfaceName(interfaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var interfaceConfig interface{}
+	err = s.get(ctx, sonicInterfacePath(sonicName), &interfaceConfig)
+	if err != nil {
+		return entries, fmt.Errorf("gnmi get interface %s failed: %v", sonicName, err)
+	}
+	b, err := json.MarshalIndent(interfaceConfig, "", "  ")
+	if err != nil {
+		return entries, err
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			entries = append(entries, line)
+		}
+	}
+	return entries, nil
+}
+
+func (s *SonicClient) ClearMacAddressTable(ctx context.Context, fqdn string) (string, error) {
+	message := ""
+	err := utils.ValidateSwitchFQDN(fqdn, "")
+	if err != nil {
+		return message, fmt.Errorf("BadRequest: %v", err)
+	}
+
+	setReq := gnmiSetRequest{
+		deletes: []string{sonicNetworkInstancePath() + "/fdb/mac-table/entries"},
+	}
+	err = s.set(ctx, setReq)
+	if err != nil {
+		return message, err
+	}
+	return message, nil
+}
+
+func (s *SonicClient) AssignSwitchPortToPortChannel(ctx context.Context, req AssignSwitchPortToPortChannelRequest) error {
+	_, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SonicClient.AssignSwitchPortToPortChannel").Start()
+	defer span.End()
+	logger.Info("Starting AssignSwitchPortToPortChannel", "req", req)
+
+	sonicName, err := sdnToSonicInterfaceName(req.SwitchPort)
+	if err != nil {
+		return fmt.Errorf("sdnToSonicInterfaceName failed, error: %v", err)
+	}
+	portChannelName, err := utils.PortChannelNumberToInterfaceName(int(req.TargetPortChannel))
+	if err != nil {
+		return err
+	}
+	sonicPortChannelName, err := sdnToSonicInterfaceName(portChannelName)
+	if err != nil {
+		return err
+	}
+
+	setReq := gnmiSetRequest{
+		updates: []gnmiUpdate{
+			{path: sonicInterfacePath(sonicName) + "/ethernet/config/aggregate-id", value: sonicPortChannelName},
+			// lacp timer fast
+			{path: fmt.Sprintf("/lacp/interfaces/interface[name=%s]/config/interval", sonicPortChannelName), value: "FAST"},
+		},
+	}
+
+	if !s.ReadOnly {
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SonicClient) RemoveSwitchPortFromPortChannel(ctx context.Context, req RemoveSwitchPortFromPortChannelRequest) error {
+	sonicName, err := sdnToSonicInterfaceName(req.SwitchPort)
+	if err != nil {
+		return fmt.Errorf("sdnToSonicInterfaceName failed, error: %v", err)
+	}
+
+	setReq := gnmiSetRequest{
+		deletes: []string{sonicInterfacePath(sonicName) + "/ethernet/config/aggregate-id"},
+	}
+
+	if !s.ReadOnly {
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SonicClient) GetPortChannels(ctx context.Context, req GetPortChannelsRequest) (map[string]PortChannel, error) {
+	interfaces, err := s.getInterfaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lacpModes := make(map[string]string)
+	lacpInterfaces := &ocLacpInterfaces{}
+	err = s.get(ctx, "/lacp/interfaces", lacpInterfaces)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, fmt.Errorf("gnmi get lacp interfaces failed, %v", err)
+	}
+	for _, lacpInterface := range lacpInterfaces.Interface {
+		lacpModes[lacpInterface.Name] = strings.ToLower(lacpInterface.Config.LacpMode)
+	}
+
+	members := portChannelMembers(interfaces)
+	portChannels := make(map[string]PortChannel)
+	for _, interf := range interfaces {
+		if interf.Aggregation == nil {
+			continue
+		}
+		portChannelName, ok := sonicToSdnInterfaceName(interf.Name)
+		if !ok {
+			continue
+		}
+
+		portChannel := PortChannel{
+			LacpMode:  lacpModes[interf.Name],
+			Protocol:  "lacp",
+			LinkState: strings.ToLower(interf.State.OperStatus),
+			Ports:     make(map[string]PortChannelPortMember),
+		}
+		lagType := interf.Aggregation.State.LagType
+		if lagType == "" {
+			lagType = interf.Aggregation.Config.LagType
+		}
+		if lagType == "STATIC" {
+			portChannel.Protocol = "static"
+		} else if portChannel.LacpMode == "" {
+			portChannel.LacpMode = "active"
+		}
+
+		for _, member := range members[portChannelName] {
+			memberName, _ := sonicToSdnInterfaceName(member.Name)
+			linkUp := member.State.OperStatus == "UP"
+			linkDown := !linkUp
+			staticLag := portChannel.Protocol == "static"
+			portChannel.Ports[memberName] = PortChannelPortMember{
+				Intf:      memberName,
+				LagMember: &linkUp,
+				LinkDown:  &linkDown,
+				StaticLag: &staticLag,
+			}
+		}
+		portChannels[portChannelName] = portChannel
+	}
+
+	return portChannels, nil
+}
+
+// CreatePortChannel creates the port-channel, or does nothing if it already exists.
+func (s *SonicClient) CreatePortChannel(ctx context.Context, req CreatePortChannelRequest) error {
+	portChannelName, err := utils.PortChannelNumberToInterfaceName(int(req.PortChannel))
+	if err != nil {
+		return err
+	}
+	sonicName, err := sdnToSonicInterfaceName(portChannelName)
+	if err != nil {
+		return err
+	}
+
+	setReq := gnmiSetRequest{
+		updates: []gnmiUpdate{
+			{
+				path: sonicInterfacePath(sonicName),
+				value: map[string]interface{}{
+					"name":        sonicName,
+					"config":      map[string]interface{}{"name": sonicName, "type": "iana-if-type:ieee8023adLag"},
+					"aggregation": map[string]interface{}{"config": map[string]interface{}{"lag-type": "LACP"}},
+				},
+			},
+		},
+	}
+
+	if !s.ReadOnly {
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SonicClient) DeletePortChannel(ctx context.Context, req DeletePortChannelRequest) error {
+	portChannelName, err := utils.PortChannelNumberToInterfaceName(int(req.TargetPortChannel))
+	if err != nil {
+		return err
+	}
+	sonicName, err := sdnToSonicInterfaceName(portChannelName)
+	if err != nil {
+		return err
+	}
+
+	setReq := gnmiSetRequest{
+		deletes: []string{sonicInterfacePath(sonicName)},
+	}
+
+	if !s.ReadOnly {
+		err = s.set(ctx, setReq)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
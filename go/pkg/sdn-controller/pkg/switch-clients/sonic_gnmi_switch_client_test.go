@@ -0,0 +1,526 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package switchclients
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	idcnetworkv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/sdn-controller/api/v1alpha1"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+const testSonicFQDN = "clab-sonic-leaf1"
+
+const testSonicInitialState = `{
+  "openconfig-interfaces:interfaces": {
+    "interface": [
+      {
+        "name": "Eth1/1",
+        "config": {"name": "Eth1/1", "description": "host-a"},
+        "state": {"admin-status": "UP", "oper-status": "UP", "last-change": "1700000000000000000"},
+        "ethernet": {
+          "config": {"port-speed": "openconfig-if-ethernet:SPEED_100GB", "duplex-mode": "FULL"},
+          "switched-vlan": {"config": {"interface-mode": "ACCESS", "access-vlan": 100}}
+        }
+      },
+      {
+        "name": "Eth1/2",
+        "config": {"name": "Eth1/2"},
+        "state": {"admin-status": "UP", "oper-status": "DOWN"},
+        "ethernet": {
+          "config": {"port-speed": "SPEED_25GB"},
+          "switched-vlan": {"config": {"interface-mode": "TRUNK", "native-vlan": 1, "trunk-vlans": [100, "200..201"]}}
+        }
+      },
+      {
+        "name": "Eth1/3",
+        "config": {"name": "Eth1/3"},
+        "state": {"admin-status": "DOWN", "oper-status": "DOWN"},
+        "ethernet": {"config": {"aggregate-id": "PortChannel10"}}
+      },
+      {
+        "name": "PortChannel10",
+        "config": {"name": "PortChannel10"},
+        "state": {"admin-status": "UP", "oper-status": "UP"},
+        "aggregation": {
+          "state": {"lag-type": "LACP", "lag-speed": 100000},
+          "switched-vlan": {"config": {"interface-mode": "TRUNK", "native-vlan": 1, "trunk-vlans": [300]}}
+        }
+      },
+      {
+        "name": "Ethernet0",
+        "config": {"name": "Ethernet0"}
+      },
+      {
+        "name": "Vlan100",
+        "config": {"name": "Vlan100"},
+        "routed-vlan": {"ipv4": {"neighbors": {"neighbor": [
+          {"ip": "10.0.0.5", "state": {"ip": "10.0.0.5", "link-layer-address": "aa:bb:cc:dd:ee:01"}}
+        ]}}}
+      }
+    ]
+  },
+  "openconfig-network-instance:network-instances": {
+    "network-instance": [
+      {
+        "name": "default",
+        "vlans": {"vlan": [
+          {"vlan-id": 100, "config": {"vlan-id": 100, "name": "Vlan100", "status": "ACTIVE"},
+           "members": {"member": [{"state": {"interface": "Eth1/1"}}, {"state": {"interface": "Eth1/2"}}]}},
+          {"vlan-id": 200, "config": {"vlan-id": 200, "name": "Tenant_Nets", "status": "ACTIVE"}},
+          {"vlan-id": 201, "config": {"vlan-id": 201, "name": "Tenant_Nets", "status": "ACTIVE"}},
+          {"vlan-id": 300, "config": {"vlan-id": 300, "name": "Provider_Nets", "status": "ACTIVE"}}
+        ]},
+        "fdb": {"mac-table": {"entries": {"entry": [
+          {"mac-address": "aa:bb:cc:dd:ee:01", "vlan": 100, "state": {"entry-type": "DYNAMIC"},
+           "interface": {"interface-ref": {"state": {"interface": "Eth1/1"}}}},
+          {"mac-address": "aa:bb:cc:dd:ee:02", "vlan": 300, "state": {"entry-type": "DYNAMIC"},
+           "interface": {"interface-ref": {"state": {"interface": "PortChannel10"}}}},
+          {"mac-address": "aa:bb:cc:dd:ee:03", "vlan": 100, "state": {"entry-type": "DYNAMIC"},
+           "interface": {"interface-ref": {"state": {"interface": "Vxlan1"}}}}
+        ]}}}
+      }
+    ]
+  },
+  "openconfig-lldp:lldp": {
+    "interfaces": {"interface": [
+      {"name": "Eth1/1", "neighbors": {"neighbor": [
+        {"id": "1", "state": {"system-name": "host-a", "system-description": "Ubuntu", "chassis-id": "aa:bb:cc:dd:ee:01",
+         "port-id": "enp1s0", "port-id-type": "INTERFACE_NAME", "port-description": "uplink", "management-address": "10.0.0.5"}}
+      ]}},
+      {"name": "Eth1/2"}
+    ]}
+  },
+  "openconfig-routing-policy:routing-policy": {
+    "defined-sets": {"bgp-defined-sets": {"community-sets": {"community-set": [
+      {"community-set-name": "incoming", "config": {"community-set-name": "incoming", "community-member": ["101:5"]}}
+    ]}}}
+  }
+}`
+
+func newTestSonicClient(t *testing.T, readOnly bool) (*SonicClient, *FakeGNMIServer) {
+	t.Helper()
+	server, err := NewFakeGNMIServer(testSonicInitialState)
+	if err != nil {
+		t.Fatalf("NewFakeGNMIServer failed: %v", err)
+	}
+	server.Username = "admin"
+	server.Password = "secret"
+	addr, err := server.Start()
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(server.Stop)
+
+	secretsPath := filepath.Join(t.TempDir(), "secret.yaml")
+	err = os.WriteFile(secretsPath, []byte("credentials:\n  username: admin\n  password: secret\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	host, portStr, _ := net.SplitHostPort(addr)
+	port, _ := strconv.Atoi(portStr)
+	client, err := NewSonicClient(host, secretsPath, port, GNMITransportInsecure, 5*time.Second, readOnly,
+		[]int{100, 200, 201, 300}, []int{1, 100}, []string{"access", "trunk"}, []string{"Tenant_Nets", "Provider_Nets"}, []int{100})
+	if err != nil {
+		t.Fatalf("NewSonicClient failed: %v", err)
+	}
+	return client, server
+}
+
+func TestParseGNMIPath(t *testing.T) {
+	path, err := parseGNMIPath("/interfaces/interface[name=Eth1/1]/ethernet/switched-vlan/config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []*gpb.PathElem{
+		{Name: "interfaces"},
+		{Name: "interface", Key: map[string]string{"name": "Eth1/1"}},
+		{Name: "ethernet"},
+		{Name: "switched-vlan"},
+		{Name: "config"},
+	}
+	if len(path.Elem) != len(expected) {
+		t.Fatalf("expected %d elems, got %d", len(expected), len(path.Elem))
+	}
+	for i := range expected {
+		if path.Elem[i].Name != expected[i].Name || !cmp.Equal(path.Elem[i].Key, expected[i].Key) {
+			t.Errorf("elem %d: expected %v, got %v", i, expected[i], path.Elem[i])
+		}
+	}
+	if s := gnmiPathToString(path); s != "/interfaces/interface[name=Eth1/1]/ethernet/switched-vlan/config" {
+		t.Errorf("unexpected path string %s", s)
+	}
+
+	for _, invalid := range []string{"/interfaces/interface[name=Eth1/1", "/interfaces//interface", "/interface[name]"} {
+		if _, err := parseGNMIPath(invalid); err == nil {
+			t.Errorf("expected error for %s", invalid)
+		}
+	}
+}
+
+func TestDecodeGNMIValue(t *testing.T) {
+	path, _ := parseGNMIPath("/interfaces/interface[name=Eth1/1]/ethernet/switched-vlan/config")
+	val := &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(
+		`{"openconfig-vlan:config": {"interface-mode": "openconfig-vlan-types:TRUNK", "trunk-vlans": [10, "20..22"]}}`)}}
+
+	config := ocSwitchedVlanConfig{}
+	err := decodeGNMIValue(val, path, &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.InterfaceMode != "TRUNK" {
+		t.Errorf("expected TRUNK, got %s", config.InterfaceMode)
+	}
+	vlans, err := parseTrunkVlans(config.TrunkVlans)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(vlans, []int{10, 20, 21, 22}) {
+		t.Errorf("unexpected trunk vlans %v", vlans)
+	}
+}
+
+func TestSonicInterfaceNames(t *testing.T) {
+	for sdnName, sonicName := range map[string]string{"Ethernet27/1": "Eth27/1", "Ethernet3": "Eth3", "Port-Channel10": "PortChannel10"} {
+		got, err := sdnToSonicInterfaceName(sdnName)
+		if err != nil || got != sonicName {
+			t.Errorf("sdnToSonicInterfaceName(%s) = %s, %v", sdnName, got, err)
+		}
+		back, ok := sonicToSdnInterfaceName(sonicName)
+		if !ok || back != sdnName {
+			t.Errorf("sonicToSdnInterfaceName(%s) = %s, %v", sonicName, back, ok)
+		}
+	}
+	for _, name := range []string{"Ethernet0", "Vlan100", "Loopback0", "Management0"} {
+		if _, ok := sonicToSdnInterfaceName(name); ok {
+			t.Errorf("expected %s to be ignored", name)
+		}
+	}
+	if _, err := sdnToSonicInterfaceName("Eth1/1"); err == nil {
+		t.Errorf("expected error for invalid port name")
+	}
+}
+
+func TestSonicClientGetSwitchPorts(t *testing.T) {
+	client, _ := newTestSonicClient(t, false)
+
+	ports, err := client.GetSwitchPorts(context.Background(), GetSwitchPortsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]*idcnetworkv1alpha1.SwitchPortStatus{
+		"Ethernet1/1": {
+			Name: "Ethernet1/1", Mode: "access", VlanId: 100, Description: "host-a", LinkStatus: "connected", LineProtocolStatus: "up",
+			Bandwidth: 100000000000, Duplex: "duplexFull", SwitchSideLastStatusChangeTimestamp: 1700000000,
+		},
+		"Ethernet1/2": {
+			Name: "Ethernet1/2", Mode: "trunk", NativeVlan: 1, LinkStatus: "notconnect", LineProtocolStatus: "down",
+			Bandwidth: 25000000000, TrunkGroups: []string{"Tenant_Nets"},
+		},
+		"Ethernet1/3": {
+			Name: "Ethernet1/3", Mode: "routed", LinkStatus: "disabled", LineProtocolStatus: "down", PortChannel: 10,
+		},
+		"Port-Channel10": {
+			Name: "Port-Channel10", Mode: "trunk", NativeVlan: 1, LinkStatus: "connected", LineProtocolStatus: "up",
+			Bandwidth: 100000000000, Duplex: "duplexFull", TrunkGroups: []string{"Provider_Nets"},
+		},
+	}
+	if diff := cmp.Diff(expected, ports); diff != "" {
+		t.Errorf("GetSwitchPorts mismatch (-want +got):\n%s", diff)
+	}
+
+	details, err := client.GetPortDetails(context.Background(), PortParamsRequest{SwitchFQDN: testSonicFQDN, PortChannel: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Mode != "trunk" || details.NativeVlan != 1 {
+		t.Errorf("unexpected port-channel details %+v", details)
+	}
+}
+
+func TestSonicClientListVlans(t *testing.T) {
+	client, _ := newTestSonicClient(t, false)
+
+	vlans, err := client.ListVlans(context.Background(), ListVlansParamsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []VlanWithTrunkGroups{
+		{VlanId: 100, Status: "active", Name: "Vlan100", InterfaceNames: []string{"Ethernet1/1", "Ethernet1/2"}},
+		{VlanId: 200, Status: "active", Name: "Tenant_Nets", InterfaceNames: []string{}, TrunkGroups: []string{"Tenant_Nets"}},
+		{VlanId: 201, Status: "active", Name: "Tenant_Nets", InterfaceNames: []string{}, TrunkGroups: []string{"Tenant_Nets"}},
+		{VlanId: 300, Status: "active", Name: "Provider_Nets", InterfaceNames: []string{}, TrunkGroups: []string{"Provider_Nets"}},
+	}
+	if diff := cmp.Diff(expected, vlans); diff != "" {
+		t.Errorf("ListVlans mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSonicClientUpdatePort(t *testing.T) {
+	ctx := context.Background()
+	client, server := newTestSonicClient(t, false)
+
+	err := client.UpdateMode(ctx, UpdateModeRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", Mode: "trunk"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := server.Node("/interfaces/interface[name=Eth1/1]/ethernet/switched-vlan/config/access-vlan"); err == nil {
+		t.Errorf("expected access-vlan to be removed when switching to trunk mode")
+	}
+
+	err = client.UpdateTrunkGroups(ctx, UpdateTrunkGroupsRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", TrunkGroups: []string{"Tenant_Nets", "Provider_Nets"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.UpdateNativeVlan(ctx, UpdateNativeVlanRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", NativeVlan: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.UpdateDescription(ctx, UpdateDescriptionRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", Description: "host-b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ports, err := client.GetSwitchPorts(ctx, GetSwitchPortsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ports["Ethernet1/1"]
+	if port.Mode != "trunk" || port.NativeVlan != 100 || port.Description != "host-b" {
+		t.Errorf("unexpected port after update %+v", port)
+	}
+	if diff := cmp.Diff([]string{"Provider_Nets", "Tenant_Nets"}, port.TrunkGroups); diff != "" {
+		t.Errorf("trunk groups mismatch (-want +got):\n%s", diff)
+	}
+
+	// back to access mode on a tenant vlan, which disables LLDP.
+	err = client.UpdateMode(ctx, UpdateModeRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", Mode: "access"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.UpdateVlan(ctx, UpdateVlanRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", Vlan: 200, UpdateLLDP: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled, err := server.Node("/lldp/interfaces/interface[name=Eth1/1]/config/enabled")
+	if err != nil || enabled != false {
+		t.Errorf("expected lldp to be disabled, got %v, %v", enabled, err)
+	}
+	ports, err = client.GetSwitchPorts(ctx, GetSwitchPortsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port := ports["Ethernet1/1"]; port.Mode != "access" || port.VlanId != 200 {
+		t.Errorf("unexpected port after update %+v", port)
+	}
+
+	// vlan must exist on the switch
+	err = client.UpdateVlan(ctx, UpdateVlanRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", Vlan: 4000})
+	if err == nil {
+		t.Errorf("expected error for vlan not allowed")
+	}
+	client.AllowedVlanIds = nil
+	err = client.UpdateVlan(ctx, UpdateVlanRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", Vlan: 4000})
+	if err == nil {
+		t.Errorf("expected error for vlan not on the switch")
+	}
+
+	err = client.UpdateTrunkGroups(ctx, UpdateTrunkGroupsRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", TrunkGroups: []string{"Not_Allowed"}})
+	if err == nil {
+		t.Errorf("expected error for trunk group not allowed")
+	}
+}
+
+func TestSonicClientReadOnly(t *testing.T) {
+	ctx := context.Background()
+	client, server := newTestSonicClient(t, true)
+
+	err := client.UpdateVlan(ctx, UpdateVlanRequest{SwitchFQDN: testSonicFQDN, PortName: "Ethernet1/1", Vlan: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.DeletePortChannel(ctx, DeletePortChannelRequest{TargetPortChannel: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vlan, err := server.Node("/interfaces/interface[name=Eth1/1]/ethernet/switched-vlan/config/access-vlan")
+	if err != nil || vlan.(interface{ String() string }).String() != "100" {
+		t.Errorf("expected access-vlan to be unchanged, got %v, %v", vlan, err)
+	}
+	if _, err := server.Node("/interfaces/interface[name=PortChannel10]"); err != nil {
+		t.Errorf("expected port-channel to still exist, %v", err)
+	}
+}
+
+func TestSonicClientPortChannels(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestSonicClient(t, false)
+
+	portChannels, err := client.GetPortChannels(ctx, GetPortChannelsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pc, found := portChannels["Port-Channel10"]
+	if !found || pc.Protocol != "lacp" || pc.LinkState != "up" {
+		t.Fatalf("unexpected port-channels %+v", portChannels)
+	}
+	if member, found := pc.Ports["Ethernet1/3"]; !found || *member.LagMember {
+		t.Errorf("unexpected port-channel members %+v", pc.Ports)
+	}
+
+	err = client.CreatePortChannel(ctx, CreatePortChannelRequest{PortChannel: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.AssignSwitchPortToPortChannel(ctx, AssignSwitchPortToPortChannelRequest{SwitchPort: "Ethernet1/2", TargetPortChannel: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	portChannels, err = client.GetPortChannels(ctx, GetPortChannelsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := portChannels["Port-Channel20"].Ports["Ethernet1/2"]; !found {
+		t.Errorf("expected Ethernet1/2 to be a member of Port-Channel20, got %+v", portChannels)
+	}
+
+	err = client.RemoveSwitchPortFromPortChannel(ctx, RemoveSwitchPortFromPortChannelRequest{SwitchPort: "Ethernet1/2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.DeletePortChannel(ctx, DeletePortChannelRequest{TargetPortChannel: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	portChannels, err = client.GetPortChannels(ctx, GetPortChannelsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := portChannels["Port-Channel20"]; found {
+		t.Errorf("expected Port-Channel20 to be deleted, got %+v", portChannels)
+	}
+}
+
+func TestSonicClientLLDPAndMacTable(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestSonicClient(t, false)
+
+	neighbors, err := client.GetLLDPNeighbors(ctx, PortParamsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedNeighbors := []ResLLDPNeighbors{
+		{Interface: "Ethernet1/1", ResLDPIntNeighbors: []ResLDPIntNeighbors{{NeighborInterfaceId: "enp1s0", NeighborName: "host-a"}}},
+	}
+	if diff := cmp.Diff(expectedNeighbors, neighbors); diff != "" {
+		t.Errorf("GetLLDPNeighbors mismatch (-want +got):\n%s", diff)
+	}
+
+	portNeighbors, err := client.GetLLDPPortNeighbors(ctx, PortParamsRequest{SwitchFQDN: testSonicFQDN, SwitchPort: "1/1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(portNeighbors) != 1 || len(portNeighbors[0].ResLDPIntNeighbors) != 1 || portNeighbors[0].ResLDPIntNeighbors[0].NeighborMgmtIP != "10.0.0.5" {
+		t.Errorf("unexpected port neighbors %+v", portNeighbors)
+	}
+
+	macs, err := client.GetMacAddressTable(ctx, ListMacAddressTableRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMacs := []ResMacAddressTableEntry{
+		{Interface: "Ethernet1/1", MacAddress: "aa:bb:cc:dd:ee:01", VlanTag: 100},
+		{Interface: "Ethernet1/3", MacAddress: "aa:bb:cc:dd:ee:02", VlanTag: 300},
+	}
+	if diff := cmp.Diff(expectedMacs, macs); diff != "" {
+		t.Errorf("GetMacAddressTable mismatch (-want +got):\n%s", diff)
+	}
+
+	ipMacs, err := client.GetIpMacInfo(ctx, ParamsRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ipMacs) != 2 || ipMacs[0].IpAddress != "10.0.0.5" {
+		t.Errorf("unexpected ip mac info %+v", ipMacs)
+	}
+
+	_, err = client.ClearMacAddressTable(ctx, testSonicFQDN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	macs, err = client.GetMacAddressTable(ctx, ListMacAddressTableRequest{SwitchFQDN: testSonicFQDN})
+	if err != nil || len(macs) != 0 {
+		t.Errorf("expected empty mac table, got %v, %v", macs, err)
+	}
+}
+
+func TestSonicClientBGPCommunityAndSaveConfig(t *testing.T) {
+	ctx := context.Background()
+	client, server := newTestSonicClient(t, false)
+
+	community, err := client.GetBGPCommunity(ctx, GetBGPCommunityRequest{BGPCommunityIncomingGroupName: "incoming"})
+	if err != nil || community != 5 {
+		t.Fatalf("expected community 5, got %d, %v", community, err)
+	}
+
+	err = client.UpdateBGPCommunity(ctx, UpdateBGPCommunityRequest{BGPCommunityIncomingGroupName: "incoming", BGPCommunity: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	community, err = client.GetBGPCommunity(ctx, GetBGPCommunityRequest{BGPCommunityIncomingGroupName: "incoming"})
+	if err != nil || community != 7 {
+		t.Errorf("expected community 7, got %d, %v", community, err)
+	}
+
+	_, err = client.GetBGPCommunity(ctx, GetBGPCommunityRequest{BGPCommunityIncomingGroupName: "missing"})
+	if err == nil {
+		t.Errorf("expected error for missing community-set")
+	}
+
+	if server.StartupConfig() != nil {
+		t.Fatalf("expected config to not be saved yet")
+	}
+	_, err = client.SaveConfig(ctx, testSonicFQDN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.StartupConfig() == nil {
+		t.Errorf("expected config to be saved")
+	}
+}
+
+func TestSonicClientInvalidCredentials(t *testing.T) {
+	server, err := NewFakeGNMIServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.Username = "admin"
+	server.Password = "secret"
+	addr, err := server.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+
+	secretsPath := filepath.Join(t.TempDir(), "secret.yaml")
+	err = os.WriteFile(secretsPath, []byte("credentials:\n  username: admin\n  password: wrong\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, portStr, _ := net.SplitHostPort(addr)
+	port, _ := strconv.Atoi(portStr)
+	_, err = NewSonicClient(host, secretsPath, port, GNMITransportInsecure, 5*time.Second, false, nil, nil, nil, nil, nil)
+	if err == nil {
+		t.Errorf("expected error for invalid credentials")
+	}
+}
@@ -0,0 +1,56 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package v1
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// The encoded security rule is also decoded by the copy of this package in go/pkg/network/sdn,
+// which is generated from the same proto file.
+const securityRuleFile = "testdata/security_rule.binpb"
+
+func newTestSecurityRule() *SecurityRule {
+	return &SecurityRule{
+		Id:                   &SecurityRuleId{Uuid: "d3f1bb4c-5a6e-4f0e-9b2a-0c6c1e2f3a4b"},
+		Name:                 "allow-ssh",
+		SecurityGroupId:      &SecurityGroupId{Uuid: "7c2d9e1a-3b4f-4c5d-8e6f-1a2b3c4d5e6f"},
+		Priority:             100,
+		Direction:            Direction_EGRESS,
+		Source_IPs:           []string{"10.0.0.0/24"},
+		Destination_IPs:      []string{"10.1.0.0/24", "10.2.0.0/24"},
+		Protocol:             Protocol_UDP.Enum(),
+		SourcePortRange:      &PortRange{Min: 1024, Max: 65535},
+		DestinationPortRange: &PortRange{Min: 22, Max: 22},
+		Action:               SecurityAction_DENY,
+		VpcId:                &VPCId{Uuid: "0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0"},
+		Status:               &ObjStatus{Status: "ready"},
+		Logging:              &SecurityRuleLogging{Enabled: true, Name: "sr-123456789012-7c2d9e1a"},
+	}
+}
+
+func TestSecurityRuleWireFormat(t *testing.T) {
+	want, err := os.ReadFile(securityRuleFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := proto.MarshalOptions{Deterministic: true}.Marshal(newTestSecurityRule())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("encoded security rule does not match %s", securityRuleFile)
+	}
+
+	rule := &SecurityRule{}
+	if err := proto.Unmarshal(want, rule); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(rule, newTestSecurityRule()) {
+		t.Errorf("decoded security rule = %v, want %v", rule, newTestSecurityRule())
+	}
+}
@@ -10,13 +10,14 @@ import (
 )
 
 type Config struct {
-	OvnCentralCfg  OvnConfig          `koanf:"ovnCentral"`
-	GrpcServerCfg  GrpcServerConfig   `koanf:"grpcServer"`
-	DbCfg          DbConfig           `koanf:"database"`
-	Database       manageddb.Config   `koanf:"database"`
-	ServiceAreaCfg ServiceAreaConfig  `koanf:"serviceArea"`
-	SecurityCfg    SecurityConfig     `koanf:"security"`
-	GatewaysCfg    []handlers.Gateway `koanf:"gateways"`
+	OvnCentralCfg  OvnConfig                  `koanf:"ovnCentral"`
+	GrpcServerCfg  GrpcServerConfig           `koanf:"grpcServer"`
+	DbCfg          DbConfig                   `koanf:"database"`
+	Database       manageddb.Config           `koanf:"database"`
+	ServiceAreaCfg ServiceAreaConfig          `koanf:"serviceArea"`
+	SecurityCfg    SecurityConfig             `koanf:"security"`
+	GatewaysCfg    []handlers.Gateway         `koanf:"gateways"`
+	FlowLoggingCfg handlers.FlowLoggingConfig `koanf:"flowLogging"`
 }
 
 func IsYes(input string) bool {
@@ -133,6 +133,8 @@ CREATE TABLE IF NOT EXISTS security_rule(
    dst_address_set_uuid UUID,
    vpc_id UUID NOT NULL,
    security_group_id UUID NOT NULL,
+   logging_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+   logging_name VARCHAR(63),
       FOREIGN KEY(vpc_id) 
         REFERENCES vpc(vpc_id),
       FOREIGN KEY(security_group_id) 
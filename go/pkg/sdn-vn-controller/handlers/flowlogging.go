@@ -0,0 +1,102 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package handlers
+
+import (
+	"fmt"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// All ACLs with logging enabled share this meter.
+	// ovn-controller writes a log record for each packet matched by such an ACL, up to the rate of the meter.
+	aclLoggingMeter    = "acl-logging"
+	aclLoggingSeverity = nbdb.ACLSeverityInfo
+	// The name of an ACL is limited to 63 characters by OVN.
+	maxACLNameLength = 63
+
+	defaultACLLoggingRateLimit = 20
+)
+
+/* FlowLoggingConfig stores the flow logging parameters parsed from the config file */
+type FlowLoggingConfig struct {
+	// Maximum number of log records per second written by each ovn-controller.
+	RateLimit int `koanf:"rateLimit"`
+}
+
+// Create or update the meter that rate limits the logging of ACLs.
+func CreateACLLoggingMeter(ovnClient libovsdbclient.Client, cfg FlowLoggingConfig) error {
+	Logger := Logger.WithName("CreateACLLoggingMeter")
+	rateLimit := cfg.RateLimit
+	if rateLimit <= 0 {
+		rateLimit = defaultACLLoggingRateLimit
+	}
+	Logger.V(DebugLevel).Info(fmt.Sprintf("create ACL logging meter %s with rate %d", aclLoggingMeter, rateLimit))
+
+	band := &nbdb.MeterBand{
+		Action: nbdb.MeterBandActionDrop,
+		Rate:   rateLimit,
+	}
+	ops, err := libovsdbops.CreateMeterBandOps(ovnClient, nil, band)
+	if err != nil {
+		return fmt.Errorf("could not create ACL logging meter band: %w", err)
+	}
+	fair := true
+	meter := &nbdb.Meter{
+		Name: aclLoggingMeter,
+		Fair: &fair,
+		Unit: nbdb.MeterUnitPktps,
+	}
+	ops, err = libovsdbops.CreateOrUpdateMeterOps(ovnClient, ops, meter, []*nbdb.MeterBand{band},
+		&meter.Bands, &meter.Fair, &meter.Unit)
+	if err != nil {
+		return fmt.Errorf("could not create ACL logging meter: %w", err)
+	}
+	_, err = libovsdbops.TransactAndCheck(ovnClient, ops)
+	if err != nil {
+		return fmt.Errorf("could not create ACL logging meter: %w", err)
+	}
+	return nil
+}
+
+/* Validate the logging parameters of a security rule */
+func validateSecurityRuleLogging(name string, logging *SecurityRuleLogging) error {
+	if logging == nil || !logging.Enabled {
+		return nil
+	}
+	if len(logging.Name) > maxACLNameLength {
+		return status.Errorf(codes.InvalidArgument, "logging name of rule %s exceeds %d characters", name, maxACLNameLength)
+	}
+	return nil
+}
+
+/* Set the logging columns of the ACL of a security rule.
+ * A rule with logging enabled is logged under its logging name, which can be
+ * mapped back to the rule by the log collector.
+ */
+func setACLLogging(acl *nbdb.ACL, rule *SecurityRule) {
+	name := rule.Name
+	if rule.Logging != nil && rule.Logging.Enabled {
+		if rule.Logging.Name != "" {
+			name = rule.Logging.Name
+		}
+		meter := aclLoggingMeter
+		severity := aclLoggingSeverity
+		acl.Log = true
+		acl.Meter = &meter
+		acl.Severity = &severity
+	} else {
+		acl.Log = false
+		acl.Meter = nil
+		acl.Severity = nil
+	}
+	if len(name) > maxACLNameLength {
+		name = name[:maxACLNameLength]
+	}
+	acl.Name = &name
+}
@@ -26,15 +26,15 @@ func NewIPManager(db *sql.DB, uuid UUID, subnet string, offset int, numAddresses
 		return nil, fmt.Errorf("%s: %v", errMsg, err)
 	}
 
-	exceptionIP := net.ParseIP(except).To4()
+	exceptionIP := normalizeIP(net.ParseIP(except))
 	if exceptionIP == nil {
 		errMsg := "Invalid exception IP address format"
 		Logger.Error(err, errMsg)
 		return nil, fmt.Errorf(errMsg)
 	}
 
-	start := ipNet.IP.To4()
-	if start == nil {
+	start := normalizeIP(ipNet.IP)
+	if start == nil || len(start) != len(exceptionIP) {
 		errMsg := "Invalid start IP address format"
 		Logger.Error(err, errMsg)
 		return nil, fmt.Errorf(errMsg)
@@ -168,8 +168,17 @@ func (ipManager *IPManager) FreeIP(tx *sql.Tx, ip string) error {
 	return nil
 }
 
+// normalizeIP returns the 4-byte form of an IPv4 address and the 16-byte form of an IPv6 address,
+// so that IPv4 and IPv6 subnets can both be iterated with nextIP.
+func normalizeIP(ip net.IP) net.IP {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip.To16()
+}
+
 func nextIP(ip net.IP) net.IP {
-	ip = ip.To4()
+	ip = normalizeIP(ip)
 	next := make(net.IP, len(ip))
 	copy(next, ip)
 
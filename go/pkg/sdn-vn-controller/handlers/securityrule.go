@@ -64,6 +64,11 @@ type SecurityOutput struct {
 	Uuid UUID
 }
 
+type SecurityRuleLogging struct {
+	Enabled bool
+	Name    string
+}
+
 type SecurityRule struct {
 	Uuid                 UUID
 	Name                 string
@@ -78,6 +83,7 @@ type SecurityRule struct {
 	DestinationPortRange *PortRange
 	Action               SecurityAction
 	VpcId                UUID
+	Logging              *SecurityRuleLogging
 	securityGroupId      UUID
 	sr_type              int32
 	portGroupUUID        *UUID
@@ -138,8 +144,12 @@ func CreateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 		DestinationPortRange: destinationPortRange,
 		Action:               SecurityAction(r.Action),
 		VpcId:                UUID(r.GetVpcId().GetUuid()),
+		Logging:              fromSdnSecurityRuleLogging(r.Logging),
 		securityGroupId:      UUID(r.SecurityGroupId.Uuid),
 	}
+	if err := validateSecurityRuleLogging(securityRule.Name, securityRule.Logging); err != nil {
+		return nil, err
+	}
 
 	tx, err := db.Begin()
 	if err != nil {
@@ -195,6 +205,8 @@ func CreateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 		aclUUID,
 		"AZ1",
 		securityRule.securityGroupId,
+		loggingEnabled(securityRule.Logging),
+		loggingName(securityRule.Logging),
 	)
 
 	if err != nil {
@@ -284,6 +296,7 @@ func CreateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 		types.DefaultACLTier,
 	)
 
+	setACLLogging(acl, &securityRule)
 	acl.UUID = aclUUID
 	Logger.V(DebugLevel).Info(fmt.Sprintf("create ACL with match %s and UUID %s for rule %s ", match, acl.UUID,
 		securityRule.Uuid))
@@ -567,6 +580,8 @@ func GetSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v1.G
 	var sourcePortRange, destinationPortRange sql.NullString // To hold raw range from DB, handles NULL values
 	var src_address_set_uuid, dst_address_set_uuid *string
 	var acl_uuid string
+	var dbLoggingEnabled bool
+	var dbLoggingName sql.NullString
 
 	err = db.QueryRow(sqlQueryGetSecurityRule, uuid).Scan(
 		&securityRule.Id.Uuid,
@@ -584,6 +599,8 @@ func GetSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v1.G
 		&dst_address_set_uuid,
 		&acl_uuid,
 		&securityRule.SecurityGroupId.Uuid,
+		&dbLoggingEnabled,
+		&dbLoggingName,
 	)
 
 	if err != nil {
@@ -591,6 +608,13 @@ func GetSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v1.G
 		return nil, GrpcErrorFromSql(err)
 	}
 
+	if dbLoggingEnabled {
+		securityRule.Logging = &v1.SecurityRuleLogging{
+			Enabled: true,
+			Name:    dbLoggingName.String,
+		}
+	}
+
 	// Convert the raw port ranges into PortRange structs, handling NULL values
 	if sourcePortRange.Valid {
 		securityRule.SourcePortRange, err = parsePortRange(sourcePortRange.String)
@@ -768,6 +792,8 @@ func UpdateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 	var srcAddressSetUuid sql.NullString
 	var dstAddressSetUuid sql.NullString
 	var aclUUID string
+	var dbLoggingEnabled bool
+	var dbLoggingName sql.NullString
 
 	err = tx.QueryRow(sqlQueryGetSecurityRule, securityRuleUuid).Scan(
 		&securityRule.Uuid,
@@ -785,6 +811,8 @@ func UpdateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 		&dstAddressSetUuid,
 		&aclUUID,
 		&securityRule.securityGroupId,
+		&dbLoggingEnabled,
+		&dbLoggingName,
 	)
 	if err != nil {
 		Logger.Error(err, "SQL query error when fetching security rule")
@@ -855,6 +883,11 @@ func UpdateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 	}
 
 	securityRule.Action = SecurityAction(r.Action)
+	securityRule.Logging = fromSdnSecurityRuleLogging(r.Logging)
+	if err = validateSecurityRuleLogging(securityRule.Name, securityRule.Logging); err != nil {
+		return nil, err
+	}
+
 	var direction string
 	if securityRule.Direction == Direction_INGRESS {
 		direction = nbdb.ACLDirectionFromLport
@@ -977,6 +1010,7 @@ func UpdateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 	} else {
 		acl.Action = nbdb.ACLActionDrop
 	}
+	setACLLogging(acl, &securityRule)
 
 	// Update the ACL in OVN
 	ops, err := libovsdbops.UpdateACLsOps(ovnClient, nil, acl)
@@ -1009,6 +1043,8 @@ func UpdateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 		securityRule.SrcAddressSetUuid,
 		securityRule.DstAddressSetUuid,
 		securityRule.Uuid,
+		loggingEnabled(securityRule.Logging),
+		loggingName(securityRule.Logging),
 	)
 	if err != nil {
 		Logger.Error(err, "SQL update error when updating security rule")
@@ -1027,3 +1063,24 @@ func UpdateSecurityRuleHandler(db *sql.DB, ovnClient libovsdbclient.Client, r *v
 		SecurityRuleId: &v1.SecurityRuleId{Uuid: string(securityRule.Uuid)},
 	}, nil
 }
+
+func fromSdnSecurityRuleLogging(logging *v1.SecurityRuleLogging) *SecurityRuleLogging {
+	if logging == nil {
+		return nil
+	}
+	return &SecurityRuleLogging{
+		Enabled: logging.Enabled,
+		Name:    logging.Name,
+	}
+}
+
+func loggingEnabled(logging *SecurityRuleLogging) bool {
+	return logging != nil && logging.Enabled
+}
+
+func loggingName(logging *SecurityRuleLogging) *string {
+	if !loggingEnabled(logging) || logging.Name == "" {
+		return nil
+	}
+	return &logging.Name
+}
@@ -221,8 +221,10 @@ var (
 				src_address_set_uuid,
 				dst_address_set_uuid,
 				vpc_id,
-				security_group_id
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $16)
+				security_group_id,
+				logging_enabled,
+				logging_name
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $16, $17, $18)
 			RETURNING security_rule_id
 		)
 		INSERT INTO acl (
@@ -279,7 +281,9 @@ var (
 			sr.src_address_set_uuid,
 			sr.dst_address_set_uuid,
 			acl.ovn_acl_id as acl_uuid,
-			sr.security_group_id
+			sr.security_group_id,
+			sr.logging_enabled,
+			sr.logging_name
 		FROM security_rule sr
 		LEFT JOIN acl ON sr.security_rule_id = acl.security_rule_id
 		WHERE sr.security_rule_id = $1;
@@ -297,7 +301,9 @@ var (
 			destination_port = $7,
 			security_rule_action = $8,
 			src_address_set_uuid = $9,
-			dst_address_set_uuid = $10
+			dst_address_set_uuid = $10,
+			logging_enabled = $12,
+			logging_name = $13
 		WHERE
 			security_rule_id = $11;
 	`
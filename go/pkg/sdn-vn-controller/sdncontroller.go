@@ -91,6 +91,10 @@ func controllerInit(ctx context.Context) {
 	if err := handlers.CreateGateways(dbClient, nbClient, cfg.GatewaysCfg); err != nil {
 		panic(err)
 	}
+
+	if err := handlers.CreateACLLoggingMeter(nbClient, cfg.FlowLoggingCfg); err != nil {
+		panic(err)
+	}
 }
 
 func main() {
@@ -12,6 +12,7 @@ import (
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/database/query"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/utils"
 	"google.golang.org/grpc/codes"
@@ -141,16 +142,22 @@ func (objSrv *BucketsServiceServer) SearchBucket(ctx context.Context, in *pb.Obj
 	if dbSession == nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
 	}
+	pagination, err := protodb.NewPagination(in.PageSize, in.PageToken, "name", "resource_id")
+	if err != nil {
+		return nil, err
+	}
 	tx, err := dbSession.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "database transaction failed")
 	}
-	bucketPrivateList, err := query.GetBucketsByCloudaccountId(ctx, tx,
-		in.Metadata.CloudAccountId, timestampInfinityStr)
+	bucketPrivateList, nextPageToken, err := query.SearchBucketsByCloudaccountId(ctx, tx,
+		in.Metadata.CloudAccountId, timestampInfinityStr, pagination)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "error reading buckets")
 	}
-	resp := pb.ObjectBucketSearchResponse{}
+	resp := pb.ObjectBucketSearchResponse{
+		NextPageToken: nextPageToken,
+	}
 
 	// check with authz service is permitted to access resource
 	authzResourceIds := []string{}
@@ -20,6 +20,7 @@ import (
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/database/query"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/utils"
 	idcutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
@@ -46,6 +47,7 @@ type FilesystemServiceServer struct {
 	quotaServiceClient          *QuotaService
 	userClient                  v1.FilesystemUserPrivateServiceClient
 	wekaAgentClient             v1.WekaStatefulAgentPrivateServiceClient
+	snapshotClient              v1.FilesystemSnapshotPrivateServiceClient
 	mu                          sync.Mutex
 	fileProduct                 fileProductInfo
 	deleteFilesystemMutex       DeleteFilesystemMutex
@@ -74,7 +76,8 @@ func NewFilesystemService(ctx context.Context, session *sql.DB, cloudAccountSvc
 	schedulerClient v1.FilesystemSchedulerPrivateServiceClient, kmsClient v1.StorageKMSPrivateServiceClient,
 	quotaServiceClient *QuotaService,
 	userClient v1.FilesystemUserPrivateServiceClient,
-	wekaClient v1.WekaStatefulAgentPrivateServiceClient, cfg *Config) (*FilesystemServiceServer, error) {
+	wekaClient v1.WekaStatefulAgentPrivateServiceClient,
+	snapshotClient v1.FilesystemSnapshotPrivateServiceClient, cfg *Config) (*FilesystemServiceServer, error) {
 	if session == nil {
 		return nil, fmt.Errorf("db session is required")
 	}
@@ -89,6 +92,7 @@ func NewFilesystemService(ctx context.Context, session *sql.DB, cloudAccountSvc
 		quotaServiceClient:          quotaServiceClient,
 		userClient:                  userClient,
 		wekaAgentClient:             wekaClient,
+		snapshotClient:              snapshotClient,
 		gpVASTEnabled:               cfg.GeneralPurposeVASTEnabled,
 		cfg:                         cfg,
 	}
@@ -375,12 +379,17 @@ func (fs *FilesystemServiceServer) Search(ctx context.Context, in *pb.Filesystem
 		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
 	}
 
+	pagination, err := protodb.NewPagination(in.PageSize, in.PageToken, "name", "resource_id")
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := dbSession.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "error startind db tx.")
 	}
 	defer tx.Rollback()
-	fsPrivateList, err := query.GetFilesystemsByCloudaccountId(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilterType, timestampInfinityStr)
+	fsPrivateList, nextPageToken, err := query.SearchFilesystemsByCloudaccountId(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilterType, timestampInfinityStr, pagination)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "error reading filesystems")
 	}
@@ -401,7 +410,9 @@ func (fs *FilesystemServiceServer) Search(ctx context.Context, in *pb.Filesystem
 		}
 	}
 
-	resp := pb.FilesystemSearchResponse{}
+	resp := pb.FilesystemSearchResponse{
+		NextPageToken: nextPageToken,
+	}
 
 	for idx := 0; idx < len(fsPrivateList); idx++ {
 		// this means that authz didn't find permission for this resourceId so will skip it in the response
@@ -713,6 +724,15 @@ func (fs *FilesystemServiceServer) deleteFilesystem(ctx context.Context, in *pb.
 		logger.Error(err, "error updating filesystem for deletion")
 		return &emptypb.Empty{}, err
 	}
+	// snapshots are removed by the backend along with the filesystem
+	if _, err := query.UpdateFilesystemSnapshotsForFilesystemDeletion(ctx, tx, in.Metadata.CloudAccountId, fsPrivate.Metadata.ResourceId); err != nil {
+		logger.Error(err, "error updating filesystem snapshots for deletion")
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "database transaction failed")
+	}
+	if err := query.DeleteFilesystemSnapshotPolicy(ctx, tx, in.Metadata.CloudAccountId, fsPrivate.Metadata.ResourceId); err != nil && status.Code(err) != codes.NotFound {
+		logger.Error(err, "error deleting filesystem snapshot policy")
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "database transaction failed")
+	}
 	// we will not be updating quota for private apis
 	// quota set and reclaim is only for public requests
 	if quotaReclaim {
@@ -9,6 +9,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ = Describe("Filesystem functions", func() {
@@ -119,4 +120,71 @@ var _ = Describe("Filesystem functions", func() {
 		})
 	})
 
+	Context("Snapshot functions", func() {
+		now := time.Now()
+		snapshotAt := func(name string, scheduled bool, age time.Duration) *pb.FilesystemSnapshotPrivate {
+			return &pb.FilesystemSnapshotPrivate{
+				Metadata: &pb.FilesystemSnapshotMetadata{
+					Name:              name,
+					Scheduled:         scheduled,
+					CreationTimestamp: timestamppb.New(now.Add(-age)),
+				},
+			}
+		}
+		It("should prune the oldest scheduled snapshots only", func() {
+			snapshots := []*pb.FilesystemSnapshotPrivate{
+				snapshotAt("scheduled-3", true, time.Hour),
+				snapshotAt("manual-1", false, 5*time.Hour),
+				snapshotAt("scheduled-1", true, 3*time.Hour),
+				snapshotAt("scheduled-2", true, 2*time.Hour),
+			}
+			pruned := snapshotsToPrune(snapshots, 1)
+			Expect(pruned).To(HaveLen(2))
+			Expect(pruned[0].Metadata.Name).To(Equal("scheduled-1"))
+			Expect(pruned[1].Metadata.Name).To(Equal("scheduled-2"))
+			Expect(snapshotsToPrune(snapshots, 3)).To(BeEmpty())
+		})
+		It("should detect due snapshots", func() {
+			policy := &pb.FilesystemSnapshotPolicy{
+				Spec: &pb.FilesystemSnapshotPolicySpec{
+					Enabled:        true,
+					IntervalHours:  2,
+					RetentionCount: 1,
+				},
+			}
+			Expect(isSnapshotDue(policy, now)).To(BeTrue())
+			policy.Status = &pb.FilesystemSnapshotPolicyStatus{LastSnapshotTimestamp: timestamppb.New(now.Add(-time.Hour))}
+			Expect(isSnapshotDue(policy, now)).To(BeFalse())
+			policy.Status.LastSnapshotTimestamp = timestamppb.New(now.Add(-2 * time.Hour))
+			Expect(isSnapshotDue(policy, now)).To(BeTrue())
+			policy.Spec.Enabled = false
+			Expect(isSnapshotDue(policy, now)).To(BeFalse())
+		})
+		It("should address vast filesystems by the view name", func() {
+			fsPrivate := &pb.FilesystemPrivate{
+				Metadata: &pb.FilesystemMetadataPrivate{CloudAccountId: "123456789012", Name: "fs1"},
+				Spec: &pb.FilesystemSpecPrivate{
+					StorageClass: pb.FilesystemStorageClass_GeneralPurposeStd,
+					Scheduler: &pb.FilesystemSchedule{
+						Cluster:   &pb.AssignedCluster{ClusterUUID: "cluster"},
+						Namespace: &pb.AssignedNamespace{Name: "vastns-123456789012"},
+					},
+				},
+			}
+			Expect(isSnapshotSupported(fsPrivate.Spec.StorageClass)).To(BeTrue())
+			req := snapshotBackendRequest(fsPrivate, "snap")
+			Expect(req.FilesystemName).To(Equal("123456789012-fs1"))
+			Expect(req.StorageClass).To(Equal(pb.FilesystemStorageClass_GeneralPurposeStd))
+
+			fsPrivate.Spec.StorageClass = pb.FilesystemStorageClass_GeneralPurpose
+			Expect(snapshotBackendRequest(fsPrivate, "snap").FilesystemName).To(Equal("fs1"))
+		})
+		It("should validate snapshot policy spec", func() {
+			Expect(isValidSnapshotPolicySpec(nil)).NotTo(Succeed())
+			Expect(isValidSnapshotPolicySpec(&pb.FilesystemSnapshotPolicySpec{IntervalHours: 0, RetentionCount: 1})).NotTo(Succeed())
+			Expect(isValidSnapshotPolicySpec(&pb.FilesystemSnapshotPolicySpec{IntervalHours: 1, RetentionCount: 101})).NotTo(Succeed())
+			Expect(isValidSnapshotPolicySpec(&pb.FilesystemSnapshotPolicySpec{IntervalHours: 24, RetentionCount: 7})).To(Succeed())
+		})
+	})
+
 })
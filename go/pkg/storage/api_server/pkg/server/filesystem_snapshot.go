@@ -0,0 +1,978 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/database/query"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/utils"
+	idcutils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/utils"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	snapshotNameTimeFormat    = "20060102-150405"
+	onDemandSnapshotPrefix    = "snapshot-"
+	scheduledSnapshotPrefix   = "scheduled-"
+	maxSnapshotRetentionCount = 100
+	maxSnapshotIntervalHours  = 24 * 30
+	restoringSnapshotMessage  = "restoring into filesystem "
+)
+
+func (fs *FilesystemServiceServer) CreateSnapshot(ctx context.Context, in *pb.FilesystemSnapshotCreateRequest) (*pb.FilesystemSnapshot, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.CreateSnapshot").
+		WithValues(logkeys.CloudAccountId, in.Metadata.CloudAccountId, logkeys.FilesystemId, in.Metadata.FilesystemId).Start()
+	defer span.End()
+	logger.Info("entering filesystem snapshot create")
+	defer logger.Info("returning from filesystem snapshot create")
+
+	if idcutils.IsValidCloudAccountId(in.Metadata.CloudAccountId) {
+		return nil, status.Error(codes.InvalidArgument, "invalid cloudaccount for creating snapshot")
+	}
+	if err := isValidUUID(in.Metadata.FilesystemId); err != nil {
+		return nil, err
+	}
+	if in.Metadata.Name != "" {
+		if err := utils.ValidateResourceName(in.Metadata.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if fs.cfg.AuthzEnabled {
+		_, err := fs.lookupResourcePermission(ctx, in.Metadata.CloudAccountId, "snapshot", []string{in.Metadata.FilesystemId}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot, err := fs.createSnapshot(ctx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId, in.Metadata.Name, in.Metadata.Description, false)
+	if err != nil {
+		return nil, err
+	}
+	return convertFilesystemSnapshotPrivateToPublic(snapshot), nil
+}
+
+func (fs *FilesystemServiceServer) createSnapshot(ctx context.Context, cloudAccountId, filesystemId, name, description string, scheduled bool) (*pb.FilesystemSnapshotPrivate, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.createSnapshot").
+		WithValues(logkeys.CloudAccountId, cloudAccountId, logkeys.FilesystemId, filesystemId, logkeys.SnapshotName, name).Start()
+	defer span.End()
+
+	dbSession := fs.session
+	if dbSession == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	fsPrivate, err := getSnapshotSourceFilesystem(ctx, tx, cloudAccountId, filesystemId)
+	if err != nil {
+		return nil, err
+	}
+	if fsPrivate.Status == nil || fsPrivate.Status.Phase != pb.FilesystemPhase_FSReady {
+		return nil, status.Error(codes.FailedPrecondition, "filesystem must be ready to take a snapshot")
+	}
+
+	now := time.Now().UTC()
+	if name == "" {
+		name = onDemandSnapshotPrefix + now.Format(snapshotNameTimeFormat)
+	}
+
+	snapshot := pb.FilesystemSnapshotPrivate{
+		Metadata: &pb.FilesystemSnapshotMetadata{
+			CloudAccountId:    cloudAccountId,
+			Name:              name,
+			ResourceId:        uuid.NewString(),
+			FilesystemId:      filesystemId,
+			FilesystemName:    fsPrivate.Metadata.Name,
+			Description:       description,
+			Scheduled:         scheduled,
+			CreationTimestamp: timestamppb.New(now),
+		},
+		Status: &pb.FilesystemSnapshotStatus{
+			Phase: pb.FilesystemSnapshotPhase_SnapshotCreating,
+		},
+		StorageClass:     fsPrivate.Spec.StorageClass,
+		AvailabilityZone: fsPrivate.Spec.AvailabilityZone,
+	}
+
+	// The snapshot is recorded before the backend call, so the name is reserved and
+	// the backend is not called while the transaction is open.
+	if err := query.StoreFilesystemSnapshot(ctx, tx, &snapshot); err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == kErrUniqueViolation {
+			return nil, status.Error(codes.AlreadyExists, "insert: snapshot name "+name+" already exists")
+		}
+		logger.Error(err, "error storing snapshot into db")
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error commiting transaction")
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+
+	backendSnapshot, backendErr := fs.snapshotClient.CreateSnapshotPrivate(ctx, snapshotBackendRequest(fsPrivate, name))
+	if backendErr != nil {
+		logger.Error(backendErr, "error creating snapshot in backend")
+		snapshot.Status.Phase = pb.FilesystemSnapshotPhase_SnapshotFailed
+		snapshot.Status.Message = "snapshot creation failed"
+	} else {
+		snapshot.Status.Phase = pb.FilesystemSnapshotPhase_SnapshotReady
+		snapshot.Status.UsedBytes = backendSnapshot.UsedBytes
+	}
+
+	if err := fs.updateSnapshotStatus(ctx, &snapshot); err != nil {
+		logger.Error(err, "error updating snapshot status")
+		if backendErr == nil && status.Code(err) == codes.NotFound {
+			// the snapshot was deleted while it was being taken
+			if _, delErr := fs.snapshotClient.DeleteSnapshotPrivate(ctx, snapshotBackendRequest(fsPrivate, name)); delErr != nil {
+				logger.Error(delErr, "error cleaning up snapshot in backend")
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+	if backendErr != nil {
+		return nil, status.Errorf(codes.Internal, "snapshot creation failed")
+	}
+
+	return &snapshot, nil
+}
+
+func (fs *FilesystemServiceServer) SearchSnapshots(ctx context.Context, in *pb.FilesystemSnapshotSearchRequest) (*pb.FilesystemSnapshotSearchResponse, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.SearchSnapshots").
+		WithValues(logkeys.CloudAccountId, in.Metadata.CloudAccountId, logkeys.FilesystemId, in.Metadata.FilesystemId).Start()
+	defer span.End()
+	logger.Info("entering filesystem snapshot search")
+	defer logger.Info("returning from filesystem snapshot search")
+
+	if idcutils.IsValidCloudAccountId(in.Metadata.CloudAccountId) {
+		return nil, status.Error(codes.InvalidArgument, "invalid cloudaccount for searching snapshots")
+	}
+	if err := isValidUUID(in.Metadata.FilesystemId); err != nil {
+		return nil, err
+	}
+
+	if fs.cfg.AuthzEnabled {
+		_, err := fs.lookupResourcePermission(ctx, in.Metadata.CloudAccountId, "get", []string{in.Metadata.FilesystemId}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbSession := fs.session
+	if dbSession == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	if _, err := query.GetFilesystemByResourceId(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId, timestampInfinityStr); err != nil {
+		return nil, err
+	}
+
+	snapshots, err := query.GetFilesystemSnapshotsByFilesystemId(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId)
+	if err != nil {
+		logger.Error(err, "error reading snapshots from db")
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+
+	resp := pb.FilesystemSnapshotSearchResponse{}
+	for _, snapshot := range snapshots {
+		resp.Items = append(resp.Items, convertFilesystemSnapshotPrivateToPublic(snapshot))
+	}
+	return &resp, nil
+}
+
+func (fs *FilesystemServiceServer) DeleteSnapshot(ctx context.Context, in *pb.FilesystemSnapshotDeleteRequest) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.DeleteSnapshot").
+		WithValues(logkeys.CloudAccountId, in.Metadata.CloudAccountId, logkeys.FilesystemId, in.Metadata.FilesystemId,
+			logkeys.SnapshotId, in.Metadata.ResourceId).Start()
+	defer span.End()
+	logger.Info("entering filesystem snapshot delete")
+	defer logger.Info("returning from filesystem snapshot delete")
+
+	if err := isValidSnapshotReference(in.Metadata); err != nil {
+		return nil, err
+	}
+
+	if fs.cfg.AuthzEnabled {
+		_, err := fs.lookupResourcePermission(ctx, in.Metadata.CloudAccountId, "snapshot", []string{in.Metadata.FilesystemId}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := fs.deleteSnapshot(ctx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId, in.Metadata.ResourceId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (fs *FilesystemServiceServer) deleteSnapshot(ctx context.Context, cloudAccountId, filesystemId, resourceId string) error {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.deleteSnapshot").
+		WithValues(logkeys.CloudAccountId, cloudAccountId, logkeys.FilesystemId, filesystemId, logkeys.SnapshotId, resourceId).Start()
+	defer span.End()
+
+	dbSession := fs.session
+	if dbSession == nil {
+		return status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	fsPrivate, err := query.GetFilesystemByResourceId(ctx, tx, cloudAccountId, filesystemId, timestampInfinityStr)
+	if err != nil {
+		return err
+	}
+	snapshot, err := query.GetFilesystemSnapshotById(ctx, tx, cloudAccountId, filesystemId, resourceId)
+	if err != nil {
+		return err
+	}
+
+	previousPhase := snapshot.Status.Phase
+	snapshot.Status.Phase = pb.FilesystemSnapshotPhase_SnapshotDeleting
+	if err := query.UpdateFilesystemSnapshot(ctx, tx, snapshot); err != nil {
+		logger.Error(err, "error updating snapshot phase")
+		return status.Errorf(codes.Internal, "database transaction failed")
+	}
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error commiting transaction")
+		return status.Errorf(codes.Internal, "database transaction failed")
+	}
+
+	if _, err := fs.snapshotClient.DeleteSnapshotPrivate(ctx, snapshotBackendRequest(fsPrivate, snapshot.Metadata.Name)); err != nil {
+		logger.Error(err, "error deleting snapshot in backend")
+		snapshot.Status.Phase = previousPhase
+		snapshot.Status.Message = "snapshot deletion failed"
+		if err := fs.updateSnapshotStatus(ctx, snapshot); err != nil {
+			logger.Error(err, "error updating snapshot status")
+		}
+		return status.Errorf(codes.Internal, "snapshot deletion failed")
+	}
+
+	tx, err = dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	if err := query.UpdateFilesystemSnapshotForDeletion(ctx, tx, snapshot); err != nil {
+		logger.Error(err, "error updating snapshot for deletion")
+		return status.Errorf(codes.Internal, "database transaction failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error commiting transaction")
+		return status.Errorf(codes.Internal, "database transaction failed")
+	}
+	return nil
+}
+
+func (fs *FilesystemServiceServer) RestoreSnapshot(ctx context.Context, in *pb.FilesystemSnapshotRestoreRequest) (*pb.Filesystem, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.RestoreSnapshot").
+		WithValues(logkeys.CloudAccountId, in.Metadata.CloudAccountId, logkeys.FilesystemId, in.Metadata.FilesystemId,
+			logkeys.SnapshotId, in.Metadata.ResourceId, logkeys.FilesystemName, in.Name).Start()
+	defer span.End()
+	logger.Info("entering filesystem snapshot restore")
+	defer logger.Info("returning from filesystem snapshot restore")
+
+	if err := isValidSnapshotReference(in.Metadata); err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateResourceName(in.Name); err != nil {
+		return nil, err
+	}
+
+	if fs.cfg.AuthzEnabled {
+		_, err := fs.lookupResourcePermission(ctx, in.Metadata.CloudAccountId, "snapshot", []string{in.Metadata.FilesystemId}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbSession := fs.session
+	if dbSession == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	srcFilesystem, err := getSnapshotSourceFilesystem(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := query.GetFilesystemSnapshotById(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId, in.Metadata.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Status.Phase != pb.FilesystemSnapshotPhase_SnapshotReady {
+		return nil, status.Error(codes.FailedPrecondition, "snapshot must be ready to be restored")
+	}
+	if _, err := query.GetFilesystemByName(ctx, tx, in.Metadata.CloudAccountId, in.Name, timestampInfinityStr); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "filesystem name "+in.Name+" already exists")
+	} else if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	// The restored filesystem has the capacity of the source filesystem
+	reqSize := utils.ParseFileSize(srcFilesystem.Spec.Request.Storage)
+	if reqSize == -1 {
+		logger.Info("invalid source filesystem size", "reqSize", srcFilesystem.Spec.Request.Storage)
+		return nil, status.Error(codes.FailedPrecondition, "invalid source filesystem size")
+	}
+	cloudAccount, err := fs.cloudAccountServiceClient.GetById(ctx, &v1.CloudAccountId{Id: in.Metadata.CloudAccountId})
+	if err != nil {
+		logger.Error(err, "error querying cloudaccount")
+		return nil, status.Errorf(codes.FailedPrecondition, "error querying cloudaccount")
+	}
+	if !fs.quotaServiceClient.checkAndUpdateFileQuota(ctx, cloudAccount, reqSize, false) {
+		logger.Info("quota check fail")
+		return nil, status.Errorf(codes.FailedPrecondition, "quota check failed")
+	}
+
+	// The restore in progress is recorded on the snapshot, the filesystem record is
+	// stored once the backend has created the filesystem for the operator to adopt.
+	snapshot.Status.Message = restoringSnapshotMessage + in.Name
+	if err := query.UpdateFilesystemSnapshot(ctx, tx, snapshot); err != nil {
+		logger.Error(err, "error updating snapshot status")
+		fs.quotaServiceClient.decFileQuota(ctx, in.Metadata.CloudAccountId, reqSize, false)
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error commiting transaction")
+		fs.quotaServiceClient.decFileQuota(ctx, in.Metadata.CloudAccountId, reqSize, false)
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+
+	backendName := snapshotBackendFilesystemName(srcFilesystem.Spec.StorageClass, in.Metadata.CloudAccountId, in.Name)
+	restoreReq := pb.FilesystemSnapshotBackendRestoreRequest{
+		Snapshot:          snapshotBackendRequest(srcFilesystem, snapshot.Metadata.Name),
+		NewFilesystemName: backendName,
+	}
+	if totalBytes, err := strconv.ParseUint(utils.ConvertToBytes(srcFilesystem.Spec.Request.Storage), 10, 64); err == nil {
+		restoreReq.TotalBytes = totalBytes
+	}
+	snapshot.Status.Message = ""
+	if _, err := fs.snapshotClient.RestoreSnapshotPrivate(ctx, &restoreReq); err != nil {
+		logger.Error(err, "error restoring snapshot in backend")
+		fs.quotaServiceClient.decFileQuota(ctx, in.Metadata.CloudAccountId, reqSize, false)
+		if err := fs.updateSnapshotStatus(ctx, snapshot); err != nil {
+			logger.Error(err, "error updating snapshot status")
+		}
+		return nil, status.Errorf(codes.Internal, "snapshot restore failed")
+	}
+
+	// The restored filesystem lives in the same cluster and namespace as the source,
+	// the operator adopts the filesystem created by the backend.
+	fsPrivate := pb.FilesystemPrivate{
+		Metadata: &pb.FilesystemMetadataPrivate{
+			CloudAccountId:    in.Metadata.CloudAccountId,
+			ResourceId:        uuid.NewString(),
+			Name:              in.Name,
+			Description:       in.Description,
+			CreationTimestamp: timestamppb.Now(),
+		},
+		Spec:   proto.Clone(srcFilesystem.Spec).(*pb.FilesystemSpecPrivate),
+		Status: &pb.FilesystemStatusPrivate{},
+	}
+	if fsPrivate.Spec.StorageClass == pb.FilesystemStorageClass_GeneralPurposeStd {
+		// VAST clones the snapshot next to the source view
+		fsPrivate.Spec.VolumePath = path.Join(path.Dir(srcFilesystem.Spec.VolumePath), backendName)
+	}
+
+	tx, err = dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(err, "error starting db tx, restored filesystem is not recorded", logkeys.FilesystemName, backendName)
+		fs.quotaServiceClient.decFileQuota(ctx, in.Metadata.CloudAccountId, reqSize, false)
+		fs.deleteRestoredFilesystem(ctx, &restoreReq)
+		return nil, status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	if err := query.StoreFilesystemRequest(ctx, tx, &fsPrivate); err != nil {
+		logger.Error(err, "error storing restored filesystem into db", logkeys.FilesystemName, backendName)
+		fs.quotaServiceClient.decFileQuota(ctx, in.Metadata.CloudAccountId, reqSize, false)
+		fs.deleteRestoredFilesystem(ctx, &restoreReq)
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == kErrUniqueViolation {
+			return nil, status.Error(codes.AlreadyExists, "insert: filesystem name "+in.Name+" already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+	if err := query.UpdateFilesystemSnapshot(ctx, tx, snapshot); err != nil {
+		logger.Error(err, "error updating snapshot status")
+		fs.quotaServiceClient.decFileQuota(ctx, in.Metadata.CloudAccountId, reqSize, false)
+		fs.deleteRestoredFilesystem(ctx, &restoreReq)
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error commiting transaction", logkeys.FilesystemName, backendName)
+		fs.quotaServiceClient.decFileQuota(ctx, in.Metadata.CloudAccountId, reqSize, false)
+		fs.deleteRestoredFilesystem(ctx, &restoreReq)
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+
+	return convertFilesystemPrivateToPublic(&fsPrivate, false), nil
+}
+
+func (fs *FilesystemServiceServer) GetSnapshotPolicy(ctx context.Context, in *pb.FilesystemSnapshotPolicyGetRequest) (*pb.FilesystemSnapshotPolicy, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.GetSnapshotPolicy").
+		WithValues(logkeys.CloudAccountId, in.Metadata.CloudAccountId, logkeys.FilesystemId, in.Metadata.FilesystemId).Start()
+	defer span.End()
+	logger.Info("entering filesystem snapshot policy get")
+	defer logger.Info("returning from filesystem snapshot policy get")
+
+	if err := isValidSnapshotPolicyReference(in.Metadata); err != nil {
+		return nil, err
+	}
+
+	if fs.cfg.AuthzEnabled {
+		_, err := fs.lookupResourcePermission(ctx, in.Metadata.CloudAccountId, "get", []string{in.Metadata.FilesystemId}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbSession := fs.session
+	if dbSession == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	return query.GetFilesystemSnapshotPolicy(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId)
+}
+
+func (fs *FilesystemServiceServer) UpdateSnapshotPolicy(ctx context.Context, in *pb.FilesystemSnapshotPolicyUpdateRequest) (*pb.FilesystemSnapshotPolicy, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.UpdateSnapshotPolicy").
+		WithValues(logkeys.CloudAccountId, in.Metadata.CloudAccountId, logkeys.FilesystemId, in.Metadata.FilesystemId).Start()
+	defer span.End()
+	logger.Info("entering filesystem snapshot policy update", logkeys.SnapshotPolicy, in.Spec)
+	defer logger.Info("returning from filesystem snapshot policy update")
+
+	if err := isValidSnapshotPolicyReference(in.Metadata); err != nil {
+		return nil, err
+	}
+	if err := isValidSnapshotPolicySpec(in.Spec); err != nil {
+		return nil, err
+	}
+
+	if fs.cfg.AuthzEnabled {
+		_, err := fs.lookupResourcePermission(ctx, in.Metadata.CloudAccountId, "snapshot", []string{in.Metadata.FilesystemId}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbSession := fs.session
+	if dbSession == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	if _, err := getSnapshotSourceFilesystem(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+	policy, err := query.GetFilesystemSnapshotPolicy(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		policy = &pb.FilesystemSnapshotPolicy{
+			Metadata: &pb.FilesystemSnapshotPolicyMetadata{
+				CloudAccountId:    in.Metadata.CloudAccountId,
+				FilesystemId:      in.Metadata.FilesystemId,
+				CreationTimestamp: now,
+			},
+			Status: &pb.FilesystemSnapshotPolicyStatus{},
+		}
+	}
+	policy.Metadata.UpdateTimestamp = now
+	policy.Spec = in.Spec
+
+	if err := query.StoreFilesystemSnapshotPolicy(ctx, tx, policy); err != nil {
+		logger.Error(err, "error storing snapshot policy into db")
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error commiting transaction")
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+	return policy, nil
+}
+
+func (fs *FilesystemServiceServer) DeleteSnapshotPolicy(ctx context.Context, in *pb.FilesystemSnapshotPolicyDeleteRequest) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.DeleteSnapshotPolicy").
+		WithValues(logkeys.CloudAccountId, in.Metadata.CloudAccountId, logkeys.FilesystemId, in.Metadata.FilesystemId).Start()
+	defer span.End()
+	logger.Info("entering filesystem snapshot policy delete")
+	defer logger.Info("returning from filesystem snapshot policy delete")
+
+	if err := isValidSnapshotPolicyReference(in.Metadata); err != nil {
+		return nil, err
+	}
+
+	if fs.cfg.AuthzEnabled {
+		_, err := fs.lookupResourcePermission(ctx, in.Metadata.CloudAccountId, "snapshot", []string{in.Metadata.FilesystemId}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbSession := fs.session
+	if dbSession == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	if err := query.DeleteFilesystemSnapshotPolicy(ctx, tx, in.Metadata.CloudAccountId, in.Metadata.FilesystemId); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error commiting transaction")
+		return nil, status.Errorf(codes.Internal, "database transaction failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SearchSnapshotsPrivate streams the snapshots which were present after deletedAfter,
+// used by the metering monitor to report snapshot capacity.
+func (fs *FilesystemServiceServer) SearchSnapshotsPrivate(in *pb.FilesystemSnapshotSearchStreamPrivateRequest, rs pb.FilesystemPrivateService_SearchSnapshotsPrivateServer) error {
+	ctx, logger, span := obs.LogAndSpanFromContext(rs.Context()).WithName("FilesystemServiceServer.SearchSnapshotsPrivate").Start()
+	defer span.End()
+
+	dbSession := fs.session
+	if dbSession == nil {
+		return status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "error startind db tx.")
+	}
+	defer tx.Rollback()
+
+	deletedAfter := time.Now()
+	if in.DeletedAfter != nil {
+		deletedAfter = in.DeletedAfter.AsTime()
+	}
+	snapshots, err := query.GetFilesystemSnapshotsForMetering(ctx, tx, deletedAfter)
+	if err != nil {
+		logger.Error(err, "error reading snapshots from db")
+		return status.Errorf(codes.Internal, "database transaction failed")
+	}
+	for _, snapshot := range snapshots {
+		if err := rs.Send(snapshot); err != nil {
+			logger.Error(err, "error sending snapshot")
+			return err
+		}
+	}
+	return nil
+}
+
+// StartSnapshotScheduler periodically takes the snapshots defined by the snapshot
+// policies until the context is cancelled.
+func (fs *FilesystemServiceServer) StartSnapshotScheduler(ctx context.Context, interval time.Duration) {
+	logger := log.FromContext(ctx).WithName("FilesystemServiceServer.StartSnapshotScheduler")
+	logger.Info("starting snapshot scheduler", "interval", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping snapshot scheduler")
+			return
+		case <-ticker.C:
+			fs.ProcessSnapshotPolicies(ctx)
+		}
+	}
+}
+
+// ProcessSnapshotPolicies takes the due scheduled snapshots, prunes the scheduled
+// snapshots exceeding the retention count and refreshes the snapshot usage.
+func (fs *FilesystemServiceServer) ProcessSnapshotPolicies(ctx context.Context) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.ProcessSnapshotPolicies").Start()
+	defer span.End()
+
+	dbSession := fs.session
+	if dbSession == nil {
+		logger.Info("no database connection found")
+		return
+	}
+
+	policies, err := readEnabledSnapshotPolicies(ctx, dbSession)
+	if err != nil {
+		logger.Error(err, "error reading snapshot policies")
+		return
+	}
+
+	for _, policy := range policies {
+		if err := fs.processSnapshotPolicy(ctx, policy, time.Now().UTC()); err != nil {
+			logger.Error(err, "error processing snapshot policy", logkeys.CloudAccountId, policy.Metadata.CloudAccountId,
+				logkeys.FilesystemId, policy.Metadata.FilesystemId)
+		}
+	}
+
+	if err := fs.refreshSnapshotUsage(ctx); err != nil {
+		logger.Error(err, "error refreshing snapshot usage")
+	}
+}
+
+func (fs *FilesystemServiceServer) processSnapshotPolicy(ctx context.Context, policy *pb.FilesystemSnapshotPolicy, now time.Time) error {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.processSnapshotPolicy").
+		WithValues(logkeys.CloudAccountId, policy.Metadata.CloudAccountId, logkeys.FilesystemId, policy.Metadata.FilesystemId).Start()
+	defer span.End()
+
+	cloudAccountId := policy.Metadata.CloudAccountId
+	filesystemId := policy.Metadata.FilesystemId
+
+	if !isSnapshotDue(policy, now) {
+		return nil
+	}
+
+	if policy.Status == nil {
+		policy.Status = &pb.FilesystemSnapshotPolicyStatus{}
+	}
+	_, err := fs.createSnapshot(ctx, cloudAccountId, filesystemId, scheduledSnapshotPrefix+now.Format(snapshotNameTimeFormat), "", true)
+	switch status.Code(err) {
+	case codes.OK:
+		policy.Status.LastSnapshotTimestamp = timestamppb.New(now)
+		policy.Status.Message = ""
+	case codes.NotFound:
+		// filesystem is gone, so is its policy
+		logger.Info("filesystem not found, removing snapshot policy")
+		return fs.removeSnapshotPolicy(ctx, cloudAccountId, filesystemId)
+	default:
+		logger.Error(err, "error taking scheduled snapshot")
+		policy.Status.Message = "scheduled snapshot failed"
+	}
+
+	if err := fs.storeSnapshotPolicyStatus(ctx, policy); err != nil {
+		return err
+	}
+
+	snapshots, err := fs.readFilesystemSnapshots(ctx, cloudAccountId, filesystemId)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshotsToPrune(snapshots, int(policy.Spec.RetentionCount)) {
+		logger.Info("pruning scheduled snapshot", logkeys.SnapshotName, snapshot.Metadata.Name)
+		if err := fs.deleteSnapshot(ctx, cloudAccountId, filesystemId, snapshot.Metadata.ResourceId); err != nil {
+			logger.Error(err, "error pruning scheduled snapshot", logkeys.SnapshotName, snapshot.Metadata.Name)
+		}
+	}
+	return nil
+}
+
+// Refreshes the capacity consumed by each snapshot as reported by the backend,
+// snapshot usage grows as the filesystem diverges from it. The backend is queried
+// outside of any transaction, the usage is written in a short transaction per filesystem.
+func (fs *FilesystemServiceServer) refreshSnapshotUsage(ctx context.Context) error {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemServiceServer.refreshSnapshotUsage").Start()
+	defer span.End()
+
+	byFilesystem, filesystems, err := fs.readSnapshotUsageFilesystems(ctx)
+	if err != nil {
+		return err
+	}
+
+	for filesystemId, fsSnapshots := range byFilesystem {
+		fsPrivate, found := filesystems[filesystemId]
+		if !found {
+			logger.Info("skipping usage refresh, filesystem not found", logkeys.FilesystemId, filesystemId)
+			continue
+		}
+		backendReq := snapshotBackendRequest(fsPrivate, "")
+		resp, err := fs.snapshotClient.ListSnapshotsPrivate(ctx, &pb.FilesystemSnapshotBackendListRequest{
+			ClusterUUID:        backendReq.ClusterUUID,
+			NamespaceName:      backendReq.NamespaceName,
+			NamespaceCredsPath: backendReq.NamespaceCredsPath,
+			FilesystemName:     backendReq.FilesystemName,
+			StorageClass:       backendReq.StorageClass,
+		})
+		if err != nil {
+			logger.Error(err, "error listing snapshots in backend", logkeys.FilesystemId, filesystemId)
+			continue
+		}
+		usedBytes := map[string]uint64{}
+		for _, backendSnapshot := range resp.Snapshots {
+			usedBytes[backendSnapshot.Name] = backendSnapshot.UsedBytes
+		}
+		if err := fs.storeSnapshotUsage(ctx, fsSnapshots, usedBytes); err != nil {
+			logger.Error(err, "error storing snapshot usage", logkeys.FilesystemId, filesystemId)
+		}
+	}
+
+	return nil
+}
+
+// Reads the snapshots to be metered grouped by filesystem, along with their filesystems
+func (fs *FilesystemServiceServer) readSnapshotUsageFilesystems(ctx context.Context) (map[string][]*pb.FilesystemSnapshotPrivate, map[string]*pb.FilesystemPrivate, error) {
+	tx, err := fs.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	snapshots, err := query.GetFilesystemSnapshotsForMetering(ctx, tx, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byFilesystem := map[string][]*pb.FilesystemSnapshotPrivate{}
+	filesystems := map[string]*pb.FilesystemPrivate{}
+	for _, snapshot := range snapshots {
+		filesystemId := snapshot.Metadata.FilesystemId
+		if _, found := byFilesystem[filesystemId]; !found {
+			fsPrivate, err := query.GetFilesystemByResourceId(ctx, tx, snapshot.Metadata.CloudAccountId, filesystemId, timestampInfinityStr)
+			if err == nil {
+				filesystems[filesystemId] = fsPrivate
+			}
+		}
+		byFilesystem[filesystemId] = append(byFilesystem[filesystemId], snapshot)
+	}
+	return byFilesystem, filesystems, nil
+}
+
+// Stores the usage reported by the backend, the snapshots are read again so that
+// changes made while the backend was queried are not overwritten.
+func (fs *FilesystemServiceServer) storeSnapshotUsage(ctx context.Context, snapshots []*pb.FilesystemSnapshotPrivate, usedBytes map[string]uint64) error {
+	tx, err := fs.session.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, snapshot := range snapshots {
+		used, found := usedBytes[snapshot.Metadata.Name]
+		if !found || used == snapshot.Status.UsedBytes {
+			continue
+		}
+		current, err := query.GetFilesystemSnapshotById(ctx, tx, snapshot.Metadata.CloudAccountId, snapshot.Metadata.FilesystemId, snapshot.Metadata.ResourceId)
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		current.Status.UsedBytes = used
+		if err := query.UpdateFilesystemSnapshot(ctx, tx, current); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (fs *FilesystemServiceServer) readFilesystemSnapshots(ctx context.Context, cloudAccountId, filesystemId string) ([]*pb.FilesystemSnapshotPrivate, error) {
+	tx, err := fs.session.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	return query.GetFilesystemSnapshotsByFilesystemId(ctx, tx, cloudAccountId, filesystemId)
+}
+
+func (fs *FilesystemServiceServer) updateSnapshotStatus(ctx context.Context, snapshot *pb.FilesystemSnapshotPrivate) error {
+	tx, err := fs.session.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := query.UpdateFilesystemSnapshot(ctx, tx, snapshot); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (fs *FilesystemServiceServer) storeSnapshotPolicyStatus(ctx context.Context, policy *pb.FilesystemSnapshotPolicy) error {
+	tx, err := fs.session.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := query.StoreFilesystemSnapshotPolicy(ctx, tx, policy); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (fs *FilesystemServiceServer) removeSnapshotPolicy(ctx context.Context, cloudAccountId, filesystemId string) error {
+	tx, err := fs.session.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := query.DeleteFilesystemSnapshotPolicy(ctx, tx, cloudAccountId, filesystemId); err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+	return tx.Commit()
+}
+
+func readEnabledSnapshotPolicies(ctx context.Context, dbSession *sql.DB) ([]*pb.FilesystemSnapshotPolicy, error) {
+	tx, err := dbSession.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	return query.GetEnabledFilesystemSnapshotPolicies(ctx, tx)
+}
+
+// Reads the filesystem to snapshot, the filesystem must be scheduled to a cluster
+func getSnapshotSourceFilesystem(ctx context.Context, tx *sql.Tx, cloudAccountId, filesystemId string) (*pb.FilesystemPrivate, error) {
+	fsPrivate, err := query.GetFilesystemByResourceId(ctx, tx, cloudAccountId, filesystemId, timestampInfinityStr)
+	if err != nil {
+		return nil, err
+	}
+	if !isSnapshotSupported(fsPrivate.Spec.StorageClass) {
+		return nil, status.Error(codes.FailedPrecondition, "snapshots are not supported for this storage class")
+	}
+	if fsPrivate.Spec.Scheduler == nil || fsPrivate.Spec.Scheduler.Cluster == nil || fsPrivate.Spec.Scheduler.Namespace == nil {
+		return nil, status.Error(codes.FailedPrecondition, "filesystem is not scheduled")
+	}
+	return fsPrivate, nil
+}
+
+func isSnapshotSupported(storageClass pb.FilesystemStorageClass) bool {
+	return storageClass == pb.FilesystemStorageClass_GeneralPurpose || storageClass == pb.FilesystemStorageClass_AIOptimized ||
+		storageClass == pb.FilesystemStorageClass_GeneralPurposeStd
+}
+
+// Returns the name of the filesystem on the cluster, VAST views are named by the vast operator
+// after the cloudaccount to be unique across the cluster.
+func snapshotBackendFilesystemName(storageClass pb.FilesystemStorageClass, cloudAccountId, name string) string {
+	if storageClass == pb.FilesystemStorageClass_GeneralPurposeStd {
+		return utils.GenerateVASTFilesystemName(cloudAccountId, name)
+	}
+	return name
+}
+
+// Rolls back a restore which could not be recorded, the filesystem created by the backend
+// is not known to the operator and would otherwise be left behind.
+func (fs *FilesystemServiceServer) deleteRestoredFilesystem(ctx context.Context, restoreReq *pb.FilesystemSnapshotBackendRestoreRequest) {
+	logger := log.FromContext(ctx).WithName("FilesystemServiceServer.deleteRestoredFilesystem")
+	if _, err := fs.snapshotClient.DeleteRestoredFilesystemPrivate(ctx, restoreReq); err != nil {
+		logger.Error(err, "error deleting restored filesystem in backend", logkeys.FilesystemName, restoreReq.NewFilesystemName)
+	}
+}
+
+func snapshotBackendRequest(fsPrivate *pb.FilesystemPrivate, snapshotName string) *pb.FilesystemSnapshotBackendRequest {
+	return &pb.FilesystemSnapshotBackendRequest{
+		ClusterUUID:        fsPrivate.Spec.Scheduler.Cluster.ClusterUUID,
+		NamespaceName:      fsPrivate.Spec.Scheduler.Namespace.Name,
+		NamespaceCredsPath: fsPrivate.Spec.Scheduler.Namespace.CredentialsPath,
+		FilesystemName:     snapshotBackendFilesystemName(fsPrivate.Spec.StorageClass, fsPrivate.Metadata.CloudAccountId, fsPrivate.Metadata.Name),
+		SnapshotName:       snapshotName,
+		StorageClass:       fsPrivate.Spec.StorageClass,
+	}
+}
+
+func isSnapshotDue(policy *pb.FilesystemSnapshotPolicy, now time.Time) bool {
+	if policy.Spec == nil || !policy.Spec.Enabled || policy.Spec.IntervalHours <= 0 {
+		return false
+	}
+	if policy.Status == nil || policy.Status.LastSnapshotTimestamp == nil {
+		return true
+	}
+	nextSnapshot := policy.Status.LastSnapshotTimestamp.AsTime().Add(time.Duration(policy.Spec.IntervalHours) * time.Hour)
+	return !nextSnapshot.After(now)
+}
+
+// Returns the oldest scheduled snapshots exceeding the retention count,
+// on-demand snapshots are never pruned.
+func snapshotsToPrune(snapshots []*pb.FilesystemSnapshotPrivate, retentionCount int) []*pb.FilesystemSnapshotPrivate {
+	scheduled := []*pb.FilesystemSnapshotPrivate{}
+	for _, snapshot := range snapshots {
+		if snapshot.Metadata.Scheduled {
+			scheduled = append(scheduled, snapshot)
+		}
+	}
+	if retentionCount < 0 || len(scheduled) <= retentionCount {
+		return nil
+	}
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return scheduled[i].Metadata.CreationTimestamp.AsTime().Before(scheduled[j].Metadata.CreationTimestamp.AsTime())
+	})
+	return scheduled[:len(scheduled)-retentionCount]
+}
+
+func isValidSnapshotReference(md *pb.FilesystemSnapshotMetadataReference) error {
+	if idcutils.IsValidCloudAccountId(md.CloudAccountId) {
+		return status.Error(codes.InvalidArgument, "invalid cloudaccount")
+	}
+	if err := isValidUUID(md.FilesystemId); err != nil {
+		return err
+	}
+	return isValidUUID(md.ResourceId)
+}
+
+func isValidSnapshotPolicyReference(md *pb.FilesystemSnapshotPolicyMetadataReference) error {
+	if idcutils.IsValidCloudAccountId(md.CloudAccountId) {
+		return status.Error(codes.InvalidArgument, "invalid cloudaccount")
+	}
+	return isValidUUID(md.FilesystemId)
+}
+
+func isValidSnapshotPolicySpec(spec *pb.FilesystemSnapshotPolicySpec) error {
+	if spec == nil {
+		return status.Error(codes.InvalidArgument, "missing snapshot policy spec")
+	}
+	if spec.IntervalHours < 1 || spec.IntervalHours > maxSnapshotIntervalHours {
+		return status.Errorf(codes.InvalidArgument, "intervalHours must be between 1 and %d", maxSnapshotIntervalHours)
+	}
+	if spec.RetentionCount < 1 || spec.RetentionCount > maxSnapshotRetentionCount {
+		return status.Errorf(codes.InvalidArgument, "retentionCount must be between 1 and %d", maxSnapshotRetentionCount)
+	}
+	return nil
+}
+
+func isValidUUID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid resourceId")
+	}
+	return nil
+}
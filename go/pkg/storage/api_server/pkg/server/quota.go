@@ -12,6 +12,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
 	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/database/query"
 )
@@ -33,6 +34,7 @@ const (
 	filesystemSizeQuota  = "totalSizeTB"
 	bucketQuota          = "buckets"
 	bucketSizeQuota      = "bucketSizeInTB"
+	gbPerTB              = 1000
 )
 
 func (qSvc *QuotaService) Init(ctx context.Context, session *sql.DB, configQuota CloudAccountQuota, qmsClient v1.QuotaManagementPrivateServiceClient) error {
@@ -70,93 +72,43 @@ func (qSvc *QuotaService) refreshCache(ctx context.Context) error {
 func (qSvc *QuotaService) checkAndUpdateFileQuota(ctx context.Context, cloudAccount *v1.CloudAccount, requestedSize int64, isUpdateFileRequest bool) bool {
 	logger := log.FromContext(ctx).WithName("QuotaService.checkAndUpdateFileQuota")
 	logger.Info("entering cloudaccount quota check ", logkeys.CloudAccountId, cloudAccount.GetId(), logkeys.CloudAccountType, cloudAccount.GetType())
-	var storageQuotaByAccount *query.StorageQuotaByAccount
-	var filevolumesQuota int64
-	var filesizeQuotaInTB int64
-
-	// Get volume quota
 	if qSvc.qmsClient != nil {
-		req := &v1.ServiceQuotaResourceRequestPrivate{
-			ServiceName:    storageServiceName,
-			CloudAccountId: cloudAccount.Id,
-		}
-		// Get cloudaccount storage quotas from QMS
-		// Get volume quota
-		req.ResourceType = filesystemQuota
-		resp, err := qSvc.qmsClient.GetResourceQuotaPrivate(ctx, req)
-		if err != nil {
-			logger.Error(err, "error when calling QMS for filevolumesQuota")
-			return false
-		}
-		if len(resp.CustomQuota.ServiceResources) != 0 {
-			filevolumesQuota = resp.CustomQuota.ServiceResources[0].QuotaConfig.Limits
-		} else {
-			filevolumesQuota = resp.DefaultQuota.ServiceResources[0].QuotaConfig.Limits
-		}
-		logger.Info("qms volume count quota", "quota", filevolumesQuota)
+		return qSvc.reserveFileQuota(ctx, cloudAccount.GetId(), requestedSize, isUpdateFileRequest)
+	}
 
-		// Get volume size quota
-		req.ResourceType = filesystemSizeQuota
-		resp, err = qSvc.qmsClient.GetResourceQuotaPrivate(ctx, req)
-		if err != nil {
-			logger.Error(err, "error when calling QMS for filesizeQuotaInTB")
-			return false
-		}
-		if len(resp.CustomQuota.ServiceResources) != 0 {
-			filesizeQuotaInTB = resp.CustomQuota.ServiceResources[0].QuotaConfig.Limits
-		} else {
-			filesizeQuotaInTB = resp.DefaultQuota.ServiceResources[0].QuotaConfig.Limits
-		}
-		logger.Info("qms volume size quota", "quota", filesizeQuotaInTB)
-	} else {
-		// Start a new transaction
-		tx, err := qSvc.session.BeginTx(ctx, nil)
-		if err != nil {
-			logger.Error(err, "error starting transaction")
-			// handle error
-		}
-		// Get the storage quota by account
-		storageQuotaByAccount, err = query.GetStorageQuotaByAccount(ctx, tx, cloudAccount.GetId())
-		if err != nil {
-			logger.Error(err, "error getting storage quota by account")
-			// handle error
-		}
+	// Start a new transaction
+	tx, err := qSvc.session.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(err, "error starting transaction")
+		// handle error
+	}
+	// Get the storage quota by account
+	storageQuotaByAccount, err := query.GetStorageQuotaByAccount(ctx, tx, cloudAccount.GetId())
+	if err != nil {
+		logger.Error(err, "error getting storage quota by account")
+		// handle error
 	}
 
 	qSvc.mu.Lock()
 	defer qSvc.mu.Unlock()
-
-	//ensure the cache is not stale, else refresh it
-	logger.Info("quota cache stale-ness check", logkeys.TimeInSecondsSinceUpdate, time.Since(qSvc.updateTimestamp).Seconds(), logkeys.QuotaCacheTtl, quotaCacheExpireSecs)
-	if time.Since(qSvc.updateTimestamp).Seconds() > quotaCacheExpireSecs {
-		if err := qSvc.refreshCache(ctx); err != nil {
-			logger.Error(err, "error refreshing cache")
-			// soft handle the error,
-			// continue using the stale cache
-		}
-	}
+	qSvc.refreshStaleCache(ctx)
 
 	var qtFilesystems int64
 	var qtSize int64
 
 	// If there's a storage quota for the account, use it
-	if qSvc.qmsClient != nil {
-		qtFilesystems = filevolumesQuota
-		qtSize = filesizeQuotaInTB * 1000 // convert the TB to GB
-	} else {
-		if storageQuotaByAccount != nil {
-			qtFilesystems = storageQuotaByAccount.FilevolumesQuota
-			qtSize = storageQuotaByAccount.FilesizeQuotaInTB * 1000 // convert the TB to GB
+	if storageQuotaByAccount != nil {
+		qtFilesystems = storageQuotaByAccount.FilevolumesQuota
+		qtSize = storageQuotaByAccount.FilesizeQuotaInTB * gbPerTB
 
-		} else {
-			// If there's no storage quota for the account, use the launch quota
-			launchQuota, qCfgfound := qSvc.accountConfigQuota.CloudAccounts[getAccountTypeStr(cloudAccount.Type)]
-			if !qCfgfound {
-				return true
-			}
-			qtFilesystems = int64(launchQuota.StorageQuota["filesystems"])
-			qtSize = int64(launchQuota.StorageQuota["totalSizeGB"])
+	} else {
+		// If there's no storage quota for the account, use the launch quota
+		launchQuota, qCfgfound := qSvc.accountConfigQuota.CloudAccounts[getAccountTypeStr(cloudAccount.Type)]
+		if !qCfgfound {
+			return true
 		}
+		qtFilesystems = int64(launchQuota.StorageQuota["filesystems"])
+		qtSize = int64(launchQuota.StorageQuota["totalSizeGB"])
 	}
 
 	logger.Info("storage quota setting ", logkeys.QuotaFilesystem, qtFilesystems, logkeys.QuotaSize, qtSize)
@@ -187,6 +139,50 @@ func (qSvc *QuotaService) checkAndUpdateFileQuota(ctx context.Context, cloudAcco
 	return true
 }
 
+// reserveFileQuota reserves the filesystem and its size in the usage ledger of QMS, which enforces the quotas of the
+// cloudaccount atomically across replicas. The cache only gives the usage that initializes the ledger the first time a
+// resource is reserved.
+func (qSvc *QuotaService) reserveFileQuota(ctx context.Context, cloudAccountId string, requestedSize int64, isUpdateFileRequest bool) bool {
+	logger := log.FromContext(ctx).WithName("QuotaService.reserveFileQuota")
+	qSvc.mu.Lock()
+	defer qSvc.mu.Unlock()
+	qSvc.refreshStaleCache(ctx)
+
+	usedQuota := (qSvc.accountQuotaCacheFile)[cloudAccountId]
+	if !isUpdateFileRequest && !qSvc.reserveQuota(ctx, cloudAccountId, filesystemQuota, 1, usedQuota.TotalFileInstances, 1) {
+		return false
+	}
+	// The size is counted in GB against the quota in TB.
+	if requestedSize > 0 && !qSvc.reserveQuota(ctx, cloudAccountId, filesystemSizeQuota, requestedSize, usedQuota.TotalFileSizeInGB, gbPerTB) {
+		if !isUpdateFileRequest {
+			qSvc.releaseQuota(ctx, cloudAccountId, filesystemQuota, 1)
+		}
+		return false
+	}
+
+	if !isUpdateFileRequest {
+		usedQuota.TotalFileInstances++
+	}
+	usedQuota.TotalFileSizeInGB += requestedSize
+	(qSvc.accountQuotaCacheFile)[cloudAccountId] = usedQuota
+	logger.Info("quota reserved for account", logkeys.CloudAccountId, cloudAccountId)
+	return true
+}
+
+// refreshStaleCache refreshes the cache of the used quota if it is older than quotaCacheExpireSecs.
+// The caller must hold qSvc.mu.
+func (qSvc *QuotaService) refreshStaleCache(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("QuotaService.refreshStaleCache")
+	logger.Info("quota cache stale-ness check", logkeys.TimeInSecondsSinceUpdate, time.Since(qSvc.updateTimestamp).Seconds(), logkeys.QuotaCacheTtl, quotaCacheExpireSecs)
+	if time.Since(qSvc.updateTimestamp).Seconds() > quotaCacheExpireSecs {
+		if err := qSvc.refreshCache(ctx); err != nil {
+			logger.Error(err, "error refreshing cache")
+			// soft handle the error,
+			// continue using the stale cache
+		}
+	}
+}
+
 func (qSvc *QuotaService) decFileQuota(ctx context.Context, cloudAccountId string, deletedSize int64, isUpdateFileRequest bool) bool {
 	logger := log.FromContext(ctx).WithName("QuotaService.decFileQuota")
 	logger.Info("entering cloudaccount quota update for delete ", logkeys.CloudAccountId, cloudAccountId)
@@ -194,6 +190,15 @@ func (qSvc *QuotaService) decFileQuota(ctx context.Context, cloudAccountId strin
 	qSvc.mu.Lock()
 	defer qSvc.mu.Unlock()
 
+	if qSvc.qmsClient != nil {
+		if !isUpdateFileRequest {
+			qSvc.releaseQuota(ctx, cloudAccountId, filesystemQuota, 1)
+		}
+		if deletedSize > 0 {
+			qSvc.releaseQuota(ctx, cloudAccountId, filesystemSizeQuota, deletedSize)
+		}
+	}
+
 	usedQuota, found := (qSvc.accountQuotaCacheFile)[cloudAccountId]
 	if !found {
 		logger.Info("no used quota found for account", logkeys.CloudAccountId, cloudAccountId)
@@ -223,6 +228,113 @@ func (qSvc *QuotaService) decFileQuota(ctx context.Context, cloudAccountId strin
 	return true
 }
 
+// reserveQuota reserves and commits quantity of a resource in the usage ledger of QMS, which enforces the quota of the
+// cloudaccount atomically across replicas. currentUsage initializes the ledger the first time the resource is reserved,
+// and limitsScale is the number of units of quantity in a unit of the quota limit.
+func (qSvc *QuotaService) reserveQuota(ctx context.Context, cloudAccountId, resourceType string, quantity, currentUsage, limitsScale int64) bool {
+	logger := log.FromContext(ctx).WithName("QuotaService.reserveQuota")
+	reservation, err := qSvc.qmsClient.ReserveQuotaPrivate(ctx, &v1.QuotaReservationRequestPrivate{
+		ServiceName:    storageServiceName,
+		ResourceType:   resourceType,
+		CloudAccountId: cloudAccountId,
+		Quantity:       quantity,
+		CurrentUsage:   currentUsage,
+		LimitsScale:    limitsScale,
+	})
+	if err != nil {
+		logger.Error(err, "error when calling QMS to reserve quota", "resourceType", resourceType)
+		return false
+	}
+	// The resource is committed right away; failed creations give it back like deletions, with releaseQuota.
+	if _, err := qSvc.qmsClient.CommitQuotaReservationPrivate(ctx, &v1.QuotaReservationReferencePrivate{ReservationId: reservation.ReservationId}); err != nil {
+		logger.Error(err, "error when calling QMS to commit quota", "resourceType", resourceType)
+		if _, err := qSvc.qmsClient.ReleaseQuotaPrivate(ctx, &v1.QuotaReleaseRequestPrivate{ReservationId: reservation.ReservationId}); err != nil {
+			logger.Error(err, "error when calling QMS to release quota", "resourceType", resourceType)
+		}
+		return false
+	}
+	return true
+}
+
+// releaseQuota removes quantity of a resource from the usage ledger of QMS.
+// An error is only logged, the usage is corrected by the next quota usage reconciliation.
+func (qSvc *QuotaService) releaseQuota(ctx context.Context, cloudAccountId, resourceType string, quantity int64) {
+	logger := log.FromContext(ctx).WithName("QuotaService.releaseQuota")
+	if _, err := qSvc.qmsClient.ReleaseQuotaPrivate(ctx, &v1.QuotaReleaseRequestPrivate{
+		ServiceName:    storageServiceName,
+		ResourceType:   resourceType,
+		CloudAccountId: cloudAccountId,
+		Quantity:       quantity,
+	}); err != nil {
+		logger.Error(err, "error when calling QMS to release quota", "resourceType", resourceType)
+	}
+}
+
+// quotaUsageReconcileMargin is subtracted from the time the resources are counted, so that the usage changed by
+// resources being created or deleted while they are counted is left to the next reconciliation.
+const quotaUsageReconcileMargin = time.Minute
+
+// ReconcileQuotaUsage sets the usage ledger of QMS to the filesystems, their size and the buckets of each cloudaccount.
+// It corrects the usage left wrong by releases that failed.
+func (qSvc *QuotaService) ReconcileQuotaUsage(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("QuotaService.ReconcileQuotaUsage")
+	if qSvc.qmsClient == nil {
+		return nil
+	}
+	updatedBefore := time.Now().Add(-quotaUsageReconcileMargin)
+	usedFile := map[string]query.UsedQuotaFile{}
+	if err := query.UpdateUsedFileQuotaForAllAccounts(ctx, qSvc.session, &usedFile, timestampInfinityStr); err != nil {
+		return err
+	}
+	usedObj := map[string]query.UsedQuotaObj{}
+	if err := query.UpdateUsedObjQuotaForAllAccounts(ctx, qSvc.session, &usedObj); err != nil {
+		return err
+	}
+
+	usages := []*v1.QuotaUsageCountPrivate{}
+	for cloudAccountId, used := range usedFile {
+		usages = append(usages,
+			&v1.QuotaUsageCountPrivate{ResourceType: filesystemQuota, CloudAccountId: cloudAccountId, Usage: used.TotalFileInstances},
+			&v1.QuotaUsageCountPrivate{ResourceType: filesystemSizeQuota, CloudAccountId: cloudAccountId, Usage: used.TotalFileSizeInGB, LimitsScale: gbPerTB})
+	}
+	for cloudAccountId, used := range usedObj {
+		usages = append(usages, &v1.QuotaUsageCountPrivate{ResourceType: bucketQuota, CloudAccountId: cloudAccountId, Usage: used.TotalBuckets})
+	}
+
+	logger.Info("reconciling quota usage", "usages", len(usages))
+	_, err := qSvc.qmsClient.ReconcileQuotaUsagePrivate(ctx, &v1.QuotaUsageReconcileRequestPrivate{
+		ServiceName:   storageServiceName,
+		Usages:        usages,
+		UpdatedBefore: timestamppb.New(updatedBefore),
+	})
+	return err
+}
+
+// StartQuotaUsageReconciler reconciles the usage ledger of QMS every interval.
+func (qSvc *QuotaService) StartQuotaUsageReconciler(ctx context.Context, interval time.Duration) {
+	logger := log.FromContext(ctx).WithName("QuotaService.StartQuotaUsageReconciler")
+	logger.Info("starting quota usage reconciler", "interval", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	// Reconcile once at startup so that the usage of filesystems and buckets created before the ledger existed is
+	// reported without waiting for the first interval.
+	if err := qSvc.ReconcileQuotaUsage(ctx); err != nil {
+		logger.Error(err, "error reconciling quota usage")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping quota usage reconciler")
+			return
+		case <-ticker.C:
+			if err := qSvc.ReconcileQuotaUsage(ctx); err != nil {
+				logger.Error(err, "error reconciling quota usage")
+			}
+		}
+	}
+}
+
 func getAccountTypeStr(accType v1.AccountType) string {
 	switch accType {
 	case v1.AccountType_ACCOUNT_TYPE_ENTERPRISE:
@@ -244,28 +356,10 @@ func (qSvc *QuotaService) checkAndUpdateBucketQuota(ctx context.Context, cloudAc
 	logger := log.FromContext(ctx).WithName("QuotaService.checkAndUpdateBucketQuota")
 	logger.Info("entering cloudaccount quota check ", logkeys.CloudAccountId, cloudAccount.GetId(), logkeys.CloudAccountType, cloudAccount.GetType())
 	var storageQuotaByAccount *query.StorageQuotaByAccount
-	var bkQuota int64
 	// Get bucket quota
 
-	if qSvc.qmsClient != nil {
-		req := &v1.ServiceQuotaResourceRequestPrivate{
-			ServiceName:    storageServiceName,
-			CloudAccountId: cloudAccount.Id,
-		}
-		// Get cloudaccount storage quotas from QMS
-		req.ResourceType = bucketQuota
-		resp, err := qSvc.qmsClient.GetResourceQuotaPrivate(ctx, req)
-		if err != nil {
-			logger.Error(err, "error when calling QMS for filevolumesQuota")
-			return false
-		}
-		if len(resp.CustomQuota.ServiceResources) != 0 {
-			bkQuota = resp.CustomQuota.ServiceResources[0].QuotaConfig.Limits
-		} else {
-			bkQuota = resp.DefaultQuota.ServiceResources[0].QuotaConfig.Limits
-		}
-		logger.Info("qms bucket count quota", "quota", bkQuota)
-	} else {
+	// With QMS, the bucket quota is enforced when the bucket is reserved in QMS.
+	if qSvc.qmsClient == nil {
 		tx, err := qSvc.session.BeginTx(ctx, nil)
 		if err != nil {
 			logger.Error(err, "error starting transaction")
@@ -292,10 +386,14 @@ func (qSvc *QuotaService) checkAndUpdateBucketQuota(ctx context.Context, cloudAc
 			// continue using the stale cache
 		}
 	}
-	var qtBuckets int64
+
+	usedQuota, found := (qSvc.accountQuotaCacheObj)[cloudAccount.GetId()]
 	if qSvc.qmsClient != nil {
-		qtBuckets = bkQuota
+		if !qSvc.reserveQuota(ctx, cloudAccount.GetId(), bucketQuota, 1, usedQuota.TotalBuckets, 1) {
+			return false
+		}
 	} else {
+		var qtBuckets int64
 		// If there's a storage quota for the account, use it
 		if storageQuotaByAccount != nil {
 			qtBuckets = storageQuotaByAccount.BucketsQuota
@@ -307,19 +405,10 @@ func (qSvc *QuotaService) checkAndUpdateBucketQuota(ctx context.Context, cloudAc
 			}
 			qtBuckets = int64(launchQuota.StorageQuota["buckets"])
 		}
-	}
-
-	logger.Info("storage bucket quota setting ", logkeys.QuotaBuckets, qtBuckets)
-
-	usedQuota, found := (qSvc.accountQuotaCacheObj)[cloudAccount.GetId()]
-	if !found {
-		(qSvc.accountQuotaCacheObj)[cloudAccount.GetId()] = query.UsedQuotaObj{
-			TotalBuckets: 1,
+		logger.Info("storage bucket quota setting ", logkeys.QuotaBuckets, qtBuckets)
+		if found && qtBuckets <= int64(usedQuota.TotalBuckets) {
+			return false
 		}
-		return true
-	}
-	if qtBuckets <= int64(usedQuota.TotalBuckets) {
-		return false
 	}
 	usedQuota.TotalBuckets++
 	(qSvc.accountQuotaCacheObj)[cloudAccount.GetId()] = usedQuota
@@ -334,6 +423,10 @@ func (qSvc *QuotaService) decBucketQuota(ctx context.Context, cloudAccountId str
 	qSvc.mu.Lock()
 	defer qSvc.mu.Unlock()
 
+	if qSvc.qmsClient != nil {
+		qSvc.releaseQuota(ctx, cloudAccountId, bucketQuota, 1)
+	}
+
 	usedQuota, found := (qSvc.accountQuotaCacheObj)[cloudAccountId]
 	if !found {
 		logger.Info("no used quota found for account", logkeys.CloudAccountId, cloudAccountId)
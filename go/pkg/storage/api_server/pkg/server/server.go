@@ -73,7 +73,9 @@ func newClient(ctx context.Context, addr string, clientOptions ...grpc.DialOptio
 }
 
 type Service struct {
-	Mdb *manageddb.ManagedDb
+	Mdb                    *manageddb.ManagedDb
+	rateLimiter            *grpcutil.RateLimiter
+	idempotencyInterceptor *grpcutil.IdempotencyInterceptor
 }
 
 type Config struct {
@@ -96,6 +98,23 @@ type Config struct {
 	AuthzServerAddr           string            `koanf:"authzServerAddr"`
 	AuthzEnabled              bool              `koanf:"authzEnabled"`
 	CustomQuotaMaxAllowedInTB int64             `koanf:"customQuotaMaxAllowedInTB"`
+	// Interval to run the scheduled filesystem snapshots, 0 disables the snapshot scheduler
+	SnapshotSchedulerIntervalMinutes uint16 `koanf:"snapshotSchedulerIntervalMinutes"`
+	// Interval to correct the quota usage in the Quota Management Service, 0 disables the reconciliation
+	QuotaUsageReconcileIntervalMinutes uint16 `koanf:"quotaUsageReconcileIntervalMinutes"`
+	// Per cloud account request rate and concurrency limits
+	RateLimit grpcutil.RateLimitConfig `koanf:"rateLimit"`
+	// Idempotency-Key support for Create methods
+	Idempotency grpcutil.IdempotencyConfig `koanf:"idempotency"`
+	// Only the replica that holds the lease runs the snapshot scheduler
+	LeaderElection LeaderElectionConfig `koanf:"leaderElection"`
+}
+
+type LeaderElectionConfig struct {
+	// If false, every replica runs the snapshot scheduler.
+	Enabled        bool   `koanf:"enabled"`
+	LeaseName      string `koanf:"leaseName"`
+	LeaseNamespace string `koanf:"leaseNamespace"`
 }
 
 type CloudAccountQuota struct {
@@ -114,6 +133,14 @@ func (config *Config) SetListenPort(port uint16) {
 	config.ListenPort = port
 }
 
+// ServerInterceptors adds the rate limiting and idempotency interceptors. Their stores are set up in Init.
+func (svc *Service) ServerInterceptors(ctx context.Context, cfg *Config) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	svc.rateLimiter = grpcutil.NewRateLimiter(cfg.RateLimit)
+	svc.idempotencyInterceptor = grpcutil.NewIdempotencyInterceptor(cfg.Idempotency)
+	return []grpc.UnaryServerInterceptor{svc.rateLimiter.UnaryServerInterceptor(), svc.idempotencyInterceptor.UnaryServerInterceptor()},
+		[]grpc.StreamServerInterceptor{svc.rateLimiter.StreamServerInterceptor()}
+}
+
 func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Resolver, grpcServer *grpc.Server) error {
 	log.SetDefaultLogger()
 	log := log.FromContext(ctx).WithName("Init")
@@ -189,12 +216,24 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 	storageUserClient := pb.NewFilesystemUserPrivateServiceClient(userclientConn)
 	bucketUserClient := pb.NewBucketUserPrivateServiceClient(userclientConn)
 	bucketlifecycleClient := pb.NewBucketLifecyclePrivateServiceClient(userclientConn)
+	snapshotClient := pb.NewFilesystemSnapshotPrivateServiceClient(userclientConn)
 
 	// Connect to Cloudaccount Service
 	cloudaccountClientConn := newClient(ctx, cfg.CloudaccountServerAddr, dialOptions...)
 	// defer cloudaccountClientConn.Close()
 	cloudAccountServiceClient := pb.NewCloudAccountServiceClient(cloudaccountClientConn)
 
+	if svc.rateLimiter != nil && cfg.RateLimit.Enabled {
+		log.Info("rate limiting enabled", "shared", cfg.RateLimit.Shared)
+		if cfg.RateLimit.Shared {
+			svc.rateLimiter.SetStore(grpcutil.NewPostgresRateLimitStore(sqlDB))
+		}
+		svc.rateLimiter.SetAccountTypeFunc(grpcutil.CloudAccountTypeFunc(cloudAccountServiceClient))
+	}
+	if svc.idempotencyInterceptor != nil && cfg.Idempotency.Enabled && cfg.Idempotency.Shared {
+		svc.idempotencyInterceptor.SetStore(grpcutil.NewPostgresIdempotencyStore(sqlDB))
+	}
+
 	// Connect to Productcatalog Service
 	productcatalogClientConn := newClient(ctx, cfg.ProductcatalogServerAddr, dialOptions...)
 	productcatalogServiceClient := pb.NewProductCatalogServiceClient(productcatalogClientConn)
@@ -218,7 +257,8 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 		storageKmsClient,
 		&quotaService,
 		storageUserClient,
-		wekaAgentClient, cfg)
+		wekaAgentClient,
+		snapshotClient, cfg)
 	if err != nil {
 		return err
 	}
@@ -256,6 +296,27 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 	secGroupMgrService := NewSecGroupMgrService(sqlDB)
 	go secGroupMgrService.StartSecurityGroupScanner(ctx)
 
+	// Start filesystem snapshot scheduler
+	if cfg.SnapshotSchedulerIntervalMinutes > 0 {
+		interval := time.Duration(cfg.SnapshotSchedulerIntervalMinutes) * time.Minute
+		startSnapshotScheduler := func(ctx context.Context) {
+			filesystemSrv.StartSnapshotScheduler(ctx, interval)
+		}
+		// Scheduled snapshots must be taken by a single replica.
+		if cfg.LeaderElection.Enabled {
+			if err := runWithLeaderElection(ctx, cfg.LeaderElection, startSnapshotScheduler); err != nil {
+				return err
+			}
+		} else {
+			go startSnapshotScheduler(ctx)
+		}
+	}
+
+	// Start quota usage reconciliation
+	if quotaManagementClient != nil && cfg.QuotaUsageReconcileIntervalMinutes > 0 {
+		go quotaService.StartQuotaUsageReconciler(ctx, time.Duration(cfg.QuotaUsageReconcileIntervalMinutes)*time.Minute)
+	}
+
 	return nil
 }
 
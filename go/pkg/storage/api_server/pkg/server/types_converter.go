@@ -127,6 +127,13 @@ func convertFilesystemUpdatePublicToPrivate(fsUpdatePublic *pb.FilesystemUpdateR
 	return fsCreatePrivate
 }
 
+func convertFilesystemSnapshotPrivateToPublic(snapshotPrivate *pb.FilesystemSnapshotPrivate) *pb.FilesystemSnapshot {
+	return &pb.FilesystemSnapshot{
+		Metadata: snapshotPrivate.Metadata,
+		Status:   snapshotPrivate.Status,
+	}
+}
+
 func convertBucketPrivateToPublic(bucketPrivate *pb.ObjectBucketPrivate, updateStatus bool) *pb.ObjectBucket {
 	bucketPublic := pb.ObjectBucket{
 		Metadata: &pb.ObjectBucketMetadata{
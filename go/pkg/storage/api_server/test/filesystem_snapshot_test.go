@@ -0,0 +1,220 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("FilesystemServiceServer snapshots", func() {
+	BeforeEach(func() {
+		ctx = CreateContextWithToken("test@user.com")
+	})
+	Context("Snapshot lifecycle", func() {
+		It("Should create, list, restore and delete snapshots", func() {
+			cloudAccountId := "123456789077"
+
+			By("Creating a filesystem")
+			fsResp, err := fsServer.Create(ctx, &pb.FilesystemCreateRequest{
+				Metadata: &pb.FilesystemMetadataCreate{
+					CloudAccountId: cloudAccountId,
+					Name:           "snap-source",
+				},
+				Spec: &pb.FilesystemSpec{
+					AvailabilityZone: "az1",
+					Request: &pb.FilesystemCapacity{
+						Storage: "1TB",
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+			filesystemId := fsResp.Metadata.ResourceId
+
+			By("Rejecting snapshots of a filesystem which is not ready")
+			_, err = fsServer.CreateSnapshot(ctx, &pb.FilesystemSnapshotCreateRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataCreate{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+					Name:           "snap1",
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			By("Marking the filesystem ready")
+			_, err = fsServer.UpdateStatus(ctx, &pb.FilesystemUpdateStatusRequest{
+				Metadata: &pb.FilesystemIdReference{
+					CloudAccountId:  cloudAccountId,
+					ResourceVersion: "1",
+					ResourceId:      fsResp.Metadata.Name,
+				},
+				Status: &pb.FilesystemStatusPrivate{
+					Phase: pb.FilesystemPhase_FSReady,
+				},
+			})
+			Expect(err).To(BeNil())
+
+			By("Creating a named snapshot")
+			snap, err := fsServer.CreateSnapshot(ctx, &pb.FilesystemSnapshotCreateRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataCreate{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+					Name:           "snap1",
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(snap.Metadata.Name).To(Equal("snap1"))
+			Expect(snap.Metadata.FilesystemName).To(Equal("snap-source"))
+			Expect(snap.Status.Phase).To(Equal(pb.FilesystemSnapshotPhase_SnapshotReady))
+
+			By("Rejecting a duplicate snapshot name")
+			_, err = fsServer.CreateSnapshot(ctx, &pb.FilesystemSnapshotCreateRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataCreate{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+					Name:           "snap1",
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+
+			By("Creating a snapshot with a generated name")
+			snap2, err := fsServer.CreateSnapshot(ctx, &pb.FilesystemSnapshotCreateRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataCreate{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(snap2.Metadata.Name).To(HavePrefix("snapshot-"))
+
+			By("Listing snapshots")
+			searchResp, err := fsServer.SearchSnapshots(ctx, &pb.FilesystemSnapshotSearchRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataSearch{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(searchResp.Items).To(HaveLen(2))
+
+			By("Restoring a snapshot to a new filesystem")
+			restored, err := fsServer.RestoreSnapshot(ctx, &pb.FilesystemSnapshotRestoreRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataReference{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+					ResourceId:     snap.Metadata.ResourceId,
+				},
+				Name: "snap-restored",
+			})
+			Expect(err).To(BeNil())
+			Expect(restored.Metadata.Name).To(Equal("snap-restored"))
+			Expect(restored.Spec.Request.Storage).To(Equal("1TB"))
+
+			By("Clearing the restore in progress from the snapshot")
+			searchResp, err = fsServer.SearchSnapshots(ctx, &pb.FilesystemSnapshotSearchRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataSearch{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+				},
+			})
+			Expect(err).To(BeNil())
+			for _, item := range searchResp.Items {
+				Expect(item.Status.Phase).To(Equal(pb.FilesystemSnapshotPhase_SnapshotReady))
+				Expect(item.Status.Message).To(BeEmpty())
+			}
+
+			By("Rejecting a restore into an existing filesystem name")
+			_, err = fsServer.RestoreSnapshot(ctx, &pb.FilesystemSnapshotRestoreRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataReference{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+					ResourceId:     snap.Metadata.ResourceId,
+				},
+				Name: "snap-source",
+			})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+
+			By("Deleting a snapshot")
+			_, err = fsServer.DeleteSnapshot(ctx, &pb.FilesystemSnapshotDeleteRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataReference{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+					ResourceId:     snap.Metadata.ResourceId,
+				},
+			})
+			Expect(err).To(BeNil())
+
+			searchResp, err = fsServer.SearchSnapshots(ctx, &pb.FilesystemSnapshotSearchRequest{
+				Metadata: &pb.FilesystemSnapshotMetadataSearch{
+					CloudAccountId: cloudAccountId,
+					FilesystemId:   filesystemId,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(searchResp.Items).To(HaveLen(1))
+		})
+	})
+	Context("Snapshot policy", func() {
+		It("Should validate and store snapshot policies", func() {
+			cloudAccountId := "123456789078"
+
+			fsResp, err := fsServer.Create(ctx, &pb.FilesystemCreateRequest{
+				Metadata: &pb.FilesystemMetadataCreate{
+					CloudAccountId: cloudAccountId,
+					Name:           "snap-policy",
+				},
+				Spec: &pb.FilesystemSpec{
+					AvailabilityZone: "az1",
+					Request: &pb.FilesystemCapacity{
+						Storage: "1TB",
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+			policyRef := &pb.FilesystemSnapshotPolicyMetadataReference{
+				CloudAccountId: cloudAccountId,
+				FilesystemId:   fsResp.Metadata.ResourceId,
+			}
+
+			By("Returning not found when no policy is set")
+			_, err = fsServer.GetSnapshotPolicy(ctx, &pb.FilesystemSnapshotPolicyGetRequest{Metadata: policyRef})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+			By("Rejecting an invalid retention count")
+			_, err = fsServer.UpdateSnapshotPolicy(ctx, &pb.FilesystemSnapshotPolicyUpdateRequest{
+				Metadata: policyRef,
+				Spec: &pb.FilesystemSnapshotPolicySpec{
+					Enabled:        true,
+					IntervalHours:  24,
+					RetentionCount: 0,
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			By("Storing a policy")
+			policy, err := fsServer.UpdateSnapshotPolicy(ctx, &pb.FilesystemSnapshotPolicyUpdateRequest{
+				Metadata: policyRef,
+				Spec: &pb.FilesystemSnapshotPolicySpec{
+					Enabled:        true,
+					IntervalHours:  24,
+					RetentionCount: 7,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(policy.Spec.RetentionCount).To(Equal(int32(7)))
+
+			policy, err = fsServer.GetSnapshotPolicy(ctx, &pb.FilesystemSnapshotPolicyGetRequest{Metadata: policyRef})
+			Expect(err).To(BeNil())
+			Expect(policy.Spec.IntervalHours).To(Equal(int32(24)))
+
+			By("Deleting the policy")
+			_, err = fsServer.DeleteSnapshotPolicy(ctx, &pb.FilesystemSnapshotPolicyDeleteRequest{Metadata: policyRef})
+			Expect(err).To(BeNil())
+			_, err = fsServer.GetSnapshotPolicy(ctx, &pb.FilesystemSnapshotPolicyGetRequest{Metadata: policyRef})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
@@ -191,6 +191,26 @@ var _ = Describe("FilesystemServiceServer", func() {
 
 		})
 
+		It("should fail to create a filesystem over the size quota", func() {
+			req := &pb.FilesystemCreateRequest{
+				Metadata: &pb.FilesystemMetadataCreate{
+					CloudAccountId: "123456789012",
+					Name:           "fs1-test-size-quota",
+				},
+				Spec: &pb.FilesystemSpec{
+					AvailabilityZone: "az1",
+					Request: &pb.FilesystemCapacity{
+						Storage: "60TB",
+					},
+				},
+			}
+
+			// The size is reserved in GB against the quota of 50TB
+			resp, err := fsServer.Create(ctx, req)
+			Expect(err).To(HaveOccurred())
+			Expect(resp).To(BeNil())
+		})
+
 		It("should return an error for repeated input", func() {
 
 			meta := &pb.FilesystemMetadataCreate{
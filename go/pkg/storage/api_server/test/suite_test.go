@@ -27,7 +27,10 @@ import (
 	db "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/database"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -132,10 +135,13 @@ var _ = BeforeSuite(func() {
 	//set up weka agent client
 	wekaClient := NewMockWekaStatefuleAgentPrivateServiceClient()
 
+	//set up filesystem snapshot client
+	snapshotClient := NewMockFilesystemSnapshotServiceClient()
+
 	//Initialize filesystemservice with sqlDb, cloudClient and quota
-	fsServer, err = server.NewFilesystemService(ctx, sqlDb, cloudAccountClient, productClient, authzClient, schedularClient, kms, qService, userClient, wekaClient, cfg)
-	fsServer2, err = server.NewFilesystemService(ctx, sqlDb, cloudAccountClient, productClient, authzClient, schedularClient, kms, qService, userClient, wekaClient, cfg)
-	fsServer3, err = server.NewFilesystemService(ctx, sqlDb, cloudAccountClient, productClient, authzClient, schedularClient, kms, qService, userClient, wekaClient, vastCfg)
+	fsServer, err = server.NewFilesystemService(ctx, sqlDb, cloudAccountClient, productClient, authzClient, schedularClient, kms, qService, userClient, wekaClient, snapshotClient, cfg)
+	fsServer2, err = server.NewFilesystemService(ctx, sqlDb, cloudAccountClient, productClient, authzClient, schedularClient, kms, qService, userClient, wekaClient, snapshotClient, cfg)
+	fsServer3, err = server.NewFilesystemService(ctx, sqlDb, cloudAccountClient, productClient, authzClient, schedularClient, kms, qService, userClient, wekaClient, snapshotClient, vastCfg)
 
 	Expect(err).Should(Succeed())
 	Expect(fsServer).ShouldNot(BeNil())
@@ -463,6 +469,19 @@ func NewMockWekaStatefuleAgentPrivateServiceClient() pb.WekaStatefulAgentPrivate
 	return wekaClient
 }
 
+func NewMockFilesystemSnapshotServiceClient() pb.FilesystemSnapshotPrivateServiceClient {
+	mockController := gomock.NewController(GinkgoT())
+	snapshotClient := pb.NewMockFilesystemSnapshotPrivateServiceClient(mockController)
+	snapshotClient.EXPECT().CreateSnapshotPrivate(gomock.Any(), gomock.Any()).Return(&pb.FilesystemSnapshotBackend{
+		UsedBytes: 4096,
+	}, nil).AnyTimes()
+	snapshotClient.EXPECT().DeleteSnapshotPrivate(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
+	snapshotClient.EXPECT().RestoreSnapshotPrivate(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
+	snapshotClient.EXPECT().DeleteRestoredFilesystemPrivate(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
+	snapshotClient.EXPECT().ListSnapshotsPrivate(gomock.Any(), gomock.Any()).Return(&pb.FilesystemSnapshotBackendListResponse{}, nil).AnyTimes()
+	return snapshotClient
+}
+
 func NewMockQuotaManagementPrivateServiceClient() pb.QuotaManagementPrivateServiceClient {
 	mockController := gomock.NewController(GinkgoT())
 	client := pb.NewMockQuotaManagementPrivateServiceClient(mockController)
@@ -483,6 +502,21 @@ func NewMockQuotaManagementPrivateServiceClient() pb.QuotaManagementPrivateServi
 			ServiceResources: ServiceResources,
 		},
 	}, nil).AnyTimes()
+	// Reservations are checked against the current usage given by the storage api server, like the first reservation
+	// of a resource in the usage ledger of QMS.
+	client.EXPECT().ReserveQuotaPrivate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *pb.QuotaReservationRequestPrivate, opts ...grpc.CallOption) (*pb.QuotaReservationPrivate, error) {
+			if req.CurrentUsage+req.Quantity > 50*max(req.LimitsScale, 1) {
+				return nil, status.Errorf(codes.ResourceExhausted, "quota limit of %d %s exceeded", 50, req.ResourceType)
+			}
+			return &pb.QuotaReservationPrivate{
+				ReservationId: "reservation-id",
+				Limits:        50,
+			}, nil
+		}).AnyTimes()
+	client.EXPECT().CommitQuotaReservationPrivate(gomock.Any(), gomock.Any()).Return(&pb.QuotaReservationPrivate{}, nil).AnyTimes()
+	client.EXPECT().ReleaseQuotaPrivate(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
+	client.EXPECT().ReconcileQuotaUsagePrivate(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
 
 	return client
 }
@@ -0,0 +1,41 @@
+--------------------------------------------------------------------------------
+-- filesystem snapshot
+--------------------------------------------------------------------------------
+
+create sequence filesystem_snapshot_resource_version_seq minvalue 1;
+
+create table filesystem_snapshot (
+    resource_id uuid primary key,
+    cloud_account_id varchar(12) not null,
+    -- filesystem the snapshot was taken of
+    filesystem_id uuid not null,
+    name varchar(63) not null,
+    -- snapshot created by the snapshot policy
+    scheduled boolean not null default false,
+    created_timestamp timestamp not null default now(),
+    -- infinity means not deleted; set to 'now' when logically deleted
+    deleted_timestamp timestamp not null default ('infinity'),
+    -- provides the ordering of inserts and updates
+    resource_version bigint not null default nextval('filesystem_snapshot_resource_version_seq'),
+    -- Protobuf FilesystemSnapshotPrivate message serialized as JSON.
+    value jsonb not null
+);
+
+-- Unique index prevents a non-deleted snapshot with the same name of the same filesystem.
+create unique index filesystem_snapshot_idx on filesystem_snapshot (cloud_account_id, filesystem_id, name, deleted_timestamp);
+
+-- Index optimizes the metering query.
+create index filesystem_snapshot_deleted_timestamp_idx on filesystem_snapshot (deleted_timestamp);
+
+--------------------------------------------------------------------------------
+-- filesystem snapshot policy
+--------------------------------------------------------------------------------
+
+create table filesystem_snapshot_policy (
+    filesystem_id uuid primary key,
+    cloud_account_id varchar(12) not null,
+    -- Protobuf FilesystemSnapshotPolicy message serialized as JSON.
+    value jsonb not null
+);
+
+create index filesystem_snapshot_policy_idx on filesystem_snapshot_policy (cloud_account_id);
@@ -10,6 +10,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -45,6 +46,17 @@ const (
 		and deleted_timestamp = $2
 	`
 
+	// Formatted with the protodb.Pagination where, order by and limit expressions.
+	searchBucketsByCloudAccount = `
+		select resource_id, name, value
+		from bucket
+		where cloud_account_id = $1
+		and deleted_timestamp = $2
+		and %s
+		%s
+		%s
+	`
+
 	updateBucketForDeletion = `
 		update bucket
 		set    resource_version = nextval('bucket_resource_version_seq'),
@@ -215,6 +227,62 @@ func GetBucketsByCloudaccountId(ctx context.Context, tx *sql.Tx, cloudaccountId,
 	return resp, nil
 }
 
+// SearchBucketsByCloudaccountId returns one page of buckets, ordered by pagination.Columns,
+// and the page token for the next page.
+func SearchBucketsByCloudaccountId(ctx context.Context, tx *sql.Tx, cloudaccountId, deletionTs string,
+	pagination *protodb.Pagination) ([]*pb.ObjectBucketPrivate, string, error) {
+	logger := log.FromContext(ctx).WithName("SearchBucketsByCloudaccountId")
+	logger.Info("begin bucket record search", logkeys.CloudAccountId, cloudaccountId)
+
+	query := fmt.Sprintf(searchBucketsByCloudAccount,
+		pagination.GetWhereString(3), pagination.GetOrderByString(), pagination.GetLimitString())
+	args := append([]any{cloudaccountId, deletionTs}, pagination.GetValues()...)
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error(err, "error searching bucket record in db")
+		return nil, "", status.Errorf(codes.Internal, "bucket record search failed")
+	}
+	defer rows.Close()
+
+	type bucketRow struct {
+		resourceId    string
+		name          string
+		bucketPrivate *pb.ObjectBucketPrivate
+	}
+	bucketRows := []bucketRow{}
+	for rows.Next() {
+		dataBuf := []byte{}
+		row := bucketRow{bucketPrivate: &pb.ObjectBucketPrivate{}}
+		if err := rows.Scan(&row.resourceId, &row.name, &dataBuf); err != nil {
+			logger.Error(err, "error reading result row")
+			return nil, "", status.Errorf(codes.Internal, "bucket record search failed")
+		}
+		if err := json.Unmarshal(dataBuf, row.bucketPrivate); err != nil {
+			logger.Error(err, "Error Unmarshalling JSON")
+			return nil, "", status.Errorf(codes.Internal, "bucket record search failed")
+		}
+		bucketRows = append(bucketRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error(err, "error reading result rows")
+		return nil, "", status.Errorf(codes.Internal, "bucket record search failed")
+	}
+
+	bucketRows, nextPageToken, err := protodb.NextPage(pagination, bucketRows, func(row bucketRow) []string {
+		return []string{row.name, row.resourceId}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp := []*pb.ObjectBucketPrivate{}
+	for _, row := range bucketRows {
+		resp = append(resp, row.bucketPrivate)
+	}
+	logger.Info("bucket record search completed successfully", logkeys.CloudAccountId, cloudaccountId)
+	return resp, nextPageToken, nil
+}
+
 func UpdateBucketForDeletion(ctx context.Context, tx *sql.Tx, in *pb.ObjectBucketMetadataRef) (int64, error) {
 	logger := log.FromContext(ctx).WithName("UpdateBucketForDeletion")
 	logger.Info("begin bucket record update for deletion", logkeys.CloudAccountId, in.CloudAccountId, logkeys.NameOrId, in.NameOrId)
@@ -12,6 +12,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -39,6 +40,19 @@ const (
 		and deleted_timestamp = $2 FOR UPDATE
 	`
 
+	// Formatted with the protodb.Pagination where, order by and limit expressions.
+	// A filesystem type of 0 (Unspecified) matches all filesystems.
+	searchFilesystemsByCloudAccount = `
+		select resource_id, name, value
+		from filesystem
+		where cloud_account_id = $1
+		and deleted_timestamp = $2
+		and ($3 = 0 or coalesce((value->'spec'->>'filesystemType')::int, 0) = $3)
+		and %s
+		%s
+		%s
+	`
+
 	getFilesystemByName = `
 		select value
 		from filesystem
@@ -173,6 +187,63 @@ func GetFilesystemsByCloudaccountId(ctx context.Context, tx *sql.Tx, cloudaccoun
 	return resp, nil
 }
 
+// SearchFilesystemsByCloudaccountId returns one page of filesystems that match fsTypeFilter, ordered by pagination.Columns,
+// and the page token for the next page.
+func SearchFilesystemsByCloudaccountId(ctx context.Context, tx *sql.Tx, cloudaccountId string, fsTypeFilter pb.FilesystemType, deletionTs string,
+	pagination *protodb.Pagination) ([]*pb.FilesystemPrivate, string, error) {
+	logger := log.FromContext(ctx).WithName("SearchFilesystemsByCloudaccountId")
+	logger.Info("begin filesystem record search", logkeys.CloudAccountId, cloudaccountId, logkeys.FilesystemTypeFilter, fsTypeFilter)
+
+	query := fmt.Sprintf(searchFilesystemsByCloudAccount,
+		pagination.GetWhereString(4), pagination.GetOrderByString(), pagination.GetLimitString())
+	args := append([]any{cloudaccountId, deletionTs, int32(fsTypeFilter)}, pagination.GetValues()...)
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error(err, "error searching filesystem record in db")
+		return nil, "", status.Errorf(codes.Internal, "filesystem record search failed")
+	}
+	defer rows.Close()
+
+	type filesystemRow struct {
+		resourceId string
+		name       string
+		fsPrivate  *pb.FilesystemPrivate
+	}
+	fsRows := []filesystemRow{}
+	for rows.Next() {
+		dataBuf := []byte{}
+		row := filesystemRow{fsPrivate: &pb.FilesystemPrivate{}}
+		if err := rows.Scan(&row.resourceId, &row.name, &dataBuf); err != nil {
+			logger.Error(err, "error reading result row")
+			return nil, "", status.Errorf(codes.Internal, "filesystem record search failed")
+		}
+		if err := json.Unmarshal(dataBuf, row.fsPrivate); err != nil {
+			logger.Error(err, "Error Unmarshalling JSON")
+			return nil, "", status.Errorf(codes.Internal, "filesystem record search failed")
+		}
+		fsRows = append(fsRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error(err, "error reading result rows")
+		return nil, "", status.Errorf(codes.Internal, "filesystem record search failed")
+	}
+
+	// The page token must be based on the last row read, not the last row returned.
+	fsRows, nextPageToken, err := protodb.NextPage(pagination, fsRows, func(row filesystemRow) []string {
+		return []string{row.name, row.resourceId}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp := []*pb.FilesystemPrivate{}
+	for _, row := range fsRows {
+		resp = append(resp, row.fsPrivate)
+	}
+	logger.Info("filesystem record search completed successfully", logkeys.CloudAccountId, cloudaccountId)
+	return resp, nextPageToken, nil
+}
+
 func GetFilesystemByResourceId(ctx context.Context, tx *sql.Tx, cloudaccountId, resourceId, deletionTs string) (*pb.FilesystemPrivate, error) {
 	logger := log.FromContext(ctx).WithName("GetFilesystemByResourceId")
 	logger.Info("begin filesystem record retrieve", logkeys.CloudAccountId, cloudaccountId, logkeys.ResourceId, resourceId)
@@ -0,0 +1,402 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	insertFilesystemSnapshotQuery = `
+		insert into filesystem_snapshot
+			(resource_id, cloud_account_id, filesystem_id, name, scheduled, created_timestamp, value)
+		values ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	updateFilesystemSnapshotQuery = `
+		update filesystem_snapshot
+		set    resource_version = nextval('filesystem_snapshot_resource_version_seq'),
+		value = $1
+		where resource_id = $2
+		and deleted_timestamp = $3
+	`
+
+	getFilesystemSnapshotById = `
+		select value
+		from filesystem_snapshot
+		where cloud_account_id = $1
+		and filesystem_id = $2
+		and resource_id = $3
+		and deleted_timestamp = $4
+	`
+
+	getFilesystemSnapshotsByFilesystemId = `
+		select value
+		from filesystem_snapshot
+		where cloud_account_id = $1
+		and filesystem_id = $2
+		and deleted_timestamp = $3
+		order by created_timestamp, name
+	`
+
+	getFilesystemSnapshotsForMetering = `
+		select value
+		from filesystem_snapshot
+		where deleted_timestamp > $1
+	`
+
+	updateFilesystemSnapshotForDeletion = `
+		update filesystem_snapshot
+		set    resource_version = nextval('filesystem_snapshot_resource_version_seq'),
+		value = $1,
+		deleted_timestamp = $2
+		where resource_id = $3
+		and deleted_timestamp = $4
+	`
+
+	updateFilesystemSnapshotsForFilesystemDeletion = `
+		update filesystem_snapshot
+		set    resource_version = nextval('filesystem_snapshot_resource_version_seq'),
+		value = jsonb_set(value, '{metadata,deletionTimestamp}', $1::jsonb, true),
+		deleted_timestamp = $2
+		where cloud_account_id = $3
+		and filesystem_id = $4
+		and deleted_timestamp = $5
+	`
+
+	upsertFilesystemSnapshotPolicyQuery = `
+		insert into filesystem_snapshot_policy
+			(filesystem_id, cloud_account_id, value)
+		values ($1, $2, $3)
+		on conflict (filesystem_id) do update
+		set value = excluded.value
+	`
+
+	getFilesystemSnapshotPolicy = `
+		select value
+		from filesystem_snapshot_policy
+		where cloud_account_id = $1
+		and filesystem_id = $2
+	`
+
+	getEnabledFilesystemSnapshotPolicies = `
+		select value
+		from filesystem_snapshot_policy
+		where value->'spec'->>'enabled' = 'true'
+	`
+
+	deleteFilesystemSnapshotPolicyQuery = `
+		delete from filesystem_snapshot_policy
+		where cloud_account_id = $1
+		and filesystem_id = $2
+	`
+)
+
+func StoreFilesystemSnapshot(ctx context.Context, tx *sql.Tx, snapshot *pb.FilesystemSnapshotPrivate) error {
+	logger := log.FromContext(ctx).WithName("StoreFilesystemSnapshot").
+		WithValues(logkeys.CloudAccountId, snapshot.Metadata.CloudAccountId, logkeys.FilesystemId, snapshot.Metadata.FilesystemId,
+			logkeys.SnapshotName, snapshot.Metadata.Name)
+
+	logger.Info("begin filesystem snapshot record insertion")
+
+	jsonVal, err := json.MarshalIndent(snapshot, "", "    ")
+	if err != nil {
+		return fmt.Errorf("json marshaling: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		insertFilesystemSnapshotQuery,
+		snapshot.Metadata.ResourceId,
+		snapshot.Metadata.CloudAccountId,
+		snapshot.Metadata.FilesystemId,
+		snapshot.Metadata.Name,
+		snapshot.Metadata.Scheduled,
+		snapshot.Metadata.CreationTimestamp.AsTime(),
+		string(jsonVal))
+	if err != nil {
+		return err
+	}
+	logger.Info("filesystem snapshot record stored successfully")
+
+	return nil
+}
+
+func UpdateFilesystemSnapshot(ctx context.Context, tx *sql.Tx, snapshot *pb.FilesystemSnapshotPrivate) error {
+	logger := log.FromContext(ctx).WithName("UpdateFilesystemSnapshot").
+		WithValues(logkeys.CloudAccountId, snapshot.Metadata.CloudAccountId, logkeys.SnapshotId, snapshot.Metadata.ResourceId)
+
+	jsonVal, err := json.MarshalIndent(snapshot, "", "    ")
+	if err != nil {
+		return fmt.Errorf("json marshaling: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx,
+		updateFilesystemSnapshotQuery,
+		string(jsonVal),
+		snapshot.Metadata.ResourceId,
+		timestampInfinityStr,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < 1 {
+		logger.Info("snapshot record not updated", logkeys.NumAffectedRows, rowsAffected)
+		return status.Error(codes.NotFound, "no matching records found")
+	}
+	return nil
+}
+
+func GetFilesystemSnapshotById(ctx context.Context, tx *sql.Tx, cloudaccountId, filesystemId, resourceId string) (*pb.FilesystemSnapshotPrivate, error) {
+	logger := log.FromContext(ctx).WithName("GetFilesystemSnapshotById").
+		WithValues(logkeys.CloudAccountId, cloudaccountId, logkeys.FilesystemId, filesystemId, logkeys.SnapshotId, resourceId)
+
+	dataBuf := []byte{}
+	snapshot := pb.FilesystemSnapshotPrivate{}
+	row := tx.QueryRowContext(ctx, getFilesystemSnapshotById, cloudaccountId, filesystemId, resourceId, timestampInfinityStr)
+	switch err := row.Scan(&dataBuf); err {
+	case sql.ErrNoRows:
+		logger.Info("no records found")
+		return nil, status.Errorf(codes.NotFound, "no matching records found")
+	case nil:
+		if err := json.Unmarshal(dataBuf, &snapshot); err != nil {
+			logger.Error(err, "Error Unmarshalling JSON")
+			return nil, status.Errorf(codes.Internal, "filesystem snapshot record search failed")
+		}
+	default:
+		logger.Error(err, "error searching filesystem snapshot record in db")
+		return nil, status.Errorf(codes.Internal, "filesystem snapshot record find failed")
+	}
+
+	return &snapshot, nil
+}
+
+// Returns snapshots of the filesystem, the oldest first
+func GetFilesystemSnapshotsByFilesystemId(ctx context.Context, tx *sql.Tx, cloudaccountId, filesystemId string) ([]*pb.FilesystemSnapshotPrivate, error) {
+	logger := log.FromContext(ctx).WithName("GetFilesystemSnapshotsByFilesystemId").
+		WithValues(logkeys.CloudAccountId, cloudaccountId, logkeys.FilesystemId, filesystemId)
+
+	rows, err := tx.QueryContext(ctx, getFilesystemSnapshotsByFilesystemId, cloudaccountId, filesystemId, timestampInfinityStr)
+	if err != nil {
+		logger.Error(err, "error searching filesystem snapshot records in db")
+		return nil, status.Errorf(codes.Internal, "filesystem snapshot record search failed")
+	}
+	defer rows.Close()
+
+	return readFilesystemSnapshotRows(ctx, rows)
+}
+
+// Returns snapshots which are not deleted or were deleted after the given time
+func GetFilesystemSnapshotsForMetering(ctx context.Context, tx *sql.Tx, deletedAfter time.Time) ([]*pb.FilesystemSnapshotPrivate, error) {
+	logger := log.FromContext(ctx).WithName("GetFilesystemSnapshotsForMetering")
+
+	rows, err := tx.QueryContext(ctx, getFilesystemSnapshotsForMetering, deletedAfter)
+	if err != nil {
+		logger.Error(err, "error searching filesystem snapshot records in db")
+		return nil, status.Errorf(codes.Internal, "filesystem snapshot record search failed")
+	}
+	defer rows.Close()
+
+	return readFilesystemSnapshotRows(ctx, rows)
+}
+
+func readFilesystemSnapshotRows(ctx context.Context, rows *sql.Rows) ([]*pb.FilesystemSnapshotPrivate, error) {
+	logger := log.FromContext(ctx).WithName("readFilesystemSnapshotRows")
+
+	resp := []*pb.FilesystemSnapshotPrivate{}
+	for rows.Next() {
+		dataBuf := []byte{}
+		snapshot := pb.FilesystemSnapshotPrivate{}
+		if err := rows.Scan(&dataBuf); err != nil {
+			logger.Error(err, "error reading result row")
+			return nil, status.Errorf(codes.Internal, "filesystem snapshot record search failed")
+		}
+		if err := json.Unmarshal(dataBuf, &snapshot); err != nil {
+			logger.Error(err, "Error Unmarshalling JSON")
+			return nil, status.Errorf(codes.Internal, "filesystem snapshot record search failed")
+		}
+		resp = append(resp, &snapshot)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error(err, "error iterating result rows")
+		return nil, status.Errorf(codes.Internal, "filesystem snapshot record search failed")
+	}
+	return resp, nil
+}
+
+func UpdateFilesystemSnapshotForDeletion(ctx context.Context, tx *sql.Tx, snapshot *pb.FilesystemSnapshotPrivate) error {
+	logger := log.FromContext(ctx).WithName("UpdateFilesystemSnapshotForDeletion").
+		WithValues(logkeys.CloudAccountId, snapshot.Metadata.CloudAccountId, logkeys.SnapshotId, snapshot.Metadata.ResourceId)
+
+	logger.Info("begin filesystem snapshot record update for deletion")
+	deletionTime := time.Now()
+	snapshot.Metadata.DeletionTimestamp = timestamppb.New(deletionTime)
+
+	jsonVal, err := json.MarshalIndent(snapshot, "", "    ")
+	if err != nil {
+		return fmt.Errorf("json marshaling: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx,
+		updateFilesystemSnapshotForDeletion,
+		string(jsonVal),
+		deletionTime,
+		snapshot.Metadata.ResourceId,
+		timestampInfinityStr,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	logger.Info("debug", logkeys.NumAffectedRows, rowsAffected)
+
+	if rowsAffected < 1 {
+		return status.Error(codes.FailedPrecondition, "no records updated; possible update conflict")
+	}
+	return nil
+}
+
+// Marks all snapshots of the filesystem as deleted, returns number of deleted snapshots
+func UpdateFilesystemSnapshotsForFilesystemDeletion(ctx context.Context, tx *sql.Tx, cloudaccountId, filesystemId string) (int64, error) {
+	logger := log.FromContext(ctx).WithName("UpdateFilesystemSnapshotsForFilesystemDeletion").
+		WithValues(logkeys.CloudAccountId, cloudaccountId, logkeys.FilesystemId, filesystemId)
+
+	deletionTime := time.Now()
+	jsonVal, err := json.Marshal(timestamppb.New(deletionTime))
+	if err != nil {
+		return 0, fmt.Errorf("json marshaling: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx,
+		updateFilesystemSnapshotsForFilesystemDeletion,
+		string(jsonVal),
+		deletionTime,
+		cloudaccountId,
+		filesystemId,
+		timestampInfinityStr,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	logger.Info("filesystem snapshot records updated for deletion", logkeys.NumAffectedRows, rowsAffected)
+
+	return rowsAffected, nil
+}
+
+func StoreFilesystemSnapshotPolicy(ctx context.Context, tx *sql.Tx, policy *pb.FilesystemSnapshotPolicy) error {
+	logger := log.FromContext(ctx).WithName("StoreFilesystemSnapshotPolicy").
+		WithValues(logkeys.CloudAccountId, policy.Metadata.CloudAccountId, logkeys.FilesystemId, policy.Metadata.FilesystemId)
+
+	jsonVal, err := json.MarshalIndent(policy, "", "    ")
+	if err != nil {
+		return fmt.Errorf("json marshaling: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		upsertFilesystemSnapshotPolicyQuery,
+		policy.Metadata.FilesystemId,
+		policy.Metadata.CloudAccountId,
+		string(jsonVal))
+	if err != nil {
+		return err
+	}
+	logger.Info("filesystem snapshot policy record stored successfully")
+
+	return nil
+}
+
+func GetFilesystemSnapshotPolicy(ctx context.Context, tx *sql.Tx, cloudaccountId, filesystemId string) (*pb.FilesystemSnapshotPolicy, error) {
+	logger := log.FromContext(ctx).WithName("GetFilesystemSnapshotPolicy").
+		WithValues(logkeys.CloudAccountId, cloudaccountId, logkeys.FilesystemId, filesystemId)
+
+	dataBuf := []byte{}
+	policy := pb.FilesystemSnapshotPolicy{}
+	row := tx.QueryRowContext(ctx, getFilesystemSnapshotPolicy, cloudaccountId, filesystemId)
+	switch err := row.Scan(&dataBuf); err {
+	case sql.ErrNoRows:
+		logger.Info("no records found")
+		return nil, status.Errorf(codes.NotFound, "no matching records found")
+	case nil:
+		if err := json.Unmarshal(dataBuf, &policy); err != nil {
+			logger.Error(err, "Error Unmarshalling JSON")
+			return nil, status.Errorf(codes.Internal, "filesystem snapshot policy record search failed")
+		}
+	default:
+		logger.Error(err, "error searching filesystem snapshot policy record in db")
+		return nil, status.Errorf(codes.Internal, "filesystem snapshot policy record find failed")
+	}
+
+	return &policy, nil
+}
+
+func GetEnabledFilesystemSnapshotPolicies(ctx context.Context, tx *sql.Tx) ([]*pb.FilesystemSnapshotPolicy, error) {
+	logger := log.FromContext(ctx).WithName("GetEnabledFilesystemSnapshotPolicies")
+
+	rows, err := tx.QueryContext(ctx, getEnabledFilesystemSnapshotPolicies)
+	if err != nil {
+		logger.Error(err, "error searching filesystem snapshot policy records in db")
+		return nil, status.Errorf(codes.Internal, "filesystem snapshot policy record search failed")
+	}
+	defer rows.Close()
+
+	resp := []*pb.FilesystemSnapshotPolicy{}
+	for rows.Next() {
+		dataBuf := []byte{}
+		policy := pb.FilesystemSnapshotPolicy{}
+		if err := rows.Scan(&dataBuf); err != nil {
+			logger.Error(err, "error reading result row")
+			return nil, status.Errorf(codes.Internal, "filesystem snapshot policy record search failed")
+		}
+		if err := json.Unmarshal(dataBuf, &policy); err != nil {
+			logger.Error(err, "Error Unmarshalling JSON")
+			return nil, status.Errorf(codes.Internal, "filesystem snapshot policy record search failed")
+		}
+		resp = append(resp, &policy)
+	}
+	return resp, nil
+}
+
+func DeleteFilesystemSnapshotPolicy(ctx context.Context, tx *sql.Tx, cloudaccountId, filesystemId string) error {
+	logger := log.FromContext(ctx).WithName("DeleteFilesystemSnapshotPolicy").
+		WithValues(logkeys.CloudAccountId, cloudaccountId, logkeys.FilesystemId, filesystemId)
+
+	result, err := tx.ExecContext(ctx, deleteFilesystemSnapshotPolicyQuery, cloudaccountId, filesystemId)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	logger.Info("filesystem snapshot policy record deleted", logkeys.NumAffectedRows, rowsAffected)
+
+	if rowsAffected < 1 {
+		return status.Errorf(codes.NotFound, "no matching records found")
+	}
+	return nil
+}
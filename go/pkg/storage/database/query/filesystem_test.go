@@ -3,11 +3,13 @@ package query
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/protodb"
 	db "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/database"
 
 	//"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/database/query"
@@ -108,6 +110,60 @@ var _ = Describe("Query", func() {
 		})
 	})
 
+	Context("Testing SearchFilesystemsByCloudaccountId", func() {
+		It("Should return full pages of filesystems of the requested type", func() {
+			tx, err := sqlDb.Begin()
+			Expect(err).Should(Succeed())
+			defer tx.Rollback()
+
+			cloudAccountId := "210987654321"
+			fsTypes := []pb.FilesystemType{
+				pb.FilesystemType_ComputeKubernetes,
+				pb.FilesystemType_ComputeGeneral,
+				pb.FilesystemType_ComputeKubernetes,
+				pb.FilesystemType_ComputeGeneral,
+			}
+			for i, fsType := range fsTypes {
+				fs := &pb.FilesystemPrivate{
+					Metadata: &pb.FilesystemMetadataPrivate{
+						CloudAccountId: cloudAccountId,
+						Name:           fmt.Sprintf("fs%d", i),
+						ResourceId:     fmt.Sprintf("6787226a-2a55-4d6f-bae9-fa2a2ca2450%d", i),
+					},
+					Spec: &pb.FilesystemSpecPrivate{
+						FilesystemType: fsType,
+					},
+				}
+				Expect(StoreFilesystemRequest(ctx, tx, fs)).Should(Succeed())
+			}
+
+			var names []string
+			pageToken := ""
+			for {
+				pagination, err := protodb.NewPagination(1, pageToken, "name", "resource_id")
+				Expect(err).Should(Succeed())
+				items, nextPageToken, err := SearchFilesystemsByCloudaccountId(ctx, tx, cloudAccountId, pb.FilesystemType_ComputeGeneral, timestampInfinityStr, pagination)
+				Expect(err).Should(Succeed())
+				if nextPageToken != "" {
+					Expect(items).Should(HaveLen(1))
+				}
+				for _, item := range items {
+					Expect(item.Spec.FilesystemType).Should(Equal(pb.FilesystemType_ComputeGeneral))
+					names = append(names, item.Metadata.Name)
+				}
+				if nextPageToken == "" {
+					break
+				}
+				pageToken = nextPageToken
+			}
+			Expect(names).Should(Equal([]string{"fs1", "fs3"}))
+
+			items, _, err := SearchFilesystemsByCloudaccountId(ctx, tx, cloudAccountId, pb.FilesystemType_Unspecified, timestampInfinityStr, nil)
+			Expect(err).Should(Succeed())
+			Expect(items).Should(HaveLen(len(fsTypes)))
+		})
+	})
+
 	Context("Testing mapEventSqlToPb", func() {
 		It("Should succeed", func() {
 			rv := mapEventSqlToPb(AddingEventType)
@@ -146,6 +146,21 @@ func main() {
 			return fmt.Errorf("error creating metering monitor: %w", err)
 		}
 
+		if cfg.StorageAPIServerAddr != "" {
+			storageClientConn, err := grpcutil.NewClient(ctx, cfg.StorageAPIServerAddr,
+				grpc.WithTransportCredentials(creds),
+				grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+				grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()))
+			if err != nil {
+				return err
+			}
+			filesystemClient := pb.NewFilesystemPrivateServiceClient(storageClientConn)
+			_, err = metering_monitor.NewSnapshotMeteringMonitor(ctx, k8sManager, filesystemClient, meteringClient, cfg)
+			if err != nil {
+				return fmt.Errorf("error creating snapshot metering monitor: %w", err)
+			}
+		}
+
 		log.Info("Starting Manager")
 		if err := k8sManager.Start(ctrl.SetupSignalHandler()); err != nil {
 			return fmt.Errorf("problem running manager: %w", err)
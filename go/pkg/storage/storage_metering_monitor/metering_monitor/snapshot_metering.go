@@ -0,0 +1,169 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package metering_monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	cloudv1alpha1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/k8s/apis/private.cloud/v1alpha1"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	util "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SnapshotMeteringMonitor periodically reports the capacity consumed by filesystem snapshots.
+// Snapshots are not kubernetes resources, they are read from the storage api server.
+type SnapshotMeteringMonitor struct {
+	FilesystemClient         pb.FilesystemPrivateServiceClient
+	MeteringClient           pb.MeteringServiceClient
+	UsageRecordSendInterval  time.Duration
+	ServiceType              string
+	Region                   string
+	lastUsageRecordTimestamp time.Time
+}
+
+func NewSnapshotMeteringMonitor(ctx context.Context, mgr ctrl.Manager, fsClient pb.FilesystemPrivateServiceClient,
+	meteringClient pb.MeteringServiceClient, cfg *cloudv1alpha1.StorageMeteringMonitorConfig) (*SnapshotMeteringMonitor, error) {
+	if cfg.MaxUsageRecordSendIntervalMinutes <= 0 {
+		return nil, fmt.Errorf("maxUsageRecordSendIntervalMinutes must be positive")
+	}
+	m := &SnapshotMeteringMonitor{
+		FilesystemClient:         fsClient,
+		MeteringClient:           meteringClient,
+		UsageRecordSendInterval:  time.Duration(cfg.MaxUsageRecordSendIntervalMinutes) * time.Minute,
+		ServiceType:              cfg.SnapshotServiceType,
+		Region:                   cfg.Region,
+		lastUsageRecordTimestamp: time.Now(),
+	}
+	if err := mgr.Add(m); err != nil {
+		return nil, fmt.Errorf("unable to add snapshot metering monitor: %w", err)
+	}
+	return m, nil
+}
+
+// Start implements manager.Runnable.
+func (m *SnapshotMeteringMonitor) Start(ctx context.Context) error {
+	log := log.FromContext(ctx).WithName("SnapshotMeteringMonitor.Start")
+	log.Info("starting snapshot metering monitor", "interval", m.UsageRecordSendInterval)
+
+	ticker := time.NewTicker(m.UsageRecordSendInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := m.ReportSnapshotUsage(ctx); err != nil {
+				log.Error(err, "error reporting snapshot usage")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, only the leader reports usage.
+func (m *SnapshotMeteringMonitor) NeedLeaderElection() bool {
+	return true
+}
+
+// ReportSnapshotUsage creates a usage record for every snapshot which existed since the previous report.
+func (m *SnapshotMeteringMonitor) ReportSnapshotUsage(ctx context.Context) error {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SnapshotMeteringMonitor.ReportSnapshotUsage").Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+
+	now := time.Now()
+	stream, err := m.FilesystemClient.SearchSnapshotsPrivate(ctx, &pb.FilesystemSnapshotSearchStreamPrivateRequest{
+		DeletedAfter: timestamppb.New(m.lastUsageRecordTimestamp),
+	})
+	if err != nil {
+		return fmt.Errorf("error searching snapshots: %w", err)
+	}
+
+	for {
+		snapshot, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error receiving snapshot: %w", err)
+		}
+		if err := m.CreateSnapshotRecord(ctx, snapshot, now); err != nil {
+			log.Error(err, "error creating snapshot metering record", logkeys.SnapshotId, snapshot.Metadata.ResourceId)
+		}
+	}
+
+	m.lastUsageRecordTimestamp = now
+	return nil
+}
+
+func (m *SnapshotMeteringMonitor) CreateSnapshotRecord(ctx context.Context, snapshot *pb.FilesystemSnapshotPrivate, now time.Time) error {
+	log := log.FromContext(ctx).WithName("SnapshotMeteringMonitor.CreateSnapshotRecord")
+
+	meteringRecord := m.snapshotUsageRecord(snapshot, now)
+	if meteringRecord == nil {
+		return nil
+	}
+	if _, err := m.MeteringClient.Create(ctx, meteringRecord); err != nil {
+		return err
+	}
+
+	log.Info("Created metering record", logkeys.MeteringRecord, meteringRecord)
+	return nil
+}
+
+// Returns nil when the snapshot has no billable lifetime.
+func (m *SnapshotMeteringMonitor) snapshotUsageRecord(snapshot *pb.FilesystemSnapshotPrivate, now time.Time) *pb.UsageCreate {
+	isDeleted := snapshot.Metadata.DeletionTimestamp != nil
+	endTime := now
+	if isDeleted {
+		endTime = snapshot.Metadata.DeletionTimestamp.AsTime()
+	}
+	lifetime := endTime.Sub(snapshot.Metadata.CreationTimestamp.AsTime())
+	if lifetime <= 0 {
+		return nil
+	}
+	lifetimeInHours := "1" // lifetime minimum 1 hour
+	if lifetime.Hours() > 1 {
+		lifetimeInHours = fmt.Sprintf("%v", lifetime.Hours())
+	}
+
+	service := m.ServiceType
+	if snapshot.StorageClass == pb.FilesystemStorageClass_AIOptimized {
+		service = service + suffix
+	}
+
+	var usedBytes uint64
+	if snapshot.Status != nil {
+		usedBytes = snapshot.Status.UsedBytes
+	}
+
+	// Since these usage records use cumulative counters, deduplication is not needed. It is effectively disabled by using a random transactionId.
+	return &pb.UsageCreate{
+		TransactionId:  uuid.NewString(),
+		ResourceId:     snapshot.Metadata.ResourceId,
+		CloudAccountId: snapshot.Metadata.CloudAccountId,
+		Timestamp:      timestamppb.New(now),
+		Properties: map[string]string{
+			"availabilityZone":  snapshot.AvailabilityZone,
+			"filesystemName":    snapshot.Metadata.FilesystemName,
+			"snapshotName":      snapshot.Metadata.Name,
+			"creationTimestamp": snapshot.Metadata.CreationTimestamp.AsTime().Format(time.RFC3339),
+			"deleted":           strconv.FormatBool(isDeleted),
+			"serviceType":       service,
+			"hour":              lifetimeInHours,
+			"TB":                strconv.FormatFloat(util.BytesToTerabytes(int64(usedBytes)), 'f', 3, 64),
+			"region":            m.Region,
+		},
+	}
+}
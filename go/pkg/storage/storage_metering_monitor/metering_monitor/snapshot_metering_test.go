@@ -0,0 +1,63 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package metering_monitor
+
+import (
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Snapshot Metering Monitor", func() {
+	now := time.Now()
+	snapshotMonitor := &SnapshotMeteringMonitor{
+		ServiceType: "FileStorageSnapshot",
+		Region:      "test",
+	}
+	newSnapshot := func(age time.Duration) *pb.FilesystemSnapshotPrivate {
+		return &pb.FilesystemSnapshotPrivate{
+			Metadata: &pb.FilesystemSnapshotMetadata{
+				CloudAccountId:    "123456789012",
+				Name:              "snap1",
+				ResourceId:        "8623ccaa-704e-4839-bc72-9a89daa20111",
+				FilesystemName:    "fs1",
+				CreationTimestamp: timestamppb.New(now.Add(-age)),
+			},
+			Status: &pb.FilesystemSnapshotStatus{
+				UsedBytes: 2 * 1024 * 1024 * 1024 * 1024,
+			},
+			StorageClass:     pb.FilesystemStorageClass_AIOptimized,
+			AvailabilityZone: "az1",
+		}
+	}
+
+	It("Should create a usage record for a live snapshot", func() {
+		record := snapshotMonitor.snapshotUsageRecord(newSnapshot(3*time.Hour), now)
+		Expect(record).NotTo(BeNil())
+		Expect(record.CloudAccountId).To(Equal("123456789012"))
+		Expect(record.Properties["serviceType"]).To(Equal("FileStorageSnapshot-SC"))
+		Expect(record.Properties["TB"]).To(Equal("2.000"))
+		Expect(record.Properties["hour"]).To(Equal("3"))
+		Expect(record.Properties["deleted"]).To(Equal("false"))
+	})
+
+	It("Should charge at least an hour", func() {
+		record := snapshotMonitor.snapshotUsageRecord(newSnapshot(time.Minute), now)
+		Expect(record.Properties["hour"]).To(Equal("1"))
+	})
+
+	It("Should stop charging at the deletion time", func() {
+		snapshot := newSnapshot(5 * time.Hour)
+		snapshot.Metadata.DeletionTimestamp = timestamppb.New(now.Add(-3 * time.Hour))
+		record := snapshotMonitor.snapshotUsageRecord(snapshot, now)
+		Expect(record.Properties["hour"]).To(Equal("2"))
+		Expect(record.Properties["deleted"]).To(Equal("true"))
+	})
+
+	It("Should skip snapshots without a billable lifetime", func() {
+		Expect(snapshotMonitor.snapshotUsageRecord(newSnapshot(-time.Hour), now)).To(BeNil())
+	})
+})
@@ -730,7 +730,15 @@ func (r *StorageReconciler) createFileSystem(ctx context.Context, storage *cloud
 		log.Info("filesystem does not exist", logkeys.Error, err)
 	}
 	if found {
+		// filesystem restored from a snapshot is created by the backend, adopt it
 		log.Info("file system with name already exists, skipping creation", logkeys.FilesystemName, storage.Spec.ProviderSchedule.FilesystemName)
+		existing, err := r.StorageControllerClient.GetFilesystem(ctx, fsQuery)
+		if err != nil {
+			log.Error(err, "error reading existing filesystem")
+			return fmt.Errorf("error reading existing filesystem")
+		}
+		storage.Status.Mount.ClusterAddr = existing.Metadata.Backend
+		storage.Status.Size = storage.Spec.StorageRequest.Size
 		return nil
 	}
 
@@ -321,6 +321,37 @@ var _ = Describe("Storage Operator", Serial, func() {
 			err := r.createFileSystem(ctx, storage)
 			Expect(err).To(BeNil())
 		})
+		It("Should adopt the filesystem restored from a snapshot", func() {
+			ctx = context.Background()
+			storage := NewStorage("123456789012", "restored")
+			mockCtrl = gomock.NewController(GinkgoT())
+			fsClient := mocks.NewMockFilesystemServiceClient(mockCtrl)
+			nsClient := mocks.NewMockNamespaceServiceClient(mockCtrl)
+			restored := &weka.Filesystem{
+				Id:      &weka.FilesystemIdentifier{Id: "restored_id"},
+				Name:    storage.Spec.ProviderSchedule.FilesystemName,
+				Backend: "10.0.0.1",
+			}
+			nsClient.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&api.ListNamespacesResponse{
+				Namespaces: []*api.Namespace{{Id: &api.NamespaceIdentifier{Id: "ns_id"}}},
+			}, nil).AnyTimes()
+			fsClient.EXPECT().ListFilesystems(gomock.Any(), gomock.Any()).Return(&weka.ListFilesystemsResponse{
+				Filesystems: []*weka.Filesystem{restored},
+			}, nil).AnyTimes()
+			fsClient.EXPECT().CreateFilesystem(gomock.Any(), gomock.Any()).Times(0)
+			nsClient.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Times(0)
+
+			r := &StorageReconciler{
+				StorageControllerClient: &sc.StorageControllerClient{
+					WekaFilesystemSvcClient: fsClient,
+					NamespaceSvcClient:      nsClient,
+				},
+				kmsClient: NewMockStorageKMSPrivateServiceClient(),
+			}
+			Expect(r.createFileSystem(ctx, storage)).To(Succeed())
+			Expect(storage.Status.Mount.ClusterAddr).To(Equal("10.0.0.1"))
+			Expect(storage.Status.Size).To(Equal(storage.Spec.StorageRequest.Size))
+		})
 		It("Should update Storage Namespace and FileSystem", func() {
 			ctx := context.Background()
 			storage := NewStorage("123456789013", "test2")
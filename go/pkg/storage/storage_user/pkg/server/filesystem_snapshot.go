@@ -0,0 +1,214 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FilesystemSnapshotServiceServer is used to implement pb.UnimplementedFilesystemSnapshotPrivateServiceServer
+type FilesystemSnapshotServiceServer struct {
+	pb.UnimplementedFilesystemSnapshotPrivateServiceServer
+
+	kmsClient    pb.StorageKMSPrivateServiceClient
+	strCntClient *storagecontroller.StorageControllerClient
+}
+
+func NewFilesystemSnapshotServiceServer(kmsClient pb.StorageKMSPrivateServiceClient,
+	strCntCli *storagecontroller.StorageControllerClient) (*FilesystemSnapshotServiceServer, error) {
+	if kmsClient == nil {
+		return nil, fmt.Errorf("storage kms client is required")
+	}
+	if strCntCli == nil {
+		return nil, fmt.Errorf("storage sds client is required")
+	}
+	return &FilesystemSnapshotServiceServer{
+		kmsClient:    kmsClient,
+		strCntClient: strCntCli,
+	}, nil
+}
+
+func (snapSrv *FilesystemSnapshotServiceServer) CreateSnapshotPrivate(ctx context.Context, in *pb.FilesystemSnapshotBackendRequest) (*pb.FilesystemSnapshotBackend, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemSnapshotServiceServer.CreateSnapshotPrivate").
+		WithValues(logkeys.ClusterId, in.ClusterUUID, logkeys.FilesystemName, in.FilesystemName, logkeys.SnapshotName, in.SnapshotName).Start()
+	defer span.End()
+
+	logger.Info("entering filesystem snapshot create")
+	defer logger.Info("returning from filesystem snapshot create")
+
+	fsMetadata, err := snapSrv.filesystemMetadata(ctx, in.ClusterUUID, in.NamespaceName, in.NamespaceCredsPath, in.FilesystemName, in.StorageClass)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := snapSrv.strCntClient.CreateFilesystemSnapshot(ctx, fsMetadata, in.SnapshotName)
+	if err != nil {
+		logger.Error(err, "error creating snapshot in sds")
+		return nil, status.Errorf(codes.Internal, "error creating snapshot")
+	}
+	logger.Info("snapshot created successfully", logkeys.SnapshotId, snapshot.ID)
+
+	return intoSnapshotBackend(snapshot), nil
+}
+
+func (snapSrv *FilesystemSnapshotServiceServer) DeleteSnapshotPrivate(ctx context.Context, in *pb.FilesystemSnapshotBackendRequest) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemSnapshotServiceServer.DeleteSnapshotPrivate").
+		WithValues(logkeys.ClusterId, in.ClusterUUID, logkeys.FilesystemName, in.FilesystemName, logkeys.SnapshotName, in.SnapshotName).Start()
+	defer span.End()
+
+	logger.Info("entering filesystem snapshot delete")
+	defer logger.Info("returning from filesystem snapshot delete")
+
+	fsMetadata, err := snapSrv.filesystemMetadata(ctx, in.ClusterUUID, in.NamespaceName, in.NamespaceCredsPath, in.FilesystemName, in.StorageClass)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := snapSrv.strCntClient.DeleteFilesystemSnapshot(ctx, fsMetadata, in.SnapshotName); err != nil {
+		logger.Error(err, "error deleting snapshot in sds")
+		return nil, status.Errorf(codes.Internal, "error deleting snapshot")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (snapSrv *FilesystemSnapshotServiceServer) ListSnapshotsPrivate(ctx context.Context, in *pb.FilesystemSnapshotBackendListRequest) (*pb.FilesystemSnapshotBackendListResponse, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemSnapshotServiceServer.ListSnapshotsPrivate").
+		WithValues(logkeys.ClusterId, in.ClusterUUID, logkeys.FilesystemName, in.FilesystemName).Start()
+	defer span.End()
+
+	logger.Info("entering filesystem snapshot list")
+	defer logger.Info("returning from filesystem snapshot list")
+
+	fsMetadata, err := snapSrv.filesystemMetadata(ctx, in.ClusterUUID, in.NamespaceName, in.NamespaceCredsPath, in.FilesystemName, in.StorageClass)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := snapSrv.strCntClient.ListFilesystemSnapshots(ctx, fsMetadata, nil)
+	if err != nil {
+		logger.Error(err, "error listing snapshots in sds")
+		return nil, status.Errorf(codes.Internal, "error listing snapshots")
+	}
+
+	resp := pb.FilesystemSnapshotBackendListResponse{}
+	for idx := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, intoSnapshotBackend(&snapshots[idx]))
+	}
+	return &resp, nil
+}
+
+func (snapSrv *FilesystemSnapshotServiceServer) RestoreSnapshotPrivate(ctx context.Context, in *pb.FilesystemSnapshotBackendRestoreRequest) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemSnapshotServiceServer.RestoreSnapshotPrivate").
+		WithValues(logkeys.ClusterId, in.Snapshot.GetClusterUUID(), logkeys.FilesystemName, in.Snapshot.GetFilesystemName(),
+			logkeys.SnapshotName, in.Snapshot.GetSnapshotName()).Start()
+	defer span.End()
+
+	logger.Info("entering filesystem snapshot restore", "newFilesystemName", in.NewFilesystemName)
+	defer logger.Info("returning from filesystem snapshot restore")
+
+	if in.Snapshot == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing snapshot")
+	}
+
+	fsMetadata, err := snapSrv.filesystemMetadata(ctx, in.Snapshot.ClusterUUID, in.Snapshot.NamespaceName, in.Snapshot.NamespaceCredsPath, in.Snapshot.FilesystemName,
+		in.Snapshot.StorageClass)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = snapSrv.strCntClient.RestoreFilesystemSnapshot(ctx, storagecontroller.RestoreSnapshotParams{
+		Filesystem:        fsMetadata,
+		SnapshotName:      in.Snapshot.SnapshotName,
+		NewFilesystemName: in.NewFilesystemName,
+		TotalBytes:        in.TotalBytes,
+	})
+	if err != nil {
+		logger.Error(err, "error restoring snapshot in sds")
+		return nil, status.Errorf(codes.Internal, "error restoring snapshot")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (snapSrv *FilesystemSnapshotServiceServer) DeleteRestoredFilesystemPrivate(ctx context.Context, in *pb.FilesystemSnapshotBackendRestoreRequest) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("FilesystemSnapshotServiceServer.DeleteRestoredFilesystemPrivate").
+		WithValues(logkeys.ClusterId, in.Snapshot.GetClusterUUID(), logkeys.FilesystemName, in.NewFilesystemName).Start()
+	defer span.End()
+
+	logger.Info("entering restored filesystem delete")
+	defer logger.Info("returning from restored filesystem delete")
+
+	if in.Snapshot == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing snapshot")
+	}
+
+	// The restored filesystem is created in the namespace of the source filesystem
+	fsMetadata, err := snapSrv.filesystemMetadata(ctx, in.Snapshot.ClusterUUID, in.Snapshot.NamespaceName, in.Snapshot.NamespaceCredsPath, in.NewFilesystemName,
+		in.Snapshot.StorageClass)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := snapSrv.strCntClient.DeleteRestoredFilesystem(ctx, fsMetadata); err != nil {
+		logger.Error(err, "error deleting restored filesystem in sds")
+		return nil, status.Errorf(codes.Internal, "error deleting restored filesystem")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (snapSrv *FilesystemSnapshotServiceServer) PingFilesystemSnapshotPrivate(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	logger := log.FromContext(ctx).WithName("FilesystemSnapshotServiceServer.PingFilesystemSnapshotPrivate")
+	logger.Info("ping private filesystem snapshot")
+
+	return &emptypb.Empty{}, nil
+}
+
+// Reads namespace credentials from kms, weka snapshot operations are executed as the namespace admin.
+// VAST namespaces have no credentials, the backend uses the cluster admin.
+func (snapSrv *FilesystemSnapshotServiceServer) filesystemMetadata(ctx context.Context, clusterUUID, namespaceName, credsPath, fsName string,
+	storageClass pb.FilesystemStorageClass) (storagecontroller.FilesystemMetadata, error) {
+	fsMetadata := storagecontroller.FilesystemMetadata{
+		FileSystemName: fsName,
+		NamespaceName:  namespaceName,
+		UUID:           clusterUUID,
+	}
+	if storageClass == pb.FilesystemStorageClass_GeneralPurposeStd {
+		fsMetadata.Vast = true
+		return fsMetadata, nil
+	}
+
+	nsCreds, err := readSecretsFromStorageKMS(ctx, snapSrv.kmsClient, credsPath)
+	if err != nil {
+		return fsMetadata, status.Error(codes.Internal, "error reading from storage kms")
+	}
+	if user, found := nsCreds["username"]; found {
+		fsMetadata.User = user
+	}
+	if password, found := nsCreds["password"]; found {
+		fsMetadata.Password = password
+	}
+
+	return fsMetadata, nil
+}
+
+func intoSnapshotBackend(snapshot *storagecontroller.Snapshot) *pb.FilesystemSnapshotBackend {
+	return &pb.FilesystemSnapshotBackend{
+		Name:              snapshot.Name,
+		CreationTimestamp: timestamppb.New(time.Unix(snapshot.CreatedTimestamp, 0)),
+		UsedBytes:         snapshot.UsedBytes,
+	}
+}
@@ -91,7 +91,13 @@ func (svc *UserService) Init(ctx context.Context, cfg *Config, resolver grpcutil
 		return err
 	}
 
+	snapshotSrv, err := NewFilesystemSnapshotServiceServer(stoargeKmsClient, &strCntCli)
+	if err != nil {
+		return err
+	}
+
 	pb.RegisterFilesystemUserPrivateServiceServer(grpcServer, userSrv)
+	pb.RegisterFilesystemSnapshotPrivateServiceServer(grpcServer, snapshotSrv)
 	if cfg.ObjectStoreEnabled {
 		pb.RegisterBucketUserPrivateServiceServer(grpcServer, userSrv)
 		pb.RegisterBucketLifecyclePrivateServiceServer(grpcServer, lifecycleSrv)
@@ -26,6 +26,7 @@ type StorageControllerClient struct {
 	S3ServiceClient         stcnt_api.S3ServiceClient
 	StatefulSvcClient       stcnt_weka_api.StatefulClientServiceClient
 	VastFilesystemSvcClient stcnt_vast_api.FilesystemServiceClient
+	SnapshotSvcClient       stcnt_api.SnapshotServiceClient
 }
 
 // Init
@@ -60,6 +61,7 @@ func (client *StorageControllerClient) Init(ctx context.Context, serverAddr stri
 	client.S3ServiceClient = stcnt_api.NewS3ServiceClient(clientConn)
 	client.StatefulSvcClient = stcnt_weka_api.NewStatefulClientServiceClient(clientConn)
 	client.VastFilesystemSvcClient = stcnt_vast_api.NewFilesystemServiceClient(clientConn)
+	client.SnapshotSvcClient = stcnt_api.NewSnapshotServiceClient(clientConn)
 
 	return nil
 }
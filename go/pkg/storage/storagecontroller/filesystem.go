@@ -22,6 +22,8 @@ type FilesystemMetadata struct {
 	NamespaceName  string
 	UUID           string
 	Backend        string
+	// VAST filesystems are views managed with the cluster admin credentials
+	Vast bool
 }
 
 type FilesystemProperties struct {
@@ -157,8 +159,23 @@ func (client *StorageControllerClient) CreateFilesystem(ctx context.Context, fil
 func (client *StorageControllerClient) GetFilesystem(ctx context.Context, queryParams FilesystemMetadata) (Filesystem, error) {
 	logger := log.FromContext(ctx).WithName("StorageControllerClient.GetFilesystem")
 	logger.Info("get filesystem object")
-	res := Filesystem{}
-	return res, nil
+
+	authCtx := newBasicAuthContext(queryParams.User, queryParams.Password)
+	ns, exists, err := client.getNamespaceByName(ctx, queryParams.UUID, queryParams.NamespaceName)
+	if err != nil {
+		return Filesystem{}, err
+	}
+	if !exists {
+		return Filesystem{}, fmt.Errorf("Namespace does not exist")
+	}
+	fs, exists, err := client.getFilesystemByName(ctx, ns.Id, queryParams.FileSystemName, authCtx)
+	if err != nil {
+		return Filesystem{}, err
+	}
+	if !exists {
+		return Filesystem{}, fmt.Errorf("Filesystem does not exist")
+	}
+	return intoFileSystem(fs), nil
 }
 
 // Gets filesystem object from the storage controller
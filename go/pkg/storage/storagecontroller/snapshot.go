@@ -0,0 +1,270 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package storagecontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	storageControllerApi "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller/api"
+)
+
+type Snapshot struct {
+	ID               string
+	Name             string
+	FilesystemName   string
+	CreatedTimestamp int64
+	UsedBytes        uint64
+}
+
+type RestoreSnapshotParams struct {
+	// Source filesystem of the snapshot
+	Filesystem   FilesystemMetadata
+	SnapshotName string
+	// Name and capacity of the filesystem to be created from the snapshot
+	NewFilesystemName string
+	TotalBytes        uint64
+}
+
+// Creates point-in-time snapshot of the filesystem
+func (client *StorageControllerClient) CreateFilesystemSnapshot(ctx context.Context, queryParams FilesystemMetadata, snapshotName string) (*Snapshot, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("StorageControllerClient.CreateFilesystemSnapshot").Start()
+	defer span.End()
+
+	logger.Info("starting snapshot creation", logkeys.FilesystemName, queryParams.FileSystemName, logkeys.SnapshotName, snapshotName)
+
+	authCtx := newBasicAuthContext(queryParams.User, queryParams.Password)
+	ns, fsId, err := client.lookupSnapshotFilesystem(ctx, queryParams, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SnapshotSvcClient.CreateSnapshot(ctx, &storageControllerApi.CreateSnapshotRequest{
+		NamespaceId:  ns.Id,
+		FilesystemId: fsId,
+		Name:         snapshotName,
+		AuthCtx:      authCtx,
+	})
+	if err != nil {
+		logger.Error(err, "error creating snapshot in the controller")
+		return nil, err
+	}
+
+	snapshot := intoSnapshot(resp.GetSnapshot(), queryParams.FileSystemName)
+	return &snapshot, nil
+}
+
+// Lists snapshots of the filesystem, optionally filtered by snapshot names
+func (client *StorageControllerClient) ListFilesystemSnapshots(ctx context.Context, queryParams FilesystemMetadata, names []string) ([]Snapshot, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("StorageControllerClient.ListFilesystemSnapshots").Start()
+	defer span.End()
+
+	logger.Info("listing snapshots", logkeys.FilesystemName, queryParams.FileSystemName)
+
+	authCtx := newBasicAuthContext(queryParams.User, queryParams.Password)
+	ns, fsId, err := client.lookupSnapshotFilesystem(ctx, queryParams, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SnapshotSvcClient.ListSnapshots(ctx, &storageControllerApi.ListSnapshotsRequest{
+		NamespaceId: ns.Id,
+		Filter: &storageControllerApi.ListSnapshotsRequest_Filter{
+			FilesystemId: fsId,
+			Names:        names,
+		},
+		AuthCtx: authCtx,
+	})
+	if err != nil {
+		logger.Error(err, "error listing snapshots in the controller")
+		return nil, err
+	}
+
+	snapshots := []Snapshot{}
+	for _, s := range resp.GetSnapshots() {
+		snapshots = append(snapshots, intoSnapshot(s, queryParams.FileSystemName))
+	}
+
+	return snapshots, nil
+}
+
+// Deletes snapshot of the filesystem by name, snapshot which does not exist is not an error
+func (client *StorageControllerClient) DeleteFilesystemSnapshot(ctx context.Context, queryParams FilesystemMetadata, snapshotName string) error {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("StorageControllerClient.DeleteFilesystemSnapshot").Start()
+	defer span.End()
+
+	logger.Info("deleting snapshot", logkeys.FilesystemName, queryParams.FileSystemName, logkeys.SnapshotName, snapshotName)
+
+	authCtx := newBasicAuthContext(queryParams.User, queryParams.Password)
+	snapshot, err := client.getSnapshotByName(ctx, queryParams, snapshotName, authCtx)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		logger.Info("could not find snapshot by name")
+		return nil
+	}
+
+	_, err = client.SnapshotSvcClient.DeleteSnapshot(ctx, &storageControllerApi.DeleteSnapshotRequest{
+		SnapshotId: snapshot.Id,
+		AuthCtx:    authCtx,
+	})
+	if err != nil {
+		logger.Error(err, "error deleting snapshot in the controller", logkeys.SnapshotId, snapshot.GetId().GetId())
+		return err
+	}
+
+	return nil
+}
+
+// Restores snapshot into a new filesystem, source filesystem is left intact
+func (client *StorageControllerClient) RestoreFilesystemSnapshot(ctx context.Context, params RestoreSnapshotParams) (Filesystem, error) {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("StorageControllerClient.RestoreFilesystemSnapshot").Start()
+	defer span.End()
+
+	logger.Info("restoring snapshot", logkeys.FilesystemName, params.Filesystem.FileSystemName, logkeys.SnapshotName, params.SnapshotName)
+
+	authCtx := newBasicAuthContext(params.Filesystem.User, params.Filesystem.Password)
+	snapshot, err := client.getSnapshotByName(ctx, params.Filesystem, params.SnapshotName, authCtx)
+	if err != nil {
+		return Filesystem{}, err
+	}
+	if snapshot == nil {
+		return Filesystem{}, fmt.Errorf("Snapshot does not exist")
+	}
+
+	resp, err := client.SnapshotSvcClient.RestoreSnapshot(ctx, &storageControllerApi.RestoreSnapshotRequest{
+		SnapshotId:     snapshot.Id,
+		FilesystemName: params.NewFilesystemName,
+		TotalBytes:     params.TotalBytes,
+		AuthCtx:        authCtx,
+	})
+	if err != nil {
+		logger.Error(err, "error restoring snapshot in the controller", logkeys.SnapshotId, snapshot.GetId().GetId())
+		return Filesystem{}, err
+	}
+
+	return Filesystem{
+		Metadata: FilesystemMetadata{
+			FileSystemName: resp.GetFilesystemName(),
+			Encrypted:      params.Filesystem.Encrypted,
+			AuthRequired:   params.Filesystem.AuthRequired,
+			NamespaceName:  params.Filesystem.NamespaceName,
+			UUID:           params.Filesystem.UUID,
+		},
+		Properties: FilesystemProperties{
+			FileSystemCapacity: fmt.Sprint(params.TotalBytes),
+		},
+	}, nil
+}
+
+// Deletes a filesystem created by RestoreFilesystemSnapshot, deleting a filesystem which does not exist is not an error
+func (client *StorageControllerClient) DeleteRestoredFilesystem(ctx context.Context, queryParams FilesystemMetadata) error {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("StorageControllerClient.DeleteRestoredFilesystem").Start()
+	defer span.End()
+
+	logger.Info("deleting restored filesystem", logkeys.FilesystemName, queryParams.FileSystemName)
+
+	if !queryParams.Vast {
+		return client.DeleteFilesystem(ctx, queryParams)
+	}
+
+	ns, exists, err := client.getNamespaceByName(ctx, queryParams.UUID, queryParams.NamespaceName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	filesystems, err := client.ListVastFilesystems(ctx, &ListFilesystemsParams{
+		NamespaceID: ns.GetId().GetId(),
+		Names:       []string{queryParams.FileSystemName},
+		ClusterID:   queryParams.UUID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, filesystem := range filesystems {
+		if err := client.DeleteVastFilesystem(ctx, &DeleteFilesystemParams{
+			NamespaceID:  ns.GetId().GetId(),
+			FilesystemID: filesystem.GetId().GetId(),
+			ClusterID:    queryParams.UUID,
+		}); err != nil {
+			logger.Error(err, "error deleting restored filesystem in the controller")
+			return err
+		}
+	}
+	return nil
+}
+
+func (client *StorageControllerClient) lookupSnapshotFilesystem(ctx context.Context, queryParams FilesystemMetadata, authCtx *storageControllerApi.AuthenticationContext) (*storageControllerApi.Namespace, string, error) {
+	ns, exists, err := client.getNamespaceByName(ctx, queryParams.UUID, queryParams.NamespaceName)
+	if err != nil {
+		return nil, "", err
+	}
+	if !exists {
+		return nil, "", fmt.Errorf("Namespace does not exist")
+	}
+	if queryParams.Vast {
+		filesystems, err := client.ListVastFilesystems(ctx, &ListFilesystemsParams{
+			NamespaceID: ns.GetId().GetId(),
+			Names:       []string{queryParams.FileSystemName},
+			ClusterID:   queryParams.UUID,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		if len(filesystems) != 1 {
+			return nil, "", fmt.Errorf("Filesystem does not exist")
+		}
+		return ns, filesystems[0].GetId().GetId(), nil
+	}
+	fs, exists, err := client.getFilesystemByName(ctx, ns.Id, queryParams.FileSystemName, authCtx)
+	if err != nil {
+		return nil, "", err
+	}
+	if !exists {
+		return nil, "", fmt.Errorf("Filesystem does not exist")
+	}
+
+	return ns, fs.GetId().GetId(), nil
+}
+
+func (client *StorageControllerClient) getSnapshotByName(ctx context.Context, queryParams FilesystemMetadata, snapshotName string, authCtx *storageControllerApi.AuthenticationContext) (*storageControllerApi.Snapshot, error) {
+	ns, fsId, err := client.lookupSnapshotFilesystem(ctx, queryParams, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SnapshotSvcClient.ListSnapshots(ctx, &storageControllerApi.ListSnapshotsRequest{
+		NamespaceId: ns.Id,
+		Filter: &storageControllerApi.ListSnapshotsRequest_Filter{
+			FilesystemId: fsId,
+			Names:        []string{snapshotName},
+		},
+		AuthCtx: authCtx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.GetSnapshots()) > 1 {
+		return nil, fmt.Errorf("unexpected number of snapshots returned by name %d!=1", len(resp.GetSnapshots()))
+	} else if len(resp.GetSnapshots()) == 0 {
+		return nil, nil
+	}
+
+	return resp.GetSnapshots()[0], nil
+}
+
+func intoSnapshot(s *storageControllerApi.Snapshot, filesystemName string) Snapshot {
+	return Snapshot{
+		ID:               s.GetId().GetId(),
+		Name:             s.GetName(),
+		FilesystemName:   filesystemName,
+		CreatedTimestamp: s.GetCreatedTimestamp(),
+		UsedBytes:        s.GetUsedBytes(),
+	}
+}
@@ -132,13 +132,17 @@ var _ = Describe("Filesystem", func() {
 	})
 	Context("Get", func() {
 		It("should get a fs", func() {
-			//TODO: GetFilesystem func not yet implemented
+			nsMockClient.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&api.ListNamespacesResponse{
+				Namespaces: []*api.Namespace{nsResponse},
+			}, nil).Times(1)
+			mockClient.EXPECT().ListFilesystems(gomock.Any(), gomock.Any()).Return(&weka.ListFilesystemsResponse{
+				Filesystems: []*weka.Filesystem{fsResponse},
+			}, nil).Times(1)
 			fs, err := client.GetFilesystem(context.Background(), fsMetadata)
 			Expect(err).Should(BeNil())
-			Expect(fs).ShouldNot(BeNil())
+			Expect(fs.Metadata.FileSystemName).Should(Equal(fsResponse.Name))
 		})
 		It("should return err if fs not found", func() {
-			//TODO: GetFilesystem func not yet implemented
 			metadata := sc.FilesystemMetadata{
 				FileSystemName: "none",
 				Encrypted:      true,
@@ -147,9 +151,12 @@ var _ = Describe("Filesystem", func() {
 				Password:       "testpassword",
 				NamespaceName:  "testnamespace",
 			}
-			fs, err := client.GetFilesystem(context.Background(), metadata)
-			Expect(fs).ShouldNot(BeNil())
-			Expect(err).Should(BeNil())
+			nsMockClient.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&api.ListNamespacesResponse{
+				Namespaces: []*api.Namespace{nsResponse},
+			}, nil).Times(1)
+			mockClient.EXPECT().ListFilesystems(gomock.Any(), gomock.Any()).Return(&weka.ListFilesystemsResponse{}, nil).Times(1)
+			_, err := client.GetFilesystem(context.Background(), metadata)
+			Expect(err).ShouldNot(BeNil())
 		})
 	})
 	Context("Exist", func() {
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller/api (interfaces: NamespaceServiceClient,ClusterServiceClient,UserServiceClient)
+// Source: github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller/api (interfaces: NamespaceServiceClient,ClusterServiceClient,UserServiceClient,SnapshotServiceClient)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -341,3 +341,106 @@ func (mr *MockUserServiceClientMockRecorder) UpdateUserPassword(arg0, arg1 inter
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockUserServiceClient)(nil).UpdateUserPassword), varargs...)
 }
+
+// MockSnapshotServiceClient is a mock of SnapshotServiceClient interface.
+type MockSnapshotServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotServiceClientMockRecorder
+}
+
+// MockSnapshotServiceClientMockRecorder is the mock recorder for MockSnapshotServiceClient.
+type MockSnapshotServiceClientMockRecorder struct {
+	mock *MockSnapshotServiceClient
+}
+
+// NewMockSnapshotServiceClient creates a new mock instance.
+func NewMockSnapshotServiceClient(ctrl *gomock.Controller) *MockSnapshotServiceClient {
+	mock := &MockSnapshotServiceClient{ctrl: ctrl}
+	mock.recorder = &MockSnapshotServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotServiceClient) EXPECT() *MockSnapshotServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockSnapshotServiceClient) CreateSnapshot(arg0 context.Context, arg1 *api.CreateSnapshotRequest, arg2 ...grpc.CallOption) (*api.CreateSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSnapshot", varargs...)
+	ret0, _ := ret[0].(*api.CreateSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockSnapshotServiceClientMockRecorder) CreateSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockSnapshotServiceClient)(nil).CreateSnapshot), varargs...)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockSnapshotServiceClient) DeleteSnapshot(arg0 context.Context, arg1 *api.DeleteSnapshotRequest, arg2 ...grpc.CallOption) (*api.DeleteSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSnapshot", varargs...)
+	ret0, _ := ret[0].(*api.DeleteSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockSnapshotServiceClientMockRecorder) DeleteSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockSnapshotServiceClient)(nil).DeleteSnapshot), varargs...)
+}
+
+// ListSnapshots mocks base method.
+func (m *MockSnapshotServiceClient) ListSnapshots(arg0 context.Context, arg1 *api.ListSnapshotsRequest, arg2 ...grpc.CallOption) (*api.ListSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshots", varargs...)
+	ret0, _ := ret[0].(*api.ListSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockSnapshotServiceClientMockRecorder) ListSnapshots(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockSnapshotServiceClient)(nil).ListSnapshots), varargs...)
+}
+
+// RestoreSnapshot mocks base method.
+func (m *MockSnapshotServiceClient) RestoreSnapshot(arg0 context.Context, arg1 *api.RestoreSnapshotRequest, arg2 ...grpc.CallOption) (*api.RestoreSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreSnapshot", varargs...)
+	ret0, _ := ret[0].(*api.RestoreSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockSnapshotServiceClientMockRecorder) RestoreSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockSnapshotServiceClient)(nil).RestoreSnapshot), varargs...)
+}
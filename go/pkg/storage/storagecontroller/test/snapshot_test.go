@@ -0,0 +1,255 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
+	sc "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller/api"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller/api/vast"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller/api/weka"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller/test/mocks"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/storagecontroller/test/vastmocks"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		fsMetadata sc.FilesystemMetadata
+
+		client         *sc.StorageControllerClient
+		nsResponse     *api.Namespace
+		fsResponse     *weka.Filesystem
+		snapResponse   *api.Snapshot
+		mockCtrl       *gomock.Controller
+		fsMockClient   *mocks.MockFilesystemServiceClient
+		nsMockClient   *mocks.MockNamespaceServiceClient
+		snapMockClient *mocks.MockSnapshotServiceClient
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		fsMockClient = mocks.NewMockFilesystemServiceClient(mockCtrl)
+		nsMockClient = mocks.NewMockNamespaceServiceClient(mockCtrl)
+		snapMockClient = mocks.NewMockSnapshotServiceClient(mockCtrl)
+		client = &sc.StorageControllerClient{
+			WekaFilesystemSvcClient: fsMockClient,
+			NamespaceSvcClient:      nsMockClient,
+			SnapshotSvcClient:       snapMockClient,
+		}
+
+		nsId := &api.NamespaceIdentifier{
+			ClusterId: &api.ClusterIdentifier{
+				Uuid: "66efeaca-e493-4a39-b683-15978aac90d5",
+			},
+			Id: "ns_id",
+		}
+		nsResponse = &api.Namespace{
+			Id:   nsId,
+			Name: "testnamespace",
+		}
+		fsResponse = &weka.Filesystem{
+			Id: &weka.FilesystemIdentifier{
+				NamespaceId: nsId,
+				Id:          "fs_id",
+			},
+			Name: "testfs",
+		}
+		snapResponse = &api.Snapshot{
+			Id: &api.SnapshotIdentifier{
+				NamespaceId: nsId,
+				Id:          "snap_id",
+			},
+			Name:             "snap",
+			FilesystemId:     "fs_id",
+			CreatedTimestamp: 1704067200,
+			UsedBytes:        1024,
+		}
+		fsMetadata = sc.FilesystemMetadata{
+			FileSystemName: "testfs",
+			User:           "testuser",
+			Password:       "testpassword",
+			NamespaceName:  "testnamespace",
+			UUID:           "66efeaca-e493-4a39-b683-15978aac90d5",
+		}
+
+		nsMockClient.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&api.ListNamespacesResponse{
+			Namespaces: []*api.Namespace{nsResponse},
+		}, nil).AnyTimes()
+		fsMockClient.EXPECT().ListFilesystems(gomock.Any(), gomock.Any()).Return(&weka.ListFilesystemsResponse{
+			Filesystems: []*weka.Filesystem{fsResponse},
+		}, nil).AnyTimes()
+	})
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("CreateFilesystemSnapshot", func() {
+		It("should create a snapshot of the filesystem", func() {
+			snapMockClient.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, r *api.CreateSnapshotRequest, opts ...interface{}) (*api.CreateSnapshotResponse, error) {
+					Expect(r.GetFilesystemId()).To(Equal("fs_id"))
+					Expect(r.GetName()).To(Equal("snap"))
+					return &api.CreateSnapshotResponse{Snapshot: snapResponse}, nil
+				}).Times(1)
+
+			snapshot, err := client.CreateFilesystemSnapshot(context.Background(), fsMetadata, "snap")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*snapshot).To(Equal(sc.Snapshot{
+				ID:               "snap_id",
+				Name:             "snap",
+				FilesystemName:   "testfs",
+				CreatedTimestamp: 1704067200,
+				UsedBytes:        1024,
+			}))
+		})
+		It("should return error if controller fails", func() {
+			snapMockClient.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any()).Return(nil, errors.New("error")).Times(1)
+
+			_, err := client.CreateFilesystemSnapshot(context.Background(), fsMetadata, "snap")
+			Expect(err).To(HaveOccurred())
+		})
+		It("should look up vast filesystem by the view name", func() {
+			vastFsMockClient := vastmocks.NewMockFilesystemServiceClient(mockCtrl)
+			client.VastFilesystemSvcClient = vastFsMockClient
+			vastFsMockClient.EXPECT().ListFilesystems(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, r *vast.ListFilesystemsRequest, opts ...interface{}) (*vast.ListFilesystemsResponse, error) {
+					Expect(r.GetNamespaceId().GetId()).To(Equal("ns_id"))
+					Expect(r.GetFilter().GetNames()).To(ConsistOf("testfs"))
+					return &vast.ListFilesystemsResponse{Filesystems: []*vast.Filesystem{{
+						Id:   &vast.FilesystemIdentifier{Id: "view_id"},
+						Name: "testfs",
+					}}}, nil
+				}).Times(1)
+			snapMockClient.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, r *api.CreateSnapshotRequest, opts ...interface{}) (*api.CreateSnapshotResponse, error) {
+					Expect(r.GetFilesystemId()).To(Equal("view_id"))
+					return &api.CreateSnapshotResponse{Snapshot: snapResponse}, nil
+				}).Times(1)
+
+			vastMetadata := sc.FilesystemMetadata{
+				FileSystemName: "testfs",
+				NamespaceName:  "testnamespace",
+				UUID:           "66efeaca-e493-4a39-b683-15978aac90d5",
+				Vast:           true,
+			}
+			_, err := client.CreateFilesystemSnapshot(context.Background(), vastMetadata, "snap")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("ListFilesystemSnapshots", func() {
+		It("should list snapshots of the filesystem", func() {
+			snapMockClient.EXPECT().ListSnapshots(gomock.Any(), gomock.Any()).Return(&api.ListSnapshotsResponse{
+				Snapshots: []*api.Snapshot{snapResponse},
+			}, nil).Times(1)
+
+			snapshots, err := client.ListFilesystemSnapshots(context.Background(), fsMetadata, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots).To(HaveLen(1))
+			Expect(snapshots[0].ID).To(Equal("snap_id"))
+		})
+	})
+
+	Context("DeleteFilesystemSnapshot", func() {
+		It("should delete snapshot by name", func() {
+			snapMockClient.EXPECT().ListSnapshots(gomock.Any(), gomock.Any()).Return(&api.ListSnapshotsResponse{
+				Snapshots: []*api.Snapshot{snapResponse},
+			}, nil).Times(1)
+			snapMockClient.EXPECT().DeleteSnapshot(gomock.Any(), gomock.Any()).Return(&api.DeleteSnapshotResponse{}, nil).Times(1)
+
+			err := client.DeleteFilesystemSnapshot(context.Background(), fsMetadata, "snap")
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should not fail if snapshot does not exist", func() {
+			snapMockClient.EXPECT().ListSnapshots(gomock.Any(), gomock.Any()).Return(&api.ListSnapshotsResponse{}, nil).Times(1)
+
+			err := client.DeleteFilesystemSnapshot(context.Background(), fsMetadata, "snap")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("RestoreFilesystemSnapshot", func() {
+		It("should restore snapshot into a new filesystem", func() {
+			snapMockClient.EXPECT().ListSnapshots(gomock.Any(), gomock.Any()).Return(&api.ListSnapshotsResponse{
+				Snapshots: []*api.Snapshot{snapResponse},
+			}, nil).Times(1)
+			snapMockClient.EXPECT().RestoreSnapshot(gomock.Any(), gomock.Any()).Return(&api.RestoreSnapshotResponse{
+				FilesystemId:   "fs_id2",
+				FilesystemName: "restored",
+			}, nil).Times(1)
+
+			fs, err := client.RestoreFilesystemSnapshot(context.Background(), sc.RestoreSnapshotParams{
+				Filesystem:        fsMetadata,
+				SnapshotName:      "snap",
+				NewFilesystemName: "restored",
+				TotalBytes:        1000,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs.Metadata.FileSystemName).To(Equal("restored"))
+			Expect(fs.Properties.FileSystemCapacity).To(Equal("1000"))
+		})
+		It("should return error if snapshot does not exist", func() {
+			snapMockClient.EXPECT().ListSnapshots(gomock.Any(), gomock.Any()).Return(&api.ListSnapshotsResponse{}, nil).Times(1)
+
+			_, err := client.RestoreFilesystemSnapshot(context.Background(), sc.RestoreSnapshotParams{
+				Filesystem:        fsMetadata,
+				SnapshotName:      "snap",
+				NewFilesystemName: "restored",
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("DeleteRestoredFilesystem", func() {
+		It("should delete the restored weka filesystem", func() {
+			fsMockClient.EXPECT().DeleteFilesystem(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, r *weka.DeleteFilesystemRequest, opts ...interface{}) (*weka.DeleteFilesystemResponse, error) {
+					Expect(r.GetFilesystemId().GetId()).To(Equal("fs_id"))
+					return &weka.DeleteFilesystemResponse{}, nil
+				}).Times(1)
+
+			err := client.DeleteRestoredFilesystem(context.Background(), fsMetadata)
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should delete the restored vast view", func() {
+			vastFsMockClient := vastmocks.NewMockFilesystemServiceClient(mockCtrl)
+			client.VastFilesystemSvcClient = vastFsMockClient
+			vastFsMockClient.EXPECT().ListFilesystems(gomock.Any(), gomock.Any()).Return(&vast.ListFilesystemsResponse{
+				Filesystems: []*vast.Filesystem{{Id: &vast.FilesystemIdentifier{Id: "view_id"}, Name: "restored"}},
+			}, nil).Times(1)
+			vastFsMockClient.EXPECT().DeleteFilesystem(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, r *vast.DeleteFilesystemRequest, opts ...interface{}) (*vast.DeleteFilesystemResponse, error) {
+					Expect(r.GetFilesystemId().GetId()).To(Equal("view_id"))
+					return &vast.DeleteFilesystemResponse{}, nil
+				}).Times(1)
+
+			err := client.DeleteRestoredFilesystem(context.Background(), sc.FilesystemMetadata{
+				FileSystemName: "restored",
+				NamespaceName:  "testnamespace",
+				UUID:           "66efeaca-e493-4a39-b683-15978aac90d5",
+				Vast:           true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should not fail if the vast view does not exist", func() {
+			vastFsMockClient := vastmocks.NewMockFilesystemServiceClient(mockCtrl)
+			client.VastFilesystemSvcClient = vastFsMockClient
+			vastFsMockClient.EXPECT().ListFilesystems(gomock.Any(), gomock.Any()).Return(&vast.ListFilesystemsResponse{}, nil).Times(1)
+
+			err := client.DeleteRestoredFilesystem(context.Background(), sc.FilesystemMetadata{
+				FileSystemName: "restored",
+				NamespaceName:  "testnamespace",
+				UUID:           "66efeaca-e493-4a39-b683-15978aac90d5",
+				Vast:           true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -836,8 +836,28 @@ func (r *StorageReconciler) createFileSystem(ctx context.Context, storage *cloud
 		},
 		ClusterID: storage.Spec.ClusterAssignment.ClusterUUID,
 	}
-	log.Info("creating filesystem", "params", createParams)
-	fsresp, err := r.StorageControllerClient.CreateVastFilesystem(ctx, createParams)
+	// filesystem restored from a snapshot is created by the backend, adopt it
+	existing, err := r.StorageControllerClient.ListVastFilesystems(ctx, &storagecontroller.ListFilesystemsParams{
+		NamespaceID: namespaceExisting.Metadata.Id,
+		Names:       []string{filesystemName},
+		ClusterID:   storage.Spec.ClusterAssignment.ClusterUUID,
+	})
+	if err != nil {
+		log.Error(err, "error listing filesystems in sds controller")
+		return fmt.Errorf("error listing filesystems: %w", err)
+	}
+	var fsresp *storageControllerVastApi.Filesystem
+	for _, fs := range existing {
+		if fs.Name == filesystemName {
+			log.Info("filesystem with name already exists, skipping creation", "FilesystemName", filesystemName)
+			fsresp = fs
+			break
+		}
+	}
+	if fsresp == nil {
+		log.Info("creating filesystem", "params", createParams)
+		fsresp, err = r.StorageControllerClient.CreateVastFilesystem(ctx, createParams)
+	}
 	if err != nil {
 		log.Error(err, "error creating filesystem in sds controller")
 		r.Recorder.Event(storage,
@@ -91,6 +91,36 @@ var _ = Describe("StorageReconciler", func() {
 		}, timeout, interval).Should(Succeed())
 
 	})
+	It("Should adopt the filesystem restored from a snapshot", func() {
+		storage := NewVastStorage("123456789012", "restored", "ComputeGeneralStd")
+		ctrl := gomock.NewController(GinkgoT())
+		fsClient := vastmocks.NewMockFilesystemServiceClient(ctrl)
+		nsClient := mocks.NewMockNamespaceServiceClient(ctrl)
+		restored := &vast.Filesystem{
+			Id: &vast.FilesystemIdentifier{
+				NamespaceId: &api.NamespaceIdentifier{Id: "2"},
+				Id:          "7",
+			},
+			Name: storage.Namespace + "-" + storage.Spec.FilesystemName,
+		}
+		nsClient.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&api.ListNamespacesResponse{
+			Namespaces: []*api.Namespace{{Id: &api.NamespaceIdentifier{Id: "2"}, Name: "namespace"}},
+		}, nil).AnyTimes()
+		fsClient.EXPECT().ListFilesystems(gomock.Any(), gomock.Any()).Return(&vast.ListFilesystemsResponse{
+			Filesystems: []*vast.Filesystem{restored},
+		}, nil).Times(1)
+		fsClient.EXPECT().CreateFilesystem(gomock.Any(), gomock.Any()).Times(0)
+
+		r := &StorageReconciler{
+			StorageControllerClient: &sc.StorageControllerClient{
+				VastFilesystemSvcClient: fsClient,
+				NamespaceSvcClient:      nsClient,
+			},
+		}
+		Expect(r.createFileSystem(ctx, storage)).To(Succeed())
+		Expect(storage.Status.VolumeProps.FilesystemId).To(Equal(int64(7)))
+		Expect(storage.Status.VolumeProps.NamespaceId).To(Equal(int64(2)))
+	})
 	It("When a Storage is created and in Running Condition", func() {
 		namespace := uuid.NewString()
 		filesystemName := uuid.NewString()
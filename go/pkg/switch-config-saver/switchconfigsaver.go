@@ -136,21 +136,32 @@ func saveConfigOnSwitchesWhereNeeded(ctx context.Context, allSwitchCRs *idcnetwo
 			continue
 		}
 
-		switchClient, err := switchclients.NewAristaClient(ipToUse, switchSecretsPath, 443, "https", 30*time.Second, false, []int{}, []int{}, allowedModes, nil, []int{})
+		switchClient, err := newSwitchClient(&swCR, ipToUse, switchSecretsPath, allowedModes)
 		if err != nil {
 			msg := fmt.Sprintf("create switch client for %s failed, %v \n", swCR.Name, err)
 			fmt.Printf(msg)
 			continue
 		}
 
-		runningConfig, err := switchClient.GetRunningConfig(ctx)
+		aristaClient, ok := switchClient.(*switchclients.AristaClient)
+		if !ok {
+			// SONiC does not expose the startup config over gNMI to compare with, the running config is saved every interval.
+			fmt.Printf("Saving running config as startupConfig on %s \n", swCR.Name)
+			if _, err := switchClient.SaveConfig(ctx, swCR.Spec.FQDN); err != nil {
+				msg := fmt.Sprintf("failed to save running-config for %s, %v \n", swCR.Name, err)
+				fmt.Printf(msg)
+			}
+			continue
+		}
+
+		runningConfig, err := aristaClient.GetRunningConfig(ctx)
 		if err != nil {
 			msg := fmt.Sprintf("failed to get running-config for %s, %v \n", swCR.Name, err)
 			fmt.Printf(msg)
 			continue
 		}
 
-		startupConfig, err := switchClient.GetStartupConfig(ctx)
+		startupConfig, err := aristaClient.GetStartupConfig(ctx)
 		if err != nil {
 			msg := fmt.Sprintf("failed to get startup-config for %s, %v \n", swCR.Name, err)
 			fmt.Printf(msg)
@@ -164,7 +175,7 @@ func saveConfigOnSwitchesWhereNeeded(ctx context.Context, allSwitchCRs *idcnetwo
 		var diffString = string(diff.Diff("startupConfig", []byte(cleanStartupConfig), "runningConfig", []byte(cleanRunningConfig)))
 		if diffString != "" {
 			fmt.Printf("Saving running config as startupConfig on %s. Diff: %s \n", swCR.Name, diffString)
-			switchClient.SaveRunningConfigAsStartupConfig(ctx)
+			aristaClient.SaveRunningConfigAsStartupConfig(ctx)
 		}
 
 	}
@@ -173,6 +184,31 @@ func saveConfigOnSwitchesWhereNeeded(ctx context.Context, allSwitchCRs *idcnetwo
 
 }
 
+// newSwitchClient creates the client of the switch vendor, switches without a vendor are Arista switches.
+func newSwitchClient(swCR *idcnetworkv1alpha1.Switch, ipToUse string, switchSecretsPath string, allowedModes []string) (switchclients.SwitchClient, error) {
+	vendor := swCR.Spec.Vendor
+	if vendor == "" {
+		vendor = idcnetworkv1alpha1.SwitchVendorArista
+	}
+	switch vendor {
+	case idcnetworkv1alpha1.SwitchVendorArista:
+		return switchclients.NewAristaClient(ipToUse, switchSecretsPath, 443, "https", 30*time.Second, false, []int{}, []int{}, allowedModes, nil, []int{})
+	case idcnetworkv1alpha1.SwitchVendorSonic:
+		port, transport := switchclients.DefaultGNMIPort, switchclients.DefaultGNMITransport
+		if swCR.Spec.GNMIConf != nil {
+			if swCR.Spec.GNMIConf.Port != 0 {
+				port = swCR.Spec.GNMIConf.Port
+			}
+			if swCR.Spec.GNMIConf.Transport != "" {
+				transport = swCR.Spec.GNMIConf.Transport
+			}
+		}
+		return switchclients.NewSonicClient(ipToUse, switchSecretsPath, port, transport, 30*time.Second, false, []int{}, []int{}, allowedModes, nil, []int{})
+	default:
+		return nil, fmt.Errorf("unsupported switch vendor %q", vendor)
+	}
+}
+
 var commentRegex = regexp.MustCompile("(?m)^!.*$")
 var emptyLineRegex = regexp.MustCompile("(?m)^\\s$")
 
@@ -0,0 +1,32 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package batch_service
+
+import (
+	"context"
+	"os"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/grpcutil"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+)
+
+func NewMeteringClient(ctx context.Context, resolver grpcutil.Resolver) (pb.MeteringServiceClient, error) {
+	logger := log.FromContext(ctx).WithName("MeteringClient.NewMeteringClient")
+
+	meteringAddr := os.Getenv("METERING_ADDR")
+	if meteringAddr == "" {
+		var err error
+		meteringAddr, err = resolver.Resolve(ctx, "metering")
+		if err != nil {
+			logger.Error(err, "grpc resolver not able to resolve", "addr", meteringAddr)
+			return nil, err
+		}
+	}
+
+	meteringConn, err := grpcConnect(ctx, meteringAddr)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewMeteringServiceClient(meteringConn), nil
+}
@@ -0,0 +1,7 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+DROP TABLE IF EXISTS slurm_job;
+
+-- Postgres cannot remove a value from an enum type, so clusters and nodes being deleted are marked as failed instead.
+UPDATE cluster SET status = 'FAILED' WHERE status = 'DELETING';
+UPDATE node SET status = 'FAILED' WHERE status = 'DELETING';
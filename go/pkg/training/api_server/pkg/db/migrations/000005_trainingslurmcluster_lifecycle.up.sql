@@ -0,0 +1,21 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+ALTER TYPE resource_states ADD VALUE IF NOT EXISTS 'DELETING';
+
+-- Jobs submitted through the training API. Rows are kept until the job usage has been reported to metering.
+CREATE TABLE IF NOT EXISTS slurm_job (
+    id SERIAL PRIMARY KEY,
+    job_id bigint NOT NULL,
+    cluster_id text NOT NULL,
+    cloud_account_id text NOT NULL,
+    name text NOT NULL,
+    submitted_at timestamptz NOT NULL DEFAULT NOW(),
+    state text,
+    start_time timestamptz,
+    end_time timestamptz,
+    reported_at timestamptz,
+
+    UNIQUE (cluster_id, job_id)
+);
+
+CREATE INDEX IF NOT EXISTS slurm_job_unreported_idx ON slurm_job (cluster_id) WHERE reported_at IS NULL;
@@ -0,0 +1,3 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+ALTER TABLE cluster DROP COLUMN IF EXISTS slurmrestd_jwt_key;
@@ -0,0 +1,4 @@
+-- INTEL CONFIDENTIAL
+-- Copyright (C) 2023 Intel Corporation
+-- The HS256 key slurmctld of each cluster validates slurmrestd tokens with. It is generated when the cluster is provisioned.
+ALTER TABLE cluster ADD COLUMN IF NOT EXISTS slurmrestd_jwt_key bytea;
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
 	storeSvcUtils "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/storage/utils"
@@ -17,19 +18,35 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/database/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// Used when maxComputeNodesPerCluster is not configured.
+	defaultMaxComputeNodesPerCluster = 16
 )
 
 type TrainingClusterServiceServer struct {
 	pb.UnimplementedTrainingClusterServiceServer
-	session *sql.DB
+	session         *sql.DB
+	slurmClient     slurmClientFunc
+	maxComputeNodes uint32
 }
 
-func NewTrainingClusterService(session *sql.DB) (*TrainingClusterServiceServer, error) {
+func NewTrainingClusterService(session *sql.DB, slurmClient slurmClientFunc, maxComputeNodes uint32) (*TrainingClusterServiceServer, error) {
 	if session == nil {
 		return nil, fmt.Errorf("db session is required")
 	}
+	if slurmClient == nil {
+		return nil, fmt.Errorf("slurm client is required")
+	}
+	if maxComputeNodes == 0 {
+		maxComputeNodes = defaultMaxComputeNodesPerCluster
+	}
 	return &TrainingClusterServiceServer{
-		session: session,
+		session:         session,
+		slurmClient:     slurmClient,
+		maxComputeNodes: maxComputeNodes,
 	}, nil
 }
 
@@ -209,3 +226,103 @@ func (svc *TrainingClusterServiceServer) List(ctx context.Context, in *pb.Cluste
 
 	return slurmClustersInfo, nil
 }
+
+func (svc *TrainingClusterServiceServer) Delete(ctx context.Context, in *pb.SlurmClusterRequest) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("TrainingClusterServiceServer.Delete").WithValues("cloudAccountId", in.CloudAccountId, "clusterId", in.ClusterId).Start()
+	defer span.End()
+	logger.Info("BEGIN")
+	defer logger.Info("END")
+
+	dbSession := svc.session
+	if dbSession == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+
+	// Validate clusterID
+	if _, err := uuid.Parse(in.ClusterId); err != nil {
+		logger.Error(err, "invalid clusterId")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid clusterId provided")
+	}
+
+	// Validate cloudAccounntId
+	if err := cloudaccount.CheckValidId(in.CloudAccountId); err != nil {
+		logger.Error(err, "invalid cloudAccountId")
+		return nil, err
+	}
+
+	svc.cancelClusterJobs(ctx, in.ClusterId, in.CloudAccountId)
+
+	if err := query.DeleteClusterRequest(ctx, dbSession, in.ClusterId, in.CloudAccountId); err != nil {
+		logger.Error(err, "error deleting cluster")
+		return nil, err
+	}
+
+	logger.Info("cluster marked for deletion")
+
+	return &emptypb.Empty{}, nil
+}
+
+func (svc *TrainingClusterServiceServer) Resize(ctx context.Context, in *pb.SlurmClusterResizeRequest) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("TrainingClusterServiceServer.Resize").WithValues("cloudAccountId", in.CloudAccountId, "clusterId", in.ClusterId).Start()
+	defer span.End()
+	logger.Info("BEGIN")
+	defer logger.Info("END")
+
+	dbSession := svc.session
+	if dbSession == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+
+	// Validate clusterID
+	if _, err := uuid.Parse(in.ClusterId); err != nil {
+		logger.Error(err, "invalid clusterId")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid clusterId provided")
+	}
+
+	// Validate cloudAccounntId
+	if err := cloudaccount.CheckValidId(in.CloudAccountId); err != nil {
+		logger.Error(err, "invalid cloudAccountId")
+		return nil, err
+	}
+
+	if in.ComputeNodeCount == 0 || in.ComputeNodeCount > svc.maxComputeNodes {
+		return nil, status.Errorf(codes.InvalidArgument, "computeNodeCount must be between 1 and %d", svc.maxComputeNodes)
+	}
+
+	if err := query.ResizeClusterRequest(ctx, dbSession, in.ClusterId, in.CloudAccountId, int(in.ComputeNodeCount)); err != nil {
+		logger.Error(err, "error resizing cluster")
+		return nil, err
+	}
+
+	logger.Info("cluster resize requested", "computeNodeCount", in.ComputeNodeCount)
+
+	return &emptypb.Empty{}, nil
+}
+
+// cancelClusterJobs cancels the unreported jobs of a ready cluster so that they end, and are metered, before the
+// cluster is torn down. Jobs that cannot be cancelled are still metered if they end before the teardown.
+func (svc *TrainingClusterServiceServer) cancelClusterJobs(ctx context.Context, clusterId, cloudAccountId string) {
+	logger := log.FromContext(ctx).WithName("TrainingClusterServiceServer.cancelClusterJobs")
+
+	clusterState, err := query.GetClusterState(ctx, svc.session, clusterId, cloudAccountId)
+	if err != nil || clusterState != query.STATE_READY {
+		return
+	}
+
+	jobs, err := query.GetUnreportedClusterSlurmJobs(ctx, svc.session, clusterId, cloudAccountId)
+	if err != nil || len(jobs) == 0 {
+		return
+	}
+
+	client, err := svc.slurmClient(ctx, clusterId, cloudAccountId)
+	if err != nil {
+		logger.Error(err, "error connecting to slurm controller")
+		return
+	}
+
+	for _, job := range jobs {
+		if err := client.CancelJob(ctx, job.JobId); err != nil && status.Code(err) != codes.NotFound {
+			logger.Error(err, "error cancelling slurm job", "jobId", job.JobId)
+		}
+	}
+}
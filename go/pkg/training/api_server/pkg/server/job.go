@@ -0,0 +1,142 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/cloudaccount"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/database/query"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/slurmrestd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (svc *TrainingClusterServiceServer) SubmitJob(ctx context.Context, in *pb.SlurmJobSubmitRequest) (*pb.SlurmJobSubmitResponse, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("TrainingClusterServiceServer.SubmitJob").WithValues("cloudAccountId", in.CloudAccountId, "clusterId", in.ClusterId).Start()
+	defer span.End()
+	logger.Info("BEGIN")
+	defer logger.Info("END")
+
+	if in.Spec == nil || in.Spec.Script == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job script is required")
+	}
+
+	client, err := svc.clusterSlurmClient(ctx, in.ClusterId, in.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each cluster has a single partition named after the cluster.
+	jobId, err := client.SubmitJob(ctx, in.ClusterId, in.Spec)
+	if err != nil {
+		logger.Error(err, "error submitting slurm job")
+		return nil, err
+	}
+
+	if err := query.CreateSlurmJob(ctx, svc.session, in.ClusterId, in.CloudAccountId, jobId, in.Spec.Name); err != nil {
+		return nil, err
+	}
+
+	logger.Info("slurm job submitted", "jobId", jobId)
+
+	return &pb.SlurmJobSubmitResponse{JobId: jobId}, nil
+}
+
+func (svc *TrainingClusterServiceServer) ListJobs(ctx context.Context, in *pb.SlurmJobListRequest) (*pb.SlurmJobListResponse, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("TrainingClusterServiceServer.ListJobs").WithValues("cloudAccountId", in.CloudAccountId, "clusterId", in.ClusterId).Start()
+	defer span.End()
+	logger.Info("BEGIN")
+	defer logger.Info("END")
+
+	client, err := svc.clusterSlurmClient(ctx, in.ClusterId, in.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := client.ListJobs(ctx)
+	if err != nil {
+		logger.Error(err, "error listing slurm jobs")
+		return nil, err
+	}
+
+	return &pb.SlurmJobListResponse{Jobs: jobs}, nil
+}
+
+func (svc *TrainingClusterServiceServer) GetJob(ctx context.Context, in *pb.SlurmJobRequest) (*pb.SlurmJob, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("TrainingClusterServiceServer.GetJob").WithValues("cloudAccountId", in.CloudAccountId, "clusterId", in.ClusterId, "jobId", in.JobId).Start()
+	defer span.End()
+	logger.Info("BEGIN")
+	defer logger.Info("END")
+
+	client, err := svc.clusterSlurmClient(ctx, in.ClusterId, in.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := client.GetJob(ctx, in.JobId)
+	if err != nil {
+		logger.Error(err, "error getting slurm job")
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (svc *TrainingClusterServiceServer) CancelJob(ctx context.Context, in *pb.SlurmJobRequest) (*emptypb.Empty, error) {
+	ctx, logger, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("TrainingClusterServiceServer.CancelJob").WithValues("cloudAccountId", in.CloudAccountId, "clusterId", in.ClusterId, "jobId", in.JobId).Start()
+	defer span.End()
+	logger.Info("BEGIN")
+	defer logger.Info("END")
+
+	client, err := svc.clusterSlurmClient(ctx, in.ClusterId, in.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.CancelJob(ctx, in.JobId); err != nil {
+		logger.Error(err, "error cancelling slurm job")
+		return nil, err
+	}
+
+	logger.Info("slurm job cancelled")
+
+	return &emptypb.Empty{}, nil
+}
+
+// clusterSlurmClient validates the request and returns a slurmrestd client for a cluster that can accept jobs.
+func (svc *TrainingClusterServiceServer) clusterSlurmClient(ctx context.Context, clusterId, cloudAccountId string) (*slurmrestd.Client, error) {
+	logger := log.FromContext(ctx).WithName("TrainingClusterServiceServer.clusterSlurmClient")
+
+	if svc.session == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database connection found.")
+	}
+
+	// Validate clusterID
+	if _, err := uuid.Parse(clusterId); err != nil {
+		logger.Error(err, "invalid clusterId")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid clusterId provided")
+	}
+
+	// Validate cloudAccounntId
+	if err := cloudaccount.CheckValidId(cloudAccountId); err != nil {
+		logger.Error(err, "invalid cloudAccountId")
+		return nil, err
+	}
+
+	clusterState, err := query.GetClusterState(ctx, svc.session, clusterId, cloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+	// The controller keeps running while compute nodes are added or removed.
+	if clusterState != query.STATE_READY && clusterState != query.STATE_UPDATING {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster is not ready")
+	}
+
+	return svc.slurmClient(ctx, clusterId, cloudAccountId)
+}
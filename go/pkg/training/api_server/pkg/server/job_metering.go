@@ -0,0 +1,167 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log/logkeys"
+	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/database/query"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/slurmrestd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	slurmJobServiceType = "TrainingSlurmJobAsAService"
+	// Must be shorter than MinJobAge in slurm.conf, after which slurmctld forgets completed jobs.
+	defaultJobMeteringInterval = time.Minute
+)
+
+// SlurmJobMeteringReporter reports the usage of each job submitted through the training API once the job has finished.
+type SlurmJobMeteringReporter struct {
+	db             *sql.DB
+	slurmClient    slurmClientFunc
+	meteringClient pb.MeteringServiceClient
+	region         string
+	interval       time.Duration
+}
+
+func NewSlurmJobMeteringReporter(db *sql.DB, slurmClient slurmClientFunc, meteringClient pb.MeteringServiceClient, region string, interval time.Duration) (*SlurmJobMeteringReporter, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	if slurmClient == nil {
+		return nil, fmt.Errorf("slurmClient is required")
+	}
+	if meteringClient == nil {
+		return nil, fmt.Errorf("meteringClient is required")
+	}
+	if interval <= 0 {
+		interval = defaultJobMeteringInterval
+	}
+	return &SlurmJobMeteringReporter{
+		db:             db,
+		slurmClient:    slurmClient,
+		meteringClient: meteringClient,
+		region:         region,
+		interval:       interval,
+	}, nil
+}
+
+// Report usage every interval until the context is cancelled.
+func (r *SlurmJobMeteringReporter) Start(ctx context.Context) {
+	log := log.FromContext(ctx).WithName("SlurmJobMeteringReporter.Start")
+	log.Info("starting slurm job metering reporter", "interval", r.interval)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Report(ctx); err != nil {
+				log.Error(err, "error reporting slurm job usage")
+			}
+		}
+	}
+}
+
+// Report creates a usage record for every job that finished since the previous report.
+func (r *SlurmJobMeteringReporter) Report(ctx context.Context) error {
+	ctx, log, span := obs.LogAndSpanFromContextOrGlobal(ctx).WithName("SlurmJobMeteringReporter.Report").Start()
+	defer span.End()
+	log.Info("BEGIN")
+	defer log.Info("END")
+
+	jobs, err := query.GetUnreportedSlurmJobs(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	clients := map[string]*slurmrestd.Client{}
+	for _, record := range jobs {
+		client, found := clients[record.ClusterId]
+		if !found {
+			client, err = r.slurmClient(ctx, record.ClusterId, record.CloudAccountId)
+			if err != nil {
+				log.Error(err, "error connecting to slurm controller", "clusterId", record.ClusterId)
+				continue
+			}
+			clients[record.ClusterId] = client
+		}
+
+		job, err := client.GetJob(ctx, record.JobId)
+		if status.Code(err) == codes.NotFound {
+			// The job was purged by slurmctld before it could be reported, its usage is lost.
+			log.Error(err, "slurm job purged before its usage was reported, the job is not metered", "clusterId", record.ClusterId, "jobId", record.JobId)
+			job = &pb.SlurmJob{JobId: record.JobId, State: pb.SlurmJobState_JOB_UNKNOWN}
+		} else if err != nil {
+			log.Error(err, "error getting slurm job", "clusterId", record.ClusterId, "jobId", record.JobId)
+			continue
+		} else if !slurmrestd.IsTerminalJobState(job.State) {
+			continue
+		}
+
+		if meteringRecord := r.usageRecord(record, job); meteringRecord != nil {
+			if _, err := r.meteringClient.Create(ctx, meteringRecord); err != nil {
+				log.Error(err, "error creating slurm job metering record", logkeys.ResourceId, meteringRecord.ResourceId)
+				continue
+			}
+			log.Info("Created metering record", logkeys.MeteringRecord, meteringRecord)
+		}
+
+		if err := query.MarkSlurmJobReported(ctx, r.db, record.ClusterId, job); err != nil {
+			log.Error(err, "error marking slurm job reported", "clusterId", record.ClusterId, "jobId", record.JobId)
+		}
+	}
+
+	return nil
+}
+
+// Returns nil when the job never started running.
+func (r *SlurmJobMeteringReporter) usageRecord(record query.SlurmJobRecord, job *pb.SlurmJob) *pb.UsageCreate {
+	if job.StartTime == nil || job.EndTime == nil {
+		return nil
+	}
+	runtime := job.EndTime.AsTime().Sub(job.StartTime.AsTime())
+	if runtime < 0 {
+		runtime = 0
+	}
+	nodes := job.Nodes
+	if nodes == 0 {
+		nodes = 1
+	}
+
+	resourceId := fmt.Sprintf("%s-%d", record.ClusterId, record.JobId)
+	// Each job is reported exactly once, so the transactionId is derived from the job to deduplicate retries.
+	return &pb.UsageCreate{
+		TransactionId:  uuid.NewSHA1(uuid.NameSpaceOID, []byte(resourceId)).String(),
+		ResourceId:     resourceId,
+		CloudAccountId: record.CloudAccountId,
+		Timestamp:      timestamppb.New(job.EndTime.AsTime()),
+		Properties: map[string]string{
+			"clusterId":   record.ClusterId,
+			"jobId":       strconv.FormatUint(uint64(record.JobId), 10),
+			"jobName":     record.Name,
+			"jobState":    job.State.String(),
+			"nodes":       strconv.FormatUint(uint64(nodes), 10),
+			"startTime":   job.StartTime.AsTime().Format(time.RFC3339),
+			"endTime":     job.EndTime.AsTime().Format(time.RFC3339),
+			"serviceType": slurmJobServiceType,
+			"hour":        fmt.Sprintf("%v", runtime.Hours()),
+			"nodeHour":    fmt.Sprintf("%v", runtime.Hours()*float64(nodes)),
+			"region":      r.region,
+		},
+	}
+}
@@ -0,0 +1,56 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/database/query"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSlurmJobUsageRecord(t *testing.T) {
+	reporter := &SlurmJobMeteringReporter{region: "us-dev-1"}
+	record := query.SlurmJobRecord{
+		JobId:          42,
+		ClusterId:      "5a2d5ac4-3b47-4b5e-9d1f-0c8a2e6c7f10",
+		CloudAccountId: "123456789012",
+		Name:           "train",
+	}
+	startTime := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Completed job", func(t *testing.T) {
+		job := &pb.SlurmJob{
+			JobId:     42,
+			State:     pb.SlurmJobState_JOB_COMPLETED,
+			Nodes:     4,
+			StartTime: timestamppb.New(startTime),
+			EndTime:   timestamppb.New(startTime.Add(30 * time.Minute)),
+		}
+		usage := reporter.usageRecord(record, job)
+		assert.NotNil(t, usage)
+		assert.Equal(t, "5a2d5ac4-3b47-4b5e-9d1f-0c8a2e6c7f10-42", usage.ResourceId)
+		assert.LessOrEqual(t, len(usage.ResourceId), 50)
+		assert.Equal(t, "123456789012", usage.CloudAccountId)
+		assert.Equal(t, "0.5", usage.Properties["hour"])
+		assert.Equal(t, "2", usage.Properties["nodeHour"])
+		assert.Equal(t, "JOB_COMPLETED", usage.Properties["jobState"])
+		assert.Equal(t, slurmJobServiceType, usage.Properties["serviceType"])
+		assert.Equal(t, "us-dev-1", usage.Properties["region"])
+
+		// Reporting the same job again must produce the same transaction.
+		assert.Equal(t, usage.TransactionId, reporter.usageRecord(record, job).TransactionId)
+	})
+
+	t.Run("Job cancelled before it started", func(t *testing.T) {
+		job := &pb.SlurmJob{
+			JobId:   42,
+			State:   pb.SlurmJobState_JOB_CANCELLED,
+			EndTime: timestamppb.New(startTime),
+		}
+		assert.Nil(t, reporter.usageRecord(record, job))
+	})
+}
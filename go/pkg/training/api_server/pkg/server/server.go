@@ -133,8 +133,13 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 		return err
 	}
 
+	if cfg.CloudConfig.SlurmRestd.UserName == "" {
+		return fmt.Errorf("slurmrestd user name is required to sign tokens")
+	}
+	slurmClient := newControllerSlurmClientFunc(computeClient, sqlDB, cfg.CloudConfig.SlurmRestd)
+
 	// Create the new armada service client connecting it to the training database for reconciliation
-	trainingClusterSvc, err := NewTrainingClusterService(sqlDB)
+	trainingClusterSvc, err := NewTrainingClusterService(sqlDB, slurmClient, cfg.CloudConfig.MaxComputeNodesPerCluster)
 	if err != nil {
 		return err
 	}
@@ -143,6 +148,18 @@ func (svc *Service) Init(ctx context.Context, cfg *Config, resolver grpcutil.Res
 
 	if armadaEnabled {
 		v1.RegisterTrainingClusterServiceServer(grpcServer, trainingClusterSvc)
+
+		// create client for metering access in global cluster
+		meteringClient, err := batchSvc.NewMeteringClient(ctx, resolver)
+		if err != nil {
+			return err
+		}
+		jobMeteringReporter, err := NewSlurmJobMeteringReporter(sqlDB, slurmClient, meteringClient, cfg.CloudConfig.IDC.Region,
+			time.Duration(cfg.CloudConfig.JobMeteringIntervalSeconds)*time.Second)
+		if err != nil {
+			return err
+		}
+		go jobMeteringReporter.Start(ctx)
 	}
 
 	// Register reflection service on gRPC server.
@@ -0,0 +1,53 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/database/query"
+	idcComputeSvc "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/idc_compute"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/slurmrestd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// slurmClientFunc returns a client for slurmrestd on the controller node of the cluster.
+type slurmClientFunc func(ctx context.Context, clusterId, cloudAccountId string) (*slurmrestd.Client, error)
+
+// newControllerSlurmClientFunc looks up the address of the first controller node of a cluster through the compute API.
+// Requests are signed with the jwt key generated for the cluster when it was provisioned.
+func newControllerSlurmClientFunc(computeClient *idcComputeSvc.IDCServiceClient, dbconn *sql.DB, cfg config.SlurmRestdConfig) slurmClientFunc {
+	return func(ctx context.Context, clusterId, cloudAccountId string) (*slurmrestd.Client, error) {
+		logger := log.FromContext(ctx).WithName("slurmClient")
+
+		controllerName := common.SlurmControllerInstanceName(clusterId, 1)
+		instance, err := computeClient.GetInstanceByName(ctx, controllerName, cloudAccountId)
+		if err != nil {
+			logger.Error(err, "error getting slurm controller instance", "instance", controllerName)
+			return nil, status.Errorf(codes.Unavailable, "slurm controller is unavailable")
+		}
+
+		interfaces := instance.GetStatus().GetInterfaces()
+		if len(interfaces) == 0 || len(interfaces[0].GetAddresses()) == 0 {
+			logger.Info("no network address found for slurm controller", "instance", controllerName)
+			return nil, status.Errorf(codes.Unavailable, "slurm controller is unavailable")
+		}
+
+		jwtKey, err := query.GetClusterSlurmRestdJWTKey(ctx, dbconn, clusterId, cloudAccountId)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := slurmrestd.NewClient(slurmrestd.Endpoint(interfaces[0].GetAddresses()[0], cfg), cfg, jwtKey)
+		if err != nil {
+			logger.Error(err, "error creating slurmrestd client")
+			return nil, status.Errorf(codes.Internal, "error connecting to slurm controller")
+		}
+		return client, nil
+	}
+}
@@ -240,10 +240,15 @@ type SlurmdCloudConfig struct {
 }
 
 type SlurmctldCloudConfig struct {
-	Common              *CommonCloudConfigs
-	NodeName            string
-	PartitionName       string
+	Common        *CommonCloudConfigs
+	NodeName      string
+	PartitionName string
+	// Compute nodes that clusters can be resized up to, declared in FUTURE state.
+	FutureNodeName      string
 	ComputeHostInstance string
+	SlurmRestdPort      uint16
+	// Base64 encoded key used by slurmctld to validate slurmrestd JWT tokens.
+	JWTKey string
 }
 
 type LoginCloudConfig struct {
@@ -4,6 +4,7 @@ package config
 
 import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/manageddb"
+	trainingConfig "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/config"
 )
 
 type Config struct {
@@ -18,6 +19,8 @@ type SchedulerGlobalConfig struct {
 	MaxNodes          uint16 `koanf:"maxNodesPerAccount"`
 	MaxVNets          uint16 `koanf:"maxVnetsPerAccount"`
 	MaxStorage        uint16 `koanf:"maxStorageSizePerAccount"`
+	// Compute nodes declared in slurm.conf beyond the requested ones so that clusters can be resized.
+	MaxComputeNodes uint16 `koanf:"maxComputeNodesPerCluster"`
 }
 
 type SchedulerCloudProviderConfig struct {
@@ -31,6 +34,7 @@ type SchedulerCloudProviderConfig struct {
 		AvailabilityZone          string `koanf:"availabilityZone"`
 		Region                    string `koanf:"region"`
 	} `koanf:"idc"`
+	SlurmRestd trainingConfig.SlurmRestdConfig `koanf:"slurmRestd"`
 }
 
 var Cfg *Config
@@ -0,0 +1,288 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flosch/pongo2"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	cloudGen "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/armada/pkg/cloud_init"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/common"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/database/query"
+	idcComputeSvc "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/idc_compute"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/slurmrestd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Time after a cluster delete request during which the cluster is kept until its jobs are reported.
+const jobReportGracePeriod = 10 * time.Minute
+
+// DeleteCluster deletes the instances and storage of the next cluster marked for deletion, then removes the cluster
+// from the database. Clusters that cannot be fully deleted stay DELETING and are retried on the next scan.
+func (clusterSchd *ClusterProvisionScheduler) DeleteCluster(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("ClusterProvisionScheduler.DeleteCluster")
+	logger.Info("entering a new cluster delete request scan")
+	defer logger.Info("returning from cluster delete request scan")
+
+	clusterReq, err := query.GetNextClusterDeleteRequest(ctx, clusterSchd.db)
+	if err != nil {
+		logger.Error(err, "error reading cluster delete request")
+		return
+	}
+	if clusterReq == nil {
+		logger.Info("cluster delete request not found, returning from reconciler")
+		return
+	}
+
+	logger.Info("new cluster delete request", "clusterId", clusterReq.ClusterId, "cloudAccountId", clusterReq.CloudAccountId)
+
+	// The usage of jobs is read from the controller node, so the controller is kept until the jobs are reported.
+	unreportedJobs, deleteRequestedAt, err := query.CountUnreportedSlurmJobs(ctx, clusterSchd.db, clusterReq.ClusterId, clusterReq.CloudAccountId)
+	if err != nil {
+		logger.Error(err, "error counting unreported slurm jobs")
+		return
+	}
+	if unreportedJobs > 0 {
+		if time.Since(deleteRequestedAt) < jobReportGracePeriod {
+			logger.Info("waiting for slurm jobs to be reported", "clusterId", clusterReq.ClusterId, "unreportedJobs", unreportedJobs)
+			return
+		}
+		logger.Error(fmt.Errorf("%d slurm jobs were not reported", unreportedJobs), "deleting cluster with unmetered slurm jobs", "clusterId", clusterReq.ClusterId)
+	}
+
+	deleteFailed := false
+	for _, instanceName := range clusterInstanceNames(clusterReq) {
+		if err := clusterSchd.deleteInstance(ctx, instanceName, clusterReq.CloudAccountId); err != nil {
+			logger.Error(err, "error deleting instance", "instance name", instanceName)
+			deleteFailed = true
+		}
+	}
+
+	for _, storageNode := range clusterReq.GetStorageNodes() {
+		if err := clusterSchd.StorageClient.DeleteStorage(ctx, storageNode.GetName(), clusterReq.CloudAccountId); err != nil && status.Code(err) != codes.NotFound {
+			logger.Error(err, "error deleting storage", "storage name", storageNode.GetName())
+			deleteFailed = true
+			continue
+		}
+
+		if err := query.DeleteStorageNodePrivate(ctx, clusterSchd.db, storageNode.GetName(), clusterReq.CloudAccountId); err != nil {
+			logger.Error(err, "error deleting storage node from the database", "storage name", storageNode.GetName())
+			deleteFailed = true
+		}
+	}
+
+	if deleteFailed {
+		logger.Info("cluster delete incomplete, retrying on the next scan", "clusterId", clusterReq.ClusterId)
+		return
+	}
+
+	if err := query.DeleteAllClusterNodeInstancesPrivate(ctx, clusterSchd.db, clusterReq.ClusterId, clusterReq.CloudAccountId); err != nil {
+		logger.Error(err, "error deleting cluster nodes from the database")
+		return
+	}
+
+	if err := query.DeleteClusterPrivate(ctx, clusterSchd.db, clusterReq.ClusterId, clusterReq.CloudAccountId); err != nil {
+		logger.Error(err, "error deleting cluster from the database")
+		return
+	}
+
+	logger.Info("cluster deleted successfully", "clusterId", clusterReq.ClusterId)
+}
+
+// ResizeCluster creates the instances of REQUESTED compute nodes and deletes the instances of DELETING compute nodes
+// of the next cluster being resized. Compute node instances are named by their position in the cluster, so new nodes
+// take the names declared as FUTURE nodes in slurm.conf when the cluster was provisioned.
+func (clusterSchd *ClusterProvisionScheduler) ResizeCluster(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("ClusterProvisionScheduler.ResizeCluster")
+	logger.Info("entering a new cluster resize request scan")
+	defer logger.Info("returning from cluster resize request scan")
+
+	clusterReq, computeNodes, err := query.GetNextClusterResizeRequest(ctx, clusterSchd.db)
+	if err != nil {
+		logger.Error(err, "error reading cluster resize request")
+		return
+	}
+	if clusterReq == nil {
+		logger.Info("cluster resize request not found, returning from reconciler")
+		return
+	}
+
+	logger.Info("new cluster resize request", "clusterId", clusterReq.ClusterId, "cloudAccountId", clusterReq.CloudAccountId)
+
+	if err := clusterSchd.resizeComputeNodes(ctx, clusterReq, computeNodes); err != nil {
+		logger.Error(err, "error resizing cluster")
+		if err := query.UpdateClusterState(ctx, clusterSchd.db, clusterReq.ClusterId, clusterReq.CloudAccountId, query.STATE_FAILED); err != nil {
+			logger.Error(err, "error updating cluster state to 'FAILED'")
+		}
+		return
+	}
+
+	if err := query.UpdateClusterState(ctx, clusterSchd.db, clusterReq.ClusterId, clusterReq.CloudAccountId, query.STATE_READY); err != nil {
+		logger.Error(err, "error updating cluster state to 'READY'")
+		return
+	}
+
+	logger.Info("cluster resized successfully", "clusterId", clusterReq.ClusterId)
+}
+
+func (clusterSchd *ClusterProvisionScheduler) resizeComputeNodes(ctx context.Context, clusterReq *pb.Cluster, computeNodes []query.ClusterComputeNode) error {
+	logger := log.FromContext(ctx).WithName("ClusterProvisionScheduler.resizeComputeNodes")
+
+	slurmClient, err := clusterSchd.controllerSlurmClient(ctx, clusterReq)
+	if err != nil {
+		return err
+	}
+
+	requestedNodes := map[int]query.ClusterComputeNode{}
+	for idx, node := range computeNodes {
+		instanceName := common.SlurmComputeInstanceName(clusterReq.ClusterId, idx+1)
+
+		switch node.Status {
+		case query.STATE_REQUESTED:
+			requestedNodes[idx+1] = node
+		case query.STATE_DELETING:
+			// Running jobs on the node are requeued by slurmctld once the node is gone.
+			if err := slurmClient.UpdateNodeState(ctx, instanceName, slurmrestd.NodeStateDrain, "node removed from cluster"); err != nil {
+				return fmt.Errorf("error draining slurm node %s: %w", instanceName, err)
+			}
+			if err := clusterSchd.deleteInstance(ctx, instanceName, clusterReq.CloudAccountId); err != nil {
+				return fmt.Errorf("error deleting instance %s: %w", instanceName, err)
+			}
+			if err := slurmClient.UpdateNodeState(ctx, instanceName, slurmrestd.NodeStateFuture, ""); err != nil {
+				return fmt.Errorf("error updating slurm node %s: %w", instanceName, err)
+			}
+			if err := query.DeleteClusterNodePrivate(ctx, clusterSchd.db, node.NodeId, clusterReq.CloudAccountId); err != nil {
+				return err
+			}
+			logger.Info("compute node removed", "instance name", instanceName)
+		}
+	}
+
+	if len(requestedNodes) == 0 {
+		return nil
+	}
+
+	serializedSlurmdUserData, err := clusterSchd.renderSlurmdCloudConfig(ctx, clusterReq)
+	if err != nil {
+		return err
+	}
+
+	instanceReq := []idcComputeSvc.InstanceCreateRequest{}
+	for instIdx, node := range requestedNodes {
+		clusterNode := &pb.ClusterNode{
+			MachineType: node.MachineType,
+			ImageType:   node.ImageType,
+		}
+		instanceReq = append(instanceReq, getSlurmComputeNodeSpecs(clusterNode, clusterReq.GetName(), clusterReq.ClusterId, clusterReq.CloudAccountId, instIdx, clusterReq.GetSSHKeyName(), serializedSlurmdUserData))
+	}
+
+	logger.Info("instance request", "creating slurmd instance", instanceReq)
+	if err := clusterSchd.CreateClusterInstances(ctx, instanceReq); err != nil {
+		return err
+	}
+
+	for _, node := range requestedNodes {
+		if err := query.UpdateClusterNodeState(ctx, clusterSchd.db, node.NodeId, clusterReq.CloudAccountId, query.STATE_READY); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderSlurmdCloudConfig renders the cloud config of compute nodes added to an existing cluster.
+func (clusterSchd *ClusterProvisionScheduler) renderSlurmdCloudConfig(ctx context.Context, clusterReq *pb.Cluster) (string, error) {
+	localStorageMountDirs := map[string]string{}
+	remoteStorageMountDirs := map[string]string{}
+	storageFilesystems := []*pb.FilesystemPrivate{}
+
+	for _, storageNode := range clusterReq.GetStorageNodes() {
+		storageReq := getSlurmStorageNodeSpecs(storageNode, clusterReq.ClusterId, clusterReq.CloudAccountId, clusterSchd.StorageClient.AvailabilityZone)
+		readyStorageFS, err := clusterSchd.StorageClient.GetStorageStatusByName(ctx, storageReq)
+		if err != nil {
+			return "", err
+		}
+
+		storageFilesystems = append(storageFilesystems, readyStorageFS)
+		localStorageMountDirs[storageNode.GetFsResourceId()] = storageNode.GetLocalMountDir()
+		remoteStorageMountDirs[storageNode.GetFsResourceId()] = storageNode.GetRemoteMountDir()
+	}
+
+	commonCloudCfg := cloudGen.CommonCloudConfigs{
+		ClusterId: clusterReq.ClusterId,
+	}
+	if err := commonCloudCfg.RenderCommonCloudConfigs(ctx, storageFilesystems, localStorageMountDirs, remoteStorageMountDirs); err != nil {
+		return "", err
+	}
+
+	slurmdNodeConfig := cloudGen.SlurmdCloudConfig{Common: &commonCloudCfg}
+	return cloudGen.RenderCloudConfig(ctx, cloudGen.SLURMD_CLOUDINIT_TMPL_FILENAME, pongo2.Context{"Values": slurmdNodeConfig})
+}
+
+func (clusterSchd *ClusterProvisionScheduler) controllerSlurmClient(ctx context.Context, clusterReq *pb.Cluster) (*slurmrestd.Client, error) {
+	controllerName := common.SlurmControllerInstanceName(clusterReq.ClusterId, 1)
+	instance, err := clusterSchd.ComputeClient.GetInstanceByName(ctx, controllerName, clusterReq.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := instance.GetStatus().GetInterfaces()
+	if len(interfaces) == 0 || len(interfaces[0].GetAddresses()) == 0 {
+		return nil, fmt.Errorf("no network address found for instance %s", controllerName)
+	}
+
+	jwtKey, err := query.GetClusterSlurmRestdJWTKey(ctx, clusterSchd.db, clusterReq.ClusterId, clusterReq.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	return slurmrestd.NewClient(slurmrestd.Endpoint(interfaces[0].GetAddresses()[0], clusterSchd.slurmRestdCfg), clusterSchd.slurmRestdCfg, jwtKey)
+}
+
+func (clusterSchd *ClusterProvisionScheduler) slurmRestdPort() uint16 {
+	if clusterSchd.slurmRestdCfg.Port == 0 {
+		return slurmrestd.DefaultPort
+	}
+	return clusterSchd.slurmRestdCfg.Port
+}
+
+// deleteInstance deletes an instance, ignoring instances that no longer exist.
+func (clusterSchd *ClusterProvisionScheduler) deleteInstance(ctx context.Context, instanceName, cloudAccountId string) error {
+	if err := clusterSchd.ComputeClient.DeleteInstance(ctx, instanceName, cloudAccountId); err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+	return nil
+}
+
+// clusterInstanceNames returns the names of all instances of a cluster, following the naming used by ProvisionCluster.
+func clusterInstanceNames(clusterReq *pb.Cluster) []string {
+	clusterReqCounts := map[pb.NodeRole]int{}
+	for _, node := range clusterReq.GetNodes() {
+		clusterReqCounts[node.Role]++
+	}
+
+	instanceNames := []string{}
+	instIdx := 1
+	for count := 0; count < clusterReqCounts[pb.NodeRole_CONTROLLER_NODE]; count++ {
+		instanceNames = append(instanceNames, common.SlurmControllerInstanceName(clusterReq.ClusterId, instIdx))
+		instIdx++
+	}
+	for count := 0; count < clusterReqCounts[pb.NodeRole_JUPYTERHUB_NODE]; count++ {
+		instanceNames = append(instanceNames, common.SlurmJupyterhubInstanceName(clusterReq.ClusterId, instIdx))
+		instIdx++
+	}
+	for count := 0; count < clusterReqCounts[pb.NodeRole_LOGIN_NODE]; count++ {
+		instanceNames = append(instanceNames, common.SlurmLoginInstanceName(clusterReq.ClusterId, instIdx))
+		instIdx++
+	}
+	for computeIdx := 1; computeIdx <= clusterReqCounts[pb.NodeRole_COMPUTE_NODE]; computeIdx++ {
+		instanceNames = append(instanceNames, common.SlurmComputeInstanceName(clusterReq.ClusterId, computeIdx))
+	}
+
+	return instanceNames
+}
@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"fmt"
 	"os"
 	"time"
@@ -20,12 +21,14 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/database/query"
 	idcComputeSvc "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/idc_compute"
 	idcStorageSvc "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/idc_storage"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/slurmrestd"
 )
 
 type ClusterProvisionScheduler struct {
 	syncTicker    *time.Ticker
 	db            *sql.DB
 	globalCfg     *config.SchedulerGlobalConfig
+	slurmRestdCfg trainingConfig.SlurmRestdConfig
 	ComputeClient *idcComputeSvc.IDCServiceClient
 	StorageClient *idcStorageSvc.IDCStorageServiceClient
 }
@@ -40,6 +43,9 @@ func NewClusterProvisionScheduler(ctx context.Context, db *sql.DB, cfg *config.C
 	if db == nil {
 		return nil, fmt.Errorf("db is requied")
 	}
+	if cfg.CloudConfig.SlurmRestd.UserName == "" {
+		return nil, fmt.Errorf("slurmrestd user name is required to sign tokens")
+	}
 	idcConfig := trainingConfig.IdcConfig{
 		ComputeGrpcAPIEndpoint:    cfg.CloudConfig.IDC.ComputeGrpcAPIEndpoint,
 		FoundationGrpcAPIEndpoint: cfg.CloudConfig.IDC.FoundationGrpcAPIEndpoint,
@@ -64,6 +70,7 @@ func NewClusterProvisionScheduler(ctx context.Context, db *sql.DB, cfg *config.C
 		syncTicker:    time.NewTicker(time.Duration(cfg.ClusterConfig.SchedulerInterval) * time.Second),
 		db:            db,
 		globalCfg:     &cfg.ClusterConfig,
+		slurmRestdCfg: cfg.CloudConfig.SlurmRestd,
 		ComputeClient: computeClient,
 		StorageClient: storageClient,
 	}, nil
@@ -81,6 +88,8 @@ func (clusterSchd *ClusterProvisionScheduler) ProvisionLoop(ctx context.Context)
 	log.Info("debug", "global config", clusterSchd.globalCfg)
 	for {
 		clusterSchd.ProvisionCluster(ctx)
+		clusterSchd.ResizeCluster(ctx)
+		clusterSchd.DeleteCluster(ctx)
 		tm := <-clusterSchd.syncTicker.C
 		if tm.IsZero() {
 			return
@@ -214,10 +223,27 @@ func (clusterSchd *ClusterProvisionScheduler) ProvisionCluster(ctx context.Conte
 		return
 	}
 
+	// Every cluster gets its own key, it is stored before the controller is created so that a retried request
+	// provisions the controller with the key that requests are signed with.
+	jwtKey, err := slurmrestd.NewJWTKey()
+	if err == nil {
+		jwtKey, err = query.StoreClusterSlurmRestdJWTKey(ctx, clusterSchd.db, clusterReq.ClusterId, clusterReq.CloudAccountId, jwtKey)
+	}
+	if err != nil {
+		logger.Error(err, "error storing slurmrestd jwt key")
+		clusterSchd.processProvisionerTeardown(ctx, clusterReq, instanceCreated, storageReq)
+		return
+	}
+
 	slurmctldNodeConfig := cloudGen.SlurmctldCloudConfig{
-		Common:        &commonCloudCfg,
-		NodeName:      fmt.Sprintf("%s-slurmd-[1-%d]", clusterReq.ClusterId, clusterReqCounts[pb.NodeRole_COMPUTE_NODE]),
-		PartitionName: clusterReq.ClusterId,
+		Common:         &commonCloudCfg,
+		NodeName:       fmt.Sprintf("%s-slurmd-[1-%d]", clusterReq.ClusterId, clusterReqCounts[pb.NodeRole_COMPUTE_NODE]),
+		PartitionName:  clusterReq.ClusterId,
+		SlurmRestdPort: clusterSchd.slurmRestdPort(),
+		JWTKey:         base64.StdEncoding.EncodeToString(jwtKey),
+	}
+	if maxComputeNodes := int(clusterSchd.globalCfg.MaxComputeNodes); maxComputeNodes > clusterReqCounts[pb.NodeRole_COMPUTE_NODE] {
+		slurmctldNodeConfig.FutureNodeName = fmt.Sprintf("%s-slurmd-[%d-%d]", clusterReq.ClusterId, clusterReqCounts[pb.NodeRole_COMPUTE_NODE]+1, maxComputeNodes)
 	}
 	serializedSlurmctldUserData, err := cloudGen.RenderSlurmctldCloudConfig(ctx, &slurmctldNodeConfig, []*pb.Instance{})
 	if err != nil {
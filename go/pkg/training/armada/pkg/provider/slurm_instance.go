@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	v1 "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/common"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/idc_compute"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/idc_storage"
 )
@@ -45,7 +46,7 @@ func getSlurmJupyterHubNodeLabels() map[string]string {
 *******************************************************/
 func getSlurmLoginNodeSpecs(node *v1.ClusterNode, vnet, clusterId, cloudAccountId string, instIdx int, keys []string, loginCloudCfgUserData string) idc_compute.InstanceCreateRequest {
 	return idc_compute.InstanceCreateRequest{
-		Name:           fmt.Sprintf("%s-login-%d", clusterId, instIdx),
+		Name:           common.SlurmLoginInstanceName(clusterId, instIdx),
 		ClusterId:      clusterId,
 		CloudAccountId: cloudAccountId,
 		VNet:           vnet,
@@ -59,7 +60,7 @@ func getSlurmLoginNodeSpecs(node *v1.ClusterNode, vnet, clusterId, cloudAccountI
 
 func getSlurmComputeNodeSpecs(node *v1.ClusterNode, vnet, clusterId, cloudAccountId string, instIdx int, keys []string, slurmdCloudCfgUserData string) idc_compute.InstanceCreateRequest {
 	return idc_compute.InstanceCreateRequest{
-		Name:           fmt.Sprintf("%s-slurmd-%d", clusterId, instIdx),
+		Name:           common.SlurmComputeInstanceName(clusterId, instIdx),
 		ClusterId:      clusterId,
 		CloudAccountId: cloudAccountId,
 		VNet:           vnet,
@@ -73,7 +74,7 @@ func getSlurmComputeNodeSpecs(node *v1.ClusterNode, vnet, clusterId, cloudAccoun
 
 func getSlurmJupyterhubNodeSpecs(node *v1.ClusterNode, vnet, clusterId, cloudAccountId string, instIdx int, keys []string, jupyterhubCloudCfgUserData string) idc_compute.InstanceCreateRequest {
 	return idc_compute.InstanceCreateRequest{
-		Name:           fmt.Sprintf("%s-jupyterhub-%d", clusterId, instIdx),
+		Name:           common.SlurmJupyterhubInstanceName(clusterId, instIdx),
 		ClusterId:      clusterId,
 		CloudAccountId: cloudAccountId,
 		VNet:           vnet,
@@ -87,7 +88,7 @@ func getSlurmJupyterhubNodeSpecs(node *v1.ClusterNode, vnet, clusterId, cloudAcc
 
 func getSlurmControllerNodeSpecs(node *v1.ClusterNode, vnet, clusterId, cloudAccountId string, instIdx int, keys []string, slurmctldCloudCfgUserData string) idc_compute.InstanceCreateRequest {
 	return idc_compute.InstanceCreateRequest{
-		Name:           fmt.Sprintf("%s-slurmctld-%d", clusterId, instIdx),
+		Name:           common.SlurmControllerInstanceName(clusterId, instIdx),
 		ClusterId:      clusterId,
 		CloudAccountId: cloudAccountId,
 		VNet:           vnet,
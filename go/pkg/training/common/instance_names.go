@@ -0,0 +1,23 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package common
+
+import "fmt"
+
+// Instance names of slurm cluster nodes. Instance indexes start at 1.
+
+func SlurmControllerInstanceName(clusterId string, instIdx int) string {
+	return fmt.Sprintf("%s-slurmctld-%d", clusterId, instIdx)
+}
+
+func SlurmComputeInstanceName(clusterId string, instIdx int) string {
+	return fmt.Sprintf("%s-slurmd-%d", clusterId, instIdx)
+}
+
+func SlurmLoginInstanceName(clusterId string, instIdx int) string {
+	return fmt.Sprintf("%s-login-%d", clusterId, instIdx)
+}
+
+func SlurmJupyterhubInstanceName(clusterId string, instIdx int) string {
+	return fmt.Sprintf("%s-jupyterhub-%d", clusterId, instIdx)
+}
@@ -3,10 +3,23 @@
 package config
 
 type BatchServiceConfig struct {
-	IDC                            IdcConfig `koanf:"idc"`
-	SlurmBatchServiceEndpoint      string    `koanf:"slurmBatchService"`
-	SlurmJupyterhubServiceEndpoint string    `koanf:"slurmJupyterhubService"`
-	SlurmSSHServiceEndpoint        string    `koanf:"slurmSSHService"`
+	IDC                            IdcConfig        `koanf:"idc"`
+	SlurmBatchServiceEndpoint      string           `koanf:"slurmBatchService"`
+	SlurmJupyterhubServiceEndpoint string           `koanf:"slurmJupyterhubService"`
+	SlurmSSHServiceEndpoint        string           `koanf:"slurmSSHService"`
+	SlurmRestd                     SlurmRestdConfig `koanf:"slurmRestd"`
+	// Largest number of compute nodes a cluster can be resized to.
+	MaxComputeNodesPerCluster uint32 `koanf:"maxComputeNodesPerCluster"`
+	// How often completed slurm jobs are reported to the metering service.
+	JobMeteringIntervalSeconds uint32 `koanf:"jobMeteringIntervalSeconds"`
+}
+
+// Connection settings for the slurmrestd daemon running on the controller node of each cluster.
+type SlurmRestdConfig struct {
+	Port       uint16 `koanf:"port"`
+	APIVersion string `koanf:"apiVersion"`
+	// Slurm user that jobs are submitted and cancelled as, requests are signed with the jwt key of each cluster.
+	UserName string `koanf:"userName"`
 }
 
 type IdcConfig struct {
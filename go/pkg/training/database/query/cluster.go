@@ -122,6 +122,66 @@ const (
 		WHERE cluster_id = $1 AND cloud_account_id = $2
 	`
 
+	getClusterStatusById = `
+		SELECT status
+		FROM cluster
+		WHERE cluster_id = $1 AND cloud_account_id = $2
+	`
+
+	setClusterSlurmRestdJWTKey = `
+		UPDATE cluster SET slurmrestd_jwt_key = COALESCE(slurmrestd_jwt_key, $1)
+		WHERE cluster_id = $2 AND cloud_account_id = $3
+		RETURNING slurmrestd_jwt_key
+	`
+
+	getClusterSlurmRestdJWTKey = `
+		SELECT slurmrestd_jwt_key
+		FROM cluster
+		WHERE cluster_id = $1 AND cloud_account_id = $2
+	`
+
+	getClusterStatusForUpdate = `
+		SELECT status
+		FROM cluster
+		WHERE cluster_id = $1 AND cloud_account_id = $2
+		FOR UPDATE
+	`
+
+	getClusterComputeNodes = `
+		SELECT n.node_id, n.labels, n.machine_type, n.image, n.status
+		FROM node as n, cluster_node_mapping as cn, cluster as c
+		WHERE n.id = cn.node_id AND
+		cn.cluster_id = c.id AND
+		c.cluster_id = $1 AND
+		n.node_role = 'SLURM_COMPUTE_NODE'
+		ORDER BY n.id
+	`
+
+	getNextClusterInState = `
+		SELECT cluster_id, cloud_account_id
+		FROM cluster
+		WHERE status = $1
+		ORDER BY updated_at
+		LIMIT 1
+	`
+
+	deleteClusterJobs = `
+		DELETE FROM slurm_job WHERE cluster_id = $1 AND cloud_account_id = $2
+	`
+
+	deleteClusterVnetSpec = `
+		DELETE FROM vnet_spec WHERE cluster_id = $1 AND cloud_account_id = $2
+	`
+
+	deleteClusterStorageMapping = `
+		DELETE FROM cluster_storage_mapping
+		WHERE cluster_id IN (SELECT id FROM cluster WHERE cluster_id = $1 AND cloud_account_id = $2)
+	`
+
+	deleteCluster = `
+		DELETE FROM cluster WHERE cluster_id = $1 AND cloud_account_id = $2
+	`
+
 	getNextClusterRequest = `
 		SELECT c.cluster_id, c.cloud_account_id, c.name, c.ssh_key_names, c.requested_at, c.updated_at
 		FROM cluster as c
@@ -135,9 +195,21 @@ const (
 	STATE_PROVISIONING = "PROVISIONING"
 	STATE_FAILED       = "FAILED"
 	STATE_READY        = "READY"
+	STATE_UPDATING     = "UPDATING"
+	STATE_DELETING     = "DELETING"
 	STATE_SLURM        = "SLURMSTATIC"
 )
 
+// ClusterComputeNode is a compute node of a cluster. Compute nodes are returned in creation order,
+// which is also the order of their instance index.
+type ClusterComputeNode struct {
+	NodeId      string
+	Labels      map[string]string
+	MachineType pb.MachineType
+	ImageType   string
+	Status      string
+}
+
 func CreateClusterState(ctx context.Context, dbconn *sql.DB, clusterId string, cluster *v1.SlurmClusterCreateRequest) error {
 	logger := log.FromContext(ctx).WithName("CreateClusterState")
 	tx, err := dbconn.BeginTx(ctx, nil)
@@ -356,6 +428,27 @@ func mapMachineType(macType pb.MachineType) string {
 	}
 }
 
+func marshalClusterStateToPb(state string) pb.ClusterState {
+	switch state {
+	case "REQUESTED":
+		return pb.ClusterState_REQUESTED
+	case "ACCEPTED":
+		return pb.ClusterState_ACCEPTED
+	case "PROVISIONING":
+		return pb.ClusterState_PROVISIONING
+	case "FAILED":
+		return pb.ClusterState_FAILED
+	case "READY":
+		return pb.ClusterState_READY
+	case "UPDATING":
+		return pb.ClusterState_UPDATING
+	case "DELETING":
+		return pb.ClusterState_DELETING
+	default:
+		return pb.ClusterState_UNKNOWN
+	}
+}
+
 func UpdateClusterState(ctx context.Context, dbconn *sql.DB, clusterId, accountId, status string) error {
 	currTime := time.Now()
 	logger := log.FromContext(ctx).WithName("UpdateClusterState")
@@ -470,6 +563,12 @@ func GetClusterByID(ctx context.Context, dbconn *sql.DB, req *v1.SlurmClusterReq
 		cl.ClusterId = req.GetClusterId()
 	}
 
+	clusterState, err := GetClusterState(ctx, dbconn, cl.ClusterId, cl.CloudAccountId)
+	if err != nil {
+		return nil, err
+	}
+	cl.ClusterState = marshalClusterStateToPb(clusterState)
+
 	specRows, err := dbconn.QueryContext(ctx, getClusterVnetSpec, cl.ClusterId)
 	if err != nil {
 		logger.Error(err, "error reading vnet spec")
@@ -565,3 +664,299 @@ func GetClustersByCloudAccount(ctx context.Context, dbconn *sql.DB, req *v1.Clus
 
 	return &clusters, nil
 }
+
+// GetClusterState returns the state of the cluster or a NotFound status if the cluster does not exist.
+func GetClusterState(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string) (string, error) {
+	logger := log.FromContext(ctx).WithName("GetClusterState")
+	var clusterState string
+
+	switch err := dbconn.QueryRowContext(ctx, getClusterStatusById, clusterId, cloudAccountId).Scan(&clusterState); {
+	case err == sql.ErrNoRows:
+		return "", status.Errorf(codes.NotFound, "no matching records found")
+	case err != nil:
+		logger.Error(err, "error querying cluster state")
+		return "", status.Errorf(codes.Internal, "error querying cluster state")
+	}
+
+	return clusterState, nil
+}
+
+// StoreClusterSlurmRestdJWTKey stores the key that slurmctld of the cluster validates slurmrestd tokens with, unless
+// the cluster already has one, and returns the key of the cluster. A retried provisioning keeps the first key.
+func StoreClusterSlurmRestdJWTKey(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string, key []byte) ([]byte, error) {
+	logger := log.FromContext(ctx).WithName("StoreClusterSlurmRestdJWTKey")
+	var clusterKey []byte
+
+	switch err := dbconn.QueryRowContext(ctx, setClusterSlurmRestdJWTKey, key, clusterId, cloudAccountId).Scan(&clusterKey); {
+	case err == sql.ErrNoRows:
+		return nil, status.Errorf(codes.NotFound, "no matching records found")
+	case err != nil:
+		logger.Error(err, "error storing cluster slurmrestd jwt key")
+		return nil, status.Errorf(codes.Internal, "error storing cluster slurmrestd jwt key")
+	}
+
+	return clusterKey, nil
+}
+
+// GetClusterSlurmRestdJWTKey returns the key that slurmrestd tokens of the cluster are signed with, a NotFound status
+// if the cluster does not exist, or a FailedPrecondition status if the cluster was provisioned without a key.
+func GetClusterSlurmRestdJWTKey(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string) ([]byte, error) {
+	logger := log.FromContext(ctx).WithName("GetClusterSlurmRestdJWTKey")
+	var clusterKey []byte
+
+	switch err := dbconn.QueryRowContext(ctx, getClusterSlurmRestdJWTKey, clusterId, cloudAccountId).Scan(&clusterKey); {
+	case err == sql.ErrNoRows:
+		return nil, status.Errorf(codes.NotFound, "no matching records found")
+	case err != nil:
+		logger.Error(err, "error querying cluster slurmrestd jwt key")
+		return nil, status.Errorf(codes.Internal, "error querying cluster slurmrestd jwt key")
+	}
+	if len(clusterKey) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "slurm controller of the cluster does not accept jobs")
+	}
+
+	return clusterKey, nil
+}
+
+// DeleteClusterRequest marks the cluster for deletion by the cluster reconciler.
+func DeleteClusterRequest(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string) error {
+	logger := log.FromContext(ctx).WithName("DeleteClusterRequest")
+	tx, err := dbconn.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(err, "error begin transaction data")
+		return status.Errorf(codes.Internal, "error deleting cluster")
+	}
+	defer tx.Rollback()
+
+	clusterState, err := getClusterStateForUpdate(ctx, tx, clusterId, cloudAccountId)
+	if err != nil {
+		return err
+	}
+
+	switch clusterState {
+	case STATE_DELETING:
+		// Delete is idempotent
+		return nil
+	case STATE_REQUESTED, STATE_READY, STATE_FAILED:
+	default:
+		return status.Errorf(codes.FailedPrecondition, "cluster cannot be deleted while it is %s", clusterState)
+	}
+
+	if _, err := tx.ExecContext(ctx, updateClusterState, STATE_DELETING, time.Now(), clusterId, cloudAccountId); err != nil {
+		logger.Error(err, "error updating cluster state")
+		return status.Errorf(codes.Internal, "error deleting cluster")
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing transaction")
+		return status.Errorf(codes.Internal, "error deleting cluster")
+	}
+	return nil
+}
+
+// ResizeClusterRequest adds or removes compute nodes so that the cluster has computeNodeCount compute nodes.
+// New nodes are created in the REQUESTED state and removed nodes are marked DELETING. The cluster remains
+// UPDATING until the cluster reconciler has created or deleted their instances.
+func ResizeClusterRequest(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string, computeNodeCount int) error {
+	logger := log.FromContext(ctx).WithName("ResizeClusterRequest")
+	tx, err := dbconn.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(err, "error begin transaction data")
+		return status.Errorf(codes.Internal, "error resizing cluster")
+	}
+	defer tx.Rollback()
+
+	clusterState, err := getClusterStateForUpdate(ctx, tx, clusterId, cloudAccountId)
+	if err != nil {
+		return err
+	}
+	if clusterState != STATE_READY {
+		return status.Errorf(codes.FailedPrecondition, "cluster cannot be resized while it is %s", clusterState)
+	}
+
+	computeNodes, err := readClusterComputeNodes(ctx, tx, clusterId)
+	if err != nil {
+		return err
+	}
+	if len(computeNodes) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "cluster has no compute nodes")
+	}
+	if len(computeNodes) == computeNodeCount {
+		return nil
+	}
+
+	// Every node of a READY cluster has been provisioned, so only the nodes added below remain REQUESTED.
+	if _, err := tx.ExecContext(ctx, updateClusterNodesState, STATE_READY, STATE_REQUESTED, clusterId, cloudAccountId); err != nil {
+		logger.Error(err, "error updating cluster node states")
+		return status.Errorf(codes.Internal, "error resizing cluster")
+	}
+
+	if computeNodeCount > len(computeNodes) {
+		// New compute nodes use the same instance type and image as the existing ones.
+		template := computeNodes[len(computeNodes)-1]
+		serializedNodeLabels, err := json.MarshalIndent(template.Labels, "", "  ")
+		if err != nil {
+			return status.Errorf(codes.Internal, "error marshal indenting node labels")
+		}
+
+		for count := len(computeNodes); count < computeNodeCount; count++ {
+			nodeId := uuid.NewString()
+			if _, err := tx.ExecContext(ctx, addClusterNodes, nodeId, cloudAccountId, mapNodeRole(pb.NodeRole_COMPUTE_NODE),
+				string(serializedNodeLabels), mapMachineType(template.MachineType), template.ImageType, STATE_REQUESTED); err != nil {
+				logger.Error(err, "error inserting node state")
+				return status.Errorf(codes.Internal, "error storing cluster resize request for nodes")
+			}
+
+			if _, err := tx.ExecContext(ctx, addClusterNodeMapping, nodeId, clusterId); err != nil {
+				logger.Error(err, "error inserting cluster-node mapping")
+				return status.Errorf(codes.Internal, "error storing cluster resize request for nodes map")
+			}
+		}
+	} else {
+		// Remove the most recently added compute nodes first
+		for _, node := range computeNodes[computeNodeCount:] {
+			if _, err := tx.ExecContext(ctx, updateNodeState, STATE_DELETING, node.NodeId, cloudAccountId); err != nil {
+				logger.Error(err, "error updating node state", "nodeId", node.NodeId)
+				return status.Errorf(codes.Internal, "error storing cluster resize request for nodes")
+			}
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, updateClusterState, STATE_UPDATING, time.Now(), clusterId, cloudAccountId); err != nil {
+		logger.Error(err, "error updating cluster state")
+		return status.Errorf(codes.Internal, "error resizing cluster")
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing transaction")
+		return status.Errorf(codes.Internal, "error resizing cluster")
+	}
+	return nil
+}
+
+// GetNextClusterDeleteRequest returns the next cluster marked for deletion, or nil if there is none.
+func GetNextClusterDeleteRequest(ctx context.Context, dbconn *sql.DB) (*v1.Cluster, error) {
+	return readNextClusterInState(ctx, dbconn, STATE_DELETING)
+}
+
+// GetNextClusterResizeRequest returns the next cluster being resized along with its compute nodes, or nil if there is none.
+func GetNextClusterResizeRequest(ctx context.Context, dbconn *sql.DB) (*v1.Cluster, []ClusterComputeNode, error) {
+	cl, err := readNextClusterInState(ctx, dbconn, STATE_UPDATING)
+	if err != nil || cl == nil {
+		return nil, nil, err
+	}
+
+	computeNodes, err := GetClusterComputeNodes(ctx, dbconn, cl.ClusterId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cl, computeNodes, nil
+}
+
+func GetClusterComputeNodes(ctx context.Context, dbconn *sql.DB, clusterId string) ([]ClusterComputeNode, error) {
+	tx, err := dbconn.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.New("error querying compute nodes data")
+	}
+	defer tx.Rollback()
+
+	return readClusterComputeNodes(ctx, tx, clusterId)
+}
+
+// Private cluster delete function that removes the cluster record once its nodes and storage have been deleted.
+func DeleteClusterPrivate(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string) error {
+	logger := log.FromContext(ctx).WithName("DeleteClusterPrivate")
+	tx, err := dbconn.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(err, "error begin transaction data")
+		return fmt.Errorf("error deleting cluster")
+	}
+	defer tx.Rollback()
+
+	deletes := []struct {
+		query string
+		args  []any
+	}{
+		{deleteClusterJobs, []any{clusterId, cloudAccountId}},
+		{deleteClusterVnetSpec, []any{clusterId, cloudAccountId}},
+		{deleteClusterStorageMapping, []any{clusterId, cloudAccountId}},
+		{deleteClusterNodeMappingByClusterId, []any{cloudAccountId, clusterId}},
+		{deleteCluster, []any{clusterId, cloudAccountId}},
+	}
+	for _, d := range deletes {
+		if _, err := tx.ExecContext(ctx, d.query, d.args...); err != nil {
+			logger.Error(err, "error deleting cluster records")
+			return fmt.Errorf("error deleting cluster records")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing transaction")
+		return err
+	}
+	return nil
+}
+
+func readNextClusterInState(ctx context.Context, dbconn *sql.DB, state string) (*v1.Cluster, error) {
+	logger := log.FromContext(ctx).WithName("readNextClusterInState")
+	req := v1.SlurmClusterRequest{}
+
+	switch err := dbconn.QueryRowContext(ctx, getNextClusterInState, state).Scan(&req.ClusterId, &req.CloudAccountId); {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		logger.Error(err, "error querying cluster request", "state", state)
+		return nil, fmt.Errorf("error querying cluster request")
+	}
+
+	return GetClusterByID(ctx, dbconn, &req)
+}
+
+func getClusterStateForUpdate(ctx context.Context, tx *sql.Tx, clusterId, cloudAccountId string) (string, error) {
+	logger := log.FromContext(ctx).WithName("getClusterStateForUpdate")
+	var clusterState string
+
+	switch err := tx.QueryRowContext(ctx, getClusterStatusForUpdate, clusterId, cloudAccountId).Scan(&clusterState); {
+	case err == sql.ErrNoRows:
+		return "", status.Errorf(codes.NotFound, "no matching records found")
+	case err != nil:
+		logger.Error(err, "error querying cluster state")
+		return "", status.Errorf(codes.Internal, "error querying cluster state")
+	}
+
+	return clusterState, nil
+}
+
+func readClusterComputeNodes(ctx context.Context, tx *sql.Tx, clusterId string) ([]ClusterComputeNode, error) {
+	logger := log.FromContext(ctx).WithName("readClusterComputeNodes")
+
+	rows, err := tx.QueryContext(ctx, getClusterComputeNodes, clusterId)
+	if err != nil {
+		logger.Error(err, "error reading compute nodes")
+		return nil, status.Errorf(codes.Internal, "error querying compute nodes data")
+	}
+	defer rows.Close()
+
+	computeNodes := []ClusterComputeNode{}
+	for rows.Next() {
+		node := ClusterComputeNode{}
+		var machineType string
+		labels := json.RawMessage{}
+		if err := rows.Scan(&node.NodeId, &labels, &machineType, &node.ImageType, &node.Status); err != nil {
+			logger.Error(err, "error scanning compute node record in database")
+			return nil, status.Errorf(codes.Internal, "error querying compute nodes data")
+		}
+		if err := json.Unmarshal(labels, &node.Labels); err != nil {
+			logger.Error(err, "error unmarshaling compute node labels")
+			return nil, status.Errorf(codes.Internal, "error unmarshaling compute node labels")
+		}
+		node.MachineType = marshalMachineTypeToPb(machineType)
+		computeNodes = append(computeNodes, node)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error(err, "error reading compute nodes")
+		return nil, status.Errorf(codes.Internal, "error querying compute nodes data")
+	}
+
+	return computeNodes, nil
+}
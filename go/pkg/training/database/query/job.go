@@ -0,0 +1,163 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	addSlurmJob = `
+		INSERT INTO slurm_job (job_id, cluster_id, cloud_account_id, name)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	getUnreportedSlurmJobs = `
+		SELECT j.job_id, j.cluster_id, j.cloud_account_id, j.name, j.submitted_at
+		FROM slurm_job as j, cluster as c
+		WHERE j.cluster_id = c.cluster_id AND
+		j.cloud_account_id = c.cloud_account_id AND
+		j.reported_at IS NULL AND
+		c.status IN ('READY', 'UPDATING', 'DELETING')
+		ORDER BY j.id
+	`
+
+	getUnreportedClusterSlurmJobs = `
+		SELECT job_id, cluster_id, cloud_account_id, name, submitted_at
+		FROM slurm_job
+		WHERE cluster_id = $1 AND
+		cloud_account_id = $2 AND
+		reported_at IS NULL
+		ORDER BY id
+	`
+
+	countUnreportedClusterSlurmJobs = `
+		SELECT count(j.id), c.updated_at
+		FROM cluster as c LEFT JOIN slurm_job as j
+		ON j.cluster_id = c.cluster_id AND
+		j.cloud_account_id = c.cloud_account_id AND
+		j.reported_at IS NULL
+		WHERE c.cluster_id = $1 AND
+		c.cloud_account_id = $2
+		GROUP BY c.updated_at
+	`
+
+	updateSlurmJobReported = `
+		UPDATE slurm_job SET state = $1, start_time = $2, end_time = $3, reported_at = $4
+		WHERE cluster_id = $5 AND job_id = $6
+	`
+)
+
+// SlurmJobRecord is a job submitted through the training API whose usage has not been reported yet.
+type SlurmJobRecord struct {
+	JobId          uint32
+	ClusterId      string
+	CloudAccountId string
+	Name           string
+	SubmittedAt    time.Time
+}
+
+func CreateSlurmJob(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string, jobId uint32, name string) error {
+	logger := log.FromContext(ctx).WithName("CreateSlurmJob")
+	if _, err := dbconn.ExecContext(ctx, addSlurmJob, jobId, clusterId, cloudAccountId, name); err != nil {
+		logger.Error(err, "error inserting slurm job", "clusterId", clusterId, "jobId", jobId)
+		return status.Errorf(codes.Internal, "error storing slurm job")
+	}
+	return nil
+}
+
+// GetUnreportedSlurmJobs returns the jobs whose usage has not been reported, skipping clusters whose controller
+// node is not running. Jobs of clusters being deleted are returned until the cluster is torn down.
+func GetUnreportedSlurmJobs(ctx context.Context, dbconn *sql.DB) ([]SlurmJobRecord, error) {
+	logger := log.FromContext(ctx).WithName("GetUnreportedSlurmJobs")
+
+	rows, err := dbconn.QueryContext(ctx, getUnreportedSlurmJobs)
+	if err != nil {
+		logger.Error(err, "error reading slurm jobs")
+		return nil, errors.New("error querying slurm jobs")
+	}
+	defer rows.Close()
+
+	return readSlurmJobRecords(ctx, rows)
+}
+
+// GetUnreportedClusterSlurmJobs returns the jobs of a cluster whose usage has not been reported.
+func GetUnreportedClusterSlurmJobs(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string) ([]SlurmJobRecord, error) {
+	logger := log.FromContext(ctx).WithName("GetUnreportedClusterSlurmJobs")
+
+	rows, err := dbconn.QueryContext(ctx, getUnreportedClusterSlurmJobs, clusterId, cloudAccountId)
+	if err != nil {
+		logger.Error(err, "error reading slurm jobs", "clusterId", clusterId)
+		return nil, errors.New("error querying slurm jobs")
+	}
+	defer rows.Close()
+
+	return readSlurmJobRecords(ctx, rows)
+}
+
+// CountUnreportedSlurmJobs returns the number of jobs of a cluster whose usage has not been reported, along with
+// the time the cluster state last changed.
+func CountUnreportedSlurmJobs(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string) (int, time.Time, error) {
+	logger := log.FromContext(ctx).WithName("CountUnreportedSlurmJobs")
+	var count int
+	var updatedAt sql.NullTime
+
+	switch err := dbconn.QueryRowContext(ctx, countUnreportedClusterSlurmJobs, clusterId, cloudAccountId).Scan(&count, &updatedAt); {
+	case err == sql.ErrNoRows:
+		return 0, time.Time{}, status.Errorf(codes.NotFound, "no matching records found")
+	case err != nil:
+		logger.Error(err, "error counting slurm jobs", "clusterId", clusterId)
+		return 0, time.Time{}, errors.New("error querying slurm jobs")
+	}
+
+	return count, updatedAt.Time, nil
+}
+
+func readSlurmJobRecords(ctx context.Context, rows *sql.Rows) ([]SlurmJobRecord, error) {
+	logger := log.FromContext(ctx).WithName("readSlurmJobRecords")
+
+	jobs := []SlurmJobRecord{}
+	for rows.Next() {
+		job := SlurmJobRecord{}
+		if err := rows.Scan(&job.JobId, &job.ClusterId, &job.CloudAccountId, &job.Name, &job.SubmittedAt); err != nil {
+			logger.Error(err, "error scanning slurm job record in database")
+			return nil, errors.New("record search failed")
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error(err, "error reading slurm jobs")
+		return nil, errors.New("error querying slurm jobs")
+	}
+
+	return jobs, nil
+}
+
+// MarkSlurmJobReported stores the final state of the job so that its usage is not reported again.
+func MarkSlurmJobReported(ctx context.Context, dbconn *sql.DB, clusterId string, job *pb.SlurmJob) error {
+	logger := log.FromContext(ctx).WithName("MarkSlurmJobReported")
+
+	var startTime, endTime *time.Time
+	if job.GetStartTime() != nil {
+		t := job.GetStartTime().AsTime()
+		startTime = &t
+	}
+	if job.GetEndTime() != nil {
+		t := job.GetEndTime().AsTime()
+		endTime = &t
+	}
+
+	if _, err := dbconn.ExecContext(ctx, updateSlurmJobReported, job.GetState().String(), startTime, endTime, time.Now(), clusterId, job.GetJobId()); err != nil {
+		logger.Error(err, "error updating slurm job", "clusterId", clusterId, "jobId", job.GetJobId())
+		return errors.New("record update failed")
+	}
+	return nil
+}
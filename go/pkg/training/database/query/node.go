@@ -29,8 +29,66 @@ const (
 		DELETE FROM cluster_node_mapping
 		WHERE cluster_id IN (SELECT id FROM cluster WHERE cloud_account_id = $1 AND cluster_id = $2);
 	`
+
+	deleteClusterNodeMappingByNodeId = `
+		DELETE FROM cluster_node_mapping
+		WHERE node_id IN (SELECT id FROM node WHERE node_id = $1 AND cloud_account_id = $2);
+	`
+
+	updateNodeState = `
+		UPDATE node SET status = $1
+		WHERE node_id = $2 AND cloud_account_id = $3
+	`
+
+	updateClusterNodesState = `
+		UPDATE node SET status = $1
+		WHERE status = $2 AND id IN (
+			SELECT cn.node_id
+			FROM cluster_node_mapping as cn, cluster as c
+			WHERE cn.cluster_id = c.id AND
+			c.cluster_id = $3 AND
+			c.cloud_account_id = $4
+		)
+	`
 )
 
+func UpdateClusterNodeState(ctx context.Context, dbconn *sql.DB, nodeId, cloudAccountId, state string) error {
+	logger := log.FromContext(ctx).WithName("UpdateClusterNodeState")
+	if _, err := dbconn.ExecContext(ctx, updateNodeState, state, nodeId, cloudAccountId); err != nil {
+		logger.Error(err, "error updating node state", "nodeId", nodeId)
+		return errors.New("record update failed")
+	}
+	return nil
+}
+
+// Private cluster node delete function for a single node that returns an error and not a status code.
+func DeleteClusterNodePrivate(ctx context.Context, dbconn *sql.DB, nodeId, cloudAccountId string) error {
+	logger := log.FromContext(ctx).WithName("DeleteClusterNodePrivate")
+
+	tx, err := dbconn.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(err, "error begin transaction data")
+		return fmt.Errorf("error deleting cluster node")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, deleteClusterNodeMappingByNodeId, nodeId, cloudAccountId); err != nil {
+		logger.Error(err, "error deleting cluster node mapping", "nodeId", nodeId)
+		return fmt.Errorf("error deleting cluster node mapping")
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteNodeByClusterId, nodeId, cloudAccountId); err != nil {
+		logger.Error(err, "error deleting cluster node", "nodeId", nodeId)
+		return fmt.Errorf("error deleting cluster node from database")
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error(err, "error committing transaction")
+		return err
+	}
+	return nil
+}
+
 // Private cluster node delete function that returns an error and not a status code.
 func DeleteAllClusterNodeInstancesPrivate(ctx context.Context, dbconn *sql.DB, clusterId, cloudAccountId string) error {
 	logger := log.FromContext(ctx).WithName("DeleteAllClusterNodeInstancesPrivate")
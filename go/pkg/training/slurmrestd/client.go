@@ -0,0 +1,281 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package slurmrestd
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultPort       = 6820
+	DefaultAPIVersion = "v0.0.39"
+
+	// Node states accepted by UpdateNodeState.
+	NodeStateResume = "RESUME"
+	NodeStateDrain  = "DRAIN"
+	NodeStateFuture = "FUTURE"
+
+	// Tokens are signed for every request, so they only need to outlive a single call.
+	tokenLifetime  = 5 * time.Minute
+	jwtKeySize     = 32
+	requestTimeout = 60 * time.Second
+
+	// ESLURM_INVALID_JOB_ID
+	errorInvalidJobId = 2017
+)
+
+// Client calls the slurmrestd daemon running on the controller node of a single cluster.
+type Client struct {
+	endpoint   string
+	apiVersion string
+	userName   string
+	jwtKey     []byte
+	httpClient *http.Client
+}
+
+// NewJWTKey generates the HS256 key that slurmctld of a new cluster validates slurmrestd tokens with.
+// Each cluster has its own key, so that the key found on a controller node cannot be used to call other clusters.
+func NewJWTKey() ([]byte, error) {
+	key := make([]byte, jwtKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("error generating slurmrestd jwt key: %w", err)
+	}
+	return key, nil
+}
+
+// Endpoint returns the slurmrestd url of the controller node with the given address.
+func Endpoint(address string, cfg config.SlurmRestdConfig) string {
+	port := cfg.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+	return fmt.Sprintf("http://%s", net.JoinHostPort(address, strconv.Itoa(int(port))))
+}
+
+// NewClient returns a client signing its requests with the jwt key of the cluster.
+func NewClient(endpoint string, cfg config.SlurmRestdConfig, jwtKey []byte) (*Client, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("slurmrestd endpoint is required")
+	}
+	if len(jwtKey) == 0 {
+		return nil, fmt.Errorf("slurmrestd jwt key is required")
+	}
+	if cfg.UserName == "" {
+		return nil, fmt.Errorf("slurmrestd user name is required to sign tokens")
+	}
+	apiVersion := cfg.APIVersion
+	if apiVersion == "" {
+		apiVersion = DefaultAPIVersion
+	}
+	return &Client{
+		endpoint:   endpoint,
+		apiVersion: apiVersion,
+		userName:   cfg.UserName,
+		jwtKey:     jwtKey,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// SubmitJob submits a batch job to the given partition and returns its job id.
+func (c *Client) SubmitJob(ctx context.Context, partition string, spec *pb.SlurmJobSpec) (uint32, error) {
+	logger := log.FromContext(ctx).WithName("slurmrestd.Client.SubmitJob")
+
+	req := jobSubmitRequest{
+		Script: spec.GetScript(),
+		Job: jobDescription{
+			Name:                    spec.GetName(),
+			Partition:               partition,
+			CurrentWorkingDirectory: spec.GetCurrentWorkingDirectory(),
+			// slurmrestd rejects jobs without an environment.
+			Environment: []string{"PATH=/usr/local/bin:/usr/bin:/bin"},
+		},
+	}
+	if spec.GetNodes() > 0 {
+		req.Job.Nodes = strconv.Itoa(int(spec.GetNodes()))
+	}
+	if spec.GetTimeLimitMinutes() > 0 {
+		req.Job.TimeLimit = &noValNumber{Set: true, Number: int64(spec.GetTimeLimitMinutes())}
+	}
+	for name, value := range spec.GetEnvironment() {
+		req.Job.Environment = append(req.Job.Environment, fmt.Sprintf("%s=%s", name, value))
+	}
+
+	resp := jobSubmitResponse{}
+	if err := c.do(ctx, http.MethodPost, "/job/submit", req, &resp); err != nil {
+		logger.Error(err, "error submitting job")
+		return 0, err
+	}
+	if err := responseError(resp.Errors, codes.InvalidArgument); err != nil {
+		return 0, err
+	}
+	return resp.JobId, nil
+}
+
+func (c *Client) GetJob(ctx context.Context, jobId uint32) (*pb.SlurmJob, error) {
+	logger := log.FromContext(ctx).WithName("slurmrestd.Client.GetJob")
+
+	resp := jobsResponse{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/job/%d", jobId), nil, &resp); err != nil {
+		logger.Error(err, "error getting job", "jobId", jobId)
+		return nil, err
+	}
+	if err := responseError(resp.Errors, codes.Internal); err != nil {
+		return nil, err
+	}
+	for _, job := range resp.Jobs {
+		if job.JobId == jobId {
+			return job.toPb(), nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "job %d not found", jobId)
+}
+
+func (c *Client) ListJobs(ctx context.Context) ([]*pb.SlurmJob, error) {
+	logger := log.FromContext(ctx).WithName("slurmrestd.Client.ListJobs")
+
+	resp := jobsResponse{}
+	if err := c.do(ctx, http.MethodGet, "/jobs", nil, &resp); err != nil {
+		logger.Error(err, "error listing jobs")
+		return nil, err
+	}
+	if err := responseError(resp.Errors, codes.Internal); err != nil {
+		return nil, err
+	}
+	jobs := make([]*pb.SlurmJob, 0, len(resp.Jobs))
+	for _, job := range resp.Jobs {
+		jobs = append(jobs, job.toPb())
+	}
+	return jobs, nil
+}
+
+func (c *Client) CancelJob(ctx context.Context, jobId uint32) error {
+	logger := log.FromContext(ctx).WithName("slurmrestd.Client.CancelJob")
+
+	resp := errorsResponse{}
+	if err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/job/%d", jobId), nil, &resp); err != nil {
+		logger.Error(err, "error cancelling job", "jobId", jobId)
+		return err
+	}
+	return responseError(resp.Errors, codes.Internal)
+}
+
+// UpdateNodeState changes the state of a node, for instance to drain it before its instance is deleted.
+func (c *Client) UpdateNodeState(ctx context.Context, nodeName, state, reason string) error {
+	logger := log.FromContext(ctx).WithName("slurmrestd.Client.UpdateNodeState")
+
+	req := nodeUpdateRequest{
+		State:  []string{state},
+		Reason: reason,
+	}
+	resp := errorsResponse{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/node/%s", nodeName), req, &resp); err != nil {
+		logger.Error(err, "error updating node state", "node", nodeName, "state", state)
+		return err
+	}
+	return responseError(resp.Errors, codes.Internal)
+}
+
+// IsTerminalJobState returns true when a job in the given state will not run again.
+func IsTerminalJobState(state pb.SlurmJobState) bool {
+	switch state {
+	case pb.SlurmJobState_JOB_COMPLETED, pb.SlurmJobState_JOB_FAILED, pb.SlurmJobState_JOB_CANCELLED, pb.SlurmJobState_JOB_TIMEOUT:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return status.Errorf(codes.Internal, "error encoding slurmrestd request")
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	url := fmt.Sprintf("%s/slurm/%s%s", c.endpoint, c.apiVersion, path)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error creating slurmrestd request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	token, err := c.token()
+	if err != nil {
+		return status.Errorf(codes.Internal, "error signing slurmrestd token")
+	}
+	req.Header.Set("X-SLURM-USER-NAME", c.userName)
+	req.Header.Set("X-SLURM-USER-TOKEN", token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "slurm controller is unavailable")
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "error reading slurmrestd response")
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return status.Errorf(codes.Internal, "slurmrestd rejected the request credentials")
+	case resp.StatusCode == http.StatusNotFound:
+		return status.Errorf(codes.NotFound, "not found")
+	}
+
+	// slurmrestd reports most failures in the errors list of the response body, so decode it even for error codes.
+	if err := json.Unmarshal(respBody, out); err != nil {
+		if resp.StatusCode >= http.StatusBadRequest {
+			return status.Errorf(codes.Internal, "slurmrestd returned status %d", resp.StatusCode)
+		}
+		return status.Errorf(codes.Internal, "error decoding slurmrestd response")
+	}
+	return nil
+}
+
+func (c *Client) token() (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iat": now.Unix(),
+		"exp": now.Add(tokenLifetime).Unix(),
+		"sun": c.userName,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(c.jwtKey)
+}
+
+func responseError(apiErrors []apiError, code codes.Code) error {
+	if len(apiErrors) == 0 {
+		return nil
+	}
+	apiErr := apiErrors[0]
+	if apiErr.ErrorNumber == errorInvalidJobId {
+		return status.Errorf(codes.NotFound, "job not found")
+	}
+	description := apiErr.Description
+	if description == "" {
+		description = apiErr.Error
+	}
+	if description == "" {
+		return status.Error(code, "slurmrestd request failed")
+	}
+	return status.Errorf(code, "slurm: %s", description)
+}
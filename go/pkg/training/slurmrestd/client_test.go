@@ -0,0 +1,171 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package slurmrestd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/config"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/training/slurmrestd/slurmrestdtest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testJWTKey = []byte("test-jwt-key")
+
+func newTestClient(t *testing.T, server *slurmrestdtest.Server, jwtKey []byte) *Client {
+	cfg := config.SlurmRestdConfig{
+		APIVersion: slurmrestdtest.APIVersion,
+		UserName:   "training",
+	}
+	client, err := NewClient(server.URL, cfg, jwtKey)
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+	return client
+}
+
+func TestSubmitJob(t *testing.T) {
+	server := slurmrestdtest.NewServer(testJWTKey)
+	defer server.Close()
+	client := newTestClient(t, server, testJWTKey)
+	ctx := context.Background()
+
+	t.Run("Submit job", func(t *testing.T) {
+		jobId, err := client.SubmitJob(ctx, "cluster-1", &pb.SlurmJobSpec{
+			Name:        "train",
+			Script:      "#!/bin/bash\nsrun hostname\n",
+			Nodes:       2,
+			Environment: map[string]string{"EPOCHS": "3"},
+		})
+		assert.NoError(t, err)
+
+		job, found := server.Job(jobId)
+		assert.True(t, found)
+		assert.Equal(t, "train", job.Name)
+		assert.Equal(t, "cluster-1", job.Partition)
+		assert.Equal(t, 2, job.Nodes)
+		assert.Contains(t, job.Environment, "EPOCHS=3")
+	})
+
+	t.Run("Submit job error - script rejected by slurm", func(t *testing.T) {
+		_, err := client.SubmitJob(ctx, "cluster-1", &pb.SlurmJobSpec{Script: "srun hostname"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetJob(t *testing.T) {
+	server := slurmrestdtest.NewServer(testJWTKey)
+	defer server.Close()
+	client := newTestClient(t, server, testJWTKey)
+	ctx := context.Background()
+
+	jobId, err := client.SubmitJob(ctx, "cluster-1", &pb.SlurmJobSpec{Name: "train", Script: "#!/bin/bash\nhostname\n"})
+	assert.NoError(t, err)
+
+	t.Run("Get pending job", func(t *testing.T) {
+		job, err := client.GetJob(ctx, jobId)
+		assert.NoError(t, err)
+		assert.Equal(t, jobId, job.JobId)
+		assert.Equal(t, pb.SlurmJobState_JOB_PENDING, job.State)
+		assert.NotNil(t, job.SubmitTime)
+		assert.Nil(t, job.StartTime)
+		assert.Nil(t, job.EndTime)
+	})
+
+	t.Run("Get completed job", func(t *testing.T) {
+		assert.NoError(t, server.SetJobState(jobId, "COMPLETED", 0))
+
+		job, err := client.GetJob(ctx, jobId)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.SlurmJobState_JOB_COMPLETED, job.State)
+		assert.NotNil(t, job.StartTime)
+		assert.NotNil(t, job.EndTime)
+	})
+
+	t.Run("Get job error - not found", func(t *testing.T) {
+		_, err := client.GetJob(ctx, jobId+100)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestListAndCancelJobs(t *testing.T) {
+	server := slurmrestdtest.NewServer(testJWTKey)
+	defer server.Close()
+	client := newTestClient(t, server, testJWTKey)
+	ctx := context.Background()
+
+	firstJobId, err := client.SubmitJob(ctx, "cluster-1", &pb.SlurmJobSpec{Script: "#!/bin/bash\nhostname\n"})
+	assert.NoError(t, err)
+	secondJobId, err := client.SubmitJob(ctx, "cluster-1", &pb.SlurmJobSpec{Script: "#!/bin/bash\nhostname\n"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.CancelJob(ctx, firstJobId))
+
+	jobs, err := client.ListJobs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, firstJobId, jobs[0].JobId)
+	assert.Equal(t, pb.SlurmJobState_JOB_CANCELLED, jobs[0].State)
+	assert.Equal(t, secondJobId, jobs[1].JobId)
+	assert.Equal(t, pb.SlurmJobState_JOB_PENDING, jobs[1].State)
+
+	err = client.CancelJob(ctx, secondJobId+100)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUpdateNodeState(t *testing.T) {
+	server := slurmrestdtest.NewServer(testJWTKey)
+	defer server.Close()
+	client := newTestClient(t, server, testJWTKey)
+
+	err := client.UpdateNodeState(context.Background(), "cluster-1-slurmd-3", NodeStateDrain, "node is being removed")
+	assert.NoError(t, err)
+	assert.Equal(t, NodeStateDrain, server.NodeState("cluster-1-slurmd-3"))
+}
+
+func TestJWTAuthentication(t *testing.T) {
+	jwtKey, err := NewJWTKey()
+	assert.NoError(t, err)
+	server := slurmrestdtest.NewServer(jwtKey)
+	defer server.Close()
+	ctx := context.Background()
+
+	t.Run("Signed request is accepted", func(t *testing.T) {
+		client := newTestClient(t, server, jwtKey)
+		_, err := client.ListJobs(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Request signed with the key of another cluster is rejected", func(t *testing.T) {
+		otherKey, err := NewJWTKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, jwtKey, otherKey)
+
+		client := newTestClient(t, server, otherKey)
+		_, err = client.ListJobs(ctx)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestNewClientRequiresJWTKey(t *testing.T) {
+	_, err := NewClient("http://10.0.0.2:6820", config.SlurmRestdConfig{UserName: "training"}, nil)
+	assert.Error(t, err)
+}
+
+func TestControllerUnavailable(t *testing.T) {
+	server := slurmrestdtest.NewServer(testJWTKey)
+	client := newTestClient(t, server, testJWTKey)
+	server.Close()
+
+	_, err := client.ListJobs(context.Background())
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestEndpoint(t *testing.T) {
+	assert.Equal(t, "http://10.0.0.2:6820", Endpoint("10.0.0.2", config.SlurmRestdConfig{}))
+	assert.Equal(t, "http://10.0.0.2:7000", Endpoint("10.0.0.2", config.SlurmRestdConfig{Port: 7000}))
+}
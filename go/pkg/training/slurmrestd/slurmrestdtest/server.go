@@ -0,0 +1,315 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+
+// Package slurmrestdtest provides an in-memory slurmrestd server for tests.
+package slurmrestdtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	APIVersion = "v0.0.39"
+
+	// ESLURM_INVALID_JOB_ID
+	errorInvalidJobId = 2017
+)
+
+// Job is a job known to the fake server.
+type Job struct {
+	JobId       uint32
+	Name        string
+	Script      string
+	Partition   string
+	Nodes       int
+	Environment []string
+	State       string
+	UserName    string
+	SubmitTime  time.Time
+	StartTime   time.Time
+	EndTime     time.Time
+	ExitCode    int
+}
+
+// Server is a fake slurmrestd daemon. Submitted jobs stay PENDING until their state is changed with SetJobState.
+type Server struct {
+	*httptest.Server
+
+	// Requests must carry a token signed with this key.
+	JWTKey []byte
+
+	mu        sync.Mutex
+	nextJobId uint32
+	jobs      map[uint32]*Job
+	nodes     map[string]string
+}
+
+// NewServer returns a server accepting the tokens signed with the jwt key of the cluster.
+func NewServer(jwtKey []byte) *Server {
+	s := &Server{
+		JWTKey:    jwtKey,
+		nextJobId: 1,
+		jobs:      map[uint32]*Job{},
+		nodes:     map[string]string{},
+	}
+	prefix := "/slurm/" + APIVersion
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+prefix+"/job/submit", s.submitJob)
+	mux.HandleFunc("GET "+prefix+"/jobs", s.listJobs)
+	mux.HandleFunc("GET "+prefix+"/job/{jobId}", s.getJob)
+	mux.HandleFunc("DELETE "+prefix+"/job/{jobId}", s.cancelJob)
+	mux.HandleFunc("POST "+prefix+"/node/{nodeName}", s.updateNode)
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	return s
+}
+
+// Job returns a copy of the job with the given id.
+func (s *Server) Job(jobId uint32) (Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, found := s.jobs[jobId]
+	if !found {
+		return Job{}, false
+	}
+	return *job, true
+}
+
+// SetJobState moves a job to the given slurm state, setting its start and end times as slurmctld would.
+func (s *Server) SetJobState(jobId uint32, state string, exitCode int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, found := s.jobs[jobId]
+	if !found {
+		return fmt.Errorf("job %d not found", jobId)
+	}
+	now := time.Now()
+	job.State = state
+	job.ExitCode = exitCode
+	if state != "PENDING" && job.StartTime.IsZero() {
+		job.StartTime = now
+	}
+	if isTerminal(state) && job.EndTime.IsZero() {
+		job.EndTime = now
+	}
+	return nil
+}
+
+// NodeState returns the last state a node was updated to.
+func (s *Server) NodeState(nodeName string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.nodes[nodeName]
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userName := r.Header.Get("X-SLURM-USER-NAME")
+		token, err := jwt.Parse(r.Header.Get("X-SLURM-USER-TOKEN"), func(*jwt.Token) (any, error) { return s.JWTKey, nil })
+		if err != nil || !token.Valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok || claims["sun"] != userName {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) submitJob(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		Script string `json:"script"`
+		Job    struct {
+			Name        string   `json:"name"`
+			Partition   string   `json:"partition"`
+			Nodes       string   `json:"nodes"`
+			Environment []string `json:"environment"`
+		} `json:"job"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, 0, "unable to parse request")
+		return
+	}
+	if !strings.HasPrefix(req.Script, "#!") {
+		writeError(w, http.StatusInternalServerError, 2037, "batch script must start with an interpreter line")
+		return
+	}
+	if len(req.Job.Environment) == 0 {
+		writeError(w, http.StatusInternalServerError, 9001, "environment must be set")
+		return
+	}
+	nodes := 1
+	if req.Job.Nodes != "" {
+		n, err := strconv.Atoi(req.Job.Nodes)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusInternalServerError, 2025, "invalid node count specified")
+			return
+		}
+		nodes = n
+	}
+
+	s.mu.Lock()
+	job := &Job{
+		JobId:       s.nextJobId,
+		Name:        req.Job.Name,
+		Script:      req.Script,
+		Partition:   req.Job.Partition,
+		Nodes:       nodes,
+		Environment: req.Job.Environment,
+		State:       "PENDING",
+		UserName:    r.Header.Get("X-SLURM-USER-NAME"),
+		SubmitTime:  time.Now(),
+	}
+	s.jobs[job.JobId] = job
+	s.nextJobId++
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"job_id": job.JobId,
+		"errors": []any{},
+	})
+}
+
+func (s *Server) listJobs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	jobs := []any{}
+	for jobId := uint32(1); jobId < s.nextJobId; jobId++ {
+		if job, found := s.jobs[jobId]; found {
+			jobs = append(jobs, jobInfo(job))
+		}
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"jobs":   jobs,
+		"errors": []any{},
+	})
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.lookupJob(w, r)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	info := jobInfo(job)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"jobs":   []any{info},
+		"errors": []any{},
+	})
+}
+
+func (s *Server) cancelJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.lookupJob(w, r)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	if !isTerminal(job.State) {
+		job.State = "CANCELLED"
+		job.EndTime = time.Now()
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{"errors": []any{}})
+}
+
+func (s *Server) updateNode(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		State []string `json:"state"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.State) == 0 {
+		writeError(w, http.StatusBadRequest, 0, "unable to parse request")
+		return
+	}
+	s.mu.Lock()
+	s.nodes[r.PathValue("nodeName")] = req.State[0]
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{"errors": []any{}})
+}
+
+func (s *Server) lookupJob(w http.ResponseWriter, r *http.Request) (*Job, bool) {
+	jobId, err := strconv.ParseUint(r.PathValue("jobId"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorInvalidJobId, "Invalid job id specified")
+		return nil, false
+	}
+	s.mu.Lock()
+	job, found := s.jobs[uint32(jobId)]
+	s.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusInternalServerError, errorInvalidJobId, "Invalid job id specified")
+		return nil, false
+	}
+	return job, true
+}
+
+func jobInfo(job *Job) map[string]any {
+	return map[string]any{
+		"job_id":      job.JobId,
+		"name":        job.Name,
+		"partition":   job.Partition,
+		"job_state":   job.State,
+		"user_name":   job.UserName,
+		"node_count":  noVal(int64(job.Nodes)),
+		"nodes":       "",
+		"submit_time": noValTime(job.SubmitTime),
+		"start_time":  noValTime(job.StartTime),
+		"end_time":    noValTime(job.EndTime),
+		"exit_code": map[string]any{
+			"status":      "SUCCESS",
+			"return_code": noVal(int64(job.ExitCode)),
+		},
+	}
+}
+
+func noVal(number int64) map[string]any {
+	return map[string]any{"set": true, "infinite": false, "number": number}
+}
+
+func noValTime(t time.Time) map[string]any {
+	if t.IsZero() {
+		return map[string]any{"set": false, "infinite": false, "number": 0}
+	}
+	return noVal(t.Unix())
+}
+
+func isTerminal(state string) bool {
+	switch state {
+	case "COMPLETED", "FAILED", "CANCELLED", "TIMEOUT", "NODE_FAIL", "OUT_OF_MEMORY":
+		return true
+	default:
+		return false
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, errorNumber int, description string) {
+	writeJSON(w, code, map[string]any{
+		"errors": []any{map[string]any{
+			"description":  description,
+			"error_number": errorNumber,
+			"error":        description,
+			"source":       "slurmrestdtest",
+		}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
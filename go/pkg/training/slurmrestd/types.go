@@ -0,0 +1,156 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package slurmrestd
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Wire types of the slurmrestd openapi plugin. Only the fields used by the training service are declared.
+
+type apiError struct {
+	Description string `json:"description"`
+	ErrorNumber int    `json:"error_number"`
+	Error       string `json:"error"`
+	Source      string `json:"source"`
+}
+
+type errorsResponse struct {
+	Errors []apiError `json:"errors"`
+}
+
+// noValNumber is a number that may be unset or infinite. Older API versions encode it as a plain number.
+type noValNumber struct {
+	Set      bool  `json:"set"`
+	Infinite bool  `json:"infinite"`
+	Number   int64 `json:"number"`
+}
+
+func (n *noValNumber) UnmarshalJSON(data []byte) error {
+	var number int64
+	if err := json.Unmarshal(data, &number); err == nil {
+		*n = noValNumber{Set: true, Number: number}
+		return nil
+	}
+	type plain noValNumber
+	return json.Unmarshal(data, (*plain)(n))
+}
+
+func (n noValNumber) timestamp() *timestamppb.Timestamp {
+	if !n.Set || n.Infinite || n.Number <= 0 {
+		return nil
+	}
+	return timestamppb.New(time.Unix(n.Number, 0))
+}
+
+// jobState is a single state in older API versions and a list of state flags in newer ones.
+type jobState []string
+
+func (s *jobState) UnmarshalJSON(data []byte) error {
+	var state string
+	if err := json.Unmarshal(data, &state); err == nil {
+		*s = jobState{state}
+		return nil
+	}
+	var states []string
+	if err := json.Unmarshal(data, &states); err != nil {
+		return err
+	}
+	*s = states
+	return nil
+}
+
+type jobDescription struct {
+	Name                    string       `json:"name,omitempty"`
+	Partition               string       `json:"partition,omitempty"`
+	Nodes                   string       `json:"nodes,omitempty"`
+	TimeLimit               *noValNumber `json:"time_limit,omitempty"`
+	CurrentWorkingDirectory string       `json:"current_working_directory,omitempty"`
+	Environment             []string     `json:"environment"`
+}
+
+type jobSubmitRequest struct {
+	Script string         `json:"script"`
+	Job    jobDescription `json:"job"`
+}
+
+type jobSubmitResponse struct {
+	JobId  uint32     `json:"job_id"`
+	Errors []apiError `json:"errors"`
+}
+
+type exitCode struct {
+	Status     string      `json:"status"`
+	ReturnCode noValNumber `json:"return_code"`
+}
+
+type jobInfo struct {
+	JobId      uint32      `json:"job_id"`
+	Name       string      `json:"name"`
+	JobState   jobState    `json:"job_state"`
+	UserName   string      `json:"user_name"`
+	NodeCount  noValNumber `json:"node_count"`
+	Nodes      string      `json:"nodes"`
+	SubmitTime noValNumber `json:"submit_time"`
+	StartTime  noValNumber `json:"start_time"`
+	EndTime    noValNumber `json:"end_time"`
+	ExitCode   exitCode    `json:"exit_code"`
+}
+
+type jobsResponse struct {
+	Jobs   []jobInfo  `json:"jobs"`
+	Errors []apiError `json:"errors"`
+}
+
+type nodeUpdateRequest struct {
+	State  []string `json:"state"`
+	Reason string   `json:"reason,omitempty"`
+}
+
+func (job *jobInfo) toPb() *pb.SlurmJob {
+	slurmJob := &pb.SlurmJob{
+		JobId:      job.JobId,
+		Name:       job.Name,
+		State:      mapJobState(job.JobState),
+		UserName:   job.UserName,
+		Nodes:      uint32(job.NodeCount.Number),
+		NodeList:   job.Nodes,
+		SubmitTime: job.SubmitTime.timestamp(),
+		ExitCode:   int32(job.ExitCode.ReturnCode.Number),
+	}
+	// Pending jobs report the time they are expected to start and end.
+	if slurmJob.State != pb.SlurmJobState_JOB_PENDING {
+		slurmJob.StartTime = job.StartTime.timestamp()
+	}
+	if IsTerminalJobState(slurmJob.State) {
+		slurmJob.EndTime = job.EndTime.timestamp()
+	}
+	return slurmJob
+}
+
+func mapJobState(states jobState) pb.SlurmJobState {
+	if len(states) == 0 {
+		return pb.SlurmJobState_JOB_UNKNOWN
+	}
+	// The base state comes first, followed by state flags.
+	switch states[0] {
+	case "PENDING", "CONFIGURING", "REQUEUED", "RESV_DEL_HOLD", "REQUEUE_FED", "REQUEUE_HOLD":
+		return pb.SlurmJobState_JOB_PENDING
+	case "RUNNING", "COMPLETING", "SUSPENDED", "STAGE_OUT", "SIGNALING", "RESIZING", "STOPPED":
+		return pb.SlurmJobState_JOB_RUNNING
+	case "COMPLETED":
+		return pb.SlurmJobState_JOB_COMPLETED
+	case "FAILED", "NODE_FAIL", "BOOT_FAIL", "OUT_OF_MEMORY", "DEADLINE", "PREEMPTED":
+		return pb.SlurmJobState_JOB_FAILED
+	case "CANCELLED":
+		return pb.SlurmJobState_JOB_CANCELLED
+	case "TIMEOUT":
+		return pb.SlurmJobState_JOB_TIMEOUT
+	default:
+		return pb.SlurmJobState_JOB_UNKNOWN
+	}
+}
@@ -5,8 +5,11 @@ package usage
 import (
 	"fmt"
 	"strconv"
+	"sync"
 
+	billingCommon "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/billing_common"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/productcatalog/expr"
 	"golang.org/x/exp/slices"
 )
 
@@ -133,3 +136,56 @@ func GetMeteringQuantityInMins(meteringRecord *pb.MeteringRecord) (float64, erro
 func GetMeteringQuantityMultipleMetrics(meteringRecords []*pb.MeteringRecord) (float64, error) {
 	return 0, nil
 }
+
+// parsed usage expressions by source.
+var usageExprCache sync.Map
+
+func parseUsageExpr(src string) (*expr.UsageExpr, error) {
+	if cached, ok := usageExprCache.Load(src); ok {
+		return cached.(*expr.UsageExpr), nil
+	}
+	usageExpr, err := expr.ParseUsage(src)
+	if err != nil {
+		return nil, err
+	}
+	usageExprCache.Store(src, usageExpr)
+	return usageExpr, nil
+}
+
+// The legacy usage expression is computed from the running seconds of the latest metering record.
+func isCustomUsageExpr(usageExpr string) bool {
+	return usageExpr != "" && usageExpr != billingCommon.SupportedMetricType
+}
+
+func hasCustomUsageExpr(product *pb.Product) bool {
+	for _, rate := range product.Rates {
+		if isCustomUsageExpr(rate.UsageExpr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetUsageExprQuantity evaluates a usage expression for each metering record and the one before it, the metering
+// records are ordered from the latest to the oldest. The oldest record is only evaluated on its own for the
+// first usage of a resource, otherwise it is the previous, already reported, metering record.
+func GetUsageExprQuantity(usageExpr *expr.UsageExpr, meteringRecords []*pb.MeteringRecord, firstUsage bool) (float64, error) {
+	var quantity float64
+	for i, meteringRecord := range meteringRecords {
+		var previousProperties map[string]string
+		if i+1 < len(meteringRecords) {
+			previousProperties = meteringRecords[i+1].Properties
+		} else if !firstUsage {
+			break
+		}
+		recordQuantity, err := usageExpr.Evaluate(meteringRecord.Properties, previousProperties)
+		if err != nil {
+			return 0, fmt.Errorf("metering %v: %w", meteringRecord.Id, err)
+		}
+		quantity += recordQuantity
+	}
+	if quantity < 0 {
+		return 0, fmt.Errorf("metering %v: negative quantity %v for usage expression %q", meteringRecords[0].Id, quantity, usageExpr)
+	}
+	return quantity, nil
+}
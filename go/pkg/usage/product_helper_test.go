@@ -221,6 +221,43 @@ func TestCheckProductMatchesMetering(t *testing.T) {
 			},
 			verifyCheckIfProductMapsToMetering: verifyCheckIfProductMapsToMeteringProperties,
 		},
+		{
+			enabled:            true,
+			expressionType:     "nestedParenthesis",
+			matchingExpression: fmt.Sprintf("billUsage && ((service == \"%s\" && instanceType == '%s') || (service == other && instanceType == other))", idcComputeServiceName, xeon3SmallInstanceType),
+			matchedProperties: map[string]string{
+				"region":       DefaultServiceRegion,
+				"service":      idcComputeServiceName,
+				"billUsage":    "true",
+				"instanceType": xeon3SmallInstanceType,
+			},
+			verifyCheckIfProductMapsToMetering: verifyCheckIfProductMapsToMeteringProperties,
+		},
+		{
+			enabled:            true,
+			expressionType:     "inList",
+			matchingExpression: fmt.Sprintf("instanceType in (\"%s\", someInstanceType) && region not in [other-region]", xeon3SmallInstanceType),
+			matchedProperties: map[string]string{
+				"region":       DefaultServiceRegion,
+				"service":      idcComputeServiceName,
+				"billUsage":    "true",
+				"instanceType": xeon3SmallInstanceType,
+			},
+			verifyCheckIfProductMapsToMetering: verifyCheckIfProductMapsToMeteringProperties,
+		},
+		{
+			enabled:            true,
+			expressionType:     "numericComparison",
+			matchingExpression: "cpuCount >= 8 && cpuCount < 16.5",
+			matchedProperties: map[string]string{
+				"region":       DefaultServiceRegion,
+				"service":      idcComputeServiceName,
+				"billUsage":    "true",
+				"instanceType": xeon3SmallInstanceType,
+				"cpuCount":     "10",
+			},
+			verifyCheckIfProductMapsToMetering: verifyCheckIfProductMapsToMeteringProperties,
+		},
 	}
 	for index := range tests {
 		if tests[index].enabled {
@@ -363,13 +400,26 @@ func TestCheckProductMatchesMeteringErrors(t *testing.T) {
 		},
 		{
 			enabled:            true,
-			expressionType:     "evalTrue&&orequals",
-			matchingExpression: fmt.Sprintf("billUsage && (instanceType == \"%s\" && instanceType == someInstanceType)", xeon3SmallInstanceType),
+			expressionType:     "unbalancedParenthesis",
+			matchingExpression: fmt.Sprintf("billUsage && (instanceType == \"%s\" || instanceType == someInstanceType", xeon3SmallInstanceType),
+			matchedProperties: map[string]string{
+				"region":       DefaultServiceRegion,
+				"service":      idcComputeServiceName,
+				"billUsage":    "true",
+				"instanceType": xeon3SmallInstanceType,
+			},
+			verifyErrorsCheckIfProductMapsToMetering: verifyErrorsCheckIfProductMapsToMeteringProperties,
+		},
+		{
+			enabled:            true,
+			expressionType:     "numericComparisonWithString",
+			matchingExpression: "cpuCount > \"many\"",
 			matchedProperties: map[string]string{
 				"region":       DefaultServiceRegion,
 				"service":      idcComputeServiceName,
 				"billUsage":    "true",
 				"instanceType": xeon3SmallInstanceType,
+				"cpuCount":     "10",
 			},
 			verifyErrorsCheckIfProductMapsToMetering: verifyErrorsCheckIfProductMapsToMeteringProperties,
 		},
@@ -516,6 +566,43 @@ func TestCheckProductDoesNotMatchMetering(t *testing.T) {
 			},
 			verifyCheckIfProductDoesNotMapToMetering: verifyCheckIfProductDoesNotMapToProperties,
 		},
+		{
+			enabled:            true,
+			expressionType:     "evalTrue&&andequals",
+			matchingExpression: fmt.Sprintf("billUsage && (instanceType == \"%s\" && instanceType == someInstanceType)", xeon3SmallInstanceType),
+			matchedProperties: map[string]string{
+				"region":       DefaultServiceRegion,
+				"service":      idcComputeServiceName,
+				"billUsage":    "true",
+				"instanceType": xeon3SmallInstanceType,
+			},
+			verifyCheckIfProductDoesNotMapToMetering: verifyCheckIfProductDoesNotMapToProperties,
+		},
+		{
+			enabled:            true,
+			expressionType:     "numericComparisonNoMatch",
+			matchingExpression: "cpuCount > 16",
+			matchedProperties: map[string]string{
+				"region":       DefaultServiceRegion,
+				"service":      idcComputeServiceName,
+				"billUsage":    "true",
+				"instanceType": xeon3SmallInstanceType,
+				"cpuCount":     "10",
+			},
+			verifyCheckIfProductDoesNotMapToMetering: verifyCheckIfProductDoesNotMapToProperties,
+		},
+		{
+			enabled:            true,
+			expressionType:     "instanceGroupNotSelected",
+			matchingExpression: fmt.Sprintf("instanceType == \"%s\"", xeon3SmallInstanceType),
+			matchedProperties: map[string]string{
+				"region":            DefaultServiceRegion,
+				"service":           idcComputeServiceName,
+				"instanceType":      xeon3SmallInstanceType,
+				"instanceGroupSize": "4",
+			},
+			verifyCheckIfProductDoesNotMapToMetering: verifyCheckIfProductDoesNotMapToProperties,
+		},
 	}
 	for index := range tests {
 		if tests[index].enabled {
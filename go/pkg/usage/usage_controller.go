@@ -13,6 +13,7 @@ import (
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/log"
 	obs "github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/observability"
 	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb"
+	"github.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/productcatalog/expr"
 	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -203,7 +204,7 @@ func (usageController *UsageController) CalculateUsages(ctx context.Context) err
 			err = usageController.updateResourceUsage(ctx, resourceMeteringRecords.ResourceId, resourceMeteringRecords.CloudAccountId,
 				resourceMeteringRecords.ResourceName, resourceMeteringRecords.Region,
 				meteringRecords, resourceUsages.ResourceUsages[0], sumOfPreviousQuantity,
-				startTime.AsTime(), endTime.AsTime())
+				validProducts, startTime.AsTime(), endTime.AsTime())
 			if err != nil {
 				logger.Error(err, "failed to update resource usages for resource", "id", resourceMeteringRecords.ResourceId)
 				continue
@@ -266,6 +267,25 @@ func GetRateForProduct(ctx context.Context, cloudAccountClient billingCommon.Clo
 	return "", "", err
 }
 
+// getCustomUsageExpr returns the usage expression of the product rate for the cloud account, or nil if the
+// rate uses the legacy usage expression.
+func (usageController *UsageController) getCustomUsageExpr(ctx context.Context, product *pb.Product, cloudAccountId string) (*expr.UsageExpr, error) {
+	// avoid looking up the cloud account type for products which are billed by running time only.
+	if !hasCustomUsageExpr(product) {
+		return nil, nil
+	}
+	cloudAccountType, err := usageController.cloudAccountClient.GetCloudAccountType(ctx, &pb.CloudAccountId{Id: cloudAccountId})
+	if err != nil {
+		return nil, err
+	}
+	for _, rate := range product.Rates {
+		if rate.AccountType == cloudAccountType && isCustomUsageExpr(rate.UsageExpr) {
+			return parseUsageExpr(rate.UsageExpr)
+		}
+	}
+	return nil, nil
+}
+
 /*
 *
 Add the usage for a resource.
@@ -300,18 +320,41 @@ func (usageController *UsageController) addResourceUsage(ctx context.Context, re
 		return err
 	}
 
-	// we only care about the last metering record.
-	usageQuantity, err := GetUsageQuantity(meteringRecords)
-	if err != nil {
-		usageController.invalidateMeteringRecords(ctx, meteringRecords, pb.MeteringRecordInvalidityReason_FAILED_TO_CALCULATE_QTY)
-		logger.Error(err, "failed to get metering quantity", "id", meteringRecords[0].Id)
-		return err
-	}
-
-	usageQuantity = usageQuantity - previousQuantity
+	// the legacy usage quantity is computed once for all products which use it.
+	var legacyUsageQuantity *float64
 
 	for _, mappedProduct := range mappedProducts {
 		logger.Info("found mapped product for resource", "id", resourceId, "cloudAccountId", cloudAccountId, "product", mappedProduct.Name)
+		usageExpr, err := usageController.getCustomUsageExpr(ctx, mappedProduct, cloudAccountId)
+		if err != nil {
+			usageController.invalidateMeteringRecords(ctx, meteringRecords, pb.MeteringRecordInvalidityReason_FAILED_TO_GET_PRODUCT_RATE)
+			logger.Error(err, "failed to get usage expression for product", "id", mappedProduct.Id)
+			return err
+		}
+
+		var usageQuantity float64
+		if usageExpr != nil {
+			usageQuantity, err = GetUsageExprQuantity(usageExpr, meteringRecords, true)
+			if err != nil {
+				usageController.invalidateMeteringRecords(ctx, meteringRecords, pb.MeteringRecordInvalidityReason_FAILED_TO_CALCULATE_QTY)
+				logger.Error(err, "failed to evaluate usage expression", "id", meteringRecords[0].Id, "usageExpr", usageExpr.String())
+				return err
+			}
+		} else {
+			if legacyUsageQuantity == nil {
+				// we only care about the last metering record.
+				quantity, err := GetUsageQuantity(meteringRecords)
+				if err != nil {
+					usageController.invalidateMeteringRecords(ctx, meteringRecords, pb.MeteringRecordInvalidityReason_FAILED_TO_CALCULATE_QTY)
+					logger.Error(err, "failed to get metering quantity", "id", meteringRecords[0].Id)
+					return err
+				}
+				quantity = quantity - previousQuantity
+				legacyUsageQuantity = &quantity
+			}
+			usageQuantity = *legacyUsageQuantity
+		}
+
 		// the usage unit type is wrong!! - it needs to be mins and not dollars per min..
 		usageUnitType, rate, err := GetRateForProduct(ctx, usageController.cloudAccountClient, mappedProduct, cloudAccountId)
 		if err != nil {
@@ -376,7 +419,7 @@ func (usageController *UsageController) invalidateMeteringRecords(ctx context.Co
 
 func (usageController *UsageController) updateResourceUsage(ctx context.Context, resourceId string, cloudAccountId string, resourceName string,
 	region string, meteringRecords []*pb.MeteringRecord, lastResourceUsage *pb.ResourceUsage, previousQuantity float64,
-	startTime time.Time, endTime time.Time) error {
+	products []*pb.Product, startTime time.Time, endTime time.Time) error {
 	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("UsageController.updateResourceUsage").WithValues("cloudAccountId", cloudAccountId, "resourceId", resourceId, "resourceName", resourceName).Start()
 	defer span.End()
 	logger.Info("BEGIN")
@@ -385,6 +428,30 @@ func (usageController *UsageController) updateResourceUsage(ctx context.Context,
 	logger.Info("updating resource usage entries for resource", "id", resourceId, "cloudAccountId", cloudAccountId)
 	logger.Info("using these values from the last resource usage", "id", lastResourceUsage.Id, "quantity", lastResourceUsage.Quantity)
 
+	for _, product := range products {
+		if product.Id != lastResourceUsage.ProductId {
+			continue
+		}
+		usageExpr, err := usageController.getCustomUsageExpr(ctx, product, cloudAccountId)
+		if err != nil {
+			usageController.invalidateMeteringRecords(ctx, meteringRecords, pb.MeteringRecordInvalidityReason_FAILED_TO_GET_PRODUCT_RATE)
+			logger.Error(err, "failed to get usage expression for product", "id", product.Id)
+			return err
+		}
+		if usageExpr != nil {
+			// usage expressions are relative to the previous metering record, there is nothing to subtract.
+			usageQuantity, err := GetUsageExprQuantity(usageExpr, meteringRecords, false)
+			if err != nil {
+				usageController.invalidateMeteringRecords(ctx, meteringRecords, pb.MeteringRecordInvalidityReason_FAILED_TO_CALCULATE_QTY)
+				logger.Error(err, "failed to evaluate usage expression", "usageExpr", usageExpr.String())
+				return err
+			}
+			return usageController.addUpdatedResourceUsage(ctx, resourceId, cloudAccountId, resourceName, region, meteringRecords,
+				lastResourceUsage, usageQuantity, startTime, endTime)
+		}
+		break
+	}
+
 	usageQuantity, err := GetUsageQuantity(meteringRecords)
 	if err != nil {
 		usageController.invalidateMeteringRecords(ctx, meteringRecords, pb.MeteringRecordInvalidityReason_FAILED_TO_CALCULATE_QTY)
@@ -416,8 +483,17 @@ func (usageController *UsageController) updateResourceUsage(ctx context.Context,
 	// Not using update paths will mean that we will end up with more records than we need which is ok.
 
 	logger.Info("adding record for", "quantity", usageQuantity, "previousQuantity", previousQuantity)
-	quantityToUpdate := usageQuantity - previousQuantity
-	err = usageController.addUsages(ctx, cloudAccountId,
+	return usageController.addUpdatedResourceUsage(ctx, resourceId, cloudAccountId, resourceName, region, meteringRecords,
+		lastResourceUsage, usageQuantity-previousQuantity, startTime, endTime)
+}
+
+func (usageController *UsageController) addUpdatedResourceUsage(ctx context.Context, resourceId string, cloudAccountId string, resourceName string,
+	region string, meteringRecords []*pb.MeteringRecord, lastResourceUsage *pb.ResourceUsage, quantityToUpdate float64,
+	startTime time.Time, endTime time.Time) error {
+	ctx, logger, span := obs.LogAndSpanFromContext(ctx).WithName("UsageController.addUpdatedResourceUsage").WithValues("cloudAccountId", cloudAccountId, "resourceId", resourceId).Start()
+	defer span.End()
+
+	err := usageController.addUsages(ctx, cloudAccountId,
 		resourceId, resourceName, lastResourceUsage.ProductId, lastResourceUsage.ProductName,
 		region, quantityToUpdate, lastResourceUsage.Rate, lastResourceUsage.UsageUnitType,
 		startTime, endTime)
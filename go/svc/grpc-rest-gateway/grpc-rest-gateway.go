@@ -153,6 +153,7 @@ func RunService(ctx context.Context, config *grpc_rest_gateway.Config) error {
 				},
 			},
 		}),
+		runtime.WithIncomingHeaderMatcher(grpc_rest_gateway.IncomingHeaderMatcher),
 		runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
 			originalURL := req.URL.String()
 			originalMethod := req.Method
@@ -164,6 +165,11 @@ func RunService(ctx context.Context, config *grpc_rest_gateway.Config) error {
 		}),
 	)
 	registerServers(ctx, conn, gwmux)
+	if config.Deployment == "all" || config.Deployment == "regional" {
+		if err := grpc_rest_gateway.RegisterOpenAIHandlers(ctx, gwmux, conn); err != nil {
+			return fmt.Errorf("register OpenAI handlers: %w", err)
+		}
+	}
 	mux.Handle("/", filterRequest(gwmux))
 
 	tlsConfig, err := tlsutil.NewTlsProvider().ServerTlsConfig(ctx)
@@ -197,7 +203,7 @@ func RunService(ctx context.Context, config *grpc_rest_gateway.Config) error {
 			if isOriginAllowed {
 				resp.Header().Set("Access-Control-Allow-Origin", origin)
 				if req.Method == "OPTIONS" && req.Header.Get("Access-Control-Request-Method") != "" {
-					headers := []string{"Content-Type", "Accept", "Authorization"}
+					headers := []string{"Content-Type", "Accept", "Authorization", "Idempotency-Key"}
 					resp.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
 					methods := []string{"OPTIONS", "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"}
 					resp.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
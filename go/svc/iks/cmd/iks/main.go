@@ -139,6 +139,12 @@ func main() {
 
 		productcatalogServiceClient := pb.NewProductCatalogServiceClient(productcatalogClientConn)
 
+		var quotaManagementClient pb.QuotaManagementPrivateServiceClient
+		if cfg.QuotaManagementServerAddr != "" {
+			quotaManagementClientConn := newClient(ctx, cfg.QuotaManagementServerAddr, dialOptions...)
+			quotaManagementClient = pb.NewQuotaManagementPrivateServiceClient(quotaManagementClientConn)
+		}
+
 		// Start GRPC server.
 		grpcService, err := server.New(ctx,
 			&cfg,
@@ -147,7 +153,8 @@ func main() {
 			sshClient,
 			vnetClient,
 			productcatalogServiceClient,
-			computeInstanceSvcClient)
+			computeInstanceSvcClient,
+			quotaManagementClient)
 		if err != nil {
 			return err
 		}
@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15infaas-generate.proto\x12\x05proto\"l\n\x15GenerateStreamRequest\x12\x11\n\trequestID\x18\x01 \x01(\t\x12\x0e\n\x06prompt\x18\x02 \x01(\t\x12\x30\n\x06params\x18\x03 \x01(\x0b\x32 .proto.GenerateRequestParameters\"\xb2\x04\n\x1b\x43hatCompletionStreamRequest\x12\x11\n\trequestID\x18\x01 \x01(\t\x12.\n\x08messages\x18\x02 \x03(\x0b\x32\x1c.proto.ChatCompletionMessage\x12\x1f\n\x12repetition_penalty\x18\x03 \x01(\x02H\x00\x88\x01\x01\x12\x1e\n\x11\x66requency_penalty\x18\x04 \x01(\x02H\x01\x88\x01\x01\x12\x12\n\nlogit_bias\x18\x05 \x03(\x02\x12\x1c\n\x0freturn_logprobs\x18\x06 \x01(\x08H\x02\x88\x01\x01\x12\x19\n\x0ctop_logprobs\x18\x07 \x01(\rH\x03\x88\x01\x01\x12\x17\n\nmax_tokens\x18\x08 \x01(\rH\x04\x88\x01\x01\x12\x1c\n\x0fnum_completions\x18\t \x01(\rH\x05\x88\x01\x01\x12\x1d\n\x10presence_penalty\x18\n \x01(\x02H\x06\x88\x01\x01\x12\x11\n\x04seed\x18\x0b \x01(\x04H\x07\x88\x01\x01\x12\x18\n\x0btemperature\x18\x0c \x01(\x02H\x08\x88\x01\x01\x12\x12\n\x05top_p\x18\r \x01(\x02H\t\x88\x01\x01\x42\x15\n\x13_repetition_penaltyB\x14\n\x12_frequency_penaltyB\x12\n\x10_return_logprobsB\x0f\n\r_top_logprobsB\r\n\x0b_max_tokensB\x12\n\x10_num_completionsB\x13\n\x11_presence_penaltyB\x07\n\x05_seedB\x0e\n\x0c_temperatureB\x08\n\x06_top_p\"6\n\x15\x43hatCompletionMessage\x12\x0c\n\x04role\x18\x01 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\t\"\xd5\x01\n\x16GenerateStreamResponse\x12&\n\x05token\x18\x01 \x01(\x0b\x32\x17.proto.GenerateAPIToken\x12+\n\ntop_tokens\x18\x02 \x03(\x0b\x32\x17.proto.GenerateAPIToken\x12\x1b\n\x0egenerated_text\x18\x03 \x01(\tH\x00\x88\x01\x01\x12*\n\x07\x64\x65tails\x18\x04 \x01(\x0b\x32\x14.proto.StreamDetailsH\x01\x88\x01\x01\x42\x11\n\x0f_generated_textB\n\n\x08_details\"\xb4\x01\n\x1c\x43hatCompletionStreamResponse\x12\x14\n\x0c\x63ompletionID\x18\x01 \x01(\t\x12\x0e\n\x06object\x18\x02 \x01(\t\x12\x0f\n\x07\x63reated\x18\x03 \x01(\x04\x12\r\n\x05model\x18\x04 \x01(\t\x12\x1a\n\x12system_fingerprint\x18\x05 \x01(\t\x12\x32\n\x07\x63hoices\x18\x06 \x03(\x0b\x32!.proto.ChatCompletionStreamChoice\"G\n\x13\x43hatCompletionUsage\x12\x15\n\rprompt_tokens\x18\x01 \x01(\r\x12\x19\n\x11\x63ompletion_tokens\x18\x02 \x01(\r\"\x9f\x02\n\x1a\x43hatCompletionStreamChoice\x12\r\n\x05index\x18\x01 \x01(\r\x12\x30\n\x05\x64\x65lta\x18\x02 \x01(\x0b\x32\x1c.proto.ChatCompletionMessageH\x00\x88\x01\x01\x12\x41\n\x08logprobs\x18\x03 \x01(\x0b\x32*.proto.ChatCompletionStreamLogprobsContentH\x01\x88\x01\x01\x12\x1a\n\rfinish_reason\x18\x04 \x01(\tH\x02\x88\x01\x01\x12.\n\x05usage\x18\x05 \x01(\x0b\x32\x1a.proto.ChatCompletionUsageH\x03\x88\x01\x01\x42\x08\n\x06_deltaB\x0b\n\t_logprobsB\x10\n\x0e_finish_reasonB\x08\n\x06_usage\"Z\n#ChatCompletionStreamLogprobsContent\x12\x33\n\x07\x63ontent\x18\x01 \x03(\x0b\x32\".proto.ChatCompletionStreamLogprob\"=\n\x1b\x43hatCompletionStreamLogprob\x12\r\n\x05token\x18\x01 \x01(\t\x12\x0f\n\x07logprob\x18\x02 \x01(\x02\"_\n\x10GenerateAPIToken\x12\n\n\x02id\x18\x01 \x01(\r\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\x14\n\x07logprob\x18\x03 \x01(\x02H\x00\x88\x01\x01\x12\x0f\n\x07special\x18\x04 \x01(\x08\x42\n\n\x08_logprob\"q\n\rStreamDetails\x12*\n\rfinish_reason\x18\x01 \x01(\x0e\x32\x13.proto.FinishReason\x12\x18\n\x10generated_tokens\x18\x02 \x01(\r\x12\x11\n\x04seed\x18\x03 \x01(\x04H\x00\x88\x01\x01\x42\x07\n\x05_seed\"\xb9\x04\n\x19GenerateRequestParameters\x12\x11\n\tdo_sample\x18\x01 \x01(\x08\x12\x16\n\x0emax_new_tokens\x18\x02 \x01(\r\x12\x1f\n\x12repetition_penalty\x18\x03 \x01(\x02H\x00\x88\x01\x01\x12\x1e\n\x11\x66requency_penalty\x18\x04 \x01(\x02H\x01\x88\x01\x01\x12\x18\n\x10return_full_text\x18\x05 \x01(\x08\x12\x0c\n\x04stop\x18\x06 \x03(\t\x12\x11\n\x04seed\x18\x07 \x01(\x04H\x02\x88\x01\x01\x12\x18\n\x0btemperature\x18\x08 \x01(\x02H\x03\x88\x01\x01\x12\x12\n\x05top_k\x18\t \x01(\rH\x04\x88\x01\x01\x12\x12\n\x05top_p\x18\n \x01(\x02H\x05\x88\x01\x01\x12\x15\n\x08truncate\x18\x0b \x01(\rH\x06\x88\x01\x01\x12\x16\n\ttypical_p\x18\x0c \x01(\x02H\x07\x88\x01\x01\x12\x14\n\x07\x62\x65st_of\x18\r \x01(\rH\x08\x88\x01\x01\x12\x11\n\twatermark\x18\x0e \x01(\x08\x12\x0f\n\x07\x64\x65tails\x18\x0f \x01(\x08\x12\x1d\n\x15\x64\x65\x63oder_input_details\x18\x10 \x01(\x08\x12\x19\n\x0ctop_n_tokens\x18\x11 \x01(\rH\t\x88\x01\x01\x42\x15\n\x13_repetition_penaltyB\x14\n\x12_frequency_penaltyB\x07\n\x05_seedB\x0e\n\x0c_temperatureB\x08\n\x06_top_kB\x08\n\x06_top_pB\x0b\n\t_truncateB\x0c\n\n_typical_pB\n\n\x08_best_ofB\x0f\n\r_top_n_tokens*f\n\x0c\x46inishReason\x12\x18\n\x14\x46INISH_REASON_LENGTH\x10\x00\x12\x1b\n\x17\x46INISH_REASON_EOS_TOKEN\x10\x01\x12\x1f\n\x1b\x46INISH_REASON_STOP_SEQUENCE\x10\x02\x32\xc3\x01\n\rTextGenerator\x12O\n\x0eGenerateStream\x12\x1c.proto.GenerateStreamRequest\x1a\x1d.proto.GenerateStreamResponse0\x01\x12\x61\n\x14\x43hatCompletionStream\x12\".proto.ChatCompletionStreamRequest\x1a#.proto.ChatCompletionStreamResponse0\x01\x42OZMgithub.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15infaas-generate.proto\x12\x05proto\"l\n\x15GenerateStreamRequest\x12\x11\n\trequestID\x18\x01 \x01(\t\x12\x0e\n\x06prompt\x18\x02 \x01(\t\x12\x30\n\x06params\x18\x03 \x01(\x0b\x32 .proto.GenerateRequestParameters\"\xc0\x04\n\x1b\x43hatCompletionStreamRequest\x12\x11\n\trequestID\x18\x01 \x01(\t\x12.\n\x08messages\x18\x02 \x03(\x0b\x32\x1c.proto.ChatCompletionMessage\x12\x1f\n\x12repetition_penalty\x18\x03 \x01(\x02H\x00\x88\x01\x01\x12\x1e\n\x11\x66requency_penalty\x18\x04 \x01(\x02H\x01\x88\x01\x01\x12\x12\n\nlogit_bias\x18\x05 \x03(\x02\x12\x1c\n\x0freturn_logprobs\x18\x06 \x01(\x08H\x02\x88\x01\x01\x12\x19\n\x0ctop_logprobs\x18\x07 \x01(\rH\x03\x88\x01\x01\x12\x17\n\nmax_tokens\x18\x08 \x01(\rH\x04\x88\x01\x01\x12\x1c\n\x0fnum_completions\x18\t \x01(\rH\x05\x88\x01\x01\x12\x1d\n\x10presence_penalty\x18\n \x01(\x02H\x06\x88\x01\x01\x12\x11\n\x04seed\x18\x0b \x01(\x04H\x07\x88\x01\x01\x12\x18\n\x0btemperature\x18\x0c \x01(\x02H\x08\x88\x01\x01\x12\x12\n\x05top_p\x18\r \x01(\x02H\t\x88\x01\x01\x12\x0c\n\x04stop\x18\x0e \x03(\t\x42\x15\n\x13_repetition_penaltyB\x14\n\x12_frequency_penaltyB\x12\n\x10_return_logprobsB\x0f\n\r_top_logprobsB\r\n\x0b_max_tokensB\x12\n\x10_num_completionsB\x13\n\x11_presence_penaltyB\x07\n\x05_seedB\x0e\n\x0c_temperatureB\x08\n\x06_top_p\"6\n\x15\x43hatCompletionMessage\x12\x0c\n\x04role\x18\x01 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\t\"\xd5\x01\n\x16GenerateStreamResponse\x12&\n\x05token\x18\x01 \x01(\x0b\x32\x17.proto.GenerateAPIToken\x12+\n\ntop_tokens\x18\x02 \x03(\x0b\x32\x17.proto.GenerateAPIToken\x12\x1b\n\x0egenerated_text\x18\x03 \x01(\tH\x00\x88\x01\x01\x12*\n\x07\x64\x65tails\x18\x04 \x01(\x0b\x32\x14.proto.StreamDetailsH\x01\x88\x01\x01\x42\x11\n\x0f_generated_textB\n\n\x08_details\"\xb4\x01\n\x1c\x43hatCompletionStreamResponse\x12\x14\n\x0c\x63ompletionID\x18\x01 \x01(\t\x12\x0e\n\x06object\x18\x02 \x01(\t\x12\x0f\n\x07\x63reated\x18\x03 \x01(\x04\x12\r\n\x05model\x18\x04 \x01(\t\x12\x1a\n\x12system_fingerprint\x18\x05 \x01(\t\x12\x32\n\x07\x63hoices\x18\x06 \x03(\x0b\x32!.proto.ChatCompletionStreamChoice\"G\n\x13\x43hatCompletionUsage\x12\x15\n\rprompt_tokens\x18\x01 \x01(\r\x12\x19\n\x11\x63ompletion_tokens\x18\x02 \x01(\r\"\x9f\x02\n\x1a\x43hatCompletionStreamChoice\x12\r\n\x05index\x18\x01 \x01(\r\x12\x30\n\x05\x64\x65lta\x18\x02 \x01(\x0b\x32\x1c.proto.ChatCompletionMessageH\x00\x88\x01\x01\x12\x41\n\x08logprobs\x18\x03 \x01(\x0b\x32*.proto.ChatCompletionStreamLogprobsContentH\x01\x88\x01\x01\x12\x1a\n\rfinish_reason\x18\x04 \x01(\tH\x02\x88\x01\x01\x12.\n\x05usage\x18\x05 \x01(\x0b\x32\x1a.proto.ChatCompletionUsageH\x03\x88\x01\x01\x42\x08\n\x06_deltaB\x0b\n\t_logprobsB\x10\n\x0e_finish_reasonB\x08\n\x06_usage\"Z\n#ChatCompletionStreamLogprobsContent\x12\x33\n\x07\x63ontent\x18\x01 \x03(\x0b\x32\".proto.ChatCompletionStreamLogprob\"=\n\x1b\x43hatCompletionStreamLogprob\x12\r\n\x05token\x18\x01 \x01(\t\x12\x0f\n\x07logprob\x18\x02 \x01(\x02\"_\n\x10GenerateAPIToken\x12\n\n\x02id\x18\x01 \x01(\r\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\x14\n\x07logprob\x18\x03 \x01(\x02H\x00\x88\x01\x01\x12\x0f\n\x07special\x18\x04 \x01(\x08\x42\n\n\x08_logprob\"q\n\rStreamDetails\x12*\n\rfinish_reason\x18\x01 \x01(\x0e\x32\x13.proto.FinishReason\x12\x18\n\x10generated_tokens\x18\x02 \x01(\r\x12\x11\n\x04seed\x18\x03 \x01(\x04H\x00\x88\x01\x01\x42\x07\n\x05_seed\"\xb9\x04\n\x19GenerateRequestParameters\x12\x11\n\tdo_sample\x18\x01 \x01(\x08\x12\x16\n\x0emax_new_tokens\x18\x02 \x01(\r\x12\x1f\n\x12repetition_penalty\x18\x03 \x01(\x02H\x00\x88\x01\x01\x12\x1e\n\x11\x66requency_penalty\x18\x04 \x01(\x02H\x01\x88\x01\x01\x12\x18\n\x10return_full_text\x18\x05 \x01(\x08\x12\x0c\n\x04stop\x18\x06 \x03(\t\x12\x11\n\x04seed\x18\x07 \x01(\x04H\x02\x88\x01\x01\x12\x18\n\x0btemperature\x18\x08 \x01(\x02H\x03\x88\x01\x01\x12\x12\n\x05top_k\x18\t \x01(\rH\x04\x88\x01\x01\x12\x12\n\x05top_p\x18\n \x01(\x02H\x05\x88\x01\x01\x12\x15\n\x08truncate\x18\x0b \x01(\rH\x06\x88\x01\x01\x12\x16\n\ttypical_p\x18\x0c \x01(\x02H\x07\x88\x01\x01\x12\x14\n\x07\x62\x65st_of\x18\r \x01(\rH\x08\x88\x01\x01\x12\x11\n\twatermark\x18\x0e \x01(\x08\x12\x0f\n\x07\x64\x65tails\x18\x0f \x01(\x08\x12\x1d\n\x15\x64\x65\x63oder_input_details\x18\x10 \x01(\x08\x12\x19\n\x0ctop_n_tokens\x18\x11 \x01(\rH\t\x88\x01\x01\x42\x15\n\x13_repetition_penaltyB\x14\n\x12_frequency_penaltyB\x07\n\x05_seedB\x0e\n\x0c_temperatureB\x08\n\x06_top_kB\x08\n\x06_top_pB\x0b\n\t_truncateB\x0c\n\n_typical_pB\n\n\x08_best_ofB\x0f\n\r_top_n_tokens*f\n\x0c\x46inishReason\x12\x18\n\x14\x46INISH_REASON_LENGTH\x10\x00\x12\x1b\n\x17\x46INISH_REASON_EOS_TOKEN\x10\x01\x12\x1f\n\x1b\x46INISH_REASON_STOP_SEQUENCE\x10\x02\x32\xc3\x01\n\rTextGenerator\x12O\n\x0eGenerateStream\x12\x1c.proto.GenerateStreamRequest\x1a\x1d.proto.GenerateStreamResponse0\x01\x12\x61\n\x14\x43hatCompletionStream\x12\".proto.ChatCompletionStreamRequest\x1a#.proto.ChatCompletionStreamResponse0\x01\x42OZMgithub.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -22,32 +22,32 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'infaas_generate_pb2', _glob
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'ZMgithub.com/intel-innersource/frameworks.cloud.devcloud.services.idc/go/pkg/pb'
-  _globals['_FINISHREASON']._serialized_start=2464
-  _globals['_FINISHREASON']._serialized_end=2566
+  _globals['_FINISHREASON']._serialized_start=2478
+  _globals['_FINISHREASON']._serialized_end=2580
   _globals['_GENERATESTREAMREQUEST']._serialized_start=32
   _globals['_GENERATESTREAMREQUEST']._serialized_end=140
   _globals['_CHATCOMPLETIONSTREAMREQUEST']._serialized_start=143
-  _globals['_CHATCOMPLETIONSTREAMREQUEST']._serialized_end=705
-  _globals['_CHATCOMPLETIONMESSAGE']._serialized_start=707
-  _globals['_CHATCOMPLETIONMESSAGE']._serialized_end=761
-  _globals['_GENERATESTREAMRESPONSE']._serialized_start=764
-  _globals['_GENERATESTREAMRESPONSE']._serialized_end=977
-  _globals['_CHATCOMPLETIONSTREAMRESPONSE']._serialized_start=980
-  _globals['_CHATCOMPLETIONSTREAMRESPONSE']._serialized_end=1160
-  _globals['_CHATCOMPLETIONUSAGE']._serialized_start=1162
-  _globals['_CHATCOMPLETIONUSAGE']._serialized_end=1233
-  _globals['_CHATCOMPLETIONSTREAMCHOICE']._serialized_start=1236
-  _globals['_CHATCOMPLETIONSTREAMCHOICE']._serialized_end=1523
-  _globals['_CHATCOMPLETIONSTREAMLOGPROBSCONTENT']._serialized_start=1525
-  _globals['_CHATCOMPLETIONSTREAMLOGPROBSCONTENT']._serialized_end=1615
-  _globals['_CHATCOMPLETIONSTREAMLOGPROB']._serialized_start=1617
-  _globals['_CHATCOMPLETIONSTREAMLOGPROB']._serialized_end=1678
-  _globals['_GENERATEAPITOKEN']._serialized_start=1680
-  _globals['_GENERATEAPITOKEN']._serialized_end=1775
-  _globals['_STREAMDETAILS']._serialized_start=1777
-  _globals['_STREAMDETAILS']._serialized_end=1890
-  _globals['_GENERATEREQUESTPARAMETERS']._serialized_start=1893
-  _globals['_GENERATEREQUESTPARAMETERS']._serialized_end=2462
-  _globals['_TEXTGENERATOR']._serialized_start=2569
-  _globals['_TEXTGENERATOR']._serialized_end=2764
+  _globals['_CHATCOMPLETIONSTREAMREQUEST']._serialized_end=719
+  _globals['_CHATCOMPLETIONMESSAGE']._serialized_start=721
+  _globals['_CHATCOMPLETIONMESSAGE']._serialized_end=775
+  _globals['_GENERATESTREAMRESPONSE']._serialized_start=778
+  _globals['_GENERATESTREAMRESPONSE']._serialized_end=991
+  _globals['_CHATCOMPLETIONSTREAMRESPONSE']._serialized_start=994
+  _globals['_CHATCOMPLETIONSTREAMRESPONSE']._serialized_end=1174
+  _globals['_CHATCOMPLETIONUSAGE']._serialized_start=1176
+  _globals['_CHATCOMPLETIONUSAGE']._serialized_end=1247
+  _globals['_CHATCOMPLETIONSTREAMCHOICE']._serialized_start=1250
+  _globals['_CHATCOMPLETIONSTREAMCHOICE']._serialized_end=1537
+  _globals['_CHATCOMPLETIONSTREAMLOGPROBSCONTENT']._serialized_start=1539
+  _globals['_CHATCOMPLETIONSTREAMLOGPROBSCONTENT']._serialized_end=1629
+  _globals['_CHATCOMPLETIONSTREAMLOGPROB']._serialized_start=1631
+  _globals['_CHATCOMPLETIONSTREAMLOGPROB']._serialized_end=1692
+  _globals['_GENERATEAPITOKEN']._serialized_start=1694
+  _globals['_GENERATEAPITOKEN']._serialized_end=1789
+  _globals['_STREAMDETAILS']._serialized_start=1791
+  _globals['_STREAMDETAILS']._serialized_end=1904
+  _globals['_GENERATEREQUESTPARAMETERS']._serialized_start=1907
+  _globals['_GENERATEREQUESTPARAMETERS']._serialized_end=2476
+  _globals['_TEXTGENERATOR']._serialized_start=2583
+  _globals['_TEXTGENERATOR']._serialized_end=2778
 # @@protoc_insertion_point(module_scope)
@@ -76,6 +76,7 @@ class ChatCompletionStreamRequest(google.protobuf.message.Message):
     SEED_FIELD_NUMBER: builtins.int
     TEMPERATURE_FIELD_NUMBER: builtins.int
     TOP_P_FIELD_NUMBER: builtins.int
+    STOP_FIELD_NUMBER: builtins.int
     requestID: builtins.str
     repetition_penalty: builtins.float
     """/ The parameter for repetition penalty. 1.0 means no penalty.
@@ -112,6 +113,10 @@ class ChatCompletionStreamRequest(google.protobuf.message.Message):
     def logit_bias(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.float]:
         """/ Adjust the likelihood of specified tokens."""
 
+    @property
+    def stop(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """/ Stop generating tokens if a member of `stop` is generated."""
+
     def __init__(
         self,
         *,
@@ -128,9 +133,10 @@ class ChatCompletionStreamRequest(google.protobuf.message.Message):
         seed: builtins.int | None = ...,
         temperature: builtins.float | None = ...,
         top_p: builtins.float | None = ...,
+        stop: collections.abc.Iterable[builtins.str] | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing.Literal["_frequency_penalty", b"_frequency_penalty", "_max_tokens", b"_max_tokens", "_num_completions", b"_num_completions", "_presence_penalty", b"_presence_penalty", "_repetition_penalty", b"_repetition_penalty", "_return_logprobs", b"_return_logprobs", "_seed", b"_seed", "_temperature", b"_temperature", "_top_logprobs", b"_top_logprobs", "_top_p", b"_top_p", "frequency_penalty", b"frequency_penalty", "max_tokens", b"max_tokens", "num_completions", b"num_completions", "presence_penalty", b"presence_penalty", "repetition_penalty", b"repetition_penalty", "return_logprobs", b"return_logprobs", "seed", b"seed", "temperature", b"temperature", "top_logprobs", b"top_logprobs", "top_p", b"top_p"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing.Literal["_frequency_penalty", b"_frequency_penalty", "_max_tokens", b"_max_tokens", "_num_completions", b"_num_completions", "_presence_penalty", b"_presence_penalty", "_repetition_penalty", b"_repetition_penalty", "_return_logprobs", b"_return_logprobs", "_seed", b"_seed", "_temperature", b"_temperature", "_top_logprobs", b"_top_logprobs", "_top_p", b"_top_p", "frequency_penalty", b"frequency_penalty", "logit_bias", b"logit_bias", "max_tokens", b"max_tokens", "messages", b"messages", "num_completions", b"num_completions", "presence_penalty", b"presence_penalty", "repetition_penalty", b"repetition_penalty", "requestID", b"requestID", "return_logprobs", b"return_logprobs", "seed", b"seed", "temperature", b"temperature", "top_logprobs", b"top_logprobs", "top_p", b"top_p"]) -> None: ...
+    def ClearField(self, field_name: typing.Literal["_frequency_penalty", b"_frequency_penalty", "_max_tokens", b"_max_tokens", "_num_completions", b"_num_completions", "_presence_penalty", b"_presence_penalty", "_repetition_penalty", b"_repetition_penalty", "_return_logprobs", b"_return_logprobs", "_seed", b"_seed", "_temperature", b"_temperature", "_top_logprobs", b"_top_logprobs", "_top_p", b"_top_p", "frequency_penalty", b"frequency_penalty", "logit_bias", b"logit_bias", "max_tokens", b"max_tokens", "messages", b"messages", "num_completions", b"num_completions", "presence_penalty", b"presence_penalty", "repetition_penalty", b"repetition_penalty", "requestID", b"requestID", "return_logprobs", b"return_logprobs", "seed", b"seed", "stop", b"stop", "temperature", b"temperature", "top_logprobs", b"top_logprobs", "top_p", b"top_p"]) -> None: ...
     @typing.overload
     def WhichOneof(self, oneof_group: typing.Literal["_frequency_penalty", b"_frequency_penalty"]) -> typing.Literal["frequency_penalty"] | None: ...
     @typing.overload
@@ -57,10 +57,17 @@ class LlmResponseHandler:
                     logprobs=choice.logprobs
                 ),
                 finish_reason=choice.finish_reason,
+                # the usage is also needed when the generation ends
+                # without the eos_token, e.g. on max_tokens, to meter
+                # the prompt tokens. It is sent with the first token as
+                # well, since the gateway cancels the generation when it
+                # finds a stop sequence.
                 usage=(
                     await self._get_usage(
                         messages, num_generated_tokens
-                    ) if await self._is_eos_token(choice.delta.content)
+                    ) if choice.finish_reason is not None
+                    or num_generated_tokens == 1
+                    or await self._is_eos_token(choice.delta.content)
                     else None
                 )
             ) for choice in response.choices
@@ -148,6 +148,7 @@ class AbstractWrapper(ABC):
             seed: Optional[int] = None,
             temperature: Optional[float] = None,
             top_p: Optional[float] = None,
+            stop: Optional[List[str]] = None,
             # We're not supporting tools at the moment
             # tools: Optional[List[Tool]] = None,
             # tool_choice: Optional[str] = None,
@@ -194,6 +195,8 @@ class AbstractWrapper(ABC):
                 If set to < 1, only the smallest set of most probable tokens
                 with probabilities that add up to `top_p` or higher are kept
                 for generation.
+            stop (`List[str]`):
+                Stop generating tokens if a member of `stop` is generated
             tools (`List[Tool]`):
                 List of tools to use
                 ** NOT SUPPORTED AT THE MOMENT
@@ -225,6 +225,7 @@ class OpenAiWrapper(AbstractWrapper):
             seed: Optional[int] = None,
             temperature: Optional[float] = None,
             top_p: Optional[float] = None,
+            stop: Optional[List[str]] = None,
 
     ) -> AsyncIterator[ChatCompletion]:
         """
@@ -269,6 +270,8 @@ class OpenAiWrapper(AbstractWrapper):
                 If set to < 1, only the smallest set of most probable tokens
                 with probabilities that add up to `top_p` or higher are kept
                 for generation.
+            stop (`List[str]`):
+                Stop generating tokens if a member of `stop` is generated
             tools (`List[Tool]`):
                 List of tools to use
                 ** NOT SUPPORTED AT THE MOMENT
@@ -307,6 +310,7 @@ class OpenAiWrapper(AbstractWrapper):
             seed=openAI_np.seed,
             temperature=openAI_np.temperature,
             top_p=openAI_np.top_p,
+            stop=stop or None,
         )
 
         async for new_pred in await chat_completion:
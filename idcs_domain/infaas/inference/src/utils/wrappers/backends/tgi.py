@@ -245,6 +245,7 @@ class TgiWrapper(AbstractWrapper):
         seed: Optional[int] = None,
         temperature: Optional[float] = None,
         top_p: Optional[float] = None,
+        stop: Optional[List[str]] = None,
         # We're not supporting tools at the moment
         # tools: Optional[List[Tool]] = None,
         # tool_choice: Optional[str] = None,
@@ -291,6 +292,8 @@ class TgiWrapper(AbstractWrapper):
                 If set to < 1, only the smallest set of most probable tokens
                 with probabilities that add up to `top_p` or higher are kept
                 for generation.
+            stop (`List[str]`):
+                Stop generating tokens if a member of `stop` is generated
             tools (`List[Tool]`):
                 List of tools to use
                 ** NOT SUPPORTED AT THE MOMENT
@@ -329,6 +332,7 @@ class TgiWrapper(AbstractWrapper):
             seed=tgi_np.seed,
             temperature=tgi_np.temperature,
             top_p=tgi_np.top_p,
+            stop=stop or None,
             tools=None,
             tool_choice=None
         )
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"time"
 )
 
 type Backend struct {
@@ -57,6 +58,13 @@ type StatefulClientOps interface {
 	ListStatefulClients(ctx context.Context, opts ListStatefulClientsOpts) ([]*StatefulClient, error)
 }
 
+type SnapshotOps interface {
+	CreateSnapshot(ctx context.Context, opts CreateSnapshotOpts) (*Snapshot, error)
+	DeleteSnapshot(ctx context.Context, opts DeleteSnapshotOpts) error
+	ListSnapshots(ctx context.Context, opts ListSnapshotsOpts) ([]*Snapshot, error)
+	RestoreSnapshot(ctx context.Context, opts RestoreSnapshotOpts) (*RestoredFilesystem, error)
+}
+
 type UserOps interface {
 	CreateUser(ctx context.Context, opts CreateUserOpts) (*User, error)
 	DeleteUser(ctx context.Context, opts DeleteUserOpts) error
@@ -133,6 +141,39 @@ type UpdateUserPasswordOpts struct {
 	AuthCreds   *AuthCreds
 }
 
+type CreateSnapshotOpts struct {
+	NamespaceID  string
+	FilesystemID string
+	Name         string
+	AuthCreds    *AuthCreds
+}
+
+type DeleteSnapshotOpts struct {
+	NamespaceID string
+	SnapshotID  string
+	AuthCreds   *AuthCreds
+}
+
+type ListSnapshotsOpts struct {
+	NamespaceID string
+	// If empty, snapshots of all filesystems in the namespace are returned
+	FilesystemID string
+	Names        []string
+	AuthCreds    *AuthCreds
+}
+
+// RestoreSnapshotOpts restores snapshot content into a new filesystem,
+// the source filesystem is not modified
+type RestoreSnapshotOpts struct {
+	NamespaceID string
+	SnapshotID  string
+	// Name of the new filesystem
+	Name string
+	// Quota of the new filesystem, should not be less than the source filesystem quota
+	Quota     uint64
+	AuthCreds *AuthCreds
+}
+
 type CreateBucketOpts struct {
 	Name         string
 	AccessPolicy AccessPolicy
@@ -272,6 +313,20 @@ type Bucket struct {
 	EndpointURL    string
 }
 
+type Snapshot struct {
+	ID           string
+	Name         string
+	FilesystemID string
+	CreatedAt    time.Time
+	// Capacity used only by the snapshot, i.e. data that is no longer referenced by the live filesystem
+	UsedBytes uint64
+}
+
+type RestoredFilesystem struct {
+	ID   string
+	Name string
+}
+
 type AccessPolicy int
 
 const (
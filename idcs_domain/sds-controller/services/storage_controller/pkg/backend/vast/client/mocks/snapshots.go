@@ -0,0 +1,116 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2024 Intel Corporation
+package mocks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	client "github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend/vast/client"
+)
+
+var snapshot = client.Snapshot{
+	Id:          w(3),
+	Name:        "snap",
+	Path:        "/view/",
+	TenantId:    w(1),
+	Created:     &time.Time{},
+	UniqueBytes: w(uint64(1024)),
+}
+
+func (*MockVastClient) SnapshotsCreateWithResponse(ctx context.Context, body client.SnapshotsCreateJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.SnapshotsCreateResponse, error) {
+	if ctx.Value("testing.SnapshotsCreateWithResponse.error") != nil {
+		return nil, errors.New("something wrong")
+	}
+
+	if ctx.Value("testing.SnapshotsCreateWithResponse.empty") != nil {
+		return &client.SnapshotsCreateResponse{}, nil
+	}
+
+	return &client.SnapshotsCreateResponse{
+		HTTPResponse: &http.Response{
+			StatusCode: 201,
+		},
+		JSON201: &snapshot,
+	}, nil
+}
+
+func (*MockVastClient) SnapshotsListWithResponse(ctx context.Context, params *client.SnapshotsListParams, reqEditors ...client.RequestEditorFn) (*client.SnapshotsListResponse, error) {
+	if ctx.Value("testing.SnapshotsListWithResponse.error") != nil {
+		return nil, errors.New("something wrong")
+	}
+
+	if ctx.Value("testing.SnapshotsListWithResponse.empty") != nil {
+		return &client.SnapshotsListResponse{}, nil
+	}
+
+	return &client.SnapshotsListResponse{
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+		},
+		JSON200: &[]client.Snapshot{snapshot},
+	}, nil
+}
+
+func (*MockVastClient) SnapshotsReadWithResponse(ctx context.Context, id int, reqEditors ...client.RequestEditorFn) (*client.SnapshotsReadResponse, error) {
+	if ctx.Value("testing.SnapshotsReadWithResponse.error") != nil {
+		return nil, errors.New("something wrong")
+	}
+
+	if ctx.Value("testing.SnapshotsReadWithResponse.empty") != nil {
+		return &client.SnapshotsReadResponse{}, nil
+	}
+
+	return &client.SnapshotsReadResponse{
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+		},
+		JSON200: &snapshot,
+	}, nil
+}
+
+func (*MockVastClient) SnapshotsDeleteWithResponse(ctx context.Context, id int, reqEditors ...client.RequestEditorFn) (*client.SnapshotsDeleteResponse, error) {
+	if ctx.Value("testing.SnapshotsDeleteWithResponse.error") != nil {
+		return nil, errors.New("something wrong")
+	}
+
+	if ctx.Value("testing.SnapshotsDeleteWithResponse.empty") != nil {
+		return &client.SnapshotsDeleteResponse{}, nil
+	}
+
+	if ctx.Value("testing.SnapshotsDeleteWithResponse.404") != nil {
+		return &client.SnapshotsDeleteResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 404,
+			},
+		}, nil
+	}
+
+	return &client.SnapshotsDeleteResponse{
+		HTTPResponse: &http.Response{
+			StatusCode: 204,
+		},
+	}, nil
+}
+
+func (*MockVastClient) GlobalsnapstreamsCreateWithResponse(ctx context.Context, body client.GlobalsnapstreamsCreateJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.GlobalsnapstreamsCreateResponse, error) {
+	if ctx.Value("testing.GlobalsnapstreamsCreateWithResponse.error") != nil {
+		return nil, errors.New("something wrong")
+	}
+
+	if ctx.Value("testing.GlobalsnapstreamsCreateWithResponse.empty") != nil {
+		return &client.GlobalsnapstreamsCreateResponse{}, nil
+	}
+
+	return &client.GlobalsnapstreamsCreateResponse{
+		HTTPResponse: &http.Response{
+			StatusCode: 201,
+		},
+		JSON201: &client.GlobalSnapStream{
+			Id:   w(4),
+			Name: body.Name,
+		},
+	}, nil
+}
@@ -16,6 +16,7 @@ var view = client.View{
 	Path:     "/view",
 	Protocols: &[]client.ViewProtocols{client.NFS},
 	PolicyId: 1,
+	TenantId: w(1),
 }
 
 func (*MockVastClient) ViewpoliciesCreateWithResponse(ctx context.Context, body client.ViewPolicy, reqEditors ...client.RequestEditorFn) (*client.ViewpoliciesCreateResponse, error) {
@@ -117,3 +118,20 @@ func (*MockVastClient) ViewsUpdateWithResponse(ctx context.Context, id int, body
 		},
 	}, nil
 }
+
+func (*MockVastClient) ViewsReadWithResponse(ctx context.Context, id int, reqEditors ...client.RequestEditorFn) (*client.ViewsReadResponse, error) {
+	if ctx.Value("testing.ViewsReadWithResponse.error") != nil {
+		return nil, errors.New("something wrong")
+	}
+
+	if ctx.Value("testing.ViewsReadWithResponse.empty") != nil {
+		return &client.ViewsReadResponse{}, nil
+	}
+
+	return &client.ViewsReadResponse{
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+		},
+		JSON200: &view,
+	}, nil
+}
@@ -0,0 +1,244 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2024 Intel Corporation
+package vast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend"
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend/vast/client"
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/server/helpers"
+	"github.com/rs/zerolog/log"
+)
+
+// In VAST filesystem is represented by the view, snapshots are taken of the view path.
+func (b *Backend) CreateSnapshot(ctx context.Context, opts backend.CreateSnapshotOpts) (*backend.Snapshot, error) {
+	tenantId, err := strconv.Atoi(opts.NamespaceID)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("namespace ID has invalid value")
+		return nil, err
+	}
+
+	view, err := b.GetView(ctx, GetViewOpts{
+		NamespaceID: opts.NamespaceID,
+		ViewID:      opts.FilesystemID,
+	})
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Could not get view by ID")
+		return nil, err
+	}
+
+	token, err := b.login(ctx, b.adminCredentials)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Admin login unsuccessful")
+		return nil, err
+	}
+
+	resp, err := b.client.SnapshotsCreateWithResponse(ctx, client.SnapshotsCreateJSONRequestBody{
+		Name:     opts.Name,
+		Path:     snapshotPath(view.Path),
+		TenantId: &tenantId,
+	}, client.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Vast API (SnapshotsCreate)")
+		return nil, err
+	}
+
+	if resp.StatusCode() != 201 || resp.JSON201 == nil {
+		return nil, backend.ResponseAsErr("could not create snapshot", resp.StatusCode(), resp.Body)
+	}
+
+	return intoSnapshot(*resp.JSON201, opts.FilesystemID)
+}
+
+func (b *Backend) DeleteSnapshot(ctx context.Context, opts backend.DeleteSnapshotOpts) error {
+	id, err := strconv.Atoi(opts.SnapshotID)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("ID has invalid value")
+		return err
+	}
+
+	token, err := b.login(ctx, b.adminCredentials)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Admin login unsuccessful")
+		return err
+	}
+
+	resp, err := b.client.SnapshotsDeleteWithResponse(ctx, id, client.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Vast API (SnapshotsDelete)")
+		return err
+	}
+
+	// Deleting snapshot which does not exist is not an error
+	if resp.StatusCode() != 204 && resp.StatusCode() != 404 {
+		return backend.ResponseAsErr("could not delete snapshot", resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+func (b *Backend) ListSnapshots(ctx context.Context, opts backend.ListSnapshotsOpts) ([]*backend.Snapshot, error) {
+	tenantId, err := strconv.Atoi(opts.NamespaceID)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("namespace ID has invalid value")
+		return nil, err
+	}
+
+	token, err := b.login(ctx, b.adminCredentials)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Admin login unsuccessful")
+		return nil, err
+	}
+
+	// Snapshots reference path, so resolve the view owning each path
+	views, err := b.getViews(ctx, tenantId, nil, token)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Could not list views")
+		return nil, err
+	}
+	viewIds := make(map[string]string)
+	for _, view := range views {
+		viewIds[snapshotPath(view.Path)] = view.ID
+	}
+
+	resp, err := b.client.SnapshotsListWithResponse(ctx, &client.SnapshotsListParams{
+		TenantId: &tenantId,
+	}, client.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Vast API (SnapshotsList)")
+		return nil, err
+	}
+
+	if resp.StatusCode() != 200 || resp.JSON200 == nil {
+		return nil, backend.ResponseAsErr("could not list snapshots", resp.StatusCode(), resp.Body)
+	}
+
+	snapshots := make([]*backend.Snapshot, 0)
+
+	for _, s := range *resp.JSON200 {
+		snapshot, err := intoSnapshot(s, viewIds[s.Path])
+		if err != nil {
+			log.Error().Ctx(ctx).Err(err).Msg("Could not parse snapshot")
+			continue
+		}
+
+		if opts.FilesystemID != "" && snapshot.FilesystemID != opts.FilesystemID {
+			continue
+		}
+
+		if len(opts.Names) > 0 && !slices.Contains(opts.Names, snapshot.Name) {
+			continue
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
+// RestoreSnapshot clones snapshot into a new path next to the source view and exposes it
+// with a new view. The clone is independent of the source view.
+func (b *Backend) RestoreSnapshot(ctx context.Context, opts backend.RestoreSnapshotOpts) (*backend.RestoredFilesystem, error) {
+	tenantId, err := strconv.Atoi(opts.NamespaceID)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("namespace ID has invalid value")
+		return nil, err
+	}
+
+	id, err := strconv.Atoi(opts.SnapshotID)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("ID has invalid value")
+		return nil, err
+	}
+
+	token, err := b.login(ctx, b.adminCredentials)
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Admin login unsuccessful")
+		return nil, err
+	}
+
+	snapResp, err := b.client.SnapshotsReadWithResponse(ctx, id, client.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Vast API (SnapshotsRead)")
+		return nil, err
+	}
+
+	if snapResp.StatusCode() != 200 || snapResp.JSON200 == nil || snapResp.JSON200.TenantId == nil {
+		return nil, backend.ResponseAsErr("could not get snapshot", snapResp.StatusCode(), snapResp.Body)
+	}
+
+	if *snapResp.JSON200.TenantId != tenantId {
+		return nil, fmt.Errorf("could not restore snapshot as it does not belongs to namespace %s!=%s", strconv.Itoa(*snapResp.JSON200.TenantId), opts.NamespaceID)
+	}
+
+	restorePath := path.Join(path.Dir(strings.TrimSuffix(snapResp.JSON200.Path, "/")), opts.Name)
+	enabled := true
+
+	cloneResp, err := b.client.GlobalsnapstreamsCreateWithResponse(ctx, client.GlobalsnapstreamsCreateJSONRequestBody{
+		Name:             &opts.Name,
+		LoaneeRootPath:   &restorePath,
+		LoaneeTenantId:   &tenantId,
+		LoaneeSnapshotId: &id,
+		Enabled:          &enabled,
+	}, client.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Vast API (GlobalsnapstreamsCreate)")
+		return nil, err
+	}
+
+	if cloneResp.StatusCode() != 201 || cloneResp.JSON201 == nil {
+		return nil, backend.ResponseAsErr("could not clone snapshot", cloneResp.StatusCode(), cloneResp.Body)
+	}
+
+	view, err := b.CreateView(ctx, CreateViewOpts{
+		NamespaceID: opts.NamespaceID,
+		Name:        opts.Name,
+		Path:        restorePath,
+		Protocols:   []Protocol{NFSV3},
+		Quota:       opts.Quota,
+	})
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Str("path", restorePath).Msg("Could not create view for restored snapshot")
+		return nil, err
+	}
+
+	return &backend.RestoredFilesystem{
+		ID:   view.ID,
+		Name: view.Name,
+	}, nil
+}
+
+// VAST snapshot paths always end with the slash
+func snapshotPath(viewPath string) string {
+	return strings.TrimSuffix(viewPath, "/") + "/"
+}
+
+func intoSnapshot(s client.Snapshot, filesystemID string) (*backend.Snapshot, error) {
+	if s.Id == nil {
+		return nil, errors.New("snapshot does not contain id")
+	}
+
+	snapshot := &backend.Snapshot{
+		ID:           strconv.Itoa(*s.Id),
+		Name:         s.Name,
+		FilesystemID: filesystemID,
+	}
+	if s.Created != nil {
+		snapshot.CreatedAt = *s.Created
+	}
+	snapshot.UsedBytes = helpers.ValueOrNil(s.UniqueBytes)
+
+	return snapshot, nil
+}
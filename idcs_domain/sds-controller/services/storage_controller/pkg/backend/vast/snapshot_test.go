@@ -0,0 +1,306 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2024 Intel Corporation
+package vast
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend"
+	"github.com/stretchr/testify/assert"
+)
+
+var defaultSnapshot = backend.Snapshot{
+	ID:           "3",
+	Name:         "snap",
+	FilesystemID: "2",
+	CreatedAt:    time.Time{},
+	UsedBytes:    1024,
+}
+
+func TestBackend_CreateSnapshot(t *testing.T) {
+	opts := backend.CreateSnapshotOpts{
+		NamespaceID:  defaultNamespace.ID,
+		FilesystemID: "2",
+		Name:         "snap",
+	}
+	type args struct {
+		ctx  context.Context
+		opts backend.CreateSnapshotOpts
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *backend.Snapshot
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "create snapshot",
+			args: args{
+				ctx:  context.Background(),
+				opts: opts,
+			},
+			want:      &defaultSnapshot,
+			assertion: assert.NoError,
+		},
+		{
+			name: "incorrect namespace",
+			args: args{
+				ctx: context.Background(),
+				opts: backend.CreateSnapshotOpts{
+					NamespaceID:  "2",
+					FilesystemID: "2",
+					Name:         "snap",
+				},
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "view not found",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.ViewsReadWithResponse.empty", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "api error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.SnapshotsCreateWithResponse.error", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "empty response",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.SnapshotsCreateWithResponse.empty", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newMockBackend(mockBackendOpts{})
+			got, err := b.CreateSnapshot(tt.args.ctx, tt.args.opts)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBackend_DeleteSnapshot(t *testing.T) {
+	opts := backend.DeleteSnapshotOpts{
+		NamespaceID: defaultNamespace.ID,
+		SnapshotID:  "3",
+	}
+	type args struct {
+		ctx  context.Context
+		opts backend.DeleteSnapshotOpts
+	}
+	tests := []struct {
+		name      string
+		args      args
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "delete snapshot",
+			args: args{
+				ctx:  context.Background(),
+				opts: opts,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "delete not existing snapshot",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.SnapshotsDeleteWithResponse.404", true),
+				opts: opts,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "invalid id",
+			args: args{
+				ctx: context.Background(),
+				opts: backend.DeleteSnapshotOpts{
+					NamespaceID: defaultNamespace.ID,
+					SnapshotID:  "snap",
+				},
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "api error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.SnapshotsDeleteWithResponse.error", true),
+				opts: opts,
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "empty response",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.SnapshotsDeleteWithResponse.empty", true),
+				opts: opts,
+			},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newMockBackend(mockBackendOpts{})
+			tt.assertion(t, b.DeleteSnapshot(tt.args.ctx, tt.args.opts))
+		})
+	}
+}
+
+func TestBackend_ListSnapshots(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		opts backend.ListSnapshotsOpts
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      []*backend.Snapshot
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "list snapshots of view",
+			args: args{
+				ctx: context.Background(),
+				opts: backend.ListSnapshotsOpts{
+					NamespaceID:  defaultNamespace.ID,
+					FilesystemID: "2",
+				},
+			},
+			want:      []*backend.Snapshot{&defaultSnapshot},
+			assertion: assert.NoError,
+		},
+		{
+			name: "filter by name",
+			args: args{
+				ctx: context.Background(),
+				opts: backend.ListSnapshotsOpts{
+					NamespaceID: defaultNamespace.ID,
+					Names:       []string{"other"},
+				},
+			},
+			want:      []*backend.Snapshot{},
+			assertion: assert.NoError,
+		},
+		{
+			name: "api error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.SnapshotsListWithResponse.error", true),
+				opts: backend.ListSnapshotsOpts{NamespaceID: defaultNamespace.ID},
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "empty response",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.SnapshotsListWithResponse.empty", true),
+				opts: backend.ListSnapshotsOpts{NamespaceID: defaultNamespace.ID},
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newMockBackend(mockBackendOpts{})
+			got, err := b.ListSnapshots(tt.args.ctx, tt.args.opts)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBackend_RestoreSnapshot(t *testing.T) {
+	opts := backend.RestoreSnapshotOpts{
+		NamespaceID: defaultNamespace.ID,
+		SnapshotID:  "3",
+		Name:        "view",
+		Quota:       defaultQuota,
+	}
+	type args struct {
+		ctx  context.Context
+		opts backend.RestoreSnapshotOpts
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *backend.RestoredFilesystem
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "restore snapshot",
+			args: args{
+				ctx:  context.Background(),
+				opts: opts,
+			},
+			want: &backend.RestoredFilesystem{
+				ID:   "2",
+				Name: "view",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "incorrect namespace",
+			args: args{
+				ctx: context.Background(),
+				opts: backend.RestoreSnapshotOpts{
+					NamespaceID: "2",
+					SnapshotID:  "3",
+					Name:        "view",
+				},
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "snapshot not found",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.SnapshotsReadWithResponse.empty", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "clone error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.GlobalsnapstreamsCreateWithResponse.error", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "view create error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.ViewsCreateWithResponse.error", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newMockBackend(mockBackendOpts{})
+			got, err := b.RestoreSnapshot(tt.args.ctx, tt.args.opts)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
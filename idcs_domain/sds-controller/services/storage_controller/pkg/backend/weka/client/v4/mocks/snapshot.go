@@ -0,0 +1,133 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package mocks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v4 "github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend/weka/client/v4"
+)
+
+func (*MockWekaClient) CreateSnapshotWithResponse(ctx context.Context, body v4.CreateSnapshotJSONRequestBody, reqEditors ...v4.RequestEditorFn) (*v4.CreateSnapshotResponse, error) {
+	resp := v4.CreateSnapshotResponse{
+		JSON200: &struct {
+			Data *v4.Snapshot "json:\"data,omitempty\""
+		}{
+			Data: &v4.Snapshot{
+				Uid:          w("snapId"),
+				Name:         w(body.Name),
+				FsUid:        w(body.FsUid),
+				CreationTime: w("2024-01-01T00:00:00Z"),
+			},
+		},
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+		},
+	}
+
+	if ctx.Value("testing.CreateSnapshotWithResponse.error") != nil {
+		return nil, errors.New("Something wrong")
+	}
+
+	if ctx.Value("testing.CreateSnapshotWithResponse.empty") != nil {
+		return &v4.CreateSnapshotResponse{}, nil
+	}
+
+	if ctx.Value("testing.CreateSnapshotWithResponse.incomplete") != nil {
+		resp.JSON200.Data.Uid = nil
+		return &resp, nil
+	}
+
+	return &resp, nil
+}
+
+func (*MockWekaClient) DeleteSnapshotWithResponse(ctx context.Context, uid string, reqEditors ...v4.RequestEditorFn) (*v4.DeleteSnapshotResponse, error) {
+	resp := v4.DeleteSnapshotResponse{
+		JSON200: &v4.N200{},
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+		},
+	}
+
+	if ctx.Value("testing.DeleteSnapshotWithResponse.error") != nil {
+		return nil, errors.New("Something wrong")
+	}
+
+	if ctx.Value("testing.DeleteSnapshotWithResponse.404") != nil {
+		return &v4.DeleteSnapshotResponse{
+			JSON404: &v4.N404{},
+			HTTPResponse: &http.Response{
+				StatusCode: 404,
+			},
+		}, nil
+	}
+
+	if ctx.Value("testing.DeleteSnapshotWithResponse.empty") != nil {
+		return &v4.DeleteSnapshotResponse{}, nil
+	}
+
+	return &resp, nil
+}
+
+func (*MockWekaClient) GetSnapshotsWithResponse(ctx context.Context, reqEditors ...v4.RequestEditorFn) (*v4.GetSnapshotsResponse, error) {
+	resp := v4.GetSnapshotsResponse{
+		JSON200: &struct {
+			Data *[]v4.Snapshot "json:\"data,omitempty\""
+		}{
+			Data: &[]v4.Snapshot{
+				{
+					Uid:          w("snapId"),
+					Name:         w("snapName"),
+					FsUid:        w("fsId"),
+					CreationTime: w("2024-01-01T00:00:00Z"),
+				},
+				{
+					Uid:          w("snapId2"),
+					Name:         w("snapName2"),
+					FsUid:        w("fsId2"),
+					CreationTime: w("2024-01-01T00:00:00Z"),
+				},
+			},
+		},
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+		},
+	}
+
+	if ctx.Value("testing.GetSnapshotsWithResponse.error") != nil {
+		return nil, errors.New("Something wrong")
+	}
+
+	if ctx.Value("testing.GetSnapshotsWithResponse.empty") != nil {
+		return &v4.GetSnapshotsResponse{}, nil
+	}
+
+	if ctx.Value("testing.GetSnapshotsWithResponse.incomplete") != nil {
+		snaps := *resp.JSON200.Data
+		snaps[0].Uid = nil
+		return &resp, nil
+	}
+
+	return &resp, nil
+}
+
+func (*MockWekaClient) RestoreFileSystemFromSnapshotWithResponse(ctx context.Context, uid string, body v4.RestoreFileSystemFromSnapshotJSONRequestBody, reqEditors ...v4.RequestEditorFn) (*v4.RestoreFileSystemFromSnapshotResponse, error) {
+	resp := v4.RestoreFileSystemFromSnapshotResponse{
+		JSON200: &v4.N200{},
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+		},
+	}
+
+	if ctx.Value("testing.RestoreFileSystemFromSnapshotWithResponse.error") != nil {
+		return nil, errors.New("Something wrong")
+	}
+
+	if ctx.Value("testing.RestoreFileSystemFromSnapshotWithResponse.empty") != nil {
+		return &v4.RestoreFileSystemFromSnapshotResponse{}, nil
+	}
+
+	return &resp, nil
+}
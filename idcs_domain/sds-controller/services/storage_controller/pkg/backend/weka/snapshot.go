@@ -0,0 +1,191 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package weka
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend"
+	v4 "github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend/weka/client/v4"
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/server/helpers"
+	"github.com/rs/zerolog/log"
+)
+
+func (b *Backend) CreateSnapshot(ctx context.Context, opts backend.CreateSnapshotOpts) (*backend.Snapshot, error) {
+	if opts.AuthCreds == nil {
+		return nil, errors.New("invalid credentials")
+	}
+	token, err := b.loginUser(ctx, *opts.AuthCreds, opts.NamespaceID)
+	if err != nil {
+		log.Info().Ctx(ctx).Err(err).Msg("User login unsuccessful")
+		return nil, err
+	}
+
+	// Snapshots are exposed under the .snapshots directory of the filesystem using the access point name
+	accessPoint := opts.Name
+	isWritable := false
+	resp, err := b.client.CreateSnapshotWithResponse(ctx, v4.CreateSnapshotJSONRequestBody{
+		FsUid:       opts.FilesystemID,
+		Name:        opts.Name,
+		AccessPoint: &accessPoint,
+		IsWritable:  &isWritable,
+	}, v4.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Weka API")
+		return nil, err
+	}
+
+	if resp.StatusCode() != 200 || resp.JSON200 == nil || resp.JSON200.Data == nil {
+		return nil, backend.ResponseAsErr("could not create snapshot", resp.StatusCode(), resp.Body)
+	}
+
+	return intoSnapshot(*resp.JSON200.Data, opts.FilesystemID)
+}
+
+func (b *Backend) DeleteSnapshot(ctx context.Context, opts backend.DeleteSnapshotOpts) error {
+	if opts.AuthCreds == nil {
+		return errors.New("invalid credentials")
+	}
+	token, err := b.loginUser(ctx, *opts.AuthCreds, opts.NamespaceID)
+	if err != nil {
+		log.Info().Ctx(ctx).Err(err).Msg("User login unsuccessful")
+		return err
+	}
+
+	resp, err := b.client.DeleteSnapshotWithResponse(ctx, opts.SnapshotID, v4.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Weka API")
+		return err
+	}
+
+	// Deleting snapshot which does not exist is not an error
+	if resp.StatusCode() != 200 && resp.StatusCode() != 404 {
+		return backend.ResponseAsErr("could not delete snapshot", resp.StatusCode(), resp.Body)
+	}
+
+	return nil
+}
+
+func (b *Backend) ListSnapshots(ctx context.Context, opts backend.ListSnapshotsOpts) ([]*backend.Snapshot, error) {
+	if opts.AuthCreds == nil {
+		return nil, errors.New("invalid credentials")
+	}
+	token, err := b.loginUser(ctx, *opts.AuthCreds, opts.NamespaceID)
+	if err != nil {
+		log.Info().Ctx(ctx).Err(err).Msg("User login unsuccessful")
+		return nil, err
+	}
+
+	resp, err := b.client.GetSnapshotsWithResponse(ctx, v4.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Weka API")
+		return nil, err
+	}
+
+	if resp.StatusCode() != 200 || resp.JSON200 == nil || resp.JSON200.Data == nil {
+		return nil, backend.ResponseAsErr("could not get snapshots", resp.StatusCode(), resp.Body)
+	}
+
+	snapshots := make([]*backend.Snapshot, 0)
+
+	for _, s := range *resp.JSON200.Data {
+		snapshot, err := intoSnapshot(s, helpers.ValueOrNil(s.FsUid))
+		if err != nil {
+			log.Error().Ctx(ctx).Err(err).Msg("Could not parse snapshot")
+			continue
+		}
+
+		if opts.FilesystemID != "" && snapshot.FilesystemID != opts.FilesystemID {
+			continue
+		}
+
+		if len(opts.Names) > 0 && !slices.Contains(opts.Names, snapshot.Name) {
+			continue
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
+// RestoreSnapshot creates a new filesystem and restores the snapshot content into it,
+// the source filesystem is not modified. If restore fails, the new filesystem is removed.
+func (b *Backend) RestoreSnapshot(ctx context.Context, opts backend.RestoreSnapshotOpts) (*backend.RestoredFilesystem, error) {
+	if opts.AuthCreds == nil {
+		return nil, errors.New("invalid credentials")
+	}
+	token, err := b.loginUser(ctx, *opts.AuthCreds, opts.NamespaceID)
+	if err != nil {
+		log.Info().Ctx(ctx).Err(err).Msg("User login unsuccessful")
+		return nil, err
+	}
+
+	encrypted := true
+	authRequired := true
+	resp, err := b.client.CreateFileSystemWithResponse(ctx, v4.CreateFileSystemJSONRequestBody{
+		Name:          opts.Name,
+		GroupName:     b.config.WekaConfig.TenantFsGroupName,
+		TotalCapacity: opts.Quota,
+		Encrypted:     &encrypted,
+		AuthRequired:  &authRequired,
+	}, v4.RequestEditorFn(token.Intercept))
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Msg("Error calling Weka API")
+		return nil, err
+	}
+
+	if resp.StatusCode() != 200 || resp.JSON200 == nil || resp.JSON200.Data == nil || resp.JSON200.Data.Uid == nil {
+		return nil, backend.ResponseAsErr("could not create filesystem for restore", resp.StatusCode(), resp.Body)
+	}
+	fs := resp.JSON200.Data
+
+	restoreResp, err := b.client.RestoreFileSystemFromSnapshotWithResponse(ctx, *fs.Uid, v4.RestoreFileSystemFromSnapshotJSONRequestBody{
+		SourceSnapUid: opts.SnapshotID,
+	}, v4.RequestEditorFn(token.Intercept))
+
+	if err == nil && restoreResp.StatusCode() != 200 {
+		err = backend.ResponseAsErr("could not restore snapshot", restoreResp.StatusCode(), restoreResp.Body)
+	}
+
+	if err != nil {
+		log.Error().Ctx(ctx).Err(err).Str("snapshot", opts.SnapshotID).Msg("Restore failed, cleanup filesystem")
+		purgeObs := true
+		b.client.DeleteFileSystemWithResponse(ctx, *fs.Uid, &v4.DeleteFileSystemParams{
+			PurgeFromObs: &purgeObs,
+		}, v4.RequestEditorFn(token.Intercept))
+		return nil, err
+	}
+
+	return &backend.RestoredFilesystem{
+		ID:   *fs.Uid,
+		Name: helpers.ValueOrNil(fs.Name),
+	}, nil
+}
+
+func intoSnapshot(s v4.Snapshot, filesystemID string) (*backend.Snapshot, error) {
+	if s.Uid == nil || s.Name == nil {
+		return nil, errors.New("snapshot does not contain uid or name")
+	}
+
+	var createdAt time.Time
+	if s.CreationTime != nil {
+		createdAt, _ = time.Parse(time.RFC3339, *s.CreationTime)
+	}
+
+	// Weka accounts snapshot capacity as a part of the filesystem used capacity,
+	// per snapshot usage is not reported.
+	return &backend.Snapshot{
+		ID:           *s.Uid,
+		Name:         *s.Name,
+		FilesystemID: filesystemID,
+		CreatedAt:    createdAt,
+	}, nil
+}
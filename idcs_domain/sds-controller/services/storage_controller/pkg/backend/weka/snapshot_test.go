@@ -0,0 +1,317 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package weka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend"
+	"github.com/stretchr/testify/assert"
+)
+
+var defaultSnapshot = backend.Snapshot{
+	ID:           "snapId",
+	Name:         "snapName",
+	FilesystemID: "fsId",
+	CreatedAt:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func TestBackend_CreateSnapshot(t *testing.T) {
+	opts := backend.CreateSnapshotOpts{
+		NamespaceID:  "nsId",
+		FilesystemID: "fsId",
+		Name:         "snapName",
+		AuthCreds:    correctCreds,
+	}
+	type args struct {
+		ctx  context.Context
+		opts backend.CreateSnapshotOpts
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *backend.Snapshot
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "create snapshot",
+			args: args{
+				ctx:  context.Background(),
+				opts: opts,
+			},
+			want:      &defaultSnapshot,
+			assertion: assert.NoError,
+		},
+		{
+			name: "no creds",
+			args: args{
+				ctx: context.Background(),
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "api error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.CreateSnapshotWithResponse.error", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "empty response",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.CreateSnapshotWithResponse.empty", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "incomplete response",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.CreateSnapshotWithResponse.incomplete", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newMockBackend(mockBackendOpts{})
+			got, err := b.CreateSnapshot(tt.args.ctx, tt.args.opts)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBackend_DeleteSnapshot(t *testing.T) {
+	opts := backend.DeleteSnapshotOpts{
+		NamespaceID: "nsId",
+		SnapshotID:  "snapId",
+		AuthCreds:   correctCreds,
+	}
+	type args struct {
+		ctx  context.Context
+		opts backend.DeleteSnapshotOpts
+	}
+	tests := []struct {
+		name      string
+		args      args
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "delete snapshot",
+			args: args{
+				ctx:  context.Background(),
+				opts: opts,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "delete not existing snapshot",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.DeleteSnapshotWithResponse.404", true),
+				opts: opts,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "no creds",
+			args: args{
+				ctx: context.Background(),
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "api error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.DeleteSnapshotWithResponse.error", true),
+				opts: opts,
+			},
+			assertion: assert.Error,
+		},
+		{
+			name: "empty response",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.DeleteSnapshotWithResponse.empty", true),
+				opts: opts,
+			},
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newMockBackend(mockBackendOpts{})
+			tt.assertion(t, b.DeleteSnapshot(tt.args.ctx, tt.args.opts))
+		})
+	}
+}
+
+func TestBackend_ListSnapshots(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		opts backend.ListSnapshotsOpts
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      []*backend.Snapshot
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "list snapshots of filesystem",
+			args: args{
+				ctx: context.Background(),
+				opts: backend.ListSnapshotsOpts{
+					NamespaceID:  "nsId",
+					FilesystemID: "fsId",
+					AuthCreds:    correctCreds,
+				},
+			},
+			want:      []*backend.Snapshot{&defaultSnapshot},
+			assertion: assert.NoError,
+		},
+		{
+			name: "list snapshots by name",
+			args: args{
+				ctx: context.Background(),
+				opts: backend.ListSnapshotsOpts{
+					NamespaceID: "nsId",
+					Names:       []string{"snapName"},
+					AuthCreds:   correctCreds,
+				},
+			},
+			want:      []*backend.Snapshot{&defaultSnapshot},
+			assertion: assert.NoError,
+		},
+		{
+			name: "skip incomplete snapshots",
+			args: args{
+				ctx: context.WithValue(context.Background(), "testing.GetSnapshotsWithResponse.incomplete", true),
+				opts: backend.ListSnapshotsOpts{
+					NamespaceID:  "nsId",
+					FilesystemID: "fsId",
+					AuthCreds:    correctCreds,
+				},
+			},
+			want:      []*backend.Snapshot{},
+			assertion: assert.NoError,
+		},
+		{
+			name: "no creds",
+			args: args{
+				ctx: context.Background(),
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "api error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.GetSnapshotsWithResponse.error", true),
+				opts: backend.ListSnapshotsOpts{NamespaceID: "nsId", AuthCreds: correctCreds},
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "empty response",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.GetSnapshotsWithResponse.empty", true),
+				opts: backend.ListSnapshotsOpts{NamespaceID: "nsId", AuthCreds: correctCreds},
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newMockBackend(mockBackendOpts{})
+			got, err := b.ListSnapshots(tt.args.ctx, tt.args.opts)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBackend_RestoreSnapshot(t *testing.T) {
+	opts := backend.RestoreSnapshotOpts{
+		NamespaceID: "nsId",
+		SnapshotID:  "snapId",
+		Name:        "fsName",
+		Quota:       1000000,
+		AuthCreds:   correctCreds,
+	}
+	type args struct {
+		ctx  context.Context
+		opts backend.RestoreSnapshotOpts
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *backend.RestoredFilesystem
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "restore snapshot",
+			args: args{
+				ctx:  context.Background(),
+				opts: opts,
+			},
+			want: &backend.RestoredFilesystem{
+				ID:   "fsId",
+				Name: "fsName",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "no creds",
+			args: args{
+				ctx: context.Background(),
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "create filesystem error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.CreateFileSystemResponse.error", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "restore error",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.RestoreFileSystemFromSnapshotWithResponse.error", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+		{
+			name: "restore empty response",
+			args: args{
+				ctx:  context.WithValue(context.Background(), "testing.RestoreFileSystemFromSnapshotWithResponse.empty", true),
+				opts: opts,
+			},
+			want:      nil,
+			assertion: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newMockBackend(mockBackendOpts{})
+			got, err := b.RestoreSnapshot(tt.args.ctx, tt.args.opts)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -111,6 +111,9 @@ func (s *Server) CreateGrpcServer() (*grpc.Server, error) {
 	api.RegisterS3ServiceServer(grpcServer, &S3Handler{
 		Backends: backends,
 	})
+	api.RegisterSnapshotServiceServer(grpcServer, &SnapshotHandler{
+		Backends: backends,
+	})
 
 	weka_api.RegisterStatefulClientServiceServer(grpcServer, &weka.StatefulClientHandler{
 		Backends: backends,
@@ -0,0 +1,174 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend"
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/server/helpers"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1 "github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/api/intel/storagecontroller/v1"
+)
+
+type SnapshotHandler struct {
+	Backends map[string]backend.Interface
+}
+
+// CreateSnapshot implements v1.SnapshotServiceServer.
+func (h *SnapshotHandler) CreateSnapshot(ctx context.Context, r *v1.CreateSnapshotRequest) (*v1.CreateSnapshotResponse, error) {
+	b := h.Backends[r.GetNamespaceId().GetClusterId().GetUuid()]
+	if b == nil {
+		log.Info().Ctx(ctx).Str("uuid", r.GetNamespaceId().GetClusterId().GetUuid()).Msg("Cluster with provided uuid was not found")
+		return nil, status.Error(codes.NotFound, "cluster does not exists")
+	}
+	s, ok := b.(backend.SnapshotOps)
+	if !ok {
+		log.Info().Ctx(ctx).Str("uuid", r.GetNamespaceId().GetClusterId().GetUuid()).Msg("Cluster with provided uuid does not support snapshot operations")
+		return nil, status.Error(codes.FailedPrecondition, "cluster does not support snapshot operations")
+	}
+
+	log.Info().Ctx(ctx).Str("name", r.GetName()).Str("filesystem", r.GetFilesystemId()).Msg("Creating snapshot")
+
+	snapshot, err := s.CreateSnapshot(ctx, backend.CreateSnapshotOpts{
+		NamespaceID:  r.GetNamespaceId().GetId(),
+		FilesystemID: r.GetFilesystemId(),
+		Name:         r.GetName(),
+		AuthCreds:    helpers.IntoAuthCreds(r.GetAuthCtx()),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().Ctx(ctx).Str("id", snapshot.ID).Msg("Created snapshot")
+
+	return &v1.CreateSnapshotResponse{
+		Snapshot: intoSnapshot(r.GetNamespaceId(), snapshot),
+	}, nil
+}
+
+// DeleteSnapshot implements v1.SnapshotServiceServer.
+func (h *SnapshotHandler) DeleteSnapshot(ctx context.Context, r *v1.DeleteSnapshotRequest) (*v1.DeleteSnapshotResponse, error) {
+	b := h.Backends[r.GetSnapshotId().GetNamespaceId().GetClusterId().GetUuid()]
+	if b == nil {
+		log.Info().Ctx(ctx).Str("uuid", r.GetSnapshotId().GetNamespaceId().GetClusterId().GetUuid()).Msg("Cluster with provided uuid was not found")
+		return nil, status.Error(codes.NotFound, "cluster does not exists")
+	}
+	s, ok := b.(backend.SnapshotOps)
+	if !ok {
+		log.Info().Ctx(ctx).Str("uuid", r.GetSnapshotId().GetNamespaceId().GetClusterId().GetUuid()).Msg("Cluster with provided uuid does not support snapshot operations")
+		return nil, status.Error(codes.FailedPrecondition, "cluster does not support snapshot operations")
+	}
+
+	log.Info().Ctx(ctx).Str("id", r.GetSnapshotId().GetId()).Msg("Deleting snapshot")
+
+	err := s.DeleteSnapshot(ctx, backend.DeleteSnapshotOpts{
+		NamespaceID: r.GetSnapshotId().GetNamespaceId().GetId(),
+		SnapshotID:  r.GetSnapshotId().GetId(),
+		AuthCreds:   helpers.IntoAuthCreds(r.GetAuthCtx()),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteSnapshotResponse{}, nil
+}
+
+// ListSnapshots implements v1.SnapshotServiceServer.
+func (h *SnapshotHandler) ListSnapshots(ctx context.Context, r *v1.ListSnapshotsRequest) (*v1.ListSnapshotsResponse, error) {
+	b := h.Backends[r.GetNamespaceId().GetClusterId().GetUuid()]
+	if b == nil {
+		log.Info().Ctx(ctx).Str("uuid", r.GetNamespaceId().GetClusterId().GetUuid()).Msg("Cluster with provided uuid was not found")
+		return nil, status.Error(codes.NotFound, "cluster does not exists")
+	}
+	s, ok := b.(backend.SnapshotOps)
+	if !ok {
+		log.Info().Ctx(ctx).Str("uuid", r.GetNamespaceId().GetClusterId().GetUuid()).Msg("Cluster with provided uuid does not support snapshot operations")
+		return nil, status.Error(codes.FailedPrecondition, "cluster does not support snapshot operations")
+	}
+
+	log.Info().Ctx(ctx).Str("filesystem", r.GetFilter().GetFilesystemId()).Strs("names", r.GetFilter().GetNames()).Msg("Listing snapshots")
+
+	snaps, err := s.ListSnapshots(ctx, backend.ListSnapshotsOpts{
+		NamespaceID:  r.GetNamespaceId().GetId(),
+		FilesystemID: r.GetFilter().GetFilesystemId(),
+		Names:        r.GetFilter().GetNames(),
+		AuthCreds:    helpers.IntoAuthCreds(r.GetAuthCtx()),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*v1.Snapshot, 0)
+
+	for _, snapshot := range snaps {
+		snapshots = append(snapshots, intoSnapshot(r.GetNamespaceId(), snapshot))
+	}
+
+	return &v1.ListSnapshotsResponse{
+		Snapshots: snapshots,
+	}, nil
+}
+
+// RestoreSnapshot implements v1.SnapshotServiceServer.
+func (h *SnapshotHandler) RestoreSnapshot(ctx context.Context, r *v1.RestoreSnapshotRequest) (*v1.RestoreSnapshotResponse, error) {
+	b := h.Backends[r.GetSnapshotId().GetNamespaceId().GetClusterId().GetUuid()]
+	if b == nil {
+		log.Info().Ctx(ctx).Str("uuid", r.GetSnapshotId().GetNamespaceId().GetClusterId().GetUuid()).Msg("Cluster with provided uuid was not found")
+		return nil, status.Error(codes.NotFound, "cluster does not exists")
+	}
+	s, ok := b.(backend.SnapshotOps)
+	if !ok {
+		log.Info().Ctx(ctx).Str("uuid", r.GetSnapshotId().GetNamespaceId().GetClusterId().GetUuid()).Msg("Cluster with provided uuid does not support snapshot operations")
+		return nil, status.Error(codes.FailedPrecondition, "cluster does not support snapshot operations")
+	}
+
+	log.Info().Ctx(ctx).Str("id", r.GetSnapshotId().GetId()).Str("name", r.GetFilesystemName()).Msg("Restoring snapshot")
+
+	fs, err := s.RestoreSnapshot(ctx, backend.RestoreSnapshotOpts{
+		NamespaceID: r.GetSnapshotId().GetNamespaceId().GetId(),
+		SnapshotID:  r.GetSnapshotId().GetId(),
+		Name:        r.GetFilesystemName(),
+		Quota:       r.GetTotalBytes(),
+		AuthCreds:   helpers.IntoAuthCreds(r.GetAuthCtx()),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().Ctx(ctx).Str("id", fs.ID).Msg("Restored snapshot")
+
+	return &v1.RestoreSnapshotResponse{
+		FilesystemId:   fs.ID,
+		FilesystemName: fs.Name,
+	}, nil
+}
+
+func intoSnapshot(namespaceID *v1.NamespaceIdentifier, snapshot *backend.Snapshot) *v1.Snapshot {
+	if snapshot == nil || namespaceID == nil {
+		return nil
+	}
+
+	var createdTimestamp int64
+	if !snapshot.CreatedAt.IsZero() {
+		createdTimestamp = snapshot.CreatedAt.Unix()
+	}
+
+	return &v1.Snapshot{
+		Id: &v1.SnapshotIdentifier{
+			NamespaceId: namespaceID,
+			Id:          snapshot.ID,
+		},
+		Name:             snapshot.Name,
+		FilesystemId:     snapshot.FilesystemID,
+		CreatedTimestamp: createdTimestamp,
+		UsedBytes:        snapshot.UsedBytes,
+	}
+}
@@ -0,0 +1,465 @@
+// INTEL CONFIDENTIAL
+// Copyright (C) 2023 Intel Corporation
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	v1 "github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/api/intel/storagecontroller/v1"
+	"github.com/intel-innersource/applications.infrastructure.idcstorage.sds-controller/services/storage_controller/pkg/backend"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var snapshot = backend.Snapshot{
+	ID:           "snapId",
+	Name:         "snap",
+	FilesystemID: "fsId",
+	CreatedAt:    time.Unix(1704067200, 0),
+	UsedBytes:    1024,
+}
+
+var snapshotResponse = v1.Snapshot{
+	Id: &v1.SnapshotIdentifier{
+		NamespaceId: &v1.NamespaceIdentifier{
+			ClusterId: &v1.ClusterIdentifier{
+				Uuid: testClusterUuid,
+			},
+			Id: "nsId",
+		},
+		Id: snapshot.ID,
+	},
+	Name:             snapshot.Name,
+	FilesystemId:     snapshot.FilesystemID,
+	CreatedTimestamp: 1704067200,
+	UsedBytes:        snapshot.UsedBytes,
+}
+
+type MockSnapshotBackend struct {
+	backend.Interface
+}
+
+func (*MockSnapshotBackend) CreateSnapshot(ctx context.Context, opts backend.CreateSnapshotOpts) (*backend.Snapshot, error) {
+	if opts.Name == "error1" {
+		return nil, errors.New("something wrong")
+	}
+
+	return &snapshot, nil
+}
+
+func (*MockSnapshotBackend) DeleteSnapshot(ctx context.Context, opts backend.DeleteSnapshotOpts) error {
+	if opts.SnapshotID == "error2" {
+		return errors.New("something wrong")
+	}
+
+	return nil
+}
+
+func (*MockSnapshotBackend) ListSnapshots(ctx context.Context, opts backend.ListSnapshotsOpts) ([]*backend.Snapshot, error) {
+	if slices.Contains(opts.Names, "error3") {
+		return nil, errors.New("something wrong")
+	}
+
+	return []*backend.Snapshot{&snapshot}, nil
+}
+
+func (*MockSnapshotBackend) RestoreSnapshot(ctx context.Context, opts backend.RestoreSnapshotOpts) (*backend.RestoredFilesystem, error) {
+	if opts.Name == "error4" {
+		return nil, errors.New("something wrong")
+	}
+
+	return &backend.RestoredFilesystem{
+		ID:   "fsId2",
+		Name: opts.Name,
+	}, nil
+}
+
+// make sure the backend implements SnapshotOps
+var _ backend.SnapshotOps = &MockSnapshotBackend{}
+
+type MockNoSnapshotsBackend struct {
+	backend.Interface
+}
+
+func TestSnapshotHandler_CreateSnapshot(t *testing.T) {
+	createReq := v1.CreateSnapshotRequest{
+		NamespaceId:  snapshotResponse.GetId().GetNamespaceId(),
+		FilesystemId: "fsId",
+		Name:         "snap",
+	}
+	type fields struct {
+		Backends map[string]backend.Interface
+	}
+	type args struct {
+		ctx context.Context
+		r   *v1.CreateSnapshotRequest
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		want      *v1.CreateSnapshotResponse
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "create snapshot",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &createReq,
+			},
+			want: &v1.CreateSnapshotResponse{
+				Snapshot: &snapshotResponse,
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "fail on error",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r: &v1.CreateSnapshotRequest{
+					NamespaceId:  snapshotResponse.GetId().GetNamespaceId(),
+					FilesystemId: "fsId",
+					Name:         "error1",
+				},
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.Unknown),
+		},
+		{
+			name: "fail on not found",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					"00000000-0000-0000-0000-000000000001": &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &createReq,
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.NotFound),
+		},
+		{
+			name: "fail on unsupported",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockNoSnapshotsBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &createReq,
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.FailedPrecondition),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &SnapshotHandler{
+				Backends: tt.fields.Backends,
+			}
+			got, err := h.CreateSnapshot(tt.args.ctx, tt.args.r)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSnapshotHandler_DeleteSnapshot(t *testing.T) {
+	deleteReq := v1.DeleteSnapshotRequest{
+		SnapshotId: snapshotResponse.GetId(),
+	}
+	type fields struct {
+		Backends map[string]backend.Interface
+	}
+	type args struct {
+		ctx context.Context
+		r   *v1.DeleteSnapshotRequest
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		want      *v1.DeleteSnapshotResponse
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "delete snapshot",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &deleteReq,
+			},
+			want:      &v1.DeleteSnapshotResponse{},
+			assertion: assert.NoError,
+		},
+		{
+			name: "fail on error",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r: &v1.DeleteSnapshotRequest{
+					SnapshotId: &v1.SnapshotIdentifier{
+						NamespaceId: snapshotResponse.GetId().GetNamespaceId(),
+						Id:          "error2",
+					},
+				},
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.Unknown),
+		},
+		{
+			name: "fail on not found",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					"00000000-0000-0000-0000-000000000001": &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &deleteReq,
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.NotFound),
+		},
+		{
+			name: "fail on unsupported",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockNoSnapshotsBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &deleteReq,
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.FailedPrecondition),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &SnapshotHandler{
+				Backends: tt.fields.Backends,
+			}
+			got, err := h.DeleteSnapshot(tt.args.ctx, tt.args.r)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSnapshotHandler_ListSnapshots(t *testing.T) {
+	listReq := v1.ListSnapshotsRequest{
+		NamespaceId: snapshotResponse.GetId().GetNamespaceId(),
+		Filter: &v1.ListSnapshotsRequest_Filter{
+			FilesystemId: "fsId",
+		},
+	}
+	type fields struct {
+		Backends map[string]backend.Interface
+	}
+	type args struct {
+		ctx context.Context
+		r   *v1.ListSnapshotsRequest
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		want      *v1.ListSnapshotsResponse
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "list snapshots",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &listReq,
+			},
+			want: &v1.ListSnapshotsResponse{
+				Snapshots: []*v1.Snapshot{&snapshotResponse},
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "fail on error",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r: &v1.ListSnapshotsRequest{
+					NamespaceId: snapshotResponse.GetId().GetNamespaceId(),
+					Filter: &v1.ListSnapshotsRequest_Filter{
+						Names: []string{"error3"},
+					},
+				},
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.Unknown),
+		},
+		{
+			name: "fail on not found",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					"00000000-0000-0000-0000-000000000001": &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &listReq,
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.NotFound),
+		},
+		{
+			name: "fail on unsupported",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockNoSnapshotsBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &listReq,
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.FailedPrecondition),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &SnapshotHandler{
+				Backends: tt.fields.Backends,
+			}
+			got, err := h.ListSnapshots(tt.args.ctx, tt.args.r)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSnapshotHandler_RestoreSnapshot(t *testing.T) {
+	restoreReq := v1.RestoreSnapshotRequest{
+		SnapshotId:     snapshotResponse.GetId(),
+		FilesystemName: "restored",
+		TotalBytes:     1000000,
+	}
+	type fields struct {
+		Backends map[string]backend.Interface
+	}
+	type args struct {
+		ctx context.Context
+		r   *v1.RestoreSnapshotRequest
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		want      *v1.RestoreSnapshotResponse
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			name: "restore snapshot",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &restoreReq,
+			},
+			want: &v1.RestoreSnapshotResponse{
+				FilesystemId:   "fsId2",
+				FilesystemName: "restored",
+			},
+			assertion: assert.NoError,
+		},
+		{
+			name: "fail on error",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r: &v1.RestoreSnapshotRequest{
+					SnapshotId:     snapshotResponse.GetId(),
+					FilesystemName: "error4",
+				},
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.Unknown),
+		},
+		{
+			name: "fail on not found",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					"00000000-0000-0000-0000-000000000001": &MockSnapshotBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &restoreReq,
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.NotFound),
+		},
+		{
+			name: "fail on unsupported",
+			fields: fields{
+				Backends: map[string]backend.Interface{
+					testClusterUuid: &MockNoSnapshotsBackend{},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				r:   &restoreReq,
+			},
+			want:      nil,
+			assertion: AssertStatus(codes.FailedPrecondition),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &SnapshotHandler{
+				Backends: tt.fields.Backends,
+			}
+			got, err := h.RestoreSnapshot(tt.args.ctx, tt.args.r)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}